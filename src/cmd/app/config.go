@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// ===========================
+// 設定（環境變數）
+// ===========================
+
+// Config 應用程式設定
+//
+// 環境變數：
+// - PORT: HTTP 埠號（預設 8080）
+// - DB_PATH: SQLite 資料庫檔案（預設 bar_crm.db）
+// - CHANNEL_SECRET / CHANNEL_TOKEN: LINE Channel（未設定時停用 Webhook 與推播排程）
+// - POINTS_CONVERSION_RATE: 每 1 點所需消費金額（預設 100）
+// - POINTS_ADJUSTMENT_APPROVAL_THRESHOLD: 人工調整超過此點數需另一位管理員核准（預設 500）
+// - ICHEF_AUTO_MATCH_AMOUNT_DELTA / ICHEF_AUTO_MATCH_DATE_OFFSET_DAYS: iChef 自動匹配容差（預設 0 元 / 0 天）
+// - ICHEF_REVIEW_AMOUNT_DELTA / ICHEF_REVIEW_DATE_OFFSET_DAYS: iChef 差異審核容差（預設 50 元 / 1 天）
+// - NOTIFICATION_DISPATCH_INTERVAL / BROADCAST_DISPATCH_INTERVAL: 推播 / 群發排程間隔（預設 1m）
+// - TIER_EVALUATION_INTERVAL: 會員等級重新評估間隔（預設 1h）
+// - BIRTHDAY_BONUS_POINTS: 生日禮點數（預設 50）
+// - BIRTHDAY_BONUS_INTERVAL: 生日禮發放排程間隔（預設 1h，每位會員每年僅發放一次）
+// - SURVEY_BONUS_SETTLE_INTERVAL: 問卷獎勵補結算排程間隔（預設 10m）
+// - SURVEY_TOKEN_KEY_ID / SURVEY_TOKEN_SECRET: 問卷 Token 簽章金鑰（secret 至少 32 bytes；未設定時使用暫時金鑰，重啟後連結失效）
+// - SURVEY_TOKEN_PREVIOUS_KEY_ID / SURVEY_TOKEN_PREVIOUS_SECRET: 輪替前的金鑰（僅驗證，選填）
+// - ADMIN_SESSION_TTL: 管理後台登入有效期（預設 12h）
+// - ADMIN_BOOTSTRAP_USERNAME / ADMIN_BOOTSTRAP_PASSWORD: 尚無後台帳號時建立的首位 owner
+type Config struct {
+	Port                         int
+	DBPath                       string
+	ChannelSecret                string
+	ChannelToken                 string
+	ConversionRate               int
+	AdjustmentApprovalThreshold  int
+	AutoMatchAmountDelta         int
+	AutoMatchDateOffsetDays      int
+	ReviewAmountDelta            int
+	ReviewDateOffsetDays         int
+	NotificationDispatchInterval time.Duration
+	BroadcastDispatchInterval    time.Duration
+	TierEvaluationInterval       time.Duration
+	BirthdayBonusPoints          int
+	BirthdayBonusInterval        time.Duration
+	SurveyBonusSettleInterval    time.Duration
+	SurveyTokenKeyID             string
+	SurveyTokenSecret            string
+	SurveyTokenPreviousKeyID     string
+	SurveyTokenPreviousSecret    string
+	AdminSessionTTL              time.Duration
+	AdminBootstrapUsername       string
+	AdminBootstrapPassword       string
+}
+
+// LineEnabled LINE Channel 是否已設定
+func (c Config) LineEnabled() bool {
+	return c.ChannelSecret != "" && c.ChannelToken != ""
+}
+
+// loadConfig 讀取環境變數
+func loadConfig() (Config, error) {
+	config := Config{
+		DBPath:        envString("DB_PATH", "bar_crm.db"),
+		ChannelSecret: os.Getenv("CHANNEL_SECRET"),
+		ChannelToken:  os.Getenv("CHANNEL_TOKEN"),
+
+		SurveyTokenKeyID:          envString("SURVEY_TOKEN_KEY_ID", "k1"),
+		SurveyTokenSecret:         os.Getenv("SURVEY_TOKEN_SECRET"),
+		SurveyTokenPreviousKeyID:  os.Getenv("SURVEY_TOKEN_PREVIOUS_KEY_ID"),
+		SurveyTokenPreviousSecret: os.Getenv("SURVEY_TOKEN_PREVIOUS_SECRET"),
+
+		AdminBootstrapUsername: os.Getenv("ADMIN_BOOTSTRAP_USERNAME"),
+		AdminBootstrapPassword: os.Getenv("ADMIN_BOOTSTRAP_PASSWORD"),
+	}
+
+	var err error
+	if config.Port, err = envInt("PORT", 8080); err != nil {
+		return Config{}, err
+	}
+	if config.Port < 1 || config.Port > 65535 {
+		return Config{}, fmt.Errorf("PORT must be between 1 and 65535, got %d", config.Port)
+	}
+	if config.ConversionRate, err = envInt("POINTS_CONVERSION_RATE", 100); err != nil {
+		return Config{}, err
+	}
+	if config.AdjustmentApprovalThreshold, err = envInt("POINTS_ADJUSTMENT_APPROVAL_THRESHOLD", 500); err != nil {
+		return Config{}, err
+	}
+	if config.AutoMatchAmountDelta, err = envInt("ICHEF_AUTO_MATCH_AMOUNT_DELTA", 0); err != nil {
+		return Config{}, err
+	}
+	if config.AutoMatchDateOffsetDays, err = envInt("ICHEF_AUTO_MATCH_DATE_OFFSET_DAYS", 0); err != nil {
+		return Config{}, err
+	}
+	if config.ReviewAmountDelta, err = envInt("ICHEF_REVIEW_AMOUNT_DELTA", 50); err != nil {
+		return Config{}, err
+	}
+	if config.ReviewDateOffsetDays, err = envInt("ICHEF_REVIEW_DATE_OFFSET_DAYS", 1); err != nil {
+		return Config{}, err
+	}
+	if config.NotificationDispatchInterval, err = envDuration("NOTIFICATION_DISPATCH_INTERVAL", time.Minute); err != nil {
+		return Config{}, err
+	}
+	if config.BroadcastDispatchInterval, err = envDuration("BROADCAST_DISPATCH_INTERVAL", time.Minute); err != nil {
+		return Config{}, err
+	}
+	if config.TierEvaluationInterval, err = envDuration("TIER_EVALUATION_INTERVAL", time.Hour); err != nil {
+		return Config{}, err
+	}
+	if config.BirthdayBonusPoints, err = envInt("BIRTHDAY_BONUS_POINTS", 50); err != nil {
+		return Config{}, err
+	}
+	if config.BirthdayBonusInterval, err = envDuration("BIRTHDAY_BONUS_INTERVAL", time.Hour); err != nil {
+		return Config{}, err
+	}
+	if config.SurveyBonusSettleInterval, err = envDuration("SURVEY_BONUS_SETTLE_INTERVAL", 10*time.Minute); err != nil {
+		return Config{}, err
+	}
+	if config.AdminSessionTTL, err = envDuration("ADMIN_SESSION_TTL", 12*time.Hour); err != nil {
+		return Config{}, err
+	}
+	return config, nil
+}
+
+func envString(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func envInt(name string, fallback int) (int, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer: %w", name, err)
+	}
+	return n, nil
+}
+
+func envDuration(name string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%s must be a positive duration such as 1m, got %q", name, value)
+	}
+	return d, nil
+}
@@ -1,8 +1,108 @@
 package main
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence"
+	"github.com/jackyeh168/bar_crm/src/internal/presentation/adminapi"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// version 應用程式版本
+const version = "1.0.0"
 
 func main() {
-	fmt.Println("Bar CRM - Restaurant Member Management System")
-	fmt.Println("Version: 1.0.0")
+	log.Printf("[INFO] Bar CRM - Restaurant Member Management System %s", version)
+	if err := run(); err != nil {
+		log.Printf("[ERROR] %v", err)
+		os.Exit(1)
+	}
+}
+
+// run 啟動 HTTP 伺服器與排程，收到 SIGINT / SIGTERM 後優雅關閉
+//
+// 關閉順序：
+// 1. 停止接受新請求並等待進行中的請求完成
+// 2. 等待執行中的排程工作結束（不中斷進行中的批次）
+// 3. 關閉資料庫連線
+func run() error {
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	db, err := gorm.Open(sqlite.Open(config.DBPath), &gorm.Config{
+		// 查無資料由 Repository 轉為領域錯誤，不需記錄
+		Logger: logger.New(log.New(os.Stdout, "\r\n", log.LstdFlags), logger.Config{
+			SlowThreshold:             200 * time.Millisecond,
+			LogLevel:                  logger.Warn,
+			IgnoreRecordNotFoundError: true,
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to open database %s: %w", config.DBPath, err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database handle: %w", err)
+	}
+	defer sqlDB.Close()
+
+	if err := persistence.AutoMigrate(db); err != nil {
+		return err
+	}
+
+	app, err := newApplication(config, db)
+	if err != nil {
+		return err
+	}
+	if !config.LineEnabled() {
+		log.Printf("[WARN] CHANNEL_SECRET / CHANNEL_TOKEN not set: LINE webhook and push jobs are disabled")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var jobs sync.WaitGroup
+	for _, j := range app.jobs {
+		jobs.Add(1)
+		go func(j job) {
+			defer jobs.Done()
+			runPeriodically(ctx, j)
+		}(j)
+	}
+
+	server := adminapi.NewServer(adminapi.DefaultServerConfig(fmt.Sprintf(":%d", config.Port)), app.handler)
+	err = server.Run(ctx)
+	stop()
+	jobs.Wait()
+	return err
+}
+
+// runPeriodically 依固定間隔執行排程工作，直到 ctx 取消
+//
+// 錯誤處理：單次執行失敗僅記錄，下次間隔繼續執行
+func runPeriodically(ctx context.Context, j job) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := j.run(now); err != nil {
+				log.Printf("[ERROR] Job %s failed: %v", j.name, err)
+			}
+		}
+	}
 }
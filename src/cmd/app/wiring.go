@@ -0,0 +1,398 @@
+package main
+
+import (
+	"crypto/rand"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	appadmin "github.com/jackyeh168/bar_crm/src/internal/application/admin"
+	appbroadcast "github.com/jackyeh168/bar_crm/src/internal/application/broadcast"
+	appconversation "github.com/jackyeh168/bar_crm/src/internal/application/conversation"
+	appexternal "github.com/jackyeh168/bar_crm/src/internal/application/external"
+	appfraud "github.com/jackyeh168/bar_crm/src/internal/application/fraud"
+	appinvoice "github.com/jackyeh168/bar_crm/src/internal/application/invoice"
+	appmember "github.com/jackyeh168/bar_crm/src/internal/application/member"
+	appnotification "github.com/jackyeh168/bar_crm/src/internal/application/notification"
+	apppoints "github.com/jackyeh168/bar_crm/src/internal/application/points"
+	appsurvey "github.com/jackyeh168/bar_crm/src/internal/application/survey"
+	apptier "github.com/jackyeh168/bar_crm/src/internal/application/tier"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/external"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/fraud"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/tier"
+	"github.com/jackyeh168/bar_crm/src/internal/infrastructure/messaging"
+	"github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence"
+	adminpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/admin"
+	broadcastpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/broadcast"
+	conversationpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/conversation"
+	externalpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/external"
+	fraudpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/fraud"
+	invoicepersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/invoice"
+	memberpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/member"
+	notificationpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/notification"
+	pointspersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/points"
+	surveypersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/survey"
+	tierpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/tier"
+	"github.com/jackyeh168/bar_crm/src/internal/infrastructure/qrcode"
+	"github.com/jackyeh168/bar_crm/src/internal/infrastructure/security"
+	"github.com/jackyeh168/bar_crm/src/internal/presentation/adminapi"
+	"github.com/jackyeh168/bar_crm/src/internal/presentation/linebot"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// 依賴注入
+// ===========================
+
+// webhookPath LINE Webhook 路徑
+const webhookPath = "/webhook"
+
+// job 排程工作（依固定間隔執行）
+type job struct {
+	name     string
+	interval time.Duration
+	run      func(now time.Time) error
+}
+
+// application 組裝完成的應用程式
+type application struct {
+	handler http.Handler
+	jobs    []job
+}
+
+// newApplication 組裝 Repository、Use Case 與 HTTP Handler
+//
+// 組裝順序：Repository → TransactionManager / EventBus → Use Case → Handler
+func newApplication(config Config, db *gorm.DB) (*application, error) {
+	rate, err := points.NewConversionRate(config.ConversionRate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid POINTS_CONVERSION_RATE: %w", err)
+	}
+	approvalPolicy, err := points.NewAdjustmentApprovalPolicy(config.AdjustmentApprovalThreshold)
+	if err != nil {
+		return nil, fmt.Errorf("invalid POINTS_ADJUSTMENT_APPROVAL_THRESHOLD: %w", err)
+	}
+	birthdayPolicy, err := points.NewBirthdayBonusPolicy(config.BirthdayBonusPoints)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BIRTHDAY_BONUS_POINTS: %w", err)
+	}
+	matchingPolicy, err := newMatchingPolicy(config)
+	if err != nil {
+		return nil, err
+	}
+	surveyTokens, err := newSurveyTokenService(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Repository
+	txManager := persistence.NewGORMTransactionManager(db)
+	memberRepo := memberpersistence.NewMemberRepository(db)
+	memberSearch := memberpersistence.NewMemberSearchQuery(db)
+	phoneChangeRepo := memberpersistence.NewPhoneNumberChangeRepository(db)
+	mergeRepo := memberpersistence.NewMemberMergeRepository(db)
+	birthdayQuery := memberpersistence.NewBirthdayMemberQuery(db)
+	accountRepo := pointspersistence.NewPointsAccountRepository(db)
+	adjustmentRepo := pointspersistence.NewPointsAdjustmentRepository(db)
+	birthdayGrantRepo := pointspersistence.NewBirthdayBonusGrantRepository(db)
+	rewardRepo := pointspersistence.NewRewardRepository(db)
+	transactionRepo := invoicepersistence.NewInvoiceTransactionRepository(db)
+	verifiedSpend := invoicepersistence.NewVerifiedSpendQuery(db)
+	scanActivity := invoicepersistence.NewScanActivityQuery(db)
+	tierRepo := tierpersistence.NewMemberTierRepository(db)
+	fraudCaseRepo := fraudpersistence.NewFraudCaseRepository(db)
+	reviewRepo := externalpersistence.NewDiscrepancyReviewRepository(db)
+	surveyRepo := surveypersistence.NewSurveyRepository(db)
+	surveyAnalytics := surveypersistence.NewSurveyAnalyticsQuery(db)
+	responseRepo := surveypersistence.NewSurveyResponseRepository(db)
+	tokenUsageRepo := surveypersistence.NewSurveyTokenUsageRepository(db)
+	pendingBonusQuery := surveypersistence.NewPendingBonusQuery(db)
+	sessionRepo := conversationpersistence.NewRegistrationSessionRepository(db)
+	notificationRepo := notificationpersistence.NewNotificationRepository(db)
+	preferenceRepo := notificationpersistence.NewNotificationPreferenceRepository(db)
+	campaignRepo := broadcastpersistence.NewCampaignRepository(db)
+	recipientRepo := broadcastpersistence.NewRecipientRepository(db)
+	exclusionRepo := broadcastpersistence.NewExclusionRepository(db)
+	audienceQuery := broadcastpersistence.NewAudienceQuery(db)
+	adminUserRepo := adminpersistence.NewAdminUserRepository(db)
+	adminSessionRepo := adminpersistence.NewSessionRepository(db)
+	hasher := security.NewBcryptPasswordHasher(security.DefaultBcryptCost)
+
+	if err := bootstrapOwner(config, adminUserRepo, hasher, txManager); err != nil {
+		return nil, err
+	}
+
+	// 事件：會員封鎖 / 解除封鎖 → 維護群發排除名單
+	eventBus := messaging.NewInMemoryEventBus()
+	reachabilityHandler := appbroadcast.NewMemberReachabilityHandler(exclusionRepo, txManager)
+	if err := eventBus.SubscribeFunc(reachabilityHandler.Handle,
+		"member.unfollowed", "member.refollowed"); err != nil {
+		return nil, err
+	}
+
+	// 事件：發票交易驗證 → 結算待發放的問卷獎勵
+	settleSurveyBonus := appsurvey.NewSettleSurveyBonusHandler(responseRepo, accountRepo, txManager, eventBus)
+	if err := eventBus.SubscribeFunc(settleSurveyBonus.Handle, "invoice.transaction_verified"); err != nil {
+		return nil, err
+	}
+
+	// 事件：積分異動 → 建立待推播的積分通知（由 dispatch-notifications 排程推播）
+	pointsNotification := appnotification.NewPointsNotificationHandler(accountRepo, notificationRepo, preferenceRepo, txManager)
+	if err := eventBus.SubscribeFunc(pointsNotification.Handle,
+		"points.earned", "points.deducted", "points.adjusted"); err != nil {
+		return nil, err
+	}
+
+	// 會員等級：發票入帳依目前等級加成積分
+	tierPolicy := tier.DefaultTierPolicy()
+	multipliers := apptier.NewEarningMultiplierQuery(tierRepo, tierPolicy)
+
+	// 會員合併：發票交易、問卷回覆、積分調整改歸屬保留的會員
+	mergeReassigners := appmember.MemberHistoryReassigners{
+		Transactions:    invoicepersistence.NewTransactionReassigner(db),
+		SurveyResponses: surveypersistence.NewResponseReassigner(db),
+		Adjustments:     pointspersistence.NewAdjustmentReassigner(db),
+	}
+
+	// 會員填寫問卷：答案依 Token 綁定的問卷驗證，交易已驗證時立即發放獎勵
+	submitSurveyResponse := appsurvey.NewSubmitSurveyResponseUseCase(
+		surveyTokens, tokenUsageRepo, surveyRepo, responseRepo, transactionRepo, accountRepo, txManager, eventBus,
+	)
+
+	// 管理後台 API
+	login, err := appadmin.NewLoginUseCase(adminUserRepo, adminSessionRepo, hasher, config.AdminSessionTTL, txManager)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(adminapi.BasePath+"/", adminapi.NewRouter(adminapi.UseCases{
+		Login:              login,
+		Logout:             appadmin.NewLogoutUseCase(adminSessionRepo, txManager),
+		Authorize:          appadmin.NewAuthorizeUseCase(adminUserRepo, adminSessionRepo),
+		CreateAdminUser:    appadmin.NewCreateAdminUserUseCase(adminUserRepo, hasher, txManager),
+		DisableAdminUser:   appadmin.NewDisableAdminUserUseCase(adminUserRepo, adminSessionRepo, txManager),
+		BeginTOTP:          appadmin.NewBeginTOTPEnrollmentUseCase(adminUserRepo, txManager),
+		ConfirmTOTP:        appadmin.NewConfirmTOTPEnrollmentUseCase(adminUserRepo, adminSessionRepo, txManager),
+		RegenerateRecovery: appadmin.NewRegenerateRecoveryCodesUseCase(adminUserRepo, txManager),
+		MemberQuery:        appmember.NewGetMemberByLineUserIDUseCase(memberRepo),
+		SearchMembers:      appmember.NewSearchMembersUseCase(memberSearch),
+		UpdateProfile:      appmember.NewUpdateMemberProfileUseCase(memberRepo, txManager),
+		VerifyAge:          appmember.NewVerifyMemberAgeUseCase(memberRepo, txManager),
+		RebindPhone:        appmember.NewRebindPhoneNumberUseCase(memberRepo, phoneChangeRepo, txManager, eventBus),
+		UnbindPhone:        appmember.NewUnbindPhoneNumberUseCase(memberRepo, phoneChangeRepo, txManager, eventBus),
+		PhoneChanges:       appmember.NewListPhoneNumberChangesUseCase(phoneChangeRepo),
+		MergeMembers:       appmember.NewMergeMembersUseCase(memberRepo, mergeRepo, accountRepo, mergeReassigners, txManager, eventBus),
+		MemberMerges:       appmember.NewListMemberMergesUseCase(mergeRepo),
+		MemberTier:         apptier.NewGetMemberTierUseCase(tierRepo, tierPolicy),
+		NotificationPrefs:  appnotification.NewUpdateNotificationPreferenceUseCase(preferenceRepo, txManager),
+		BalanceQuery:       apppoints.NewGetPointsBalanceUseCase(accountRepo),
+		FreezeAccount:      apppoints.NewFreezePointsAccountUseCase(accountRepo, txManager),
+		UnfreezeAccount:    apppoints.NewUnfreezePointsAccountUseCase(accountRepo, txManager),
+		AdjustPoints:       apppoints.NewAdjustPointsUseCase(accountRepo, adjustmentRepo, approvalPolicy, txManager, eventBus),
+		ApproveAdjustment:  apppoints.NewApprovePointsAdjustmentUseCase(accountRepo, adjustmentRepo, txManager, eventBus),
+		RejectAdjustment:   apppoints.NewRejectPointsAdjustmentUseCase(accountRepo, adjustmentRepo, txManager),
+		WithdrawAdjustment: apppoints.NewWithdrawPointsAdjustmentUseCase(accountRepo, adjustmentRepo, txManager),
+		ListAdjustments:    apppoints.NewListPointsAdjustmentsUseCase(adjustmentRepo),
+		CreateReward:       apppoints.NewCreateRewardUseCase(rewardRepo, txManager),
+		ListRewards:        apppoints.NewListRewardsUseCase(rewardRepo),
+		RedeemReward:       apppoints.NewRedeemRewardUseCase(rewardRepo, accountRepo, memberRepo, txManager, eventBus),
+		ClearFraudCase:     appfraud.NewClearFraudCaseUseCase(fraudCaseRepo, transactionRepo, txManager),
+		ConfirmFraudCase:   appfraud.NewConfirmFraudCaseUseCase(fraudCaseRepo, transactionRepo, accountRepo, txManager),
+		MatchIChefRecord: appexternal.NewMatchIChefRecordUseCase(
+			transactionRepo, reviewRepo, accountRepo, matchingPolicy, rate, multipliers, txManager, eventBus,
+		),
+		ListDiscrepancies:  appexternal.NewListPendingDiscrepanciesUseCase(reviewRepo),
+		ApproveDiscrepancy: appexternal.NewApproveDiscrepancyUseCase(reviewRepo, transactionRepo, accountRepo, rate, multipliers, txManager, eventBus),
+		RejectDiscrepancy:  appexternal.NewRejectDiscrepancyUseCase(reviewRepo, transactionRepo, txManager),
+		CreateSurvey:       appsurvey.NewCreateSurveyUseCase(surveyRepo, txManager),
+		ReviseSurvey:       appsurvey.NewReviseSurveyUseCase(surveyRepo, txManager),
+		ActivateSurvey:     appsurvey.NewActivateSurveyUseCase(surveyRepo, txManager),
+		DeactivateSurvey:   appsurvey.NewDeactivateSurveyUseCase(surveyRepo, txManager),
+		ActiveSurvey:       appsurvey.NewGetActiveSurveyUseCase(surveyRepo),
+		IssueSurveyToken:   appsurvey.NewIssueSurveyTokenUseCase(transactionRepo, surveyRepo, surveyTokens),
+		OpenSurvey:         appsurvey.NewOpenSurveyUseCase(surveyTokens, tokenUsageRepo, surveyRepo),
+		SubmitSurvey:       submitSurveyResponse,
+		SurveyStatistics:   appsurvey.NewGetSurveyStatisticsUseCase(surveyRepo, surveyAnalytics),
+		SurveyNPS:          appsurvey.NewGetSurveyNPSUseCase(surveyRepo, surveyAnalytics),
+		TextAnswers:        appsurvey.NewListTextAnswersUseCase(surveyRepo, surveyAnalytics),
+		ExportResponses:    appsurvey.NewExportSurveyResponsesUseCase(surveyRepo, surveyAnalytics),
+		CreateCampaign:     appbroadcast.NewCreateCampaignUseCase(campaignRepo, txManager),
+		CancelCampaign:     appbroadcast.NewCancelCampaignUseCase(campaignRepo, txManager),
+		CampaignReport:     appbroadcast.NewGetCampaignReportUseCase(campaignRepo, recipientRepo),
+		ConversionRate:     rate,
+	}))
+
+	// 會員等級定期評估、生日禮發放與問卷獎勵補結算（不需 LINE Channel）
+	reevaluateTiers := apptier.NewReevaluateMemberTiersUseCase(tierRepo, verifiedSpend, tierPolicy, txManager, eventBus)
+	grantBirthdayBonuses := appmember.NewGrantBirthdayBonusesUseCase(
+		birthdayQuery, accountRepo, birthdayGrantRepo, birthdayPolicy, txManager, eventBus,
+	)
+	settlePendingSurveyBonuses := appsurvey.NewSettlePendingSurveyBonusesUseCase(
+		pendingBonusQuery, responseRepo, accountRepo, txManager, eventBus,
+	)
+	app := &application{handler: mux, jobs: []job{
+		{
+			name:     "reevaluate-member-tiers",
+			interval: config.TierEvaluationInterval,
+			run: func(now time.Time) error {
+				_, err := reevaluateTiers.Execute(apptier.ReevaluateMemberTiersCommand{Now: now})
+				return err
+			},
+		},
+		{
+			name:     "grant-birthday-bonuses",
+			interval: config.BirthdayBonusInterval,
+			run: func(now time.Time) error {
+				_, err := grantBirthdayBonuses.Execute(appmember.GrantBirthdayBonusesCommand{Now: now})
+				return err
+			},
+		},
+		{
+			name:     "settle-survey-bonuses",
+			interval: config.SurveyBonusSettleInterval,
+			run: func(time.Time) error {
+				_, err := settlePendingSurveyBonuses.Execute()
+				return err
+			},
+		},
+	}}
+	if !config.LineEnabled() {
+		return app, nil
+	}
+
+	// LINE Webhook 與推播排程
+	client := linebot.NewClient(linebot.ClientConfig{ChannelAccessToken: config.ChannelToken})
+	registerMember := appmember.NewRegisterMemberUseCase(memberRepo, txManager)
+	screenScan := appfraud.NewScreenScanUseCase(
+		transactionRepo, memberRepo, fraudCaseRepo, scanActivity, fraud.DefaultFraudRules(), txManager,
+	)
+	recordScannedInvoice := appinvoice.NewRecordScannedInvoiceUseCase(transactionRepo, screenScan, txManager, eventBus)
+	router := linebot.NewEventRouter(
+		appmember.NewGetMemberByLineUserIDUseCase(memberRepo),
+		appconversation.NewStartRegistrationUseCase(sessionRepo, txManager),
+		appconversation.NewHandleRegistrationInputUseCase(
+			sessionRepo, registerMember, appmember.NewBindPhoneNumberUseCase(memberRepo, txManager), txManager,
+		),
+		apppoints.NewGetPointsBalanceUseCase(accountRepo),
+		appmember.NewUpdateReachabilityUseCase(memberRepo, txManager, eventBus),
+		appmember.NewSyncLineProfileUseCase(memberRepo, txManager),
+		appmember.NewRecordBirthdayUseCase(memberRepo, txManager),
+		linebot.NewInvoiceQRProcessor(client, qrcode.NewDecoder(), recordScannedInvoice),
+		client,
+		client,
+	)
+	mux.Handle(webhookPath, linebot.NewWebhookHandler(config.ChannelSecret, router))
+
+	dispatchNotifications := appnotification.NewDispatchNotificationsUseCase(
+		notificationRepo, preferenceRepo, memberRepo, client, txManager,
+	)
+	dispatchBroadcasts := appbroadcast.NewDispatchBroadcastsUseCase(
+		campaignRepo, recipientRepo, exclusionRepo, audienceQuery, client, txManager, appbroadcast.ThrottleConfig{},
+	)
+	app.jobs = append(app.jobs,
+		job{
+			name:     "dispatch-notifications",
+			interval: config.NotificationDispatchInterval,
+			run: func(now time.Time) error {
+				_, err := dispatchNotifications.Execute(appnotification.DispatchNotificationsCommand{Now: now})
+				return err
+			},
+		},
+		job{
+			name:     "dispatch-broadcasts",
+			interval: config.BroadcastDispatchInterval,
+			run: func(now time.Time) error {
+				_, err := dispatchBroadcasts.Execute(appbroadcast.DispatchBroadcastsCommand{Now: now})
+				return err
+			},
+		},
+	)
+	return app, nil
+}
+
+// newMatchingPolicy 依 ICHEF_* 設定建立 iChef 匹配策略
+func newMatchingPolicy(config Config) (external.MatchingPolicy, error) {
+	autoMatch, err := external.NewMatchingTolerance(config.AutoMatchAmountDelta, config.AutoMatchDateOffsetDays)
+	if err != nil {
+		return external.MatchingPolicy{}, fmt.Errorf("invalid ICHEF_AUTO_MATCH_* tolerance: %w", err)
+	}
+	review, err := external.NewMatchingTolerance(config.ReviewAmountDelta, config.ReviewDateOffsetDays)
+	if err != nil {
+		return external.MatchingPolicy{}, fmt.Errorf("invalid ICHEF_REVIEW_* tolerance: %w", err)
+	}
+	policy, err := external.NewMatchingPolicy(autoMatch, review)
+	if err != nil {
+		return external.MatchingPolicy{}, fmt.Errorf("invalid iChef matching policy: %w", err)
+	}
+	return policy, nil
+}
+
+// newSurveyTokenService 依 SURVEY_TOKEN_* 設定建立問卷 Token 服務
+//
+// 未設定 SURVEY_TOKEN_SECRET 時使用隨機產生的暫時金鑰（重啟後已簽發的問卷連結失效）
+func newSurveyTokenService(config Config) (*survey.SurveyTokenService, error) {
+	secret := []byte(config.SurveyTokenSecret)
+	if len(secret) == 0 {
+		log.Printf("[WARN] SURVEY_TOKEN_SECRET not set: using a temporary key, survey links will expire on restart")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("failed to generate survey token key: %w", err)
+		}
+	}
+	current, err := survey.NewSigningKey(config.SurveyTokenKeyID, secret)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SURVEY_TOKEN_KEY_ID / SURVEY_TOKEN_SECRET: %w", err)
+	}
+
+	var previous []survey.SigningKey
+	if config.SurveyTokenPreviousSecret != "" {
+		key, err := survey.NewSigningKey(config.SurveyTokenPreviousKeyID, []byte(config.SurveyTokenPreviousSecret))
+		if err != nil {
+			return nil, fmt.Errorf("invalid SURVEY_TOKEN_PREVIOUS_KEY_ID / SURVEY_TOKEN_PREVIOUS_SECRET: %w", err)
+		}
+		previous = append(previous, key)
+	}
+
+	ring, err := survey.NewSigningKeyRing(current, previous...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid survey token keys: %w", err)
+	}
+	return survey.NewSurveyTokenService(ring, survey.DefaultSurveyTokenTTL)
+}
+
+// bootstrapOwner 尚無後台帳號時，以 ADMIN_BOOTSTRAP_USERNAME / ADMIN_BOOTSTRAP_PASSWORD 建立首位 owner
+func bootstrapOwner(
+	config Config,
+	userRepo admin.AdminUserRepository,
+	hasher admin.PasswordHasher,
+	txManager shared.TransactionManager,
+) error {
+	if config.AdminBootstrapUsername == "" || config.AdminBootstrapPassword == "" {
+		count, err := userRepo.Count(nil)
+		if err != nil {
+			return fmt.Errorf("failed to count admin users: %w", err)
+		}
+		if count == 0 {
+			log.Printf("[WARN] No admin users; set ADMIN_BOOTSTRAP_USERNAME and ADMIN_BOOTSTRAP_PASSWORD to create the first owner")
+		}
+		return nil
+	}
+
+	created, err := appadmin.NewBootstrapOwnerUseCase(userRepo, hasher, txManager).Execute(appadmin.BootstrapOwnerCommand{
+		Username: config.AdminBootstrapUsername,
+		Password: config.AdminBootstrapPassword,
+		Now:      time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to bootstrap owner: %w", err)
+	}
+	if created {
+		log.Printf("[INFO] Created owner account %q", config.AdminBootstrapUsername)
+	}
+	return nil
+}
@@ -0,0 +1,396 @@
+package admin
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// 管理員認證 / 授權測試
+// ===========================
+
+const testPassword = "correct horse battery"
+
+// authFixture 測試用 Repository 與 Use Case
+type authFixture struct {
+	users     *MockAdminUserRepository
+	sessions  *MockSessionRepository
+	login     *LoginUseCase
+	logout    *LogoutUseCase
+	authorize *AuthorizeUseCase
+	now       time.Time
+}
+
+func newAuthFixture(t *testing.T) *authFixture {
+	users := &MockAdminUserRepository{users: make(map[string]*admin.AdminUser)}
+	sessions := &MockSessionRepository{sessions: make(map[string]*admin.Session)}
+	tx := &MockTransactionManager{}
+	login, err := NewLoginUseCase(users, sessions, StubPasswordHasher{}, time.Hour, tx)
+	require.NoError(t, err)
+	return &authFixture{
+		users:     users,
+		sessions:  sessions,
+		login:     login,
+		logout:    NewLogoutUseCase(sessions, tx),
+		authorize: NewAuthorizeUseCase(users, sessions),
+		now:       time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+// createAdmin 透過 CreateAdminUserUseCase 建立帳號
+func (f *authFixture) createAdmin(t *testing.T, username string, role admin.Role) *AdminUserResult {
+	t.Helper()
+	result, err := NewCreateAdminUserUseCase(f.users, StubPasswordHasher{}, &MockTransactionManager{}).Execute(
+		CreateAdminUserCommand{Username: username, Role: role.String(), Password: testPassword, Now: f.now},
+	)
+	require.NoError(t, err)
+	return result
+}
+
+// enrollTOTP 以登入的工作階段完成兩步驟驗證設定
+//
+// 返回：TOTP 金鑰與復原碼
+func (f *authFixture) enrollTOTP(t *testing.T, adminID, token string) (admin.TOTPSecret, []string) {
+	t.Helper()
+	tx := &MockTransactionManager{}
+	begin, err := NewBeginTOTPEnrollmentUseCase(f.users, tx).Execute(BeginTOTPEnrollmentCommand{AdminID: adminID, Now: f.now})
+	require.NoError(t, err)
+	secret, err := admin.TOTPSecretFromBase32(begin.Secret)
+	require.NoError(t, err)
+	confirmed, err := NewConfirmTOTPEnrollmentUseCase(f.users, f.sessions, tx).Execute(ConfirmTOTPEnrollmentCommand{
+		AdminID: adminID, Token: token, Code: secret.CodeAt(f.now), Now: f.now,
+	})
+	require.NoError(t, err)
+	return secret, confirmed.RecoveryCodes
+}
+
+// Test 1: 登入成功發放 Token（只保存雜湊），Token 可通過驗證
+func TestLogin_IssuesSessionToken(t *testing.T) {
+	// Arrange
+	f := newAuthFixture(t)
+	f.createAdmin(t, "owner", admin.RoleOwner)
+
+	// Act
+	result, err := f.login.Execute(LoginCommand{Username: " Owner ", Password: testPassword, Now: f.now})
+	require.NoError(t, err)
+	principal, authErr := f.authorize.Execute(AuthorizeQuery{Token: result.Token, Now: f.now.Add(time.Minute)})
+
+	// Assert
+	assert.Equal(t, f.now.Add(time.Hour), result.ExpiresAt)
+	assert.Equal(t, "owner", result.Admin.Username)
+	assert.Contains(t, result.Admin.Permissions, admin.PermissionAdjustPoints.String())
+	require.Len(t, f.sessions.sessions, 1)
+	_, storedPlain := f.sessions.sessions[result.Token]
+	assert.False(t, storedPlain)
+
+	require.NoError(t, authErr)
+	assert.Equal(t, "owner", principal.Username)
+	assert.Equal(t, "owner", principal.Role)
+}
+
+// Test 2: 登入失敗（帳號不存在與密碼錯誤相同錯誤；失敗次數保存；達上限鎖定）
+func TestLogin_ThrottlesFailedAttempts(t *testing.T) {
+	// Arrange
+	f := newAuthFixture(t)
+	created := f.createAdmin(t, "manager", admin.RoleManager)
+
+	// Act
+	_, unknown := f.login.Execute(LoginCommand{Username: "nobody", Password: testPassword, Now: f.now})
+	var lastErr error
+	for i := 0; i < admin.MaxFailedLoginAttempts; i++ {
+		_, lastErr = f.login.Execute(LoginCommand{Username: "manager", Password: "wrong password", Now: f.now})
+	}
+	_, whileLocked := f.login.Execute(LoginCommand{Username: "manager", Password: testPassword, Now: f.now.Add(time.Minute)})
+	_, afterLockout := f.login.Execute(LoginCommand{
+		Username: "manager", Password: testPassword, Now: f.now.Add(admin.LockoutDuration),
+	})
+
+	// Assert
+	assert.ErrorIs(t, unknown, admin.ErrInvalidCredentials)
+	assert.ErrorIs(t, lastErr, admin.ErrAccountLocked)
+	assert.ErrorIs(t, whileLocked, admin.ErrAccountLocked)
+	assert.NoError(t, afterLockout)
+	stored := f.users.users["manager"]
+	assert.Equal(t, created.AdminID, stored.AdminID().String())
+	assert.Equal(t, 0, stored.FailedLoginAttempts())
+}
+
+// Test 3: 權限檢查（只有 owner 可調整積分 / 管理後台帳號）
+func TestAuthorize_EnforcesRolePermissions(t *testing.T) {
+	// Arrange
+	f := newAuthFixture(t)
+	tokens := make(map[string]string)
+	for username, role := range map[string]admin.Role{
+		"owner": admin.RoleOwner, "manager": admin.RoleManager, "auditor": admin.RoleAuditor,
+	} {
+		created := f.createAdmin(t, username, role)
+		result, err := f.login.Execute(LoginCommand{Username: username, Password: testPassword, Now: f.now})
+		require.NoError(t, err)
+		tokens[username] = result.Token
+		if role.RequiresTwoFactor() {
+			f.enrollTOTP(t, created.AdminID, result.Token)
+		}
+	}
+	check := func(username string, permission admin.Permission) error {
+		_, err := f.authorize.Execute(AuthorizeQuery{Token: tokens[username], Permission: permission, Now: f.now})
+		return err
+	}
+
+	// Act & Assert
+	assert.NoError(t, check("owner", admin.PermissionAdjustPoints))
+	assert.NoError(t, check("owner", admin.PermissionManageAdmins))
+	assert.ErrorIs(t, check("manager", admin.PermissionAdjustPoints), admin.ErrPermissionDenied)
+	assert.ErrorIs(t, check("manager", admin.PermissionManageAdmins), admin.ErrPermissionDenied)
+	assert.NoError(t, check("manager", admin.PermissionManageMembers))
+	assert.NoError(t, check("auditor", admin.PermissionExportData))
+	assert.ErrorIs(t, check("auditor", admin.PermissionManageSurveys), admin.ErrPermissionDenied)
+
+	_, missing := f.authorize.Execute(AuthorizeQuery{Token: "", Now: f.now})
+	_, forged := f.authorize.Execute(AuthorizeQuery{Token: "forged", Now: f.now})
+	_, expired := f.authorize.Execute(AuthorizeQuery{Token: tokens["owner"], Now: f.now.Add(time.Hour)})
+	assert.ErrorIs(t, missing, admin.ErrUnauthenticated)
+	assert.ErrorIs(t, forged, admin.ErrUnauthenticated)
+	assert.ErrorIs(t, expired, admin.ErrSessionExpired)
+}
+
+// Test 4: 撤銷（登出撤銷目前 Token；停用帳號撤銷其所有 Token）
+func TestLogoutAndDisable_RevokeSessions(t *testing.T) {
+	// Arrange
+	f := newAuthFixture(t)
+	owner := f.createAdmin(t, "owner", admin.RoleOwner)
+	staff := f.createAdmin(t, "staff", admin.RoleStaff)
+	first, err := f.login.Execute(LoginCommand{Username: "staff", Password: testPassword, Now: f.now})
+	require.NoError(t, err)
+	second, err := f.login.Execute(LoginCommand{Username: "staff", Password: testPassword, Now: f.now})
+	require.NoError(t, err)
+	third, err := f.login.Execute(LoginCommand{Username: "staff", Password: testPassword, Now: f.now})
+	require.NoError(t, err)
+	disable := NewDisableAdminUserUseCase(f.users, f.sessions, &MockTransactionManager{})
+
+	// Act
+	require.NoError(t, f.logout.Execute(LogoutCommand{Token: first.Token, Now: f.now}))
+	require.NoError(t, f.logout.Execute(LogoutCommand{Token: first.Token, Now: f.now}))
+	_, afterLogout := f.authorize.Execute(AuthorizeQuery{Token: first.Token, Now: f.now})
+	_, stillValid := f.authorize.Execute(AuthorizeQuery{Token: second.Token, Now: f.now})
+
+	_, self := disable.Execute(DisableAdminUserCommand{AdminID: owner.AdminID, OperatorID: owner.AdminID, Now: f.now})
+	disabled, err := disable.Execute(DisableAdminUserCommand{AdminID: staff.AdminID, OperatorID: owner.AdminID, Now: f.now})
+	require.NoError(t, err)
+	_, afterDisable := f.authorize.Execute(AuthorizeQuery{Token: third.Token, Now: f.now})
+	_, loginDisabled := f.login.Execute(LoginCommand{Username: "staff", Password: testPassword, Now: f.now})
+
+	// Assert
+	assert.ErrorIs(t, afterLogout, admin.ErrSessionRevoked)
+	assert.NoError(t, stillValid)
+	assert.ErrorIs(t, self, admin.ErrPermissionDenied)
+	assert.True(t, disabled.Disabled)
+	assert.Empty(t, disabled.Permissions)
+	assert.ErrorIs(t, afterDisable, admin.ErrSessionRevoked)
+	assert.ErrorIs(t, loginDisabled, admin.ErrAdminDisabled)
+}
+
+// Test 5: 首位 owner 只在尚無帳號時建立；帳號不可重複
+func TestBootstrapOwnerAndUniqueUsername(t *testing.T) {
+	// Arrange
+	f := newAuthFixture(t)
+	bootstrap := NewBootstrapOwnerUseCase(f.users, StubPasswordHasher{}, &MockTransactionManager{})
+	create := NewCreateAdminUserUseCase(f.users, StubPasswordHasher{}, &MockTransactionManager{})
+
+	// Act
+	created, err := bootstrap.Execute(BootstrapOwnerCommand{Username: "boss", Password: testPassword, Now: f.now})
+	require.NoError(t, err)
+	again, err := bootstrap.Execute(BootstrapOwnerCommand{Username: "other", Password: testPassword, Now: f.now})
+	require.NoError(t, err)
+	_, duplicate := create.Execute(CreateAdminUserCommand{Username: "BOSS", Role: "staff", Password: testPassword, Now: f.now})
+	_, badRole := create.Execute(CreateAdminUserCommand{Username: "x-admin", Role: "guest", Password: testPassword, Now: f.now})
+
+	// Assert
+	assert.True(t, created)
+	assert.False(t, again)
+	require.Len(t, f.users.users, 1)
+	assert.Equal(t, admin.RoleOwner, f.users.users["boss"].Role())
+	assert.ErrorIs(t, duplicate, admin.ErrUsernameTaken)
+	assert.ErrorIs(t, badRole, admin.ErrInvalidRole)
+}
+
+// Test 6: 兩步驟驗證（owner / manager 未設定前只能設定；其他工作階段需重新登入；登入需驗證碼）
+func TestTwoFactor_EnrollmentAndEnforcement(t *testing.T) {
+	// Arrange（固定時鐘）
+	f := newAuthFixture(t)
+	owner := f.createAdmin(t, "owner", admin.RoleOwner)
+	staff := f.createAdmin(t, "staff", admin.RoleStaff)
+	otherDevice, err := f.login.Execute(LoginCommand{Username: "owner", Password: testPassword, Now: f.now})
+	require.NoError(t, err)
+	current, err := f.login.Execute(LoginCommand{Username: "owner", Password: testPassword, Now: f.now})
+	require.NoError(t, err)
+	staffLogin, err := f.login.Execute(LoginCommand{Username: "staff", Password: testPassword, Now: f.now})
+	require.NoError(t, err)
+	authorize := func(token string, permission admin.Permission) error {
+		_, err := f.authorize.Execute(AuthorizeQuery{Token: token, Permission: permission, Now: f.now})
+		return err
+	}
+
+	// Act
+	notEnrolled := authorize(current.Token, admin.PermissionViewMembers)
+	enrollOnly := authorize(current.Token, "")
+	secret, recoveryCodes := f.enrollTOTP(t, owner.AdminID, current.Token)
+	_, staffRegenerate := NewRegenerateRecoveryCodesUseCase(f.users, &MockTransactionManager{}).Execute(
+		RegenerateRecoveryCodesCommand{AdminID: staff.AdminID, Code: "123456", Now: f.now},
+	)
+
+	later := f.now.Add(time.Minute)
+	_, withoutCode := f.login.Execute(LoginCommand{Username: "owner", Password: testPassword, Now: later})
+	withCode, err := f.login.Execute(LoginCommand{
+		Username: "owner", Password: testPassword, OTPCode: secret.CodeAt(later), Now: later,
+	})
+	require.NoError(t, err)
+	_, replayed := f.login.Execute(LoginCommand{
+		Username: "owner", Password: testPassword, OTPCode: secret.CodeAt(later), Now: later,
+	})
+	withRecovery, err := f.login.Execute(LoginCommand{
+		Username: "owner", Password: testPassword, OTPCode: recoveryCodes[0], Now: later,
+	})
+	require.NoError(t, err)
+
+	// Assert
+	assert.ErrorIs(t, notEnrolled, admin.ErrTwoFactorEnrollmentRequired)
+	assert.NoError(t, enrollOnly)
+	assert.NoError(t, authorize(current.Token, admin.PermissionAdjustPoints))
+	assert.ErrorIs(t, authorize(otherDevice.Token, ""), admin.ErrTwoFactorRequired)
+	assert.NoError(t, authorize(staffLogin.Token, admin.PermissionViewMembers))
+	assert.ErrorIs(t, staffRegenerate, admin.ErrTwoFactorNotEnabled)
+
+	assert.ErrorIs(t, withoutCode, admin.ErrTwoFactorRequired)
+	assert.NoError(t, authorize(withCode.Token, admin.PermissionAdjustPoints))
+	assert.ErrorIs(t, replayed, admin.ErrInvalidOTP)
+	assert.True(t, withRecovery.Admin.TwoFactorEnabled)
+	assert.Equal(t, admin.RecoveryCodeCount-1, withRecovery.Admin.RemainingRecoveryCodes)
+}
+
+// Test 7: 無法產生比對用雜湊時建立失敗（不以空雜湊繼續運作）
+func TestNewLoginUseCase_HasherFailure(t *testing.T) {
+	// Act
+	login, err := NewLoginUseCase(
+		&MockAdminUserRepository{users: make(map[string]*admin.AdminUser)},
+		&MockSessionRepository{sessions: make(map[string]*admin.Session)},
+		FailingPasswordHasher{}, time.Hour, &MockTransactionManager{},
+	)
+
+	// Assert
+	assert.Nil(t, login)
+	assert.ErrorIs(t, err, errHashUnavailable)
+}
+
+// ===========================
+// Mocks
+// ===========================
+
+// MockTransactionManager 直接執行函數（ctx 為 nil）
+type MockTransactionManager struct{}
+
+func (m *MockTransactionManager) InTransaction(fn func(ctx shared.TransactionContext) error) error {
+	return fn(nil)
+}
+
+// MockAdminUserRepository 記憶體管理員倉儲（以帳號為鍵）
+type MockAdminUserRepository struct {
+	users map[string]*admin.AdminUser
+}
+
+func (m *MockAdminUserRepository) Save(ctx shared.TransactionContext, u *admin.AdminUser) error {
+	m.users[u.Username()] = u
+	u.MarkPersisted()
+	return nil
+}
+
+func (m *MockAdminUserRepository) Update(ctx shared.TransactionContext, u *admin.AdminUser) error {
+	if _, ok := m.users[u.Username()]; !ok {
+		return admin.ErrAdminUserNotFound
+	}
+	m.users[u.Username()] = u
+	u.MarkPersisted()
+	return nil
+}
+
+func (m *MockAdminUserRepository) FindByID(ctx shared.TransactionContext, adminID admin.AdminUserID) (*admin.AdminUser, error) {
+	for _, u := range m.users {
+		if u.AdminID() == adminID {
+			return u, nil
+		}
+	}
+	return nil, admin.ErrAdminUserNotFound
+}
+
+func (m *MockAdminUserRepository) FindByUsername(ctx shared.TransactionContext, username string) (*admin.AdminUser, error) {
+	if u, ok := m.users[username]; ok {
+		return u, nil
+	}
+	return nil, admin.ErrAdminUserNotFound
+}
+
+func (m *MockAdminUserRepository) ExistsByUsername(ctx shared.TransactionContext, username string) (bool, error) {
+	_, ok := m.users[username]
+	return ok, nil
+}
+
+func (m *MockAdminUserRepository) Count(ctx shared.TransactionContext) (int, error) {
+	return len(m.users), nil
+}
+
+// MockSessionRepository 記憶體工作階段倉儲（以 Token 雜湊為鍵）
+type MockSessionRepository struct {
+	sessions map[string]*admin.Session
+}
+
+func (m *MockSessionRepository) Save(ctx shared.TransactionContext, s *admin.Session) error {
+	m.sessions[s.TokenHash()] = s
+	return nil
+}
+
+func (m *MockSessionRepository) FindByTokenHash(ctx shared.TransactionContext, tokenHash string) (*admin.Session, error) {
+	if s, ok := m.sessions[tokenHash]; ok {
+		return s, nil
+	}
+	return nil, admin.ErrUnauthenticated
+}
+
+func (m *MockSessionRepository) FindActiveByAdminID(ctx shared.TransactionContext, adminID admin.AdminUserID) ([]*admin.Session, error) {
+	sessions := make([]*admin.Session, 0)
+	for _, s := range m.sessions {
+		if s.AdminID() == adminID && s.RevokedAt() == nil {
+			sessions = append(sessions, s)
+		}
+	}
+	return sessions, nil
+}
+
+// StubPasswordHasher 測試用雜湊（不做實際運算）
+type StubPasswordHasher struct{}
+
+func (StubPasswordHasher) Hash(password string) (string, error) {
+	return "hashed:" + password, nil
+}
+
+func (StubPasswordHasher) Verify(hash, password string) bool {
+	return hash == "hashed:"+password
+}
+
+// errHashUnavailable FailingPasswordHasher 返回的錯誤
+var errHashUnavailable = errors.New("hash unavailable")
+
+// FailingPasswordHasher 雜湊一律失敗
+type FailingPasswordHasher struct{}
+
+func (FailingPasswordHasher) Hash(password string) (string, error) {
+	return "", errHashUnavailable
+}
+
+func (FailingPasswordHasher) Verify(hash, password string) bool {
+	return false
+}
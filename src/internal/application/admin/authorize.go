@@ -0,0 +1,103 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+)
+
+// ===========================
+// Authorize Use Case
+// ===========================
+
+// AuthorizeQuery 授權查詢
+//
+// 欄位：
+// - Token: Bearer Token
+// - Permission: 欲執行操作所需的權限（空字串表示只驗證身分）
+type AuthorizeQuery struct {
+	Token      string
+	Permission admin.Permission
+	Now        time.Time
+}
+
+// AuthorizeUseCase 驗證 Token 並檢查權限（每個管理後台請求執行前呼叫）
+//
+// 業務規則：
+// - 工作階段需存在、未撤銷、未過期
+// - 管理員帳號需存在且未停用
+// - 已啟用兩步驟驗證時，工作階段需已通過驗證（啟用前發放的工作階段需重新登入）
+// - 角色要求兩步驟驗證但尚未啟用時，只能執行不需權限的操作（如設定兩步驟驗證）
+// - 角色需具備所需權限（見 admin.Role.Can）
+type AuthorizeUseCase struct {
+	userRepo    admin.AdminUserRepository
+	sessionRepo admin.SessionRepository
+}
+
+// NewAuthorizeUseCase 創建 Use Case 實例
+func NewAuthorizeUseCase(
+	userRepo admin.AdminUserRepository,
+	sessionRepo admin.SessionRepository,
+) *AuthorizeUseCase {
+	return &AuthorizeUseCase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+	}
+}
+
+// Execute 驗證身分與權限
+//
+// 錯誤：
+// - Token 空白 / 不存在、管理員不存在 → admin.ErrUnauthenticated
+// - 工作階段已撤銷 / 過期 → admin.ErrSessionRevoked / admin.ErrSessionExpired
+// - 帳號停用 → admin.ErrAdminDisabled
+// - 工作階段未通過兩步驟驗證 → admin.ErrTwoFactorRequired
+// - 尚未設定必要的兩步驟驗證 → admin.ErrTwoFactorEnrollmentRequired
+// - 權限不足 → admin.ErrPermissionDenied
+func (uc *AuthorizeUseCase) Execute(query AuthorizeQuery) (*PrincipalResult, error) {
+	if query.Token == "" {
+		return nil, admin.ErrUnauthenticated
+	}
+
+	session, err := uc.sessionRepo.FindByTokenHash(nil, admin.HashSessionToken(query.Token))
+	if err != nil {
+		return nil, err
+	}
+	if err := session.Validate(query.Now); err != nil {
+		return nil, err
+	}
+
+	u, err := uc.userRepo.FindByID(nil, session.AdminID())
+	if errors.Is(err, admin.ErrAdminUserNotFound) {
+		return nil, admin.ErrUnauthenticated
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find admin user: %w", err)
+	}
+	if u.IsDisabled() {
+		return nil, admin.ErrAdminDisabled.WithContext("username", u.Username())
+	}
+	if u.TwoFactorEnabled() && !session.TwoFactorVerified() {
+		return nil, admin.ErrTwoFactorRequired.WithContext("session_id", session.SessionID().String())
+	}
+	if query.Permission != "" && u.RequiresTwoFactorEnrollment() {
+		return nil, admin.ErrTwoFactorEnrollmentRequired.WithContext("role", u.Role().String())
+	}
+	if query.Permission != "" && !u.Can(query.Permission) {
+		return nil, admin.ErrPermissionDenied.WithContext(
+			"role", u.Role().String(),
+			"permission", query.Permission.String(),
+		)
+	}
+
+	return &PrincipalResult{
+		AdminID:     u.AdminID().String(),
+		Username:    u.Username(),
+		DisplayName: u.DisplayName(),
+		Role:        u.Role().String(),
+		SessionID:   session.SessionID().String(),
+		ExpiresAt:   session.ExpiresAt(),
+	}, nil
+}
@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// BootstrapOwner Use Case
+// ===========================
+
+// BootstrapOwnerCommand 建立首位 owner 指令（啟動時執行）
+type BootstrapOwnerCommand struct {
+	Username string
+	Password string
+	Now      time.Time
+}
+
+// BootstrapOwnerUseCase 尚無任何管理員帳號時建立首位 owner
+//
+// 業務規則：已有任何帳號時不做任何事（之後的帳號由 owner 透過後台建立）
+type BootstrapOwnerUseCase struct {
+	userRepo  admin.AdminUserRepository
+	hasher    admin.PasswordHasher
+	txManager shared.TransactionManager
+}
+
+// NewBootstrapOwnerUseCase 創建 Use Case 實例
+func NewBootstrapOwnerUseCase(
+	userRepo admin.AdminUserRepository,
+	hasher admin.PasswordHasher,
+	txManager shared.TransactionManager,
+) *BootstrapOwnerUseCase {
+	return &BootstrapOwnerUseCase{
+		userRepo:  userRepo,
+		hasher:    hasher,
+		txManager: txManager,
+	}
+}
+
+// Execute 建立首位 owner
+//
+// 返回：是否建立了帳號
+func (uc *BootstrapOwnerUseCase) Execute(cmd BootstrapOwnerCommand) (bool, error) {
+	created := false
+	err := uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		count, err := uc.userRepo.Count(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count admin users: %w", err)
+		}
+		if count > 0 {
+			return nil
+		}
+
+		u, err := admin.NewAdminUser(cmd.Username, "", admin.RoleOwner, cmd.Password, uc.hasher, cmd.Now)
+		if err != nil {
+			return err
+		}
+		if err := saveNewAdminUser(ctx, uc.userRepo, u); err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return created, nil
+}
@@ -0,0 +1,168 @@
+package admin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// CreateAdminUser Use Case
+// ===========================
+
+// CreateAdminUserCommand 建立管理員帳號指令
+//
+// 欄位：
+// - Role: owner / manager / staff / auditor
+// - Password: 初始密碼（12-72 bytes）
+type CreateAdminUserCommand struct {
+	Username    string
+	DisplayName string
+	Role        string
+	Password    string
+	Now         time.Time
+}
+
+// CreateAdminUserUseCase 建立管理員帳號（需 admins:write 權限，即 owner）
+type CreateAdminUserUseCase struct {
+	userRepo  admin.AdminUserRepository
+	hasher    admin.PasswordHasher
+	txManager shared.TransactionManager
+}
+
+// NewCreateAdminUserUseCase 創建 Use Case 實例
+func NewCreateAdminUserUseCase(
+	userRepo admin.AdminUserRepository,
+	hasher admin.PasswordHasher,
+	txManager shared.TransactionManager,
+) *CreateAdminUserUseCase {
+	return &CreateAdminUserUseCase{
+		userRepo:  userRepo,
+		hasher:    hasher,
+		txManager: txManager,
+	}
+}
+
+// Execute 建立管理員帳號
+//
+// 錯誤：
+// - 角色無效 → admin.ErrInvalidRole
+// - 帳號格式不符 / 密碼不符規則 → admin.ErrInvalidUsername / admin.ErrWeakPassword
+// - 帳號已被使用 → admin.ErrUsernameTaken
+func (uc *CreateAdminUserUseCase) Execute(cmd CreateAdminUserCommand) (*AdminUserResult, error) {
+	role, err := admin.ParseRole(cmd.Role)
+	if err != nil {
+		return nil, err
+	}
+	u, err := admin.NewAdminUser(cmd.Username, cmd.DisplayName, role, cmd.Password, uc.hasher, cmd.Now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		return saveNewAdminUser(ctx, uc.userRepo, u)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toAdminUserResult(u), nil
+}
+
+// saveNewAdminUser 檢查帳號唯一後保存
+func saveNewAdminUser(ctx shared.TransactionContext, userRepo admin.AdminUserRepository, u *admin.AdminUser) error {
+	exists, err := userRepo.ExistsByUsername(ctx, u.Username())
+	if err != nil {
+		return fmt.Errorf("failed to check username: %w", err)
+	}
+	if exists {
+		return admin.ErrUsernameTaken.WithContext("username", u.Username())
+	}
+	if err := userRepo.Save(ctx, u); err != nil {
+		return fmt.Errorf("failed to save admin user: %w", err)
+	}
+	return nil
+}
+
+// ===========================
+// DisableAdminUser Use Case
+// ===========================
+
+// DisableAdminUserCommand 停用管理員帳號指令
+//
+// 欄位：
+// - OperatorID: 執行停用的管理員（不可停用自己，避免最後一位 owner 被鎖在門外）
+type DisableAdminUserCommand struct {
+	AdminID    string
+	OperatorID string
+	Now        time.Time
+}
+
+// DisableAdminUserUseCase 停用管理員帳號並撤銷其所有工作階段（需 admins:write 權限）
+type DisableAdminUserUseCase struct {
+	userRepo    admin.AdminUserRepository
+	sessionRepo admin.SessionRepository
+	txManager   shared.TransactionManager
+}
+
+// NewDisableAdminUserUseCase 創建 Use Case 實例
+func NewDisableAdminUserUseCase(
+	userRepo admin.AdminUserRepository,
+	sessionRepo admin.SessionRepository,
+	txManager shared.TransactionManager,
+) *DisableAdminUserUseCase {
+	return &DisableAdminUserUseCase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		txManager:   txManager,
+	}
+}
+
+// Execute 停用管理員帳號
+//
+// 錯誤：
+// - 管理員不存在 → admin.ErrAdminUserNotFound
+// - 停用自己 → admin.ErrPermissionDenied
+func (uc *DisableAdminUserUseCase) Execute(cmd DisableAdminUserCommand) (*AdminUserResult, error) {
+	adminID, err := admin.AdminUserIDFromString(cmd.AdminID)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.AdminID == cmd.OperatorID {
+		return nil, admin.ErrPermissionDenied.WithContext(
+			"admin_id", cmd.AdminID,
+			"reason", "cannot disable yourself",
+		)
+	}
+
+	var result *AdminUserResult
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		u, err := uc.userRepo.FindByID(ctx, adminID)
+		if err != nil {
+			return err
+		}
+		u.Disable(cmd.Now)
+		if err := uc.userRepo.Update(ctx, u); err != nil {
+			return fmt.Errorf("failed to update admin user: %w", err)
+		}
+
+		sessions, err := uc.sessionRepo.FindActiveByAdminID(ctx, adminID)
+		if err != nil {
+			return fmt.Errorf("failed to find sessions: %w", err)
+		}
+		for _, s := range sessions {
+			s.Revoke(cmd.Now)
+			if err := uc.sessionRepo.Save(ctx, s); err != nil {
+				return fmt.Errorf("failed to save session: %w", err)
+			}
+		}
+
+		result = toAdminUserResult(u)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
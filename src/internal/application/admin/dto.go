@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+)
+
+// ===========================
+// DTO（Use Case 輸出）
+// ===========================
+
+// AdminUserResult 管理員帳號（不含密碼雜湊）
+type AdminUserResult struct {
+	AdminID     string
+	Username    string
+	DisplayName string
+	Role        string
+	Permissions []string
+	LastLoginAt *time.Time
+	Disabled    bool
+
+	TwoFactorEnabled       bool
+	RemainingRecoveryCodes int
+}
+
+// PrincipalResult 已驗證的管理員身分（每個請求由 AuthorizeUseCase 產生）
+//
+// 欄位：
+// - SessionID: 目前使用的工作階段（登出時撤銷）
+type PrincipalResult struct {
+	AdminID     string
+	Username    string
+	DisplayName string
+	Role        string
+	SessionID   string
+	ExpiresAt   time.Time
+}
+
+// toAdminUserResult 將管理員帳號轉換為輸出 DTO
+func toAdminUserResult(u *admin.AdminUser) *AdminUserResult {
+	permissions := make([]string, 0)
+	if !u.IsDisabled() {
+		for _, p := range u.Role().Permissions() {
+			permissions = append(permissions, p.String())
+		}
+	}
+	return &AdminUserResult{
+		AdminID:     u.AdminID().String(),
+		Username:    u.Username(),
+		DisplayName: u.DisplayName(),
+		Role:        u.Role().String(),
+		Permissions: permissions,
+		LastLoginAt: u.LastLoginAt(),
+		Disabled:    u.IsDisabled(),
+
+		TwoFactorEnabled:       u.TwoFactorEnabled(),
+		RemainingRecoveryCodes: u.RemainingRecoveryCodes(),
+	}
+}
@@ -0,0 +1,144 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// Login Use Case
+// ===========================
+
+// timingPassword 帳號不存在時用於雜湊比對的密碼（讓回應時間與帳號存在時一致）
+const timingPassword = "bar-crm-timing-equalizer"
+
+// LoginCommand 登入指令
+//
+// 欄位：
+// - OTPCode: TOTP 驗證碼或復原碼（已啟用兩步驟驗證時必填）
+type LoginCommand struct {
+	Username string
+	Password string
+	OTPCode  string
+	Now      time.Time
+}
+
+// LoginResult 登入結果
+//
+// 欄位：
+// - Token: Bearer Token（僅回傳這一次，伺服器只保存雜湊）
+// - ExpiresAt: Token 到期時間
+type LoginResult struct {
+	Token     string
+	ExpiresAt time.Time
+	Admin     *AdminUserResult
+}
+
+// LoginUseCase 管理員帳號密碼登入
+//
+// 業務規則：
+// - 帳號不存在與密碼錯誤返回相同錯誤（ErrInvalidCredentials）
+// - 連續失敗 5 次鎖定 15 分鐘（失敗次數在返回錯誤前先提交）
+// - 已啟用兩步驟驗證時需同時提供驗證碼（或一次性復原碼）
+// - 登入成功發放新的工作階段（既有工作階段不受影響，可多裝置登入）
+type LoginUseCase struct {
+	userRepo    admin.AdminUserRepository
+	sessionRepo admin.SessionRepository
+	hasher      admin.PasswordHasher
+	sessionTTL  time.Duration
+	txManager   shared.TransactionManager
+	timingHash  string
+}
+
+// NewLoginUseCase 創建 Use Case 實例
+//
+// 參數：
+//   sessionTTL - 工作階段有效期（<= 0 時使用 admin.DefaultSessionTTL）
+//
+// 錯誤：無法產生帳號不存在時比對用的雜湊（否則不存在的帳號會明顯較快返回）
+func NewLoginUseCase(
+	userRepo admin.AdminUserRepository,
+	sessionRepo admin.SessionRepository,
+	hasher admin.PasswordHasher,
+	sessionTTL time.Duration,
+	txManager shared.TransactionManager,
+) (*LoginUseCase, error) {
+	timingHash, err := hasher.Hash(timingPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash timing password: %w", err)
+	}
+	return &LoginUseCase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		hasher:      hasher,
+		sessionTTL:  sessionTTL,
+		txManager:   txManager,
+		timingHash:  timingHash,
+	}, nil
+}
+
+// Execute 登入
+//
+// 錯誤：
+// - 帳號不存在 / 密碼錯誤 → admin.ErrInvalidCredentials
+// - 鎖定中 → admin.ErrAccountLocked
+// - 帳號停用 → admin.ErrAdminDisabled
+// - 需要驗證碼 → admin.ErrTwoFactorRequired
+// - 驗證碼錯誤 / 已使用 → admin.ErrInvalidOTP
+// - 同一帳號的登入請求同時進行 → admin.ErrAdminVersionConflict（失敗次數不會被覆寫）
+func (uc *LoginUseCase) Execute(cmd LoginCommand) (*LoginResult, error) {
+	username, err := admin.NormalizeUsername(cmd.Username)
+	if err != nil {
+		uc.hasher.Verify(uc.timingHash, cmd.Password)
+		return nil, admin.ErrInvalidCredentials
+	}
+
+	var authErr error
+	var result *LoginResult
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		u, err := uc.userRepo.FindByUsername(ctx, username)
+		if errors.Is(err, admin.ErrAdminUserNotFound) {
+			uc.hasher.Verify(uc.timingHash, cmd.Password)
+			authErr = admin.ErrInvalidCredentials
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find admin user: %w", err)
+		}
+
+		// 失敗次數 / 鎖定狀態也需保存，因此驗證失敗不回滾事務
+		authErr = u.Authenticate(cmd.Password, cmd.OTPCode, uc.hasher, cmd.Now)
+		if err := uc.userRepo.Update(ctx, u); err != nil {
+			return fmt.Errorf("failed to update admin user: %w", err)
+		}
+		if authErr != nil {
+			return nil
+		}
+
+		session, token, err := admin.IssueSession(u.AdminID(), u.TwoFactorEnabled(), uc.sessionTTL, cmd.Now)
+		if err != nil {
+			return err
+		}
+		if err := uc.sessionRepo.Save(ctx, session); err != nil {
+			return fmt.Errorf("failed to save session: %w", err)
+		}
+
+		result = &LoginResult{
+			Token:     token,
+			ExpiresAt: session.ExpiresAt(),
+			Admin:     toAdminUserResult(u),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if authErr != nil {
+		return nil, authErr
+	}
+	return result, nil
+}
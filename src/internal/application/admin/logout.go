@@ -0,0 +1,57 @@
+package admin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// Logout Use Case
+// ===========================
+
+// LogoutCommand 登出指令
+type LogoutCommand struct {
+	Token string
+	Now   time.Time
+}
+
+// LogoutUseCase 登出（撤銷目前的工作階段）
+//
+// 業務規則：重複登出視為成功（冪等）
+type LogoutUseCase struct {
+	sessionRepo admin.SessionRepository
+	txManager   shared.TransactionManager
+}
+
+// NewLogoutUseCase 創建 Use Case 實例
+func NewLogoutUseCase(
+	sessionRepo admin.SessionRepository,
+	txManager shared.TransactionManager,
+) *LogoutUseCase {
+	return &LogoutUseCase{
+		sessionRepo: sessionRepo,
+		txManager:   txManager,
+	}
+}
+
+// Execute 登出
+//
+// 錯誤：Token 不存在 → admin.ErrUnauthenticated
+func (uc *LogoutUseCase) Execute(cmd LogoutCommand) error {
+	return uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		session, err := uc.sessionRepo.FindByTokenHash(ctx, admin.HashSessionToken(cmd.Token))
+		if err != nil {
+			return err
+		}
+		if !session.Revoke(cmd.Now) {
+			return nil
+		}
+		if err := uc.sessionRepo.Save(ctx, session); err != nil {
+			return fmt.Errorf("failed to save session: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,245 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// TOTPIssuer Authenticator App 中顯示的服務名稱
+const TOTPIssuer = "Bar CRM"
+
+// ===========================
+// BeginTOTPEnrollment Use Case
+// ===========================
+
+// BeginTOTPEnrollmentCommand 開始設定兩步驟驗證指令
+type BeginTOTPEnrollmentCommand struct {
+	AdminID string
+	Now     time.Time
+}
+
+// BeginTOTPEnrollmentResult 兩步驟驗證設定資訊
+//
+// 欄位：
+// - Secret: Base32 金鑰（無法掃描 QR Code 時手動輸入）
+// - ProvisioningURI: otpauth:// URI（前端轉成 QR Code 供 Authenticator App 掃描）
+type BeginTOTPEnrollmentResult struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// BeginTOTPEnrollmentUseCase 產生 TOTP 金鑰（待 ConfirmTOTPEnrollmentUseCase 確認後才生效）
+type BeginTOTPEnrollmentUseCase struct {
+	userRepo  admin.AdminUserRepository
+	txManager shared.TransactionManager
+}
+
+// NewBeginTOTPEnrollmentUseCase 創建 Use Case 實例
+func NewBeginTOTPEnrollmentUseCase(
+	userRepo admin.AdminUserRepository,
+	txManager shared.TransactionManager,
+) *BeginTOTPEnrollmentUseCase {
+	return &BeginTOTPEnrollmentUseCase{
+		userRepo:  userRepo,
+		txManager: txManager,
+	}
+}
+
+// Execute 開始設定兩步驟驗證
+//
+// 錯誤：
+// - 管理員不存在 → admin.ErrAdminUserNotFound
+// - 已啟用 → admin.ErrTwoFactorAlreadyEnabled
+func (uc *BeginTOTPEnrollmentUseCase) Execute(cmd BeginTOTPEnrollmentCommand) (*BeginTOTPEnrollmentResult, error) {
+	adminID, err := admin.AdminUserIDFromString(cmd.AdminID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *BeginTOTPEnrollmentResult
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		u, err := uc.userRepo.FindByID(ctx, adminID)
+		if err != nil {
+			return err
+		}
+		secret, err := u.BeginTOTPEnrollment(cmd.Now)
+		if err != nil {
+			return err
+		}
+		if err := uc.userRepo.Update(ctx, u); err != nil {
+			return fmt.Errorf("failed to update admin user: %w", err)
+		}
+
+		result = &BeginTOTPEnrollmentResult{
+			Secret:          secret.Base32(),
+			ProvisioningURI: secret.ProvisioningURI(TOTPIssuer, u.Username()),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ===========================
+// ConfirmTOTPEnrollment Use Case
+// ===========================
+
+// ConfirmTOTPEnrollmentCommand 確認兩步驟驗證設定指令
+//
+// 欄位：
+// - Token: 目前工作階段的 Bearer Token（確認後此工作階段視為已通過驗證）
+// - Code: Authenticator App 顯示的 6 位數驗證碼
+type ConfirmTOTPEnrollmentCommand struct {
+	AdminID string
+	Token   string
+	Code    string
+	Now     time.Time
+}
+
+// RecoveryCodesResult 一次性復原碼（明文僅回傳這一次）
+type RecoveryCodesResult struct {
+	RecoveryCodes []string
+}
+
+// ConfirmTOTPEnrollmentUseCase 以驗證碼確認並啟用兩步驟驗證
+//
+// 業務規則：
+// - 啟用時產生 10 組一次性復原碼（遺失手機時可代替驗證碼登入）
+// - 目前的工作階段標記為已通過驗證；其他裝置的工作階段需重新登入
+type ConfirmTOTPEnrollmentUseCase struct {
+	userRepo    admin.AdminUserRepository
+	sessionRepo admin.SessionRepository
+	txManager   shared.TransactionManager
+}
+
+// NewConfirmTOTPEnrollmentUseCase 創建 Use Case 實例
+func NewConfirmTOTPEnrollmentUseCase(
+	userRepo admin.AdminUserRepository,
+	sessionRepo admin.SessionRepository,
+	txManager shared.TransactionManager,
+) *ConfirmTOTPEnrollmentUseCase {
+	return &ConfirmTOTPEnrollmentUseCase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		txManager:   txManager,
+	}
+}
+
+// Execute 確認設定
+//
+// 錯誤：
+// - 管理員不存在 → admin.ErrAdminUserNotFound
+// - 已啟用 → admin.ErrTwoFactorAlreadyEnabled
+// - 尚未開始設定 → admin.ErrTwoFactorNotPending
+// - 驗證碼錯誤 → admin.ErrInvalidOTP
+func (uc *ConfirmTOTPEnrollmentUseCase) Execute(cmd ConfirmTOTPEnrollmentCommand) (*RecoveryCodesResult, error) {
+	adminID, err := admin.AdminUserIDFromString(cmd.AdminID)
+	if err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		u, err := uc.userRepo.FindByID(ctx, adminID)
+		if err != nil {
+			return err
+		}
+		codes, err = u.ConfirmTOTPEnrollment(cmd.Code, cmd.Now)
+		if err != nil {
+			return err
+		}
+		if err := uc.userRepo.Update(ctx, u); err != nil {
+			return fmt.Errorf("failed to update admin user: %w", err)
+		}
+
+		session, err := uc.sessionRepo.FindByTokenHash(ctx, admin.HashSessionToken(cmd.Token))
+		if errors.Is(err, admin.ErrUnauthenticated) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find session: %w", err)
+		}
+		if session.AdminID() != u.AdminID() {
+			return nil
+		}
+		session.MarkTwoFactorVerified()
+		if err := uc.sessionRepo.Save(ctx, session); err != nil {
+			return fmt.Errorf("failed to save session: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &RecoveryCodesResult{RecoveryCodes: codes}, nil
+}
+
+// ===========================
+// RegenerateRecoveryCodes Use Case
+// ===========================
+
+// RegenerateRecoveryCodesCommand 重新產生復原碼指令
+//
+// 欄位：
+// - Code: 目前的 TOTP 驗證碼（不接受復原碼）
+type RegenerateRecoveryCodesCommand struct {
+	AdminID string
+	Code    string
+	Now     time.Time
+}
+
+// RegenerateRecoveryCodesUseCase 重新產生復原碼（舊復原碼全部失效）
+type RegenerateRecoveryCodesUseCase struct {
+	userRepo  admin.AdminUserRepository
+	txManager shared.TransactionManager
+}
+
+// NewRegenerateRecoveryCodesUseCase 創建 Use Case 實例
+func NewRegenerateRecoveryCodesUseCase(
+	userRepo admin.AdminUserRepository,
+	txManager shared.TransactionManager,
+) *RegenerateRecoveryCodesUseCase {
+	return &RegenerateRecoveryCodesUseCase{
+		userRepo:  userRepo,
+		txManager: txManager,
+	}
+}
+
+// Execute 重新產生復原碼
+//
+// 錯誤：
+// - 管理員不存在 → admin.ErrAdminUserNotFound
+// - 未啟用兩步驟驗證 → admin.ErrTwoFactorNotEnabled
+// - 驗證碼錯誤 / 已使用 → admin.ErrInvalidOTP
+func (uc *RegenerateRecoveryCodesUseCase) Execute(cmd RegenerateRecoveryCodesCommand) (*RecoveryCodesResult, error) {
+	adminID, err := admin.AdminUserIDFromString(cmd.AdminID)
+	if err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		u, err := uc.userRepo.FindByID(ctx, adminID)
+		if err != nil {
+			return err
+		}
+		codes, err = u.RegenerateRecoveryCodes(cmd.Code, cmd.Now)
+		if err != nil {
+			return err
+		}
+		if err := uc.userRepo.Update(ctx, u); err != nil {
+			return fmt.Errorf("failed to update admin user: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &RecoveryCodesResult{RecoveryCodes: codes}, nil
+}
@@ -0,0 +1,68 @@
+package broadcast
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// CancelCampaign Use Case
+// ===========================
+
+// CancelCampaignCommand 取消群發活動指令
+type CancelCampaignCommand struct {
+	CampaignID string
+	Now        time.Time
+}
+
+// CancelCampaignUseCase 取消群發活動
+//
+// 業務規則：
+// - scheduled / sending 可取消；已送出的批次無法收回，尚未送出的收件者維持 pending
+// - 發送中的排程在下一批開始前重新讀取活動狀態，取消後即停止
+type CancelCampaignUseCase struct {
+	campaignRepo broadcast.CampaignRepository
+	txManager    shared.TransactionManager
+}
+
+// NewCancelCampaignUseCase 創建 Use Case 實例
+func NewCancelCampaignUseCase(
+	campaignRepo broadcast.CampaignRepository,
+	txManager shared.TransactionManager,
+) *CancelCampaignUseCase {
+	return &CancelCampaignUseCase{
+		campaignRepo: campaignRepo,
+		txManager:    txManager,
+	}
+}
+
+// Execute 取消群發活動
+func (uc *CancelCampaignUseCase) Execute(cmd CancelCampaignCommand) (*CampaignResult, error) {
+	campaignID, err := broadcast.CampaignIDFromString(cmd.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	var c *broadcast.Campaign
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		c, err = uc.campaignRepo.FindByID(ctx, campaignID)
+		if err != nil {
+			return fmt.Errorf("failed to find campaign: %w", err)
+		}
+		if err := c.CancelAt(cmd.Now); err != nil {
+			return err
+		}
+		if err := uc.campaignRepo.Save(ctx, c); err != nil {
+			return fmt.Errorf("failed to save campaign: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCampaignResult(c), nil
+}
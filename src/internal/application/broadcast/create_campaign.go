@@ -0,0 +1,86 @@
+package broadcast
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// CreateCampaign Use Case
+// ===========================
+
+// CreateCampaignCommand 建立群發活動指令
+//
+// 欄位：
+// - MinAvailablePoints / InactiveDays: 目標分眾（例如 100 / 30 →「積分 ≥ 100 且 30 天未消費」）
+// - VerifiedAdultsOnly: 僅發送給已驗證成年的會員（酒類促銷必須設定）
+// - ScheduledAt: 預定發送時間（零值表示立即發送）
+// - CreatedBy: 建立者（管理員帳號）
+type CreateCampaignCommand struct {
+	Name               string
+	MinAvailablePoints int
+	InactiveDays       int
+	VerifiedAdultsOnly bool
+	Message            string
+	ScheduledAt        time.Time
+	CreatedBy          string
+	Now                time.Time
+}
+
+// CreateCampaignUseCase 建立群發活動
+//
+// 業務規則：
+// - 建立後為 scheduled，由 DispatchBroadcastsUseCase 於預定時間開始發送
+// - 收件者名單於開始發送時才依分眾條件建立（反映發送當下的積分與消費紀錄）
+type CreateCampaignUseCase struct {
+	campaignRepo broadcast.CampaignRepository
+	txManager    shared.TransactionManager
+}
+
+// NewCreateCampaignUseCase 創建 Use Case 實例
+func NewCreateCampaignUseCase(
+	campaignRepo broadcast.CampaignRepository,
+	txManager shared.TransactionManager,
+) *CreateCampaignUseCase {
+	return &CreateCampaignUseCase{
+		campaignRepo: campaignRepo,
+		txManager:    txManager,
+	}
+}
+
+// Execute 建立群發活動
+func (uc *CreateCampaignUseCase) Execute(cmd CreateCampaignCommand) (*CampaignResult, error) {
+	segment, err := broadcast.NewSegment(cmd.MinAvailablePoints, cmd.InactiveDays)
+	if err != nil {
+		return nil, err
+	}
+	segment = segment.WithVerifiedAdultsOnly(cmd.VerifiedAdultsOnly)
+	message, err := broadcast.NewMessageTemplate(cmd.Message)
+	if err != nil {
+		return nil, err
+	}
+	scheduledAt := cmd.ScheduledAt
+	if scheduledAt.IsZero() {
+		scheduledAt = cmd.Now
+	}
+
+	c, err := broadcast.NewCampaign(cmd.Name, segment, message, scheduledAt, cmd.CreatedBy, cmd.Now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create campaign: %w", err)
+	}
+
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		if err := uc.campaignRepo.Save(ctx, c); err != nil {
+			return fmt.Errorf("failed to save campaign: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCampaignResult(c), nil
+}
@@ -0,0 +1,373 @@
+package broadcast
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// DispatchBroadcasts Use Case
+// ===========================
+
+// 節流預設值
+const (
+	defaultBatchSize     = broadcast.MaxBatchSize
+	defaultBatchInterval = time.Second // 兩批之間的間隔（避免觸發 LINE API 速率限制）
+	defaultMaxBatches    = 20          // 每次排程最多送出的批次數（其餘於下次排程繼續）
+)
+
+// MulticastSender LINE 群發介面
+//
+// 實作：presentation/linebot.Client（Multicast API）
+//
+// retryKey 作為 X-Line-Retry-Key：相同 retryKey 的請求 LINE 只會發送一次
+type MulticastSender interface {
+	MulticastText(retryKey string, lineUserIDs []string, text string) error
+}
+
+// ThrottleConfig 發送節流設定
+//
+// 欄位：
+// - BatchSize: 每批收件者數（1 ~ 500，0 使用預設值 500）
+// - BatchInterval: 兩批之間的間隔（0 使用預設值 1 秒）
+type ThrottleConfig struct {
+	BatchSize     int
+	BatchInterval time.Duration
+}
+
+// DispatchBroadcastsCommand 群發排程指令
+//
+// 欄位：
+// - Now: 排程執行時間
+// - MaxBatches: 本次最多送出的批次數（0 使用預設值 20）
+type DispatchBroadcastsCommand struct {
+	Now        time.Time
+	MaxBatches int
+}
+
+// DispatchBroadcastsResult 群發排程結果
+//
+// 欄位：
+// - Started / Completed: 本次開始 / 完成的活動數
+// - Batches: 本次送出的批次數（含失敗）
+// - Sent / Failed / Retrying / Excluded: 本次處理的收件者數
+type DispatchBroadcastsResult struct {
+	Started   int
+	Completed int
+	Batches   int
+	Sent      int
+	Failed    int
+	Retrying  int
+	Excluded  int
+}
+
+// DispatchBroadcastsUseCase 發送群發活動（排程執行，例如每分鐘）
+//
+// 業務規則：
+// - 到達預定時間的活動：依分眾條件建立收件者名單快照，排除名單中的會員標記為 excluded
+// - 每批送出前重新檢查排除名單（發送期間取消訂閱 / 封鎖的會員不會再收到）
+// - 批次推播失敗：該批收件者記錄失敗並於下次排程以同一批次重試，本次停止該活動；
+//   達到重試上限時標記 failed 並繼續下一批
+// - 活動被取消後不再送出新的批次
+// - 所有收件者皆已處理時完成活動並記錄投遞統計
+//
+// 續傳設計：
+// - 每批先提交批次分配（batchKey），推播後再提交結果
+// - 行程在兩者之間中斷時，下次排程優先重送未完成的批次；
+//   batchKey 作為 Retry-Key，已送達的批次不會重複發送
+type DispatchBroadcastsUseCase struct {
+	campaignRepo  broadcast.CampaignRepository
+	recipientRepo broadcast.RecipientRepository
+	exclusionRepo broadcast.ExclusionRepository
+	audienceQuery broadcast.AudienceQuery
+	sender        MulticastSender
+	txManager     shared.TransactionManager
+	throttle      ThrottleConfig
+	sleep         func(time.Duration)
+}
+
+// NewDispatchBroadcastsUseCase 創建 Use Case 實例
+func NewDispatchBroadcastsUseCase(
+	campaignRepo broadcast.CampaignRepository,
+	recipientRepo broadcast.RecipientRepository,
+	exclusionRepo broadcast.ExclusionRepository,
+	audienceQuery broadcast.AudienceQuery,
+	sender MulticastSender,
+	txManager shared.TransactionManager,
+	throttle ThrottleConfig,
+) *DispatchBroadcastsUseCase {
+	if throttle.BatchSize <= 0 || throttle.BatchSize > broadcast.MaxBatchSize {
+		throttle.BatchSize = defaultBatchSize
+	}
+	if throttle.BatchInterval <= 0 {
+		throttle.BatchInterval = defaultBatchInterval
+	}
+
+	return &DispatchBroadcastsUseCase{
+		campaignRepo:  campaignRepo,
+		recipientRepo: recipientRepo,
+		exclusionRepo: exclusionRepo,
+		audienceQuery: audienceQuery,
+		sender:        sender,
+		txManager:     txManager,
+		throttle:      throttle,
+		sleep:         time.Sleep,
+	}
+}
+
+// Execute 開始到期的活動並送出待發送的批次
+func (uc *DispatchBroadcastsUseCase) Execute(cmd DispatchBroadcastsCommand) (*DispatchBroadcastsResult, error) {
+	maxBatches := cmd.MaxBatches
+	if maxBatches <= 0 {
+		maxBatches = defaultMaxBatches
+	}
+
+	campaigns, err := uc.campaignRepo.FindSendable(nil, cmd.Now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sendable campaigns: %w", err)
+	}
+
+	result := &DispatchBroadcastsResult{}
+	for _, c := range campaigns {
+		if c.IsDueAt(cmd.Now) {
+			if err := uc.start(c, cmd.Now, result); err != nil {
+				return result, err
+			}
+		}
+		if err := uc.sendBatches(c.CampaignID(), cmd.Now, maxBatches, result); err != nil {
+			return result, err
+		}
+		if result.Batches >= maxBatches {
+			break
+		}
+	}
+	return result, nil
+}
+
+// start 建立收件者名單快照並開始發送（單一事務）
+func (uc *DispatchBroadcastsUseCase) start(c *broadcast.Campaign, now time.Time, result *DispatchBroadcastsResult) error {
+	return uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		audience, err := uc.audienceQuery.FindAudience(ctx, c.Segment(), now)
+		if err != nil {
+			return fmt.Errorf("failed to find audience: %w", err)
+		}
+
+		recipients := make([]*broadcast.Recipient, 0, len(audience))
+		for _, a := range audience {
+			recipients = append(recipients, broadcast.NewRecipient(c.CampaignID(), a.MemberID, a.LineUserID, now))
+		}
+		excluded, err := uc.applyExclusions(ctx, recipients, now)
+		if err != nil {
+			return err
+		}
+		result.Excluded += excluded
+
+		if err := c.StartAt(now); err != nil {
+			return err
+		}
+		if err := uc.recipientRepo.SaveAll(ctx, recipients); err != nil {
+			return fmt.Errorf("failed to save recipients: %w", err)
+		}
+		if err := uc.campaignRepo.Save(ctx, c); err != nil {
+			return fmt.Errorf("failed to save campaign: %w", err)
+		}
+		result.Started++
+		return nil
+	})
+}
+
+// sendBatches 依序送出活動的批次，直到完成、失敗、被取消或達到本次批次上限
+func (uc *DispatchBroadcastsUseCase) sendBatches(
+	campaignID broadcast.CampaignID,
+	now time.Time,
+	maxBatches int,
+	result *DispatchBroadcastsResult,
+) error {
+	for result.Batches < maxBatches {
+		// 每批前重新讀取活動（發送期間可能被取消）
+		c, err := uc.campaignRepo.FindByID(nil, campaignID)
+		if err != nil {
+			return fmt.Errorf("failed to find campaign: %w", err)
+		}
+		if c.Status() != broadcast.CampaignStatusSending {
+			return nil
+		}
+
+		batch, err := uc.nextBatch(campaignID, now, result)
+		if err != nil {
+			return err
+		}
+		if batch == nil {
+			return uc.complete(c, now, result)
+		}
+		if len(batch) == 0 {
+			continue // 本批收件者皆已排除
+		}
+
+		if result.Batches > 0 {
+			uc.sleep(uc.throttle.BatchInterval)
+		}
+		if proceed, err := uc.deliver(c, batch, now, result); err != nil || !proceed {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextBatch 取得下一批收件者
+//
+// 返回：
+// - 未完成的批次（續傳 / 重試）優先
+// - 否則分配新的批次（分配前檢查排除名單並提交）
+// - nil 表示沒有待發送的收件者；空切片表示本批收件者皆已排除
+func (uc *DispatchBroadcastsUseCase) nextBatch(
+	campaignID broadcast.CampaignID,
+	now time.Time,
+	result *DispatchBroadcastsResult,
+) ([]*broadcast.Recipient, error) {
+	inFlight, err := uc.recipientRepo.FindInFlightBatch(nil, campaignID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find in-flight batch: %w", err)
+	}
+	if len(inFlight) > 0 {
+		return inFlight, nil
+	}
+
+	unassigned, err := uc.recipientRepo.FindUnassigned(nil, campaignID, uc.throttle.BatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find unassigned recipients: %w", err)
+	}
+	if len(unassigned) == 0 {
+		return nil, nil
+	}
+
+	batch := make([]*broadcast.Recipient, 0, len(unassigned))
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		excluded, err := uc.applyExclusions(ctx, unassigned, now)
+		if err != nil {
+			return err
+		}
+		result.Excluded += excluded
+
+		batchKey := broadcast.NewBatchKey()
+		for _, r := range unassigned {
+			if r.Status() != broadcast.RecipientStatusPending {
+				continue
+			}
+			if err := r.AssignBatchAt(batchKey, now); err != nil {
+				return err
+			}
+			batch = append(batch, r)
+		}
+		if err := uc.recipientRepo.SaveAll(ctx, unassigned); err != nil {
+			return fmt.Errorf("failed to save recipients: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+// deliver 推播單一批次並提交結果
+//
+// 返回：是否繼續下一批（推播失敗且未達重試上限時停止該活動，下次排程以同一批次重試）
+func (uc *DispatchBroadcastsUseCase) deliver(
+	c *broadcast.Campaign,
+	batch []*broadcast.Recipient,
+	now time.Time,
+	result *DispatchBroadcastsResult,
+) (bool, error) {
+	lineUserIDs := make([]string, 0, len(batch))
+	for _, r := range batch {
+		lineUserIDs = append(lineUserIDs, r.LineUserID())
+	}
+
+	result.Batches++
+	sendErr := uc.sender.MulticastText(batch[0].BatchKey(), lineUserIDs, c.Message().Text())
+	retrying := false
+	for _, r := range batch {
+		if sendErr == nil {
+			if err := r.MarkSentAt(now); err != nil {
+				return false, err
+			}
+			result.Sent++
+			continue
+		}
+		if err := r.RecordFailureAt(sendErr.Error(), now); err != nil {
+			return false, err
+		}
+		if r.Status() == broadcast.RecipientStatusFailed {
+			result.Failed++
+		} else {
+			result.Retrying++
+			retrying = true
+		}
+	}
+
+	err := uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		return uc.recipientRepo.SaveAll(ctx, batch)
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to save recipients: %w", err)
+	}
+	return !retrying, nil
+}
+
+// complete 完成活動並記錄投遞統計
+func (uc *DispatchBroadcastsUseCase) complete(c *broadcast.Campaign, now time.Time, result *DispatchBroadcastsResult) error {
+	return uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		summary, err := uc.recipientRepo.Summarize(ctx, c.CampaignID())
+		if err != nil {
+			return fmt.Errorf("failed to summarize recipients: %w", err)
+		}
+		if err := c.CompleteAt(summary, now); err != nil {
+			return err
+		}
+		if err := uc.campaignRepo.Save(ctx, c); err != nil {
+			return fmt.Errorf("failed to save campaign: %w", err)
+		}
+		result.Completed++
+		return nil
+	})
+}
+
+// applyExclusions 將排除名單中的 pending 收件者標記為 excluded
+//
+// 返回：本次排除的收件者數
+func (uc *DispatchBroadcastsUseCase) applyExclusions(
+	ctx shared.TransactionContext,
+	recipients []*broadcast.Recipient,
+	now time.Time,
+) (int, error) {
+	memberIDs := make([]broadcast.MemberID, 0, len(recipients))
+	for _, r := range recipients {
+		memberIDs = append(memberIDs, r.MemberID())
+	}
+	exclusions, err := uc.exclusionRepo.FindByMemberIDs(ctx, memberIDs)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find exclusions: %w", err)
+	}
+
+	reasons := make(map[string]broadcast.ExclusionReason, len(exclusions))
+	for _, e := range exclusions {
+		reasons[e.MemberID().String()] = e.Reason()
+	}
+
+	excluded := 0
+	for _, r := range recipients {
+		reason, ok := reasons[r.MemberID().String()]
+		if !ok {
+			continue
+		}
+		if err := r.ExcludeAt(reason, now); err != nil {
+			return 0, err
+		}
+		excluded++
+	}
+	return excluded, nil
+}
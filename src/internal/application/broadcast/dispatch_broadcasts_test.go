@@ -0,0 +1,463 @@
+package broadcast
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// Test Fixture
+// ===========================
+
+type broadcastFixture struct {
+	campaigns  *MockCampaignRepository
+	recipients *MockRecipientRepository
+	exclusions *MockExclusionRepository
+	audience   *StubAudienceQuery
+	sender     *FakeMulticastSender
+	sleeps     []time.Duration
+	now        time.Time
+}
+
+func newBroadcastFixture(t *testing.T, audienceSize int) *broadcastFixture {
+	f := &broadcastFixture{
+		campaigns:  &MockCampaignRepository{campaigns: map[string]*broadcast.Campaign{}},
+		recipients: &MockRecipientRepository{recipients: map[string]*broadcast.Recipient{}},
+		exclusions: &MockExclusionRepository{exclusions: map[string]*broadcast.Exclusion{}},
+		audience:   &StubAudienceQuery{},
+		sender:     &FakeMulticastSender{delivered: map[string]bool{}},
+		now:        time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+	for i := 0; i < audienceSize; i++ {
+		memberID, err := broadcast.MemberIDFromString(fmt.Sprintf("00000000-0000-4000-8000-%012d", i))
+		require.NoError(t, err)
+		f.audience.audience = append(f.audience.audience, broadcast.Audience{
+			MemberID:   memberID,
+			LineUserID: fmt.Sprintf("U%032d", i),
+		})
+	}
+	return f
+}
+
+func (f *broadcastFixture) dispatcher(batchSize int) *DispatchBroadcastsUseCase {
+	uc := NewDispatchBroadcastsUseCase(
+		f.campaigns, f.recipients, f.exclusions, f.audience, f.sender, &MockTransactionManager{},
+		ThrottleConfig{BatchSize: batchSize, BatchInterval: 2 * time.Second},
+	)
+	uc.sleep = func(d time.Duration) { f.sleeps = append(f.sleeps, d) }
+	return uc
+}
+
+// createCampaign 建立立即發送的活動
+func (f *broadcastFixture) createCampaign(t *testing.T) string {
+	uc := NewCreateCampaignUseCase(f.campaigns, &MockTransactionManager{})
+	result, err := uc.Execute(CreateCampaignCommand{
+		Name:               "30 天未回訪",
+		MinAvailablePoints: 100,
+		InactiveDays:       30,
+		Message:            "好久不見！本週回店出示訊息享招待一杯",
+		CreatedBy:          "manager",
+		Now:                f.now,
+	})
+	require.NoError(t, err)
+	return result.CampaignID
+}
+
+func (f *broadcastFixture) report(t *testing.T, campaignID string) *CampaignReportResult {
+	report, err := NewGetCampaignReportUseCase(f.campaigns, f.recipients).Execute(campaignID)
+	require.NoError(t, err)
+	return report
+}
+
+// ===========================
+// Tests
+// ===========================
+
+// Test 1: 分批節流發送、排除名單中的會員不發送、完成後記錄統計
+func TestDispatchBroadcasts_SendsThrottledBatches(t *testing.T) {
+	// Arrange
+	f := newBroadcastFixture(t, 1200)
+	campaignID := f.createCampaign(t)
+	unsubscribe := NewUpdateBroadcastSubscriptionUseCase(f.exclusions, &MockTransactionManager{})
+	_, err := unsubscribe.Execute(UpdateBroadcastSubscriptionCommand{
+		MemberID: f.audience.audience[3].MemberID.String(),
+		Now:      f.now,
+	})
+	require.NoError(t, err)
+
+	// Act
+	result, err := f.dispatcher(500).Execute(DispatchBroadcastsCommand{Now: f.now})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, &DispatchBroadcastsResult{Started: 1, Completed: 1, Batches: 3, Sent: 1199, Excluded: 1}, result)
+	require.Len(t, f.sender.requests, 3)
+	assert.Len(t, f.sender.requests[0].to, 500)
+	assert.Len(t, f.sender.requests[2].to, 199)
+	assert.NotContains(t, f.sender.requests[0].to, f.audience.audience[3].LineUserID)
+	assert.Equal(t, []time.Duration{2 * time.Second, 2 * time.Second}, f.sleeps)
+
+	report := f.report(t, campaignID)
+	assert.Equal(t, broadcast.CampaignStatusCompleted.String(), report.Campaign.Status)
+	assert.Equal(t, 1200, report.Total)
+	assert.Equal(t, 1199, report.Sent)
+	assert.Equal(t, 1, report.Excluded)
+}
+
+// Test 2: 行程重啟後續傳（未完成的批次以原 Retry-Key 重送，不重複發送）
+func TestDispatchBroadcasts_ResumesInFlightBatchAfterRestart(t *testing.T) {
+	// Arrange：第一批已分配並送達 LINE，但行程在記錄結果前中斷
+	f := newBroadcastFixture(t, 5)
+	f.createCampaign(t)
+	f.sender.crashAfterSend = true
+	assert.Panics(t, func() {
+		_, _ = f.dispatcher(2).Execute(DispatchBroadcastsCommand{Now: f.now})
+	})
+	crashedKey := f.sender.requests[0].retryKey
+	f.sender.crashAfterSend = false
+
+	// Act
+	result, err := f.dispatcher(2).Execute(DispatchBroadcastsCommand{Now: f.now.Add(time.Minute)})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Completed)
+	require.Len(t, f.sender.requests, 4)
+	assert.Equal(t, crashedKey, f.sender.requests[1].retryKey)
+	assert.Equal(t, f.sender.requests[0].to, f.sender.requests[1].to)
+	assert.Equal(t, 5, f.sender.deliveredRecipients, "each member receives the message exactly once")
+}
+
+// Test 3: 批次失敗以同一批次重試，達上限後標記失敗並繼續下一批
+func TestDispatchBroadcasts_RetriesFailedBatch(t *testing.T) {
+	// Arrange
+	f := newBroadcastFixture(t, 3)
+	campaignID := f.createCampaign(t)
+	f.sender.failures = broadcast.MaxDeliveryAttempts
+
+	// Act
+	var results []*DispatchBroadcastsResult
+	for i := 0; i < broadcast.MaxDeliveryAttempts; i++ {
+		result, err := f.dispatcher(2).Execute(DispatchBroadcastsCommand{Now: f.now.Add(time.Duration(i) * time.Minute)})
+		require.NoError(t, err)
+		results = append(results, result)
+	}
+
+	// Assert
+	assert.Equal(t, 2, results[0].Retrying)
+	assert.Equal(t, 2, results[1].Retrying)
+	assert.Equal(t, 2, results[2].Failed)
+	assert.Equal(t, 1, results[2].Sent)
+	assert.Equal(t, 1, results[2].Completed)
+	assert.Equal(t, f.sender.requests[0].retryKey, f.sender.requests[2].retryKey)
+
+	report := f.report(t, campaignID)
+	assert.Equal(t, 2, report.Failed)
+	require.Len(t, report.Failures, 2)
+	assert.Equal(t, broadcast.MaxDeliveryAttempts, report.Failures[0].Attempts)
+	assert.Equal(t, "line api unavailable", report.Failures[0].LastError)
+}
+
+// Test 4: 發送期間取消訂閱的會員不再收到；取消活動後停止發送
+func TestDispatchBroadcasts_HonoursExclusionsAndCancellation(t *testing.T) {
+	// Arrange
+	f := newBroadcastFixture(t, 6)
+	campaignID := f.createCampaign(t)
+	_, err := f.dispatcher(2).Execute(DispatchBroadcastsCommand{Now: f.now, MaxBatches: 1})
+	require.NoError(t, err)
+
+	blocked, err := broadcast.NewExclusion(f.audience.audience[2].MemberID, broadcast.ExclusionReasonBlocked, f.now)
+	require.NoError(t, err)
+	require.NoError(t, f.exclusions.Save(nil, blocked))
+
+	// Act
+	second, err := f.dispatcher(2).Execute(DispatchBroadcastsCommand{Now: f.now.Add(time.Minute), MaxBatches: 1})
+	require.NoError(t, err)
+	_, err = NewCancelCampaignUseCase(f.campaigns, &MockTransactionManager{}).Execute(CancelCampaignCommand{
+		CampaignID: campaignID,
+		Now:        f.now.Add(2 * time.Minute),
+	})
+	require.NoError(t, err)
+	third, err := f.dispatcher(2).Execute(DispatchBroadcastsCommand{Now: f.now.Add(3 * time.Minute)})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 1, second.Excluded)
+	assert.Equal(t, []string{f.audience.audience[3].LineUserID}, f.sender.requests[1].to)
+	assert.Equal(t, &DispatchBroadcastsResult{}, third)
+	assert.Len(t, f.sender.requests, 2)
+
+	report := f.report(t, campaignID)
+	assert.Equal(t, broadcast.CampaignStatusCancelled.String(), report.Campaign.Status)
+	assert.Equal(t, 2, report.Pending)
+}
+
+// Test 5: 封鎖狀態不因重新訂閱而解除；取消訂閱可重新訂閱
+func TestUpdateBroadcastSubscription(t *testing.T) {
+	// Arrange
+	f := newBroadcastFixture(t, 2)
+	uc := NewUpdateBroadcastSubscriptionUseCase(f.exclusions, &MockTransactionManager{})
+	blocked, err := broadcast.NewExclusion(f.audience.audience[0].MemberID, broadcast.ExclusionReasonBlocked, f.now)
+	require.NoError(t, err)
+	require.NoError(t, f.exclusions.Save(nil, blocked))
+	other := f.audience.audience[1].MemberID.String()
+
+	// Act
+	blockedResult, err := uc.Execute(UpdateBroadcastSubscriptionCommand{
+		MemberID:   f.audience.audience[0].MemberID.String(),
+		Subscribed: true,
+		Now:        f.now,
+	})
+	require.NoError(t, err)
+	unsubscribed, err := uc.Execute(UpdateBroadcastSubscriptionCommand{MemberID: other, Now: f.now})
+	require.NoError(t, err)
+	resubscribed, err := uc.Execute(UpdateBroadcastSubscriptionCommand{MemberID: other, Subscribed: true, Now: f.now})
+	require.NoError(t, err)
+
+	// Assert
+	assert.False(t, blockedResult.Subscribed)
+	assert.Equal(t, "blocked", blockedResult.ExclusionReason)
+	assert.False(t, unsubscribed.Subscribed)
+	assert.True(t, resubscribed.Subscribed)
+	assert.NotContains(t, f.exclusions.exclusions, other)
+}
+
+// Test 6: 封鎖官方帳號加入排除名單；解除封鎖後移除，但取消訂閱者維持排除
+func TestMemberReachabilityHandler(t *testing.T) {
+	// Arrange
+	f := newBroadcastFixture(t, 2)
+	handler := NewMemberReachabilityHandler(f.exclusions, &MockTransactionManager{})
+	lineUserID, err := member.NewLineUserID("U1234567890abcdef1234567890abcdef")
+	require.NoError(t, err)
+	blockedID, err := member.MemberIDFromString(f.audience.audience[0].MemberID.String())
+	require.NoError(t, err)
+	unsubscribedID, err := member.MemberIDFromString(f.audience.audience[1].MemberID.String())
+	require.NoError(t, err)
+	unsubscribed, err := broadcast.NewExclusion(f.audience.audience[1].MemberID, broadcast.ExclusionReasonUnsubscribed, f.now)
+	require.NoError(t, err)
+	require.NoError(t, f.exclusions.Save(nil, unsubscribed))
+	unfollowedAt := f.now.Add(-time.Hour)
+
+	// Act & Assert: 封鎖
+	require.NoError(t, handler.HandleEvents([]shared.DomainEvent{
+		member.NewMemberUnfollowedEvent(blockedID, lineUserID, unfollowedAt),
+		member.NewMemberUnfollowedEvent(unsubscribedID, lineUserID, unfollowedAt),
+	}))
+	require.Contains(t, f.exclusions.exclusions, blockedID.String())
+	assert.Equal(t, broadcast.ExclusionReasonBlocked, f.exclusions.exclusions[blockedID.String()].Reason())
+	assert.Equal(t, broadcast.ExclusionReasonUnsubscribed, f.exclusions.exclusions[unsubscribedID.String()].Reason())
+
+	// Act & Assert: 解除封鎖
+	require.NoError(t, handler.HandleEvents([]shared.DomainEvent{
+		member.NewMemberRefollowedEvent(blockedID, lineUserID, unfollowedAt, f.now),
+		member.NewMemberRefollowedEvent(unsubscribedID, lineUserID, unfollowedAt, f.now),
+	}))
+	assert.NotContains(t, f.exclusions.exclusions, blockedID.String())
+	assert.Contains(t, f.exclusions.exclusions, unsubscribedID.String())
+}
+
+// ===========================
+// Mock 實現
+// ===========================
+
+// MockTransactionManager 直接執行函數（ctx 為 nil）
+type MockTransactionManager struct{}
+
+func (m *MockTransactionManager) InTransaction(fn func(ctx shared.TransactionContext) error) error {
+	return fn(nil)
+}
+
+// MockCampaignRepository 以活動 ID 為鍵
+type MockCampaignRepository struct {
+	campaigns map[string]*broadcast.Campaign
+}
+
+func (m *MockCampaignRepository) Save(ctx shared.TransactionContext, c *broadcast.Campaign) error {
+	m.campaigns[c.CampaignID().String()] = c
+	return nil
+}
+
+func (m *MockCampaignRepository) FindByID(ctx shared.TransactionContext, id broadcast.CampaignID) (*broadcast.Campaign, error) {
+	if c, ok := m.campaigns[id.String()]; ok {
+		return c, nil
+	}
+	return nil, broadcast.ErrCampaignNotFound
+}
+
+func (m *MockCampaignRepository) FindSendable(ctx shared.TransactionContext, now time.Time) ([]*broadcast.Campaign, error) {
+	var campaigns []*broadcast.Campaign
+	for _, c := range m.campaigns {
+		if c.IsDueAt(now) || c.Status() == broadcast.CampaignStatusSending {
+			campaigns = append(campaigns, c)
+		}
+	}
+	return campaigns, nil
+}
+
+// MockRecipientRepository 以 campaign_id/member_id 為鍵，保留建立順序
+type MockRecipientRepository struct {
+	recipients map[string]*broadcast.Recipient
+	order      []string
+}
+
+func recipientKey(campaignID broadcast.CampaignID, memberID broadcast.MemberID) string {
+	return campaignID.String() + "/" + memberID.String()
+}
+
+func (m *MockRecipientRepository) SaveAll(ctx shared.TransactionContext, recipients []*broadcast.Recipient) error {
+	for _, r := range recipients {
+		key := recipientKey(r.CampaignID(), r.MemberID())
+		if _, ok := m.recipients[key]; !ok {
+			m.order = append(m.order, key)
+		}
+		m.recipients[key] = r
+	}
+	return nil
+}
+
+func (m *MockRecipientRepository) FindInFlightBatch(ctx shared.TransactionContext, campaignID broadcast.CampaignID) ([]*broadcast.Recipient, error) {
+	batchKey := ""
+	return m.filter(campaignID, func(r *broadcast.Recipient) bool {
+		if r.Status() != broadcast.RecipientStatusPending || r.BatchKey() == "" {
+			return false
+		}
+		if batchKey == "" {
+			batchKey = r.BatchKey()
+		}
+		return r.BatchKey() == batchKey
+	}, 0), nil
+}
+
+func (m *MockRecipientRepository) FindUnassigned(ctx shared.TransactionContext, campaignID broadcast.CampaignID, limit int) ([]*broadcast.Recipient, error) {
+	return m.filter(campaignID, func(r *broadcast.Recipient) bool {
+		return r.Status() == broadcast.RecipientStatusPending && r.BatchKey() == ""
+	}, limit), nil
+}
+
+func (m *MockRecipientRepository) FindByCampaignID(
+	ctx shared.TransactionContext,
+	campaignID broadcast.CampaignID,
+	status broadcast.RecipientStatus,
+) ([]*broadcast.Recipient, error) {
+	return m.filter(campaignID, func(r *broadcast.Recipient) bool {
+		return status == "" || r.Status() == status
+	}, 0), nil
+}
+
+func (m *MockRecipientRepository) Summarize(ctx shared.TransactionContext, campaignID broadcast.CampaignID) (broadcast.DeliverySummary, error) {
+	var summary broadcast.DeliverySummary
+	for _, r := range m.filter(campaignID, func(*broadcast.Recipient) bool { return true }, 0) {
+		switch r.Status() {
+		case broadcast.RecipientStatusPending:
+			summary.Pending++
+		case broadcast.RecipientStatusSent:
+			summary.Sent++
+		case broadcast.RecipientStatusFailed:
+			summary.Failed++
+		case broadcast.RecipientStatusExcluded:
+			summary.Excluded++
+		}
+	}
+	return summary, nil
+}
+
+func (m *MockRecipientRepository) filter(campaignID broadcast.CampaignID, match func(*broadcast.Recipient) bool, limit int) []*broadcast.Recipient {
+	var recipients []*broadcast.Recipient
+	for _, key := range m.order {
+		r := m.recipients[key]
+		if r.CampaignID() != campaignID || !match(r) {
+			continue
+		}
+		recipients = append(recipients, r)
+		if limit > 0 && len(recipients) == limit {
+			break
+		}
+	}
+	return recipients
+}
+
+// MockExclusionRepository 以會員 ID 為鍵
+type MockExclusionRepository struct {
+	exclusions map[string]*broadcast.Exclusion
+}
+
+func (m *MockExclusionRepository) Save(ctx shared.TransactionContext, e *broadcast.Exclusion) error {
+	m.exclusions[e.MemberID().String()] = e
+	return nil
+}
+
+func (m *MockExclusionRepository) Delete(ctx shared.TransactionContext, memberID broadcast.MemberID) error {
+	if _, ok := m.exclusions[memberID.String()]; !ok {
+		return broadcast.ErrExclusionNotFound
+	}
+	delete(m.exclusions, memberID.String())
+	return nil
+}
+
+func (m *MockExclusionRepository) FindByMemberID(ctx shared.TransactionContext, memberID broadcast.MemberID) (*broadcast.Exclusion, error) {
+	if e, ok := m.exclusions[memberID.String()]; ok {
+		return e, nil
+	}
+	return nil, broadcast.ErrExclusionNotFound
+}
+
+func (m *MockExclusionRepository) FindByMemberIDs(ctx shared.TransactionContext, memberIDs []broadcast.MemberID) ([]*broadcast.Exclusion, error) {
+	var exclusions []*broadcast.Exclusion
+	for _, id := range memberIDs {
+		if e, ok := m.exclusions[id.String()]; ok {
+			exclusions = append(exclusions, e)
+		}
+	}
+	return exclusions, nil
+}
+
+// StubAudienceQuery 返回固定的分眾結果
+type StubAudienceQuery struct {
+	audience []broadcast.Audience
+}
+
+func (q *StubAudienceQuery) FindAudience(ctx shared.TransactionContext, segment broadcast.Segment, now time.Time) ([]broadcast.Audience, error) {
+	return q.audience, nil
+}
+
+// FakeMulticastSender 記錄請求，模擬 LINE 以 Retry-Key 去重
+//
+// - failures: 前 N 次請求返回錯誤（未送達）
+// - crashAfterSend: 送達後 panic（模擬行程在記錄結果前中斷）
+type FakeMulticastSender struct {
+	requests            []recordedMulticast
+	delivered           map[string]bool
+	deliveredRecipients int
+	failures            int
+	crashAfterSend      bool
+}
+
+type recordedMulticast struct {
+	retryKey string
+	to       []string
+	text     string
+}
+
+func (s *FakeMulticastSender) MulticastText(retryKey string, lineUserIDs []string, text string) error {
+	s.requests = append(s.requests, recordedMulticast{retryKey: retryKey, to: lineUserIDs, text: text})
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("line api unavailable")
+	}
+	if !s.delivered[retryKey] {
+		s.delivered[retryKey] = true
+		s.deliveredRecipients += len(lineUserIDs)
+	}
+	if s.crashAfterSend {
+		panic("process terminated")
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package broadcast
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+)
+
+// ===========================
+// DTO（Use Case 輸出）
+// ===========================
+
+// CampaignResult 群發活動
+type CampaignResult struct {
+	CampaignID         string
+	Name               string
+	MinAvailablePoints int
+	InactiveDays       int
+	VerifiedAdultsOnly bool
+	Message            string
+	Status             string
+	ScheduledAt        time.Time
+	CreatedBy          string
+	StartedAt          *time.Time
+	CompletedAt        *time.Time
+}
+
+// toCampaignResult 將群發活動轉換為輸出 DTO
+func toCampaignResult(c *broadcast.Campaign) *CampaignResult {
+	return &CampaignResult{
+		CampaignID:         c.CampaignID().String(),
+		Name:               c.Name(),
+		MinAvailablePoints: c.Segment().MinAvailablePoints(),
+		InactiveDays:       c.Segment().InactiveDays(),
+		VerifiedAdultsOnly: c.Segment().VerifiedAdultsOnly(),
+		Message:            c.Message().Text(),
+		Status:             c.Status().String(),
+		ScheduledAt:        c.ScheduledAt(),
+		CreatedBy:          c.CreatedBy(),
+		StartedAt:          c.StartedAt(),
+		CompletedAt:        c.CompletedAt(),
+	}
+}
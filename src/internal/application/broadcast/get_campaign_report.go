@@ -0,0 +1,93 @@
+package broadcast
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+)
+
+// ===========================
+// GetCampaignReport Query
+// ===========================
+
+// CampaignReportResult 群發活動投遞報告
+//
+// 欄位：
+// - Pending / Sent / Failed / Excluded: 各狀態收件者數（發送中即時統計）
+// - Failures: 發送失敗的收件者（含失敗原因）
+type CampaignReportResult struct {
+	Campaign *CampaignResult
+	Total    int
+	Pending  int
+	Sent     int
+	Failed   int
+	Excluded int
+	Failures []RecipientFailureResult
+}
+
+// RecipientFailureResult 發送失敗的收件者
+type RecipientFailureResult struct {
+	MemberID  string
+	Attempts  int
+	LastError string
+	FailedAt  time.Time
+}
+
+// GetCampaignReportUseCase 查詢群發活動投遞報告（唯讀）
+type GetCampaignReportUseCase struct {
+	campaignRepo  broadcast.CampaignRepository
+	recipientRepo broadcast.RecipientRepository
+}
+
+// NewGetCampaignReportUseCase 創建 Use Case 實例
+func NewGetCampaignReportUseCase(
+	campaignRepo broadcast.CampaignRepository,
+	recipientRepo broadcast.RecipientRepository,
+) *GetCampaignReportUseCase {
+	return &GetCampaignReportUseCase{
+		campaignRepo:  campaignRepo,
+		recipientRepo: recipientRepo,
+	}
+}
+
+// Execute 查詢投遞報告
+func (uc *GetCampaignReportUseCase) Execute(campaignID string) (*CampaignReportResult, error) {
+	id, err := broadcast.CampaignIDFromString(campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := uc.campaignRepo.FindByID(nil, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find campaign: %w", err)
+	}
+	summary, err := uc.recipientRepo.Summarize(nil, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize recipients: %w", err)
+	}
+	failed, err := uc.recipientRepo.FindByCampaignID(nil, id, broadcast.RecipientStatusFailed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find failed recipients: %w", err)
+	}
+
+	failures := make([]RecipientFailureResult, 0, len(failed))
+	for _, r := range failed {
+		failures = append(failures, RecipientFailureResult{
+			MemberID:  r.MemberID().String(),
+			Attempts:  r.Attempts(),
+			LastError: r.LastError(),
+			FailedAt:  r.UpdatedAt(),
+		})
+	}
+
+	return &CampaignReportResult{
+		Campaign: toCampaignResult(c),
+		Total:    summary.Total(),
+		Pending:  summary.Pending,
+		Sent:     summary.Sent,
+		Failed:   summary.Failed,
+		Excluded: summary.Excluded,
+		Failures: failures,
+	}, nil
+}
@@ -0,0 +1,114 @@
+package broadcast
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// MemberReachability 事件處理器
+// ===========================
+
+// MemberReachabilityHandler 依會員封鎖 / 解除封鎖維護群發排除名單
+//
+// 處理事件（Member.PullEvents()）：
+// - member.unfollowed → 加入排除名單（原因 blocked）
+// - member.refollowed → 移除 blocked 紀錄
+// - 其他事件忽略
+//
+// 業務規則：
+// - 已取消訂閱的會員維持 unsubscribed（解除封鎖後仍不接收群發）
+// - 發送中的活動於下一批分配前檢查排除名單，封鎖的會員不會再收到
+type MemberReachabilityHandler struct {
+	exclusionRepo broadcast.ExclusionRepository
+	txManager     shared.TransactionManager
+}
+
+// NewMemberReachabilityHandler 創建事件處理器實例
+func NewMemberReachabilityHandler(
+	exclusionRepo broadcast.ExclusionRepository,
+	txManager shared.TransactionManager,
+) *MemberReachabilityHandler {
+	return &MemberReachabilityHandler{
+		exclusionRepo: exclusionRepo,
+		txManager:     txManager,
+	}
+}
+
+// HandleEvents 處理 Member.PullEvents() 取得的事件（依序處理，任一失敗即返回）
+func (h *MemberReachabilityHandler) HandleEvents(events []shared.DomainEvent) error {
+	for _, event := range events {
+		if err := h.Handle(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Handle 處理單一事件
+func (h *MemberReachabilityHandler) Handle(event shared.DomainEvent) error {
+	switch e := event.(type) {
+	case *member.MemberUnfollowedEvent:
+		return h.block(e.MemberID().String(), e)
+	case *member.MemberRefollowedEvent:
+		return h.unblock(e.MemberID().String())
+	default:
+		return nil
+	}
+}
+
+// block 加入排除名單（已在名單中時維持原因）
+func (h *MemberReachabilityHandler) block(id string, event shared.DomainEvent) error {
+	memberID, err := broadcast.MemberIDFromString(id)
+	if err != nil {
+		return err
+	}
+
+	return h.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		_, err := h.exclusionRepo.FindByMemberID(ctx, memberID)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, broadcast.ErrExclusionNotFound) {
+			return fmt.Errorf("failed to find exclusion: %w", err)
+		}
+
+		exclusion, err := broadcast.NewExclusion(memberID, broadcast.ExclusionReasonBlocked, event.OccurredAt())
+		if err != nil {
+			return err
+		}
+		if err := h.exclusionRepo.Save(ctx, exclusion); err != nil {
+			return fmt.Errorf("failed to save exclusion: %w", err)
+		}
+		return nil
+	})
+}
+
+// unblock 移除 blocked 紀錄（unsubscribed 維持不變）
+func (h *MemberReachabilityHandler) unblock(id string) error {
+	memberID, err := broadcast.MemberIDFromString(id)
+	if err != nil {
+		return err
+	}
+
+	return h.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		exclusion, err := h.exclusionRepo.FindByMemberID(ctx, memberID)
+		if errors.Is(err, broadcast.ErrExclusionNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find exclusion: %w", err)
+		}
+		if exclusion.Reason() != broadcast.ExclusionReasonBlocked {
+			return nil
+		}
+		if err := h.exclusionRepo.Delete(ctx, memberID); err != nil {
+			return fmt.Errorf("failed to delete exclusion: %w", err)
+		}
+		return nil
+	})
+}
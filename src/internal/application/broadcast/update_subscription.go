@@ -0,0 +1,100 @@
+package broadcast
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// UpdateBroadcastSubscription Use Case
+// ===========================
+
+// UpdateBroadcastSubscriptionCommand 更新群發訂閱指令
+//
+// 欄位：
+// - Subscribed: false 取消訂閱（加入排除名單）；true 重新訂閱（移出排除名單）
+type UpdateBroadcastSubscriptionCommand struct {
+	MemberID   string
+	Subscribed bool
+	Now        time.Time
+}
+
+// BroadcastSubscriptionResult 群發訂閱狀態
+//
+// 欄位：
+// - ExclusionReason: 仍在排除名單中的原因（可接收群發時為空字串）
+type BroadcastSubscriptionResult struct {
+	MemberID        string
+	Subscribed      bool
+	ExclusionReason string
+}
+
+// UpdateBroadcastSubscriptionUseCase 會員取消 / 重新訂閱群發訊息
+//
+// 業務規則：
+// - 取消訂閱：加入排除名單（原因 unsubscribed）；已因封鎖而排除時維持 blocked
+// - 重新訂閱：僅移除 unsubscribed 紀錄（封鎖狀態由 LINE follow / unfollow 事件維護）
+// - 重複操作冪等
+type UpdateBroadcastSubscriptionUseCase struct {
+	exclusionRepo broadcast.ExclusionRepository
+	txManager     shared.TransactionManager
+}
+
+// NewUpdateBroadcastSubscriptionUseCase 創建 Use Case 實例
+func NewUpdateBroadcastSubscriptionUseCase(
+	exclusionRepo broadcast.ExclusionRepository,
+	txManager shared.TransactionManager,
+) *UpdateBroadcastSubscriptionUseCase {
+	return &UpdateBroadcastSubscriptionUseCase{
+		exclusionRepo: exclusionRepo,
+		txManager:     txManager,
+	}
+}
+
+// Execute 更新群發訂閱
+func (uc *UpdateBroadcastSubscriptionUseCase) Execute(cmd UpdateBroadcastSubscriptionCommand) (*BroadcastSubscriptionResult, error) {
+	memberID, err := broadcast.MemberIDFromString(cmd.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BroadcastSubscriptionResult{MemberID: cmd.MemberID}
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		existing, err := uc.exclusionRepo.FindByMemberID(ctx, memberID)
+		if err != nil && !errors.Is(err, broadcast.ErrExclusionNotFound) {
+			return fmt.Errorf("failed to find exclusion: %w", err)
+		}
+
+		switch {
+		case existing != nil && existing.Reason() == broadcast.ExclusionReasonBlocked:
+			result.ExclusionReason = existing.Reason().String()
+			return nil
+		case cmd.Subscribed && existing != nil:
+			if err := uc.exclusionRepo.Delete(ctx, memberID); err != nil {
+				return fmt.Errorf("failed to delete exclusion: %w", err)
+			}
+		case !cmd.Subscribed && existing == nil:
+			exclusion, err := broadcast.NewExclusion(memberID, broadcast.ExclusionReasonUnsubscribed, cmd.Now)
+			if err != nil {
+				return err
+			}
+			if err := uc.exclusionRepo.Save(ctx, exclusion); err != nil {
+				return fmt.Errorf("failed to save exclusion: %w", err)
+			}
+		}
+		if !cmd.Subscribed {
+			result.ExclusionReason = broadcast.ExclusionReasonUnsubscribed.String()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.Subscribed = result.ExclusionReason == ""
+	return result, nil
+}
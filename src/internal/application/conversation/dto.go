@@ -0,0 +1,33 @@
+package conversation
+
+// ===========================
+// 註冊對話 DTO
+// ===========================
+
+// RegistrationStep 註冊對話步驟（由展示層轉為回覆訊息）
+type RegistrationStep string
+
+const (
+	StepAskPhoneNumber     RegistrationStep = "ask_phone_number"     // 請輸入手機號碼
+	StepInvalidPhoneNumber RegistrationStep = "invalid_phone_number" // 手機號碼格式錯誤（可重試）
+	StepConfirmPhoneNumber RegistrationStep = "confirm_phone_number" // 請確認手機號碼
+	StepPhoneNumberBound   RegistrationStep = "phone_number_bound"   // 手機號碼已被註冊（可改輸入其他號碼）
+	StepRetryLimitExceeded RegistrationStep = "retry_limit_exceeded" // 超過重試次數，會話結束
+	StepCancelled          RegistrationStep = "cancelled"            // 用戶取消，會話結束
+	StepExpired            RegistrationStep = "expired"              // 會話逾時，會話結束
+	StepRegistered         RegistrationStep = "registered"           // 註冊成功，會話結束
+	StepAlreadyRegistered  RegistrationStep = "already_registered"   // LINE 帳號已註冊，會話結束
+)
+
+// RegistrationStepResult 註冊對話步驟結果
+//
+// 欄位：
+// - PhoneNumber: 確認中 / 已註冊的手機號碼
+// - RemainingAttempts: 格式錯誤時的剩餘重試次數
+// - MemberID: 註冊成功時的會員 ID
+type RegistrationStepResult struct {
+	Step              RegistrationStep
+	PhoneNumber       string
+	RemainingAttempts int
+	MemberID          string
+}
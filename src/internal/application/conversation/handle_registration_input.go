@@ -0,0 +1,235 @@
+package conversation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	appmember "github.com/jackyeh168/bar_crm/src/internal/application/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/conversation"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// HandleRegistrationInput Use Case
+// ===========================
+
+// RegistrationInputCommand 註冊對話輸入指令
+//
+// 欄位：
+// - DisplayName: LINE 顯示名稱（確認註冊時使用）
+// - Text: 用戶輸入的文字訊息
+type RegistrationInputCommand struct {
+	LineUserID  string
+	DisplayName string
+	Text        string
+}
+
+// PhoneNumberBinder 已存在但尚未綁定手機號碼的會員綁定手機號碼
+//
+// 實作：application/member.BindPhoneNumberUseCase
+type PhoneNumberBinder interface {
+	Execute(cmd appmember.BindPhoneNumberCommand) (*appmember.RegisterMemberResult, error)
+}
+
+// HandleRegistrationInputUseCase 處理註冊對話中的用戶輸入
+//
+// 流程（docs/product/ui-ux/linebot-conversation-flows.md 註冊流程）：
+// 1. 逾時 → 結束會話
+// 2. 取消關鍵字 → 結束會話
+// 3. 等待手機號碼：以 member.NewPhoneNumber 驗證
+//    - 格式錯誤 → 累計重試次數，達上限結束會話
+//    - 格式正確 → 進入確認步驟
+// 4. 等待確認：
+//    - 是 → 呼叫 RegisterMemberUseCase，成功後結束會話
+//      （會員已存在但尚未綁定手機號碼，例如管理員解除綁定後 → 改由 PhoneNumberBinder 綁定）
+//    - 否 → 退回輸入手機號碼
+//    - 無法辨識 → 再次請用戶確認
+//
+// 設計原則：
+// - RegisterMemberUseCase / PhoneNumberBinder 自行管理事務，會話狀態變更另行保存
+// - 註冊失敗（手機號碼已被註冊）時會話退回輸入步驟，用戶可改用其他號碼
+type HandleRegistrationInputUseCase struct {
+	sessionRepo     conversation.RegistrationSessionRepository
+	registerMember  appmember.RegisterMemberUseCase
+	bindPhoneNumber PhoneNumberBinder
+	txManager       shared.TransactionManager
+}
+
+// NewHandleRegistrationInputUseCase 創建 Use Case 實例
+func NewHandleRegistrationInputUseCase(
+	sessionRepo conversation.RegistrationSessionRepository,
+	registerMember appmember.RegisterMemberUseCase,
+	bindPhoneNumber PhoneNumberBinder,
+	txManager shared.TransactionManager,
+) *HandleRegistrationInputUseCase {
+	return &HandleRegistrationInputUseCase{
+		sessionRepo:     sessionRepo,
+		registerMember:  registerMember,
+		bindPhoneNumber: bindPhoneNumber,
+		txManager:       txManager,
+	}
+}
+
+// Execute 處理用戶輸入
+//
+// 錯誤處理：
+// - ErrSessionNotFound: 沒有進行中的註冊會話（由呼叫端決定是否開始新會話）
+// - 其他錯誤：添加上下文後返回
+func (uc *HandleRegistrationInputUseCase) Execute(cmd RegistrationInputCommand) (*RegistrationStepResult, error) {
+	session, err := uc.sessionRepo.FindByLineUserID(nil, cmd.LineUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	text := strings.TrimSpace(cmd.Text)
+
+	switch {
+	case session.IsExpiredAt(now):
+		return uc.end(session, &RegistrationStepResult{Step: StepExpired})
+	case conversation.IsCancelKeyword(text):
+		return uc.end(session, &RegistrationStepResult{Step: StepCancelled})
+	case session.State() == conversation.SessionStateAwaitingPhone:
+		return uc.handlePhoneNumber(session, text, now)
+	default:
+		return uc.handleConfirmation(session, cmd, text, now)
+	}
+}
+
+// handlePhoneNumber 等待輸入手機號碼
+func (uc *HandleRegistrationInputUseCase) handlePhoneNumber(
+	session *conversation.RegistrationSession,
+	text string,
+	now time.Time,
+) (*RegistrationStepResult, error) {
+	phoneNumber, err := member.NewPhoneNumber(text)
+	if err != nil {
+		if !errors.Is(err, member.ErrInvalidPhoneNumberFormat) {
+			return nil, err
+		}
+
+		if err := session.RecordInvalidPhoneAt(now); err != nil {
+			if errors.Is(err, conversation.ErrRetryLimitExceeded) {
+				return uc.end(session, &RegistrationStepResult{Step: StepRetryLimitExceeded})
+			}
+			return nil, err
+		}
+		return uc.save(session, &RegistrationStepResult{
+			Step:              StepInvalidPhoneNumber,
+			RemainingAttempts: session.RemainingAttempts(),
+		})
+	}
+
+	if err := session.AcceptPhoneNumberAt(phoneNumber.String(), now); err != nil {
+		return nil, err
+	}
+	return uc.save(session, &RegistrationStepResult{
+		Step:        StepConfirmPhoneNumber,
+		PhoneNumber: phoneNumber.String(),
+	})
+}
+
+// handleConfirmation 等待確認手機號碼
+func (uc *HandleRegistrationInputUseCase) handleConfirmation(
+	session *conversation.RegistrationSession,
+	cmd RegistrationInputCommand,
+	text string,
+	now time.Time,
+) (*RegistrationStepResult, error) {
+	phoneNumber, err := session.ConfirmedPhoneNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	switch conversation.ParseConfirmation(text) {
+	case conversation.ConfirmationYes:
+		return uc.register(session, cmd, phoneNumber, now)
+	case conversation.ConfirmationNo:
+		if err := session.RejectPhoneNumberAt(now); err != nil {
+			return nil, err
+		}
+		return uc.save(session, &RegistrationStepResult{
+			Step:              StepAskPhoneNumber,
+			RemainingAttempts: session.RemainingAttempts(),
+		})
+	default:
+		return &RegistrationStepResult{
+			Step:        StepConfirmPhoneNumber,
+			PhoneNumber: phoneNumber,
+		}, nil
+	}
+}
+
+// register 確認後註冊會員（會員已存在但尚未綁定手機號碼時改為綁定）
+func (uc *HandleRegistrationInputUseCase) register(
+	session *conversation.RegistrationSession,
+	cmd RegistrationInputCommand,
+	phoneNumber string,
+	now time.Time,
+) (*RegistrationStepResult, error) {
+	registered, err := uc.registerMember.Execute(appmember.RegisterMemberCommand{
+		LineUserID:  cmd.LineUserID,
+		DisplayName: cmd.DisplayName,
+		PhoneNumber: phoneNumber,
+	})
+	if errors.Is(err, member.ErrMemberAlreadyExists) {
+		registered, err = uc.bindPhoneNumber.Execute(appmember.BindPhoneNumberCommand{
+			LineUserID:  cmd.LineUserID,
+			PhoneNumber: phoneNumber,
+		})
+	}
+
+	switch {
+	case err == nil:
+		return uc.end(session, &RegistrationStepResult{
+			Step:        StepRegistered,
+			PhoneNumber: phoneNumber,
+			MemberID:    registered.MemberID,
+		})
+	case errors.Is(err, member.ErrPhoneNumberAlreadyBound):
+		if err := session.RejectPhoneNumberAt(now); err != nil {
+			return nil, err
+		}
+		return uc.save(session, &RegistrationStepResult{
+			Step:              StepPhoneNumberBound,
+			PhoneNumber:       phoneNumber,
+			RemainingAttempts: session.RemainingAttempts(),
+		})
+	case errors.Is(err, member.ErrPhoneAlreadyBound), errors.Is(err, member.ErrMemberMerged):
+		// 會員已綁定手機號碼，或此 LINE 帳號的會員已合併到其他會員
+		return uc.end(session, &RegistrationStepResult{Step: StepAlreadyRegistered})
+	default:
+		return nil, fmt.Errorf("failed to register member: %w", err)
+	}
+}
+
+// save 保存會話並返回結果
+func (uc *HandleRegistrationInputUseCase) save(
+	session *conversation.RegistrationSession,
+	result *RegistrationStepResult,
+) (*RegistrationStepResult, error) {
+	err := uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		return uc.sessionRepo.Save(ctx, session)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save registration session: %w", err)
+	}
+	return result, nil
+}
+
+// end 結束會話並返回結果
+func (uc *HandleRegistrationInputUseCase) end(
+	session *conversation.RegistrationSession,
+	result *RegistrationStepResult,
+) (*RegistrationStepResult, error) {
+	err := uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		return uc.sessionRepo.Delete(ctx, session.LineUserID())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete registration session: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,386 @@
+package conversation
+
+import (
+	"testing"
+	"time"
+
+	appmember "github.com/jackyeh168/bar_crm/src/internal/application/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/conversation"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// 註冊對話 Use Case 測試
+// ===========================
+
+const testLineUserID = "U4af4980629a1b2c3d4e5f6a7b8c9d0e1"
+
+// registrationFixture 測試用註冊對話環境
+type registrationFixture struct {
+	sessions *MockRegistrationSessionRepository
+	register *StubRegisterMember
+	bind     *StubBindPhoneNumber
+	start    *StartRegistrationUseCase
+	input    *HandleRegistrationInputUseCase
+}
+
+func newRegistrationFixture() *registrationFixture {
+	sessions := NewMockRegistrationSessionRepository()
+	register := &StubRegisterMember{}
+	bind := &StubBindPhoneNumber{}
+	txManager := NewMockTransactionManager()
+	return &registrationFixture{
+		sessions: sessions,
+		register: register,
+		bind:     bind,
+		start:    NewStartRegistrationUseCase(sessions, txManager),
+		input:    NewHandleRegistrationInputUseCase(sessions, register, bind, txManager),
+	}
+}
+
+// send 模擬用戶輸入
+func (f *registrationFixture) send(t *testing.T, text string) *RegistrationStepResult {
+	t.Helper()
+	result, err := f.input.Execute(RegistrationInputCommand{
+		LineUserID:  testLineUserID,
+		DisplayName: "王小明",
+		Text:        text,
+	})
+	require.NoError(t, err)
+	return result
+}
+
+// Test 1: 完整註冊流程（輸入 → 確認 → 註冊）
+func TestRegistrationFlow_ConfirmAndRegister(t *testing.T) {
+	// Arrange
+	f := newRegistrationFixture()
+	started, err := f.start.Execute(StartRegistrationCommand{LineUserID: testLineUserID})
+	require.NoError(t, err)
+
+	// Act
+	confirm := f.send(t, " 0912345678 ")
+	registered := f.send(t, "是")
+
+	// Assert
+	assert.Equal(t, StepAskPhoneNumber, started.Step)
+	assert.Equal(t, StepConfirmPhoneNumber, confirm.Step)
+	assert.Equal(t, "0912345678", confirm.PhoneNumber)
+	assert.Equal(t, StepRegistered, registered.Step)
+	assert.Equal(t, "member-1", registered.MemberID)
+	assert.Equal(t, []appmember.RegisterMemberCommand{{
+		LineUserID:  testLineUserID,
+		DisplayName: "王小明",
+		PhoneNumber: "0912345678",
+	}}, f.register.commands)
+	assert.Empty(t, f.sessions.sessions, "session ends after registration")
+}
+
+// Test 2: 格式錯誤累計重試，達上限結束會話
+func TestRegistrationFlow_InvalidPhone_RetryLimit(t *testing.T) {
+	// Arrange
+	f := newRegistrationFixture()
+	_, err := f.start.Execute(StartRegistrationCommand{LineUserID: testLineUserID})
+	require.NoError(t, err)
+
+	// Act
+	first := f.send(t, "123456")
+	second := f.send(t, "0812345678")
+	third := f.send(t, "abc")
+
+	// Assert
+	assert.Equal(t, StepInvalidPhoneNumber, first.Step)
+	assert.Equal(t, 2, first.RemainingAttempts)
+	assert.Equal(t, 1, second.RemainingAttempts)
+	assert.Equal(t, StepRetryLimitExceeded, third.Step)
+	assert.Empty(t, f.sessions.sessions)
+	assert.Empty(t, f.register.commands)
+}
+
+// Test 3: 手機號碼已被註冊時退回輸入步驟
+func TestRegistrationFlow_PhoneAlreadyBound_ReturnsToPhoneInput(t *testing.T) {
+	// Arrange
+	f := newRegistrationFixture()
+	f.register.err = member.ErrPhoneNumberAlreadyBound
+	_, err := f.start.Execute(StartRegistrationCommand{LineUserID: testLineUserID})
+	require.NoError(t, err)
+	f.send(t, "0912345678")
+
+	// Act
+	result := f.send(t, "是")
+
+	// Assert
+	assert.Equal(t, StepPhoneNumberBound, result.Step)
+	session := f.sessions.sessions[testLineUserID]
+	require.NotNil(t, session)
+	assert.Equal(t, conversation.SessionStateAwaitingPhone, session.State())
+}
+
+// Test 4: 取消、否認與無法辨識的確認回覆
+func TestRegistrationFlow_CancelAndReject(t *testing.T) {
+	// Arrange
+	f := newRegistrationFixture()
+	_, err := f.start.Execute(StartRegistrationCommand{LineUserID: testLineUserID})
+	require.NoError(t, err)
+	f.send(t, "0912345678")
+
+	// Act
+	unknown := f.send(t, "好喔")
+	rejected := f.send(t, "否")
+	cancelled := f.send(t, "取消")
+
+	// Assert
+	assert.Equal(t, StepConfirmPhoneNumber, unknown.Step)
+	assert.Equal(t, StepAskPhoneNumber, rejected.Step)
+	assert.Equal(t, StepCancelled, cancelled.Step)
+	assert.Empty(t, f.sessions.sessions)
+
+	_, err = f.input.Execute(RegistrationInputCommand{LineUserID: testLineUserID, Text: "0912345678"})
+	assert.ErrorIs(t, err, conversation.ErrSessionNotFound)
+}
+
+// Test 5: 逾時會話結束
+func TestRegistrationFlow_ExpiredSession(t *testing.T) {
+	// Arrange
+	f := newRegistrationFixture()
+	startedAt := time.Now().Add(-conversation.PhoneInputTimeout - time.Minute)
+	session, err := conversation.StartRegistrationSession(testLineUserID, startedAt)
+	require.NoError(t, err)
+	f.sessions.sessions[testLineUserID] = session
+
+	// Act
+	result := f.send(t, "0912345678")
+
+	// Assert
+	assert.Equal(t, StepExpired, result.Step)
+	assert.Empty(t, f.sessions.sessions)
+	assert.Empty(t, f.register.commands)
+}
+
+// Test 6: LINE 帳號已有會員時改為綁定手機號碼；會員已綁定手機號碼時結束會話
+func TestRegistrationFlow_ExistingMember_BindsPhoneNumber(t *testing.T) {
+	// Arrange
+	f := newRegistrationFixture()
+	f.register.err = member.ErrMemberAlreadyExists
+	confirm := func() *RegistrationStepResult {
+		_, err := f.start.Execute(StartRegistrationCommand{LineUserID: testLineUserID})
+		require.NoError(t, err)
+		f.send(t, "0912345678")
+		return f.send(t, "是")
+	}
+
+	// Act
+	bound := confirm()
+	f.bind.err = member.ErrPhoneAlreadyBound
+	alreadyBound := confirm()
+
+	// Assert
+	assert.Equal(t, StepRegistered, bound.Step)
+	assert.Equal(t, "member-1", bound.MemberID)
+	assert.Equal(t, []appmember.BindPhoneNumberCommand{
+		{LineUserID: testLineUserID, PhoneNumber: "0912345678"},
+		{LineUserID: testLineUserID, PhoneNumber: "0912345678"},
+	}, f.bind.commands)
+	assert.Equal(t, StepAlreadyRegistered, alreadyBound.Step)
+	assert.Empty(t, f.sessions.sessions)
+}
+
+// Test 7: 管理員解除綁定後，會員可在 LINE 重新完成註冊並綁定新號碼
+func TestRegistrationFlow_ReRegisterAfterAdminUnbind(t *testing.T) {
+	// Arrange
+	members := NewFakeMemberRepository()
+	txManager := NewMockTransactionManager()
+	registerMember := appmember.NewRegisterMemberUseCase(members, txManager)
+	unbind := appmember.NewUnbindPhoneNumberUseCase(members, &FakePhoneNumberChangeRepository{}, txManager, &FakeEventPublisher{})
+	getMember := appmember.NewGetMemberByLineUserIDUseCase(members)
+	f := newRegistrationFixture()
+	f.input = NewHandleRegistrationInputUseCase(f.sessions, registerMember,
+		appmember.NewBindPhoneNumberUseCase(members, txManager), txManager)
+
+	registered, err := registerMember.Execute(appmember.RegisterMemberCommand{
+		LineUserID: testLineUserID, DisplayName: "王小明", PhoneNumber: "0912345678",
+	})
+	require.NoError(t, err)
+	_, err = unbind.Execute(appmember.UnbindPhoneNumberCommand{
+		MemberID: registered.MemberID, Reason: "門號停用", OperatorID: "admin",
+	})
+	require.NoError(t, err)
+	unbound, err := getMember.Execute(appmember.GetMemberByLineUserIDQuery{LineUserID: testLineUserID})
+	require.NoError(t, err)
+	require.False(t, unbound.IsRegistered)
+
+	// Act
+	_, err = f.start.Execute(StartRegistrationCommand{LineUserID: testLineUserID})
+	require.NoError(t, err)
+	f.send(t, "0987654321")
+	result := f.send(t, "是")
+	rebound, err := getMember.Execute(appmember.GetMemberByLineUserIDQuery{LineUserID: testLineUserID})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, StepRegistered, result.Step)
+	assert.Equal(t, registered.MemberID, result.MemberID)
+	assert.True(t, rebound.IsRegistered)
+	assert.Equal(t, "0987654321", rebound.PhoneNumber)
+	assert.Empty(t, f.sessions.sessions)
+}
+
+// ===========================
+// Mocks
+// ===========================
+
+// MockRegistrationSessionRepository 以 map 保存會話
+type MockRegistrationSessionRepository struct {
+	sessions map[string]*conversation.RegistrationSession
+}
+
+func NewMockRegistrationSessionRepository() *MockRegistrationSessionRepository {
+	return &MockRegistrationSessionRepository{sessions: make(map[string]*conversation.RegistrationSession)}
+}
+
+func (m *MockRegistrationSessionRepository) Save(ctx shared.TransactionContext, s *conversation.RegistrationSession) error {
+	m.sessions[s.LineUserID()] = s
+	return nil
+}
+
+func (m *MockRegistrationSessionRepository) FindByLineUserID(ctx shared.TransactionContext, lineUserID string) (*conversation.RegistrationSession, error) {
+	s, ok := m.sessions[lineUserID]
+	if !ok {
+		return nil, conversation.ErrSessionNotFound
+	}
+	return s, nil
+}
+
+func (m *MockRegistrationSessionRepository) Delete(ctx shared.TransactionContext, lineUserID string) error {
+	delete(m.sessions, lineUserID)
+	return nil
+}
+
+// StubRegisterMember 記錄註冊指令
+type StubRegisterMember struct {
+	commands []appmember.RegisterMemberCommand
+	err      error
+}
+
+func (s *StubRegisterMember) Execute(cmd appmember.RegisterMemberCommand) (*appmember.RegisterMemberResult, error) {
+	s.commands = append(s.commands, cmd)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &appmember.RegisterMemberResult{MemberID: "member-1", LineUserID: cmd.LineUserID}, nil
+}
+
+// StubBindPhoneNumber 記錄綁定指令
+type StubBindPhoneNumber struct {
+	commands []appmember.BindPhoneNumberCommand
+	err      error
+}
+
+func (s *StubBindPhoneNumber) Execute(cmd appmember.BindPhoneNumberCommand) (*appmember.RegisterMemberResult, error) {
+	s.commands = append(s.commands, cmd)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &appmember.RegisterMemberResult{MemberID: "member-1", LineUserID: cmd.LineUserID}, nil
+}
+
+// FakeMemberRepository 以 map 保存會員（Update 檢查載入時版本號）
+type FakeMemberRepository struct {
+	members map[string]*member.Member
+}
+
+func NewFakeMemberRepository() *FakeMemberRepository {
+	return &FakeMemberRepository{members: make(map[string]*member.Member)}
+}
+
+func (r *FakeMemberRepository) Save(ctx shared.TransactionContext, m *member.Member) error {
+	r.members[m.MemberID().String()] = m
+	m.MarkPersisted()
+	return nil
+}
+
+func (r *FakeMemberRepository) Update(ctx shared.TransactionContext, m *member.Member) error {
+	stored, ok := r.members[m.MemberID().String()]
+	if !ok {
+		return member.ErrMemberNotFound
+	}
+	if stored.PersistedVersion() != m.PersistedVersion() {
+		return member.ErrMemberVersionConflict
+	}
+	return r.Save(ctx, m)
+}
+
+func (r *FakeMemberRepository) FindByMemberID(ctx shared.TransactionContext, id member.MemberID) (*member.Member, error) {
+	if m, ok := r.members[id.String()]; ok {
+		return m, nil
+	}
+	return nil, member.ErrMemberNotFound
+}
+
+func (r *FakeMemberRepository) FindByLineUserID(ctx shared.TransactionContext, lineUserID member.LineUserID) (*member.Member, error) {
+	for _, m := range r.members {
+		if m.LineUserID().Equals(lineUserID) {
+			return m, nil
+		}
+	}
+	return nil, member.ErrMemberNotFound
+}
+
+func (r *FakeMemberRepository) ExistsByPhoneNumber(ctx shared.TransactionContext, phoneNumber member.PhoneNumber) (bool, error) {
+	for _, m := range r.members {
+		if m.PhoneNumber().Equals(phoneNumber) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *FakeMemberRepository) ExistsByLineUserID(ctx shared.TransactionContext, lineUserID member.LineUserID) (bool, error) {
+	_, err := r.FindByLineUserID(ctx, lineUserID)
+	return err == nil, nil
+}
+
+// FakePhoneNumberChangeRepository 記錄手機號碼異動紀錄
+type FakePhoneNumberChangeRepository struct {
+	changes []*member.PhoneNumberChange
+}
+
+func (r *FakePhoneNumberChangeRepository) Save(ctx shared.TransactionContext, change *member.PhoneNumberChange) error {
+	r.changes = append(r.changes, change)
+	return nil
+}
+
+func (r *FakePhoneNumberChangeRepository) FindByMemberID(ctx shared.TransactionContext, memberID member.MemberID) ([]*member.PhoneNumberChange, error) {
+	return r.changes, nil
+}
+
+// FakeEventPublisher 記錄發布的事件
+type FakeEventPublisher struct {
+	events []shared.DomainEvent
+}
+
+func (p *FakeEventPublisher) Publish(event shared.DomainEvent) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *FakeEventPublisher) PublishBatch(events []shared.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+// MockTransactionManager 直接執行函數（ctx 為 nil）
+type MockTransactionManager struct {
+	InTransactionCallCount int
+}
+
+func NewMockTransactionManager() *MockTransactionManager {
+	return &MockTransactionManager{}
+}
+
+func (m *MockTransactionManager) InTransaction(fn func(ctx shared.TransactionContext) error) error {
+	m.InTransactionCallCount++
+	return fn(nil)
+}
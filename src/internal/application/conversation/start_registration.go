@@ -0,0 +1,61 @@
+package conversation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/conversation"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// StartRegistration Use Case
+// ===========================
+
+// StartRegistrationCommand 開始註冊對話指令
+type StartRegistrationCommand struct {
+	LineUserID string
+}
+
+// StartRegistrationUseCase 開始註冊對話
+//
+// 使用場景：
+// - 未註冊用戶加入好友
+// - 未註冊用戶輸入「註冊」或使用需要註冊的功能
+//
+// 注意：已有進行中的會話時重新開始（重置重試次數）
+type StartRegistrationUseCase struct {
+	sessionRepo conversation.RegistrationSessionRepository
+	txManager   shared.TransactionManager
+}
+
+// NewStartRegistrationUseCase 創建 Use Case 實例
+func NewStartRegistrationUseCase(
+	sessionRepo conversation.RegistrationSessionRepository,
+	txManager shared.TransactionManager,
+) *StartRegistrationUseCase {
+	return &StartRegistrationUseCase{
+		sessionRepo: sessionRepo,
+		txManager:   txManager,
+	}
+}
+
+// Execute 開始註冊對話
+func (uc *StartRegistrationUseCase) Execute(cmd StartRegistrationCommand) (*RegistrationStepResult, error) {
+	session, err := conversation.StartRegistrationSession(cmd.LineUserID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		return uc.sessionRepo.Save(ctx, session)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save registration session: %w", err)
+	}
+
+	return &RegistrationStepResult{
+		Step:              StepAskPhoneNumber,
+		RemainingAttempts: session.RemainingAttempts(),
+	}, nil
+}
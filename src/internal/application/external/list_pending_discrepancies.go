@@ -0,0 +1,86 @@
+package external
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/external"
+)
+
+// ===========================
+// ListPendingDiscrepancies Query
+// ===========================
+
+// ListPendingDiscrepanciesQuery 查詢待處理審核案件
+type ListPendingDiscrepanciesQuery struct {
+	Limit  int
+	Offset int
+}
+
+// DiscrepancyReviewDTO 審核案件（管理後台顯示用）
+type DiscrepancyReviewDTO struct {
+	ReviewID       string
+	TransactionID  string
+	InvoiceNumber  string
+	PosAmount      int
+	PosInvoiceDate time.Time
+	ScannedAmount  int
+	ScannedDate    time.Time
+	AmountDelta    int
+	DateOffsetDays int
+	CreatedAt      time.Time
+}
+
+// 分頁預設值
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// ListPendingDiscrepanciesUseCase 差異審核佇列查詢 Use Case
+type ListPendingDiscrepanciesUseCase struct {
+	reviewRepo external.DiscrepancyReviewRepository
+}
+
+// NewListPendingDiscrepanciesUseCase 創建 Use Case 實例
+func NewListPendingDiscrepanciesUseCase(reviewRepo external.DiscrepancyReviewRepository) *ListPendingDiscrepanciesUseCase {
+	return &ListPendingDiscrepanciesUseCase{reviewRepo: reviewRepo}
+}
+
+// Execute 執行查詢（先進先審）
+func (uc *ListPendingDiscrepanciesUseCase) Execute(query ListPendingDiscrepanciesQuery) ([]DiscrepancyReviewDTO, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	reviews, err := uc.reviewRepo.FindPending(nil, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending reviews: %w", err)
+	}
+
+	dtos := make([]DiscrepancyReviewDTO, 0, len(reviews))
+	for _, review := range reviews {
+		dtos = append(dtos, DiscrepancyReviewDTO{
+			ReviewID:       review.ReviewID().String(),
+			TransactionID:  review.TransactionID().String(),
+			InvoiceNumber:  review.InvoiceNumber(),
+			PosAmount:      review.PosAmount(),
+			PosInvoiceDate: review.PosInvoiceDate(),
+			ScannedAmount:  review.ScannedAmount(),
+			ScannedDate:    review.ScannedDate(),
+			AmountDelta:    review.AmountDelta(),
+			DateOffsetDays: review.DateOffsetDays(),
+			CreatedAt:      review.CreatedAt(),
+		})
+	}
+
+	return dtos, nil
+}
@@ -0,0 +1,262 @@
+package external
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/external"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// MatchIChefRecord Use Case
+// ===========================
+
+// IChefRecord iChef 匯入記錄（單筆發票）
+//
+// 實作 external.MatchableInvoice 介面
+type IChefRecord struct {
+	InvoiceNumber string
+	InvoiceDate   time.Time
+	Amount        int
+}
+
+// GetAmount 實作 MatchableInvoice 介面
+func (r IChefRecord) GetAmount() int {
+	return r.Amount
+}
+
+// GetInvoiceDate 實作 MatchableInvoice 介面
+func (r IChefRecord) GetInvoiceDate() time.Time {
+	return r.InvoiceDate
+}
+
+// MatchIChefRecordResult 匹配結果
+//
+// 輸出：
+// - Outcome: matched / near_miss / mismatch
+// - ReviewID: near_miss 時開立（或已存在）的審核案件 ID
+// - PointsEarned: matched 時入帳的積分
+type MatchIChefRecordResult struct {
+	TransactionID  string
+	Outcome        string
+	AmountDelta    int
+	DateOffsetDays int
+	ReviewID       string
+	PointsEarned   int
+}
+
+// MatchIChefRecordUseCase 以 iChef 記錄比對會員掃描的發票
+//
+// 職責：
+// 1. 依發票號碼查找交易（只處理 imported 狀態）
+// 2. 依匹配策略判定結果
+//    - matched：驗證交易並發放積分（先前開立的待處理審核案件一併結案）
+//    - near_miss：開立差異審核案件，積分暫不發放
+//    - mismatch：不變更交易，回報差異
+//
+// 設計原則：
+// - 容差由 MatchingPolicy 注入（可由設定檔調整）
+// - 積分倍率由 EarningMultiplierQuery 依會員目前等級提供
+// - 驗證與入帳在同一事務中完成
+//
+// 事件發布：提交後發布 invoice.transaction_verified（結算待發放的問卷獎勵）與 points.earned（積分通知）
+type MatchIChefRecordUseCase struct {
+	transactionRepo invoice.InvoiceTransactionRepository
+	reviewRepo      external.DiscrepancyReviewRepository
+	matcher         *external.InvoiceMatchingService
+	crediter        *invoicePointsCrediter
+	txManager       shared.TransactionManager
+	publisher       shared.EventPublisher
+}
+
+// NewMatchIChefRecordUseCase 創建 Use Case 實例
+func NewMatchIChefRecordUseCase(
+	transactionRepo invoice.InvoiceTransactionRepository,
+	reviewRepo external.DiscrepancyReviewRepository,
+	accountRepo points.PointsAccountRepository,
+	policy external.MatchingPolicy,
+	rate points.ConversionRate,
+	multipliers points.EarningMultiplierQuery,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *MatchIChefRecordUseCase {
+	return &MatchIChefRecordUseCase{
+		transactionRepo: transactionRepo,
+		reviewRepo:      reviewRepo,
+		matcher:         external.NewInvoiceMatchingService(policy),
+		crediter: &invoicePointsCrediter{
+			accountRepo: accountRepo,
+			calculator:  points.NewPointsCalculationService(),
+			rate:        rate,
+			multipliers: multipliers,
+		},
+		txManager: txManager,
+		publisher: publisher,
+	}
+}
+
+// Execute 執行匹配
+//
+// 錯誤處理：
+// - ErrInvalidInvoiceNumber: 發票號碼格式無效
+// - ErrTransactionNotFound: 沒有會員掃描過此發票
+// - ErrInvalidStatusTransition: 交易已驗證或已失敗
+func (uc *MatchIChefRecordUseCase) Execute(record IChefRecord) (*MatchIChefRecordResult, error) {
+	invoiceNumber, err := invoice.NewInvoiceNumber(record.InvoiceNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse invoice number: %w", err)
+	}
+
+	var result *MatchIChefRecordResult
+	var tx *invoice.InvoiceTransaction
+	var account *points.PointsAccount
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		tx, err = uc.transactionRepo.FindByInvoiceNumber(ctx, invoiceNumber)
+		if err != nil {
+			return fmt.Errorf("failed to find transaction: %w", err)
+		}
+
+		if tx.Status() != invoice.TransactionStatusImported {
+			return invoice.ErrInvalidStatusTransition.WithContext(
+				"transaction_id", tx.TransactionID().String(),
+				"status", tx.Status().String(),
+				"reason", "only imported transactions can be matched",
+			)
+		}
+
+		match := uc.matcher.Match(record, tx)
+		result = &MatchIChefRecordResult{
+			TransactionID:  tx.TransactionID().String(),
+			Outcome:        match.Outcome().String(),
+			AmountDelta:    match.AmountDelta(),
+			DateOffsetDays: match.DateOffsetDays(),
+		}
+
+		switch match.Outcome() {
+		case external.MatchOutcomeMatched:
+			credited, earned, err := uc.verifyAndCredit(ctx, tx, record, match)
+			if err != nil {
+				return err
+			}
+			account = credited
+			result.PointsEarned = earned
+
+		case external.MatchOutcomeNearMiss:
+			reviewID, err := uc.openReview(ctx, tx, record, match)
+			if err != nil {
+				return err
+			}
+			result.ReviewID = reviewID
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := publishCreditEvents(uc.publisher, tx, account); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// verifyAndCredit 在容差內自動驗證（以 iChef 資料為準）並入帳
+func (uc *MatchIChefRecordUseCase) verifyAndCredit(
+	ctx shared.TransactionContext,
+	tx *invoice.InvoiceTransaction,
+	record IChefRecord,
+	match external.MatchResult,
+) (*points.PointsAccount, int, error) {
+	var err error
+	if match.IsExact() {
+		err = tx.Verify("ichef_exact_match")
+	} else {
+		posAmount, moneyErr := invoice.NewMoney(record.Amount)
+		if moneyErr != nil {
+			return nil, 0, fmt.Errorf("failed to parse POS amount: %w", moneyErr)
+		}
+		err = tx.VerifyWithPOSData(posAmount, record.InvoiceDate, "ichef_tolerance_match")
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to verify transaction: %w", err)
+	}
+
+	if err := uc.transactionRepo.Update(ctx, tx); err != nil {
+		return nil, 0, fmt.Errorf("failed to update transaction: %w", err)
+	}
+
+	if err := uc.closePendingReview(ctx, tx); err != nil {
+		return nil, 0, err
+	}
+
+	return uc.crediter.credit(ctx, tx)
+}
+
+// closePendingReview 交易已驗證時結案先前開立的待處理審核案件（避免之後被駁回）
+func (uc *MatchIChefRecordUseCase) closePendingReview(ctx shared.TransactionContext, tx *invoice.InvoiceTransaction) error {
+	transactionID, err := external.TransactionIDFromString(tx.TransactionID().String())
+	if err != nil {
+		return fmt.Errorf("failed to parse transaction ID: %w", err)
+	}
+
+	review, err := uc.reviewRepo.FindPendingByTransactionID(ctx, transactionID)
+	if errors.Is(err, external.ErrReviewNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find pending review: %w", err)
+	}
+
+	if err := review.CloseAsVerified(tx.StatusReason()); err != nil {
+		return fmt.Errorf("failed to close review: %w", err)
+	}
+	if err := uc.reviewRepo.Update(ctx, review); err != nil {
+		return fmt.Errorf("failed to update review: %w", err)
+	}
+	return nil
+}
+
+// openReview 開立差異審核案件（同一交易只保留一個待處理案件）
+func (uc *MatchIChefRecordUseCase) openReview(
+	ctx shared.TransactionContext,
+	tx *invoice.InvoiceTransaction,
+	record IChefRecord,
+	match external.MatchResult,
+) (string, error) {
+	transactionID, err := external.TransactionIDFromString(tx.TransactionID().String())
+	if err != nil {
+		return "", fmt.Errorf("failed to parse transaction ID: %w", err)
+	}
+
+	existing, err := uc.reviewRepo.FindPendingByTransactionID(ctx, transactionID)
+	if err == nil {
+		return existing.ReviewID().String(), nil
+	}
+	if !errors.Is(err, external.ErrReviewNotFound) {
+		return "", fmt.Errorf("failed to find pending review: %w", err)
+	}
+
+	review, err := external.OpenDiscrepancyReview(
+		transactionID,
+		tx.InvoiceNumber().String(),
+		record,
+		tx,
+		match,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to open review: %w", err)
+	}
+
+	if err := uc.reviewRepo.Save(ctx, review); err != nil {
+		return "", fmt.Errorf("failed to save review: %w", err)
+	}
+
+	return review.ReviewID().String(), nil
+}
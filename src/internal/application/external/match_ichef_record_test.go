@@ -0,0 +1,378 @@
+package external
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/external"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// MatchIChefRecord Use Case 測試
+// ===========================
+
+var testInvoiceDate = time.Date(2025, 1, 15, 20, 0, 0, 0, time.UTC)
+
+// testFixture 測試共用依賴
+type testFixture struct {
+	transactionRepo *MockInvoiceTransactionRepository
+	reviewRepo      *MockDiscrepancyReviewRepository
+	accountRepo     *MockPointsAccountRepository
+	txManager       *MockTransactionManager
+	multipliers     *StubEarningMultiplierQuery
+	publisher       *FakeEventPublisher
+	rate            points.ConversionRate
+}
+
+func newTestFixture(t *testing.T) *testFixture {
+	rate, err := points.NewConversionRate(100)
+	require.NoError(t, err)
+	return &testFixture{
+		transactionRepo: NewMockInvoiceTransactionRepository(),
+		reviewRepo:      NewMockDiscrepancyReviewRepository(),
+		accountRepo:     NewMockPointsAccountRepository(),
+		txManager:       NewMockTransactionManager(),
+		multipliers:     &StubEarningMultiplierQuery{multipliers: map[string]points.EarningMultiplier{}},
+		publisher:       &FakeEventPublisher{},
+		rate:            rate,
+	}
+}
+
+// givenScannedInvoice 建立會員、積分帳戶及掃描的發票（AB12345678，1000 元）
+func (f *testFixture) givenScannedInvoice(t *testing.T) *invoice.InvoiceTransaction {
+	memberID := invoice.NewMemberID()
+	pointsMemberID, err := points.MemberIDFromString(memberID.String())
+	require.NoError(t, err)
+	account, err := points.NewPointsAccount(pointsMemberID)
+	require.NoError(t, err)
+	account.PullEvents()
+	f.accountRepo.accounts[pointsMemberID.String()] = account
+
+	number, _ := invoice.NewInvoiceNumber("AB12345678")
+	amount, _ := invoice.NewMoney(1000)
+	tx, err := invoice.NewInvoiceTransaction(memberID, number, testInvoiceDate, amount)
+	require.NoError(t, err)
+	require.NoError(t, f.transactionRepo.Save(nil, tx))
+	tx.PullEvents()
+	return tx
+}
+
+func (f *testFixture) balanceOf(t *testing.T, tx *invoice.InvoiceTransaction) int {
+	memberID, _ := points.MemberIDFromString(tx.MemberID().String())
+	account, err := f.accountRepo.FindByMemberID(nil, memberID)
+	require.NoError(t, err)
+	return account.GetAvailablePoints().Value()
+}
+
+func (f *testFixture) matchUseCase() *MatchIChefRecordUseCase {
+	return NewMatchIChefRecordUseCase(
+		f.transactionRepo,
+		f.reviewRepo,
+		f.accountRepo,
+		external.DefaultMatchingPolicy(),
+		f.rate,
+		f.multipliers,
+		f.txManager,
+		f.publisher,
+	)
+}
+
+// Test 1: 完全匹配 → 驗證並發放積分
+func TestMatchIChefRecordUseCase_ExactMatch_VerifiesAndCreditsPoints(t *testing.T) {
+	// Arrange
+	f := newTestFixture(t)
+	tx := f.givenScannedInvoice(t)
+	useCase := f.matchUseCase()
+
+	// Act
+	result, err := useCase.Execute(IChefRecord{
+		InvoiceNumber: "ab-12345678",
+		InvoiceDate:   testInvoiceDate,
+		Amount:        1000,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "matched", result.Outcome)
+	assert.Equal(t, 10, result.PointsEarned)
+	assert.Empty(t, result.ReviewID)
+	assert.True(t, tx.IsVerified())
+	assert.Equal(t, 10, f.balanceOf(t, tx))
+	assert.Equal(t, 1, f.txManager.InTransactionCallCount)
+	require.Len(t, f.publisher.events, 2)
+	assert.Equal(t, "invoice.transaction_verified", f.publisher.events[0].EventType())
+	assert.Equal(t, "points.earned", f.publisher.events[1].EventType())
+}
+
+// Test 2: 容差內差異 → 開立審核案件，積分暫不發放
+func TestMatchIChefRecordUseCase_NearMiss_OpensReview(t *testing.T) {
+	// Arrange
+	f := newTestFixture(t)
+	tx := f.givenScannedInvoice(t)
+	useCase := f.matchUseCase()
+	record := IChefRecord{InvoiceNumber: "AB12345678", InvoiceDate: testInvoiceDate, Amount: 1040}
+
+	// Act
+	result, err := useCase.Execute(record)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "near_miss", result.Outcome)
+	assert.Equal(t, 40, result.AmountDelta)
+	assert.NotEmpty(t, result.ReviewID)
+	assert.Equal(t, invoice.TransactionStatusImported, tx.Status())
+	assert.Equal(t, 0, f.balanceOf(t, tx))
+	assert.Len(t, f.reviewRepo.reviews, 1)
+	assert.Empty(t, f.publisher.events)
+
+	// 重複匯入不重複開立案件
+	again, err := useCase.Execute(record)
+	require.NoError(t, err)
+	assert.Equal(t, result.ReviewID, again.ReviewID)
+	assert.Len(t, f.reviewRepo.reviews, 1)
+}
+
+// Test 3: 超出容差 → 不變更交易
+func TestMatchIChefRecordUseCase_Mismatch_LeavesTransactionImported(t *testing.T) {
+	// Arrange
+	f := newTestFixture(t)
+	tx := f.givenScannedInvoice(t)
+	useCase := f.matchUseCase()
+
+	// Act
+	result, err := useCase.Execute(IChefRecord{
+		InvoiceNumber: "AB12345678",
+		InvoiceDate:   testInvoiceDate.AddDate(0, 0, 3),
+		Amount:        1000,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "mismatch", result.Outcome)
+	assert.Equal(t, invoice.TransactionStatusImported, tx.Status())
+	assert.Empty(t, f.reviewRepo.reviews)
+}
+
+// Test 4: 找不到交易
+func TestMatchIChefRecordUseCase_UnknownInvoice_ReturnsError(t *testing.T) {
+	// Arrange
+	f := newTestFixture(t)
+	useCase := f.matchUseCase()
+
+	// Act
+	result, err := useCase.Execute(IChefRecord{InvoiceNumber: "ZZ00000000", InvoiceDate: testInvoiceDate, Amount: 1})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, invoice.ErrTransactionNotFound))
+}
+
+// Test 5: 會員等級倍率加成入帳積分
+func TestMatchIChefRecordUseCase_ExactMatch_AppliesTierMultiplier(t *testing.T) {
+	// Arrange
+	f := newTestFixture(t)
+	tx := f.givenScannedInvoice(t)
+	gold, err := points.NewEarningMultiplier(decimal.RequireFromString("1.5"))
+	require.NoError(t, err)
+	f.multipliers.multipliers[tx.MemberID().String()] = gold
+
+	// Act
+	result, err := f.matchUseCase().Execute(IChefRecord{
+		InvoiceNumber: "AB12345678",
+		InvoiceDate:   testInvoiceDate,
+		Amount:        1000,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 15, result.PointsEarned)
+	assert.Equal(t, 15, f.balanceOf(t, tx))
+}
+
+// ===========================
+// Mock Repositories
+// ===========================
+
+type MockInvoiceTransactionRepository struct {
+	transactions map[string]*invoice.InvoiceTransaction
+}
+
+func NewMockInvoiceTransactionRepository() *MockInvoiceTransactionRepository {
+	return &MockInvoiceTransactionRepository{
+		transactions: make(map[string]*invoice.InvoiceTransaction),
+	}
+}
+
+func (m *MockInvoiceTransactionRepository) Save(ctx shared.TransactionContext, tx *invoice.InvoiceTransaction) error {
+	for _, existing := range m.transactions {
+		if existing.InvoiceNumber().Equals(tx.InvoiceNumber()) {
+			return invoice.ErrDuplicateInvoice
+		}
+	}
+	m.transactions[tx.TransactionID().String()] = tx
+	return nil
+}
+
+func (m *MockInvoiceTransactionRepository) Update(ctx shared.TransactionContext, tx *invoice.InvoiceTransaction) error {
+	if _, exists := m.transactions[tx.TransactionID().String()]; !exists {
+		return invoice.ErrTransactionNotFound
+	}
+	m.transactions[tx.TransactionID().String()] = tx
+	return nil
+}
+
+func (m *MockInvoiceTransactionRepository) FindByID(ctx shared.TransactionContext, id invoice.TransactionID) (*invoice.InvoiceTransaction, error) {
+	if tx, exists := m.transactions[id.String()]; exists {
+		return tx, nil
+	}
+	return nil, invoice.ErrTransactionNotFound
+}
+
+func (m *MockInvoiceTransactionRepository) FindByInvoiceNumber(ctx shared.TransactionContext, number invoice.InvoiceNumber) (*invoice.InvoiceTransaction, error) {
+	for _, tx := range m.transactions {
+		if tx.InvoiceNumber().Equals(number) {
+			return tx, nil
+		}
+	}
+	return nil, invoice.ErrTransactionNotFound
+}
+
+type MockDiscrepancyReviewRepository struct {
+	reviews map[string]*external.DiscrepancyReview
+}
+
+func NewMockDiscrepancyReviewRepository() *MockDiscrepancyReviewRepository {
+	return &MockDiscrepancyReviewRepository{
+		reviews: make(map[string]*external.DiscrepancyReview),
+	}
+}
+
+func (m *MockDiscrepancyReviewRepository) Save(ctx shared.TransactionContext, review *external.DiscrepancyReview) error {
+	m.reviews[review.ReviewID().String()] = review
+	return nil
+}
+
+func (m *MockDiscrepancyReviewRepository) Update(ctx shared.TransactionContext, review *external.DiscrepancyReview) error {
+	if _, exists := m.reviews[review.ReviewID().String()]; !exists {
+		return external.ErrReviewNotFound
+	}
+	m.reviews[review.ReviewID().String()] = review
+	return nil
+}
+
+func (m *MockDiscrepancyReviewRepository) FindByID(ctx shared.TransactionContext, id external.ReviewID) (*external.DiscrepancyReview, error) {
+	if review, exists := m.reviews[id.String()]; exists {
+		return review, nil
+	}
+	return nil, external.ErrReviewNotFound
+}
+
+func (m *MockDiscrepancyReviewRepository) FindPendingByTransactionID(ctx shared.TransactionContext, transactionID external.TransactionID) (*external.DiscrepancyReview, error) {
+	for _, review := range m.reviews {
+		if review.IsPending() && review.TransactionID().Equals(transactionID) {
+			return review, nil
+		}
+	}
+	return nil, external.ErrReviewNotFound
+}
+
+func (m *MockDiscrepancyReviewRepository) FindPending(ctx shared.TransactionContext, limit, offset int) ([]*external.DiscrepancyReview, error) {
+	pending := make([]*external.DiscrepancyReview, 0)
+	for _, review := range m.reviews {
+		if review.IsPending() {
+			pending = append(pending, review)
+		}
+	}
+	return pending, nil
+}
+
+type MockPointsAccountRepository struct {
+	accounts map[string]*points.PointsAccount
+}
+
+func NewMockPointsAccountRepository() *MockPointsAccountRepository {
+	return &MockPointsAccountRepository{
+		accounts: make(map[string]*points.PointsAccount),
+	}
+}
+
+func (m *MockPointsAccountRepository) Save(ctx shared.TransactionContext, account *points.PointsAccount) error {
+	m.accounts[account.MemberID().String()] = account
+	return nil
+}
+
+func (m *MockPointsAccountRepository) FindByID(ctx shared.TransactionContext, accountID points.AccountID) (*points.PointsAccount, error) {
+	for _, account := range m.accounts {
+		if account.AccountID().Equals(accountID) {
+			return account, nil
+		}
+	}
+	return nil, points.ErrAccountNotFound
+}
+
+func (m *MockPointsAccountRepository) FindByMemberID(ctx shared.TransactionContext, memberID points.MemberID) (*points.PointsAccount, error) {
+	if account, exists := m.accounts[memberID.String()]; exists {
+		return account, nil
+	}
+	return nil, points.ErrAccountNotFound
+}
+
+func (m *MockPointsAccountRepository) Update(ctx shared.TransactionContext, account *points.PointsAccount) error {
+	m.accounts[account.MemberID().String()] = account
+	return nil
+}
+
+// StubEarningMultiplierQuery 以會員 ID 為鍵（未設定的會員為基本倍率）
+type StubEarningMultiplierQuery struct {
+	multipliers map[string]points.EarningMultiplier
+}
+
+func (s *StubEarningMultiplierQuery) MultiplierFor(ctx shared.TransactionContext, memberID points.MemberID) (points.EarningMultiplier, error) {
+	if multiplier, ok := s.multipliers[memberID.String()]; ok {
+		return multiplier, nil
+	}
+	return points.BaseEarningMultiplier(), nil
+}
+
+// ===========================
+// Fake EventPublisher
+// ===========================
+
+// FakeEventPublisher 記錄發布的事件
+type FakeEventPublisher struct {
+	events []shared.DomainEvent
+}
+
+func (p *FakeEventPublisher) Publish(event shared.DomainEvent) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *FakeEventPublisher) PublishBatch(events []shared.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+// ===========================
+// Mock TransactionManager
+// ===========================
+
+type MockTransactionManager struct {
+	InTransactionCallCount int
+}
+
+func NewMockTransactionManager() *MockTransactionManager {
+	return &MockTransactionManager{}
+}
+
+func (m *MockTransactionManager) InTransaction(fn func(ctx shared.TransactionContext) error) error {
+	m.InTransactionCallCount++
+	return fn(nil)
+}
@@ -0,0 +1,101 @@
+package external
+
+import (
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/shopspring/decimal"
+)
+
+// ===========================
+// 發票積分入帳（Use Case 共用）
+// ===========================
+
+// invoicePointsCrediter 依已驗證的發票交易為會員入帳積分
+//
+// 職責：
+// - 跨上下文轉換 MemberID（invoice → points，透過 String()）
+// - 依金額、轉換率與會員等級倍率計算積分（PointsCalculationService）
+// - EarnPoints(PointsSourceInvoice, transactionID) 並更新帳戶
+//
+// 設計原則：
+// - 在調用者的事務中執行（與交易狀態變更同一事務，避免驗證成功但積分未入帳）
+// - 返回入帳的帳戶，由調用者在提交後發布帳戶事件（points.earned）
+type invoicePointsCrediter struct {
+	accountRepo points.PointsAccountRepository
+	calculator  *points.PointsCalculationService
+	rate        points.ConversionRate
+	multipliers points.EarningMultiplierQuery
+}
+
+// credit 為已驗證的交易入帳積分，返回入帳的帳戶（含待發布事件）與入帳點數
+func (c *invoicePointsCrediter) credit(
+	ctx shared.TransactionContext,
+	tx *invoice.InvoiceTransaction,
+) (*points.PointsAccount, int, error) {
+	memberID, err := points.MemberIDFromString(tx.MemberID().String())
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse member ID: %w", err)
+	}
+
+	account, err := c.accountRepo.FindByMemberID(ctx, memberID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to find account: %w", err)
+	}
+
+	multiplier, err := c.multipliers.MultiplierFor(ctx, memberID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to find earning multiplier: %w", err)
+	}
+
+	amount, err := c.calculator.CalculateWithMultiplier(
+		decimal.NewFromInt(int64(tx.GetAmount())),
+		c.rate,
+		multiplier,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to calculate points: %w", err)
+	}
+
+	description := fmt.Sprintf("發票 %s 消費積分", tx.InvoiceNumber().String())
+	if err := account.EarnPoints(
+		amount,
+		points.PointsSourceInvoice,
+		tx.TransactionID().String(),
+		description,
+	); err != nil {
+		return nil, 0, fmt.Errorf("failed to earn points: %w", err)
+	}
+
+	if err := c.accountRepo.Update(ctx, account); err != nil {
+		return nil, 0, fmt.Errorf("failed to update account: %w", err)
+	}
+
+	return account, amount.Value(), nil
+}
+
+// publishCreditEvents 提交後發布交易事件（invoice.transaction_verified）與帳戶事件（points.earned）
+//
+// account 為 nil 表示本次未入帳（例如開立差異審核案件），只發布交易事件
+func publishCreditEvents(
+	publisher shared.EventPublisher,
+	tx *invoice.InvoiceTransaction,
+	account *points.PointsAccount,
+) error {
+	if events := tx.PullEvents(); len(events) > 0 {
+		if err := publisher.PublishBatch(events); err != nil {
+			return fmt.Errorf("failed to publish transaction events: %w", err)
+		}
+	}
+	if account == nil {
+		return nil
+	}
+	if events := account.PullEvents(); len(events) > 0 {
+		if err := publisher.PublishBatch(events); err != nil {
+			return fmt.Errorf("failed to publish points events: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package external
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/external"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// ResolveDiscrepancy Use Cases（核准 / 駁回）
+// ===========================
+
+// ResolveDiscrepancyCommand 處理差異審核案件的命令
+//
+// 輸入：
+// - ReviewID: 審核案件 ID
+// - ResolvedBy: 審核人員（管理員帳號，必填，審計用途）
+// - Note: 審核備註
+type ResolveDiscrepancyCommand struct {
+	ReviewID   string
+	ResolvedBy string
+	Note       string
+}
+
+// ResolveDiscrepancyResult 處理結果
+type ResolveDiscrepancyResult struct {
+	ReviewID          string
+	TransactionID     string
+	Status            string
+	TransactionStatus string
+	ResolvedBy        string
+	ResolvedAt        time.Time
+	PointsEarned      int
+}
+
+// ApproveDiscrepancyUseCase 核准差異審核案件
+//
+// 業務規則：
+// - 以 iChef 資料（金額、日期）校正並驗證交易
+// - 依校正後金額與會員等級倍率發放積分
+// - 記錄審核人員
+//
+// 事件發布：提交後發布 invoice.transaction_verified（結算待發放的問卷獎勵）與 points.earned（積分通知）
+type ApproveDiscrepancyUseCase struct {
+	reviewRepo      external.DiscrepancyReviewRepository
+	transactionRepo invoice.InvoiceTransactionRepository
+	crediter        *invoicePointsCrediter
+	txManager       shared.TransactionManager
+	publisher       shared.EventPublisher
+}
+
+// NewApproveDiscrepancyUseCase 創建 Use Case 實例
+func NewApproveDiscrepancyUseCase(
+	reviewRepo external.DiscrepancyReviewRepository,
+	transactionRepo invoice.InvoiceTransactionRepository,
+	accountRepo points.PointsAccountRepository,
+	rate points.ConversionRate,
+	multipliers points.EarningMultiplierQuery,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *ApproveDiscrepancyUseCase {
+	return &ApproveDiscrepancyUseCase{
+		reviewRepo:      reviewRepo,
+		transactionRepo: transactionRepo,
+		crediter: &invoicePointsCrediter{
+			accountRepo: accountRepo,
+			calculator:  points.NewPointsCalculationService(),
+			rate:        rate,
+			multipliers: multipliers,
+		},
+		txManager: txManager,
+		publisher: publisher,
+	}
+}
+
+// Execute 執行核准
+//
+// 錯誤處理：
+// - ErrReviewNotFound: 案件不存在
+// - ErrReviewAlreadyClosed: 案件已結案
+// - ErrReviewerRequired: 未提供審核人員
+func (uc *ApproveDiscrepancyUseCase) Execute(cmd ResolveDiscrepancyCommand) (*ResolveDiscrepancyResult, error) {
+	reviewID, err := external.ReviewIDFromString(cmd.ReviewID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse review ID: %w", err)
+	}
+
+	var result *ResolveDiscrepancyResult
+	var tx *invoice.InvoiceTransaction
+	var account *points.PointsAccount
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		var review *external.DiscrepancyReview
+		review, tx, err = loadReviewAndTransaction(ctx, uc.reviewRepo, uc.transactionRepo, reviewID)
+		if err != nil {
+			return err
+		}
+
+		if err := review.Approve(cmd.ResolvedBy, cmd.Note); err != nil {
+			return fmt.Errorf("failed to approve review: %w", err)
+		}
+
+		posAmount, err := invoice.NewMoney(review.PosAmount())
+		if err != nil {
+			return fmt.Errorf("failed to parse POS amount: %w", err)
+		}
+		if err := tx.VerifyWithPOSData(posAmount, review.PosInvoiceDate(), "discrepancy_approved"); err != nil {
+			return fmt.Errorf("failed to verify transaction: %w", err)
+		}
+
+		if err := uc.transactionRepo.Update(ctx, tx); err != nil {
+			return fmt.Errorf("failed to update transaction: %w", err)
+		}
+
+		var earned int
+		account, earned, err = uc.crediter.credit(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.reviewRepo.Update(ctx, review); err != nil {
+			return fmt.Errorf("failed to update review: %w", err)
+		}
+
+		result = newResolveDiscrepancyResult(review, tx)
+		result.PointsEarned = earned
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := publishCreditEvents(uc.publisher, tx, account); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RejectDiscrepancyUseCase 駁回差異審核案件
+//
+// 業務規則：
+// - 交易標記為 failed，積分不發放
+// - 交易須仍為 imported；已驗證（已入帳）的交易不可駁回
+// - 記錄審核人員
+type RejectDiscrepancyUseCase struct {
+	reviewRepo      external.DiscrepancyReviewRepository
+	transactionRepo invoice.InvoiceTransactionRepository
+	txManager       shared.TransactionManager
+}
+
+// NewRejectDiscrepancyUseCase 創建 Use Case 實例
+func NewRejectDiscrepancyUseCase(
+	reviewRepo external.DiscrepancyReviewRepository,
+	transactionRepo invoice.InvoiceTransactionRepository,
+	txManager shared.TransactionManager,
+) *RejectDiscrepancyUseCase {
+	return &RejectDiscrepancyUseCase{
+		reviewRepo:      reviewRepo,
+		transactionRepo: transactionRepo,
+		txManager:       txManager,
+	}
+}
+
+// Execute 執行駁回
+//
+// 錯誤處理：
+// - ErrReviewNotFound: 案件不存在
+// - ErrReviewAlreadyClosed: 案件已結案
+// - ErrInvalidStatusTransition: 交易已驗證或已失敗（事務回滾，案件維持待處理）
+func (uc *RejectDiscrepancyUseCase) Execute(cmd ResolveDiscrepancyCommand) (*ResolveDiscrepancyResult, error) {
+	reviewID, err := external.ReviewIDFromString(cmd.ReviewID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse review ID: %w", err)
+	}
+
+	var result *ResolveDiscrepancyResult
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		review, tx, err := loadReviewAndTransaction(ctx, uc.reviewRepo, uc.transactionRepo, reviewID)
+		if err != nil {
+			return err
+		}
+
+		if err := review.Reject(cmd.ResolvedBy, cmd.Note); err != nil {
+			return fmt.Errorf("failed to reject review: %w", err)
+		}
+
+		if tx.Status() != invoice.TransactionStatusImported {
+			return invoice.ErrInvalidStatusTransition.WithContext(
+				"transaction_id", tx.TransactionID().String(),
+				"status", tx.Status().String(),
+				"reason", "only imported transactions can be rejected",
+			)
+		}
+
+		if err := tx.Fail("discrepancy_rejected"); err != nil {
+			return fmt.Errorf("failed to fail transaction: %w", err)
+		}
+
+		if err := uc.transactionRepo.Update(ctx, tx); err != nil {
+			return fmt.Errorf("failed to update transaction: %w", err)
+		}
+
+		if err := uc.reviewRepo.Update(ctx, review); err != nil {
+			return fmt.Errorf("failed to update review: %w", err)
+		}
+
+		result = newResolveDiscrepancyResult(review, tx)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// loadReviewAndTransaction 載入審核案件及其對應交易
+func loadReviewAndTransaction(
+	ctx shared.TransactionContext,
+	reviewRepo external.DiscrepancyReviewRepository,
+	transactionRepo invoice.InvoiceTransactionRepository,
+	reviewID external.ReviewID,
+) (*external.DiscrepancyReview, *invoice.InvoiceTransaction, error) {
+	review, err := reviewRepo.FindByID(ctx, reviewID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find review: %w", err)
+	}
+
+	transactionID, err := invoice.TransactionIDFromString(review.TransactionID().String())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse transaction ID: %w", err)
+	}
+
+	tx, err := transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find transaction: %w", err)
+	}
+
+	return review, tx, nil
+}
+
+// newResolveDiscrepancyResult 構建處理結果
+func newResolveDiscrepancyResult(
+	review *external.DiscrepancyReview,
+	tx *invoice.InvoiceTransaction,
+) *ResolveDiscrepancyResult {
+	result := &ResolveDiscrepancyResult{
+		ReviewID:          review.ReviewID().String(),
+		TransactionID:     tx.TransactionID().String(),
+		Status:            review.Status().String(),
+		TransactionStatus: tx.Status().String(),
+		ResolvedBy:        review.ResolvedBy(),
+	}
+	if review.ResolvedAt() != nil {
+		result.ResolvedAt = *review.ResolvedAt()
+	}
+	return result
+}
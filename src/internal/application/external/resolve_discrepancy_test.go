@@ -0,0 +1,179 @@
+package external
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/external"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// Approve / Reject Discrepancy Use Case 測試
+// ===========================
+
+// givenOpenReview 建立掃描 1000 元、iChef 1040 元的待審核案件
+func (f *testFixture) givenOpenReview(t *testing.T) (*invoice.InvoiceTransaction, string) {
+	tx := f.givenScannedInvoice(t)
+	result, err := f.matchUseCase().Execute(IChefRecord{
+		InvoiceNumber: "AB12345678",
+		InvoiceDate:   testInvoiceDate,
+		Amount:        1040,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, result.ReviewID)
+	return tx, result.ReviewID
+}
+
+// Test 1: 核准 → 以 iChef 金額驗證並發放積分
+func TestApproveDiscrepancyUseCase_CreditsPointsWithPOSAmount(t *testing.T) {
+	// Arrange
+	f := newTestFixture(t)
+	tx, reviewID := f.givenOpenReview(t)
+	useCase := NewApproveDiscrepancyUseCase(f.reviewRepo, f.transactionRepo, f.accountRepo, f.rate, f.multipliers, f.txManager, f.publisher)
+
+	// Act
+	result, err := useCase.Execute(ResolveDiscrepancyCommand{
+		ReviewID:   reviewID,
+		ResolvedBy: "manager01",
+		Note:       "POS 含服務費",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "approved", result.Status)
+	assert.Equal(t, "verified", result.TransactionStatus)
+	assert.Equal(t, "manager01", result.ResolvedBy)
+	assert.False(t, result.ResolvedAt.IsZero())
+	assert.Equal(t, 10, result.PointsEarned)
+	assert.Equal(t, 1040, tx.GetAmount())
+	assert.Equal(t, 10, f.balanceOf(t, tx))
+	require.Len(t, f.publisher.events, 2)
+	assert.Equal(t, "invoice.transaction_verified", f.publisher.events[0].EventType())
+	assert.Equal(t, "points.earned", f.publisher.events[1].EventType())
+}
+
+// Test 2: 駁回 → 交易失敗，不發放積分
+func TestRejectDiscrepancyUseCase_WithholdsPoints(t *testing.T) {
+	// Arrange
+	f := newTestFixture(t)
+	tx, reviewID := f.givenOpenReview(t)
+	useCase := NewRejectDiscrepancyUseCase(f.reviewRepo, f.transactionRepo, f.txManager)
+
+	// Act
+	result, err := useCase.Execute(ResolveDiscrepancyCommand{
+		ReviewID:   reviewID,
+		ResolvedBy: "manager01",
+		Note:       "非本店發票",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "rejected", result.Status)
+	assert.Equal(t, invoice.TransactionStatusFailed, tx.Status())
+	assert.Equal(t, 0, f.balanceOf(t, tx))
+	review, _ := f.reviewRepo.FindByID(nil, mustReviewID(t, reviewID))
+	assert.Equal(t, "manager01", review.ResolvedBy())
+}
+
+// Test 3: 未提供審核人員
+func TestApproveDiscrepancyUseCase_MissingResolver_ReturnsError(t *testing.T) {
+	// Arrange
+	f := newTestFixture(t)
+	tx, reviewID := f.givenOpenReview(t)
+	useCase := NewApproveDiscrepancyUseCase(f.reviewRepo, f.transactionRepo, f.accountRepo, f.rate, f.multipliers, f.txManager, f.publisher)
+
+	// Act
+	result, err := useCase.Execute(ResolveDiscrepancyCommand{ReviewID: reviewID})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, external.ErrReviewerRequired))
+	assert.Equal(t, invoice.TransactionStatusImported, tx.Status())
+}
+
+// Test 4: 已結案的案件不可再次處理
+func TestRejectDiscrepancyUseCase_AlreadyApproved_ReturnsError(t *testing.T) {
+	// Arrange
+	f := newTestFixture(t)
+	_, reviewID := f.givenOpenReview(t)
+	approve := NewApproveDiscrepancyUseCase(f.reviewRepo, f.transactionRepo, f.accountRepo, f.rate, f.multipliers, f.txManager, f.publisher)
+	_, err := approve.Execute(ResolveDiscrepancyCommand{ReviewID: reviewID, ResolvedBy: "owner"})
+	require.NoError(t, err)
+	reject := NewRejectDiscrepancyUseCase(f.reviewRepo, f.transactionRepo, f.txManager)
+
+	// Act
+	_, err = reject.Execute(ResolveDiscrepancyCommand{ReviewID: reviewID, ResolvedBy: "owner"})
+
+	// Assert
+	assert.True(t, errors.Is(err, external.ErrReviewAlreadyClosed))
+}
+
+// Test 5: 審核佇列只列出待處理案件
+func TestListPendingDiscrepanciesUseCase_ReturnsPendingOnly(t *testing.T) {
+	// Arrange
+	f := newTestFixture(t)
+	_, reviewID := f.givenOpenReview(t)
+	useCase := NewListPendingDiscrepanciesUseCase(f.reviewRepo)
+
+	// Act
+	pending, err := useCase.Execute(ListPendingDiscrepanciesQuery{})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, reviewID, pending[0].ReviewID)
+	assert.Equal(t, 40, pending[0].AmountDelta)
+}
+
+// Test 6: 重新匯入完全匹配 → 交易驗證入帳並結案審核案件；之後不可駁回，積分保留
+func TestRejectDiscrepancyUseCase_AfterReimportMatch_ReviewClosed(t *testing.T) {
+	// Arrange
+	f := newTestFixture(t)
+	tx, reviewID := f.givenOpenReview(t)
+	_, err := f.matchUseCase().Execute(IChefRecord{
+		InvoiceNumber: "AB12345678",
+		InvoiceDate:   testInvoiceDate,
+		Amount:        1000,
+	})
+	require.NoError(t, err)
+	reject := NewRejectDiscrepancyUseCase(f.reviewRepo, f.transactionRepo, f.txManager)
+
+	// Act
+	_, err = reject.Execute(ResolveDiscrepancyCommand{ReviewID: reviewID, ResolvedBy: "manager01"})
+
+	// Assert
+	assert.True(t, errors.Is(err, external.ErrReviewAlreadyClosed))
+	review, _ := f.reviewRepo.FindByID(nil, mustReviewID(t, reviewID))
+	assert.Equal(t, external.ReviewStatusApproved, review.Status())
+	assert.Equal(t, external.SystemResolver, review.ResolvedBy())
+	assert.Equal(t, invoice.TransactionStatusVerified, tx.Status())
+	assert.Equal(t, 10, f.balanceOf(t, tx))
+}
+
+// Test 7: 交易已驗證但案件仍待處理 → 拒絕駁回，交易與積分不變
+func TestRejectDiscrepancyUseCase_TransactionAlreadyVerified_ReturnsError(t *testing.T) {
+	// Arrange
+	f := newTestFixture(t)
+	tx, reviewID := f.givenOpenReview(t)
+	require.NoError(t, tx.Verify("manual"))
+	require.NoError(t, f.transactionRepo.Update(nil, tx))
+	reject := NewRejectDiscrepancyUseCase(f.reviewRepo, f.transactionRepo, f.txManager)
+
+	// Act
+	result, err := reject.Execute(ResolveDiscrepancyCommand{ReviewID: reviewID, ResolvedBy: "manager01"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, invoice.ErrInvalidStatusTransition))
+	assert.Equal(t, invoice.TransactionStatusVerified, tx.Status())
+	assert.Equal(t, "manual", tx.StatusReason())
+}
+
+func mustReviewID(t *testing.T, s string) external.ReviewID {
+	id, err := external.ReviewIDFromString(s)
+	require.NoError(t, err)
+	return id
+}
@@ -0,0 +1,244 @@
+package fraud
+
+import (
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/fraud"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// ResolveFraudCase Use Cases（排除 / 確認）
+// ===========================
+
+// ResolveFraudCaseCommand 處理調查案件的命令
+//
+// 輸入：
+// - CaseID: 調查案件 ID
+// - ResolvedBy: 處理人員（必填，審計用途）
+// - Note: 處理備註
+// - FreezeAccount: 確認詐騙時是否凍結積分帳戶（僅 Confirm 使用）
+type ResolveFraudCaseCommand struct {
+	CaseID        string
+	ResolvedBy    string
+	Note          string
+	FreezeAccount bool
+}
+
+// ResolveFraudCaseResult 處理結果
+type ResolveFraudCaseResult struct {
+	CaseID            string
+	Status            string
+	TransactionStatus string
+	AccountFrozen     bool
+}
+
+// ClearFraudCaseUseCase 排除嫌疑
+//
+// 業務規則：
+// - 暫停中的交易恢復為 imported（等待 iChef 驗證）
+// - 已被 block 的交易維持 failed（發票號碼唯一，無法恢復自動處理）
+type ClearFraudCaseUseCase struct {
+	caseRepo        fraud.FraudCaseRepository
+	transactionRepo invoice.InvoiceTransactionRepository
+	txManager       shared.TransactionManager
+}
+
+// NewClearFraudCaseUseCase 創建 Use Case 實例
+func NewClearFraudCaseUseCase(
+	caseRepo fraud.FraudCaseRepository,
+	transactionRepo invoice.InvoiceTransactionRepository,
+	txManager shared.TransactionManager,
+) *ClearFraudCaseUseCase {
+	return &ClearFraudCaseUseCase{
+		caseRepo:        caseRepo,
+		transactionRepo: transactionRepo,
+		txManager:       txManager,
+	}
+}
+
+// Execute 執行排除嫌疑
+func (uc *ClearFraudCaseUseCase) Execute(cmd ResolveFraudCaseCommand) (*ResolveFraudCaseResult, error) {
+	caseID, err := fraud.CaseIDFromString(cmd.CaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse case ID: %w", err)
+	}
+
+	var result *ResolveFraudCaseResult
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		fraudCase, tx, err := loadCaseAndTransaction(ctx, uc.caseRepo, uc.transactionRepo, caseID)
+		if err != nil {
+			return err
+		}
+
+		if err := fraudCase.Clear(cmd.ResolvedBy, cmd.Note); err != nil {
+			return fmt.Errorf("failed to clear fraud case: %w", err)
+		}
+
+		if tx.IsHeld() {
+			if err := tx.Release("fraud_cleared"); err != nil {
+				return fmt.Errorf("failed to release transaction: %w", err)
+			}
+			if err := uc.transactionRepo.Update(ctx, tx); err != nil {
+				return fmt.Errorf("failed to update transaction: %w", err)
+			}
+		}
+
+		if err := uc.caseRepo.Update(ctx, fraudCase); err != nil {
+			return fmt.Errorf("failed to update fraud case: %w", err)
+		}
+
+		result = &ResolveFraudCaseResult{
+			CaseID:            fraudCase.CaseID().String(),
+			Status:            fraudCase.Status().String(),
+			TransactionStatus: tx.Status().String(),
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ConfirmFraudCaseUseCase 確認詐騙
+//
+// 業務規則：
+// - 暫停中的交易標記失敗（積分不發放）
+// - FreezeAccount=true 時凍結會員積分帳戶（DeductPoints 將被拒絕）
+type ConfirmFraudCaseUseCase struct {
+	caseRepo        fraud.FraudCaseRepository
+	transactionRepo invoice.InvoiceTransactionRepository
+	accountRepo     points.PointsAccountRepository
+	txManager       shared.TransactionManager
+}
+
+// NewConfirmFraudCaseUseCase 創建 Use Case 實例
+func NewConfirmFraudCaseUseCase(
+	caseRepo fraud.FraudCaseRepository,
+	transactionRepo invoice.InvoiceTransactionRepository,
+	accountRepo points.PointsAccountRepository,
+	txManager shared.TransactionManager,
+) *ConfirmFraudCaseUseCase {
+	return &ConfirmFraudCaseUseCase{
+		caseRepo:        caseRepo,
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		txManager:       txManager,
+	}
+}
+
+// Execute 執行確認詐騙
+func (uc *ConfirmFraudCaseUseCase) Execute(cmd ResolveFraudCaseCommand) (*ResolveFraudCaseResult, error) {
+	caseID, err := fraud.CaseIDFromString(cmd.CaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse case ID: %w", err)
+	}
+
+	var result *ResolveFraudCaseResult
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		fraudCase, tx, err := loadCaseAndTransaction(ctx, uc.caseRepo, uc.transactionRepo, caseID)
+		if err != nil {
+			return err
+		}
+
+		if err := fraudCase.Confirm(cmd.ResolvedBy, cmd.Note); err != nil {
+			return fmt.Errorf("failed to confirm fraud case: %w", err)
+		}
+
+		if tx.IsHeld() {
+			if err := tx.Fail("fraud_confirmed"); err != nil {
+				return fmt.Errorf("failed to fail transaction: %w", err)
+			}
+			if err := uc.transactionRepo.Update(ctx, tx); err != nil {
+				return fmt.Errorf("failed to update transaction: %w", err)
+			}
+		}
+
+		frozen := false
+		if cmd.FreezeAccount {
+			frozen, err = uc.freezeAccount(ctx, fraudCase, cmd.ResolvedBy)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := uc.caseRepo.Update(ctx, fraudCase); err != nil {
+			return fmt.Errorf("failed to update fraud case: %w", err)
+		}
+
+		result = &ResolveFraudCaseResult{
+			CaseID:            fraudCase.CaseID().String(),
+			Status:            fraudCase.Status().String(),
+			TransactionStatus: tx.Status().String(),
+			AccountFrozen:     frozen,
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// freezeAccount 凍結會員積分帳戶（已凍結時不重複凍結）
+func (uc *ConfirmFraudCaseUseCase) freezeAccount(
+	ctx shared.TransactionContext,
+	fraudCase *fraud.FraudCase,
+	frozenBy string,
+) (bool, error) {
+	memberID, err := points.MemberIDFromString(fraudCase.MemberID().String())
+	if err != nil {
+		return false, fmt.Errorf("failed to parse member ID: %w", err)
+	}
+
+	account, err := uc.accountRepo.FindByMemberID(ctx, memberID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find account: %w", err)
+	}
+
+	if account.IsFrozen() {
+		return true, nil
+	}
+
+	if err := account.Freeze("fraud_case:"+fraudCase.CaseID().String(), frozenBy); err != nil {
+		return false, fmt.Errorf("failed to freeze account: %w", err)
+	}
+
+	if err := uc.accountRepo.Update(ctx, account); err != nil {
+		return false, fmt.Errorf("failed to update account: %w", err)
+	}
+
+	return true, nil
+}
+
+// loadCaseAndTransaction 載入調查案件及其對應交易
+func loadCaseAndTransaction(
+	ctx shared.TransactionContext,
+	caseRepo fraud.FraudCaseRepository,
+	transactionRepo invoice.InvoiceTransactionRepository,
+	caseID fraud.CaseID,
+) (*fraud.FraudCase, *invoice.InvoiceTransaction, error) {
+	fraudCase, err := caseRepo.FindByID(ctx, caseID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find fraud case: %w", err)
+	}
+
+	transactionID, err := invoice.TransactionIDFromString(fraudCase.TransactionID().String())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse transaction ID: %w", err)
+	}
+
+	tx, err := transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find transaction: %w", err)
+	}
+
+	return fraudCase, tx, nil
+}
@@ -0,0 +1,88 @@
+package fraud
+
+import (
+	"testing"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/fraud"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// ResolveFraudCase Use Cases 測試
+// ===========================
+
+// givenHeldCase 建立高金額暫停中的交易與調查案件
+func (f *testFixture) givenHeldCase(t *testing.T) (*invoice.InvoiceTransaction, string) {
+	tx := f.givenScannedInvoice(t, 30000)
+	f.activityQuery.scans = 1
+	result, err := f.screenUseCase().Execute(ScreenScanCommand{TransactionID: tx.TransactionID().String()})
+	require.NoError(t, err)
+	require.True(t, tx.IsHeld())
+	return tx, result.CaseID
+}
+
+// Test 5: 排除嫌疑 → 交易恢復 imported
+func TestClearFraudCaseUseCase_ReleasesTransaction(t *testing.T) {
+	// Arrange
+	f := newTestFixture()
+	tx, caseID := f.givenHeldCase(t)
+	useCase := NewClearFraudCaseUseCase(f.caseRepo, f.transactionRepo, f.txManager)
+
+	// Act
+	result, err := useCase.Execute(ResolveFraudCaseCommand{CaseID: caseID, ResolvedBy: "admin-1", Note: "birthday party"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "cleared", result.Status)
+	assert.Equal(t, invoice.TransactionStatusImported, tx.Status())
+	assert.False(t, f.caseRepo.cases[caseID].IsOpen())
+}
+
+// Test 6: 確認詐騙並凍結帳戶 → 交易失敗、DeductPoints 被拒
+func TestConfirmFraudCaseUseCase_FreezeAccount_BlocksDeduction(t *testing.T) {
+	// Arrange
+	f := newTestFixture()
+	tx, caseID := f.givenHeldCase(t)
+	useCase := NewConfirmFraudCaseUseCase(f.caseRepo, f.transactionRepo, f.accountRepo, f.txManager)
+
+	// Act
+	result, err := useCase.Execute(ResolveFraudCaseCommand{
+		CaseID:        caseID,
+		ResolvedBy:    "admin-1",
+		Note:          "invoices collected from other tables",
+		FreezeAccount: true,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "confirmed", result.Status)
+	assert.True(t, result.AccountFrozen)
+	assert.Equal(t, invoice.TransactionStatusFailed, tx.Status())
+
+	memberID, _ := points.MemberIDFromString(tx.MemberID().String())
+	account, err := f.accountRepo.FindByMemberID(nil, memberID)
+	require.NoError(t, err)
+	require.True(t, account.IsFrozen())
+	amount, _ := points.NewPointsAmount(1)
+	assert.ErrorIs(t, account.DeductPoints(amount, "redeem"), points.ErrAccountFrozen)
+}
+
+// Test 7: 已結案的案件不可再次處理
+func TestConfirmFraudCaseUseCase_AlreadyClosed_ReturnsError(t *testing.T) {
+	// Arrange
+	f := newTestFixture()
+	_, caseID := f.givenHeldCase(t)
+	clearUseCase := NewClearFraudCaseUseCase(f.caseRepo, f.transactionRepo, f.txManager)
+	_, err := clearUseCase.Execute(ResolveFraudCaseCommand{CaseID: caseID, ResolvedBy: "admin-1"})
+	require.NoError(t, err)
+	useCase := NewConfirmFraudCaseUseCase(f.caseRepo, f.transactionRepo, f.accountRepo, f.txManager)
+
+	// Act
+	_, err = useCase.Execute(ResolveFraudCaseCommand{CaseID: caseID, ResolvedBy: "admin-2"})
+
+	// Assert
+	assert.ErrorIs(t, err, fraud.ErrCaseAlreadyClosed)
+}
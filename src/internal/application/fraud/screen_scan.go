@@ -0,0 +1,235 @@
+package fraud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/fraud"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// ScreenScan Use Case
+// ===========================
+
+// ScreenScanCommand 掃描風險評估命令
+//
+// 輸入：
+// - TransactionID: 剛登錄的發票交易 ID（imported 狀態）
+type ScreenScanCommand struct {
+	TransactionID string
+}
+
+// ScreenScanResult 評估結果
+//
+// 輸出：
+// - Decision: allow / hold / block
+// - Signals: 觸發的風險訊號
+// - CaseID: hold / block 時開立的調查案件 ID
+type ScreenScanResult struct {
+	TransactionID string
+	Decision      string
+	Signals       []string
+	CaseID        string
+}
+
+// ScreenScanUseCase 掃描風險評估 Use Case
+//
+// 職責：
+// 1. 收集評估資料（掃描頻率、註冊時間、連號登錄）
+// 2. 依規則評估風險
+// 3. hold → 交易暫停；block → 交易標記失敗；兩者皆開立調查案件
+//
+// 設計原則：
+// - 在會員掃描發票、交易建立後立即執行（見 Screen，與交易建立同一事務）
+// - 評估與狀態變更在同一事務中完成
+// - Execute 供重新評估既有交易使用（自行開啟事務）
+type ScreenScanUseCase struct {
+	transactionRepo invoice.InvoiceTransactionRepository
+	memberRepo      member.MemberRepository
+	caseRepo        fraud.FraudCaseRepository
+	activityQuery   fraud.ScanActivityQuery
+	detector        *fraud.FraudDetectionService
+	txManager       shared.TransactionManager
+}
+
+// NewScreenScanUseCase 創建 Use Case 實例
+func NewScreenScanUseCase(
+	transactionRepo invoice.InvoiceTransactionRepository,
+	memberRepo member.MemberRepository,
+	caseRepo fraud.FraudCaseRepository,
+	activityQuery fraud.ScanActivityQuery,
+	rules fraud.FraudRules,
+	txManager shared.TransactionManager,
+) *ScreenScanUseCase {
+	return &ScreenScanUseCase{
+		transactionRepo: transactionRepo,
+		memberRepo:      memberRepo,
+		caseRepo:        caseRepo,
+		activityQuery:   activityQuery,
+		detector:        fraud.NewFraudDetectionService(rules),
+		txManager:       txManager,
+	}
+}
+
+// Execute 執行風險評估
+//
+// 錯誤處理：
+// - ErrTransactionNotFound: 交易不存在
+// - ErrMemberNotFound: 會員不存在
+func (uc *ScreenScanUseCase) Execute(cmd ScreenScanCommand) (*ScreenScanResult, error) {
+	transactionID, err := invoice.TransactionIDFromString(cmd.TransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse transaction ID: %w", err)
+	}
+
+	var result *ScreenScanResult
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		tx, err := uc.transactionRepo.FindByID(ctx, transactionID)
+		if err != nil {
+			return fmt.Errorf("failed to find transaction: %w", err)
+		}
+
+		result, err = uc.Screen(ctx, tx)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Screen 在調用者的事務中評估剛登錄的交易
+//
+// 使用場景：會員掃描發票時，與交易建立同一事務（避免交易已建立但未經評估）
+//
+// 副作用：hold / block 時更新交易狀態並保存調查案件
+func (uc *ScreenScanUseCase) Screen(
+	ctx shared.TransactionContext,
+	tx *invoice.InvoiceTransaction,
+) (*ScreenScanResult, error) {
+	activity, err := uc.collectActivity(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	assessment := uc.detector.Assess(activity)
+	result := &ScreenScanResult{
+		TransactionID: tx.TransactionID().String(),
+		Decision:      assessment.Decision().String(),
+		Signals:       signalStrings(assessment.Signals()),
+	}
+
+	if !assessment.IsSuspicious() {
+		return result, nil
+	}
+
+	reason := "fraud_" + assessment.Decision().String() + ": " + strings.Join(result.Signals, ",")
+	if assessment.Decision() == fraud.DecisionBlock {
+		err = tx.Fail(reason)
+	} else {
+		err = tx.Hold(reason)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply fraud decision: %w", err)
+	}
+
+	if err := uc.transactionRepo.Update(ctx, tx); err != nil {
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+
+	fraudCase, err := openCase(tx, assessment)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.caseRepo.Save(ctx, fraudCase); err != nil {
+		return nil, fmt.Errorf("failed to save fraud case: %w", err)
+	}
+
+	result.CaseID = fraudCase.CaseID().String()
+	return result, nil
+}
+
+// collectActivity 收集評估資料
+func (uc *ScreenScanUseCase) collectActivity(
+	ctx shared.TransactionContext,
+	tx *invoice.InvoiceTransaction,
+) (fraud.ScanActivity, error) {
+	cfg := uc.detector.Rules().Config()
+	scannedAt := tx.CreatedAt()
+
+	memberID, err := member.MemberIDFromString(tx.MemberID().String())
+	if err != nil {
+		return fraud.ScanActivity{}, fmt.Errorf("failed to parse member ID: %w", err)
+	}
+	m, err := uc.memberRepo.FindByMemberID(ctx, memberID)
+	if err != nil {
+		return fraud.ScanActivity{}, fmt.Errorf("failed to find member: %w", err)
+	}
+
+	fraudMemberID, err := fraud.MemberIDFromString(tx.MemberID().String())
+	if err != nil {
+		return fraud.ScanActivity{}, fmt.Errorf("failed to parse member ID: %w", err)
+	}
+
+	recentScans, err := uc.activityQuery.CountScansSince(ctx, fraudMemberID, scannedAt.Add(-cfg.VelocityWindow))
+	if err != nil {
+		return fraud.ScanActivity{}, fmt.Errorf("failed to count recent scans: %w", err)
+	}
+
+	claims, err := uc.activityQuery.CountScansSince(ctx, fraudMemberID, m.CreatedAt())
+	if err != nil {
+		return fraud.ScanActivity{}, fmt.Errorf("failed to count claims: %w", err)
+	}
+
+	nearbyMembers := 0
+	if from, to, ok := uc.detector.Rules().SequentialNeighbourRange(tx.InvoiceNumber().String()); ok {
+		nearbyMembers, err = uc.activityQuery.CountDistinctMembersInRange(
+			ctx, from, to, fraudMemberID, scannedAt.Add(-cfg.SequentialLookback),
+		)
+		if err != nil {
+			return fraud.ScanActivity{}, fmt.Errorf("failed to count sequential claims: %w", err)
+		}
+	}
+
+	return fraud.ScanActivity{
+		Amount:                  tx.GetAmount(),
+		ScannedAt:               scannedAt,
+		MemberRegisteredAt:      m.CreatedAt(),
+		RecentScanCount:         recentScans,
+		ClaimsSinceRegistration: claims,
+		NearbyClaimMembers:      nearbyMembers,
+	}, nil
+}
+
+// openCase 依評估結果開立調查案件
+func openCase(tx *invoice.InvoiceTransaction, assessment fraud.Assessment) (*fraud.FraudCase, error) {
+	memberID, err := fraud.MemberIDFromString(tx.MemberID().String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse member ID: %w", err)
+	}
+	transactionID, err := fraud.TransactionIDFromString(tx.TransactionID().String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse transaction ID: %w", err)
+	}
+
+	fraudCase, err := fraud.OpenFraudCase(memberID, transactionID, tx.InvoiceNumber().String(), assessment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open fraud case: %w", err)
+	}
+	return fraudCase, nil
+}
+
+// signalStrings 將風險訊號轉為字串列表
+func signalStrings(signals []fraud.RiskSignal) []string {
+	result := make([]string, 0, len(signals))
+	for _, s := range signals {
+		result = append(result, s.String())
+	}
+	return result
+}
@@ -0,0 +1,346 @@
+package fraud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/fraud"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// ScreenScan Use Case 測試
+// ===========================
+
+// testFixture 測試共用依賴
+type testFixture struct {
+	transactionRepo *MockInvoiceTransactionRepository
+	memberRepo      *MockMemberRepository
+	caseRepo        *MockFraudCaseRepository
+	activityQuery   *StubScanActivityQuery
+	accountRepo     *MockPointsAccountRepository
+	txManager       *MockTransactionManager
+}
+
+func newTestFixture() *testFixture {
+	return &testFixture{
+		transactionRepo: NewMockInvoiceTransactionRepository(),
+		memberRepo:      NewMockMemberRepository(),
+		caseRepo:        NewMockFraudCaseRepository(),
+		activityQuery:   &StubScanActivityQuery{},
+		accountRepo:     NewMockPointsAccountRepository(),
+		txManager:       NewMockTransactionManager(),
+	}
+}
+
+// givenScannedInvoice 建立註冊已久的會員、積分帳戶及掃描的發票
+func (f *testFixture) givenScannedInvoice(t *testing.T, amount int) *invoice.InvoiceTransaction {
+	lineUserID, err := member.NewLineUserID("U1234567890abcdef1234567890abcdef")
+	require.NoError(t, err)
+	registeredAt := time.Now().AddDate(0, -6, 0)
+	m, err := member.ReconstructMember(
+		member.NewMemberID(), lineUserID, "Test", member.PhoneNumber{}, nil, member.MemberProfile{}, member.AgeVerification{}, registeredAt, registeredAt, 1,
+	)
+	require.NoError(t, err)
+	f.memberRepo.members[m.MemberID().String()] = m
+
+	pointsMemberID, err := points.MemberIDFromString(m.MemberID().String())
+	require.NoError(t, err)
+	account, err := points.NewPointsAccount(pointsMemberID)
+	require.NoError(t, err)
+	f.accountRepo.accounts[pointsMemberID.String()] = account
+
+	memberID, err := invoice.MemberIDFromString(m.MemberID().String())
+	require.NoError(t, err)
+	number, _ := invoice.NewInvoiceNumber("AB12345678")
+	money, _ := invoice.NewMoney(amount)
+	tx, err := invoice.NewInvoiceTransaction(memberID, number, time.Now(), money)
+	require.NoError(t, err)
+	require.NoError(t, f.transactionRepo.Save(nil, tx))
+	return tx
+}
+
+func (f *testFixture) screenUseCase() *ScreenScanUseCase {
+	return NewScreenScanUseCase(
+		f.transactionRepo,
+		f.memberRepo,
+		f.caseRepo,
+		f.activityQuery,
+		fraud.DefaultFraudRules(),
+		f.txManager,
+	)
+}
+
+// Test 1: 一般掃描放行，不開案
+func TestScreenScanUseCase_Normal_Allows(t *testing.T) {
+	// Arrange
+	f := newTestFixture()
+	tx := f.givenScannedInvoice(t, 1200)
+	f.activityQuery.scans = 1
+
+	// Act
+	result, err := f.screenUseCase().Execute(ScreenScanCommand{TransactionID: tx.TransactionID().String()})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "allow", result.Decision)
+	assert.Empty(t, result.CaseID)
+	assert.Equal(t, invoice.TransactionStatusImported, tx.Status())
+	assert.Empty(t, f.caseRepo.cases)
+}
+
+// Test 2: 高金額 → 交易暫停並開案
+func TestScreenScanUseCase_HighAmount_HoldsTransaction(t *testing.T) {
+	// Arrange
+	f := newTestFixture()
+	tx := f.givenScannedInvoice(t, 30000)
+	f.activityQuery.scans = 1
+
+	// Act
+	result, err := f.screenUseCase().Execute(ScreenScanCommand{TransactionID: tx.TransactionID().String()})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "hold", result.Decision)
+	assert.Equal(t, []string{"high_amount"}, result.Signals)
+	assert.True(t, tx.IsHeld())
+	require.Contains(t, f.caseRepo.cases, result.CaseID)
+	assert.Equal(t, 1, f.txManager.InTransactionCallCount)
+}
+
+// Test 3: 多名會員登錄連號發票 → 暫停
+func TestScreenScanUseCase_SequentialClaims_HoldsTransaction(t *testing.T) {
+	// Arrange
+	f := newTestFixture()
+	tx := f.givenScannedInvoice(t, 800)
+	f.activityQuery.scans = 1
+	f.activityQuery.nearbyMembers = 4
+
+	// Act
+	result, err := f.screenUseCase().Execute(ScreenScanCommand{TransactionID: tx.TransactionID().String()})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sequential_invoices"}, result.Signals)
+	assert.Equal(t, "AB12345673", f.activityQuery.lastFrom)
+	assert.Equal(t, "AB12345683", f.activityQuery.lastTo)
+	assert.True(t, tx.IsHeld())
+}
+
+// Test 4: 極端掃描頻率 → 交易直接標記失敗
+func TestScreenScanUseCase_ExtremeVelocity_BlocksTransaction(t *testing.T) {
+	// Arrange
+	f := newTestFixture()
+	tx := f.givenScannedInvoice(t, 800)
+	f.activityQuery.scans = 20
+
+	// Act
+	result, err := f.screenUseCase().Execute(ScreenScanCommand{TransactionID: tx.TransactionID().String()})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "block", result.Decision)
+	assert.Equal(t, invoice.TransactionStatusFailed, tx.Status())
+	assert.NotEmpty(t, result.CaseID)
+}
+
+// ===========================
+// Mock Repositories
+// ===========================
+
+type MockInvoiceTransactionRepository struct {
+	transactions map[string]*invoice.InvoiceTransaction
+}
+
+func NewMockInvoiceTransactionRepository() *MockInvoiceTransactionRepository {
+	return &MockInvoiceTransactionRepository{
+		transactions: make(map[string]*invoice.InvoiceTransaction),
+	}
+}
+
+func (m *MockInvoiceTransactionRepository) Save(ctx shared.TransactionContext, tx *invoice.InvoiceTransaction) error {
+	m.transactions[tx.TransactionID().String()] = tx
+	return nil
+}
+
+func (m *MockInvoiceTransactionRepository) Update(ctx shared.TransactionContext, tx *invoice.InvoiceTransaction) error {
+	if _, exists := m.transactions[tx.TransactionID().String()]; !exists {
+		return invoice.ErrTransactionNotFound
+	}
+	m.transactions[tx.TransactionID().String()] = tx
+	return nil
+}
+
+func (m *MockInvoiceTransactionRepository) FindByID(ctx shared.TransactionContext, id invoice.TransactionID) (*invoice.InvoiceTransaction, error) {
+	if tx, exists := m.transactions[id.String()]; exists {
+		return tx, nil
+	}
+	return nil, invoice.ErrTransactionNotFound
+}
+
+func (m *MockInvoiceTransactionRepository) FindByInvoiceNumber(ctx shared.TransactionContext, number invoice.InvoiceNumber) (*invoice.InvoiceTransaction, error) {
+	for _, tx := range m.transactions {
+		if tx.InvoiceNumber().Equals(number) {
+			return tx, nil
+		}
+	}
+	return nil, invoice.ErrTransactionNotFound
+}
+
+type MockMemberRepository struct {
+	members map[string]*member.Member
+}
+
+func NewMockMemberRepository() *MockMemberRepository {
+	return &MockMemberRepository{
+		members: make(map[string]*member.Member),
+	}
+}
+
+func (m *MockMemberRepository) Save(ctx shared.TransactionContext, mem *member.Member) error {
+	m.members[mem.MemberID().String()] = mem
+	return nil
+}
+
+func (m *MockMemberRepository) Update(ctx shared.TransactionContext, mem *member.Member) error {
+	return m.Save(ctx, mem)
+}
+
+func (m *MockMemberRepository) FindByMemberID(ctx shared.TransactionContext, id member.MemberID) (*member.Member, error) {
+	if mem, exists := m.members[id.String()]; exists {
+		return mem, nil
+	}
+	return nil, member.ErrMemberNotFound
+}
+
+func (m *MockMemberRepository) FindByLineUserID(ctx shared.TransactionContext, lineUserID member.LineUserID) (*member.Member, error) {
+	for _, mem := range m.members {
+		if mem.LineUserID().Equals(lineUserID) {
+			return mem, nil
+		}
+	}
+	return nil, member.ErrMemberNotFound
+}
+
+func (m *MockMemberRepository) ExistsByPhoneNumber(ctx shared.TransactionContext, phoneNumber member.PhoneNumber) (bool, error) {
+	return false, nil
+}
+
+func (m *MockMemberRepository) ExistsByLineUserID(ctx shared.TransactionContext, lineUserID member.LineUserID) (bool, error) {
+	return false, nil
+}
+
+type MockFraudCaseRepository struct {
+	cases map[string]*fraud.FraudCase
+}
+
+func NewMockFraudCaseRepository() *MockFraudCaseRepository {
+	return &MockFraudCaseRepository{
+		cases: make(map[string]*fraud.FraudCase),
+	}
+}
+
+func (m *MockFraudCaseRepository) Save(ctx shared.TransactionContext, c *fraud.FraudCase) error {
+	m.cases[c.CaseID().String()] = c
+	return nil
+}
+
+func (m *MockFraudCaseRepository) Update(ctx shared.TransactionContext, c *fraud.FraudCase) error {
+	if _, exists := m.cases[c.CaseID().String()]; !exists {
+		return fraud.ErrFraudCaseNotFound
+	}
+	m.cases[c.CaseID().String()] = c
+	return nil
+}
+
+func (m *MockFraudCaseRepository) FindByID(ctx shared.TransactionContext, id fraud.CaseID) (*fraud.FraudCase, error) {
+	if c, exists := m.cases[id.String()]; exists {
+		return c, nil
+	}
+	return nil, fraud.ErrFraudCaseNotFound
+}
+
+func (m *MockFraudCaseRepository) FindOpen(ctx shared.TransactionContext, limit, offset int) ([]*fraud.FraudCase, error) {
+	open := make([]*fraud.FraudCase, 0)
+	for _, c := range m.cases {
+		if c.IsOpen() {
+			open = append(open, c)
+		}
+	}
+	return open, nil
+}
+
+// StubScanActivityQuery 回傳固定統計數字
+type StubScanActivityQuery struct {
+	scans         int
+	nearbyMembers int
+	lastFrom      string
+	lastTo        string
+}
+
+func (s *StubScanActivityQuery) CountScansSince(ctx shared.TransactionContext, memberID fraud.MemberID, since time.Time) (int, error) {
+	return s.scans, nil
+}
+
+func (s *StubScanActivityQuery) CountDistinctMembersInRange(ctx shared.TransactionContext, from, to string, excludeMemberID fraud.MemberID, since time.Time) (int, error) {
+	s.lastFrom, s.lastTo = from, to
+	return s.nearbyMembers, nil
+}
+
+type MockPointsAccountRepository struct {
+	accounts map[string]*points.PointsAccount
+}
+
+func NewMockPointsAccountRepository() *MockPointsAccountRepository {
+	return &MockPointsAccountRepository{
+		accounts: make(map[string]*points.PointsAccount),
+	}
+}
+
+func (m *MockPointsAccountRepository) Save(ctx shared.TransactionContext, account *points.PointsAccount) error {
+	m.accounts[account.MemberID().String()] = account
+	return nil
+}
+
+func (m *MockPointsAccountRepository) FindByID(ctx shared.TransactionContext, accountID points.AccountID) (*points.PointsAccount, error) {
+	for _, account := range m.accounts {
+		if account.AccountID().Equals(accountID) {
+			return account, nil
+		}
+	}
+	return nil, points.ErrAccountNotFound
+}
+
+func (m *MockPointsAccountRepository) FindByMemberID(ctx shared.TransactionContext, memberID points.MemberID) (*points.PointsAccount, error) {
+	if account, exists := m.accounts[memberID.String()]; exists {
+		return account, nil
+	}
+	return nil, points.ErrAccountNotFound
+}
+
+func (m *MockPointsAccountRepository) Update(ctx shared.TransactionContext, account *points.PointsAccount) error {
+	m.accounts[account.MemberID().String()] = account
+	return nil
+}
+
+// ===========================
+// Mock TransactionManager
+// ===========================
+
+type MockTransactionManager struct {
+	InTransactionCallCount int
+}
+
+func NewMockTransactionManager() *MockTransactionManager {
+	return &MockTransactionManager{}
+}
+
+func (m *MockTransactionManager) InTransaction(fn func(ctx shared.TransactionContext) error) error {
+	m.InTransactionCallCount++
+	return fn(nil)
+}
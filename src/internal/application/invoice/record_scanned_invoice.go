@@ -0,0 +1,146 @@
+package invoice
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	appfraud "github.com/jackyeh168/bar_crm/src/internal/application/fraud"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// RecordScannedInvoice Use Case
+// ===========================
+
+// ScanScreener 掃描風險評估（在調用者的事務中執行）
+//
+// 實作：application/fraud.ScreenScanUseCase.Screen
+type ScanScreener interface {
+	Screen(ctx shared.TransactionContext, tx *invoice.InvoiceTransaction) (*appfraud.ScreenScanResult, error)
+}
+
+// RecordScannedInvoiceCommand 登錄會員掃描的發票
+//
+// 輸入：
+// - MemberID: 掃描的會員
+// - InvoiceNumber / InvoiceDate / Amount: 由發票 QR Code 解析（金額為總計額）
+type RecordScannedInvoiceCommand struct {
+	MemberID      string
+	InvoiceNumber string
+	InvoiceDate   time.Time
+	Amount        int
+}
+
+// RecordScannedInvoiceResult 登錄結果
+//
+// 輸出：
+// - Status: imported（待 iChef 驗證）/ held（疑似詐騙，待調查）/ failed（封鎖）
+// - Decision: 風險評估結果 allow / hold / block
+// - CaseID: hold / block 時開立的調查案件 ID
+type RecordScannedInvoiceResult struct {
+	TransactionID string
+	InvoiceNumber string
+	InvoiceDate   time.Time
+	Amount        int
+	Status        string
+	Decision      string
+	CaseID        string
+}
+
+// RecordScannedInvoiceUseCase 登錄會員掃描的發票
+//
+// 職責：
+// 1. 建立 imported 狀態的發票交易（BR-002-04）
+// 2. 檢查重複登錄（BR-002-02，另由唯一約束保證）
+// 3. 在同一事務中執行掃描風險評估（hold / block 時交易不會以 imported 狀態提交）
+//
+// 事件發布：提交後發布 invoice.transaction_created（封鎖時另有 invoice.transaction_failed）
+type RecordScannedInvoiceUseCase struct {
+	transactionRepo invoice.InvoiceTransactionRepository
+	screener        ScanScreener
+	txManager       shared.TransactionManager
+	publisher       shared.EventPublisher
+}
+
+// NewRecordScannedInvoiceUseCase 創建 Use Case 實例
+func NewRecordScannedInvoiceUseCase(
+	transactionRepo invoice.InvoiceTransactionRepository,
+	screener ScanScreener,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *RecordScannedInvoiceUseCase {
+	return &RecordScannedInvoiceUseCase{
+		transactionRepo: transactionRepo,
+		screener:        screener,
+		txManager:       txManager,
+		publisher:       publisher,
+	}
+}
+
+// Execute 執行登錄
+//
+// 錯誤處理：
+// - ErrInvalidMemberID / ErrInvalidInvoiceNumber / ErrInvalidMoney / ErrInvalidInvoiceDate: 輸入無效
+// - ErrDuplicateInvoice: 發票已被登錄
+func (uc *RecordScannedInvoiceUseCase) Execute(cmd RecordScannedInvoiceCommand) (*RecordScannedInvoiceResult, error) {
+	memberID, err := invoice.MemberIDFromString(cmd.MemberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse member ID: %w", err)
+	}
+	invoiceNumber, err := invoice.NewInvoiceNumber(cmd.InvoiceNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse invoice number: %w", err)
+	}
+	amount, err := invoice.NewMoney(cmd.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse amount: %w", err)
+	}
+
+	tx, err := invoice.NewInvoiceTransaction(memberID, invoiceNumber, cmd.InvoiceDate, amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transaction: %w", err)
+	}
+
+	var screening *appfraud.ScreenScanResult
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		_, err := uc.transactionRepo.FindByInvoiceNumber(ctx, invoiceNumber)
+		if err == nil {
+			return invoice.ErrDuplicateInvoice.WithContext("invoice_number", invoiceNumber.String())
+		}
+		if !errors.Is(err, invoice.ErrTransactionNotFound) {
+			return fmt.Errorf("failed to check duplicate invoice: %w", err)
+		}
+
+		if err := uc.transactionRepo.Save(ctx, tx); err != nil {
+			return fmt.Errorf("failed to save transaction: %w", err)
+		}
+
+		screening, err = uc.screener.Screen(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to screen scan: %w", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if events := tx.PullEvents(); len(events) > 0 {
+		if err := uc.publisher.PublishBatch(events); err != nil {
+			return nil, fmt.Errorf("failed to publish transaction events: %w", err)
+		}
+	}
+
+	return &RecordScannedInvoiceResult{
+		TransactionID: tx.TransactionID().String(),
+		InvoiceNumber: tx.InvoiceNumber().String(),
+		InvoiceDate:   tx.InvoiceDate(),
+		Amount:        tx.GetAmount(),
+		Status:        tx.Status().String(),
+		Decision:      screening.Decision,
+		CaseID:        screening.CaseID,
+	}, nil
+}
@@ -0,0 +1,338 @@
+package invoice
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	appfraud "github.com/jackyeh168/bar_crm/src/internal/application/fraud"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/fraud"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// RecordScannedInvoice Use Case 測試
+// ===========================
+
+var testInvoiceDate = time.Date(2025, 1, 5, 20, 0, 0, 0, time.UTC)
+
+// testFixture 測試共用依賴（風險評估使用實際的 ScreenScanUseCase）
+type testFixture struct {
+	transactionRepo *MockInvoiceTransactionRepository
+	memberRepo      *MockMemberRepository
+	caseRepo        *MockFraudCaseRepository
+	activityQuery   *StubScanActivityQuery
+	txManager       *MockTransactionManager
+	publisher       *FakeEventPublisher
+}
+
+func newTestFixture() *testFixture {
+	return &testFixture{
+		transactionRepo: NewMockInvoiceTransactionRepository(),
+		memberRepo:      NewMockMemberRepository(),
+		caseRepo:        NewMockFraudCaseRepository(),
+		activityQuery:   &StubScanActivityQuery{},
+		txManager:       NewMockTransactionManager(),
+		publisher:       &FakeEventPublisher{},
+	}
+}
+
+// givenMember 建立註冊已久的會員
+func (f *testFixture) givenMember(t *testing.T) string {
+	lineUserID, err := member.NewLineUserID("U1234567890abcdef1234567890abcdef")
+	require.NoError(t, err)
+	registeredAt := time.Now().AddDate(0, -6, 0)
+	m, err := member.ReconstructMember(
+		member.NewMemberID(), lineUserID, "Test", member.PhoneNumber{}, nil, member.MemberProfile{}, member.AgeVerification{}, registeredAt, registeredAt, 1,
+	)
+	require.NoError(t, err)
+	f.memberRepo.members[m.MemberID().String()] = m
+	return m.MemberID().String()
+}
+
+func (f *testFixture) useCase() *RecordScannedInvoiceUseCase {
+	screener := appfraud.NewScreenScanUseCase(
+		f.transactionRepo,
+		f.memberRepo,
+		f.caseRepo,
+		f.activityQuery,
+		fraud.DefaultFraudRules(),
+		f.txManager,
+	)
+	return NewRecordScannedInvoiceUseCase(f.transactionRepo, screener, f.txManager, f.publisher)
+}
+
+func scanCommand(memberID string, amount int) RecordScannedInvoiceCommand {
+	return RecordScannedInvoiceCommand{
+		MemberID:      memberID,
+		InvoiceNumber: "AB12345678",
+		InvoiceDate:   testInvoiceDate,
+		Amount:        amount,
+	}
+}
+
+// Test 1: 一般掃描 → 交易以 imported 登錄，發布建立事件
+func TestRecordScannedInvoiceUseCase_Normal_RecordsImported(t *testing.T) {
+	// Arrange
+	f := newTestFixture()
+	memberID := f.givenMember(t)
+	f.activityQuery.scans = 1
+
+	// Act
+	result, err := f.useCase().Execute(scanCommand(memberID, 250))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "imported", result.Status)
+	assert.Equal(t, "allow", result.Decision)
+	assert.Empty(t, result.CaseID)
+	assert.Len(t, f.transactionRepo.transactions, 1)
+	assert.Empty(t, f.caseRepo.cases)
+	assert.Equal(t, 1, f.txManager.InTransactionCallCount)
+	require.Len(t, f.publisher.events, 1)
+	assert.Equal(t, "invoice.transaction_created", f.publisher.events[0].EventType())
+}
+
+// Test 2: 高金額 → 同一事務中暫停交易並開案
+func TestRecordScannedInvoiceUseCase_HighAmount_HoldsInSameTransaction(t *testing.T) {
+	// Arrange
+	f := newTestFixture()
+	memberID := f.givenMember(t)
+	f.activityQuery.scans = 1
+
+	// Act
+	result, err := f.useCase().Execute(scanCommand(memberID, 30000))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "held", result.Status)
+	assert.Equal(t, "hold", result.Decision)
+	require.Contains(t, f.caseRepo.cases, result.CaseID)
+	assert.Equal(t, 1, f.txManager.InTransactionCallCount)
+	tx := f.transactionRepo.transactions[result.TransactionID]
+	require.NotNil(t, tx)
+	assert.True(t, tx.IsHeld())
+}
+
+// Test 3: 極端掃描頻率 → 交易直接標記失敗
+func TestRecordScannedInvoiceUseCase_ExtremeVelocity_Blocks(t *testing.T) {
+	// Arrange
+	f := newTestFixture()
+	memberID := f.givenMember(t)
+	f.activityQuery.scans = 20
+
+	// Act
+	result, err := f.useCase().Execute(scanCommand(memberID, 800))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "failed", result.Status)
+	assert.Equal(t, "block", result.Decision)
+	assert.NotEmpty(t, result.CaseID)
+}
+
+// Test 4: 重複登錄 → ErrDuplicateInvoice，不開案
+func TestRecordScannedInvoiceUseCase_Duplicate_ReturnsError(t *testing.T) {
+	// Arrange
+	f := newTestFixture()
+	memberID := f.givenMember(t)
+	useCase := f.useCase()
+	_, err := useCase.Execute(scanCommand(memberID, 250))
+	require.NoError(t, err)
+
+	// Act
+	result, err := useCase.Execute(scanCommand(memberID, 250))
+
+	// Assert
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, invoice.ErrDuplicateInvoice))
+	assert.Len(t, f.transactionRepo.transactions, 1)
+	assert.Empty(t, f.caseRepo.cases)
+}
+
+// ===========================
+// Mock Repositories
+// ===========================
+
+type MockInvoiceTransactionRepository struct {
+	transactions map[string]*invoice.InvoiceTransaction
+}
+
+func NewMockInvoiceTransactionRepository() *MockInvoiceTransactionRepository {
+	return &MockInvoiceTransactionRepository{
+		transactions: make(map[string]*invoice.InvoiceTransaction),
+	}
+}
+
+func (m *MockInvoiceTransactionRepository) Save(ctx shared.TransactionContext, tx *invoice.InvoiceTransaction) error {
+	for _, existing := range m.transactions {
+		if existing.InvoiceNumber().Equals(tx.InvoiceNumber()) {
+			return invoice.ErrDuplicateInvoice
+		}
+	}
+	m.transactions[tx.TransactionID().String()] = tx
+	return nil
+}
+
+func (m *MockInvoiceTransactionRepository) Update(ctx shared.TransactionContext, tx *invoice.InvoiceTransaction) error {
+	if _, exists := m.transactions[tx.TransactionID().String()]; !exists {
+		return invoice.ErrTransactionNotFound
+	}
+	m.transactions[tx.TransactionID().String()] = tx
+	return nil
+}
+
+func (m *MockInvoiceTransactionRepository) FindByID(ctx shared.TransactionContext, id invoice.TransactionID) (*invoice.InvoiceTransaction, error) {
+	if tx, exists := m.transactions[id.String()]; exists {
+		return tx, nil
+	}
+	return nil, invoice.ErrTransactionNotFound
+}
+
+func (m *MockInvoiceTransactionRepository) FindByInvoiceNumber(ctx shared.TransactionContext, number invoice.InvoiceNumber) (*invoice.InvoiceTransaction, error) {
+	for _, tx := range m.transactions {
+		if tx.InvoiceNumber().Equals(number) {
+			return tx, nil
+		}
+	}
+	return nil, invoice.ErrTransactionNotFound
+}
+
+type MockMemberRepository struct {
+	members map[string]*member.Member
+}
+
+func NewMockMemberRepository() *MockMemberRepository {
+	return &MockMemberRepository{
+		members: make(map[string]*member.Member),
+	}
+}
+
+func (m *MockMemberRepository) Save(ctx shared.TransactionContext, mem *member.Member) error {
+	m.members[mem.MemberID().String()] = mem
+	return nil
+}
+
+func (m *MockMemberRepository) Update(ctx shared.TransactionContext, mem *member.Member) error {
+	return m.Save(ctx, mem)
+}
+
+func (m *MockMemberRepository) FindByMemberID(ctx shared.TransactionContext, id member.MemberID) (*member.Member, error) {
+	if mem, exists := m.members[id.String()]; exists {
+		return mem, nil
+	}
+	return nil, member.ErrMemberNotFound
+}
+
+func (m *MockMemberRepository) FindByLineUserID(ctx shared.TransactionContext, lineUserID member.LineUserID) (*member.Member, error) {
+	for _, mem := range m.members {
+		if mem.LineUserID().Equals(lineUserID) {
+			return mem, nil
+		}
+	}
+	return nil, member.ErrMemberNotFound
+}
+
+func (m *MockMemberRepository) ExistsByPhoneNumber(ctx shared.TransactionContext, phoneNumber member.PhoneNumber) (bool, error) {
+	return false, nil
+}
+
+func (m *MockMemberRepository) ExistsByLineUserID(ctx shared.TransactionContext, lineUserID member.LineUserID) (bool, error) {
+	return false, nil
+}
+
+type MockFraudCaseRepository struct {
+	cases map[string]*fraud.FraudCase
+}
+
+func NewMockFraudCaseRepository() *MockFraudCaseRepository {
+	return &MockFraudCaseRepository{
+		cases: make(map[string]*fraud.FraudCase),
+	}
+}
+
+func (m *MockFraudCaseRepository) Save(ctx shared.TransactionContext, c *fraud.FraudCase) error {
+	m.cases[c.CaseID().String()] = c
+	return nil
+}
+
+func (m *MockFraudCaseRepository) Update(ctx shared.TransactionContext, c *fraud.FraudCase) error {
+	if _, exists := m.cases[c.CaseID().String()]; !exists {
+		return fraud.ErrFraudCaseNotFound
+	}
+	m.cases[c.CaseID().String()] = c
+	return nil
+}
+
+func (m *MockFraudCaseRepository) FindByID(ctx shared.TransactionContext, id fraud.CaseID) (*fraud.FraudCase, error) {
+	if c, exists := m.cases[id.String()]; exists {
+		return c, nil
+	}
+	return nil, fraud.ErrFraudCaseNotFound
+}
+
+func (m *MockFraudCaseRepository) FindOpen(ctx shared.TransactionContext, limit, offset int) ([]*fraud.FraudCase, error) {
+	open := make([]*fraud.FraudCase, 0)
+	for _, c := range m.cases {
+		if c.IsOpen() {
+			open = append(open, c)
+		}
+	}
+	return open, nil
+}
+
+// StubScanActivityQuery 回傳固定統計數字
+type StubScanActivityQuery struct {
+	scans         int
+	nearbyMembers int
+	lastFrom      string
+	lastTo        string
+}
+
+func (s *StubScanActivityQuery) CountScansSince(ctx shared.TransactionContext, memberID fraud.MemberID, since time.Time) (int, error) {
+	return s.scans, nil
+}
+
+func (s *StubScanActivityQuery) CountDistinctMembersInRange(ctx shared.TransactionContext, from, to string, excludeMemberID fraud.MemberID, since time.Time) (int, error) {
+	s.lastFrom, s.lastTo = from, to
+	return s.nearbyMembers, nil
+}
+
+// ===========================
+// Fake EventPublisher
+// ===========================
+
+type FakeEventPublisher struct {
+	events []shared.DomainEvent
+}
+
+func (p *FakeEventPublisher) Publish(event shared.DomainEvent) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *FakeEventPublisher) PublishBatch(events []shared.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+// ===========================
+// Mock TransactionManager
+// ===========================
+
+type MockTransactionManager struct {
+	InTransactionCallCount int
+}
+
+func NewMockTransactionManager() *MockTransactionManager {
+	return &MockTransactionManager{}
+}
+
+func (m *MockTransactionManager) InTransaction(fn func(ctx shared.TransactionContext) error) error {
+	m.InTransactionCallCount++
+	return fn(nil)
+}
@@ -0,0 +1,93 @@
+package member
+
+import (
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// BindPhoneNumber Use Case（LINE 重新綁定）
+// ===========================
+
+// BindPhoneNumberCommand 已存在但尚未綁定手機號碼的會員綁定手機號碼指令
+type BindPhoneNumberCommand struct {
+	LineUserID  string
+	PhoneNumber string
+}
+
+// BindPhoneNumberUseCase 已存在的會員透過 LINE 綁定手機號碼
+//
+// 使用場景：
+// - 管理員解除綁定後，會員在 LINE 重新完成註冊（RegisterMember 會因 LINE UserID 已存在而失敗）
+//
+// 業務規則：
+// - 號碼不可已被其他會員綁定
+// - 會員已綁定手機號碼時返回 ErrPhoneAlreadyBound；已被合併的會員返回 ErrMemberMerged
+// - 以樂觀鎖更新會員；與管理後台同時修改時（版本衝突）重新載入後再套用
+type BindPhoneNumberUseCase struct {
+	memberRepo member.MemberRepository
+	txManager  shared.TransactionManager
+}
+
+// NewBindPhoneNumberUseCase 創建 Use Case 實例
+func NewBindPhoneNumberUseCase(
+	memberRepo member.MemberRepository,
+	txManager shared.TransactionManager,
+) *BindPhoneNumberUseCase {
+	return &BindPhoneNumberUseCase{
+		memberRepo: memberRepo,
+		txManager:  txManager,
+	}
+}
+
+// Execute 綁定手機號碼
+//
+// 錯誤處理：
+// - 號碼格式無效 → ErrInvalidPhoneNumberFormat
+// - 未註冊的 LINE 用戶 → ErrMemberNotFound
+// - 會員已綁定手機號碼 → ErrPhoneAlreadyBound
+// - 號碼已被其他會員綁定 → ErrPhoneNumberAlreadyBound
+func (uc *BindPhoneNumberUseCase) Execute(cmd BindPhoneNumberCommand) (*RegisterMemberResult, error) {
+	lineUserID, err := member.NewLineUserID(cmd.LineUserID)
+	if err != nil {
+		return nil, err
+	}
+	phoneNumber, err := member.NewPhoneNumber(cmd.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	var m *member.Member
+	err = retryOnVersionConflict(func() error {
+		return uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+			m, err = uc.memberRepo.FindByLineUserID(ctx, lineUserID)
+			if err != nil {
+				return err
+			}
+			// 會員已綁定同一號碼時由聚合返回 ErrPhoneAlreadyBound（不視為被其他會員綁定）
+			if !m.PhoneNumber().Equals(phoneNumber) {
+				exists, err := uc.memberRepo.ExistsByPhoneNumber(ctx, phoneNumber)
+				if err != nil {
+					return fmt.Errorf("failed to check phone number: %w", err)
+				}
+				if exists {
+					return member.ErrPhoneNumberAlreadyBound.WithContext("phone_number", phoneNumber.String())
+				}
+			}
+			if err := m.BindPhoneNumber(phoneNumber); err != nil {
+				return err
+			}
+			return uc.memberRepo.Update(ctx, m)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RegisterMemberResult{
+		MemberID:   m.MemberID().String(),
+		LineUserID: m.LineUserID().String(),
+	}, nil
+}
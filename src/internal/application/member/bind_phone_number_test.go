@@ -0,0 +1,47 @@
+package member
+
+import (
+	"testing"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// BindPhoneNumber Use Case Tests
+// ===========================
+
+// Test 28: An existing member without a phone binds one with a version-checked update; taken numbers and bound members are rejected
+func TestBindPhoneNumberUseCase_Execute(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	useCase := NewBindPhoneNumberUseCase(mockRepo, new(MockTransactionManager))
+	m := newBoundMember(t, "0912345678")
+	_, err := m.UnbindPhoneNumber("門號停用", "admin", m.UpdatedAt())
+	require.NoError(t, err)
+	taken, _ := member.NewPhoneNumber("0911111111")
+	free, _ := member.NewPhoneNumber("0987654321")
+
+	mockRepo.On("FindByLineUserID", mock.Anything, m.LineUserID()).Return(m, nil)
+	mockRepo.On("ExistsByPhoneNumber", mock.Anything, taken).Return(true, nil)
+	mockRepo.On("ExistsByPhoneNumber", mock.Anything, free).Return(false, nil)
+	mockRepo.On("Update", mock.Anything, m).Return(nil).Once()
+	cmd := BindPhoneNumberCommand{LineUserID: m.LineUserID().String()}
+
+	// Act
+	cmd.PhoneNumber = taken.String()
+	_, takenErr := useCase.Execute(cmd)
+	cmd.PhoneNumber = free.String()
+	result, err := useCase.Execute(cmd)
+	_, boundErr := useCase.Execute(cmd)
+
+	// Assert
+	assert.ErrorIs(t, takenErr, member.ErrPhoneNumberAlreadyBound)
+	require.NoError(t, err)
+	assert.Equal(t, m.MemberID().String(), result.MemberID)
+	assert.True(t, m.PhoneNumber().Equals(free))
+	assert.ErrorIs(t, boundErr, member.ErrPhoneAlreadyBound)
+	mockRepo.AssertExpectations(t)
+}
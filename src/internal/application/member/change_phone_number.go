@@ -0,0 +1,243 @@
+package member
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// RebindPhoneNumber / UnbindPhoneNumber Use Cases（管理後台）
+// ===========================
+
+// RebindPhoneNumberCommand 管理員更換會員手機號碼指令
+//
+// 欄位：
+// - Reason: 更換原因（必填）
+// - OperatorID: 操作的管理員
+type RebindPhoneNumberCommand struct {
+	MemberID    string
+	PhoneNumber string
+	Reason      string
+	OperatorID  string
+	Now         time.Time
+}
+
+// UnbindPhoneNumberCommand 管理員解除會員手機號碼綁定指令
+type UnbindPhoneNumberCommand struct {
+	MemberID   string
+	Reason     string
+	OperatorID string
+	Now        time.Time
+}
+
+// PhoneNumberChangeResult 手機號碼異動紀錄（Output DTO）
+//
+// 欄位：
+// - Action: rebind / unbind
+// - PreviousPhoneNumber / PhoneNumber: 異動前後號碼（未綁定時為空字串）
+type PhoneNumberChangeResult struct {
+	ChangeID            string
+	MemberID            string
+	Action              string
+	PreviousPhoneNumber string
+	PhoneNumber         string
+	Reason              string
+	OperatorID          string
+	ChangedAt           time.Time
+}
+
+// phoneNumberChanger 更換與解除綁定共用的流程（載入 → 變更 → 樂觀鎖更新 → 稽核 → 發布事件）
+type phoneNumberChanger struct {
+	memberRepo member.MemberRepository
+	changeRepo member.PhoneNumberChangeRepository
+	txManager  shared.TransactionManager
+	publisher  shared.EventPublisher
+}
+
+// RebindPhoneNumberUseCase 管理員更換會員手機號碼（會員換號）
+//
+// 業務規則：
+// - 原因必填；新號碼不可已被其他會員綁定
+// - 以樂觀鎖更新會員（同時有其他操作更新時返回 ErrMemberVersionConflict）
+// - 異動紀錄與會員更新在同一事務保存（稽核軌跡）
+//
+// 事件發布：提交後發布 member.phone_number_changed
+type RebindPhoneNumberUseCase struct {
+	phoneNumberChanger
+}
+
+// NewRebindPhoneNumberUseCase 創建 Use Case 實例
+func NewRebindPhoneNumberUseCase(
+	memberRepo member.MemberRepository,
+	changeRepo member.PhoneNumberChangeRepository,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *RebindPhoneNumberUseCase {
+	return &RebindPhoneNumberUseCase{phoneNumberChanger{
+		memberRepo: memberRepo,
+		changeRepo: changeRepo,
+		txManager:  txManager,
+		publisher:  publisher,
+	}}
+}
+
+// Execute 更換手機號碼
+//
+// 錯誤處理：
+// - 號碼格式無效 → ErrInvalidPhoneNumberFormat
+// - 號碼已被其他會員綁定 → ErrPhoneNumberAlreadyBound
+// - 原因未填或號碼未變更 → ErrInvalidPhoneNumberChange
+// - 會員不存在 → ErrMemberNotFound
+// - 會員已被其他操作更新 → ErrMemberVersionConflict
+func (uc *RebindPhoneNumberUseCase) Execute(cmd RebindPhoneNumberCommand) (*PhoneNumberChangeResult, error) {
+	phoneNumber, err := member.NewPhoneNumber(cmd.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.execute(cmd.MemberID, func(ctx shared.TransactionContext, m *member.Member) (*member.PhoneNumberChange, error) {
+		// 號碼未變更時由聚合返回 ErrInvalidPhoneNumberChange（不視為重複綁定）
+		if !m.PhoneNumber().Equals(phoneNumber) {
+			exists, err := uc.memberRepo.ExistsByPhoneNumber(ctx, phoneNumber)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check phone number: %w", err)
+			}
+			if exists {
+				return nil, member.ErrPhoneNumberAlreadyBound.WithContext("phone_number", phoneNumber.String())
+			}
+		}
+		return m.RebindPhoneNumber(phoneNumber, cmd.Reason, cmd.OperatorID, cmd.Now)
+	})
+}
+
+// UnbindPhoneNumberUseCase 管理員解除會員手機號碼綁定
+//
+// 業務規則：
+// - 原因必填；會員尚未綁定時返回 ErrPhoneNotBound
+// - 解除後會員需重新綁定手機號碼才算完成註冊
+// - 樂觀鎖、稽核與事件發布同 RebindPhoneNumberUseCase
+type UnbindPhoneNumberUseCase struct {
+	phoneNumberChanger
+}
+
+// NewUnbindPhoneNumberUseCase 創建 Use Case 實例
+func NewUnbindPhoneNumberUseCase(
+	memberRepo member.MemberRepository,
+	changeRepo member.PhoneNumberChangeRepository,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *UnbindPhoneNumberUseCase {
+	return &UnbindPhoneNumberUseCase{phoneNumberChanger{
+		memberRepo: memberRepo,
+		changeRepo: changeRepo,
+		txManager:  txManager,
+		publisher:  publisher,
+	}}
+}
+
+// Execute 解除手機號碼綁定
+func (uc *UnbindPhoneNumberUseCase) Execute(cmd UnbindPhoneNumberCommand) (*PhoneNumberChangeResult, error) {
+	return uc.execute(cmd.MemberID, func(_ shared.TransactionContext, m *member.Member) (*member.PhoneNumberChange, error) {
+		return m.UnbindPhoneNumber(cmd.Reason, cmd.OperatorID, cmd.Now)
+	})
+}
+
+// execute 在事務中載入會員、套用變更、更新會員並保存異動紀錄，提交後發布事件
+func (c phoneNumberChanger) execute(
+	memberIDStr string,
+	change func(ctx shared.TransactionContext, m *member.Member) (*member.PhoneNumberChange, error),
+) (*PhoneNumberChangeResult, error) {
+	memberID, err := member.MemberIDFromString(memberIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var m *member.Member
+	var record *member.PhoneNumberChange
+	err = c.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		m, err = c.memberRepo.FindByMemberID(ctx, memberID)
+		if err != nil {
+			return err
+		}
+		if record, err = change(ctx, m); err != nil {
+			return err
+		}
+		if err := c.memberRepo.Update(ctx, m); err != nil {
+			return err
+		}
+		if err := c.changeRepo.Save(ctx, record); err != nil {
+			return fmt.Errorf("failed to save phone number change: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if events := m.PullEvents(); len(events) > 0 {
+		if err := c.publisher.PublishBatch(events); err != nil {
+			return nil, fmt.Errorf("failed to publish member events: %w", err)
+		}
+	}
+	return toPhoneNumberChangeResult(record), nil
+}
+
+// ===========================
+// ListPhoneNumberChanges Query
+// ===========================
+
+// ListPhoneNumberChangesQuery 查詢會員手機號碼異動紀錄
+type ListPhoneNumberChangesQuery struct {
+	MemberID string
+}
+
+// ListPhoneNumberChangesUseCase 查詢會員手機號碼異動紀錄（新到舊）
+type ListPhoneNumberChangesUseCase struct {
+	changeRepo member.PhoneNumberChangeRepository
+}
+
+// NewListPhoneNumberChangesUseCase 創建 Use Case 實例
+func NewListPhoneNumberChangesUseCase(changeRepo member.PhoneNumberChangeRepository) *ListPhoneNumberChangesUseCase {
+	return &ListPhoneNumberChangesUseCase{changeRepo: changeRepo}
+}
+
+// Execute 執行查詢
+func (uc *ListPhoneNumberChangesUseCase) Execute(query ListPhoneNumberChangesQuery) ([]PhoneNumberChangeResult, error) {
+	memberID, err := member.MemberIDFromString(query.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := uc.changeRepo.FindByMemberID(nil, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list phone number changes: %w", err)
+	}
+
+	results := make([]PhoneNumberChangeResult, 0, len(changes))
+	for _, change := range changes {
+		results = append(results, *toPhoneNumberChangeResult(change))
+	}
+	return results, nil
+}
+
+// toPhoneNumberChangeResult 將異動紀錄轉為輸出 DTO
+func toPhoneNumberChangeResult(change *member.PhoneNumberChange) *PhoneNumberChangeResult {
+	result := &PhoneNumberChangeResult{
+		ChangeID:   change.ChangeID(),
+		MemberID:   change.MemberID().String(),
+		Action:     change.Action().String(),
+		Reason:     change.Reason(),
+		OperatorID: change.OperatorID(),
+		ChangedAt:  change.ChangedAt(),
+	}
+	if !change.PreviousPhoneNumber().IsZero() {
+		result.PreviousPhoneNumber = change.PreviousPhoneNumber().String()
+	}
+	if !change.PhoneNumber().IsZero() {
+		result.PhoneNumber = change.PhoneNumber().String()
+	}
+	return result
+}
@@ -0,0 +1,139 @@
+package member
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// RebindPhoneNumber / UnbindPhoneNumber Use Case Tests
+// ===========================
+
+// newBoundMember 建立已綁定手機號碼的會員
+func newBoundMember(t *testing.T, phone string) *member.Member {
+	t.Helper()
+	lineUserID, _ := member.NewLineUserID("U1234567890abcdef1234567890abcdef")
+	m, err := member.NewMember(lineUserID, "John Doe")
+	require.NoError(t, err)
+	phoneNumber, _ := member.NewPhoneNumber(phone)
+	require.NoError(t, m.BindPhoneNumber(phoneNumber))
+	return m
+}
+
+// Test 17: Rebind checks uniqueness, updates with version check, records audit and publishes event
+func TestRebindPhoneNumberUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	changeRepo := &FakePhoneNumberChangeRepository{}
+	publisher := &FakeEventPublisher{}
+	useCase := NewRebindPhoneNumberUseCase(mockRepo, changeRepo, new(MockTransactionManager), publisher)
+	m := newBoundMember(t, "0912345678")
+	newPhone, _ := member.NewPhoneNumber("0987654321")
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	mockRepo.On("FindByMemberID", mock.Anything, m.MemberID()).Return(m, nil)
+	mockRepo.On("ExistsByPhoneNumber", mock.Anything, newPhone).Return(false, nil)
+	mockRepo.On("Update", mock.Anything, m).Return(nil).Once()
+
+	// Act
+	result, err := useCase.Execute(RebindPhoneNumberCommand{
+		MemberID:    m.MemberID().String(),
+		PhoneNumber: "0987654321",
+		Reason:      "會員換號",
+		OperatorID:  "admin",
+		Now:         now,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "rebind", result.Action)
+	assert.Equal(t, "0912345678", result.PreviousPhoneNumber)
+	assert.Equal(t, "0987654321", result.PhoneNumber)
+	assert.Equal(t, now, result.ChangedAt)
+	require.Len(t, changeRepo.changes, 1)
+	assert.Equal(t, result.ChangeID, changeRepo.changes[0].ChangeID())
+	require.Len(t, publisher.events, 1)
+	assert.Equal(t, "member.phone_number_changed", publisher.events[0].EventType())
+	mockRepo.AssertExpectations(t)
+}
+
+// Test 18: Rebind to a number bound by another member, or a version conflict, leaves no audit record
+func TestRebindPhoneNumberUseCase_Execute_Conflicts_ReturnError(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	changeRepo := &FakePhoneNumberChangeRepository{}
+	publisher := &FakeEventPublisher{}
+	useCase := NewRebindPhoneNumberUseCase(mockRepo, changeRepo, new(MockTransactionManager), publisher)
+	m := newBoundMember(t, "0912345678")
+	taken, _ := member.NewPhoneNumber("0911111111")
+	free, _ := member.NewPhoneNumber("0922222222")
+
+	mockRepo.On("FindByMemberID", mock.Anything, m.MemberID()).Return(m, nil)
+	mockRepo.On("ExistsByPhoneNumber", mock.Anything, taken).Return(true, nil)
+	mockRepo.On("ExistsByPhoneNumber", mock.Anything, free).Return(false, nil)
+	mockRepo.On("Update", mock.Anything, m).Return(member.ErrMemberVersionConflict)
+	cmd := RebindPhoneNumberCommand{MemberID: m.MemberID().String(), Reason: "會員換號", OperatorID: "admin"}
+
+	// Act
+	cmd.PhoneNumber = taken.String()
+	_, takenErr := useCase.Execute(cmd)
+	cmd.PhoneNumber = free.String()
+	_, conflictErr := useCase.Execute(cmd)
+
+	// Assert
+	assert.ErrorIs(t, takenErr, member.ErrPhoneNumberAlreadyBound)
+	assert.ErrorIs(t, conflictErr, member.ErrMemberVersionConflict)
+	assert.Empty(t, changeRepo.changes)
+	assert.Empty(t, publisher.events)
+}
+
+// Test 19: Unbind requires a reason and clears the phone number
+func TestUnbindPhoneNumberUseCase_Execute(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	changeRepo := &FakePhoneNumberChangeRepository{}
+	publisher := &FakeEventPublisher{}
+	useCase := NewUnbindPhoneNumberUseCase(mockRepo, changeRepo, new(MockTransactionManager), publisher)
+	m := newBoundMember(t, "0912345678")
+
+	mockRepo.On("FindByMemberID", mock.Anything, m.MemberID()).Return(m, nil)
+	mockRepo.On("Update", mock.Anything, m).Return(nil).Once()
+
+	// Act
+	_, missingReason := useCase.Execute(UnbindPhoneNumberCommand{MemberID: m.MemberID().String(), OperatorID: "admin"})
+	result, err := useCase.Execute(UnbindPhoneNumberCommand{
+		MemberID:   m.MemberID().String(),
+		Reason:     "門號停用",
+		OperatorID: "admin",
+	})
+
+	// Assert
+	assert.ErrorIs(t, missingReason, member.ErrInvalidPhoneNumberChange)
+	require.NoError(t, err)
+	assert.Equal(t, "unbind", result.Action)
+	assert.Empty(t, result.PhoneNumber)
+	assert.False(t, m.HasPhoneNumber())
+	assert.Len(t, changeRepo.changes, 1)
+	assert.Len(t, publisher.events, 1)
+	mockRepo.AssertExpectations(t)
+}
+
+// FakePhoneNumberChangeRepository 記錄保存的異動紀錄
+type FakePhoneNumberChangeRepository struct {
+	changes []*member.PhoneNumberChange
+}
+
+func (r *FakePhoneNumberChangeRepository) Save(ctx shared.TransactionContext, change *member.PhoneNumberChange) error {
+	r.changes = append(r.changes, change)
+	return nil
+}
+
+func (r *FakePhoneNumberChangeRepository) FindByMemberID(ctx shared.TransactionContext, memberID member.MemberID) ([]*member.PhoneNumberChange, error) {
+	return r.changes, nil
+}
@@ -0,0 +1,105 @@
+package member
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+)
+
+// ===========================
+// GetMemberByLineUserID Query
+// ===========================
+
+// GetMemberByLineUserIDQuery 以 LINE UserID 查詢會員
+type GetMemberByLineUserIDQuery struct {
+	LineUserID string
+}
+
+// MemberResult 會員資訊（Output DTO）
+//
+// 欄位：
+// - PhoneNumber: 未綁定時為空字串
+// - IsRegistered: 已綁定手機號碼才算完成註冊（US-001）
+// - IsReachable: 未封鎖官方帳號（可推播）
+// - IsVerifiedAdult: 已由店員查驗證件確認成年（AgeVerifiedBy / AgeVerificationMethod / AgeVerifiedAt 為驗證紀錄）
+// - Version: 版本號（管理後台修改會員資料時帶回，用於偵測並行修改）
+// - MergedInto: 已被合併時為保留的會員 ID（未被合併時為空字串）
+type MemberResult struct {
+	MemberID     string
+	LineUserID   string
+	DisplayName  string
+	PhoneNumber  string
+	IsRegistered bool
+	IsReachable  bool
+	Email        string
+	Note         string
+	Birthday     string
+	Version      int
+	MergedInto   string
+
+	IsVerifiedAdult       bool
+	AgeVerifiedBy         string
+	AgeVerificationMethod string
+	AgeVerifiedAt         *time.Time
+}
+
+// GetMemberByLineUserIDUseCase 以 LINE UserID 查詢會員
+//
+// 使用場景：
+// - LINE Webhook 收到事件時辨識會員身份
+type GetMemberByLineUserIDUseCase struct {
+	memberRepo member.MemberRepository
+}
+
+// NewGetMemberByLineUserIDUseCase 創建 Use Case 實例
+func NewGetMemberByLineUserIDUseCase(memberRepo member.MemberRepository) *GetMemberByLineUserIDUseCase {
+	return &GetMemberByLineUserIDUseCase{
+		memberRepo: memberRepo,
+	}
+}
+
+// Execute 執行查詢
+//
+// 錯誤處理：
+// - ErrInvalidLineUserID: LINE UserID 格式無效
+// - ErrMemberNotFound: 會員不存在
+func (uc *GetMemberByLineUserIDUseCase) Execute(query GetMemberByLineUserIDQuery) (*MemberResult, error) {
+	lineUserID, err := member.NewLineUserID(query.LineUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := uc.memberRepo.FindByLineUserID(nil, lineUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toMemberResult(m), nil
+}
+
+// toMemberResult 將聚合轉為輸出 DTO
+func toMemberResult(m *member.Member) *MemberResult {
+	result := &MemberResult{
+		MemberID:     m.MemberID().String(),
+		LineUserID:   m.LineUserID().String(),
+		DisplayName:  m.DisplayName(),
+		IsRegistered: m.HasPhoneNumber(),
+		IsReachable:  m.IsReachable(),
+		Email:        m.Profile().Email(),
+		Note:         m.Profile().Note(),
+		Birthday:     m.Profile().Birthday().String(),
+		Version:      m.Version(),
+
+		IsVerifiedAdult:       m.IsVerifiedAdult(),
+		AgeVerifiedBy:         m.AgeVerification().VerifiedBy(),
+		AgeVerificationMethod: m.AgeVerification().Method().String(),
+		AgeVerifiedAt:         m.AgeVerification().VerifiedAt(),
+	}
+	if m.HasPhoneNumber() {
+		result.PhoneNumber = m.PhoneNumber().String()
+	}
+	if m.IsMerged() {
+		result.MergedInto = m.MergedInto().String()
+	}
+	return result
+}
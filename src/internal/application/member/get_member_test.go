@@ -0,0 +1,57 @@
+package member
+
+import (
+	"testing"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// GetMemberByLineUserIDUseCase Tests
+// ===========================
+
+// Test 11: Find registered member by LINE UserID
+func TestGetMemberByLineUserIDUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	useCase := NewGetMemberByLineUserIDUseCase(mockRepo)
+
+	lineUserID, _ := member.NewLineUserID("U1234567890abcdef1234567890abcdef")
+	phoneNumber, _ := member.NewPhoneNumber("0912345678")
+	m, err := member.NewMember(lineUserID, "John Doe")
+	require.NoError(t, err)
+	require.NoError(t, m.BindPhoneNumber(phoneNumber))
+
+	mockRepo.On("FindByLineUserID", mock.Anything, lineUserID).Return(m, nil)
+
+	// Act
+	result, err := useCase.Execute(GetMemberByLineUserIDQuery{LineUserID: lineUserID.String()})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, m.MemberID().String(), result.MemberID)
+	assert.Equal(t, "John Doe", result.DisplayName)
+	assert.Equal(t, "0912345678", result.PhoneNumber)
+	assert.True(t, result.IsRegistered)
+
+	mockRepo.AssertExpectations(t)
+}
+
+// Test 12: Member not found
+func TestGetMemberByLineUserIDUseCase_Execute_NotFound_ReturnsError(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	useCase := NewGetMemberByLineUserIDUseCase(mockRepo)
+
+	mockRepo.On("FindByLineUserID", mock.Anything, mock.Anything).Return(nil, member.ErrMemberNotFound)
+
+	// Act
+	result, err := useCase.Execute(GetMemberByLineUserIDQuery{LineUserID: "U1234567890abcdef1234567890abcdef"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, member.ErrMemberNotFound)
+}
@@ -0,0 +1,161 @@
+package member
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// GrantBirthdayBonuses Use Case（排程）
+// ===========================
+
+// GrantBirthdayBonusesCommand 生日禮發放指令
+type GrantBirthdayBonusesCommand struct {
+	Now time.Time
+}
+
+// GrantBirthdayBonusesResult 發放結果
+//
+// 欄位：
+// - Celebrating: 今天（營業時區）生日的會員數
+// - Granted: 本次發放的會員數
+// - AlreadyGranted: 今年已發放（排程重跑）的會員數
+// - NoAccount: 尚未建立積分帳戶而略過的會員數
+type GrantBirthdayBonusesResult struct {
+	Celebrating    int
+	Granted        int
+	AlreadyGranted int
+	NoAccount      int
+}
+
+// birthdayBonusOutcome 單一會員的發放結果
+type birthdayBonusOutcome int
+
+const (
+	birthdayBonusGranted birthdayBonusOutcome = iota
+	birthdayBonusAlreadyGranted
+	birthdayBonusNoAccount
+)
+
+// GrantBirthdayBonusesUseCase 為今天生日的會員發放生日禮積分（排程執行，例如每小時）
+//
+// 業務規則：
+// - 以營業時區（Asia/Taipei）判斷今天；2/29 出生的會員在非閏年 2/28 發放
+// - 每位會員每年一次：發放紀錄 (member_id, year) 唯一，排程重跑或並行執行時不重複入帳
+// - 生日禮點數由 BirthdayBonusPolicy 設定，以 EarnPoints(PointsSourceBirthday) 入帳
+// - 已被合併的會員不發放；尚未建立積分帳戶的會員略過
+// - 每位會員各自一個事務（入帳與發放紀錄同一事務），提交後發布 points.earned 事件
+//
+// 錯誤處理：任一會員失敗即停止並返回已處理的統計（下次排程重試，已發放的會員不重複）
+type GrantBirthdayBonusesUseCase struct {
+	birthdays   member.BirthdayMemberQuery
+	accountRepo points.PointsAccountRepository
+	grantRepo   points.BirthdayBonusGrantRepository
+	policy      points.BirthdayBonusPolicy
+	txManager   shared.TransactionManager
+	publisher   shared.EventPublisher
+}
+
+// NewGrantBirthdayBonusesUseCase 創建 Use Case 實例
+func NewGrantBirthdayBonusesUseCase(
+	birthdays member.BirthdayMemberQuery,
+	accountRepo points.PointsAccountRepository,
+	grantRepo points.BirthdayBonusGrantRepository,
+	policy points.BirthdayBonusPolicy,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *GrantBirthdayBonusesUseCase {
+	return &GrantBirthdayBonusesUseCase{
+		birthdays:   birthdays,
+		accountRepo: accountRepo,
+		grantRepo:   grantRepo,
+		policy:      policy,
+		txManager:   txManager,
+		publisher:   publisher,
+	}
+}
+
+// Execute 執行發放
+func (uc *GrantBirthdayBonusesUseCase) Execute(cmd GrantBirthdayBonusesCommand) (*GrantBirthdayBonusesResult, error) {
+	memberIDs, err := uc.birthdays.FindCelebratingOn(nil, cmd.Now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find birthday members: %w", err)
+	}
+
+	year := cmd.Now.In(shared.BusinessLocation).Year()
+	result := &GrantBirthdayBonusesResult{Celebrating: len(memberIDs)}
+	for _, memberID := range memberIDs {
+		outcome, err := uc.grant(memberID, year, cmd.Now)
+		if err != nil {
+			return result, err
+		}
+		switch outcome {
+		case birthdayBonusGranted:
+			result.Granted++
+		case birthdayBonusAlreadyGranted:
+			result.AlreadyGranted++
+		case birthdayBonusNoAccount:
+			result.NoAccount++
+		}
+	}
+	return result, nil
+}
+
+// grant 為單一會員發放生日禮（各自一個事務，提交後發布事件）
+func (uc *GrantBirthdayBonusesUseCase) grant(
+	memberID member.MemberID,
+	year int,
+	now time.Time,
+) (birthdayBonusOutcome, error) {
+	pointsMemberID, err := points.MemberIDFromString(memberID.String())
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse member ID: %w", err)
+	}
+
+	var account *points.PointsAccount
+	outcome := birthdayBonusGranted
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		granted, err := uc.grantRepo.ExistsForYear(ctx, pointsMemberID, year)
+		if err != nil {
+			return fmt.Errorf("failed to check birthday bonus: %w", err)
+		}
+		if granted {
+			outcome = birthdayBonusAlreadyGranted
+			return nil
+		}
+
+		account, err = uc.accountRepo.FindByMemberID(ctx, pointsMemberID)
+		if errors.Is(err, points.ErrAccountNotFound) {
+			outcome = birthdayBonusNoAccount
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find account: %w", err)
+		}
+
+		grant, err := points.GrantBirthdayBonus(account, uc.policy, year, now)
+		if err != nil {
+			return err
+		}
+		if err := uc.accountRepo.Update(ctx, account); err != nil {
+			return fmt.Errorf("failed to update account: %w", err)
+		}
+		return uc.grantRepo.Save(ctx, grant)
+	})
+	if errors.Is(err, points.ErrBirthdayBonusAlreadyGranted) {
+		return birthdayBonusAlreadyGranted, nil
+	}
+	if err != nil || outcome != birthdayBonusGranted {
+		return outcome, err
+	}
+
+	if err := uc.publisher.PublishBatch(account.PullEvents()); err != nil {
+		return outcome, fmt.Errorf("failed to publish points events: %w", err)
+	}
+	return outcome, nil
+}
@@ -0,0 +1,85 @@
+package member
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// GrantBirthdayBonuses Use Case Tests
+// ===========================
+
+// Test 25: Birthday bonus is credited once per year; reruns and members without an account are skipped
+func TestGrantBirthdayBonusesUseCase_Execute_GrantsOncePerYear(t *testing.T) {
+	// Arrange
+	withAccount := member.NewMemberID()
+	withoutAccount := member.NewMemberID()
+	pointsMemberID, _ := points.MemberIDFromString(withAccount.String())
+	account, err := points.NewPointsAccount(pointsMemberID)
+	require.NoError(t, err)
+	account.PullEvents()
+	accounts := &FakePointsAccountRepository{accounts: map[string]*points.PointsAccount{withAccount.String(): account}}
+	birthdays := &StubBirthdayMemberQuery{memberIDs: []member.MemberID{withAccount, withoutAccount}}
+	grants := &FakeBirthdayBonusGrantRepository{}
+	publisher := &FakeEventPublisher{}
+	policy, err := points.NewBirthdayBonusPolicy(50)
+	require.NoError(t, err)
+	useCase := NewGrantBirthdayBonusesUseCase(birthdays, accounts, grants, policy, new(MockTransactionManager), publisher)
+	now := time.Date(2024, 12, 31, 16, 30, 0, 0, time.UTC) // 台北 2025-01-01 00:30
+
+	// Act
+	first, err := useCase.Execute(GrantBirthdayBonusesCommand{Now: now})
+	require.NoError(t, err)
+	rerun, err := useCase.Execute(GrantBirthdayBonusesCommand{Now: now.Add(time.Hour)})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, &GrantBirthdayBonusesResult{Celebrating: 2, Granted: 1, NoAccount: 1}, first)
+	assert.Equal(t, &GrantBirthdayBonusesResult{Celebrating: 2, AlreadyGranted: 1, NoAccount: 1}, rerun)
+	assert.Equal(t, 50, account.EarnedPoints().Value())
+	require.Len(t, grants.grants, 1)
+	assert.Equal(t, 2025, grants.grants[0].Year(), "year follows the business timezone")
+	assert.Equal(t, now.Add(time.Hour), birthdays.at)
+	require.Len(t, publisher.events, 1)
+	assert.Equal(t, "points.earned", publisher.events[0].EventType())
+}
+
+// StubBirthdayMemberQuery 返回預設的生日會員並記錄查詢時間
+type StubBirthdayMemberQuery struct {
+	memberIDs []member.MemberID
+	at        time.Time
+}
+
+func (q *StubBirthdayMemberQuery) FindCelebratingOn(ctx shared.TransactionContext, at time.Time) ([]member.MemberID, error) {
+	q.at = at
+	return q.memberIDs, nil
+}
+
+// FakeBirthdayBonusGrantRepository 保存發放紀錄（in-memory，重複時返回錯誤）
+type FakeBirthdayBonusGrantRepository struct {
+	grants []*points.BirthdayBonusGrant
+}
+
+func (r *FakeBirthdayBonusGrantRepository) Save(ctx shared.TransactionContext, grant *points.BirthdayBonusGrant) error {
+	exists, _ := r.ExistsForYear(ctx, grant.MemberID(), grant.Year())
+	if exists {
+		return points.ErrBirthdayBonusAlreadyGranted
+	}
+	r.grants = append(r.grants, grant)
+	return nil
+}
+
+func (r *FakeBirthdayBonusGrantRepository) ExistsForYear(ctx shared.TransactionContext, memberID points.MemberID, year int) (bool, error) {
+	for _, grant := range r.grants {
+		if grant.MemberID().Equals(memberID) && grant.Year() == year {
+			return true, nil
+		}
+	}
+	return false, nil
+}
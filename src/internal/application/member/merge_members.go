@@ -0,0 +1,313 @@
+package member
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// MergeMembers Use Case（管理後台）
+// ===========================
+
+// MergeMembersCommand 合併重複會員指令
+//
+// 欄位：
+// - PrimaryMemberID: 保留的會員
+// - SecondaryMemberID: 被合併（停用）的會員
+// - Reason: 合併原因（必填）
+// - OperatorID: 操作的管理員
+type MergeMembersCommand struct {
+	PrimaryMemberID   string
+	SecondaryMemberID string
+	Reason            string
+	OperatorID        string
+	Now               time.Time
+}
+
+// MemberMergeResult 會員合併紀錄（Output DTO）
+//
+// 欄位：
+// - ReleasedPhoneNumber: 次要會員釋出的手機號碼（未綁定時為空字串）
+// - EarnedPoints / UsedPoints: 移轉的累積獲得 / 使用積分
+// - Transactions / SurveyResponses / Adjustments: 改歸屬的筆數
+type MemberMergeResult struct {
+	MergeID             string
+	PrimaryMemberID     string
+	SecondaryMemberID   string
+	Reason              string
+	OperatorID          string
+	ReleasedPhoneNumber string
+	EarnedPoints        int
+	UsedPoints          int
+	Transactions        int
+	SurveyResponses     int
+	Adjustments         int
+	MergedAt            time.Time
+}
+
+// MemberHistoryReassigners 會員合併時需改歸屬的歷史資料
+type MemberHistoryReassigners struct {
+	Transactions    member.MemberHistoryReassigner
+	SurveyResponses member.MemberHistoryReassigner
+	Adjustments     member.MemberHistoryReassigner
+}
+
+// MergeMembersUseCase 合併重複會員（同一顧客以第二個 LINE 帳號重複註冊）
+//
+// 業務規則：
+// - 原因必填；不可與自身合併；已被合併的會員不可再合併（ErrMemberMerged）
+// - 次要會員的累積獲得 / 使用積分併入保留會員的積分帳戶，次要帳戶歸零（保留會員沒有帳戶時建立）
+// - 任一積分帳戶凍結中不可合併（points.ErrAccountFrozen）
+// - 發票交易、問卷回覆、積分調整紀錄改歸屬保留會員
+// - 次要會員停用並指向保留會員，釋出手機號碼
+// - 保留會員與次要會員皆以樂觀鎖更新（並行合併到同一保留會員時只有一筆成功）
+// - 以上與合併紀錄在同一事務完成（任一步驟失敗全部回滾）
+//
+// 事件發布：提交後發布 member.merged、points.account_merged（及新建帳戶時的 points.account_created）
+type MergeMembersUseCase struct {
+	memberRepo  member.MemberRepository
+	mergeRepo   member.MemberMergeRepository
+	accountRepo points.PointsAccountRepository
+	reassigners MemberHistoryReassigners
+	txManager   shared.TransactionManager
+	publisher   shared.EventPublisher
+}
+
+// NewMergeMembersUseCase 創建 Use Case 實例
+func NewMergeMembersUseCase(
+	memberRepo member.MemberRepository,
+	mergeRepo member.MemberMergeRepository,
+	accountRepo points.PointsAccountRepository,
+	reassigners MemberHistoryReassigners,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *MergeMembersUseCase {
+	return &MergeMembersUseCase{
+		memberRepo:  memberRepo,
+		mergeRepo:   mergeRepo,
+		accountRepo: accountRepo,
+		reassigners: reassigners,
+		txManager:   txManager,
+		publisher:   publisher,
+	}
+}
+
+// Execute 合併會員
+//
+// 錯誤處理：
+// - 會員 ID 無效 → ErrInvalidMemberID
+// - 原因未填、與自身合併 → ErrInvalidMemberMerge
+// - 會員不存在 → ErrMemberNotFound
+// - 任一會員已被合併 → ErrMemberMerged
+// - 積分帳戶凍結中 → points.ErrAccountFrozen
+// - 會員已被其他操作更新 → ErrMemberVersionConflict
+func (uc *MergeMembersUseCase) Execute(cmd MergeMembersCommand) (*MemberMergeResult, error) {
+	primaryID, err := member.MemberIDFromString(cmd.PrimaryMemberID)
+	if err != nil {
+		return nil, err
+	}
+	secondaryID, err := member.MemberIDFromString(cmd.SecondaryMemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	var secondary *member.Member
+	var record *member.MemberMerge
+	var accounts []*points.PointsAccount
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		primary, err := uc.memberRepo.FindByMemberID(ctx, primaryID)
+		if err != nil {
+			return err
+		}
+		secondary, err = uc.memberRepo.FindByMemberID(ctx, secondaryID)
+		if err != nil {
+			return err
+		}
+		if record, err = secondary.MergeInto(primary, cmd.Reason, cmd.OperatorID, cmd.Now); err != nil {
+			return err
+		}
+
+		var transfer member.MergeTransfer
+		if accounts, err = uc.mergePointsAccounts(ctx, record, &transfer, cmd.Now); err != nil {
+			return err
+		}
+		if err := uc.reassignHistory(ctx, &transfer, secondaryID, primaryID); err != nil {
+			return err
+		}
+		record.RecordTransfer(transfer)
+
+		if err := uc.memberRepo.Update(ctx, primary); err != nil {
+			return err
+		}
+		if err := uc.memberRepo.Update(ctx, secondary); err != nil {
+			return err
+		}
+		if err := uc.mergeRepo.Save(ctx, record); err != nil {
+			return fmt.Errorf("failed to save member merge: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := secondary.PullEvents()
+	for _, account := range accounts {
+		events = append(events, account.PullEvents()...)
+	}
+	if err := uc.publisher.PublishBatch(events); err != nil {
+		return nil, fmt.Errorf("failed to publish member merge events: %w", err)
+	}
+	return toMemberMergeResult(record), nil
+}
+
+// mergePointsAccounts 將次要會員的積分帳戶併入保留會員（次要會員沒有帳戶時略過）
+//
+// 返回：有變更的帳戶（提交後發布事件）
+func (uc *MergeMembersUseCase) mergePointsAccounts(
+	ctx shared.TransactionContext,
+	record *member.MemberMerge,
+	transfer *member.MergeTransfer,
+	now time.Time,
+) ([]*points.PointsAccount, error) {
+	secondaryID, err := points.MemberIDFromString(record.SecondaryMemberID().String())
+	if err != nil {
+		return nil, err
+	}
+	source, err := uc.accountRepo.FindByMemberID(ctx, secondaryID)
+	if errors.Is(err, points.ErrAccountNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find secondary points account: %w", err)
+	}
+
+	target, err := uc.findOrCreateAccount(ctx, record.PrimaryMemberID())
+	if err != nil {
+		return nil, err
+	}
+
+	transfer.EarnedPoints = source.EarnedPoints().Value()
+	transfer.UsedPoints = source.UsedPoints().Value()
+	if err := target.AbsorbAccount(source, record.MergeID(), now); err != nil {
+		return nil, err
+	}
+	if err := uc.accountRepo.Update(ctx, target); err != nil {
+		return nil, fmt.Errorf("failed to update primary points account: %w", err)
+	}
+	if err := uc.accountRepo.Update(ctx, source); err != nil {
+		return nil, fmt.Errorf("failed to update secondary points account: %w", err)
+	}
+	return []*points.PointsAccount{target, source}, nil
+}
+
+// findOrCreateAccount 查詢保留會員的積分帳戶（尚未建立時在同一事務內建立）
+func (uc *MergeMembersUseCase) findOrCreateAccount(
+	ctx shared.TransactionContext,
+	memberID member.MemberID,
+) (*points.PointsAccount, error) {
+	accountMemberID, err := points.MemberIDFromString(memberID.String())
+	if err != nil {
+		return nil, err
+	}
+	account, err := uc.accountRepo.FindByMemberID(ctx, accountMemberID)
+	if err == nil {
+		return account, nil
+	}
+	if !errors.Is(err, points.ErrAccountNotFound) {
+		return nil, fmt.Errorf("failed to find primary points account: %w", err)
+	}
+
+	account, err = points.NewPointsAccount(accountMemberID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.accountRepo.Save(ctx, account); err != nil {
+		return nil, fmt.Errorf("failed to create primary points account: %w", err)
+	}
+	return account, nil
+}
+
+// reassignHistory 將發票交易、問卷回覆、積分調整改歸屬保留會員並記錄筆數
+func (uc *MergeMembersUseCase) reassignHistory(
+	ctx shared.TransactionContext,
+	transfer *member.MergeTransfer,
+	from, to member.MemberID,
+) error {
+	var err error
+	if transfer.Transactions, err = uc.reassigners.Transactions.ReassignMember(ctx, from, to); err != nil {
+		return fmt.Errorf("failed to reassign transactions: %w", err)
+	}
+	if transfer.SurveyResponses, err = uc.reassigners.SurveyResponses.ReassignMember(ctx, from, to); err != nil {
+		return fmt.Errorf("failed to reassign survey responses: %w", err)
+	}
+	if transfer.Adjustments, err = uc.reassigners.Adjustments.ReassignMember(ctx, from, to); err != nil {
+		return fmt.Errorf("failed to reassign points adjustments: %w", err)
+	}
+	return nil
+}
+
+// ===========================
+// ListMemberMerges Query
+// ===========================
+
+// ListMemberMergesQuery 查詢會員合併紀錄
+type ListMemberMergesQuery struct {
+	MemberID string
+}
+
+// ListMemberMergesUseCase 查詢會員（作為保留或被合併的一方）的合併紀錄（新到舊）
+type ListMemberMergesUseCase struct {
+	mergeRepo member.MemberMergeRepository
+}
+
+// NewListMemberMergesUseCase 創建 Use Case 實例
+func NewListMemberMergesUseCase(mergeRepo member.MemberMergeRepository) *ListMemberMergesUseCase {
+	return &ListMemberMergesUseCase{mergeRepo: mergeRepo}
+}
+
+// Execute 執行查詢
+func (uc *ListMemberMergesUseCase) Execute(query ListMemberMergesQuery) ([]MemberMergeResult, error) {
+	memberID, err := member.MemberIDFromString(query.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	merges, err := uc.mergeRepo.FindByMemberID(nil, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list member merges: %w", err)
+	}
+
+	results := make([]MemberMergeResult, 0, len(merges))
+	for _, merge := range merges {
+		results = append(results, *toMemberMergeResult(merge))
+	}
+	return results, nil
+}
+
+// toMemberMergeResult 將合併紀錄轉為輸出 DTO
+func toMemberMergeResult(merge *member.MemberMerge) *MemberMergeResult {
+	transfer := merge.Transfer()
+	result := &MemberMergeResult{
+		MergeID:           merge.MergeID(),
+		PrimaryMemberID:   merge.PrimaryMemberID().String(),
+		SecondaryMemberID: merge.SecondaryMemberID().String(),
+		Reason:            merge.Reason(),
+		OperatorID:        merge.OperatorID(),
+		EarnedPoints:      transfer.EarnedPoints,
+		UsedPoints:        transfer.UsedPoints,
+		Transactions:      transfer.Transactions,
+		SurveyResponses:   transfer.SurveyResponses,
+		Adjustments:       transfer.Adjustments,
+		MergedAt:          merge.MergedAt(),
+	}
+	if !merge.ReleasedPhoneNumber().IsZero() {
+		result.ReleasedPhoneNumber = merge.ReleasedPhoneNumber().String()
+	}
+	return result
+}
@@ -0,0 +1,233 @@
+package member
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// MergeMembers Use Case Tests
+// ===========================
+
+// newMergeFixture 建立保留會員、已綁定手機的次要會員與各自的積分帳戶
+func newMergeFixture(t *testing.T) (*member.Member, *member.Member, *FakePointsAccountRepository) {
+	t.Helper()
+	primary := newBoundMember(t, "0912345678")
+	secondaryLine, _ := member.NewLineUserID("Uabcdef1234567890abcdef1234567890")
+	secondary, err := member.NewMember(secondaryLine, "John (new phone)")
+	require.NoError(t, err)
+	phone, _ := member.NewPhoneNumber("0987654321")
+	require.NoError(t, secondary.BindPhoneNumber(phone))
+
+	accounts := &FakePointsAccountRepository{accounts: map[string]*points.PointsAccount{}}
+	for _, balance := range []struct {
+		m            *member.Member
+		earned, used int
+	}{{primary, 100, 40}, {secondary, 80, 30}} {
+		memberID, _ := points.MemberIDFromString(balance.m.MemberID().String())
+		account, err := points.NewPointsAccount(memberID)
+		require.NoError(t, err)
+		earned, _ := points.NewPointsAmount(balance.earned)
+		used, _ := points.NewPointsAmount(balance.used)
+		require.NoError(t, account.EarnPoints(earned, points.PointsSourceInvoice, "TX", "test"))
+		require.NoError(t, account.DeductPoints(used, "兌換商品"))
+		account.PullEvents()
+		accounts.accounts[memberID.String()] = account
+	}
+	return primary, secondary, accounts
+}
+
+// Test 22: Merge moves points totals and history, retires the secondary member and saves an audit record
+func TestMergeMembersUseCase_Execute_Success(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	mergeRepo := &FakeMemberMergeRepository{}
+	publisher := &FakeEventPublisher{}
+	primary, secondary, accounts := newMergeFixture(t)
+	useCase := NewMergeMembersUseCase(mockRepo, mergeRepo, accounts, MemberHistoryReassigners{
+		Transactions:    FakeHistoryReassigner{moved: 5},
+		SurveyResponses: FakeHistoryReassigner{moved: 2},
+		Adjustments:     FakeHistoryReassigner{moved: 1},
+	}, new(MockTransactionManager), publisher)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	mockRepo.On("FindByMemberID", mock.Anything, primary.MemberID()).Return(primary, nil)
+	mockRepo.On("FindByMemberID", mock.Anything, secondary.MemberID()).Return(secondary, nil)
+	mockRepo.On("Update", mock.Anything, primary).Return(nil).Once()
+	mockRepo.On("Update", mock.Anything, secondary).Return(nil).Once()
+
+	// Act
+	result, err := useCase.Execute(MergeMembersCommand{
+		PrimaryMemberID:   primary.MemberID().String(),
+		SecondaryMemberID: secondary.MemberID().String(),
+		Reason:            "重複註冊",
+		OperatorID:        "admin",
+		Now:               now,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 80, result.EarnedPoints)
+	assert.Equal(t, 30, result.UsedPoints)
+	assert.Equal(t, 5, result.Transactions)
+	assert.Equal(t, 2, result.SurveyResponses)
+	assert.Equal(t, 1, result.Adjustments)
+	assert.Equal(t, "0987654321", result.ReleasedPhoneNumber)
+	assert.True(t, secondary.IsMerged())
+	assert.Equal(t, primary.MemberID(), secondary.MergedInto())
+	require.Len(t, mergeRepo.merges, 1)
+	assert.Equal(t, result.MergeID, mergeRepo.merges[0].MergeID())
+
+	target := accounts.accounts[primary.MemberID().String()]
+	source := accounts.accounts[secondary.MemberID().String()]
+	assert.Equal(t, 180, target.EarnedPoints().Value())
+	assert.Equal(t, 70, target.UsedPoints().Value())
+	assert.Equal(t, 0, source.EarnedPoints().Value())
+	for _, account := range []*points.PointsAccount{target, source} {
+		_, err := points.ReconstructPointsAccount(
+			account.AccountID(), account.MemberID(),
+			account.EarnedPoints().Value(), account.UsedPoints().Value(),
+			account.CreatedAt(), account.UpdatedAt(), account.Version(),
+		)
+		assert.NoError(t, err, "merged accounts must satisfy reconstruct invariants")
+	}
+
+	eventTypes := make([]string, 0, len(publisher.events))
+	for _, event := range publisher.events {
+		eventTypes = append(eventTypes, event.EventType())
+	}
+	assert.ElementsMatch(t, []string{"member.merged", "points.account_merged"}, eventTypes)
+	mockRepo.AssertExpectations(t)
+}
+
+// Test 23: Frozen points account or an already merged member aborts the merge without an audit record
+func TestMergeMembersUseCase_Execute_Rejected(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	mergeRepo := &FakeMemberMergeRepository{}
+	publisher := &FakeEventPublisher{}
+	primary, secondary, accounts := newMergeFixture(t)
+	useCase := NewMergeMembersUseCase(mockRepo, mergeRepo, accounts, MemberHistoryReassigners{
+		Transactions:    FakeHistoryReassigner{},
+		SurveyResponses: FakeHistoryReassigner{},
+		Adjustments:     FakeHistoryReassigner{},
+	}, new(MockTransactionManager), publisher)
+	require.NoError(t, accounts.accounts[secondary.MemberID().String()].Freeze("調查中", "owner01"))
+	retiredLine, _ := member.NewLineUserID("U00000000000000000000000000000000")
+	retired, _ := member.NewMember(retiredLine, "Old Account")
+	_, err := retired.MergeInto(primary, "重複註冊", "admin", time.Now())
+	require.NoError(t, err)
+
+	mockRepo.On("FindByMemberID", mock.Anything, primary.MemberID()).Return(primary, nil)
+	mockRepo.On("FindByMemberID", mock.Anything, secondary.MemberID()).Return(secondary, nil)
+	mockRepo.On("FindByMemberID", mock.Anything, retired.MemberID()).Return(retired, nil)
+	cmd := MergeMembersCommand{PrimaryMemberID: primary.MemberID().String(), Reason: "重複註冊", OperatorID: "admin"}
+
+	// Act
+	cmd.SecondaryMemberID = secondary.MemberID().String()
+	_, frozenErr := useCase.Execute(cmd)
+	cmd.SecondaryMemberID = retired.MemberID().String()
+	_, mergedErr := useCase.Execute(cmd)
+
+	// Assert
+	assert.ErrorIs(t, frozenErr, points.ErrAccountFrozen)
+	assert.ErrorIs(t, mergedErr, member.ErrMemberMerged)
+	assert.Empty(t, mergeRepo.merges)
+	assert.Empty(t, publisher.events)
+	assert.Equal(t, 100, accounts.accounts[primary.MemberID().String()].EarnedPoints().Value())
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+// Test 27: A concurrent change to the surviving member aborts the merge with a version conflict
+func TestMergeMembersUseCase_Execute_SurvivorVersionConflict(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	mergeRepo := &FakeMemberMergeRepository{}
+	publisher := &FakeEventPublisher{}
+	primary, secondary, accounts := newMergeFixture(t)
+	useCase := NewMergeMembersUseCase(mockRepo, mergeRepo, accounts, MemberHistoryReassigners{
+		Transactions:    FakeHistoryReassigner{},
+		SurveyResponses: FakeHistoryReassigner{},
+		Adjustments:     FakeHistoryReassigner{},
+	}, new(MockTransactionManager), publisher)
+
+	mockRepo.On("FindByMemberID", mock.Anything, primary.MemberID()).Return(primary, nil)
+	mockRepo.On("FindByMemberID", mock.Anything, secondary.MemberID()).Return(secondary, nil)
+	mockRepo.On("Update", mock.Anything, primary).Return(member.ErrMemberVersionConflict).Once()
+
+	// Act
+	_, err := useCase.Execute(MergeMembersCommand{
+		PrimaryMemberID:   primary.MemberID().String(),
+		SecondaryMemberID: secondary.MemberID().String(),
+		Reason:            "重複註冊",
+		OperatorID:        "admin",
+		Now:               time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, member.ErrMemberVersionConflict)
+	assert.Empty(t, mergeRepo.merges)
+	assert.Empty(t, publisher.events)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, secondary)
+}
+
+// FakeMemberMergeRepository 記錄保存的合併紀錄
+type FakeMemberMergeRepository struct {
+	merges []*member.MemberMerge
+}
+
+func (r *FakeMemberMergeRepository) Save(ctx shared.TransactionContext, merge *member.MemberMerge) error {
+	r.merges = append(r.merges, merge)
+	return nil
+}
+
+func (r *FakeMemberMergeRepository) FindByMemberID(ctx shared.TransactionContext, memberID member.MemberID) ([]*member.MemberMerge, error) {
+	return r.merges, nil
+}
+
+// FakeHistoryReassigner 返回固定的改歸屬筆數
+type FakeHistoryReassigner struct {
+	moved int
+}
+
+func (r FakeHistoryReassigner) ReassignMember(ctx shared.TransactionContext, from, to member.MemberID) (int, error) {
+	return r.moved, nil
+}
+
+// FakePointsAccountRepository 以會員 ID 保存積分帳戶（in-memory）
+type FakePointsAccountRepository struct {
+	accounts map[string]*points.PointsAccount
+}
+
+func (r *FakePointsAccountRepository) Save(ctx shared.TransactionContext, account *points.PointsAccount) error {
+	r.accounts[account.MemberID().String()] = account
+	return nil
+}
+
+func (r *FakePointsAccountRepository) FindByID(ctx shared.TransactionContext, accountID points.AccountID) (*points.PointsAccount, error) {
+	for _, account := range r.accounts {
+		if account.AccountID().Equals(accountID) {
+			return account, nil
+		}
+	}
+	return nil, points.ErrAccountNotFound
+}
+
+func (r *FakePointsAccountRepository) FindByMemberID(ctx shared.TransactionContext, memberID points.MemberID) (*points.PointsAccount, error) {
+	if account, ok := r.accounts[memberID.String()]; ok {
+		return account, nil
+	}
+	return nil, points.ErrAccountNotFound
+}
+
+func (r *FakePointsAccountRepository) Update(ctx shared.TransactionContext, account *points.PointsAccount) error {
+	r.accounts[account.MemberID().String()] = account
+	return nil
+}
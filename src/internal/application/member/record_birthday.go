@@ -0,0 +1,94 @@
+package member
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// RecordBirthday Use Case（LINE Bot）
+// ===========================
+
+// RecordBirthdayCommand 會員自行填寫生日指令
+//
+// 欄位：
+// - Birthday: YYYY-MM-DD
+type RecordBirthdayCommand struct {
+	LineUserID string
+	Birthday   string
+	Now        time.Time
+}
+
+// RecordBirthdayResult 填寫結果
+//
+// 欄位：
+// - Changed: 是否新填寫（與已填寫的生日相同時為 false）
+type RecordBirthdayResult struct {
+	MemberID string
+	Birthday string
+	Changed  bool
+}
+
+// RecordBirthdayUseCase 會員於 LINE 填寫生日（用於生日禮）
+//
+// 業務規則：
+// - 僅可填寫一次；已填寫不同的生日時需由管理員於後台修改
+// - 與管理後台同時修改會員時（樂觀鎖衝突）重新載入後再套用
+//
+// 錯誤處理：
+// - 未註冊的 LINE 用戶 → member.ErrMemberNotFound
+// - 生日格式無效 → member.ErrInvalidBirthday
+// - 已填寫不同的生日 → member.ErrBirthdayAlreadySet
+type RecordBirthdayUseCase struct {
+	memberRepo member.MemberRepository
+	txManager  shared.TransactionManager
+}
+
+// NewRecordBirthdayUseCase 創建 Use Case 實例
+func NewRecordBirthdayUseCase(
+	memberRepo member.MemberRepository,
+	txManager shared.TransactionManager,
+) *RecordBirthdayUseCase {
+	return &RecordBirthdayUseCase{
+		memberRepo: memberRepo,
+		txManager:  txManager,
+	}
+}
+
+// Execute 填寫生日
+func (uc *RecordBirthdayUseCase) Execute(cmd RecordBirthdayCommand) (*RecordBirthdayResult, error) {
+	lineUserID, err := member.NewLineUserID(cmd.LineUserID)
+	if err != nil {
+		return nil, err
+	}
+	birthday, err := member.ParseBirthday(cmd.Birthday, cmd.Now)
+	if err != nil {
+		return nil, err
+	}
+
+	var m *member.Member
+	changed := false
+	err = retryOnVersionConflict(func() error {
+		return uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+			m, err = uc.memberRepo.FindByLineUserID(ctx, lineUserID)
+			if err != nil {
+				return err
+			}
+			if changed, err = m.RecordBirthday(birthday, cmd.Now); err != nil || !changed {
+				return err
+			}
+			return uc.memberRepo.Update(ctx, m)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecordBirthdayResult{
+		MemberID: m.MemberID().String(),
+		Birthday: birthday.String(),
+		Changed:  changed,
+	}, nil
+}
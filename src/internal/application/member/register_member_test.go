@@ -25,6 +25,11 @@ func (m *MockMemberRepository) Save(ctx shared.TransactionContext, mem *member.M
 	return args.Error(0)
 }
 
+func (m *MockMemberRepository) Update(ctx shared.TransactionContext, mem *member.Member) error {
+	args := m.Called(ctx, mem)
+	return args.Error(0)
+}
+
 func (m *MockMemberRepository) FindByMemberID(ctx shared.TransactionContext, id member.MemberID) (*member.Member, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -0,0 +1,128 @@
+package member
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+)
+
+// ===========================
+// SearchMembers Query
+// ===========================
+
+// SearchMembersQuery 管理後台會員搜尋
+//
+// 欄位（皆為選填）：
+// - PhoneSuffix: 手機號碼末碼
+// - Name: 顯示名稱部分比對
+// - RegisteredFrom / RegisteredTo: 註冊時間區間 [from, to)
+// - Reachable: 是否可觸及（nil 表示不限）
+// - MinPoints / MaxPoints: 可用積分區間（含端點）
+// - Sort: created_at（預設）/ display_name / available_points
+// - Cursor: 上一頁的 NextCursor
+type SearchMembersQuery struct {
+	PhoneSuffix    string
+	Name           string
+	RegisteredFrom *time.Time
+	RegisteredTo   *time.Time
+	Reachable      *bool
+	MinPoints      *int
+	MaxPoints      *int
+	Sort           string
+	Descending     bool
+	Limit          int
+	Cursor         string
+}
+
+// MemberSummaryResult 會員搜尋結果（含積分餘額）
+type MemberSummaryResult struct {
+	MemberID         string
+	LineUserID       string
+	DisplayName      string
+	PhoneNumber      string
+	IsRegistered     bool
+	IsReachable      bool
+	RegisteredAt     time.Time
+	HasPointsAccount bool
+	EarnedPoints     int
+	UsedPoints       int
+	AvailablePoints  int
+}
+
+// SearchMembersResult 一頁搜尋結果
+//
+// 欄位：
+// - NextCursor: 下一頁游標（空字串表示已無資料）
+type SearchMembersResult struct {
+	Items      []MemberSummaryResult
+	NextCursor string
+}
+
+// SearchMembersUseCase 會員搜尋 Use Case（keyset 分頁）
+type SearchMembersUseCase struct {
+	searchQuery member.MemberSearchQuery
+}
+
+// NewSearchMembersUseCase 創建 Use Case 實例
+func NewSearchMembersUseCase(searchQuery member.MemberSearchQuery) *SearchMembersUseCase {
+	return &SearchMembersUseCase{searchQuery: searchQuery}
+}
+
+// Execute 執行搜尋
+//
+// 錯誤處理：
+// - ErrInvalidMemberSearch: 條件格式或範圍無效
+// - ErrInvalidSearchCursor: 游標無效或與排序方式不一致
+func (uc *SearchMembersUseCase) Execute(query SearchMembersQuery) (*SearchMembersResult, error) {
+	sort, err := member.ParseMemberSortField(query.Sort)
+	if err != nil {
+		return nil, err
+	}
+	criteria := member.MemberSearchCriteria{
+		PhoneSuffix:        query.PhoneSuffix,
+		NameKeyword:        query.Name,
+		RegisteredFrom:     query.RegisteredFrom,
+		RegisteredTo:       query.RegisteredTo,
+		Reachable:          query.Reachable,
+		MinAvailablePoints: query.MinPoints,
+		MaxAvailablePoints: query.MaxPoints,
+		Sort:               sort,
+		Descending:         query.Descending,
+		Limit:              query.Limit,
+	}
+	if query.Cursor != "" {
+		if criteria.Cursor, err = member.ParseMemberSearchCursor(query.Cursor); err != nil {
+			return nil, err
+		}
+	}
+	if criteria, err = criteria.Normalize(); err != nil {
+		return nil, err
+	}
+
+	page, err := uc.searchQuery.Search(nil, criteria)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search members: %w", err)
+	}
+
+	result := &SearchMembersResult{Items: make([]MemberSummaryResult, 0, len(page.Items))}
+	for _, item := range page.Items {
+		result.Items = append(result.Items, MemberSummaryResult{
+			MemberID:         item.MemberID.String(),
+			LineUserID:       item.LineUserID,
+			DisplayName:      item.DisplayName,
+			PhoneNumber:      item.PhoneNumber,
+			IsRegistered:     item.PhoneNumber != "",
+			IsReachable:      item.IsReachable,
+			RegisteredAt:     item.CreatedAt,
+			HasPointsAccount: item.HasPointsAccount,
+			EarnedPoints:     item.EarnedPoints,
+			UsedPoints:       item.UsedPoints,
+			AvailablePoints:  item.AvailablePoints,
+		})
+	}
+	if page.NextCursor != nil {
+		result.NextCursor = page.NextCursor.String()
+	}
+	return result, nil
+}
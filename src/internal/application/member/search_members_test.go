@@ -0,0 +1,91 @@
+package member
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// SearchMembersUseCase Tests
+// ===========================
+
+// Test 15: Search maps criteria and returns an opaque next-page cursor
+func TestSearchMembersUseCase_Execute_MapsCriteriaAndCursor(t *testing.T) {
+	// Arrange
+	registeredAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	last := member.MemberSummary{
+		MemberID:         member.NewMemberID(),
+		DisplayName:      "王小明",
+		PhoneNumber:      "0912345678",
+		IsReachable:      true,
+		CreatedAt:        registeredAt,
+		HasPointsAccount: true,
+		EarnedPoints:     130,
+		UsedPoints:       10,
+		AvailablePoints:  120,
+	}
+	next := member.NewMemberSearchCursor(member.MemberSortByAvailablePoints, true, last)
+	query := &StubMemberSearchQuery{page: &member.MemberSearchPage{Items: []member.MemberSummary{last}, NextCursor: &next}}
+	useCase := NewSearchMembersUseCase(query)
+	minPoints := 100
+
+	// Act
+	result, err := useCase.Execute(SearchMembersQuery{
+		PhoneSuffix: "5678",
+		Name:        " 小明 ",
+		MinPoints:   &minPoints,
+		Sort:        "available_points",
+		Descending:  true,
+	})
+	require.NoError(t, err)
+	secondPage, err := useCase.Execute(SearchMembersQuery{Sort: "available_points", Descending: true, Cursor: result.NextCursor})
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, query.criteria, 2)
+	assert.Equal(t, "小明", query.criteria[0].NameKeyword)
+	assert.Equal(t, member.DefaultMemberSearchLimit, query.criteria[0].Limit)
+	assert.Equal(t, 100, *query.criteria[0].MinAvailablePoints)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "王小明", result.Items[0].DisplayName)
+	assert.True(t, result.Items[0].IsRegistered)
+	assert.Equal(t, registeredAt, result.Items[0].RegisteredAt)
+	assert.Equal(t, 120, result.Items[0].AvailablePoints)
+	assert.Equal(t, next.String(), result.NextCursor)
+	require.NotNil(t, query.criteria[1].Cursor)
+	assert.Equal(t, last.MemberID.String(), query.criteria[1].Cursor.MemberID)
+	assert.NotNil(t, secondPage)
+}
+
+// Test 16: Invalid sort or cursor for a different sort order is rejected
+func TestSearchMembersUseCase_Execute_InvalidInput_ReturnsError(t *testing.T) {
+	// Arrange
+	query := &StubMemberSearchQuery{page: &member.MemberSearchPage{}}
+	useCase := NewSearchMembersUseCase(query)
+	cursor := member.NewMemberSearchCursor(member.MemberSortByDisplayName, false, member.MemberSummary{MemberID: member.NewMemberID()})
+
+	// Act
+	_, badSort := useCase.Execute(SearchMembersQuery{Sort: "phone"})
+	_, mismatch := useCase.Execute(SearchMembersQuery{Cursor: cursor.String()})
+
+	// Assert
+	assert.ErrorIs(t, badSort, member.ErrInvalidMemberSearch)
+	assert.ErrorIs(t, mismatch, member.ErrInvalidSearchCursor)
+	assert.Empty(t, query.criteria)
+}
+
+// StubMemberSearchQuery 記錄搜尋條件並返回固定結果
+type StubMemberSearchQuery struct {
+	criteria []member.MemberSearchCriteria
+	page     *member.MemberSearchPage
+}
+
+func (s *StubMemberSearchQuery) Search(ctx shared.TransactionContext, criteria member.MemberSearchCriteria) (*member.MemberSearchPage, error) {
+	s.criteria = append(s.criteria, criteria)
+	return s.page, nil
+}
@@ -0,0 +1,209 @@
+package member
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// SyncLineProfile Use Case（LINE Bot）
+// ===========================
+
+// SyncLineProfileCommand 同步 LINE 顯示名稱指令
+type SyncLineProfileCommand struct {
+	LineUserID  string
+	DisplayName string
+	Now         time.Time
+}
+
+// SyncLineProfileResult 同步結果
+//
+// 欄位：
+// - Changed: 顯示名稱是否變更（名稱相同時不更新資料庫）
+type SyncLineProfileResult struct {
+	MemberID    string
+	DisplayName string
+	Changed     bool
+}
+
+// SyncLineProfileUseCase 以 LINE Profile 同步會員顯示名稱
+//
+// 業務規則：
+// - 名稱相同時不更新
+// - 與管理後台同時修改會員時（樂觀鎖衝突）重新載入後再套用
+//
+// 錯誤處理：
+// - 未註冊的 LINE 用戶 → member.ErrMemberNotFound（呼叫端可忽略）
+// - 名稱為空或過長 → member.ErrInvalidDisplayName
+type SyncLineProfileUseCase struct {
+	memberRepo member.MemberRepository
+	txManager  shared.TransactionManager
+}
+
+// NewSyncLineProfileUseCase 創建 Use Case 實例
+func NewSyncLineProfileUseCase(
+	memberRepo member.MemberRepository,
+	txManager shared.TransactionManager,
+) *SyncLineProfileUseCase {
+	return &SyncLineProfileUseCase{
+		memberRepo: memberRepo,
+		txManager:  txManager,
+	}
+}
+
+// Execute 同步顯示名稱
+func (uc *SyncLineProfileUseCase) Execute(cmd SyncLineProfileCommand) (*SyncLineProfileResult, error) {
+	lineUserID, err := member.NewLineUserID(cmd.LineUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var m *member.Member
+	changed := false
+	err = retryOnVersionConflict(func() error {
+		return uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+			m, err = uc.memberRepo.FindByLineUserID(ctx, lineUserID)
+			if err != nil {
+				return err
+			}
+			if changed, err = m.ChangeDisplayName(cmd.DisplayName, cmd.Now); err != nil || !changed {
+				return err
+			}
+			return uc.memberRepo.Update(ctx, m)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SyncLineProfileResult{
+		MemberID:    m.MemberID().String(),
+		DisplayName: m.DisplayName(),
+		Changed:     changed,
+	}, nil
+}
+
+// ===========================
+// UpdateMemberProfile Use Case（管理後台）
+// ===========================
+
+// UpdateMemberProfileCommand 管理員修改會員資料指令
+//
+// 欄位：
+// - DisplayName / Email / Note / Birthday: nil 表示不修改（Email、Note、Birthday 可傳空字串清除）
+// - Birthday: YYYY-MM-DD（管理員可修改會員已填寫的生日）
+// - Version: 讀取會員資料時的版本號（必填，用於偵測並行修改）
+type UpdateMemberProfileCommand struct {
+	MemberID    string
+	DisplayName *string
+	Email       *string
+	Note        *string
+	Birthday    *string
+	Version     int
+	Now         time.Time
+}
+
+// UpdateMemberProfileUseCase 管理員修改會員資料
+//
+// 業務規則：
+// - 版本號與目前不符（會員已被 LINE 同步或其他管理員修改）→ ErrMemberVersionConflict，不重試
+// - 內容未變更時不更新資料庫
+// - 寫入時再以樂觀鎖比對版本號（讀取到寫入之間的修改同樣返回衝突）
+type UpdateMemberProfileUseCase struct {
+	memberRepo member.MemberRepository
+	txManager  shared.TransactionManager
+}
+
+// NewUpdateMemberProfileUseCase 創建 Use Case 實例
+func NewUpdateMemberProfileUseCase(
+	memberRepo member.MemberRepository,
+	txManager shared.TransactionManager,
+) *UpdateMemberProfileUseCase {
+	return &UpdateMemberProfileUseCase{
+		memberRepo: memberRepo,
+		txManager:  txManager,
+	}
+}
+
+// Execute 修改會員資料
+//
+// 錯誤處理：
+// - 未提供版本號、Email 或備註格式無效 → ErrInvalidMemberProfile
+// - 生日格式無效 → ErrInvalidBirthday
+// - 顯示名稱無效 → ErrInvalidDisplayName
+// - 會員不存在 → ErrMemberNotFound
+// - 版本號不符 → ErrMemberVersionConflict
+func (uc *UpdateMemberProfileUseCase) Execute(cmd UpdateMemberProfileCommand) (*MemberResult, error) {
+	memberID, err := member.MemberIDFromString(cmd.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.Version <= 0 {
+		return nil, member.ErrInvalidMemberProfile.WithContext("version", "version is required")
+	}
+
+	var m *member.Member
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		m, err = uc.memberRepo.FindByMemberID(ctx, memberID)
+		if err != nil {
+			return err
+		}
+		if m.Version() != cmd.Version {
+			return member.ErrMemberVersionConflict.WithContext(
+				"member_id", cmd.MemberID,
+				"expected_version", strconv.Itoa(cmd.Version),
+				"current_version", strconv.Itoa(m.Version()),
+			)
+		}
+
+		changed := false
+		if cmd.DisplayName != nil {
+			if changed, err = m.ChangeDisplayName(*cmd.DisplayName, cmd.Now); err != nil {
+				return err
+			}
+		}
+
+		email, note := m.Profile().Email(), m.Profile().Note()
+		if cmd.Email != nil {
+			email = *cmd.Email
+		}
+		if cmd.Note != nil {
+			note = *cmd.Note
+		}
+		profile, err := member.NewMemberProfile(email, note)
+		if err != nil {
+			return err
+		}
+		birthday := m.Profile().Birthday()
+		if cmd.Birthday != nil {
+			if birthday, err = parseOptionalBirthday(*cmd.Birthday, cmd.Now); err != nil {
+				return err
+			}
+		}
+		if m.UpdateProfile(profile.WithBirthday(birthday), cmd.Now) {
+			changed = true
+		}
+
+		if !changed {
+			return nil
+		}
+		return uc.memberRepo.Update(ctx, m)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toMemberResult(m), nil
+}
+
+// parseOptionalBirthday 解析生日（空字串表示清除）
+func parseOptionalBirthday(s string, now time.Time) (member.Birthday, error) {
+	if strings.TrimSpace(s) == "" {
+		return member.Birthday{}, nil
+	}
+	return member.ParseBirthday(s, now)
+}
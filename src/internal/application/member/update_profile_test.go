@@ -0,0 +1,113 @@
+package member
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// SyncLineProfile / UpdateMemberProfile Use Case Tests
+// ===========================
+
+// Test 20: LINE display name sync reloads and retries after a version conflict
+func TestSyncLineProfileUseCase_Execute_RetriesOnVersionConflict(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	useCase := NewSyncLineProfileUseCase(mockRepo, new(MockTransactionManager))
+	lineUserID, _ := member.NewLineUserID("U1234567890abcdef1234567890abcdef")
+	stale, _ := member.NewMember(lineUserID, "John Doe")
+	fresh, _ := member.NewMember(lineUserID, "John Doe")
+
+	mockRepo.On("FindByLineUserID", mock.Anything, lineUserID).Return(stale, nil).Once()
+	mockRepo.On("FindByLineUserID", mock.Anything, lineUserID).Return(fresh, nil).Once()
+	mockRepo.On("Update", mock.Anything, stale).Return(member.ErrMemberVersionConflict).Once()
+	mockRepo.On("Update", mock.Anything, fresh).Return(nil).Once()
+
+	// Act
+	result, err := useCase.Execute(SyncLineProfileCommand{
+		LineUserID:  lineUserID.String(),
+		DisplayName: "小明",
+		Now:         time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.Changed)
+	assert.Equal(t, "小明", result.DisplayName)
+	assert.Equal(t, "小明", fresh.DisplayName())
+	mockRepo.AssertExpectations(t)
+}
+
+// Test 21: Admin profile update requires the version that was read and applies partial changes
+func TestUpdateMemberProfileUseCase_Execute(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	useCase := NewUpdateMemberProfileUseCase(mockRepo, new(MockTransactionManager))
+	m := newBoundMember(t, "0912345678")
+	readVersion := m.Version()
+	email, note := "john@example.com", "常客"
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	mockRepo.On("FindByMemberID", mock.Anything, m.MemberID()).Return(m, nil)
+	mockRepo.On("Update", mock.Anything, m).Return(nil).Once()
+
+	// Act
+	_, missingVersion := useCase.Execute(UpdateMemberProfileCommand{MemberID: m.MemberID().String(), Email: &email})
+	_, stale := useCase.Execute(UpdateMemberProfileCommand{
+		MemberID: m.MemberID().String(), Email: &email, Version: readVersion - 1, Now: now,
+	})
+	result, err := useCase.Execute(UpdateMemberProfileCommand{
+		MemberID: m.MemberID().String(), Email: &email, Note: &note, Version: readVersion, Now: now,
+	})
+
+	// Assert
+	assert.ErrorIs(t, missingVersion, member.ErrInvalidMemberProfile)
+	assert.ErrorIs(t, stale, member.ErrMemberVersionConflict)
+	require.NoError(t, err)
+	assert.Equal(t, "John Doe", result.DisplayName, "display name should be kept when not provided")
+	assert.Equal(t, "john@example.com", result.Email)
+	assert.Equal(t, "常客", result.Note)
+	assert.Equal(t, readVersion+1, result.Version)
+	mockRepo.AssertExpectations(t)
+}
+
+// Test 24: Members record their birthday once through LINE; only an admin can change it afterwards
+func TestRecordBirthdayUseCase_Execute(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	recordBirthday := NewRecordBirthdayUseCase(mockRepo, new(MockTransactionManager))
+	updateProfile := NewUpdateMemberProfileUseCase(mockRepo, new(MockTransactionManager))
+	m := newBoundMember(t, "0912345678")
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	corrected := "1990-06-02"
+
+	mockRepo.On("FindByLineUserID", mock.Anything, m.LineUserID()).Return(m, nil)
+	mockRepo.On("FindByMemberID", mock.Anything, m.MemberID()).Return(m, nil)
+	mockRepo.On("Update", mock.Anything, m).Return(nil).Twice()
+
+	// Act
+	_, invalid := recordBirthday.Execute(RecordBirthdayCommand{LineUserID: m.LineUserID().String(), Birthday: "6/1", Now: now})
+	recorded, err := recordBirthday.Execute(RecordBirthdayCommand{LineUserID: m.LineUserID().String(), Birthday: "1990-06-01", Now: now})
+	require.NoError(t, err)
+	repeated, err := recordBirthday.Execute(RecordBirthdayCommand{LineUserID: m.LineUserID().String(), Birthday: "1990-06-01", Now: now})
+	require.NoError(t, err)
+	_, selfChange := recordBirthday.Execute(RecordBirthdayCommand{LineUserID: m.LineUserID().String(), Birthday: corrected, Now: now})
+	updated, err := updateProfile.Execute(UpdateMemberProfileCommand{
+		MemberID: m.MemberID().String(), Birthday: &corrected, Version: m.Version(), Now: now,
+	})
+	require.NoError(t, err)
+
+	// Assert
+	assert.ErrorIs(t, invalid, member.ErrInvalidBirthday)
+	assert.True(t, recorded.Changed)
+	assert.Equal(t, "1990-06-01", recorded.Birthday)
+	assert.False(t, repeated.Changed)
+	assert.ErrorIs(t, selfChange, member.ErrBirthdayAlreadySet)
+	assert.Equal(t, corrected, updated.Birthday)
+	mockRepo.AssertExpectations(t)
+}
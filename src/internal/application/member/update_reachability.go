@@ -0,0 +1,112 @@
+package member
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// UpdateReachability Use Case
+// ===========================
+
+// UpdateReachabilityCommand 更新會員可觸及狀態指令
+//
+// 欄位：
+// - Reachable: false 封鎖（LINE unfollow）；true 解除封鎖（LINE follow）
+// - OccurredAt: LINE 事件時間
+type UpdateReachabilityCommand struct {
+	LineUserID string
+	Reachable  bool
+	OccurredAt time.Time
+}
+
+// ReachabilityResult 會員可觸及狀態
+//
+// 欄位：
+// - Changed: 本次是否變更狀態（重送或過期的事件為 false）
+type ReachabilityResult struct {
+	MemberID     string
+	Reachable    bool
+	UnfollowedAt *time.Time
+	Changed      bool
+}
+
+// UpdateReachabilityUseCase 處理會員封鎖 / 解除封鎖
+//
+// 業務規則：
+// - 封鎖：記錄封鎖時間，之後不再推播通知與群發訊息
+// - 解除封鎖：恢復可觸及，保留會員資料（不需重新註冊）
+// - 重送或過期的事件不變更狀態、不發布事件
+// - 與管理後台同時修改會員時（樂觀鎖衝突）重新載入後再套用
+//
+// 事件發布：提交後發布 member.unfollowed / member.refollowed
+// （群發排除名單由 application/broadcast.MemberReachabilityHandler 維護）
+//
+// 錯誤處理：
+// - 未註冊的 LINE 用戶 → member.ErrMemberNotFound（呼叫端可忽略）
+type UpdateReachabilityUseCase struct {
+	memberRepo member.MemberRepository
+	txManager  shared.TransactionManager
+	publisher  shared.EventPublisher
+}
+
+// NewUpdateReachabilityUseCase 創建 Use Case 實例
+func NewUpdateReachabilityUseCase(
+	memberRepo member.MemberRepository,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *UpdateReachabilityUseCase {
+	return &UpdateReachabilityUseCase{
+		memberRepo: memberRepo,
+		txManager:  txManager,
+		publisher:  publisher,
+	}
+}
+
+// Execute 更新會員可觸及狀態
+func (uc *UpdateReachabilityUseCase) Execute(cmd UpdateReachabilityCommand) (*ReachabilityResult, error) {
+	lineUserID, err := member.NewLineUserID(cmd.LineUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var m *member.Member
+	changed := false
+	err = retryOnVersionConflict(func() error {
+		return uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+			m, err = uc.memberRepo.FindByLineUserID(ctx, lineUserID)
+			if err != nil {
+				return err
+			}
+
+			if cmd.Reachable {
+				changed = m.MarkRefollowed(cmd.OccurredAt)
+			} else {
+				changed = m.MarkUnfollowed(cmd.OccurredAt)
+			}
+			if !changed {
+				return nil
+			}
+			return uc.memberRepo.Update(ctx, m)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if events := m.PullEvents(); len(events) > 0 {
+		if err := uc.publisher.PublishBatch(events); err != nil {
+			return nil, fmt.Errorf("failed to publish member events: %w", err)
+		}
+	}
+
+	return &ReachabilityResult{
+		MemberID:     m.MemberID().String(),
+		Reachable:    m.IsReachable(),
+		UnfollowedAt: m.UnfollowedAt(),
+		Changed:      changed,
+	}, nil
+}
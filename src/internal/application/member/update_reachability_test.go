@@ -0,0 +1,93 @@
+package member
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// UpdateReachabilityUseCase Tests
+// ===========================
+
+// FakeEventPublisher 記錄發布的事件
+type FakeEventPublisher struct {
+	events []shared.DomainEvent
+}
+
+func (p *FakeEventPublisher) Publish(event shared.DomainEvent) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *FakeEventPublisher) PublishBatch(events []shared.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+// Test 13: Unfollow marks member unreachable and publishes event
+func TestUpdateReachabilityUseCase_Execute_Unfollow_PublishesEvent(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	publisher := &FakeEventPublisher{}
+	useCase := NewUpdateReachabilityUseCase(mockRepo, new(MockTransactionManager), publisher)
+
+	lineUserID, _ := member.NewLineUserID("U1234567890abcdef1234567890abcdef")
+	m, err := member.NewMember(lineUserID, "John Doe")
+	require.NoError(t, err)
+	unfollowedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	mockRepo.On("FindByLineUserID", mock.Anything, lineUserID).Return(m, nil)
+	mockRepo.On("Update", mock.Anything, m).Return(nil).Once()
+
+	// Act
+	result, err := useCase.Execute(UpdateReachabilityCommand{
+		LineUserID: lineUserID.String(),
+		Reachable:  false,
+		OccurredAt: unfollowedAt,
+	})
+	redelivered, redeliveredErr := useCase.Execute(UpdateReachabilityCommand{
+		LineUserID: lineUserID.String(),
+		Reachable:  false,
+		OccurredAt: unfollowedAt,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.Changed)
+	assert.False(t, result.Reachable)
+	assert.Equal(t, unfollowedAt, *result.UnfollowedAt)
+	require.NoError(t, redeliveredErr)
+	assert.False(t, redelivered.Changed, "redelivered webhook should not change state")
+	require.Len(t, publisher.events, 1)
+	assert.Equal(t, "member.unfollowed", publisher.events[0].EventType())
+
+	mockRepo.AssertExpectations(t)
+}
+
+// Test 14: Re-follow of unknown LINE user returns ErrMemberNotFound
+func TestUpdateReachabilityUseCase_Execute_UnknownUser_ReturnsNotFound(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	publisher := &FakeEventPublisher{}
+	useCase := NewUpdateReachabilityUseCase(mockRepo, new(MockTransactionManager), publisher)
+
+	mockRepo.On("FindByLineUserID", mock.Anything, mock.Anything).Return(nil, member.ErrMemberNotFound)
+
+	// Act
+	result, err := useCase.Execute(UpdateReachabilityCommand{
+		LineUserID: "U1234567890abcdef1234567890abcdef",
+		Reachable:  true,
+		OccurredAt: time.Now(),
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, member.ErrMemberNotFound)
+	assert.Empty(t, publisher.events)
+}
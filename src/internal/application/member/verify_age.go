@@ -0,0 +1,104 @@
+package member
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// VerifyMemberAge Use Case（管理後台 / 店員）
+// ===========================
+
+// VerifyMemberAgeCommand 成年驗證指令
+//
+// 欄位：
+// - Method: 驗證方式（空字串表示店員查驗身分證件）
+// - OperatorID: 查驗的店員 / 管理員
+// - Version: 讀取會員資料時的版本號（必填，用於偵測並行修改）
+type VerifyMemberAgeCommand struct {
+	MemberID   string
+	Method     string
+	OperatorID string
+	Version    int
+	Now        time.Time
+}
+
+// VerifyMemberAgeUseCase 店員查驗證件後將會員標記為已驗證成年
+//
+// 業務規則：
+// - 記錄查驗人員、方式與時間（不保存證件號碼）
+// - 已驗證的會員不重複記錄（保留最初的驗證紀錄）
+// - 會員已填寫生日且未滿 18 歲 → ErrMemberUnderage
+// - 版本號與目前不符 → ErrMemberVersionConflict，不重試
+//
+// 使用場景：酒類促銷群發、酒類獎勵兌換僅限已驗證成年的會員
+type VerifyMemberAgeUseCase struct {
+	memberRepo member.MemberRepository
+	txManager  shared.TransactionManager
+}
+
+// NewVerifyMemberAgeUseCase 創建 Use Case 實例
+func NewVerifyMemberAgeUseCase(
+	memberRepo member.MemberRepository,
+	txManager shared.TransactionManager,
+) *VerifyMemberAgeUseCase {
+	return &VerifyMemberAgeUseCase{
+		memberRepo: memberRepo,
+		txManager:  txManager,
+	}
+}
+
+// Execute 記錄成年驗證
+//
+// 錯誤處理：
+// - 未提供版本號、缺少操作者、驗證方式無效 → ErrInvalidAgeVerification
+// - 會員不存在 → ErrMemberNotFound
+// - 會員已被合併 → ErrMemberMerged
+// - 未滿 18 歲 → ErrMemberUnderage
+// - 版本號不符 → ErrMemberVersionConflict
+func (uc *VerifyMemberAgeUseCase) Execute(cmd VerifyMemberAgeCommand) (*MemberResult, error) {
+	memberID, err := member.MemberIDFromString(cmd.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.Version <= 0 {
+		return nil, member.ErrInvalidAgeVerification.WithContext("version", "version is required")
+	}
+	method := member.AgeVerifiedByStaffIDCheck
+	if cmd.Method != "" {
+		method = member.AgeVerificationMethod(cmd.Method)
+	}
+	verification, err := member.NewAgeVerification(cmd.OperatorID, method, cmd.Now)
+	if err != nil {
+		return nil, err
+	}
+
+	var m *member.Member
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		m, err = uc.memberRepo.FindByMemberID(ctx, memberID)
+		if err != nil {
+			return err
+		}
+		if m.Version() != cmd.Version {
+			return member.ErrMemberVersionConflict.WithContext(
+				"member_id", cmd.MemberID,
+				"expected_version", strconv.Itoa(cmd.Version),
+				"current_version", strconv.Itoa(m.Version()),
+			)
+		}
+
+		changed, err := m.VerifyAge(verification)
+		if err != nil || !changed {
+			return err
+		}
+		return uc.memberRepo.Update(ctx, m)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toMemberResult(m), nil
+}
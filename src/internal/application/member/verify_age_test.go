@@ -0,0 +1,53 @@
+package member
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// VerifyMemberAge Use Case Tests
+// ===========================
+
+// Test 26: Staff ID check records who verified the member and when; stale versions and repeat checks do not write
+func TestVerifyMemberAgeUseCase_Execute(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockMemberRepository)
+	useCase := NewVerifyMemberAgeUseCase(mockRepo, new(MockTransactionManager))
+	m := newBoundMember(t, "0912345678")
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	version := m.Version()
+
+	mockRepo.On("FindByMemberID", mock.Anything, m.MemberID()).Return(m, nil)
+	mockRepo.On("Update", mock.Anything, m).Return(nil).Once()
+
+	// Act
+	_, noOperator := useCase.Execute(VerifyMemberAgeCommand{MemberID: m.MemberID().String(), Version: version, Now: now})
+	_, stale := useCase.Execute(VerifyMemberAgeCommand{
+		MemberID: m.MemberID().String(), OperatorID: "staff01", Version: version - 1, Now: now,
+	})
+	verified, err := useCase.Execute(VerifyMemberAgeCommand{
+		MemberID: m.MemberID().String(), OperatorID: "staff01", Version: version, Now: now,
+	})
+	require.NoError(t, err)
+	repeated, err := useCase.Execute(VerifyMemberAgeCommand{
+		MemberID: m.MemberID().String(), OperatorID: "staff02", Version: verified.Version, Now: now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Assert
+	assert.ErrorIs(t, noOperator, member.ErrInvalidAgeVerification)
+	assert.ErrorIs(t, stale, member.ErrMemberVersionConflict)
+	assert.True(t, verified.IsVerifiedAdult)
+	assert.Equal(t, "staff01", verified.AgeVerifiedBy)
+	assert.Equal(t, "staff_id_check", verified.AgeVerificationMethod)
+	assert.Equal(t, now, *verified.AgeVerifiedAt)
+	assert.Equal(t, version+1, verified.Version)
+	assert.Equal(t, "staff01", repeated.AgeVerifiedBy, "the original verification record is kept")
+	mockRepo.AssertExpectations(t)
+}
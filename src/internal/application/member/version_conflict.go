@@ -0,0 +1,25 @@
+package member
+
+import (
+	"errors"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+)
+
+// maxVersionConflictAttempts 樂觀鎖衝突時的最多嘗試次數
+const maxVersionConflictAttempts = 3
+
+// retryOnVersionConflict 樂觀鎖衝突時重新執行 fn（fn 需在事務中重新載入會員）
+//
+// 使用範圍：
+// - 僅用於可安全重套的 LINE 事件同步（封鎖狀態、顯示名稱、會員填寫生日、重新綁定手機號碼）
+// - 管理員操作不重試，直接返回 ErrMemberVersionConflict 由使用者重新載入
+func retryOnVersionConflict(fn func() error) error {
+	var err error
+	for attempt := 0; attempt < maxVersionConflictAttempts; attempt++ {
+		if err = fn(); !errors.Is(err, member.ErrMemberVersionConflict) {
+			return err
+		}
+	}
+	return err
+}
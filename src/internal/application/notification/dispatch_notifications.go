@@ -0,0 +1,165 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/notification"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// DispatchNotifications Use Case
+// ===========================
+
+// defaultDispatchLimit 每次排程最多推播的通知數
+const defaultDispatchLimit = 100
+
+// MessagePusher LINE 推播介面
+//
+// 實作：presentation/linebot.Client（Push API，帶 Retry-Key）
+type MessagePusher interface {
+	PushText(lineUserID, text string) error
+}
+
+// DispatchNotificationsCommand 推播排程指令
+//
+// 欄位：
+// - Now: 排程執行時間
+// - Limit: 本次最多處理的通知數（0 使用預設值 100）
+type DispatchNotificationsCommand struct {
+	Now   time.Time
+	Limit int
+}
+
+// DispatchNotificationsResult 推播排程結果
+//
+// 欄位：
+// - Sent: 推播成功
+// - Deferred: 落在勿擾時段，延後推播
+// - Retrying: 推播失敗，稍後重試
+// - Failed: 推播失敗且已達重試上限
+// - Cancelled: 會員已關閉通知、已封鎖官方帳號或會員不存在
+type DispatchNotificationsResult struct {
+	Sent      int
+	Deferred  int
+	Retrying  int
+	Failed    int
+	Cancelled int
+}
+
+// DispatchNotificationsUseCase 推播已到時間的通知（排程執行，例如每分鐘）
+//
+// 業務規則：
+// - 推播前重新檢查會員偏好（建立通知後可能已關閉或變更勿擾時段）
+// - 勿擾時段內延後至時段結束
+// - 推播內容依會員語系產生
+// - 推播結果記錄於通知（sent / 重試 / failed）
+//
+// 設計原則：推播在事務外執行，每則通知的狀態變更各自提交
+type DispatchNotificationsUseCase struct {
+	notificationRepo notification.NotificationRepository
+	preferenceRepo   notification.NotificationPreferenceRepository
+	memberRepo       member.MemberRepository
+	pusher           MessagePusher
+	txManager        shared.TransactionManager
+}
+
+// NewDispatchNotificationsUseCase 創建 Use Case 實例
+func NewDispatchNotificationsUseCase(
+	notificationRepo notification.NotificationRepository,
+	preferenceRepo notification.NotificationPreferenceRepository,
+	memberRepo member.MemberRepository,
+	pusher MessagePusher,
+	txManager shared.TransactionManager,
+) *DispatchNotificationsUseCase {
+	return &DispatchNotificationsUseCase{
+		notificationRepo: notificationRepo,
+		preferenceRepo:   preferenceRepo,
+		memberRepo:       memberRepo,
+		pusher:           pusher,
+		txManager:        txManager,
+	}
+}
+
+// Execute 推播已到時間的通知
+func (uc *DispatchNotificationsUseCase) Execute(cmd DispatchNotificationsCommand) (*DispatchNotificationsResult, error) {
+	limit := cmd.Limit
+	if limit <= 0 {
+		limit = defaultDispatchLimit
+	}
+
+	due, err := uc.notificationRepo.FindDue(nil, cmd.Now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find due notifications: %w", err)
+	}
+
+	result := &DispatchNotificationsResult{}
+	for _, n := range due {
+		if err := uc.deliver(n, cmd.Now, result); err != nil {
+			return result, err
+		}
+
+		err := uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+			return uc.notificationRepo.Save(ctx, n)
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to save notification: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// deliver 推播單則通知並更新其狀態
+func (uc *DispatchNotificationsUseCase) deliver(
+	n *notification.Notification,
+	now time.Time,
+	result *DispatchNotificationsResult,
+) error {
+	preference, err := findPreference(nil, uc.preferenceRepo, n.MemberID(), now)
+	if err != nil {
+		return err
+	}
+	if !preference.PointsActivityEnabled() {
+		result.Cancelled++
+		return n.CancelAt("notifications disabled by member", now)
+	}
+	if preference.QuietHours().Contains(now) {
+		result.Deferred++
+		return n.DeferUntilAt(preference.QuietHours().DeliverableAt(now), now)
+	}
+
+	memberID, err := member.MemberIDFromString(n.MemberID().String())
+	if err != nil {
+		return fmt.Errorf("failed to parse member ID: %w", err)
+	}
+	m, err := uc.memberRepo.FindByMemberID(nil, memberID)
+	if errors.Is(err, member.ErrMemberNotFound) {
+		result.Cancelled++
+		return n.CancelAt("member not found", now)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find member: %w", err)
+	}
+	if !m.IsReachable() {
+		result.Cancelled++
+		return n.CancelAt("member unreachable", now)
+	}
+
+	if err := uc.pusher.PushText(m.LineUserID().String(), renderPointsActivity(preference.Locale(), n)); err != nil {
+		if err := n.RecordFailureAt(err.Error(), now); err != nil {
+			return err
+		}
+		if n.Status() == notification.NotificationStatusFailed {
+			result.Failed++
+		} else {
+			result.Retrying++
+		}
+		return nil
+	}
+
+	result.Sent++
+	return n.MarkSentAt(now)
+}
@@ -0,0 +1,74 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/notification"
+)
+
+// ===========================
+// 推播文案
+// ===========================
+
+// pointsActivityTemplate 積分異動通知文案（依語系）
+type pointsActivityTemplate struct {
+	titleEarned   string
+	titleDeducted string
+	titleMixed    string
+	earnedLine    string
+	deductedLine  string
+	availableLine string
+}
+
+// pointsActivityTemplates 支援語系的文案（未支援的語系使用 DefaultLocale）
+var pointsActivityTemplates = map[notification.Locale]pointsActivityTemplate{
+	notification.LocaleZhTW: {
+		titleEarned:   "🎉 積分入帳通知",
+		titleDeducted: "🎁 積分使用通知",
+		titleMixed:    "💰 積分異動通知",
+		earnedLine:    "獲得積分：+%d 點",
+		deductedLine:  "使用積分：-%d 點",
+		availableLine: "目前可用積分：%d 點",
+	},
+	notification.LocaleEn: {
+		titleEarned:   "🎉 Points earned",
+		titleDeducted: "🎁 Points redeemed",
+		titleMixed:    "💰 Points activity",
+		earnedLine:    "Earned: +%d points",
+		deductedLine:  "Redeemed: -%d points",
+		availableLine: "Available balance: %d points",
+	},
+}
+
+// renderPointsActivity 產生積分異動通知文字
+//
+// 範例（zh-TW，合併兩張發票）：
+//   🎉 積分入帳通知
+//
+//   獲得積分：+8 點
+//   目前可用積分：108 點
+func renderPointsActivity(locale notification.Locale, n *notification.Notification) string {
+	tmpl, ok := pointsActivityTemplates[locale]
+	if !ok {
+		tmpl = pointsActivityTemplates[notification.DefaultLocale]
+	}
+
+	title := tmpl.titleMixed
+	switch {
+	case n.DeductedPoints() == 0:
+		title = tmpl.titleEarned
+	case n.EarnedPoints() == 0:
+		title = tmpl.titleDeducted
+	}
+
+	lines := []string{title, ""}
+	if n.EarnedPoints() > 0 {
+		lines = append(lines, fmt.Sprintf(tmpl.earnedLine, n.EarnedPoints()))
+	}
+	if n.DeductedPoints() > 0 {
+		lines = append(lines, fmt.Sprintf(tmpl.deductedLine, n.DeductedPoints()))
+	}
+	lines = append(lines, fmt.Sprintf(tmpl.availableLine, n.AvailablePoints()))
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,175 @@
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/notification"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// PointsNotification 事件處理器
+// ===========================
+
+// PointsNotificationHandler 將積分異動事件轉為待推播通知
+//
+// 處理事件（PointsAccount.PullEvents()）：
+// - points.earned（發票 / 問卷積分入帳）
+// - points.deducted（兌換 / 扣減）
+// - points.adjusted（管理員人工調整；正數視為入帳、負數視為扣減）
+// - 其他事件忽略
+//
+// 業務規則：
+// - 會員關閉積分通知 → 不建立通知
+// - 已有 pending 通知 → 合併（連續掃描多張發票只推播一次）
+// - 否則建立通知，預計推播時間 = 事件時間 + BatchWindow（落在勿擾時段則延後至時段結束）
+// - 同一事件重送時不重複通知
+//
+// 注意：實際推播由 DispatchNotificationsUseCase 排程執行
+type PointsNotificationHandler struct {
+	accountRepo      points.PointsAccountRepository
+	notificationRepo notification.NotificationRepository
+	preferenceRepo   notification.NotificationPreferenceRepository
+	txManager        shared.TransactionManager
+}
+
+// NewPointsNotificationHandler 創建事件處理器實例
+func NewPointsNotificationHandler(
+	accountRepo points.PointsAccountRepository,
+	notificationRepo notification.NotificationRepository,
+	preferenceRepo notification.NotificationPreferenceRepository,
+	txManager shared.TransactionManager,
+) *PointsNotificationHandler {
+	return &PointsNotificationHandler{
+		accountRepo:      accountRepo,
+		notificationRepo: notificationRepo,
+		preferenceRepo:   preferenceRepo,
+		txManager:        txManager,
+	}
+}
+
+// HandleEvents 處理 PointsAccount.PullEvents() 取得的事件（依序處理，任一失敗即返回）
+func (h *PointsNotificationHandler) HandleEvents(events []shared.DomainEvent) error {
+	for _, event := range events {
+		if err := h.Handle(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Handle 處理單一事件
+func (h *PointsNotificationHandler) Handle(event shared.DomainEvent) error {
+	accountID, change, ok := pointsChangeFromEvent(event)
+	if !ok {
+		return nil
+	}
+	now := time.Now()
+
+	return h.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		exists, err := h.notificationRepo.ExistsBySourceEventID(ctx, change.EventID)
+		if err != nil {
+			return fmt.Errorf("failed to check notification event: %w", err)
+		}
+		if exists {
+			return nil
+		}
+
+		account, err := h.accountRepo.FindByID(ctx, accountID)
+		if err != nil {
+			return fmt.Errorf("failed to find account: %w", err)
+		}
+		memberID, err := notification.MemberIDFromString(account.MemberID().String())
+		if err != nil {
+			return fmt.Errorf("failed to parse member ID: %w", err)
+		}
+
+		preference, err := findPreference(ctx, h.preferenceRepo, memberID, now)
+		if err != nil {
+			return err
+		}
+		if !preference.PointsActivityEnabled() {
+			return nil
+		}
+
+		change.AvailablePoints = account.GetAvailablePoints().Value()
+
+		pending, err := h.notificationRepo.FindPendingByMemberID(ctx, memberID, notification.NotificationTypePointsActivity)
+		switch {
+		case err == nil:
+			if _, err := pending.MergeAt(change, now); err != nil {
+				return fmt.Errorf("failed to merge notification: %w", err)
+			}
+			if err := h.notificationRepo.Save(ctx, pending); err != nil {
+				return fmt.Errorf("failed to save notification: %w", err)
+			}
+			return nil
+		case !errors.Is(err, notification.ErrNotificationNotFound):
+			return fmt.Errorf("failed to find pending notification: %w", err)
+		}
+
+		scheduledAt := preference.QuietHours().DeliverableAt(change.OccurredAt.Add(notification.BatchWindow))
+		n, err := notification.NewPointsActivityNotification(memberID, change, scheduledAt, now)
+		if err != nil {
+			return err
+		}
+		if err := h.notificationRepo.Save(ctx, n); err != nil {
+			return fmt.Errorf("failed to save notification: %w", err)
+		}
+		return nil
+	})
+}
+
+// pointsChangeFromEvent 將積分事件轉為通知的異動內容
+//
+// 返回：ok = false 表示非積分異動事件或異動為 0（不通知）
+func pointsChangeFromEvent(event shared.DomainEvent) (points.AccountID, notification.PointsChange, bool) {
+	change := notification.PointsChange{
+		EventID:    event.EventID(),
+		OccurredAt: event.OccurredAt(),
+	}
+
+	var accountID points.AccountID
+	switch e := event.(type) {
+	case *points.PointsEarnedEvent:
+		accountID = e.AccountID()
+		change.Earned = e.Amount().Value()
+	case *points.PointsDeductedEvent:
+		accountID = e.AccountID()
+		change.Deducted = e.Amount().Value()
+	case *points.PointsAdjustedEvent:
+		accountID = e.AccountID()
+		if e.Delta() > 0 {
+			change.Earned = e.Delta()
+		} else {
+			change.Deducted = -e.Delta()
+		}
+	default:
+		return accountID, change, false
+	}
+
+	if change.Earned == 0 && change.Deducted == 0 {
+		return accountID, change, false
+	}
+	return accountID, change, true
+}
+
+// findPreference 查詢會員通知偏好（未設定時返回預設值）
+func findPreference(
+	ctx shared.TransactionContext,
+	preferenceRepo notification.NotificationPreferenceRepository,
+	memberID notification.MemberID,
+	now time.Time,
+) (*notification.NotificationPreference, error) {
+	preference, err := preferenceRepo.FindByMemberID(ctx, memberID)
+	if errors.Is(err, notification.ErrPreferenceNotFound) {
+		return notification.DefaultNotificationPreference(memberID, now), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find notification preference: %w", err)
+	}
+	return preference, nil
+}
@@ -0,0 +1,421 @@
+package notification
+
+import (
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/notification"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// Test Fixture
+// ===========================
+
+const testLineUserID = "U4af4980629a1b2c3d4e5f6a7b8c9d0e1"
+
+type notificationFixture struct {
+	accounts      *MockPointsAccountRepository
+	members       *MockMemberRepository
+	notifications *MockNotificationRepository
+	preferences   *MockNotificationPreferenceRepository
+	pusher        *FakePusher
+	account       *points.PointsAccount
+	memberID      notification.MemberID
+}
+
+func newNotificationFixture(t *testing.T) *notificationFixture {
+	lineUserID, err := member.NewLineUserID(testLineUserID)
+	require.NoError(t, err)
+	m, err := member.NewMember(lineUserID, "小明")
+	require.NoError(t, err)
+
+	pointsMemberID, err := points.MemberIDFromString(m.MemberID().String())
+	require.NoError(t, err)
+	account, err := points.NewPointsAccount(pointsMemberID)
+	require.NoError(t, err)
+	account.PullEvents()
+
+	memberID, err := notification.MemberIDFromString(m.MemberID().String())
+	require.NoError(t, err)
+
+	f := &notificationFixture{
+		accounts:      &MockPointsAccountRepository{accounts: map[string]*points.PointsAccount{}},
+		members:       &MockMemberRepository{members: map[string]*member.Member{}},
+		notifications: &MockNotificationRepository{notifications: map[string]*notification.Notification{}},
+		preferences:   &MockNotificationPreferenceRepository{preferences: map[string]*notification.NotificationPreference{}},
+		pusher:        &FakePusher{},
+		account:       account,
+		memberID:      memberID,
+	}
+	f.accounts.accounts[account.AccountID().String()] = account
+	f.members.members[m.MemberID().String()] = m
+	return f
+}
+
+// givenPreference 設定會員通知偏好
+func (f *notificationFixture) givenPreference(enabled bool, quietHours notification.QuietHours, locale notification.Locale) {
+	preference := notification.DefaultNotificationPreference(f.memberID, time.Now())
+	preference.UpdateAt(enabled, quietHours, locale, time.Now())
+	f.preferences.preferences[f.memberID.String()] = preference
+}
+
+// earn 入帳積分並返回 PullEvents() 取得的事件
+func (f *notificationFixture) earn(t *testing.T, amount int) []shared.DomainEvent {
+	pointsAmount, err := points.NewPointsAmount(amount)
+	require.NoError(t, err)
+	require.NoError(t, f.account.EarnPoints(pointsAmount, points.PointsSourceInvoice, "tx", "發票消費積分"))
+	return f.account.PullEvents()
+}
+
+func (f *notificationFixture) handler() *PointsNotificationHandler {
+	return NewPointsNotificationHandler(f.accounts, f.notifications, f.preferences, &MockTransactionManager{})
+}
+
+func (f *notificationFixture) dispatcher() *DispatchNotificationsUseCase {
+	return NewDispatchNotificationsUseCase(f.notifications, f.preferences, f.members, f.pusher, &MockTransactionManager{})
+}
+
+// onlyNotification 返回唯一的通知
+func (f *notificationFixture) onlyNotification(t *testing.T) *notification.Notification {
+	require.Len(t, f.notifications.notifications, 1)
+	for _, n := range f.notifications.notifications {
+		return n
+	}
+	return nil
+}
+
+// ===========================
+// 測試
+// ===========================
+
+// Test 1: 連續入帳事件合併為一則通知
+func TestPointsNotificationHandler_BatchesRapidEvents(t *testing.T) {
+	// Arrange
+	f := newNotificationFixture(t)
+	f.givenPreference(true, notification.NoQuietHours, notification.LocaleZhTW)
+	first := f.earn(t, 3)
+	second := f.earn(t, 5)
+
+	// Act
+	require.NoError(t, f.handler().HandleEvents(first))
+	require.NoError(t, f.handler().HandleEvents(second))
+
+	// Assert
+	n := f.onlyNotification(t)
+	assert.Equal(t, 8, n.EarnedPoints())
+	assert.Equal(t, 8, n.AvailablePoints())
+	assert.Equal(t, first[0].OccurredAt().Add(notification.BatchWindow), n.ScheduledAt())
+	assert.Equal(t, []string{first[0].EventID(), second[0].EventID()}, n.SourceEventIDs())
+}
+
+// Test 2: 事件重送不重複通知（含已推播的通知）
+func TestPointsNotificationHandler_IgnoresRedeliveredEvents(t *testing.T) {
+	// Arrange
+	f := newNotificationFixture(t)
+	f.givenPreference(true, notification.NoQuietHours, notification.LocaleZhTW)
+	events := f.earn(t, 3)
+	require.NoError(t, f.handler().HandleEvents(events))
+	require.NoError(t, f.onlyNotification(t).MarkSentAt(time.Now()))
+
+	// Act
+	err := f.handler().HandleEvents(events)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, f.notifications.notifications, 1)
+}
+
+// Test 3: 會員關閉積分通知時不建立通知
+func TestPointsNotificationHandler_RespectsDisabledPreference(t *testing.T) {
+	// Arrange
+	f := newNotificationFixture(t)
+	f.givenPreference(false, notification.NoQuietHours, notification.LocaleZhTW)
+
+	// Act
+	err := f.handler().HandleEvents(f.earn(t, 3))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, f.notifications.notifications)
+}
+
+// Test 4: 凌晨 3 點（勿擾時段）延後至 10 點推播
+func TestDispatchNotifications_QuietHoursDefersDelivery(t *testing.T) {
+	// Arrange
+	f := newNotificationFixture(t)
+	f.givenPreference(true, notification.DefaultQuietHours, notification.LocaleZhTW)
+	require.NoError(t, f.handler().HandleEvents(f.earn(t, 3)))
+	threeAM := time.Date(2025, 1, 9, 3, 0, 0, 0, shared.BusinessLocation)
+	require.NoError(t, f.onlyNotification(t).DeferUntilAt(threeAM.Add(-time.Minute), threeAM))
+
+	// Act
+	deferred, err := f.dispatcher().Execute(DispatchNotificationsCommand{Now: threeAM})
+	require.NoError(t, err)
+	early, err := f.dispatcher().Execute(DispatchNotificationsCommand{Now: threeAM.Add(time.Hour)})
+	require.NoError(t, err)
+	tenAM := time.Date(2025, 1, 9, 10, 0, 0, 0, shared.BusinessLocation)
+	sent, err := f.dispatcher().Execute(DispatchNotificationsCommand{Now: tenAM})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 1, deferred.Deferred)
+	assert.Equal(t, DispatchNotificationsResult{}, *early)
+	assert.Equal(t, 1, sent.Sent)
+	require.Len(t, f.pusher.pushes, 1)
+	assert.Equal(t, testLineUserID, f.pusher.pushes[0].to)
+	assert.Equal(t, "🎉 積分入帳通知\n\n獲得積分：+3 點\n目前可用積分：3 點", f.pusher.pushes[0].text)
+	assert.Equal(t, notification.NotificationStatusSent, f.onlyNotification(t).Status())
+}
+
+// Test 5: 推播失敗追蹤（重試後標記 failed），英文語系
+func TestDispatchNotifications_TracksDeliveryFailures(t *testing.T) {
+	// Arrange
+	f := newNotificationFixture(t)
+	f.givenPreference(true, notification.NoQuietHours, notification.LocaleEn)
+	require.NoError(t, f.handler().HandleEvents(f.earn(t, 10)))
+	deducted, err := points.NewPointsAmount(4)
+	require.NoError(t, err)
+	require.NoError(t, f.account.DeductPoints(deducted, "兌換調酒"))
+	require.NoError(t, f.handler().HandleEvents(f.account.PullEvents()))
+	f.pusher.err = errors.New("LINE API error (status 500)")
+	now := time.Now().Add(notification.BatchWindow)
+
+	// Act
+	var results []*DispatchNotificationsResult
+	for i := 0; i < notification.MaxDeliveryAttempts; i++ {
+		result, err := f.dispatcher().Execute(DispatchNotificationsCommand{Now: now})
+		require.NoError(t, err)
+		results = append(results, result)
+		now = now.Add(notification.RetryDelay)
+	}
+
+	// Assert
+	assert.Equal(t, 1, results[0].Retrying)
+	assert.Equal(t, 1, results[len(results)-1].Failed)
+	n := f.onlyNotification(t)
+	assert.Equal(t, notification.NotificationStatusFailed, n.Status())
+	assert.Equal(t, "LINE API error (status 500)", n.LastError())
+	require.Len(t, f.pusher.pushes, notification.MaxDeliveryAttempts)
+	assert.Equal(t, "💰 Points activity\n\nEarned: +10 points\nRedeemed: -4 points\nAvailable balance: 6 points", f.pusher.pushes[0].text)
+}
+
+// Test 6: 會員封鎖官方帳號後取消推播
+func TestDispatchNotifications_CancelsForUnreachableMember(t *testing.T) {
+	// Arrange
+	f := newNotificationFixture(t)
+	f.givenPreference(true, notification.NoQuietHours, notification.LocaleZhTW)
+	require.NoError(t, f.handler().HandleEvents(f.earn(t, 5)))
+	for _, m := range f.members.members {
+		m.MarkUnfollowed(time.Now())
+	}
+
+	// Act
+	result, err := f.dispatcher().Execute(DispatchNotificationsCommand{Now: time.Now().Add(notification.BatchWindow)})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Cancelled)
+	assert.Empty(t, f.pusher.pushes)
+	assert.Equal(t, notification.NotificationStatusCancelled, f.onlyNotification(t).Status())
+}
+
+// Test 7: 人工調整事件（加點 / 扣點）併入同一則通知
+func TestPointsNotificationHandler_IncludesManualAdjustments(t *testing.T) {
+	// Arrange
+	f := newNotificationFixture(t)
+	f.givenPreference(true, notification.NoQuietHours, notification.LocaleZhTW)
+	now := time.Now()
+	policy, err := points.NewAdjustmentApprovalPolicy(points.DefaultAdjustmentApprovalThreshold)
+	require.NoError(t, err)
+	credit, err := points.RequestPointsAdjustment(f.account, 20, points.AdjustmentReasonGoodwill, "招待", "owner", policy, now)
+	require.NoError(t, err)
+	require.NoError(t, f.account.ApplyAdjustment(credit, now))
+	debit, err := points.RequestPointsAdjustment(f.account, -5, points.AdjustmentReasonCorrection, "更正", "owner", policy, now)
+	require.NoError(t, err)
+	require.NoError(t, f.account.ApplyAdjustment(debit, now))
+
+	// Act
+	err = f.handler().HandleEvents(f.account.PullEvents())
+
+	// Assert
+	require.NoError(t, err)
+	n := f.onlyNotification(t)
+	assert.Equal(t, 20, n.EarnedPoints())
+	assert.Equal(t, 5, n.DeductedPoints())
+	assert.Equal(t, 15, n.AvailablePoints())
+	assert.Equal(t, []string{credit.AccountEventID(), debit.AccountEventID()}, n.SourceEventIDs())
+}
+
+// ===========================
+// Mocks
+// ===========================
+
+// MockTransactionManager 直接執行函數（ctx 為 nil）
+type MockTransactionManager struct{}
+
+func (m *MockTransactionManager) InTransaction(fn func(ctx shared.TransactionContext) error) error {
+	return fn(nil)
+}
+
+// MockPointsAccountRepository 以帳戶 ID 為鍵
+type MockPointsAccountRepository struct {
+	accounts map[string]*points.PointsAccount
+}
+
+func (m *MockPointsAccountRepository) Save(ctx shared.TransactionContext, account *points.PointsAccount) error {
+	m.accounts[account.AccountID().String()] = account
+	return nil
+}
+
+func (m *MockPointsAccountRepository) FindByID(ctx shared.TransactionContext, accountID points.AccountID) (*points.PointsAccount, error) {
+	if account, exists := m.accounts[accountID.String()]; exists {
+		return account, nil
+	}
+	return nil, points.ErrAccountNotFound
+}
+
+func (m *MockPointsAccountRepository) FindByMemberID(ctx shared.TransactionContext, memberID points.MemberID) (*points.PointsAccount, error) {
+	for _, account := range m.accounts {
+		if account.MemberID().Equals(memberID) {
+			return account, nil
+		}
+	}
+	return nil, points.ErrAccountNotFound
+}
+
+func (m *MockPointsAccountRepository) Update(ctx shared.TransactionContext, account *points.PointsAccount) error {
+	m.accounts[account.AccountID().String()] = account
+	return nil
+}
+
+// MockMemberRepository 以會員 ID 為鍵
+type MockMemberRepository struct {
+	members map[string]*member.Member
+}
+
+func (m *MockMemberRepository) Save(ctx shared.TransactionContext, mem *member.Member) error {
+	m.members[mem.MemberID().String()] = mem
+	return nil
+}
+
+func (m *MockMemberRepository) Update(ctx shared.TransactionContext, mem *member.Member) error {
+	return m.Save(ctx, mem)
+}
+
+func (m *MockMemberRepository) FindByMemberID(ctx shared.TransactionContext, id member.MemberID) (*member.Member, error) {
+	if mem, exists := m.members[id.String()]; exists {
+		return mem, nil
+	}
+	return nil, member.ErrMemberNotFound
+}
+
+func (m *MockMemberRepository) FindByLineUserID(ctx shared.TransactionContext, lineUserID member.LineUserID) (*member.Member, error) {
+	for _, mem := range m.members {
+		if mem.LineUserID().Equals(lineUserID) {
+			return mem, nil
+		}
+	}
+	return nil, member.ErrMemberNotFound
+}
+
+func (m *MockMemberRepository) ExistsByPhoneNumber(ctx shared.TransactionContext, phoneNumber member.PhoneNumber) (bool, error) {
+	return false, nil
+}
+
+func (m *MockMemberRepository) ExistsByLineUserID(ctx shared.TransactionContext, lineUserID member.LineUserID) (bool, error) {
+	_, err := m.FindByLineUserID(ctx, lineUserID)
+	return err == nil, nil
+}
+
+// MockNotificationRepository 以通知 ID 為鍵
+type MockNotificationRepository struct {
+	notifications map[string]*notification.Notification
+}
+
+func (m *MockNotificationRepository) Save(ctx shared.TransactionContext, n *notification.Notification) error {
+	m.notifications[n.NotificationID().String()] = n
+	return nil
+}
+
+func (m *MockNotificationRepository) FindByID(ctx shared.TransactionContext, id notification.NotificationID) (*notification.Notification, error) {
+	if n, exists := m.notifications[id.String()]; exists {
+		return n, nil
+	}
+	return nil, notification.ErrNotificationNotFound
+}
+
+func (m *MockNotificationRepository) FindPendingByMemberID(
+	ctx shared.TransactionContext,
+	memberID notification.MemberID,
+	notificationType notification.NotificationType,
+) (*notification.Notification, error) {
+	for _, n := range m.notifications {
+		if n.MemberID().Equals(memberID) && n.Type() == notificationType && n.Status() == notification.NotificationStatusPending {
+			return n, nil
+		}
+	}
+	return nil, notification.ErrNotificationNotFound
+}
+
+func (m *MockNotificationRepository) FindDue(ctx shared.TransactionContext, now time.Time, limit int) ([]*notification.Notification, error) {
+	var due []*notification.Notification
+	for _, n := range m.notifications {
+		if n.IsDueAt(now) {
+			due = append(due, n)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].ScheduledAt().Before(due[j].ScheduledAt()) })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+func (m *MockNotificationRepository) ExistsBySourceEventID(ctx shared.TransactionContext, eventID string) (bool, error) {
+	for _, n := range m.notifications {
+		if n.ContainsEvent(eventID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MockNotificationPreferenceRepository 以會員 ID 為鍵
+type MockNotificationPreferenceRepository struct {
+	preferences map[string]*notification.NotificationPreference
+}
+
+func (m *MockNotificationPreferenceRepository) Save(ctx shared.TransactionContext, p *notification.NotificationPreference) error {
+	m.preferences[p.MemberID().String()] = p
+	return nil
+}
+
+func (m *MockNotificationPreferenceRepository) FindByMemberID(ctx shared.TransactionContext, memberID notification.MemberID) (*notification.NotificationPreference, error) {
+	if p, exists := m.preferences[memberID.String()]; exists {
+		return p, nil
+	}
+	return nil, notification.ErrPreferenceNotFound
+}
+
+// FakePusher 記錄推播內容
+type FakePusher struct {
+	pushes []recordedPush
+	err    error
+}
+
+type recordedPush struct {
+	to   string
+	text string
+}
+
+func (p *FakePusher) PushText(lineUserID, text string) error {
+	p.pushes = append(p.pushes, recordedPush{to: lineUserID, text: text})
+	return p.err
+}
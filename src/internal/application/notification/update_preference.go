@@ -0,0 +1,119 @@
+package notification
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/notification"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// UpdateNotificationPreference Use Case
+// ===========================
+
+// UpdateNotificationPreferenceCommand 更新通知偏好指令
+//
+// 欄位：
+// - QuietHoursStart / QuietHoursEnd: "HH:MM"（台北時間），兩者皆空字串表示不啟用勿擾時段
+// - Locale: zh-TW / en（空字串使用預設語系）
+type UpdateNotificationPreferenceCommand struct {
+	MemberID              string
+	PointsActivityEnabled bool
+	QuietHoursStart       string
+	QuietHoursEnd         string
+	Locale                string
+}
+
+// NotificationPreferenceResult 通知偏好
+type NotificationPreferenceResult struct {
+	MemberID              string
+	PointsActivityEnabled bool
+	QuietHours            string // HH:MM-HH:MM 或 off
+	Locale                string
+}
+
+// UpdateNotificationPreferenceUseCase 更新會員通知偏好
+type UpdateNotificationPreferenceUseCase struct {
+	preferenceRepo notification.NotificationPreferenceRepository
+	txManager      shared.TransactionManager
+}
+
+// NewUpdateNotificationPreferenceUseCase 創建 Use Case 實例
+func NewUpdateNotificationPreferenceUseCase(
+	preferenceRepo notification.NotificationPreferenceRepository,
+	txManager shared.TransactionManager,
+) *UpdateNotificationPreferenceUseCase {
+	return &UpdateNotificationPreferenceUseCase{
+		preferenceRepo: preferenceRepo,
+		txManager:      txManager,
+	}
+}
+
+// Execute 更新通知偏好（尚未設定時以預設值為基礎建立）
+func (uc *UpdateNotificationPreferenceUseCase) Execute(cmd UpdateNotificationPreferenceCommand) (*NotificationPreferenceResult, error) {
+	memberID, err := notification.MemberIDFromString(cmd.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	quietHours, err := parseQuietHours(cmd.QuietHoursStart, cmd.QuietHoursEnd)
+	if err != nil {
+		return nil, err
+	}
+	locale := notification.DefaultLocale
+	if cmd.Locale != "" {
+		if locale, err = notification.ParseLocale(cmd.Locale); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	var preference *notification.NotificationPreference
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		preference, err = findPreference(ctx, uc.preferenceRepo, memberID, now)
+		if err != nil {
+			return err
+		}
+		preference.UpdateAt(cmd.PointsActivityEnabled, quietHours, locale, now)
+		if err := uc.preferenceRepo.Save(ctx, preference); err != nil {
+			return fmt.Errorf("failed to save notification preference: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotificationPreferenceResult{
+		MemberID:              preference.MemberID().String(),
+		PointsActivityEnabled: preference.PointsActivityEnabled(),
+		QuietHours:            preference.QuietHours().String(),
+		Locale:                preference.Locale().String(),
+	}, nil
+}
+
+// parseQuietHours 解析 "HH:MM" 起訖時間
+func parseQuietHours(start, end string) (notification.QuietHours, error) {
+	if start == "" && end == "" {
+		return notification.NoQuietHours, nil
+	}
+
+	startMinute, startErr := parseClock(start)
+	endMinute, endErr := parseClock(end)
+	if startErr != nil || endErr != nil {
+		return notification.QuietHours{}, notification.ErrInvalidQuietHours.WithContext(
+			"start", start,
+			"end", end,
+		)
+	}
+	return notification.NewQuietHours(startMinute, endMinute)
+}
+
+// parseClock 解析 "HH:MM" 為當日分鐘數
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
@@ -0,0 +1,468 @@
+package points
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// AdjustPoints Use Case
+// ===========================
+
+// AdjustPointsCommand 人工調整積分的命令
+//
+// 輸入：
+// - MemberID: 會員 ID（UUID 字串）
+// - Delta: 調整點數（正數加點、負數扣點，不可為 0）
+// - Reason: 原因代碼（goodwill / service_recovery / missed_invoice / correction / fraud_reversal）
+// - Note: 說明（必填）
+// - RequestedBy: 申請人（管理員帳號，審計用途）
+// - RequesterCanApprove: 申請人本身具核准權限（owner）；否則不論點數一律待核准
+type AdjustPointsCommand struct {
+	MemberID            string
+	Delta               int
+	Reason              string
+	Note                string
+	RequestedBy         string
+	RequesterCanApprove bool
+	Now                 time.Time
+}
+
+// PointsAdjustmentResult 積分調整紀錄（申請、核准、駁回的結果）
+//
+// 欄位：
+// - Status: pending_approval / applied / rejected
+// - AccountEventID: 入帳時產生的 points.adjusted 事件 ID（未入帳為空字串）
+// - AvailablePoints: 處理後的帳戶可用積分
+type PointsAdjustmentResult struct {
+	AdjustmentID     string
+	AccountID        string
+	MemberID         string
+	Delta            int
+	Reason           string
+	Note             string
+	RequiresApproval bool
+	Status           string
+	RequestedBy      string
+	RequestedAt      time.Time
+	ReviewedBy       string
+	ReviewNote       string
+	ReviewedAt       *time.Time
+	AccountEventID   string
+	AppliedAt        *time.Time
+	AvailablePoints  int
+}
+
+// AdjustPointsUseCase 人工調整積分 Use Case
+//
+// 業務規則：
+// - 具核准權限的申請人且調整點數絕對值未超過門檻 → 立即入帳
+// - 超過門檻，或申請人不具核准權限 → 建立待核准申請，由另一位管理員核准後入帳（maker-checker）
+// - 每筆申請都保存為 PointsAdjustment（審計紀錄，連結入帳事件）
+//
+// 事件發布：立即入帳時，提交後發布 points.adjusted
+type AdjustPointsUseCase struct {
+	accountRepo    points.PointsAccountRepository
+	adjustmentRepo points.PointsAdjustmentRepository
+	policy         points.AdjustmentApprovalPolicy
+	txManager      shared.TransactionManager
+	publisher      shared.EventPublisher
+}
+
+// NewAdjustPointsUseCase 創建 Use Case 實例
+func NewAdjustPointsUseCase(
+	accountRepo points.PointsAccountRepository,
+	adjustmentRepo points.PointsAdjustmentRepository,
+	policy points.AdjustmentApprovalPolicy,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *AdjustPointsUseCase {
+	return &AdjustPointsUseCase{
+		accountRepo:    accountRepo,
+		adjustmentRepo: adjustmentRepo,
+		policy:         policy,
+		txManager:      txManager,
+		publisher:      publisher,
+	}
+}
+
+// Execute 執行人工調整
+//
+// 錯誤處理：
+// - ErrInvalidMemberID: MemberID 格式無效
+// - ErrInvalidAdjustmentReason: 原因代碼無效
+// - ErrInvalidAdjustment: 點數為 0、說明或申請人空白
+// - ErrAccountNotFound: 帳戶不存在
+// - ErrInsufficientPoints: 立即入帳的扣點超過可用積分
+func (uc *AdjustPointsUseCase) Execute(cmd AdjustPointsCommand) (*PointsAdjustmentResult, error) {
+	memberID, err := points.MemberIDFromString(cmd.MemberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse member ID: %w", err)
+	}
+	reason, err := points.ParseAdjustmentReason(cmd.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *PointsAdjustmentResult
+	var account *points.PointsAccount
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		account, err = uc.accountRepo.FindByMemberID(ctx, memberID)
+		if err != nil {
+			return fmt.Errorf("failed to find account: %w", err)
+		}
+
+		adjustment, err := points.RequestPointsAdjustment(
+			account, cmd.Delta, reason, cmd.Note, cmd.RequestedBy, uc.policy.ForRequester(cmd.RequesterCanApprove), cmd.Now,
+		)
+		if err != nil {
+			return err
+		}
+
+		if !adjustment.RequiresApproval() {
+			if err := account.ApplyAdjustment(adjustment, cmd.Now); err != nil {
+				return fmt.Errorf("failed to apply adjustment: %w", err)
+			}
+			if err := uc.accountRepo.Update(ctx, account); err != nil {
+				return fmt.Errorf("failed to update account: %w", err)
+			}
+		}
+
+		if err := uc.adjustmentRepo.Save(ctx, adjustment); err != nil {
+			return fmt.Errorf("failed to save adjustment: %w", err)
+		}
+
+		result = toPointsAdjustmentResult(adjustment, account)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := publishAccountEvents(uc.publisher, account); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ===========================
+// ReviewPointsAdjustment Use Case
+// ===========================
+
+// ReviewPointsAdjustmentCommand 核准 / 駁回積分調整的命令
+//
+// 輸入：
+// - AdjustmentID: 調整 ID（UUID 字串）
+// - Reviewer: 審核人（管理員帳號；核准時不可為申請人）
+// - Note: 審核說明（選填）
+type ReviewPointsAdjustmentCommand struct {
+	AdjustmentID string
+	Reviewer     string
+	Note         string
+	Now          time.Time
+}
+
+// ApprovePointsAdjustmentUseCase 核准積分調整並入帳 Use Case
+//
+// 事件發布：提交後發布 points.adjusted
+type ApprovePointsAdjustmentUseCase struct {
+	accountRepo    points.PointsAccountRepository
+	adjustmentRepo points.PointsAdjustmentRepository
+	txManager      shared.TransactionManager
+	publisher      shared.EventPublisher
+}
+
+// NewApprovePointsAdjustmentUseCase 創建 Use Case 實例
+func NewApprovePointsAdjustmentUseCase(
+	accountRepo points.PointsAccountRepository,
+	adjustmentRepo points.PointsAdjustmentRepository,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *ApprovePointsAdjustmentUseCase {
+	return &ApprovePointsAdjustmentUseCase{
+		accountRepo:    accountRepo,
+		adjustmentRepo: adjustmentRepo,
+		txManager:      txManager,
+		publisher:      publisher,
+	}
+}
+
+// Execute 執行核准（核准與入帳在同一事務中完成）
+//
+// 錯誤處理：
+// - ErrAdjustmentNotFound: 調整不存在
+// - ErrAdjustmentNotPending: 已入帳或已駁回
+// - ErrAdjustmentSelfApproval: 審核人即申請人
+// - ErrInsufficientPoints: 扣點超過目前可用積分（申請維持待核准，可改為駁回）
+func (uc *ApprovePointsAdjustmentUseCase) Execute(cmd ReviewPointsAdjustmentCommand) (*PointsAdjustmentResult, error) {
+	adjustmentID, err := points.AdjustmentIDFromString(cmd.AdjustmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *PointsAdjustmentResult
+	var account *points.PointsAccount
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		adjustment, err := uc.adjustmentRepo.FindByID(ctx, adjustmentID)
+		if err != nil {
+			return fmt.Errorf("failed to find adjustment: %w", err)
+		}
+		account, err = uc.accountRepo.FindByID(ctx, adjustment.AccountID())
+		if err != nil {
+			return fmt.Errorf("failed to find account: %w", err)
+		}
+
+		if err := adjustment.Approve(cmd.Reviewer, cmd.Note, cmd.Now); err != nil {
+			return err
+		}
+		if err := account.ApplyAdjustment(adjustment, cmd.Now); err != nil {
+			return fmt.Errorf("failed to apply adjustment: %w", err)
+		}
+
+		if err := uc.accountRepo.Update(ctx, account); err != nil {
+			return fmt.Errorf("failed to update account: %w", err)
+		}
+		if err := uc.adjustmentRepo.Save(ctx, adjustment); err != nil {
+			return fmt.Errorf("failed to save adjustment: %w", err)
+		}
+
+		result = toPointsAdjustmentResult(adjustment, account)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := publishAccountEvents(uc.publisher, account); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RejectPointsAdjustmentUseCase 駁回積分調整 Use Case
+//
+// 以 NewWithdrawPointsAdjustmentUseCase 建立時為申請人撤回自己的申請（Reviewer 必須為申請人）
+type RejectPointsAdjustmentUseCase struct {
+	accountRepo    points.PointsAccountRepository
+	adjustmentRepo points.PointsAdjustmentRepository
+	txManager      shared.TransactionManager
+	withdraw       bool
+}
+
+// NewRejectPointsAdjustmentUseCase 創建 Use Case 實例
+func NewRejectPointsAdjustmentUseCase(
+	accountRepo points.PointsAccountRepository,
+	adjustmentRepo points.PointsAdjustmentRepository,
+	txManager shared.TransactionManager,
+) *RejectPointsAdjustmentUseCase {
+	return &RejectPointsAdjustmentUseCase{
+		accountRepo:    accountRepo,
+		adjustmentRepo: adjustmentRepo,
+		txManager:      txManager,
+	}
+}
+
+// NewWithdrawPointsAdjustmentUseCase 創建撤回 Use Case 實例（申請人撤回自己待核准的申請）
+func NewWithdrawPointsAdjustmentUseCase(
+	accountRepo points.PointsAccountRepository,
+	adjustmentRepo points.PointsAdjustmentRepository,
+	txManager shared.TransactionManager,
+) *RejectPointsAdjustmentUseCase {
+	uc := NewRejectPointsAdjustmentUseCase(accountRepo, adjustmentRepo, txManager)
+	uc.withdraw = true
+	return uc
+}
+
+// Execute 執行駁回 / 撤回（不異動積分）
+//
+// 錯誤處理：
+// - ErrAdjustmentNotFound: 調整不存在
+// - ErrAdjustmentNotPending: 已入帳或已駁回
+// - ErrAdjustmentNotRequester: 撤回人不是申請人（僅撤回）
+func (uc *RejectPointsAdjustmentUseCase) Execute(cmd ReviewPointsAdjustmentCommand) (*PointsAdjustmentResult, error) {
+	adjustmentID, err := points.AdjustmentIDFromString(cmd.AdjustmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *PointsAdjustmentResult
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		adjustment, err := uc.adjustmentRepo.FindByID(ctx, adjustmentID)
+		if err != nil {
+			return fmt.Errorf("failed to find adjustment: %w", err)
+		}
+		account, err := uc.accountRepo.FindByID(ctx, adjustment.AccountID())
+		if err != nil {
+			return fmt.Errorf("failed to find account: %w", err)
+		}
+
+		decide := adjustment.Reject
+		if uc.withdraw {
+			decide = adjustment.Withdraw
+		}
+		if err := decide(cmd.Reviewer, cmd.Note, cmd.Now); err != nil {
+			return err
+		}
+		if err := uc.adjustmentRepo.Save(ctx, adjustment); err != nil {
+			return fmt.Errorf("failed to save adjustment: %w", err)
+		}
+
+		result = toPointsAdjustmentResult(adjustment, account)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ===========================
+// ListPointsAdjustments Query
+// ===========================
+
+// defaultAdjustmentListLimit 調整清單預設筆數上限
+const defaultAdjustmentListLimit = 100
+
+// ListPointsAdjustmentsQuery 查詢積分調整清單
+//
+// 輸入：
+// - Status: 依狀態查詢（預設 pending_approval，依申請時間排序）
+// - MemberID: 指定時改為查詢該會員的調整紀錄（新到舊，忽略 Status）
+// - RequestedBy: 指定時只查詢該管理員申請的調整（新到舊；MemberID / Status 改為篩選條件）
+// - Limit: 筆數上限（<= 0 時使用預設值）
+type ListPointsAdjustmentsQuery struct {
+	Status      string
+	MemberID    string
+	RequestedBy string
+	Limit       int
+}
+
+// ListPointsAdjustmentsUseCase 查詢積分調整清單 Use Case
+type ListPointsAdjustmentsUseCase struct {
+	adjustmentRepo points.PointsAdjustmentRepository
+}
+
+// NewListPointsAdjustmentsUseCase 創建 Use Case 實例
+func NewListPointsAdjustmentsUseCase(adjustmentRepo points.PointsAdjustmentRepository) *ListPointsAdjustmentsUseCase {
+	return &ListPointsAdjustmentsUseCase{adjustmentRepo: adjustmentRepo}
+}
+
+// Execute 執行查詢（清單不含帳戶可用積分）
+func (uc *ListPointsAdjustmentsUseCase) Execute(query ListPointsAdjustmentsQuery) ([]*PointsAdjustmentResult, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultAdjustmentListLimit
+	}
+
+	var adjustments []*points.PointsAdjustment
+	if query.RequestedBy != "" {
+		var err error
+		adjustments, err = uc.findRequestedBy(query, limit)
+		if err != nil {
+			return nil, err
+		}
+	} else if query.MemberID != "" {
+		memberID, err := points.MemberIDFromString(query.MemberID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse member ID: %w", err)
+		}
+		adjustments, err = uc.adjustmentRepo.FindByMemberID(nil, memberID, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find adjustments: %w", err)
+		}
+	} else {
+		status := points.AdjustmentStatusPendingApproval
+		if query.Status != "" {
+			parsed, err := points.ParseAdjustmentStatus(query.Status)
+			if err != nil {
+				return nil, err
+			}
+			status = parsed
+		}
+		var err error
+		adjustments, err = uc.adjustmentRepo.FindByStatus(nil, status, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find adjustments: %w", err)
+		}
+	}
+
+	results := make([]*PointsAdjustmentResult, 0, len(adjustments))
+	for _, adjustment := range adjustments {
+		results = append(results, toPointsAdjustmentResult(adjustment, nil))
+	}
+	return results, nil
+}
+
+// findRequestedBy 查詢指定申請人的調整，再依 MemberID / Status 篩選
+func (uc *ListPointsAdjustmentsUseCase) findRequestedBy(query ListPointsAdjustmentsQuery, limit int) ([]*points.PointsAdjustment, error) {
+	var memberID *points.MemberID
+	if query.MemberID != "" {
+		parsed, err := points.MemberIDFromString(query.MemberID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse member ID: %w", err)
+		}
+		memberID = &parsed
+	}
+	var status *points.AdjustmentStatus
+	if query.Status != "" {
+		parsed, err := points.ParseAdjustmentStatus(query.Status)
+		if err != nil {
+			return nil, err
+		}
+		status = &parsed
+	}
+
+	adjustments, err := uc.adjustmentRepo.FindByRequestedBy(nil, query.RequestedBy, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find adjustments: %w", err)
+	}
+	filtered := adjustments[:0]
+	for _, adjustment := range adjustments {
+		if memberID != nil && adjustment.MemberID() != *memberID {
+			continue
+		}
+		if status != nil && adjustment.Status() != *status {
+			continue
+		}
+		filtered = append(filtered, adjustment)
+	}
+	return filtered, nil
+}
+
+// toPointsAdjustmentResult 轉換為輸出 DTO（account 為 nil 時不填可用積分）
+func toPointsAdjustmentResult(a *points.PointsAdjustment, account *points.PointsAccount) *PointsAdjustmentResult {
+	result := &PointsAdjustmentResult{
+		AdjustmentID:     a.AdjustmentID().String(),
+		AccountID:        a.AccountID().String(),
+		MemberID:         a.MemberID().String(),
+		Delta:            a.Delta(),
+		Reason:           a.Reason().String(),
+		Note:             a.Note(),
+		RequiresApproval: a.RequiresApproval(),
+		Status:           a.Status().String(),
+		RequestedBy:      a.RequestedBy(),
+		RequestedAt:      a.RequestedAt(),
+		ReviewedBy:       a.ReviewedBy(),
+		ReviewNote:       a.ReviewNote(),
+		ReviewedAt:       a.ReviewedAt(),
+		AccountEventID:   a.AccountEventID(),
+		AppliedAt:        a.AppliedAt(),
+	}
+	if account != nil {
+		result.AvailablePoints = account.GetAvailablePoints().Value()
+	}
+	return result
+}
+
+// publishAccountEvents 提交後發布積分帳戶事件（待核准的申請不異動帳戶，沒有事件）
+func publishAccountEvents(publisher shared.EventPublisher, account *points.PointsAccount) error {
+	if events := account.PullEvents(); len(events) > 0 {
+		if err := publisher.PublishBatch(events); err != nil {
+			return fmt.Errorf("failed to publish points events: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+package points
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// AdjustPoints / Approve / Reject Use Case 測試
+// ===========================
+
+// Test 1: 具核准權限的申請人未超過門檻立即入帳；超過門檻或申請人不具核准權限時建立待核准申請
+func TestAdjustPointsUseCase_AppliesOrQueuesByThreshold(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	accountRepo := NewMockPointsAccountRepository()
+	adjustmentRepo := NewMockPointsAdjustmentRepository()
+	memberID := points.NewMemberID()
+	account, _ := points.NewPointsAccount(memberID)
+	account.PullEvents()
+	accountRepo.accounts[memberID.String()] = account
+	policy, err := points.NewAdjustmentApprovalPolicy(100)
+	require.NoError(t, err)
+	publisher := &FakeEventPublisher{}
+	useCase := NewAdjustPointsUseCase(accountRepo, adjustmentRepo, policy, NewMockTransactionManager(), publisher)
+
+	// Act
+	applied, err := useCase.Execute(AdjustPointsCommand{
+		MemberID: memberID.String(), Delta: 80, Reason: "service_recovery",
+		Note: "出餐延誤補償", RequestedBy: "alice", RequesterCanApprove: true, Now: now,
+	})
+	require.NoError(t, err)
+	staffRequest, err := useCase.Execute(AdjustPointsCommand{
+		MemberID: memberID.String(), Delta: 10, Reason: "service_recovery",
+		Note: "出餐延誤補償", RequestedBy: "staff01", Now: now,
+	})
+	require.NoError(t, err)
+	pending, err := useCase.Execute(AdjustPointsCommand{
+		MemberID: memberID.String(), Delta: 500, Reason: "missed_invoice",
+		Note: "補登紙本發票", RequestedBy: "alice", Now: now,
+	})
+	require.NoError(t, err)
+	_, badReason := useCase.Execute(AdjustPointsCommand{
+		MemberID: memberID.String(), Delta: 5, Reason: "vip", Note: "說明", RequestedBy: "alice", Now: now,
+	})
+
+	// Assert
+	assert.Equal(t, "applied", applied.Status)
+	assert.NotEmpty(t, applied.AccountEventID)
+	assert.Equal(t, 80, applied.AvailablePoints)
+	assert.Equal(t, "pending_approval", pending.Status)
+	assert.True(t, pending.RequiresApproval)
+	assert.Empty(t, pending.AccountEventID)
+	assert.Equal(t, 80, pending.AvailablePoints)
+	assert.Equal(t, "pending_approval", staffRequest.Status)
+	assert.True(t, staffRequest.RequiresApproval)
+	assert.Len(t, adjustmentRepo.adjustments, 3)
+	assert.ErrorIs(t, badReason, points.ErrInvalidAdjustmentReason)
+	require.Len(t, publisher.events, 1) // 待核准的申請不發布事件
+	assert.Equal(t, "points.adjusted", publisher.events[0].EventType())
+	assert.Equal(t, applied.AccountEventID, publisher.events[0].EventID())
+}
+
+// Test 2: 核准需由另一位管理員執行，核准後入帳
+func TestApprovePointsAdjustmentUseCase_RequiresSecondAdmin(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	accountRepo := NewMockPointsAccountRepository()
+	adjustmentRepo := NewMockPointsAdjustmentRepository()
+	memberID := points.NewMemberID()
+	account, _ := points.NewPointsAccount(memberID)
+	account.PullEvents()
+	accountRepo.accounts[memberID.String()] = account
+	policy, _ := points.NewAdjustmentApprovalPolicy(100)
+	publisher := &FakeEventPublisher{}
+	pending, err := NewAdjustPointsUseCase(accountRepo, adjustmentRepo, policy, NewMockTransactionManager(), publisher).Execute(AdjustPointsCommand{
+		MemberID: memberID.String(), Delta: 300, Reason: "goodwill", Note: "週年慶", RequestedBy: "alice", Now: now,
+	})
+	require.NoError(t, err)
+	useCase := NewApprovePointsAdjustmentUseCase(accountRepo, adjustmentRepo, NewMockTransactionManager(), publisher)
+
+	// Act
+	_, selfApproval := useCase.Execute(ReviewPointsAdjustmentCommand{AdjustmentID: pending.AdjustmentID, Reviewer: "alice", Now: now})
+	approved, err := useCase.Execute(ReviewPointsAdjustmentCommand{
+		AdjustmentID: pending.AdjustmentID, Reviewer: "bob", Note: "OK", Now: now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	_, again := useCase.Execute(ReviewPointsAdjustmentCommand{AdjustmentID: pending.AdjustmentID, Reviewer: "bob", Now: now})
+
+	// Assert
+	assert.ErrorIs(t, selfApproval, points.ErrAdjustmentSelfApproval)
+	assert.Equal(t, "applied", approved.Status)
+	assert.Equal(t, "bob", approved.ReviewedBy)
+	assert.NotEmpty(t, approved.AccountEventID)
+	assert.Equal(t, 300, approved.AvailablePoints)
+	assert.Equal(t, 300, account.GetAvailablePoints().Value())
+	assert.ErrorIs(t, again, points.ErrAdjustmentNotPending)
+	require.Len(t, publisher.events, 1)
+	assert.Equal(t, "points.adjusted", publisher.events[0].EventType())
+}
+
+// Test 3: 駁回不異動積分，清單不再列為待核准
+func TestRejectPointsAdjustmentUseCase_LeavesBalanceUnchanged(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	accountRepo := NewMockPointsAccountRepository()
+	adjustmentRepo := NewMockPointsAdjustmentRepository()
+	memberID := points.NewMemberID()
+	account, _ := points.NewPointsAccount(memberID)
+	accountRepo.accounts[memberID.String()] = account
+	policy, _ := points.NewAdjustmentApprovalPolicy(100)
+	pending, err := NewAdjustPointsUseCase(accountRepo, adjustmentRepo, policy, NewMockTransactionManager(), &FakeEventPublisher{}).Execute(AdjustPointsCommand{
+		MemberID: memberID.String(), Delta: 300, Reason: "goodwill", Note: "週年慶", RequestedBy: "alice", Now: now,
+	})
+	require.NoError(t, err)
+	listUseCase := NewListPointsAdjustmentsUseCase(adjustmentRepo)
+	before, err := listUseCase.Execute(ListPointsAdjustmentsQuery{})
+	require.NoError(t, err)
+
+	// Act
+	rejected, err := NewRejectPointsAdjustmentUseCase(accountRepo, adjustmentRepo, NewMockTransactionManager()).Execute(
+		ReviewPointsAdjustmentCommand{AdjustmentID: pending.AdjustmentID, Reviewer: "bob", Note: "金額過高", Now: now},
+	)
+	require.NoError(t, err)
+	after, err := listUseCase.Execute(ListPointsAdjustmentsQuery{})
+	require.NoError(t, err)
+	history, err := listUseCase.Execute(ListPointsAdjustmentsQuery{MemberID: memberID.String()})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Len(t, before, 1)
+	assert.Equal(t, "rejected", rejected.Status)
+	assert.Equal(t, "金額過高", rejected.ReviewNote)
+	assert.Equal(t, 0, account.GetAvailablePoints().Value())
+	assert.Empty(t, after)
+	require.Len(t, history, 1)
+	assert.Equal(t, "rejected", history[0].Status)
+}
+
+// Test 4: 申請人只能撤回自己的申請；依申請人查詢只列出自己的申請
+func TestWithdrawPointsAdjustmentUseCase_OnlyRequester(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	accountRepo := NewMockPointsAccountRepository()
+	adjustmentRepo := NewMockPointsAdjustmentRepository()
+	memberID := points.NewMemberID()
+	account, _ := points.NewPointsAccount(memberID)
+	accountRepo.accounts[memberID.String()] = account
+	policy, _ := points.NewAdjustmentApprovalPolicy(100)
+	adjustUseCase := NewAdjustPointsUseCase(accountRepo, adjustmentRepo, policy, NewMockTransactionManager(), &FakeEventPublisher{})
+	mine, err := adjustUseCase.Execute(AdjustPointsCommand{
+		MemberID: memberID.String(), Delta: 30, Reason: "goodwill", Note: "招待", RequestedBy: "staff01", Now: now,
+	})
+	require.NoError(t, err)
+	_, err = adjustUseCase.Execute(AdjustPointsCommand{
+		MemberID: memberID.String(), Delta: 40, Reason: "goodwill", Note: "招待", RequestedBy: "staff02", Now: now,
+	})
+	require.NoError(t, err)
+	useCase := NewWithdrawPointsAdjustmentUseCase(accountRepo, adjustmentRepo, NewMockTransactionManager())
+	listUseCase := NewListPointsAdjustmentsUseCase(adjustmentRepo)
+
+	// Act
+	_, notRequester := useCase.Execute(ReviewPointsAdjustmentCommand{AdjustmentID: mine.AdjustmentID, Reviewer: "staff02", Now: now})
+	withdrawn, err := useCase.Execute(ReviewPointsAdjustmentCommand{
+		AdjustmentID: mine.AdjustmentID, Reviewer: "staff01", Note: "輸入錯誤", Now: now,
+	})
+	require.NoError(t, err)
+	own, err := listUseCase.Execute(ListPointsAdjustmentsQuery{RequestedBy: "staff01"})
+	require.NoError(t, err)
+	ownPending, err := listUseCase.Execute(ListPointsAdjustmentsQuery{RequestedBy: "staff01", Status: "pending_approval"})
+	require.NoError(t, err)
+
+	// Assert
+	assert.ErrorIs(t, notRequester, points.ErrAdjustmentNotRequester)
+	assert.Equal(t, "rejected", withdrawn.Status)
+	assert.Equal(t, "staff01", withdrawn.ReviewedBy)
+	require.Len(t, own, 1)
+	assert.Equal(t, mine.AdjustmentID, own[0].AdjustmentID)
+	assert.Empty(t, ownPending)
+	assert.Equal(t, 0, account.GetAvailablePoints().Value())
+}
+
+// ===========================
+// Mock PointsAdjustmentRepository
+// ===========================
+
+type MockPointsAdjustmentRepository struct {
+	adjustments map[string]*points.PointsAdjustment
+}
+
+func NewMockPointsAdjustmentRepository() *MockPointsAdjustmentRepository {
+	return &MockPointsAdjustmentRepository{
+		adjustments: make(map[string]*points.PointsAdjustment),
+	}
+}
+
+func (m *MockPointsAdjustmentRepository) Save(ctx shared.TransactionContext, adjustment *points.PointsAdjustment) error {
+	m.adjustments[adjustment.AdjustmentID().String()] = adjustment
+	return nil
+}
+
+func (m *MockPointsAdjustmentRepository) FindByID(ctx shared.TransactionContext, adjustmentID points.AdjustmentID) (*points.PointsAdjustment, error) {
+	if adjustment, exists := m.adjustments[adjustmentID.String()]; exists {
+		return adjustment, nil
+	}
+	return nil, points.ErrAdjustmentNotFound
+}
+
+func (m *MockPointsAdjustmentRepository) FindByStatus(ctx shared.TransactionContext, status points.AdjustmentStatus, limit int) ([]*points.PointsAdjustment, error) {
+	var result []*points.PointsAdjustment
+	for _, adjustment := range m.adjustments {
+		if adjustment.Status() == status && len(result) < limit {
+			result = append(result, adjustment)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockPointsAdjustmentRepository) FindByMemberID(ctx shared.TransactionContext, memberID points.MemberID, limit int) ([]*points.PointsAdjustment, error) {
+	var result []*points.PointsAdjustment
+	for _, adjustment := range m.adjustments {
+		if adjustment.MemberID() == memberID && len(result) < limit {
+			result = append(result, adjustment)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockPointsAdjustmentRepository) FindByRequestedBy(ctx shared.TransactionContext, requestedBy string, limit int) ([]*points.PointsAdjustment, error) {
+	var result []*points.PointsAdjustment
+	for _, adjustment := range m.adjustments {
+		if adjustment.RequestedBy() == requestedBy && len(result) < limit {
+			result = append(result, adjustment)
+		}
+	}
+	return result, nil
+}
+
+// ===========================
+// Fake EventPublisher
+// ===========================
+
+// FakeEventPublisher 記錄發布的事件
+type FakeEventPublisher struct {
+	events []shared.DomainEvent
+}
+
+func (p *FakeEventPublisher) Publish(event shared.DomainEvent) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *FakeEventPublisher) PublishBatch(events []shared.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
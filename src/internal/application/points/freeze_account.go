@@ -0,0 +1,160 @@
+package points
+
+import (
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// FreezePointsAccount Use Case
+// ===========================
+
+// FreezePointsAccountCommand 凍結積分帳戶的命令
+//
+// 輸入：
+// - MemberID: 會員 ID（UUID 字串）
+// - Reason: 凍結原因（必填）
+// - OperatorID: 操作人員（必填，審計用途）
+type FreezePointsAccountCommand struct {
+	MemberID   string
+	Reason     string
+	OperatorID string
+}
+
+// UnfreezePointsAccountCommand 解除凍結的命令
+type UnfreezePointsAccountCommand struct {
+	MemberID   string
+	OperatorID string
+}
+
+// FreezePointsAccountResult 凍結 / 解除凍結的結果
+type FreezePointsAccountResult struct {
+	AccountID string
+	MemberID  string
+	Frozen    bool
+}
+
+// FreezePointsAccountUseCase 凍結積分帳戶 Use Case
+//
+// 使用場景：詐騙調查期間暫停會員使用積分（DeductPoints 被拒絕）
+type FreezePointsAccountUseCase struct {
+	accountRepo points.PointsAccountRepository
+	txManager   shared.TransactionManager
+}
+
+// NewFreezePointsAccountUseCase 創建 Use Case 實例
+func NewFreezePointsAccountUseCase(
+	repo points.PointsAccountRepository,
+	txManager shared.TransactionManager,
+) *FreezePointsAccountUseCase {
+	return &FreezePointsAccountUseCase{
+		accountRepo: repo,
+		txManager:   txManager,
+	}
+}
+
+// Execute 執行凍結
+//
+// 錯誤處理：
+// - ErrInvalidMemberID: MemberID 格式無效
+// - ErrAccountNotFound: 帳戶不存在
+// - ErrAccountFrozen: 帳戶已凍結
+// - ErrInvalidAccountFreeze: 未提供原因或操作人員
+func (uc *FreezePointsAccountUseCase) Execute(cmd FreezePointsAccountCommand) (*FreezePointsAccountResult, error) {
+	memberID, err := points.MemberIDFromString(cmd.MemberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse member ID: %w", err)
+	}
+
+	var result *FreezePointsAccountResult
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		account, err := uc.accountRepo.FindByMemberID(ctx, memberID)
+		if err != nil {
+			return fmt.Errorf("failed to find account: %w", err)
+		}
+
+		if err := account.Freeze(cmd.Reason, cmd.OperatorID); err != nil {
+			return fmt.Errorf("failed to freeze account: %w", err)
+		}
+
+		if err := uc.accountRepo.Update(ctx, account); err != nil {
+			return fmt.Errorf("failed to update account: %w", err)
+		}
+
+		result = &FreezePointsAccountResult{
+			AccountID: account.AccountID().String(),
+			MemberID:  account.MemberID().String(),
+			Frozen:    account.IsFrozen(),
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ===========================
+// UnfreezePointsAccount Use Case
+// ===========================
+
+// UnfreezePointsAccountUseCase 解除凍結 Use Case
+type UnfreezePointsAccountUseCase struct {
+	accountRepo points.PointsAccountRepository
+	txManager   shared.TransactionManager
+}
+
+// NewUnfreezePointsAccountUseCase 創建 Use Case 實例
+func NewUnfreezePointsAccountUseCase(
+	repo points.PointsAccountRepository,
+	txManager shared.TransactionManager,
+) *UnfreezePointsAccountUseCase {
+	return &UnfreezePointsAccountUseCase{
+		accountRepo: repo,
+		txManager:   txManager,
+	}
+}
+
+// Execute 執行解除凍結
+//
+// 錯誤處理：
+// - ErrAccountNotFrozen: 帳戶未凍結
+func (uc *UnfreezePointsAccountUseCase) Execute(cmd UnfreezePointsAccountCommand) (*FreezePointsAccountResult, error) {
+	memberID, err := points.MemberIDFromString(cmd.MemberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse member ID: %w", err)
+	}
+
+	var result *FreezePointsAccountResult
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		account, err := uc.accountRepo.FindByMemberID(ctx, memberID)
+		if err != nil {
+			return fmt.Errorf("failed to find account: %w", err)
+		}
+
+		if err := account.Unfreeze(cmd.OperatorID); err != nil {
+			return fmt.Errorf("failed to unfreeze account: %w", err)
+		}
+
+		if err := uc.accountRepo.Update(ctx, account); err != nil {
+			return fmt.Errorf("failed to update account: %w", err)
+		}
+
+		result = &FreezePointsAccountResult{
+			AccountID: account.AccountID().String(),
+			MemberID:  account.MemberID().String(),
+			Frozen:    account.IsFrozen(),
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,62 @@
+package points
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// Freeze / Unfreeze PointsAccount Use Case 測試
+// ===========================
+
+// Test 1: 凍結成功，扣減積分被拒絕
+func TestFreezePointsAccountUseCase_Success_BlocksDeduction(t *testing.T) {
+	// Arrange
+	mockRepo := NewMockPointsAccountRepository()
+	mockTxManager := NewMockTransactionManager()
+	memberID := points.NewMemberID()
+	account, _ := points.NewPointsAccount(memberID)
+	earned, _ := points.NewPointsAmount(50)
+	require.NoError(t, account.EarnPoints(earned, points.PointsSourceInvoice, "TX001", "test"))
+	mockRepo.accounts[memberID.String()] = account
+	useCase := NewFreezePointsAccountUseCase(mockRepo, mockTxManager)
+
+	// Act
+	result, err := useCase.Execute(FreezePointsAccountCommand{
+		MemberID:   memberID.String(),
+		Reason:     "連號發票調查",
+		OperatorID: "owner01",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, result.Frozen)
+	deduct, _ := points.NewPointsAmount(10)
+	assert.ErrorIs(t, account.DeductPoints(deduct, "兌換"), points.ErrAccountFrozen)
+	assert.Equal(t, 1, mockTxManager.InTransactionCallCount)
+}
+
+// Test 2: 解除未凍結的帳戶，返回錯誤
+func TestUnfreezePointsAccountUseCase_NotFrozen_ReturnsError(t *testing.T) {
+	// Arrange
+	mockRepo := NewMockPointsAccountRepository()
+	mockTxManager := NewMockTransactionManager()
+	memberID := points.NewMemberID()
+	account, _ := points.NewPointsAccount(memberID)
+	mockRepo.accounts[memberID.String()] = account
+	useCase := NewUnfreezePointsAccountUseCase(mockRepo, mockTxManager)
+
+	// Act
+	result, err := useCase.Execute(UnfreezePointsAccountCommand{
+		MemberID:   memberID.String(),
+		OperatorID: "owner01",
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, points.ErrAccountNotFrozen), "error should wrap ErrAccountNotFrozen")
+}
@@ -0,0 +1,228 @@
+package points
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// 兌換目錄
+// ===========================
+
+// RewardResult 兌換目錄中的獎勵
+type RewardResult struct {
+	RewardID   string
+	Name       string
+	PointsCost int
+	Alcoholic  bool
+	CreatedAt  time.Time
+}
+
+// CreateRewardCommand 新增獎勵的命令
+//
+// 輸入：
+// - Name: 獎勵名稱（必填，最多 100 字）
+// - PointsCost: 兌換所需點數（必須大於 0）
+// - Alcoholic: 是否為酒類獎勵（僅限已驗證成年的會員兌換）
+type CreateRewardCommand struct {
+	Name       string
+	PointsCost int
+	Alcoholic  bool
+	Now        time.Time
+}
+
+// CreateRewardUseCase 新增獎勵 Use Case
+type CreateRewardUseCase struct {
+	rewardRepo points.RewardRepository
+	txManager  shared.TransactionManager
+}
+
+// NewCreateRewardUseCase 創建 Use Case 實例
+func NewCreateRewardUseCase(rewardRepo points.RewardRepository, txManager shared.TransactionManager) *CreateRewardUseCase {
+	return &CreateRewardUseCase{rewardRepo: rewardRepo, txManager: txManager}
+}
+
+// Execute 新增獎勵
+//
+// 錯誤：名稱空白或過長、點數 <= 0 → ErrInvalidReward
+func (uc *CreateRewardUseCase) Execute(cmd CreateRewardCommand) (*RewardResult, error) {
+	reward, err := points.NewReward(cmd.Name, cmd.PointsCost, cmd.Alcoholic, cmd.Now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		if err := uc.rewardRepo.Save(ctx, reward); err != nil {
+			return fmt.Errorf("failed to save reward: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toRewardResult(reward), nil
+}
+
+// ListRewardsUseCase 查詢兌換目錄 Use Case
+type ListRewardsUseCase struct {
+	rewardRepo points.RewardRepository
+}
+
+// NewListRewardsUseCase 創建 Use Case 實例
+func NewListRewardsUseCase(rewardRepo points.RewardRepository) *ListRewardsUseCase {
+	return &ListRewardsUseCase{rewardRepo: rewardRepo}
+}
+
+// Execute 查詢兌換目錄（依兌換點數由低到高）
+func (uc *ListRewardsUseCase) Execute() ([]*RewardResult, error) {
+	rewards, err := uc.rewardRepo.FindAll(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find rewards: %w", err)
+	}
+
+	results := make([]*RewardResult, 0, len(rewards))
+	for _, r := range rewards {
+		results = append(results, toRewardResult(r))
+	}
+	return results, nil
+}
+
+// ===========================
+// RedeemReward Use Case
+// ===========================
+
+// RedeemRewardCommand 以積分兌換獎勵的命令
+type RedeemRewardCommand struct {
+	MemberID string
+	RewardID string
+}
+
+// RedeemRewardResult 兌換結果
+type RedeemRewardResult struct {
+	MemberID        string
+	RewardID        string
+	RewardName      string
+	PointsCost      int
+	AvailablePoints int
+}
+
+// RedeemRewardUseCase 以積分兌換獎勵 Use Case
+//
+// 業務規則：
+// - 酒類獎勵僅限已驗證成年的會員兌換（店員查驗證件後標記）
+// - 凍結中的帳戶不可兌換；可用積分不足時不可兌換
+//
+// 事件發布：提交後發布 points.deducted
+type RedeemRewardUseCase struct {
+	rewardRepo  points.RewardRepository
+	accountRepo points.PointsAccountRepository
+	memberRepo  member.MemberRepository
+	txManager   shared.TransactionManager
+	publisher   shared.EventPublisher
+}
+
+// NewRedeemRewardUseCase 創建 Use Case 實例
+func NewRedeemRewardUseCase(
+	rewardRepo points.RewardRepository,
+	accountRepo points.PointsAccountRepository,
+	memberRepo member.MemberRepository,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *RedeemRewardUseCase {
+	return &RedeemRewardUseCase{
+		rewardRepo:  rewardRepo,
+		accountRepo: accountRepo,
+		memberRepo:  memberRepo,
+		txManager:   txManager,
+		publisher:   publisher,
+	}
+}
+
+// Execute 執行兌換
+//
+// 錯誤處理：
+// - ErrRewardNotFound: 獎勵不存在
+// - member.ErrAgeVerificationRequired: 酒類獎勵，會員尚未驗證成年
+// - ErrAccountNotFound: 帳戶不存在
+// - ErrAccountFrozen: 帳戶凍結中
+// - ErrInsufficientPoints: 可用積分不足
+func (uc *RedeemRewardUseCase) Execute(cmd RedeemRewardCommand) (*RedeemRewardResult, error) {
+	memberID, err := points.MemberIDFromString(cmd.MemberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse member ID: %w", err)
+	}
+	rewardID, err := points.RewardIDFromString(cmd.RewardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *RedeemRewardResult
+	var account *points.PointsAccount
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		reward, err := uc.rewardRepo.FindByID(ctx, rewardID)
+		if err != nil {
+			return fmt.Errorf("failed to find reward: %w", err)
+		}
+		if reward.RequiresVerifiedAdult() {
+			if err := uc.ensureVerifiedAdult(ctx, memberID); err != nil {
+				return err
+			}
+		}
+
+		account, err = uc.accountRepo.FindByMemberID(ctx, memberID)
+		if err != nil {
+			return fmt.Errorf("failed to find account: %w", err)
+		}
+		if err := account.DeductPoints(reward.PointsCost(), reward.RedemptionReason()); err != nil {
+			return err
+		}
+		if err := uc.accountRepo.Update(ctx, account); err != nil {
+			return fmt.Errorf("failed to update account: %w", err)
+		}
+
+		result = &RedeemRewardResult{
+			MemberID:        memberID.String(),
+			RewardID:        reward.RewardID().String(),
+			RewardName:      reward.Name(),
+			PointsCost:      reward.PointsCost().Value(),
+			AvailablePoints: account.GetAvailablePoints().Value(),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := publishAccountEvents(uc.publisher, account); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ensureVerifiedAdult 確認會員已驗證成年（酒類獎勵）
+func (uc *RedeemRewardUseCase) ensureVerifiedAdult(ctx shared.TransactionContext, memberID points.MemberID) error {
+	id, err := member.MemberIDFromString(memberID.String())
+	if err != nil {
+		return fmt.Errorf("failed to parse member ID: %w", err)
+	}
+	m, err := uc.memberRepo.FindByMemberID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to find member: %w", err)
+	}
+	return m.EnsureVerifiedAdult()
+}
+
+// toRewardResult 將 Domain 模型轉換為結果 DTO
+func toRewardResult(r *points.Reward) *RewardResult {
+	return &RewardResult{
+		RewardID:   r.RewardID().String(),
+		Name:       r.Name(),
+		PointsCost: r.PointsCost().Value(),
+		Alcoholic:  r.Alcoholic(),
+		CreatedAt:  r.CreatedAt(),
+	}
+}
@@ -0,0 +1,212 @@
+package points
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// 兌換目錄 / RedeemReward Use Case 測試
+// ===========================
+
+// rewardFixture 已有 200 點帳戶的會員與兌換目錄
+type rewardFixture struct {
+	now         time.Time
+	rewardRepo  *MockRewardRepository
+	accountRepo *MockPointsAccountRepository
+	memberRepo  *MockMemberRepository
+	publisher   *FakeEventPublisher
+	member      *member.Member
+	useCase     *RedeemRewardUseCase
+}
+
+func newRewardFixture(t *testing.T) *rewardFixture {
+	t.Helper()
+	f := &rewardFixture{
+		now:         time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+		rewardRepo:  NewMockRewardRepository(),
+		accountRepo: NewMockPointsAccountRepository(),
+		memberRepo:  &MockMemberRepository{members: make(map[string]*member.Member)},
+		publisher:   &FakeEventPublisher{},
+	}
+
+	lineUserID, err := member.NewLineUserID("U1234567890abcdef1234567890abcdef")
+	require.NoError(t, err)
+	f.member, err = member.NewMember(lineUserID, "王小明")
+	require.NoError(t, err)
+	f.memberRepo.members[f.member.MemberID().String()] = f.member
+
+	memberID, err := points.MemberIDFromString(f.member.MemberID().String())
+	require.NoError(t, err)
+	account, err := points.NewPointsAccount(memberID)
+	require.NoError(t, err)
+	amount, err := points.NewPointsAmount(200)
+	require.NoError(t, err)
+	require.NoError(t, account.EarnPoints(amount, points.PointsSourceInvoice, "tx-1", "消費"))
+	account.PullEvents()
+	f.accountRepo.accounts[memberID.String()] = account
+
+	f.useCase = NewRedeemRewardUseCase(f.rewardRepo, f.accountRepo, f.memberRepo, NewMockTransactionManager(), f.publisher)
+	return f
+}
+
+// createReward 透過 CreateRewardUseCase 新增獎勵
+func (f *rewardFixture) createReward(t *testing.T, name string, cost int, alcoholic bool) *RewardResult {
+	t.Helper()
+	result, err := NewCreateRewardUseCase(f.rewardRepo, NewMockTransactionManager()).Execute(CreateRewardCommand{
+		Name: name, PointsCost: cost, Alcoholic: alcoholic, Now: f.now,
+	})
+	require.NoError(t, err)
+	return result
+}
+
+// Test 1: 一般獎勵直接兌換並發布 points.deducted；目錄依點數排序
+func TestRedeemRewardUseCase_RedeemsNonAlcoholicReward(t *testing.T) {
+	// Arrange
+	f := newRewardFixture(t)
+	beer := f.createReward(t, "生啤酒一杯", 120, true)
+	fries := f.createReward(t, "薯條", 80, false)
+
+	// Act
+	result, err := f.useCase.Execute(RedeemRewardCommand{MemberID: f.member.MemberID().String(), RewardID: fries.RewardID})
+	require.NoError(t, err)
+	catalog, err := NewListRewardsUseCase(f.rewardRepo).Execute()
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "薯條", result.RewardName)
+	assert.Equal(t, 80, result.PointsCost)
+	assert.Equal(t, 120, result.AvailablePoints)
+	require.Len(t, f.publisher.events, 1)
+	assert.Equal(t, "points.deducted", f.publisher.events[0].EventType())
+	require.Len(t, catalog, 2)
+	assert.Equal(t, fries.RewardID, catalog[0].RewardID)
+	assert.Equal(t, beer.RewardID, catalog[1].RewardID)
+	assert.True(t, catalog[1].Alcoholic)
+}
+
+// Test 2: 酒類獎勵拒絕未驗證成年的會員（不扣點、不發布事件）；驗證後可兌換
+func TestRedeemRewardUseCase_AlcoholicRewardRequiresVerifiedAdult(t *testing.T) {
+	// Arrange
+	f := newRewardFixture(t)
+	beer := f.createReward(t, "生啤酒一杯", 120, true)
+	cmd := RedeemRewardCommand{MemberID: f.member.MemberID().String(), RewardID: beer.RewardID}
+
+	// Act
+	_, unverifiedErr := f.useCase.Execute(cmd)
+	unverifiedEvents := len(f.publisher.events)
+
+	verification, err := member.NewAgeVerification("staff01", member.AgeVerifiedByStaffIDCheck, f.now)
+	require.NoError(t, err)
+	_, err = f.member.VerifyAge(verification)
+	require.NoError(t, err)
+	result, verifiedErr := f.useCase.Execute(cmd)
+
+	// Assert
+	assert.ErrorIs(t, unverifiedErr, member.ErrAgeVerificationRequired)
+	assert.Equal(t, 0, unverifiedEvents)
+	require.NoError(t, verifiedErr)
+	assert.Equal(t, 80, result.AvailablePoints)
+	require.Len(t, f.publisher.events, 1)
+}
+
+// Test 3: 可用積分不足、獎勵不存在、名稱空白
+func TestRedeemRewardUseCase_Errors(t *testing.T) {
+	// Arrange
+	f := newRewardFixture(t)
+	bottle := f.createReward(t, "威士忌一瓶", 5000, false)
+
+	// Act
+	_, insufficientErr := f.useCase.Execute(RedeemRewardCommand{MemberID: f.member.MemberID().String(), RewardID: bottle.RewardID})
+	_, missingErr := f.useCase.Execute(RedeemRewardCommand{
+		MemberID: f.member.MemberID().String(), RewardID: points.NewRewardID().String(),
+	})
+	_, invalidErr := NewCreateRewardUseCase(f.rewardRepo, NewMockTransactionManager()).Execute(CreateRewardCommand{
+		Name: " ", PointsCost: 100, Now: f.now,
+	})
+
+	// Assert
+	assert.ErrorIs(t, insufficientErr, points.ErrInsufficientPoints)
+	assert.ErrorIs(t, missingErr, points.ErrRewardNotFound)
+	assert.ErrorIs(t, invalidErr, points.ErrInvalidReward)
+	assert.Empty(t, f.publisher.events)
+}
+
+// ===========================
+// Mock RewardRepository / MemberRepository
+// ===========================
+
+type MockRewardRepository struct {
+	rewards []*points.Reward
+}
+
+func NewMockRewardRepository() *MockRewardRepository {
+	return &MockRewardRepository{}
+}
+
+func (m *MockRewardRepository) Save(ctx shared.TransactionContext, reward *points.Reward) error {
+	m.rewards = append(m.rewards, reward)
+	return nil
+}
+
+func (m *MockRewardRepository) FindByID(ctx shared.TransactionContext, rewardID points.RewardID) (*points.Reward, error) {
+	for _, r := range m.rewards {
+		if r.RewardID() == rewardID {
+			return r, nil
+		}
+	}
+	return nil, points.ErrRewardNotFound
+}
+
+func (m *MockRewardRepository) FindAll(ctx shared.TransactionContext) ([]*points.Reward, error) {
+	result := append([]*points.Reward(nil), m.rewards...)
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].PointsCost().LessThan(result[j].PointsCost())
+	})
+	return result, nil
+}
+
+type MockMemberRepository struct {
+	members map[string]*member.Member
+}
+
+func (m *MockMemberRepository) Save(ctx shared.TransactionContext, mem *member.Member) error {
+	m.members[mem.MemberID().String()] = mem
+	return nil
+}
+
+func (m *MockMemberRepository) Update(ctx shared.TransactionContext, mem *member.Member) error {
+	return m.Save(ctx, mem)
+}
+
+func (m *MockMemberRepository) FindByMemberID(ctx shared.TransactionContext, id member.MemberID) (*member.Member, error) {
+	if mem, exists := m.members[id.String()]; exists {
+		return mem, nil
+	}
+	return nil, member.ErrMemberNotFound
+}
+
+func (m *MockMemberRepository) FindByLineUserID(ctx shared.TransactionContext, lineUserID member.LineUserID) (*member.Member, error) {
+	for _, mem := range m.members {
+		if mem.LineUserID().Equals(lineUserID) {
+			return mem, nil
+		}
+	}
+	return nil, member.ErrMemberNotFound
+}
+
+func (m *MockMemberRepository) ExistsByPhoneNumber(ctx shared.TransactionContext, phoneNumber member.PhoneNumber) (bool, error) {
+	return false, nil
+}
+
+func (m *MockMemberRepository) ExistsByLineUserID(ctx shared.TransactionContext, lineUserID member.LineUserID) (bool, error) {
+	_, err := m.FindByLineUserID(ctx, lineUserID)
+	return err == nil, nil
+}
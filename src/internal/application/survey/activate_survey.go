@@ -0,0 +1,148 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+)
+
+// ===========================
+// ActivateSurvey / DeactivateSurvey Use Cases
+// ===========================
+
+// ActivateSurveyCommand 啟用 / 停用問卷命令
+type ActivateSurveyCommand struct {
+	SurveyID string
+}
+
+// ActivateSurveyResult 啟用結果
+//
+// 輸出：
+// - DeactivatedSurveyID: 被自動停用的問卷 ID（沒有則為空字串）
+type ActivateSurveyResult struct {
+	SurveyID            string
+	DeactivatedSurveyID string
+}
+
+// ActivateSurveyUseCase 啟用問卷 Use Case
+//
+// 業務規則（BR-004-04）：
+// - 同時只能有一個啟用問卷，啟用時自動停用目前啟用的問卷
+// - 停用與啟用在同一事務中完成（先停用再啟用，避免違反部分唯一索引）
+type ActivateSurveyUseCase struct {
+	surveyRepo survey.SurveyRepository
+	activation *survey.SurveyActivationService
+	txManager  shared.TransactionManager
+}
+
+// NewActivateSurveyUseCase 創建 Use Case 實例
+func NewActivateSurveyUseCase(
+	surveyRepo survey.SurveyRepository,
+	txManager shared.TransactionManager,
+) *ActivateSurveyUseCase {
+	return &ActivateSurveyUseCase{
+		surveyRepo: surveyRepo,
+		activation: survey.NewSurveyActivationService(),
+		txManager:  txManager,
+	}
+}
+
+// Execute 執行啟用問卷
+//
+// 錯誤處理：
+// - ErrSurveyNotFound: 問卷不存在
+// - ErrSurveyAlreadyActive: 問卷已啟用
+func (uc *ActivateSurveyUseCase) Execute(cmd ActivateSurveyCommand) (*ActivateSurveyResult, error) {
+	surveyID, err := survey.SurveyIDFromString(cmd.SurveyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse survey ID: %w", err)
+	}
+
+	var result *ActivateSurveyResult
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		target, err := uc.surveyRepo.FindByID(ctx, surveyID)
+		if err != nil {
+			return fmt.Errorf("failed to find survey: %w", err)
+		}
+
+		current, err := uc.surveyRepo.FindActive(ctx)
+		if err != nil && !errors.Is(err, survey.ErrNoActiveSurvey) {
+			return fmt.Errorf("failed to find active survey: %w", err)
+		}
+
+		deactivated, err := uc.activation.Activate(target, current)
+		if err != nil {
+			return fmt.Errorf("failed to activate survey: %w", err)
+		}
+
+		result = &ActivateSurveyResult{SurveyID: target.SurveyID().String()}
+
+		if deactivated != nil {
+			if err := uc.surveyRepo.Update(ctx, deactivated); err != nil {
+				return fmt.Errorf("failed to deactivate survey: %w", err)
+			}
+			result.DeactivatedSurveyID = deactivated.SurveyID().String()
+		}
+
+		if err := uc.surveyRepo.Update(ctx, target); err != nil {
+			return fmt.Errorf("failed to update survey: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DeactivateSurveyUseCase 停用問卷 Use Case
+//
+// 注意：停用後沒有啟用中的問卷，掃描發票時不發送問卷連結
+type DeactivateSurveyUseCase struct {
+	surveyRepo survey.SurveyRepository
+	txManager  shared.TransactionManager
+}
+
+// NewDeactivateSurveyUseCase 創建 Use Case 實例
+func NewDeactivateSurveyUseCase(
+	surveyRepo survey.SurveyRepository,
+	txManager shared.TransactionManager,
+) *DeactivateSurveyUseCase {
+	return &DeactivateSurveyUseCase{
+		surveyRepo: surveyRepo,
+		txManager:  txManager,
+	}
+}
+
+// Execute 執行停用問卷
+//
+// 錯誤處理：
+// - ErrSurveyNotFound: 問卷不存在
+// - ErrSurveyNotActive: 問卷未啟用
+func (uc *DeactivateSurveyUseCase) Execute(cmd ActivateSurveyCommand) error {
+	surveyID, err := survey.SurveyIDFromString(cmd.SurveyID)
+	if err != nil {
+		return fmt.Errorf("failed to parse survey ID: %w", err)
+	}
+
+	return uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		s, err := uc.surveyRepo.FindByID(ctx, surveyID)
+		if err != nil {
+			return fmt.Errorf("failed to find survey: %w", err)
+		}
+
+		if err := s.Deactivate(); err != nil {
+			return fmt.Errorf("failed to deactivate survey: %w", err)
+		}
+
+		if err := uc.surveyRepo.Update(ctx, s); err != nil {
+			return fmt.Errorf("failed to update survey: %w", err)
+		}
+
+		return nil
+	})
+}
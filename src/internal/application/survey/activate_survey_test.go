@@ -0,0 +1,82 @@
+package survey
+
+import (
+	"testing"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// ActivateSurvey / DeactivateSurvey Use Case 測試
+// ===========================
+
+// givenSurvey 建立未啟用的問卷
+func givenSurvey(t *testing.T, repo *MockSurveyRepository) string {
+	result, err := NewCreateSurveyUseCase(repo, NewMockTransactionManager()).Execute(CreateSurveyCommand{
+		Title:     "滿意度問卷",
+		Questions: testQuestionInputs(),
+	})
+	require.NoError(t, err)
+	return result.SurveyID
+}
+
+// Test 5: 啟用問卷時自動停用其他問卷
+func TestActivateSurveyUseCase_DeactivatesCurrent(t *testing.T) {
+	// Arrange
+	repo := NewMockSurveyRepository()
+	first := givenSurvey(t, repo)
+	second := givenSurvey(t, repo)
+	useCase := NewActivateSurveyUseCase(repo, NewMockTransactionManager())
+	_, err := useCase.Execute(ActivateSurveyCommand{SurveyID: first})
+	require.NoError(t, err)
+
+	// Act
+	result, err := useCase.Execute(ActivateSurveyCommand{SurveyID: second})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, first, result.DeactivatedSurveyID)
+	assert.False(t, repo.surveys[first].IsActive())
+	assert.True(t, repo.surveys[second].IsActive())
+
+	active, err := NewGetActiveSurveyUseCase(repo).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, second, active.SurveyID)
+}
+
+// Test 6: 重複啟用
+func TestActivateSurveyUseCase_AlreadyActive_ReturnsError(t *testing.T) {
+	// Arrange
+	repo := NewMockSurveyRepository()
+	id := givenSurvey(t, repo)
+	useCase := NewActivateSurveyUseCase(repo, NewMockTransactionManager())
+	_, err := useCase.Execute(ActivateSurveyCommand{SurveyID: id})
+	require.NoError(t, err)
+
+	// Act
+	_, err = useCase.Execute(ActivateSurveyCommand{SurveyID: id})
+
+	// Assert
+	assert.ErrorIs(t, err, survey.ErrSurveyAlreadyActive)
+}
+
+// Test 7: 停用後沒有啟用中的問卷
+func TestDeactivateSurveyUseCase_Success(t *testing.T) {
+	// Arrange
+	repo := NewMockSurveyRepository()
+	id := givenSurvey(t, repo)
+	txManager := NewMockTransactionManager()
+	_, err := NewActivateSurveyUseCase(repo, txManager).Execute(ActivateSurveyCommand{SurveyID: id})
+	require.NoError(t, err)
+	useCase := NewDeactivateSurveyUseCase(repo, txManager)
+
+	// Act
+	err = useCase.Execute(ActivateSurveyCommand{SurveyID: id})
+
+	// Assert
+	require.NoError(t, err)
+	_, err = NewGetActiveSurveyUseCase(repo).Execute()
+	assert.ErrorIs(t, err, survey.ErrNoActiveSurvey)
+}
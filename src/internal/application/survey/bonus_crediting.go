@@ -0,0 +1,67 @@
+package survey
+
+import (
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+)
+
+// ===========================
+// 問卷獎勵入帳（Use Case 共用）
+// ===========================
+
+// surveyBonusCrediter 為問卷回覆發放獎勵積分
+//
+// 職責：
+// - 跨上下文轉換 MemberID（survey → points，透過 String()）
+// - EarnPoints(PointsSourceSurvey, transactionID) 並更新帳戶
+// - 標記回覆獎勵已發放（由調用者持久化 SurveyResponse）
+// - 返回更新後的帳戶，由調用者於提交後發布 points.earned
+//
+// 設計原則：
+// - 在調用者的事務中執行（積分入帳與獎勵狀態同一事務，避免重複發放）
+type surveyBonusCrediter struct {
+	accountRepo points.PointsAccountRepository
+}
+
+// award 發放問卷獎勵，返回入帳的帳戶與點數
+func (c *surveyBonusCrediter) award(
+	ctx shared.TransactionContext,
+	response *survey.SurveyResponse,
+) (*points.PointsAccount, int, error) {
+	if err := response.AwardBonus(); err != nil {
+		return nil, 0, err
+	}
+
+	memberID, err := points.MemberIDFromString(response.MemberID().String())
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse member ID: %w", err)
+	}
+
+	account, err := c.accountRepo.FindByMemberID(ctx, memberID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to find account: %w", err)
+	}
+
+	amount, err := points.NewPointsAmount(survey.SurveyBonusPoints)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create points amount: %w", err)
+	}
+
+	if err := account.EarnPoints(
+		amount,
+		points.PointsSourceSurvey,
+		response.TransactionID().String(),
+		"問卷填寫獎勵",
+	); err != nil {
+		return nil, 0, fmt.Errorf("failed to earn points: %w", err)
+	}
+
+	if err := c.accountRepo.Update(ctx, account); err != nil {
+		return nil, 0, fmt.Errorf("failed to update account: %w", err)
+	}
+
+	return account, amount.Value(), nil
+}
@@ -0,0 +1,64 @@
+package survey
+
+import (
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+)
+
+// ===========================
+// CreateSurvey Use Case
+// ===========================
+
+// CreateSurveyCommand 建立問卷命令
+type CreateSurveyCommand struct {
+	Title       string
+	Description string
+	Questions   []QuestionInput
+}
+
+// CreateSurveyUseCase 建立問卷 Use Case
+//
+// 業務規則：
+// - 新問卷預設未啟用，需透過 ActivateSurveyUseCase 啟用
+type CreateSurveyUseCase struct {
+	surveyRepo survey.SurveyRepository
+	txManager  shared.TransactionManager
+}
+
+// NewCreateSurveyUseCase 創建 Use Case 實例
+func NewCreateSurveyUseCase(
+	surveyRepo survey.SurveyRepository,
+	txManager shared.TransactionManager,
+) *CreateSurveyUseCase {
+	return &CreateSurveyUseCase{
+		surveyRepo: surveyRepo,
+		txManager:  txManager,
+	}
+}
+
+// Execute 執行建立問卷
+func (uc *CreateSurveyUseCase) Execute(cmd CreateSurveyCommand) (*SurveyResult, error) {
+	questions, err := buildQuestions(cmd.Questions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := survey.NewSurvey(cmd.Title, cmd.Description, questions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create survey: %w", err)
+	}
+
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		if err := uc.surveyRepo.Save(ctx, s); err != nil {
+			return fmt.Errorf("failed to save survey: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toSurveyResult(s), nil
+}
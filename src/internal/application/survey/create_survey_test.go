@@ -0,0 +1,231 @@
+package survey
+
+import (
+	"testing"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// CreateSurvey / ReviseSurvey Use Case 測試
+// ===========================
+
+// testQuestionInputs 測試用題目輸入
+func testQuestionInputs() []QuestionInput {
+	return []QuestionInput{
+		{Text: "您對今日的用餐體驗滿意嗎？", Type: "rating", Required: true},
+		{Text: "您會推薦給朋友嗎？", Type: "multiple_choice", Options: []string{"會", "不會"}, Required: true},
+	}
+}
+
+// Test 1: 建立問卷（未啟用）
+func TestCreateSurveyUseCase_Success(t *testing.T) {
+	// Arrange
+	repo := NewMockSurveyRepository()
+	txManager := NewMockTransactionManager()
+	useCase := NewCreateSurveyUseCase(repo, txManager)
+
+	// Act
+	result, err := useCase.Execute(CreateSurveyCommand{Title: "滿意度問卷", Questions: testQuestionInputs()})
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, result.IsActive)
+	require.Len(t, result.Questions, 2)
+	assert.Equal(t, "rating", result.Questions[0].Type)
+	assert.Contains(t, repo.surveys, result.SurveyID)
+	assert.Equal(t, 1, txManager.InTransactionCallCount)
+}
+
+// Test 2: 無效題型
+func TestCreateSurveyUseCase_InvalidQuestionType_ReturnsError(t *testing.T) {
+	// Arrange
+	repo := NewMockSurveyRepository()
+	useCase := NewCreateSurveyUseCase(repo, NewMockTransactionManager())
+
+	// Act
+	_, err := useCase.Execute(CreateSurveyCommand{
+		Title:     "滿意度問卷",
+		Questions: []QuestionInput{{Text: "Q", Type: "slider"}},
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, survey.ErrInvalidQuestionType)
+	assert.Empty(t, repo.surveys)
+}
+
+// Test 3: 修訂問卷保留既有題目並新增題目
+func TestReviseSurveyUseCase_KeepsExistingQuestion(t *testing.T) {
+	// Arrange
+	repo := NewMockSurveyRepository()
+	txManager := NewMockTransactionManager()
+	created, err := NewCreateSurveyUseCase(repo, txManager).Execute(CreateSurveyCommand{
+		Title:     "滿意度問卷",
+		Questions: testQuestionInputs(),
+	})
+	require.NoError(t, err)
+	useCase := NewReviseSurveyUseCase(repo, txManager)
+
+	// Act
+	result, err := useCase.Execute(ReviseSurveyCommand{
+		SurveyID: created.SurveyID,
+		Title:    "滿意度問卷 v2",
+		Questions: []QuestionInput{
+			{QuestionID: created.Questions[0].QuestionID, Text: "今晚整體體驗？", Type: "rating", Required: true},
+			{Text: "其他建議", Type: "text"},
+		},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "滿意度問卷 v2", result.Title)
+	require.Len(t, result.Questions, 2)
+	assert.Equal(t, created.Questions[0].QuestionID, result.Questions[0].QuestionID)
+	assert.NotEmpty(t, result.Questions[1].QuestionID)
+}
+
+// Test 4: 修訂時指定不屬於此問卷的題目 ID
+func TestReviseSurveyUseCase_ForeignQuestionID_ReturnsError(t *testing.T) {
+	// Arrange
+	repo := NewMockSurveyRepository()
+	txManager := NewMockTransactionManager()
+	created, err := NewCreateSurveyUseCase(repo, txManager).Execute(CreateSurveyCommand{
+		Title:     "滿意度問卷",
+		Questions: testQuestionInputs(),
+	})
+	require.NoError(t, err)
+	useCase := NewReviseSurveyUseCase(repo, txManager)
+
+	// Act
+	_, err = useCase.Execute(ReviseSurveyCommand{
+		SurveyID:  created.SurveyID,
+		Title:     "滿意度問卷",
+		Questions: []QuestionInput{{QuestionID: survey.NewQuestionID().String(), Text: "Q", Type: "rating"}},
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, survey.ErrUnknownQuestion)
+}
+
+// Test 19: 新題目以位置引用同批題目作為顯示條件
+func TestCreateSurveyUseCase_ConditionByPosition(t *testing.T) {
+	// Arrange
+	useCase := NewCreateSurveyUseCase(NewMockSurveyRepository(), NewMockTransactionManager())
+
+	// Act
+	result, err := useCase.Execute(CreateSurveyCommand{
+		Title: "滿意度問卷",
+		Questions: []QuestionInput{
+			{Text: "整體滿意度", Type: "rating", Required: true},
+			{
+				Text:       "哪裡需要改進？",
+				Type:       "text",
+				Required:   true,
+				Conditions: []ConditionInput{{SourcePosition: 1, Operator: "rating_lte", Value: "2"}},
+			},
+		},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Questions[1].Conditions, 1)
+	assert.Equal(t, result.Questions[0].QuestionID, result.Questions[1].Conditions[0].SourceQuestionID)
+	assert.Equal(t, "rating_lte", result.Questions[1].Conditions[0].Operator)
+}
+
+// Test 20: 顯示條件互相引用形成循環
+func TestCreateSurveyUseCase_ConditionCycle_ReturnsError(t *testing.T) {
+	// Arrange
+	useCase := NewCreateSurveyUseCase(NewMockSurveyRepository(), NewMockTransactionManager())
+
+	// Act
+	_, err := useCase.Execute(CreateSurveyCommand{
+		Title: "滿意度問卷",
+		Questions: []QuestionInput{
+			{
+				Text:       "服務評分",
+				Type:       "rating",
+				Conditions: []ConditionInput{{SourcePosition: 2, Operator: "rating_gte", Value: "3"}},
+			},
+			{
+				Text:       "餐點評分",
+				Type:       "rating",
+				Conditions: []ConditionInput{{SourcePosition: 1, Operator: "rating_gte", Value: "3"}},
+			},
+		},
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, survey.ErrDisplayConditionCycle)
+}
+
+// ===========================
+// Mock Repositories
+// ===========================
+
+type MockSurveyRepository struct {
+	surveys map[string]*survey.Survey
+}
+
+func NewMockSurveyRepository() *MockSurveyRepository {
+	return &MockSurveyRepository{
+		surveys: make(map[string]*survey.Survey),
+	}
+}
+
+func (m *MockSurveyRepository) Save(ctx shared.TransactionContext, s *survey.Survey) error {
+	m.surveys[s.SurveyID().String()] = s
+	return nil
+}
+
+func (m *MockSurveyRepository) Update(ctx shared.TransactionContext, s *survey.Survey) error {
+	if _, exists := m.surveys[s.SurveyID().String()]; !exists {
+		return survey.ErrSurveyNotFound
+	}
+	m.surveys[s.SurveyID().String()] = s
+	return nil
+}
+
+func (m *MockSurveyRepository) FindByID(ctx shared.TransactionContext, id survey.SurveyID) (*survey.Survey, error) {
+	if s, exists := m.surveys[id.String()]; exists {
+		return s, nil
+	}
+	return nil, survey.ErrSurveyNotFound
+}
+
+func (m *MockSurveyRepository) FindActive(ctx shared.TransactionContext) (*survey.Survey, error) {
+	for _, s := range m.surveys {
+		if s.IsActive() {
+			return s, nil
+		}
+	}
+	return nil, survey.ErrNoActiveSurvey
+}
+
+func (m *MockSurveyRepository) FindAll(ctx shared.TransactionContext, limit, offset int) ([]*survey.Survey, error) {
+	all := make([]*survey.Survey, 0, len(m.surveys))
+	for _, s := range m.surveys {
+		all = append(all, s)
+	}
+	return all, nil
+}
+
+// ===========================
+// Mock TransactionManager
+// ===========================
+
+type MockTransactionManager struct {
+	InTransactionCallCount int
+}
+
+func NewMockTransactionManager() *MockTransactionManager {
+	return &MockTransactionManager{}
+}
+
+func (m *MockTransactionManager) InTransaction(fn func(ctx shared.TransactionContext) error) error {
+	m.InTransactionCallCount++
+	return fn(nil)
+}
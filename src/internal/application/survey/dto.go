@@ -0,0 +1,189 @@
+package survey
+
+import (
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+)
+
+// ===========================
+// 問卷 DTO
+// ===========================
+
+// QuestionInput 題目輸入（建立 / 修訂問卷共用）
+//
+// 欄位：
+// - QuestionID: 修訂時填入既有題目 ID 以保留答案對應；空字串表示新題目
+// - Type: text / multiple_choice / rating
+// - Options: 僅選擇題使用
+// - Conditions: 顯示條件（全部成立才顯示）
+type QuestionInput struct {
+	QuestionID string
+	Text       string
+	Type       string
+	Options    []string
+	Required   bool
+	Conditions []ConditionInput
+}
+
+// ConditionInput 顯示條件輸入
+//
+// 欄位：
+// - SourceQuestionID: 來源題目 ID（引用既有題目）
+// - SourcePosition: 來源題目在本次輸入中的位置（從 1 開始；引用同批新增、尚無 ID 的題目時使用）
+// - Operator: rating_lte / rating_gte / choice_eq
+// - Value: 評分（1-5）或選項文字
+type ConditionInput struct {
+	SourceQuestionID string
+	SourcePosition   int
+	Operator         string
+	Value            string
+}
+
+// QuestionResult 題目輸出（依問卷順序）
+type QuestionResult struct {
+	QuestionID string
+	Text       string
+	Type       string
+	Options    []string
+	Required   bool
+	Conditions []ConditionResult
+}
+
+// ConditionResult 顯示條件輸出
+type ConditionResult struct {
+	SourceQuestionID string
+	Operator         string
+	Value            string
+}
+
+// SurveyResult 問卷輸出
+type SurveyResult struct {
+	SurveyID    string
+	Title       string
+	Description string
+	IsActive    bool
+	Questions   []QuestionResult
+}
+
+// toSurveyResult 將聚合轉為輸出 DTO
+func toSurveyResult(s *survey.Survey) *SurveyResult {
+	questions := make([]QuestionResult, 0, len(s.Questions()))
+	for _, q := range s.Questions() {
+		conditions := make([]ConditionResult, 0, len(q.Conditions()))
+		for _, c := range q.Conditions() {
+			conditions = append(conditions, ConditionResult{
+				SourceQuestionID: c.SourceQuestionID().String(),
+				Operator:         c.Operator().String(),
+				Value:            c.Value(),
+			})
+		}
+
+		questions = append(questions, QuestionResult{
+			QuestionID: q.QuestionID().String(),
+			Text:       q.Text(),
+			Type:       q.Type().String(),
+			Options:    q.Options(),
+			Required:   q.IsRequired(),
+			Conditions: conditions,
+		})
+	}
+
+	return &SurveyResult{
+		SurveyID:    s.SurveyID().String(),
+		Title:       s.Title(),
+		Description: s.Description(),
+		IsActive:    s.IsActive(),
+		Questions:   questions,
+	}
+}
+
+// buildQuestions 將題目輸入轉為領域實體
+//
+// 參數：
+//   existing - 修訂中的問卷（建立時為 nil）；指定 QuestionID 時必須屬於此問卷
+//
+// 實作邏輯：
+// 1. 先建立所有題目（新題目取得 ID）
+// 2. 再解析顯示條件（SourcePosition 對應本次輸入的題目）
+// 條件引用是否存在、是否循環由 Survey 檢查
+func buildQuestions(inputs []QuestionInput, existing *survey.Survey) ([]survey.Question, error) {
+	questions := make([]survey.Question, 0, len(inputs))
+	for i, input := range inputs {
+		questionType, err := survey.ParseQuestionType(input.Type)
+		if err != nil {
+			return nil, fmt.Errorf("invalid question #%d: %w", i+1, err)
+		}
+
+		var q survey.Question
+		if input.QuestionID == "" {
+			q, err = survey.NewQuestion(input.Text, questionType, input.Options, input.Required)
+		} else {
+			q, err = reviseQuestion(input, questionType, existing)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid question #%d: %w", i+1, err)
+		}
+
+		questions = append(questions, q)
+	}
+
+	for i, input := range inputs {
+		if len(input.Conditions) == 0 {
+			continue
+		}
+
+		conditions := make([]survey.DisplayCondition, 0, len(input.Conditions))
+		for _, c := range input.Conditions {
+			condition, err := buildCondition(c, questions)
+			if err != nil {
+				return nil, fmt.Errorf("invalid condition on question #%d: %w", i+1, err)
+			}
+			conditions = append(conditions, condition)
+		}
+		questions[i] = questions[i].WithConditions(conditions...)
+	}
+
+	return questions, nil
+}
+
+// buildCondition 解析顯示條件輸入
+func buildCondition(input ConditionInput, questions []survey.Question) (survey.DisplayCondition, error) {
+	var sourceID survey.QuestionID
+	switch {
+	case input.SourceQuestionID != "":
+		id, err := survey.QuestionIDFromString(input.SourceQuestionID)
+		if err != nil {
+			return survey.DisplayCondition{}, err
+		}
+		sourceID = id
+	case input.SourcePosition >= 1 && input.SourcePosition <= len(questions):
+		sourceID = questions[input.SourcePosition-1].QuestionID()
+	default:
+		return survey.DisplayCondition{}, survey.ErrUnknownConditionQuestion.WithContext(
+			"source_position", input.SourcePosition,
+		)
+	}
+
+	return survey.NewDisplayCondition(sourceID, survey.ConditionOperator(input.Operator), input.Value)
+}
+
+// reviseQuestion 以既有題目 ID 重建題目
+func reviseQuestion(input QuestionInput, questionType survey.QuestionType, existing *survey.Survey) (survey.Question, error) {
+	questionID, err := survey.QuestionIDFromString(input.QuestionID)
+	if err != nil {
+		return survey.Question{}, err
+	}
+
+	if existing == nil {
+		return survey.Question{}, survey.ErrUnknownQuestion.WithContext("question_id", input.QuestionID)
+	}
+	if _, ok := existing.Question(questionID); !ok {
+		return survey.Question{}, survey.ErrUnknownQuestion.WithContext(
+			"survey_id", existing.SurveyID().String(),
+			"question_id", input.QuestionID,
+		)
+	}
+
+	return survey.ReconstructQuestion(questionID, input.Text, questionType, input.Options, input.Required)
+}
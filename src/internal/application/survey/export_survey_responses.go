@@ -0,0 +1,125 @@
+package survey
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+)
+
+// ===========================
+// ExportSurveyResponses Use Case
+// ===========================
+
+// 每寫入多少筆回覆 Flush 一次（串流輸出，避免緩衝整份檔案）
+const csvFlushInterval = 100
+
+// utf8BOM 讓 Excel 正確辨識 UTF-8 中文
+const utf8BOM = "\xef\xbb\xbf"
+
+// ExportSurveyResponsesQuery 匯出查詢（區間 [From, To)）
+type ExportSurveyResponsesQuery struct {
+	SurveyID string
+	From     time.Time
+	To       time.Time
+}
+
+// ExportSurveyResponsesUseCase 匯出問卷回覆 CSV Use Case
+//
+// 輸出格式：
+// - 欄位：回覆 ID、交易 ID、會員 ID、提交時間（Asia/Taipei）、獎勵狀態、各題答案（依題目順序）
+// - 評分題輸出分數，文字 / 選擇題輸出內容，未作答留空
+//
+// 設計原則：逐筆讀取、逐筆寫出（SurveyAnalyticsQuery.StreamResponses）
+type ExportSurveyResponsesUseCase struct {
+	surveyRepo survey.SurveyRepository
+	analytics  survey.SurveyAnalyticsQuery
+}
+
+// NewExportSurveyResponsesUseCase 創建 Use Case 實例
+func NewExportSurveyResponsesUseCase(
+	surveyRepo survey.SurveyRepository,
+	analytics survey.SurveyAnalyticsQuery,
+) *ExportSurveyResponsesUseCase {
+	return &ExportSurveyResponsesUseCase{
+		surveyRepo: surveyRepo,
+		analytics:  analytics,
+	}
+}
+
+// Execute 將 CSV 寫入 w
+func (uc *ExportSurveyResponsesUseCase) Execute(query ExportSurveyResponsesQuery, w io.Writer) error {
+	s, period, err := loadSurveyAndPeriod(uc.surveyRepo, query.SurveyID, query.From, query.To)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return fmt.Errorf("failed to write csv: %w", err)
+	}
+
+	questions := s.Questions()
+	writer := csv.NewWriter(w)
+
+	header := []string{"回覆ID", "交易ID", "會員ID", "提交時間", "獎勵狀態"}
+	for _, q := range questions {
+		header = append(header, q.Text())
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write csv: %w", err)
+	}
+
+	written := 0
+	err = uc.analytics.StreamResponses(nil, s.SurveyID(), period, func(record survey.ResponseRecord) error {
+		answers := make(map[string]survey.Answer, len(record.Answers))
+		for _, a := range record.Answers {
+			answers[a.QuestionID().String()] = a
+		}
+
+		row := []string{
+			record.ResponseID.String(),
+			record.TransactionID.String(),
+			record.MemberID.String(),
+			record.SubmittedAt.In(shared.BusinessLocation).Format("2006-01-02 15:04:05"),
+			record.BonusStatus.String(),
+		}
+		for _, q := range questions {
+			row = append(row, formatAnswer(answers, q))
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+
+		written++
+		if written%csvFlushInterval == 0 {
+			writer.Flush()
+			return writer.Error()
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export survey responses: %w", err)
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write csv: %w", err)
+	}
+	return nil
+}
+
+// formatAnswer 格式化單題答案（未作答返回空字串）
+func formatAnswer(answers map[string]survey.Answer, q survey.Question) string {
+	a, ok := answers[q.QuestionID().String()]
+	if !ok {
+		return ""
+	}
+	if a.Type() == survey.QuestionTypeRating {
+		return strconv.Itoa(a.Rating())
+	}
+	return a.Text()
+}
@@ -0,0 +1,36 @@
+package survey
+
+import (
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+)
+
+// GetActiveSurveyUseCase 查詢啟用中問卷 Use Case
+//
+// 使用場景：
+// - 問卷填寫頁面載入題目
+// - 掃描發票後判斷是否附上問卷連結
+type GetActiveSurveyUseCase struct {
+	surveyRepo survey.SurveyRepository
+}
+
+// NewGetActiveSurveyUseCase 創建 Use Case 實例
+func NewGetActiveSurveyUseCase(surveyRepo survey.SurveyRepository) *GetActiveSurveyUseCase {
+	return &GetActiveSurveyUseCase{
+		surveyRepo: surveyRepo,
+	}
+}
+
+// Execute 執行查詢
+//
+// 錯誤處理：
+// - ErrNoActiveSurvey: 目前沒有啟用中的問卷
+func (uc *GetActiveSurveyUseCase) Execute() (*SurveyResult, error) {
+	s, err := uc.surveyRepo.FindActive(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find active survey: %w", err)
+	}
+
+	return toSurveyResult(s), nil
+}
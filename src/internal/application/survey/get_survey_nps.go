@@ -0,0 +1,160 @@
+package survey
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+)
+
+// ===========================
+// GetSurveyNPS Use Case
+// ===========================
+
+// 預設 NPS 趨勢時間窗（每週）
+const defaultNPSWindow = 7 * 24 * time.Hour
+
+// SurveyNPSQuery NPS 查詢
+//
+// 輸入：
+// - QuestionID: 作為推薦意願指標的評分題
+// - Window: 趨勢時間窗（預設 7 天）
+type SurveyNPSQuery struct {
+	SurveyID   string
+	QuestionID string
+	From       time.Time
+	To         time.Time
+	Window     time.Duration
+}
+
+// NPSResult NPS 分數
+type NPSResult struct {
+	Score      float64
+	Promoters  int
+	Passives   int
+	Detractors int
+	Total      int
+}
+
+// NPSWindowResult 時間窗 NPS
+type NPSWindowResult struct {
+	Start time.Time
+	End   time.Time
+	NPS   NPSResult
+}
+
+// BreakdownResult 分組統計（星期 / 時段）
+type BreakdownResult struct {
+	Key           string
+	Count         int
+	AverageRating float64
+	NPS           NPSResult
+}
+
+// SurveyNPSResult NPS 分析結果
+type SurveyNPSResult struct {
+	Overall    NPSResult
+	Trend      []NPSWindowResult
+	ByWeekday  []BreakdownResult
+	ByTimeSlot []BreakdownResult
+}
+
+// GetSurveyNPSUseCase NPS 趨勢與星期 / 時段分析 Use Case
+//
+// 業務規則：
+// - 評分 5 為推薦者、4 為中立者、1-3 為批評者
+// - 星期與時段以 Asia/Taipei 當地時間判斷
+type GetSurveyNPSUseCase struct {
+	surveyRepo survey.SurveyRepository
+	analytics  survey.SurveyAnalyticsQuery
+	service    *survey.SurveyAnalyticsService
+}
+
+// NewGetSurveyNPSUseCase 創建 Use Case 實例
+func NewGetSurveyNPSUseCase(
+	surveyRepo survey.SurveyRepository,
+	analytics survey.SurveyAnalyticsQuery,
+) *GetSurveyNPSUseCase {
+	return &GetSurveyNPSUseCase{
+		surveyRepo: surveyRepo,
+		analytics:  analytics,
+		service:    survey.NewSurveyAnalyticsService(),
+	}
+}
+
+// Execute 執行查詢
+//
+// 錯誤處理：
+// - ErrInvalidAnalyticsQuery: 區間或時間窗無效、題目不是評分題
+func (uc *GetSurveyNPSUseCase) Execute(query SurveyNPSQuery) (*SurveyNPSResult, error) {
+	s, period, err := loadSurveyAndPeriod(uc.surveyRepo, query.SurveyID, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := findQuestionOfType(s, query.QuestionID, survey.QuestionTypeRating)
+	if err != nil {
+		return nil, err
+	}
+
+	window := query.Window
+	if window == 0 {
+		window = defaultNPSWindow
+	}
+
+	samples, err := uc.analytics.RatingSamples(nil, s.SurveyID(), q.QuestionID(), period)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rating samples: %w", err)
+	}
+
+	trend, err := uc.service.NPSTrend(samples, period, window)
+	if err != nil {
+		return nil, err
+	}
+
+	var overall survey.NPSScore
+	for _, sample := range samples {
+		overall = overall.Add(sample.Rating)
+	}
+
+	result := &SurveyNPSResult{
+		Overall:    toNPSResult(overall),
+		Trend:      make([]NPSWindowResult, 0, len(trend)),
+		ByWeekday:  toBreakdownResults(uc.service.ByWeekday(samples)),
+		ByTimeSlot: toBreakdownResults(uc.service.ByTimeSlot(samples)),
+	}
+	for _, w := range trend {
+		result.Trend = append(result.Trend, NPSWindowResult{
+			Start: w.Start,
+			End:   w.End,
+			NPS:   toNPSResult(w.Score),
+		})
+	}
+
+	return result, nil
+}
+
+// toNPSResult 轉換 NPS DTO
+func toNPSResult(score survey.NPSScore) NPSResult {
+	return NPSResult{
+		Score:      score.Score(),
+		Promoters:  score.Promoters(),
+		Passives:   score.Passives(),
+		Detractors: score.Detractors(),
+		Total:      score.Total(),
+	}
+}
+
+// toBreakdownResults 轉換分組統計 DTO
+func toBreakdownResults(breakdowns []survey.Breakdown) []BreakdownResult {
+	results := make([]BreakdownResult, 0, len(breakdowns))
+	for _, b := range breakdowns {
+		results = append(results, BreakdownResult{
+			Key:           b.Key,
+			Count:         b.Count,
+			AverageRating: b.Average(),
+			NPS:           toNPSResult(b.NPS),
+		})
+	}
+	return results
+}
@@ -0,0 +1,310 @@
+package survey
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+)
+
+// ===========================
+// GetSurveyStatistics / ListTextAnswers Use Cases
+// ===========================
+
+// 文字答案分頁設定
+const (
+	defaultTextAnswerLimit = 20
+	maxTextAnswerLimit     = 100
+	textAnswerPreviewLimit = 5
+)
+
+// SurveyStatisticsQuery 問卷統計查詢（區間 [From, To)）
+type SurveyStatisticsQuery struct {
+	SurveyID string
+	From     time.Time
+	To       time.Time
+}
+
+// ChoiceCountResult 選項人數
+type ChoiceCountResult struct {
+	Option string
+	Count  int
+}
+
+// TextAnswerResult 文字答案
+type TextAnswerResult struct {
+	ResponseID  string
+	Text        string
+	SubmittedAt time.Time
+}
+
+// QuestionStatisticsResult 單題統計
+//
+// 依題型填入：
+// - rating: RatingDistribution（1-5 分）、RatingAverage
+// - multiple_choice: ChoiceCounts（依題目選項順序，含 0 人的選項）
+// - text: LatestTextAnswers（最新 5 筆，完整列表使用 ListTextAnswersUseCase）
+type QuestionStatisticsResult struct {
+	QuestionID         string
+	Text               string
+	Type               string
+	AnswerCount        int
+	RatingDistribution map[int]int
+	RatingAverage      float64
+	ChoiceCounts       []ChoiceCountResult
+	LatestTextAnswers  []TextAnswerResult
+}
+
+// SurveyStatisticsResult 問卷統計結果
+type SurveyStatisticsResult struct {
+	SurveyID      string
+	Title         string
+	From          time.Time
+	To            time.Time
+	ResponseCount int
+	Questions     []QuestionStatisticsResult
+}
+
+// GetSurveyStatisticsUseCase 問卷統計摘要 Use Case（US-006.4）
+//
+// 使用場景：
+// - 管理後台查看問卷回應統計
+type GetSurveyStatisticsUseCase struct {
+	surveyRepo survey.SurveyRepository
+	analytics  survey.SurveyAnalyticsQuery
+}
+
+// NewGetSurveyStatisticsUseCase 創建 Use Case 實例
+func NewGetSurveyStatisticsUseCase(
+	surveyRepo survey.SurveyRepository,
+	analytics survey.SurveyAnalyticsQuery,
+) *GetSurveyStatisticsUseCase {
+	return &GetSurveyStatisticsUseCase{
+		surveyRepo: surveyRepo,
+		analytics:  analytics,
+	}
+}
+
+// Execute 執行查詢
+//
+// 錯誤處理：
+// - ErrSurveyNotFound: 問卷不存在
+// - ErrInvalidAnalyticsQuery: 區間無效
+func (uc *GetSurveyStatisticsUseCase) Execute(query SurveyStatisticsQuery) (*SurveyStatisticsResult, error) {
+	s, period, err := loadSurveyAndPeriod(uc.surveyRepo, query.SurveyID, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	responseCount, err := uc.analytics.CountResponses(nil, s.SurveyID(), period)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count responses: %w", err)
+	}
+
+	result := &SurveyStatisticsResult{
+		SurveyID:      s.SurveyID().String(),
+		Title:         s.Title(),
+		From:          period.From(),
+		To:            period.To(),
+		ResponseCount: responseCount,
+		Questions:     make([]QuestionStatisticsResult, 0, len(s.Questions())),
+	}
+
+	for _, q := range s.Questions() {
+		stats, err := uc.questionStatistics(s, q, period)
+		if err != nil {
+			return nil, err
+		}
+		result.Questions = append(result.Questions, *stats)
+	}
+
+	return result, nil
+}
+
+// questionStatistics 依題型計算單題統計
+func (uc *GetSurveyStatisticsUseCase) questionStatistics(
+	s *survey.Survey,
+	q survey.Question,
+	period survey.DateRange,
+) (*QuestionStatisticsResult, error) {
+	stats := &QuestionStatisticsResult{
+		QuestionID: q.QuestionID().String(),
+		Text:       q.Text(),
+		Type:       q.Type().String(),
+	}
+
+	switch q.Type() {
+	case survey.QuestionTypeRating:
+		distribution, err := uc.analytics.RatingDistribution(nil, s.SurveyID(), q.QuestionID(), period)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query rating distribution: %w", err)
+		}
+		summary := survey.NewRatingSummary(distribution)
+		stats.AnswerCount = summary.Count()
+		stats.RatingDistribution = summary.Distribution()
+		stats.RatingAverage = summary.Average()
+
+	case survey.QuestionTypeMultipleChoice:
+		counts, err := uc.analytics.ChoiceCounts(nil, s.SurveyID(), q.QuestionID(), period)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query choice counts: %w", err)
+		}
+		stats.ChoiceCounts = make([]ChoiceCountResult, 0, len(q.Options()))
+		for _, option := range q.Options() {
+			stats.ChoiceCounts = append(stats.ChoiceCounts, ChoiceCountResult{Option: option, Count: counts[option]})
+			stats.AnswerCount += counts[option]
+		}
+
+	default:
+		entries, total, err := uc.analytics.TextAnswers(nil, s.SurveyID(), q.QuestionID(), period, textAnswerPreviewLimit, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query text answers: %w", err)
+		}
+		stats.AnswerCount = total
+		stats.LatestTextAnswers = toTextAnswerResults(entries)
+	}
+
+	return stats, nil
+}
+
+// ListTextAnswersQuery 文字答案分頁查詢
+type ListTextAnswersQuery struct {
+	SurveyID   string
+	QuestionID string
+	From       time.Time
+	To         time.Time
+	Limit      int
+	Offset     int
+}
+
+// ListTextAnswersResult 文字答案分頁結果
+type ListTextAnswersResult struct {
+	Total   int
+	Answers []TextAnswerResult
+}
+
+// ListTextAnswersUseCase 文字題答案分頁 Use Case
+type ListTextAnswersUseCase struct {
+	surveyRepo survey.SurveyRepository
+	analytics  survey.SurveyAnalyticsQuery
+}
+
+// NewListTextAnswersUseCase 創建 Use Case 實例
+func NewListTextAnswersUseCase(
+	surveyRepo survey.SurveyRepository,
+	analytics survey.SurveyAnalyticsQuery,
+) *ListTextAnswersUseCase {
+	return &ListTextAnswersUseCase{
+		surveyRepo: surveyRepo,
+		analytics:  analytics,
+	}
+}
+
+// Execute 執行查詢
+//
+// 分頁：Limit 預設 20、上限 100
+//
+// 錯誤處理：
+// - ErrInvalidAnalyticsQuery: 區間無效或題目不是文字題
+func (uc *ListTextAnswersUseCase) Execute(query ListTextAnswersQuery) (*ListTextAnswersResult, error) {
+	s, period, err := loadSurveyAndPeriod(uc.surveyRepo, query.SurveyID, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := findQuestionOfType(s, query.QuestionID, survey.QuestionTypeText)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultTextAnswerLimit
+	}
+	if limit > maxTextAnswerLimit {
+		limit = maxTextAnswerLimit
+	}
+	offset := query.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	entries, total, err := uc.analytics.TextAnswers(nil, s.SurveyID(), q.QuestionID(), period, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query text answers: %w", err)
+	}
+
+	return &ListTextAnswersResult{
+		Total:   total,
+		Answers: toTextAnswerResults(entries),
+	}, nil
+}
+
+// ===========================
+// 統計共用函數
+// ===========================
+
+// loadSurveyAndPeriod 載入問卷並建立統計區間
+func loadSurveyAndPeriod(
+	surveyRepo survey.SurveyRepository,
+	surveyIDValue string,
+	from, to time.Time,
+) (*survey.Survey, survey.DateRange, error) {
+	surveyID, err := survey.SurveyIDFromString(surveyIDValue)
+	if err != nil {
+		return nil, survey.DateRange{}, fmt.Errorf("failed to parse survey ID: %w", err)
+	}
+
+	period, err := survey.NewDateRange(from, to)
+	if err != nil {
+		return nil, survey.DateRange{}, err
+	}
+
+	s, err := surveyRepo.FindByID(nil, surveyID)
+	if err != nil {
+		return nil, survey.DateRange{}, fmt.Errorf("failed to find survey: %w", err)
+	}
+
+	return s, period, nil
+}
+
+// findQuestionOfType 查找指定題型的題目
+//
+// 錯誤：ErrUnknownQuestion（題目不屬於問卷）、ErrInvalidAnalyticsQuery（題型不符）
+func findQuestionOfType(s *survey.Survey, questionIDValue string, expected survey.QuestionType) (survey.Question, error) {
+	questionID, err := survey.QuestionIDFromString(questionIDValue)
+	if err != nil {
+		return survey.Question{}, fmt.Errorf("failed to parse question ID: %w", err)
+	}
+
+	q, ok := s.Question(questionID)
+	if !ok {
+		return survey.Question{}, survey.ErrUnknownQuestion.WithContext(
+			"survey_id", s.SurveyID().String(),
+			"question_id", questionIDValue,
+		)
+	}
+
+	if q.Type() != expected {
+		return survey.Question{}, survey.ErrInvalidAnalyticsQuery.WithContext(
+			"question_id", questionIDValue,
+			"question_type", q.Type().String(),
+			"expected_type", expected.String(),
+		)
+	}
+
+	return q, nil
+}
+
+// toTextAnswerResults 轉換文字答案 DTO
+func toTextAnswerResults(entries []survey.TextAnswerEntry) []TextAnswerResult {
+	results := make([]TextAnswerResult, 0, len(entries))
+	for _, entry := range entries {
+		results = append(results, TextAnswerResult{
+			ResponseID:  entry.ResponseID.String(),
+			Text:        entry.Text,
+			SubmittedAt: entry.SubmittedAt,
+		})
+	}
+	return results
+}
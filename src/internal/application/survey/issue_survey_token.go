@@ -0,0 +1,106 @@
+package survey
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+)
+
+// ===========================
+// IssueSurveyToken Use Case
+// ===========================
+
+// IssueSurveyTokenCommand 簽發問卷 Token 命令
+type IssueSurveyTokenCommand struct {
+	TransactionID string
+}
+
+// IssueSurveyTokenResult 簽發結果
+type IssueSurveyTokenResult struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// IssueSurveyTokenUseCase 簽發問卷 Token Use Case
+//
+// 使用場景：
+// - 發票掃描成功後，於回覆訊息附上問卷連結
+//
+// 業務規則：
+// - 必須有啟用中的問卷
+// - 失敗的交易不可填寫問卷
+// - Token 綁定簽發當下啟用中的問卷、交易與會員（會員 ID 取自交易，不信任外部輸入）
+type IssueSurveyTokenUseCase struct {
+	transactionRepo invoice.InvoiceTransactionRepository
+	surveyRepo      survey.SurveyRepository
+	tokenService    *survey.SurveyTokenService
+}
+
+// NewIssueSurveyTokenUseCase 創建 Use Case 實例
+func NewIssueSurveyTokenUseCase(
+	transactionRepo invoice.InvoiceTransactionRepository,
+	surveyRepo survey.SurveyRepository,
+	tokenService *survey.SurveyTokenService,
+) *IssueSurveyTokenUseCase {
+	return &IssueSurveyTokenUseCase{
+		transactionRepo: transactionRepo,
+		surveyRepo:      surveyRepo,
+		tokenService:    tokenService,
+	}
+}
+
+// Execute 執行簽發
+//
+// 錯誤處理：
+// - ErrNoActiveSurvey: 目前沒有啟用中的問卷
+// - ErrTransactionNotFound: 交易不存在
+// - ErrSurveyNotEligible: 交易已失敗
+func (uc *IssueSurveyTokenUseCase) Execute(cmd IssueSurveyTokenCommand) (*IssueSurveyTokenResult, error) {
+	// 1. 確認有啟用中的問卷
+	active, err := uc.surveyRepo.FindActive(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find active survey: %w", err)
+	}
+
+	// 2. 查詢交易
+	invoiceTxID, err := invoice.TransactionIDFromString(cmd.TransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	tx, err := uc.transactionRepo.FindByID(nil, invoiceTxID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction: %w", err)
+	}
+
+	if tx.Status() == invoice.TransactionStatusFailed {
+		return nil, survey.ErrSurveyNotEligible.WithContext(
+			"transaction_id", cmd.TransactionID,
+			"status", tx.Status().String(),
+		)
+	}
+
+	// 3. 轉換為問卷上下文的 ID
+	transactionID, err := survey.TransactionIDFromString(tx.TransactionID().String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	memberID, err := survey.MemberIDFromString(tx.MemberID().String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid member ID: %w", err)
+	}
+
+	// 4. 簽發 Token
+	token, err := uc.tokenService.Issue(active.SurveyID(), transactionID, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue survey token: %w", err)
+	}
+
+	return &IssueSurveyTokenResult{
+		Token:     token.Value(),
+		ExpiresAt: token.ExpiresAt(),
+	}, nil
+}
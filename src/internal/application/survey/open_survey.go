@@ -0,0 +1,90 @@
+package survey
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+)
+
+// ===========================
+// OpenSurvey Use Case
+// ===========================
+
+// OpenSurveyQuery 開啟問卷查詢
+type OpenSurveyQuery struct {
+	Token string
+}
+
+// OpenSurveyResult 開啟問卷結果
+type OpenSurveyResult struct {
+	Survey        *SurveyResult
+	TransactionID string
+	MemberID      string
+	ExpiresAt     time.Time
+}
+
+// OpenSurveyUseCase 以 Token 開啟問卷 Use Case
+//
+// 使用場景：
+// - 會員點擊問卷連結，載入問卷頁面前驗證 Token
+//
+// 業務規則：
+// - 簽章無效 → ErrInvalidSurveyToken
+// - 已過期 → ErrSurveyTokenExpired
+// - 已提交過 → ErrSurveyTokenUsed
+//
+// 注意：
+// - 本 Use Case 僅檢查，不標記 Token 已使用（提交問卷時才標記）
+// - 載入 Token 綁定的問卷（簽發後改為啟用其他問卷，仍顯示原問卷）
+type OpenSurveyUseCase struct {
+	tokenService *survey.SurveyTokenService
+	usageRepo    survey.SurveyTokenUsageRepository
+	surveyRepo   survey.SurveyRepository
+}
+
+// NewOpenSurveyUseCase 創建 Use Case 實例
+func NewOpenSurveyUseCase(
+	tokenService *survey.SurveyTokenService,
+	usageRepo survey.SurveyTokenUsageRepository,
+	surveyRepo survey.SurveyRepository,
+) *OpenSurveyUseCase {
+	return &OpenSurveyUseCase{
+		tokenService: tokenService,
+		usageRepo:    usageRepo,
+		surveyRepo:   surveyRepo,
+	}
+}
+
+// Execute 執行查詢
+func (uc *OpenSurveyUseCase) Execute(query OpenSurveyQuery) (*OpenSurveyResult, error) {
+	// 1. 驗證簽章與有效期
+	token, err := uc.tokenService.Verify(query.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 檢查是否已使用
+	used, err := uc.usageRepo.IsUsed(nil, token.TokenID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check token usage: %w", err)
+	}
+	if used {
+		return nil, survey.ErrSurveyTokenUsed.WithContext(
+			"token_id", token.TokenID().String(),
+		)
+	}
+
+	// 3. 載入 Token 綁定的問卷
+	s, err := uc.surveyRepo.FindByID(nil, token.SurveyID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find survey: %w", err)
+	}
+
+	return &OpenSurveyResult{
+		Survey:        toSurveyResult(s),
+		TransactionID: token.TransactionID().String(),
+		MemberID:      token.MemberID().String(),
+		ExpiresAt:     token.ExpiresAt(),
+	}, nil
+}
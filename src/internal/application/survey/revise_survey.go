@@ -0,0 +1,84 @@
+package survey
+
+import (
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+)
+
+// ===========================
+// ReviseSurvey Use Case
+// ===========================
+
+// ReviseSurveyCommand 修訂問卷命令
+//
+// 輸入：
+// - Questions: 完整的題目清單（依顯示順序）；保留的題目需帶入原 QuestionID
+type ReviseSurveyCommand struct {
+	SurveyID    string
+	Title       string
+	Description string
+	Questions   []QuestionInput
+}
+
+// ReviseSurveyUseCase 修訂問卷 Use Case
+//
+// 使用場景：店長於後台調整題目、順序、必填設定（不需重新部署）
+// 啟用中的問卷也可修訂，修訂後立即生效
+type ReviseSurveyUseCase struct {
+	surveyRepo survey.SurveyRepository
+	txManager  shared.TransactionManager
+}
+
+// NewReviseSurveyUseCase 創建 Use Case 實例
+func NewReviseSurveyUseCase(
+	surveyRepo survey.SurveyRepository,
+	txManager shared.TransactionManager,
+) *ReviseSurveyUseCase {
+	return &ReviseSurveyUseCase{
+		surveyRepo: surveyRepo,
+		txManager:  txManager,
+	}
+}
+
+// Execute 執行修訂問卷
+//
+// 錯誤處理：
+// - ErrSurveyNotFound: 問卷不存在
+// - ErrUnknownQuestion: 指定的 QuestionID 不屬於此問卷
+func (uc *ReviseSurveyUseCase) Execute(cmd ReviseSurveyCommand) (*SurveyResult, error) {
+	surveyID, err := survey.SurveyIDFromString(cmd.SurveyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse survey ID: %w", err)
+	}
+
+	var result *SurveyResult
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		s, err := uc.surveyRepo.FindByID(ctx, surveyID)
+		if err != nil {
+			return fmt.Errorf("failed to find survey: %w", err)
+		}
+
+		questions, err := buildQuestions(cmd.Questions, s)
+		if err != nil {
+			return err
+		}
+
+		if err := s.Revise(cmd.Title, cmd.Description, questions); err != nil {
+			return fmt.Errorf("failed to revise survey: %w", err)
+		}
+
+		if err := uc.surveyRepo.Update(ctx, s); err != nil {
+			return fmt.Errorf("failed to update survey: %w", err)
+		}
+
+		result = toSurveyResult(s)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
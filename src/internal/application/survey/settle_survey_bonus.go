@@ -0,0 +1,199 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+)
+
+// ===========================
+// SettleSurveyBonus 事件處理器
+// ===========================
+
+// SettleSurveyBonusHandler 交易驗證後結算待發放的問卷獎勵
+//
+// 訂閱事件：invoice.transaction_verified
+//
+// 業務規則（US-004）：
+// - 交易沒有問卷回覆 → 略過
+// - 獎勵已發放 → 略過（事件重送時保持冪等）
+// - 獎勵待發放 → 發放 1 點並標記 awarded
+//
+// 事件發布：提交後發布 points.earned（積分通知）
+//
+// 實現 shared.EventHandler 介面
+type SettleSurveyBonusHandler struct {
+	responseRepo survey.SurveyResponseRepository
+	crediter     *surveyBonusCrediter
+	txManager    shared.TransactionManager
+	publisher    shared.EventPublisher
+}
+
+// NewSettleSurveyBonusHandler 創建事件處理器實例
+func NewSettleSurveyBonusHandler(
+	responseRepo survey.SurveyResponseRepository,
+	accountRepo points.PointsAccountRepository,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *SettleSurveyBonusHandler {
+	return &SettleSurveyBonusHandler{
+		responseRepo: responseRepo,
+		crediter:     &surveyBonusCrediter{accountRepo: accountRepo},
+		txManager:    txManager,
+		publisher:    publisher,
+	}
+}
+
+// EventType 實現 EventHandler 介面
+func (h *SettleSurveyBonusHandler) EventType() string {
+	return "invoice.transaction_verified"
+}
+
+// Handle 實現 EventHandler 介面
+func (h *SettleSurveyBonusHandler) Handle(event shared.DomainEvent) error {
+	verified, ok := event.(*invoice.TransactionVerifiedEvent)
+	if !ok {
+		return fmt.Errorf("unexpected event type: %s", event.EventType())
+	}
+
+	transactionID, err := survey.TransactionIDFromString(verified.TransactionID().String())
+	if err != nil {
+		return fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	var account *points.PointsAccount
+	err = h.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		var err error
+		account, err = settlePendingBonus(ctx, h.responseRepo, h.crediter, transactionID)
+		return err
+	})
+	if err != nil || account == nil {
+		return err
+	}
+	return publishAccountEvents(h.publisher, account)
+}
+
+// settlePendingBonus 在調用者的事務中結算交易的待發放問卷獎勵
+//
+// 返回：入帳的帳戶（沒有回覆或已發放時返回 nil）
+func settlePendingBonus(
+	ctx shared.TransactionContext,
+	responseRepo survey.SurveyResponseRepository,
+	crediter *surveyBonusCrediter,
+	transactionID survey.TransactionID,
+) (*points.PointsAccount, error) {
+	response, err := responseRepo.FindByTransactionID(ctx, transactionID)
+	if err != nil {
+		if errors.Is(err, survey.ErrResponseNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find survey response: %w", err)
+	}
+
+	if !response.IsBonusPending() {
+		return nil, nil
+	}
+
+	account, _, err := crediter.award(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := responseRepo.Update(ctx, response); err != nil {
+		return nil, fmt.Errorf("failed to update survey response: %w", err)
+	}
+	return account, nil
+}
+
+// publishAccountEvents 提交後發布積分帳戶事件（points.earned）
+func publishAccountEvents(publisher shared.EventPublisher, account *points.PointsAccount) error {
+	if events := account.PullEvents(); len(events) > 0 {
+		if err := publisher.PublishBatch(events); err != nil {
+			return fmt.Errorf("failed to publish points events: %w", err)
+		}
+	}
+	return nil
+}
+
+// ===========================
+// SettlePendingSurveyBonuses Use Case（排程）
+// ===========================
+
+// settlePendingBatchSize 每次排程最多結算的回覆數
+const settlePendingBatchSize = 100
+
+// SettlePendingSurveyBonusesResult 補結算結果
+//
+// 欄位：
+// - Settled: 本次發放的回覆數
+type SettlePendingSurveyBonusesResult struct {
+	Settled int
+}
+
+// SettlePendingSurveyBonusesUseCase 補結算交易已驗證但獎勵仍待發放的問卷回覆（排程執行）
+//
+// 業務規則：
+// - 提交問卷時交易尚未驗證、而驗證事件在回覆寫入前已處理時，獎勵會停在 pending
+// - 排程依 PendingBonusQuery 找出此類回覆並發放 1 點（與 SettleSurveyBonusHandler 相同規則）
+// - 每筆回覆各自一個事務；已由事件結算的回覆略過（冪等）
+//
+// 錯誤處理：任一回覆失敗即停止並返回已處理的統計（下次排程重試）
+//
+// 事件發布：每筆提交後發布 points.earned
+type SettlePendingSurveyBonusesUseCase struct {
+	pending      survey.PendingBonusQuery
+	responseRepo survey.SurveyResponseRepository
+	crediter     *surveyBonusCrediter
+	txManager    shared.TransactionManager
+	publisher    shared.EventPublisher
+}
+
+// NewSettlePendingSurveyBonusesUseCase 創建 Use Case 實例
+func NewSettlePendingSurveyBonusesUseCase(
+	pending survey.PendingBonusQuery,
+	responseRepo survey.SurveyResponseRepository,
+	accountRepo points.PointsAccountRepository,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *SettlePendingSurveyBonusesUseCase {
+	return &SettlePendingSurveyBonusesUseCase{
+		pending:      pending,
+		responseRepo: responseRepo,
+		crediter:     &surveyBonusCrediter{accountRepo: accountRepo},
+		txManager:    txManager,
+		publisher:    publisher,
+	}
+}
+
+// Execute 執行補結算
+func (uc *SettlePendingSurveyBonusesUseCase) Execute() (*SettlePendingSurveyBonusesResult, error) {
+	transactionIDs, err := uc.pending.FindSettleable(nil, settlePendingBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find settleable survey bonuses: %w", err)
+	}
+
+	result := &SettlePendingSurveyBonusesResult{}
+	for _, transactionID := range transactionIDs {
+		var account *points.PointsAccount
+		err := uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+			var err error
+			account, err = settlePendingBonus(ctx, uc.responseRepo, uc.crediter, transactionID)
+			return err
+		})
+		if err != nil {
+			return result, err
+		}
+		if account == nil {
+			continue
+		}
+		result.Settled++
+		if err := publishAccountEvents(uc.publisher, account); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
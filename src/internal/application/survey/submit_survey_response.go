@@ -0,0 +1,211 @@
+package survey
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+)
+
+// ===========================
+// SubmitSurveyResponse Use Case
+// ===========================
+
+// AnswerInput 單題答案輸入
+//
+// 欄位：
+// - Text: 文字題答案或選擇題選項
+// - Rating: 評分題分數（1-5）
+type AnswerInput struct {
+	QuestionID string
+	Text       string
+	Rating     int
+}
+
+// SubmitSurveyResponseCommand 提交問卷回覆命令
+type SubmitSurveyResponseCommand struct {
+	Token   string
+	Answers []AnswerInput
+}
+
+// SubmitSurveyResponseResult 提交結果
+//
+// 輸出：
+// - BonusStatus: awarded（交易已驗證，立即入帳）/ pending（待交易驗證後入帳）
+// - PointsAwarded: 本次入帳點數（pending 時為 0）
+type SubmitSurveyResponseResult struct {
+	ResponseID    string
+	TransactionID string
+	BonusStatus   string
+	PointsAwarded int
+}
+
+// SubmitSurveyResponseUseCase 提交問卷回覆 Use Case
+//
+// 業務規則（US-004）：
+// - Token 單次使用，且每筆交易只能有一份回覆
+// - 答案依 Token 綁定的問卷驗證（與開啟問卷時顯示的題目一致）
+// - 回覆與獎勵歸屬交易目前的會員，而非 Token 簽發時的會員（會員合併後改發給保留會員）
+// - 交易已驗證 → 立即發放 1 點（EarnPoints(PointsSourceSurvey, transactionID)）
+// - 交易未驗證 → 記錄待發放獎勵，交易驗證後由 SettleSurveyBonusHandler 結算
+// - 驗證事件早於回覆寫入時（並行）由 SettlePendingSurveyBonusesUseCase 排程補結算
+//
+// 事件發布：立即發放時，提交後發布 points.earned
+type SubmitSurveyResponseUseCase struct {
+	tokenService    *survey.SurveyTokenService
+	usageRepo       survey.SurveyTokenUsageRepository
+	surveyRepo      survey.SurveyRepository
+	responseRepo    survey.SurveyResponseRepository
+	transactionRepo invoice.InvoiceTransactionRepository
+	crediter        *surveyBonusCrediter
+	txManager       shared.TransactionManager
+	publisher       shared.EventPublisher
+}
+
+// NewSubmitSurveyResponseUseCase 創建 Use Case 實例
+func NewSubmitSurveyResponseUseCase(
+	tokenService *survey.SurveyTokenService,
+	usageRepo survey.SurveyTokenUsageRepository,
+	surveyRepo survey.SurveyRepository,
+	responseRepo survey.SurveyResponseRepository,
+	transactionRepo invoice.InvoiceTransactionRepository,
+	accountRepo points.PointsAccountRepository,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *SubmitSurveyResponseUseCase {
+	return &SubmitSurveyResponseUseCase{
+		tokenService:    tokenService,
+		usageRepo:       usageRepo,
+		surveyRepo:      surveyRepo,
+		responseRepo:    responseRepo,
+		transactionRepo: transactionRepo,
+		crediter:        &surveyBonusCrediter{accountRepo: accountRepo},
+		txManager:       txManager,
+		publisher:       publisher,
+	}
+}
+
+// Execute 執行提交
+//
+// 錯誤處理：
+// - ErrInvalidSurveyToken / ErrSurveyTokenExpired / ErrSurveyTokenUsed: Token 無效
+// - ErrResponseAlreadySubmitted: 此交易已有回覆
+// - 答案驗證錯誤（ErrMissingRequiredAnswer 等）
+func (uc *SubmitSurveyResponseUseCase) Execute(cmd SubmitSurveyResponseCommand) (*SubmitSurveyResponseResult, error) {
+	// 1. 驗證 Token（簽章、有效期）
+	token, err := uc.tokenService.Verify(cmd.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceTxID, err := invoice.TransactionIDFromString(token.TransactionID().String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction ID: %w", err)
+	}
+
+	var result *SubmitSurveyResponseResult
+	var account *points.PointsAccount
+	err = uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		// 2. 標記 Token 已使用（重複提交 → ErrSurveyTokenUsed）
+		if err := uc.usageRepo.MarkUsed(ctx, token.TokenID(), token.TransactionID(), time.Now()); err != nil {
+			return err
+		}
+
+		// 3. 依 Token 綁定的問卷驗證答案並建立回覆
+		s, err := uc.surveyRepo.FindByID(ctx, token.SurveyID())
+		if err != nil {
+			return fmt.Errorf("failed to find survey: %w", err)
+		}
+
+		answers, err := buildAnswers(s, cmd.Answers)
+		if err != nil {
+			return err
+		}
+
+		// 4. 回覆歸屬交易目前的會員（Token 簽發後會員可能已被合併，交易已改歸屬保留會員）
+		tx, err := uc.transactionRepo.FindByID(ctx, invoiceTxID)
+		if err != nil {
+			return fmt.Errorf("failed to find transaction: %w", err)
+		}
+		memberID, err := survey.MemberIDFromString(tx.MemberID().String())
+		if err != nil {
+			return fmt.Errorf("invalid member ID: %w", err)
+		}
+
+		response, err := survey.SubmitSurveyResponse(s, token.TransactionID(), memberID, answers)
+		if err != nil {
+			return err
+		}
+
+		// 5. 交易已驗證 → 立即發放獎勵
+		pointsAwarded := 0
+		if tx.IsVerified() {
+			account, pointsAwarded, err = uc.crediter.award(ctx, response)
+			if err != nil {
+				return err
+			}
+		}
+
+		// 6. 保存回覆（同一交易重複提交 → ErrResponseAlreadySubmitted）
+		if err := uc.responseRepo.Save(ctx, response); err != nil {
+			return fmt.Errorf("failed to save survey response: %w", err)
+		}
+
+		result = &SubmitSurveyResponseResult{
+			ResponseID:    response.ResponseID().String(),
+			TransactionID: response.TransactionID().String(),
+			BonusStatus:   response.BonusStatus().String(),
+			PointsAwarded: pointsAwarded,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if account != nil {
+		if err := publishAccountEvents(uc.publisher, account); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// buildAnswers 依題型將輸入轉換為 Domain 答案
+//
+// 錯誤：ErrUnknownQuestion（題目不屬於此問卷）
+func buildAnswers(s *survey.Survey, inputs []AnswerInput) ([]survey.Answer, error) {
+	answers := make([]survey.Answer, 0, len(inputs))
+	for _, input := range inputs {
+		questionID, err := survey.QuestionIDFromString(input.QuestionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse question ID: %w", err)
+		}
+
+		q, ok := s.Question(questionID)
+		if !ok {
+			return nil, survey.ErrUnknownQuestion.WithContext(
+				"survey_id", s.SurveyID().String(),
+				"question_id", input.QuestionID,
+			)
+		}
+
+		var answer survey.Answer
+		switch q.Type() {
+		case survey.QuestionTypeRating:
+			answer, err = survey.NewRatingAnswer(questionID, input.Rating)
+		case survey.QuestionTypeMultipleChoice:
+			answer, err = survey.NewChoiceAnswer(questionID, input.Text)
+		default:
+			answer, err = survey.NewTextAnswer(questionID, input.Text)
+		}
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, answer)
+	}
+	return answers, nil
+}
@@ -0,0 +1,465 @@
+package survey
+
+import (
+	"testing"
+	"time"
+
+	appexternal "github.com/jackyeh168/bar_crm/src/internal/application/external"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/external"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/jackyeh168/bar_crm/src/internal/infrastructure/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// SubmitSurveyResponse / SettleSurveyBonus 測試
+// ===========================
+
+// submitFixture 問卷提交測試夾具
+type submitFixture struct {
+	*tokenFixture
+	responseRepo *MockSurveyResponseRepository
+	accountRepo  *MockPointsAccountRepository
+	txManager    *MockTransactionManager
+	publisher    *FakeEventPublisher
+}
+
+func newSubmitFixture(t *testing.T) *submitFixture {
+	f := &submitFixture{
+		tokenFixture: newTokenFixture(t),
+		responseRepo: NewMockSurveyResponseRepository(),
+		accountRepo:  NewMockPointsAccountRepository(),
+		txManager:    NewMockTransactionManager(),
+		publisher:    &FakeEventPublisher{},
+	}
+	f.givenActiveSurvey(t)
+	return f
+}
+
+// givenTransactionWithAccount 建立交易與會員積分帳戶，並簽發問卷 Token
+func (f *submitFixture) givenTransactionWithAccount(t *testing.T) (*invoice.InvoiceTransaction, string) {
+	tx := f.givenTransaction(t)
+	tx.PullEvents()
+
+	memberID, err := points.MemberIDFromString(tx.MemberID().String())
+	require.NoError(t, err)
+	account, err := points.NewPointsAccount(memberID)
+	require.NoError(t, err)
+	account.PullEvents()
+	require.NoError(t, f.accountRepo.Save(nil, account))
+
+	issued, err := NewIssueSurveyTokenUseCase(f.transactionRepo, f.surveyRepo, f.tokenService).
+		Execute(IssueSurveyTokenCommand{TransactionID: tx.TransactionID().String()})
+	require.NoError(t, err)
+	return tx, issued.Token
+}
+
+// validAnswerInputs 依啟用中問卷產生完整答案（評分 5 分、選擇「會」）
+func (f *submitFixture) validAnswerInputs(t *testing.T) []AnswerInput {
+	s, err := f.surveyRepo.FindActive(nil)
+	require.NoError(t, err)
+	questions := s.Questions()
+	return []AnswerInput{
+		{QuestionID: questions[0].QuestionID().String(), Rating: 5},
+		{QuestionID: questions[1].QuestionID().String(), Text: "會"},
+	}
+}
+
+func (f *submitFixture) submitUseCase() *SubmitSurveyResponseUseCase {
+	return NewSubmitSurveyResponseUseCase(
+		f.tokenService,
+		f.usageRepo,
+		f.surveyRepo,
+		f.responseRepo,
+		f.transactionRepo,
+		f.accountRepo,
+		f.txManager,
+		f.publisher,
+	)
+}
+
+// availablePoints 查詢交易會員的可用積分
+func (f *submitFixture) availablePoints(t *testing.T, tx *invoice.InvoiceTransaction) int {
+	memberID, err := points.MemberIDFromString(tx.MemberID().String())
+	require.NoError(t, err)
+	account, err := f.accountRepo.FindByMemberID(nil, memberID)
+	require.NoError(t, err)
+	return account.GetAvailablePoints().Value()
+}
+
+// Test 12: 交易已驗證 → 立即發放 1 點
+func TestSubmitSurveyResponseUseCase_VerifiedTransaction_AwardsImmediately(t *testing.T) {
+	// Arrange
+	f := newSubmitFixture(t)
+	tx, token := f.givenTransactionWithAccount(t)
+	require.NoError(t, tx.Verify("ichef_exact_match"))
+
+	// Act
+	result, err := f.submitUseCase().Execute(SubmitSurveyResponseCommand{
+		Token:   token,
+		Answers: f.validAnswerInputs(t),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "awarded", result.BonusStatus)
+	assert.Equal(t, 1, result.PointsAwarded)
+	assert.Equal(t, 1, f.availablePoints(t, tx))
+	assert.Equal(t, 1, f.txManager.InTransactionCallCount)
+	require.Len(t, f.publisher.events, 1)
+	assert.Equal(t, "points.earned", f.publisher.events[0].EventType())
+}
+
+// Test 13: 交易未驗證 → 記錄待發放，交易驗證事件觸發後結算
+func TestSubmitSurveyResponseUseCase_PendingBonus_SettledOnVerification(t *testing.T) {
+	// Arrange
+	f := newSubmitFixture(t)
+	tx, token := f.givenTransactionWithAccount(t)
+	result, err := f.submitUseCase().Execute(SubmitSurveyResponseCommand{
+		Token:   token,
+		Answers: f.validAnswerInputs(t),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "pending", result.BonusStatus)
+	require.Equal(t, 0, f.availablePoints(t, tx))
+	require.Empty(t, f.publisher.events)
+
+	require.NoError(t, tx.Verify("ichef_exact_match"))
+	events := tx.PullEvents()
+	require.Len(t, events, 1)
+	handler := NewSettleSurveyBonusHandler(f.responseRepo, f.accountRepo, f.txManager, f.publisher)
+
+	// Act
+	err = handler.Handle(events[0])
+	require.NoError(t, err)
+	// 事件重送時不重複發放
+	err = handler.Handle(events[0])
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "invoice.transaction_verified", handler.EventType())
+	assert.Equal(t, 1, f.availablePoints(t, tx))
+	require.Len(t, f.publisher.events, 1)
+	assert.Equal(t, "points.earned", f.publisher.events[0].EventType())
+	transactionID, err := survey.TransactionIDFromString(tx.TransactionID().String())
+	require.NoError(t, err)
+	response, err := f.responseRepo.FindByTransactionID(nil, transactionID)
+	require.NoError(t, err)
+	assert.Equal(t, survey.BonusStatusAwarded, response.BonusStatus())
+}
+
+// Test 14: 同一交易不可重複填寫（即使換了新的 Token）
+func TestSubmitSurveyResponseUseCase_DuplicateTransaction_ReturnsError(t *testing.T) {
+	// Arrange
+	f := newSubmitFixture(t)
+	tx, token := f.givenTransactionWithAccount(t)
+	_, err := f.submitUseCase().Execute(SubmitSurveyResponseCommand{Token: token, Answers: f.validAnswerInputs(t)})
+	require.NoError(t, err)
+
+	reissued, err := NewIssueSurveyTokenUseCase(f.transactionRepo, f.surveyRepo, f.tokenService).
+		Execute(IssueSurveyTokenCommand{TransactionID: tx.TransactionID().String()})
+	require.NoError(t, err)
+
+	// Act
+	_, sameTokenErr := f.submitUseCase().Execute(SubmitSurveyResponseCommand{Token: token, Answers: f.validAnswerInputs(t)})
+	_, newTokenErr := f.submitUseCase().Execute(SubmitSurveyResponseCommand{Token: reissued.Token, Answers: f.validAnswerInputs(t)})
+
+	// Assert
+	assert.ErrorIs(t, sameTokenErr, survey.ErrSurveyTokenUsed)
+	assert.ErrorIs(t, newTokenErr, survey.ErrResponseAlreadySubmitted)
+	assert.Len(t, f.responseRepo.responses, 1)
+}
+
+// Test 15: 缺少必填題目時拒絕提交
+func TestSubmitSurveyResponseUseCase_MissingRequired_ReturnsError(t *testing.T) {
+	// Arrange
+	f := newSubmitFixture(t)
+	_, token := f.givenTransactionWithAccount(t)
+
+	// Act
+	_, err := f.submitUseCase().Execute(SubmitSurveyResponseCommand{
+		Token:   token,
+		Answers: f.validAnswerInputs(t)[:1],
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, survey.ErrMissingRequiredAnswer)
+	assert.Empty(t, f.responseRepo.responses)
+}
+
+// Test 21: iChef 匹配驗證交易 → 事件匯流排 → 結算待發放的問卷獎勵
+func TestSettleSurveyBonusHandler_SubscribedToVerification_SettlesThroughEventBus(t *testing.T) {
+	// Arrange
+	f := newSubmitFixture(t)
+	tx, token := f.givenTransactionWithAccount(t)
+	result, err := f.submitUseCase().Execute(SubmitSurveyResponseCommand{
+		Token:   token,
+		Answers: f.validAnswerInputs(t),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "pending", result.BonusStatus)
+
+	eventBus := messaging.NewInMemoryEventBus()
+	handler := NewSettleSurveyBonusHandler(f.responseRepo, f.accountRepo, f.txManager, eventBus)
+	require.NoError(t, eventBus.SubscribeFunc(handler.Handle, "invoice.transaction_verified"))
+	rate, err := points.NewConversionRate(100)
+	require.NoError(t, err)
+	// 完全匹配不會開立差異審核案件（只查詢待處理案件以便結案）
+	match := appexternal.NewMatchIChefRecordUseCase(
+		f.transactionRepo, StubNoPendingReviews{}, f.accountRepo, external.DefaultMatchingPolicy(),
+		rate, StubBaseEarningMultiplierQuery{}, f.txManager, eventBus,
+	)
+
+	// Act
+	matched, err := match.Execute(appexternal.IChefRecord{
+		InvoiceNumber: tx.InvoiceNumber().String(),
+		InvoiceDate:   tx.InvoiceDate(),
+		Amount:        tx.GetAmount(),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "matched", matched.Outcome)
+	assert.Equal(t, matched.PointsEarned+survey.SurveyBonusPoints, f.availablePoints(t, tx))
+	transactionID, err := survey.TransactionIDFromString(tx.TransactionID().String())
+	require.NoError(t, err)
+	response, err := f.responseRepo.FindByTransactionID(nil, transactionID)
+	require.NoError(t, err)
+	assert.Equal(t, survey.BonusStatusAwarded, response.BonusStatus())
+}
+
+// Test 22: 驗證事件早於回覆寫入（獎勵停在 pending）→ 補結算排程發放，重跑不重複發放
+func TestSettlePendingSurveyBonusesUseCase_SettlesMissedVerification(t *testing.T) {
+	// Arrange
+	f := newSubmitFixture(t)
+	tx, token := f.givenTransactionWithAccount(t)
+	result, err := f.submitUseCase().Execute(SubmitSurveyResponseCommand{
+		Token:   token,
+		Answers: f.validAnswerInputs(t),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "pending", result.BonusStatus)
+	require.NoError(t, tx.Verify("ichef_exact_match"))
+	tx.PullEvents() // 事件已在回覆寫入前處理完畢
+
+	transactionID, err := survey.TransactionIDFromString(tx.TransactionID().String())
+	require.NoError(t, err)
+	pending := &StubPendingBonusQuery{transactionIDs: []survey.TransactionID{transactionID}}
+	useCase := NewSettlePendingSurveyBonusesUseCase(pending, f.responseRepo, f.accountRepo, f.txManager, f.publisher)
+
+	// Act
+	first, err := useCase.Execute()
+	require.NoError(t, err)
+	second, err := useCase.Execute()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.Settled)
+	assert.Equal(t, 0, second.Settled)
+	assert.Equal(t, 1, f.availablePoints(t, tx))
+	require.Len(t, f.publisher.events, 1)
+	assert.Equal(t, "points.earned", f.publisher.events[0].EventType())
+	response, err := f.responseRepo.FindByTransactionID(nil, transactionID)
+	require.NoError(t, err)
+	assert.Equal(t, survey.BonusStatusAwarded, response.BonusStatus())
+}
+
+// Test 23: 簽發後切換啟用問卷 → 仍依 Token 綁定的原問卷驗證答案
+func TestSubmitSurveyResponseUseCase_ActiveSurveyChanged_UsesIssuedSurvey(t *testing.T) {
+	// Arrange
+	f := newSubmitFixture(t)
+	tx, token := f.givenTransactionWithAccount(t)
+	issued, err := f.surveyRepo.FindActive(nil)
+	require.NoError(t, err)
+	answers := f.validAnswerInputs(t)
+	f.givenActiveSurvey(t)
+
+	// Act
+	_, err = f.submitUseCase().Execute(SubmitSurveyResponseCommand{
+		Token:   token,
+		Answers: answers,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	transactionID, err := survey.TransactionIDFromString(tx.TransactionID().String())
+	require.NoError(t, err)
+	response, err := f.responseRepo.FindByTransactionID(nil, transactionID)
+	require.NoError(t, err)
+	assert.Equal(t, issued.SurveyID(), response.SurveyID())
+}
+
+// Test 24: 簽發 Token 後會員被合併 → 回覆與獎勵歸屬保留會員，不發給已停用的會員
+func TestSubmitSurveyResponseUseCase_MemberMergedAfterIssue_CreditsSurvivor(t *testing.T) {
+	// Arrange
+	f := newSubmitFixture(t)
+	tx, token := f.givenTransactionWithAccount(t)
+	require.NoError(t, tx.Verify("ichef_exact_match"))
+	secondaryID, err := points.MemberIDFromString(tx.MemberID().String())
+	require.NoError(t, err)
+	secondary, err := f.accountRepo.FindByMemberID(nil, secondaryID)
+	require.NoError(t, err)
+	primaryID := points.NewMemberID()
+	primary, err := points.NewPointsAccount(primaryID)
+	require.NoError(t, err)
+	require.NoError(t, f.accountRepo.Save(nil, primary))
+
+	// 合併：帳戶併入保留會員，交易改歸屬保留會員（同 MergeMembersUseCase / TransactionReassigner）
+	require.NoError(t, primary.AbsorbAccount(secondary, "merge-1", time.Now()))
+	txMemberID, err := invoice.MemberIDFromString(primaryID.String())
+	require.NoError(t, err)
+	merged, err := invoice.ReconstructInvoiceTransaction(
+		tx.TransactionID(), txMemberID, tx.InvoiceNumber(), tx.InvoiceDate(), tx.Amount(),
+		tx.Status(), tx.StatusReason(), tx.VerifiedAt(), tx.CreatedAt(), tx.UpdatedAt(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, f.transactionRepo.Update(nil, merged))
+
+	// Act
+	result, err := f.submitUseCase().Execute(SubmitSurveyResponseCommand{
+		Token:   token,
+		Answers: f.validAnswerInputs(t),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "awarded", result.BonusStatus)
+	assert.Equal(t, 1, f.availablePoints(t, merged))
+	assert.Equal(t, 0, f.availablePoints(t, tx))
+	transactionID, err := survey.TransactionIDFromString(tx.TransactionID().String())
+	require.NoError(t, err)
+	response, err := f.responseRepo.FindByTransactionID(nil, transactionID)
+	require.NoError(t, err)
+	assert.Equal(t, primaryID.String(), response.MemberID().String())
+}
+
+// ===========================
+// Mock Implementations
+// ===========================
+
+// StubPendingBonusQuery 返回固定的交易 ID
+type StubPendingBonusQuery struct {
+	transactionIDs []survey.TransactionID
+}
+
+func (s *StubPendingBonusQuery) FindSettleable(ctx shared.TransactionContext, limit int) ([]survey.TransactionID, error) {
+	return s.transactionIDs, nil
+}
+
+// StubBaseEarningMultiplierQuery 所有會員皆為基本倍率
+type StubBaseEarningMultiplierQuery struct{}
+
+func (StubBaseEarningMultiplierQuery) MultiplierFor(ctx shared.TransactionContext, memberID points.MemberID) (points.EarningMultiplier, error) {
+	return points.BaseEarningMultiplier(), nil
+}
+
+type MockSurveyResponseRepository struct {
+	responses map[string]*survey.SurveyResponse
+}
+
+func NewMockSurveyResponseRepository() *MockSurveyResponseRepository {
+	return &MockSurveyResponseRepository{
+		responses: make(map[string]*survey.SurveyResponse),
+	}
+}
+
+func (m *MockSurveyResponseRepository) Save(ctx shared.TransactionContext, r *survey.SurveyResponse) error {
+	if _, exists := m.responses[r.TransactionID().String()]; exists {
+		return survey.ErrResponseAlreadySubmitted
+	}
+	m.responses[r.TransactionID().String()] = r
+	return nil
+}
+
+func (m *MockSurveyResponseRepository) Update(ctx shared.TransactionContext, r *survey.SurveyResponse) error {
+	if _, exists := m.responses[r.TransactionID().String()]; !exists {
+		return survey.ErrResponseNotFound
+	}
+	m.responses[r.TransactionID().String()] = r
+	return nil
+}
+
+func (m *MockSurveyResponseRepository) FindByTransactionID(ctx shared.TransactionContext, transactionID survey.TransactionID) (*survey.SurveyResponse, error) {
+	if r, exists := m.responses[transactionID.String()]; exists {
+		return r, nil
+	}
+	return nil, survey.ErrResponseNotFound
+}
+
+type MockPointsAccountRepository struct {
+	accounts map[string]*points.PointsAccount
+}
+
+func NewMockPointsAccountRepository() *MockPointsAccountRepository {
+	return &MockPointsAccountRepository{
+		accounts: make(map[string]*points.PointsAccount),
+	}
+}
+
+func (m *MockPointsAccountRepository) Save(ctx shared.TransactionContext, account *points.PointsAccount) error {
+	m.accounts[account.MemberID().String()] = account
+	return nil
+}
+
+func (m *MockPointsAccountRepository) FindByID(ctx shared.TransactionContext, accountID points.AccountID) (*points.PointsAccount, error) {
+	for _, account := range m.accounts {
+		if account.AccountID().Equals(accountID) {
+			return account, nil
+		}
+	}
+	return nil, points.ErrAccountNotFound
+}
+
+func (m *MockPointsAccountRepository) FindByMemberID(ctx shared.TransactionContext, memberID points.MemberID) (*points.PointsAccount, error) {
+	if account, exists := m.accounts[memberID.String()]; exists {
+		return account, nil
+	}
+	return nil, points.ErrAccountNotFound
+}
+
+func (m *MockPointsAccountRepository) Update(ctx shared.TransactionContext, account *points.PointsAccount) error {
+	m.accounts[account.MemberID().String()] = account
+	return nil
+}
+
+// FakeEventPublisher 記錄發布的事件
+type FakeEventPublisher struct {
+	events []shared.DomainEvent
+}
+
+func (p *FakeEventPublisher) Publish(event shared.DomainEvent) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *FakeEventPublisher) PublishBatch(events []shared.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+// StubNoPendingReviews 沒有任何差異審核案件
+type StubNoPendingReviews struct{}
+
+func (StubNoPendingReviews) Save(ctx shared.TransactionContext, review *external.DiscrepancyReview) error {
+	return nil
+}
+
+func (StubNoPendingReviews) Update(ctx shared.TransactionContext, review *external.DiscrepancyReview) error {
+	return external.ErrReviewNotFound
+}
+
+func (StubNoPendingReviews) FindByID(ctx shared.TransactionContext, id external.ReviewID) (*external.DiscrepancyReview, error) {
+	return nil, external.ErrReviewNotFound
+}
+
+func (StubNoPendingReviews) FindPendingByTransactionID(ctx shared.TransactionContext, transactionID external.TransactionID) (*external.DiscrepancyReview, error) {
+	return nil, external.ErrReviewNotFound
+}
+
+func (StubNoPendingReviews) FindPending(ctx shared.TransactionContext, limit, offset int) ([]*external.DiscrepancyReview, error) {
+	return nil, nil
+}
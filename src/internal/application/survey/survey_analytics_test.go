@@ -0,0 +1,182 @@
+package survey
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// 問卷統計 Use Case 測試
+// ===========================
+
+// analyticsFrom 統計區間起點（2025-03-03 週一 00:00 台北時間）
+var analyticsFrom = time.Date(2025, 3, 3, 0, 0, 0, 0, shared.BusinessLocation)
+
+// givenAnalyticsSurvey 建立問卷並返回聚合（評分、選擇題）
+func givenAnalyticsSurvey(t *testing.T, repo *MockSurveyRepository) *survey.Survey {
+	id := givenSurvey(t, repo)
+	surveyID, err := survey.SurveyIDFromString(id)
+	require.NoError(t, err)
+	s, err := repo.FindByID(nil, surveyID)
+	require.NoError(t, err)
+	return s
+}
+
+// Test 16: 統計摘要補齊評分分佈並依選項順序輸出（含 0 人選項）
+func TestGetSurveyStatisticsUseCase_Success(t *testing.T) {
+	// Arrange
+	repo := NewMockSurveyRepository()
+	s := givenAnalyticsSurvey(t, repo)
+	questions := s.Questions()
+	stub := NewStubSurveyAnalyticsQuery()
+	stub.responseCount = 4
+	stub.distributions[questions[0].QuestionID().String()] = map[int]int{5: 3, 1: 1}
+	stub.choices[questions[1].QuestionID().String()] = map[string]int{"會": 4}
+
+	// Act
+	result, err := NewGetSurveyStatisticsUseCase(repo, stub).Execute(SurveyStatisticsQuery{
+		SurveyID: s.SurveyID().String(),
+		From:     analyticsFrom,
+		To:       analyticsFrom.Add(7 * 24 * time.Hour),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.ResponseCount)
+	require.Len(t, result.Questions, 2)
+	assert.InDelta(t, 4.0, result.Questions[0].RatingAverage, 0.0001)
+	assert.Equal(t, 0, result.Questions[0].RatingDistribution[3])
+	assert.Equal(t, []ChoiceCountResult{{Option: "會", Count: 4}, {Option: "不會", Count: 0}}, result.Questions[1].ChoiceCounts)
+}
+
+// Test 17: NPS 分析（整體、趨勢、星期分組），非評分題拒絕
+func TestGetSurveyNPSUseCase_Success(t *testing.T) {
+	// Arrange
+	repo := NewMockSurveyRepository()
+	s := givenAnalyticsSurvey(t, repo)
+	questions := s.Questions()
+	stub := NewStubSurveyAnalyticsQuery()
+	stub.samples = []survey.RatingSample{
+		{SubmittedAt: analyticsFrom.Add(20 * time.Hour), Rating: 5},
+		{SubmittedAt: analyticsFrom.Add(22 * time.Hour), Rating: 4},
+		{SubmittedAt: analyticsFrom.Add(8*24*time.Hour + 20*time.Hour), Rating: 2},
+	}
+	useCase := NewGetSurveyNPSUseCase(repo, stub)
+	query := SurveyNPSQuery{
+		SurveyID:   s.SurveyID().String(),
+		QuestionID: questions[0].QuestionID().String(),
+		From:       analyticsFrom,
+		To:         analyticsFrom.Add(14 * 24 * time.Hour),
+	}
+
+	// Act
+	result, err := useCase.Execute(query)
+	query.QuestionID = questions[1].QuestionID().String()
+	_, choiceErr := useCase.Execute(query)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Overall.Total)
+	assert.InDelta(t, 0.0, result.Overall.Score, 0.0001)
+	require.Len(t, result.Trend, 2)
+	assert.Equal(t, 50.0, result.Trend[0].NPS.Score)
+	assert.Equal(t, -100.0, result.Trend[1].NPS.Score)
+	assert.Equal(t, "Monday", result.ByWeekday[0].Key)
+	assert.Equal(t, 2, result.ByWeekday[0].Count)
+	assert.ErrorIs(t, choiceErr, survey.ErrInvalidAnalyticsQuery)
+}
+
+// Test 18: CSV 匯出依題目順序輸出答案，未作答留空
+func TestExportSurveyResponsesUseCase_WritesCSV(t *testing.T) {
+	// Arrange
+	repo := NewMockSurveyRepository()
+	s := givenAnalyticsSurvey(t, repo)
+	questions := s.Questions()
+	rating, err := survey.NewRatingAnswer(questions[0].QuestionID(), 4)
+	require.NoError(t, err)
+	stub := NewStubSurveyAnalyticsQuery()
+	stub.records = []survey.ResponseRecord{{
+		ResponseID:    survey.NewResponseID(),
+		TransactionID: shared.NewEntityID[survey.TransactionMarker](),
+		MemberID:      shared.NewEntityID[survey.MemberMarker](),
+		BonusStatus:   survey.BonusStatusAwarded,
+		SubmittedAt:   time.Date(2025, 3, 3, 13, 30, 0, 0, time.UTC),
+		Answers:       []survey.Answer{rating},
+	}}
+	var buf bytes.Buffer
+
+	// Act
+	err = NewExportSurveyResponsesUseCase(repo, stub).Execute(ExportSurveyResponsesQuery{
+		SurveyID: s.SurveyID().String(),
+		From:     analyticsFrom,
+		To:       analyticsFrom.Add(24 * time.Hour),
+	}, &buf)
+
+	// Assert
+	require.NoError(t, err)
+	rows, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(buf.String(), utf8BOM))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, questions[0].Text(), rows[0][5])
+	assert.Equal(t, "2025-03-03 21:30:00", rows[1][3])
+	assert.Equal(t, "awarded", rows[1][4])
+	assert.Equal(t, "4", rows[1][5])
+	assert.Equal(t, "", rows[1][6])
+}
+
+// ===========================
+// Stub SurveyAnalyticsQuery
+// ===========================
+
+type StubSurveyAnalyticsQuery struct {
+	responseCount int
+	distributions map[string]map[int]int
+	choices       map[string]map[string]int
+	texts         []survey.TextAnswerEntry
+	samples       []survey.RatingSample
+	records       []survey.ResponseRecord
+}
+
+func NewStubSurveyAnalyticsQuery() *StubSurveyAnalyticsQuery {
+	return &StubSurveyAnalyticsQuery{
+		distributions: make(map[string]map[int]int),
+		choices:       make(map[string]map[string]int),
+	}
+}
+
+func (s *StubSurveyAnalyticsQuery) CountResponses(ctx shared.TransactionContext, surveyID survey.SurveyID, period survey.DateRange) (int, error) {
+	return s.responseCount, nil
+}
+
+func (s *StubSurveyAnalyticsQuery) RatingDistribution(ctx shared.TransactionContext, surveyID survey.SurveyID, questionID survey.QuestionID, period survey.DateRange) (map[int]int, error) {
+	return s.distributions[questionID.String()], nil
+}
+
+func (s *StubSurveyAnalyticsQuery) ChoiceCounts(ctx shared.TransactionContext, surveyID survey.SurveyID, questionID survey.QuestionID, period survey.DateRange) (map[string]int, error) {
+	return s.choices[questionID.String()], nil
+}
+
+func (s *StubSurveyAnalyticsQuery) TextAnswers(ctx shared.TransactionContext, surveyID survey.SurveyID, questionID survey.QuestionID, period survey.DateRange, limit, offset int) ([]survey.TextAnswerEntry, int, error) {
+	return s.texts, len(s.texts), nil
+}
+
+func (s *StubSurveyAnalyticsQuery) RatingSamples(ctx shared.TransactionContext, surveyID survey.SurveyID, questionID survey.QuestionID, period survey.DateRange) ([]survey.RatingSample, error) {
+	return s.samples, nil
+}
+
+func (s *StubSurveyAnalyticsQuery) StreamResponses(ctx shared.TransactionContext, surveyID survey.SurveyID, period survey.DateRange, fn func(survey.ResponseRecord) error) error {
+	for _, record := range s.records {
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package survey
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// IssueSurveyToken / OpenSurvey Use Case 測試
+// ===========================
+
+// tokenFixture 問卷 Token 測試夾具
+type tokenFixture struct {
+	surveyRepo      *MockSurveyRepository
+	transactionRepo *MockInvoiceTransactionRepository
+	usageRepo       *MockSurveyTokenUsageRepository
+	tokenService    *survey.SurveyTokenService
+}
+
+func newTokenFixture(t *testing.T) *tokenFixture {
+	key, err := survey.NewSigningKey("k1", []byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+	ring, err := survey.NewSigningKeyRing(key)
+	require.NoError(t, err)
+	service, err := survey.NewSurveyTokenService(ring, survey.DefaultSurveyTokenTTL)
+	require.NoError(t, err)
+
+	return &tokenFixture{
+		surveyRepo:      NewMockSurveyRepository(),
+		transactionRepo: NewMockInvoiceTransactionRepository(),
+		usageRepo:       NewMockSurveyTokenUsageRepository(),
+		tokenService:    service,
+	}
+}
+
+// givenActiveSurvey 建立並啟用問卷
+func (f *tokenFixture) givenActiveSurvey(t *testing.T) {
+	id := givenSurvey(t, f.surveyRepo)
+	_, err := NewActivateSurveyUseCase(f.surveyRepo, NewMockTransactionManager()).Execute(ActivateSurveyCommand{SurveyID: id})
+	require.NoError(t, err)
+}
+
+// givenTransaction 建立發票交易
+func (f *tokenFixture) givenTransaction(t *testing.T) *invoice.InvoiceTransaction {
+	memberID := shared.NewEntityID[invoice.MemberMarker]()
+	number, _ := invoice.NewInvoiceNumber("AB12345678")
+	money, _ := invoice.NewMoney(350)
+	tx, err := invoice.NewInvoiceTransaction(memberID, number, time.Now(), money)
+	require.NoError(t, err)
+	require.NoError(t, f.transactionRepo.Save(nil, tx))
+	return tx
+}
+
+// Test 8: 簽發後可開啟問卷，並取得交易與會員
+func TestOpenSurveyUseCase_ValidToken_ReturnsSurvey(t *testing.T) {
+	// Arrange
+	f := newTokenFixture(t)
+	f.givenActiveSurvey(t)
+	tx := f.givenTransaction(t)
+	issued, err := NewIssueSurveyTokenUseCase(f.transactionRepo, f.surveyRepo, f.tokenService).
+		Execute(IssueSurveyTokenCommand{TransactionID: tx.TransactionID().String()})
+	require.NoError(t, err)
+
+	// Act
+	result, err := NewOpenSurveyUseCase(f.tokenService, f.usageRepo, f.surveyRepo).
+		Execute(OpenSurveyQuery{Token: issued.Token})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, tx.TransactionID().String(), result.TransactionID)
+	assert.Equal(t, tx.MemberID().String(), result.MemberID)
+	assert.True(t, result.Survey.IsActive)
+}
+
+// Test 9: 失敗的交易不可簽發問卷 Token
+func TestIssueSurveyTokenUseCase_FailedTransaction_ReturnsError(t *testing.T) {
+	// Arrange
+	f := newTokenFixture(t)
+	f.givenActiveSurvey(t)
+	tx := f.givenTransaction(t)
+	require.NoError(t, tx.Fail("invoice voided"))
+
+	// Act
+	_, err := NewIssueSurveyTokenUseCase(f.transactionRepo, f.surveyRepo, f.tokenService).
+		Execute(IssueSurveyTokenCommand{TransactionID: tx.TransactionID().String()})
+
+	// Assert
+	assert.ErrorIs(t, err, survey.ErrSurveyNotEligible)
+}
+
+// Test 10: 已使用的 Token 不可再次開啟
+func TestOpenSurveyUseCase_UsedToken_ReturnsError(t *testing.T) {
+	// Arrange
+	f := newTokenFixture(t)
+	f.givenActiveSurvey(t)
+	tx := f.givenTransaction(t)
+	issued, err := NewIssueSurveyTokenUseCase(f.transactionRepo, f.surveyRepo, f.tokenService).
+		Execute(IssueSurveyTokenCommand{TransactionID: tx.TransactionID().String()})
+	require.NoError(t, err)
+	token, err := f.tokenService.Verify(issued.Token)
+	require.NoError(t, err)
+	require.NoError(t, f.usageRepo.MarkUsed(nil, token.TokenID(), token.TransactionID(), time.Now()))
+
+	// Act
+	_, err = NewOpenSurveyUseCase(f.tokenService, f.usageRepo, f.surveyRepo).
+		Execute(OpenSurveyQuery{Token: issued.Token})
+
+	// Assert
+	assert.ErrorIs(t, err, survey.ErrSurveyTokenUsed)
+}
+
+// Test 11: 竄改的 Token 回傳無效錯誤
+func TestOpenSurveyUseCase_TamperedToken_ReturnsError(t *testing.T) {
+	// Arrange
+	f := newTokenFixture(t)
+	f.givenActiveSurvey(t)
+
+	// Act
+	_, err := NewOpenSurveyUseCase(f.tokenService, f.usageRepo, f.surveyRepo).
+		Execute(OpenSurveyQuery{Token: "k1.e30.AAAA"})
+
+	// Assert
+	assert.ErrorIs(t, err, survey.ErrInvalidSurveyToken)
+}
+
+// ===========================
+// Mock Implementations
+// ===========================
+
+type MockInvoiceTransactionRepository struct {
+	transactions map[string]*invoice.InvoiceTransaction
+}
+
+func NewMockInvoiceTransactionRepository() *MockInvoiceTransactionRepository {
+	return &MockInvoiceTransactionRepository{
+		transactions: make(map[string]*invoice.InvoiceTransaction),
+	}
+}
+
+func (m *MockInvoiceTransactionRepository) Save(ctx shared.TransactionContext, tx *invoice.InvoiceTransaction) error {
+	m.transactions[tx.TransactionID().String()] = tx
+	return nil
+}
+
+func (m *MockInvoiceTransactionRepository) Update(ctx shared.TransactionContext, tx *invoice.InvoiceTransaction) error {
+	if _, exists := m.transactions[tx.TransactionID().String()]; !exists {
+		return invoice.ErrTransactionNotFound
+	}
+	m.transactions[tx.TransactionID().String()] = tx
+	return nil
+}
+
+func (m *MockInvoiceTransactionRepository) FindByID(ctx shared.TransactionContext, id invoice.TransactionID) (*invoice.InvoiceTransaction, error) {
+	if tx, exists := m.transactions[id.String()]; exists {
+		return tx, nil
+	}
+	return nil, invoice.ErrTransactionNotFound
+}
+
+func (m *MockInvoiceTransactionRepository) FindByInvoiceNumber(ctx shared.TransactionContext, number invoice.InvoiceNumber) (*invoice.InvoiceTransaction, error) {
+	for _, tx := range m.transactions {
+		if tx.InvoiceNumber().Equals(number) {
+			return tx, nil
+		}
+	}
+	return nil, invoice.ErrTransactionNotFound
+}
+
+type MockSurveyTokenUsageRepository struct {
+	used map[string]survey.TransactionID
+}
+
+func NewMockSurveyTokenUsageRepository() *MockSurveyTokenUsageRepository {
+	return &MockSurveyTokenUsageRepository{
+		used: make(map[string]survey.TransactionID),
+	}
+}
+
+func (m *MockSurveyTokenUsageRepository) MarkUsed(ctx shared.TransactionContext, tokenID survey.TokenID, transactionID survey.TransactionID, usedAt time.Time) error {
+	if _, exists := m.used[tokenID.String()]; exists {
+		return survey.ErrSurveyTokenUsed
+	}
+	m.used[tokenID.String()] = transactionID
+	return nil
+}
+
+func (m *MockSurveyTokenUsageRepository) IsUsed(ctx shared.TransactionContext, tokenID survey.TokenID) (bool, error) {
+	_, exists := m.used[tokenID.String()]
+	return exists, nil
+}
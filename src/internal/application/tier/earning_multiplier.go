@@ -0,0 +1,49 @@
+package tier
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/tier"
+)
+
+// ===========================
+// 會員等級積分倍率（points.EarningMultiplierQuery 實作）
+// ===========================
+
+// tierEarningMultiplierQuery 依會員目前等級提供發票積分倍率
+//
+// 業務規則：
+// - 尚無等級紀錄的會員 → 基本倍率
+// - 降級寬限期內沿用目前等級的倍率
+type tierEarningMultiplierQuery struct {
+	tierRepo tier.MemberTierRepository
+	policy   tier.TierPolicy
+}
+
+// NewEarningMultiplierQuery 創建積分倍率查詢（供積分入帳使用）
+func NewEarningMultiplierQuery(tierRepo tier.MemberTierRepository, policy tier.TierPolicy) points.EarningMultiplierQuery {
+	return &tierEarningMultiplierQuery{tierRepo: tierRepo, policy: policy}
+}
+
+// MultiplierFor 返回會員目前的積分倍率
+func (q *tierEarningMultiplierQuery) MultiplierFor(
+	ctx shared.TransactionContext,
+	memberID points.MemberID,
+) (points.EarningMultiplier, error) {
+	tierMemberID, err := tier.MemberIDFromString(memberID.String())
+	if err != nil {
+		return points.EarningMultiplier{}, err
+	}
+
+	memberTier, err := q.tierRepo.FindByMemberID(ctx, tierMemberID)
+	if errors.Is(err, tier.ErrMemberTierNotFound) {
+		return points.BaseEarningMultiplier(), nil
+	}
+	if err != nil {
+		return points.EarningMultiplier{}, fmt.Errorf("failed to find member tier: %w", err)
+	}
+	return points.NewEarningMultiplier(q.policy.Multiplier(memberTier.Level()))
+}
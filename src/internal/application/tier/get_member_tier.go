@@ -0,0 +1,91 @@
+package tier
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/tier"
+)
+
+// ===========================
+// GetMemberTier Query
+// ===========================
+
+// GetMemberTierQuery 查詢會員等級
+type GetMemberTierQuery struct {
+	MemberID string
+}
+
+// MemberTierResult 會員等級（Output DTO）
+//
+// 欄位：
+// - Multiplier: 發票積分倍率（例如 "1.5"）
+// - RollingSpend: 最近一次評估的近 12 個月已驗證消費（元，尚未評估時為 0）
+// - DemotionDueAt: 降級寬限期屆滿時間（未在寬限期時為 nil）
+// - NextLevel / SpendToNextLevel: 下一個等級與尚需消費金額（已是最高等級時為空字串 / 0）
+type MemberTierResult struct {
+	MemberID         string
+	Level            string
+	Multiplier       string
+	Perks            []string
+	RollingSpend     int
+	DemotionDueAt    *time.Time
+	NextLevel        string
+	SpendToNextLevel int
+	EvaluatedAt      *time.Time
+}
+
+// GetMemberTierUseCase 查詢會員目前等級與權益
+//
+// 業務規則：尚無等級紀錄的會員視為 basic
+type GetMemberTierUseCase struct {
+	tierRepo tier.MemberTierRepository
+	policy   tier.TierPolicy
+}
+
+// NewGetMemberTierUseCase 創建 Use Case 實例
+func NewGetMemberTierUseCase(tierRepo tier.MemberTierRepository, policy tier.TierPolicy) *GetMemberTierUseCase {
+	return &GetMemberTierUseCase{tierRepo: tierRepo, policy: policy}
+}
+
+// Execute 執行查詢
+func (uc *GetMemberTierUseCase) Execute(query GetMemberTierQuery) (*MemberTierResult, error) {
+	memberID, err := tier.MemberIDFromString(query.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberTier, err := uc.tierRepo.FindByMemberID(nil, memberID)
+	if errors.Is(err, tier.ErrMemberTierNotFound) {
+		memberTier, err = tier.NewMemberTier(memberID, time.Time{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find member tier: %w", err)
+	}
+
+	definition := uc.policy.Definition(memberTier.Level())
+	result := &MemberTierResult{
+		MemberID:      memberID.String(),
+		Level:         memberTier.Level().String(),
+		Multiplier:    definition.Multiplier.String(),
+		Perks:         definition.Perks,
+		RollingSpend:  memberTier.RollingSpend(),
+		DemotionDueAt: memberTier.DemotionDueAt(),
+	}
+	if !memberTier.EvaluatedAt().IsZero() {
+		evaluatedAt := memberTier.EvaluatedAt()
+		result.EvaluatedAt = &evaluatedAt
+	}
+	for _, next := range uc.policy.Definitions() {
+		if next.Level.IsHigherThan(memberTier.Level()) {
+			result.NextLevel = next.Level.String()
+			result.SpendToNextLevel = next.SpendThreshold - memberTier.RollingSpend()
+			if result.SpendToNextLevel < 0 {
+				result.SpendToNextLevel = 0
+			}
+			break
+		}
+	}
+	return result, nil
+}
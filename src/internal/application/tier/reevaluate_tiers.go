@@ -0,0 +1,162 @@
+package tier
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/tier"
+)
+
+// ===========================
+// ReevaluateMemberTiers Use Case（排程）
+// ===========================
+
+// ReevaluateMemberTiersCommand 會員等級定期評估指令
+type ReevaluateMemberTiersCommand struct {
+	Now time.Time
+}
+
+// ReevaluateMemberTiersResult 評估結果
+//
+// 欄位：
+// - Evaluated: 本次評估的會員數
+// - Promoted / Demoted: 升級 / 降級的會員數
+// - DemotionsScheduled / DemotionsCancelled: 進入 / 離開降級寬限期的會員數
+type ReevaluateMemberTiersResult struct {
+	Evaluated          int
+	Promoted           int
+	Demoted            int
+	DemotionsScheduled int
+	DemotionsCancelled int
+}
+
+// ReevaluateMemberTiersUseCase 依近 12 個月已驗證消費重新評估會員等級（排程執行，例如每小時）
+//
+// 業務規則：
+// - 評估對象：統計期間內有已驗證消費的會員，以及目前等級高於 basic 的會員
+// - 達到更高等級門檻 → 立即升級
+// - 低於目前等級門檻 → 進入寬限期；寬限期屆滿仍未達標才降級
+// - 未達任何等級門檻且尚無等級紀錄的會員不建立紀錄
+// - 每位會員各自一個事務，提交後發布 tier.changed / tier.demotion_scheduled 事件
+//
+// 錯誤處理：任一會員失敗即停止並返回已處理的統計（下次排程重新評估）
+type ReevaluateMemberTiersUseCase struct {
+	tierRepo   tier.MemberTierRepository
+	spendQuery tier.VerifiedSpendQuery
+	evaluator  *tier.TierEvaluationService
+	txManager  shared.TransactionManager
+	publisher  shared.EventPublisher
+}
+
+// NewReevaluateMemberTiersUseCase 創建 Use Case 實例
+func NewReevaluateMemberTiersUseCase(
+	tierRepo tier.MemberTierRepository,
+	spendQuery tier.VerifiedSpendQuery,
+	policy tier.TierPolicy,
+	txManager shared.TransactionManager,
+	publisher shared.EventPublisher,
+) *ReevaluateMemberTiersUseCase {
+	return &ReevaluateMemberTiersUseCase{
+		tierRepo:   tierRepo,
+		spendQuery: spendQuery,
+		evaluator:  tier.NewTierEvaluationService(policy),
+		txManager:  txManager,
+		publisher:  publisher,
+	}
+}
+
+// Execute 執行評估
+func (uc *ReevaluateMemberTiersUseCase) Execute(cmd ReevaluateMemberTiersCommand) (*ReevaluateMemberTiersResult, error) {
+	since := uc.evaluator.Policy().WindowStart(cmd.Now)
+	spends, err := uc.spendQuery.SumVerifiedSpendSince(nil, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum verified spend: %w", err)
+	}
+	ranked, err := uc.tierRepo.FindAboveBasic(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ranked members: %w", err)
+	}
+
+	spendByMember := make(map[string]int, len(spends))
+	memberIDs := make(map[string]tier.MemberID, len(spends)+len(ranked))
+	for _, spend := range spends {
+		spendByMember[spend.MemberID.String()] = spend.Amount
+		memberIDs[spend.MemberID.String()] = spend.MemberID
+	}
+	for _, t := range ranked {
+		memberIDs[t.MemberID().String()] = t.MemberID()
+	}
+	keys := make([]string, 0, len(memberIDs))
+	for key := range memberIDs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := &ReevaluateMemberTiersResult{}
+	for _, key := range keys {
+		outcome, err := uc.evaluate(memberIDs[key], spendByMember[key], cmd.Now)
+		if err != nil {
+			return result, err
+		}
+		result.record(outcome)
+	}
+	return result, nil
+}
+
+// evaluate 評估單一會員並保存（各自一個事務，提交後發布事件）
+func (uc *ReevaluateMemberTiersUseCase) evaluate(
+	memberID tier.MemberID,
+	rollingSpend int,
+	now time.Time,
+) (tier.EvaluationOutcome, error) {
+	var memberTier *tier.MemberTier
+	outcome := tier.OutcomeUnchanged
+	err := uc.txManager.InTransaction(func(ctx shared.TransactionContext) error {
+		var err error
+		memberTier, err = uc.tierRepo.FindByMemberID(ctx, memberID)
+		isNew := errors.Is(err, tier.ErrMemberTierNotFound)
+		switch {
+		case isNew:
+			if memberTier, err = tier.NewMemberTier(memberID, now); err != nil {
+				return err
+			}
+		case err != nil:
+			return fmt.Errorf("failed to find member tier: %w", err)
+		}
+
+		outcome = uc.evaluator.Evaluate(memberTier, rollingSpend, now)
+		if isNew && outcome == tier.OutcomeUnchanged {
+			return nil
+		}
+		if err := uc.tierRepo.Save(ctx, memberTier); err != nil {
+			return fmt.Errorf("failed to save member tier: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return outcome, err
+	}
+
+	if err := uc.publisher.PublishBatch(memberTier.PullEvents()); err != nil {
+		return outcome, fmt.Errorf("failed to publish member tier events: %w", err)
+	}
+	return outcome, nil
+}
+
+// record 累計評估結果
+func (r *ReevaluateMemberTiersResult) record(outcome tier.EvaluationOutcome) {
+	r.Evaluated++
+	switch outcome {
+	case tier.OutcomePromoted:
+		r.Promoted++
+	case tier.OutcomeDemoted:
+		r.Demoted++
+	case tier.OutcomeDemotionScheduled:
+		r.DemotionsScheduled++
+	case tier.OutcomeDemotionCancelled:
+		r.DemotionsCancelled++
+	}
+}
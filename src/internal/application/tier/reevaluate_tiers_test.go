@@ -0,0 +1,188 @@
+package tier
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/tier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// ReevaluateMemberTiers Use Case Tests
+// ===========================
+
+// tierFixture 測試共用依賴
+type tierFixture struct {
+	tiers     *FakeMemberTierRepository
+	spends    *FakeVerifiedSpendQuery
+	publisher *FakeEventPublisher
+	policy    tier.TierPolicy
+	now       time.Time
+}
+
+func newTierFixture() *tierFixture {
+	return &tierFixture{
+		tiers:     &FakeMemberTierRepository{tiers: map[string]*tier.MemberTier{}},
+		spends:    &FakeVerifiedSpendQuery{},
+		publisher: &FakeEventPublisher{},
+		policy:    tier.DefaultTierPolicy(),
+		now:       time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func (f *tierFixture) useCase() *ReevaluateMemberTiersUseCase {
+	return NewReevaluateMemberTiersUseCase(f.tiers, f.spends, f.policy, &MockTransactionManager{}, f.publisher)
+}
+
+// givenSpend 設定會員統計期間內的已驗證消費
+func (f *tierFixture) givenSpend(memberID tier.MemberID, amount int) {
+	f.spends.spends = append(f.spends.spends, tier.MemberSpend{MemberID: memberID, Amount: amount})
+}
+
+// Test 1: Spenders reaching a threshold are promoted; members below every threshold get no record
+func TestReevaluateMemberTiersUseCase_Execute_PromotesQualifiedMembers(t *testing.T) {
+	// Arrange
+	f := newTierFixture()
+	gold := shared.NewEntityID[tier.MemberMarker]()
+	casual := shared.NewEntityID[tier.MemberMarker]()
+	f.givenSpend(gold, 42000)
+	f.givenSpend(casual, 3000)
+
+	// Act
+	result, err := f.useCase().Execute(ReevaluateMemberTiersCommand{Now: f.now})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, &ReevaluateMemberTiersResult{Evaluated: 2, Promoted: 1}, result)
+	assert.Equal(t, tier.LevelGold, f.tiers.tiers[gold.String()].Level())
+	assert.NotContains(t, f.tiers.tiers, casual.String())
+	assert.Equal(t, f.policy.WindowStart(f.now), f.spends.since)
+	require.Len(t, f.publisher.events, 1)
+	assert.Equal(t, "tier.changed", f.publisher.events[0].EventType())
+}
+
+// Test 2: Ranked members without enough spend keep their tier through the grace period, then are demoted
+func TestReevaluateMemberTiersUseCase_Execute_DemotesAfterGracePeriod(t *testing.T) {
+	// Arrange
+	f := newTierFixture()
+	silver := shared.NewEntityID[tier.MemberMarker]()
+	f.givenSpend(silver, 12000)
+	_, err := f.useCase().Execute(ReevaluateMemberTiersCommand{Now: f.now})
+	require.NoError(t, err)
+	f.spends.spends = nil
+	f.publisher.events = nil
+	lapsed := f.now.AddDate(1, 0, 0)
+
+	// Act
+	scheduled, err := f.useCase().Execute(ReevaluateMemberTiersCommand{Now: lapsed})
+	require.NoError(t, err)
+	levelDuringGrace := f.tiers.tiers[silver.String()].Level()
+	demoted, err := f.useCase().Execute(ReevaluateMemberTiersCommand{Now: lapsed.Add(f.policy.DemotionGracePeriod())})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, &ReevaluateMemberTiersResult{Evaluated: 1, DemotionsScheduled: 1}, scheduled)
+	assert.Equal(t, tier.LevelSilver, levelDuringGrace)
+	assert.Equal(t, &ReevaluateMemberTiersResult{Evaluated: 1, Demoted: 1}, demoted)
+	assert.Equal(t, tier.LevelBasic, f.tiers.tiers[silver.String()].Level())
+	require.Len(t, f.publisher.events, 2)
+	assert.Equal(t, "tier.demotion_scheduled", f.publisher.events[0].EventType())
+	assert.Equal(t, "tier.changed", f.publisher.events[1].EventType())
+}
+
+// Test 3: Earning multiplier and tier query follow the member's current tier
+func TestEarningMultiplierQueryAndGetMemberTier(t *testing.T) {
+	// Arrange
+	f := newTierFixture()
+	gold := shared.NewEntityID[tier.MemberMarker]()
+	f.givenSpend(gold, 42000)
+	_, err := f.useCase().Execute(ReevaluateMemberTiersCommand{Now: f.now})
+	require.NoError(t, err)
+	multipliers := NewEarningMultiplierQuery(f.tiers, f.policy)
+	goldPointsID, _ := points.MemberIDFromString(gold.String())
+	newcomerPointsID := points.NewMemberID()
+
+	// Act
+	goldMultiplier, err := multipliers.MultiplierFor(nil, goldPointsID)
+	require.NoError(t, err)
+	newcomerMultiplier, err := multipliers.MultiplierFor(nil, newcomerPointsID)
+	require.NoError(t, err)
+	result, err := NewGetMemberTierUseCase(f.tiers, f.policy).Execute(GetMemberTierQuery{MemberID: gold.String()})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "1.5", goldMultiplier.Value().String())
+	assert.True(t, newcomerMultiplier.Equals(points.BaseEarningMultiplier()))
+	assert.Equal(t, "gold", result.Level)
+	assert.Equal(t, "black", result.NextLevel)
+	assert.Equal(t, 38000, result.SpendToNextLevel)
+	assert.NotEmpty(t, result.Perks)
+}
+
+// ===========================
+// Fake 實現
+// ===========================
+
+// FakeMemberTierRepository 以會員 ID 為鍵
+type FakeMemberTierRepository struct {
+	tiers map[string]*tier.MemberTier
+}
+
+func (r *FakeMemberTierRepository) Save(ctx shared.TransactionContext, t *tier.MemberTier) error {
+	r.tiers[t.MemberID().String()] = t
+	return nil
+}
+
+func (r *FakeMemberTierRepository) FindByMemberID(ctx shared.TransactionContext, memberID tier.MemberID) (*tier.MemberTier, error) {
+	if t, ok := r.tiers[memberID.String()]; ok {
+		return t, nil
+	}
+	return nil, tier.ErrMemberTierNotFound
+}
+
+func (r *FakeMemberTierRepository) FindAboveBasic(ctx shared.TransactionContext) ([]*tier.MemberTier, error) {
+	var tiers []*tier.MemberTier
+	for _, t := range r.tiers {
+		if t.Level() != tier.LevelBasic {
+			tiers = append(tiers, t)
+		}
+	}
+	return tiers, nil
+}
+
+// FakeVerifiedSpendQuery 返回預設的消費統計並記錄統計起點
+type FakeVerifiedSpendQuery struct {
+	spends []tier.MemberSpend
+	since  time.Time
+}
+
+func (q *FakeVerifiedSpendQuery) SumVerifiedSpendSince(ctx shared.TransactionContext, since time.Time) ([]tier.MemberSpend, error) {
+	q.since = since
+	return q.spends, nil
+}
+
+// FakeEventPublisher 記錄發布的事件
+type FakeEventPublisher struct {
+	events []shared.DomainEvent
+}
+
+func (p *FakeEventPublisher) Publish(event shared.DomainEvent) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *FakeEventPublisher) PublishBatch(events []shared.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+// MockTransactionManager 直接執行函數（ctx 為 nil）
+type MockTransactionManager struct{}
+
+func (m *MockTransactionManager) InTransaction(fn func(ctx shared.TransactionContext) error) error {
+	return fn(nil)
+}
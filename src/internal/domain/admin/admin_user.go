@@ -0,0 +1,486 @@
+package admin
+
+import (
+	"crypto/subtle"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// 登入節流規則
+const (
+	MaxFailedLoginAttempts = 5                // 連續失敗幾次後鎖定
+	LockoutDuration        = 15 * time.Minute // 鎖定時間
+)
+
+// ===========================
+// TwoFactorState 兩步驟驗證狀態
+// ===========================
+
+// TwoFactorState 兩步驟驗證（TOTP）狀態
+//
+// 欄位：
+// - Secret: 已啟用的 TOTP 金鑰（Base32，空字串表示未啟用）
+// - PendingSecret: 設定中、尚待輸入驗證碼確認的金鑰
+// - LastUsedStep: 最後一次通過驗證的時間區間（同一驗證碼不可重複使用）
+// - RecoveryCodeHashes: 尚未使用的復原碼雜湊（使用後移除）
+//
+// 注意：TOTP 驗證需要金鑰明文，資料庫需以磁碟 / 欄位加密保護
+type TwoFactorState struct {
+	Secret             string
+	PendingSecret      string
+	EnabledAt          *time.Time
+	LastUsedStep       int64
+	RecoveryCodeHashes []string
+}
+
+// usernamePattern 帳號格式（小寫英數字、點、底線、連字號、@，3-100 字）
+var usernamePattern = regexp.MustCompile(`^[a-z0-9._@-]{3,100}$`)
+
+// ===========================
+// AdminUser 聚合根
+// ===========================
+
+// AdminUser 管理後台帳號
+//
+// 職責：
+// - 保存帳號、角色與密碼雜湊
+// - 驗證登入密碼並記錄連續失敗次數（登入節流）
+// - 連續失敗達上限時鎖定一段時間
+// - 兩步驟驗證（TOTP + 一次性復原碼，owner / manager 必須啟用）
+//
+// 設計原則：
+// - 帳號一律轉為小寫（不區分大小寫登入）
+// - 不保存明文密碼，驗證透過 PasswordHasher
+// - 每次狀態變更遞增 Version，Repository.Update 以載入時版本號偵測並行修改（保護登入失敗次數與 TOTP 重放檢查）
+type AdminUser struct {
+	adminID             AdminUserID
+	username            string
+	displayName         string
+	passwordHash        string
+	role                Role
+	failedLoginAttempts int
+	lockedUntil         *time.Time
+	lastLoginAt         *time.Time
+	disabledAt          *time.Time
+	twoFactor           TwoFactorState
+	createdAt           time.Time
+	updatedAt           time.Time
+	version             int // 樂觀鎖版本號（Optimistic Locking）
+
+	// persistedVersion 最近一次載入或寫入時的版本號（更新時比對，0 表示尚未保存）
+	persistedVersion int
+}
+
+// NewAdminUser 創建管理員帳號
+//
+// 錯誤：
+// - 帳號格式不符 → ErrInvalidUsername
+// - 角色無效 → ErrInvalidRole
+// - 密碼不符規則 → ErrWeakPassword
+// - 雜湊失敗 → hasher 返回的錯誤
+func NewAdminUser(
+	username string,
+	displayName string,
+	role Role,
+	password string,
+	hasher PasswordHasher,
+	now time.Time,
+) (*AdminUser, error) {
+	username, err := NormalizeUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if !role.IsValid() {
+		return nil, ErrInvalidRole.WithContext("role", role.String())
+	}
+	if err := ValidatePassword(password); err != nil {
+		return nil, err
+	}
+	hash, err := hasher.Hash(password)
+	if err != nil {
+		return nil, err
+	}
+
+	displayName = strings.TrimSpace(displayName)
+	if displayName == "" {
+		displayName = username
+	}
+
+	return &AdminUser{
+		adminID:      NewAdminUserID(),
+		username:     username,
+		displayName:  displayName,
+		passwordHash: hash,
+		role:         role,
+		createdAt:    now,
+		updatedAt:    now,
+		version:      1, // 初始版本為 1
+	}, nil
+}
+
+// ReconstructAdminUser 從持久化存儲重建管理員帳號
+//
+// 設計原則：僅供 Repository 使用
+func ReconstructAdminUser(
+	adminID AdminUserID,
+	username string,
+	displayName string,
+	passwordHash string,
+	role Role,
+	failedLoginAttempts int,
+	lockedUntil *time.Time,
+	lastLoginAt *time.Time,
+	disabledAt *time.Time,
+	twoFactor TwoFactorState,
+	createdAt time.Time,
+	updatedAt time.Time,
+	version int,
+) (*AdminUser, error) {
+	if !role.IsValid() {
+		return nil, ErrInvalidRole.WithContext("admin_id", adminID.String(), "role", role.String())
+	}
+	return &AdminUser{
+		adminID:             adminID,
+		username:            username,
+		displayName:         displayName,
+		passwordHash:        passwordHash,
+		role:                role,
+		failedLoginAttempts: failedLoginAttempts,
+		lockedUntil:         lockedUntil,
+		lastLoginAt:         lastLoginAt,
+		disabledAt:          disabledAt,
+		twoFactor:           twoFactor,
+		createdAt:           createdAt,
+		updatedAt:           updatedAt,
+		version:             version,
+		persistedVersion:    version,
+	}, nil
+}
+
+// NormalizeUsername 正規化帳號（去除空白、轉小寫）並驗證格式
+//
+// 錯誤：格式不符 → ErrInvalidUsername
+func NormalizeUsername(username string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(username))
+	if !usernamePattern.MatchString(normalized) {
+		return "", ErrInvalidUsername.WithContext("username", username)
+	}
+	return normalized, nil
+}
+
+// ===========================
+// 業務方法
+// ===========================
+
+// Authenticate 驗證登入密碼與兩步驟驗證碼
+//
+// 參數：
+//   otpCode - TOTP 驗證碼或復原碼（未啟用兩步驟驗證時忽略）
+//
+// 業務規則：
+// - 停用的帳號不可登入
+// - 鎖定期間不驗證密碼，直接拒絕
+// - 密碼或驗證碼錯誤時累計失敗次數，連續 5 次鎖定 15 分鐘（鎖定後計數歸零）
+// - 已啟用兩步驟驗證時，密碼正確但未提供驗證碼不計失敗、也不清除失敗次數
+// - 登入成功時清除失敗次數並記錄登入時間
+//
+// 注意：失敗時聚合狀態也會改變，呼叫端需保存後再返回錯誤
+//
+// 錯誤：
+// - 帳號停用 → ErrAdminDisabled
+// - 鎖定中（或本次失敗觸發鎖定）→ ErrAccountLocked
+// - 密碼錯誤 → ErrInvalidCredentials
+// - 需要驗證碼 → ErrTwoFactorRequired
+// - 驗證碼錯誤 / 已使用 → ErrInvalidOTP
+func (u *AdminUser) Authenticate(password, otpCode string, hasher PasswordHasher, now time.Time) error {
+	if u.IsDisabled() {
+		return ErrAdminDisabled.WithContext("username", u.username)
+	}
+	if u.IsLocked(now) {
+		return ErrAccountLocked.WithContext("locked_until", *u.lockedUntil)
+	}
+
+	if !hasher.Verify(u.passwordHash, password) {
+		return u.recordFailedLogin(ErrInvalidCredentials, now)
+	}
+	if u.TwoFactorEnabled() {
+		if strings.TrimSpace(otpCode) == "" {
+			return ErrTwoFactorRequired
+		}
+		if !u.verifySecondFactor(otpCode, now) {
+			return u.recordFailedLogin(ErrInvalidOTP, now)
+		}
+	}
+
+	u.failedLoginAttempts = 0
+	u.lockedUntil = nil
+	u.lastLoginAt = &now
+	u.touch(now)
+	return nil
+}
+
+// recordFailedLogin 累計登入失敗次數，達上限時鎖定
+//
+// 返回：觸發鎖定時為 ErrAccountLocked，否則為 err
+func (u *AdminUser) recordFailedLogin(err error, now time.Time) error {
+	u.failedLoginAttempts++
+	u.touch(now)
+	if u.failedLoginAttempts >= MaxFailedLoginAttempts {
+		lockedUntil := now.Add(LockoutDuration)
+		u.lockedUntil = &lockedUntil
+		u.failedLoginAttempts = 0
+		return ErrAccountLocked.WithContext("locked_until", lockedUntil)
+	}
+	return err
+}
+
+// ===========================
+// 兩步驟驗證（TOTP）
+// ===========================
+
+// BeginTOTPEnrollment 開始設定兩步驟驗證（產生新金鑰，待 ConfirmTOTPEnrollment 確認）
+//
+// 業務規則：重複呼叫會以新金鑰取代尚未確認的金鑰
+//
+// 錯誤：已啟用 → ErrTwoFactorAlreadyEnabled
+func (u *AdminUser) BeginTOTPEnrollment(now time.Time) (TOTPSecret, error) {
+	if u.TwoFactorEnabled() {
+		return TOTPSecret{}, ErrTwoFactorAlreadyEnabled.WithContext("username", u.username)
+	}
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		return TOTPSecret{}, err
+	}
+	u.twoFactor.PendingSecret = secret.Base32()
+	u.touch(now)
+	return secret, nil
+}
+
+// ConfirmTOTPEnrollment 以 Authenticator App 顯示的驗證碼確認設定，啟用兩步驟驗證
+//
+// 返回：一次性復原碼明文（僅此一次）
+//
+// 錯誤：
+// - 已啟用 → ErrTwoFactorAlreadyEnabled
+// - 沒有設定中的金鑰 → ErrTwoFactorNotPending
+// - 驗證碼錯誤 → ErrInvalidOTP
+func (u *AdminUser) ConfirmTOTPEnrollment(code string, now time.Time) ([]string, error) {
+	if u.TwoFactorEnabled() {
+		return nil, ErrTwoFactorAlreadyEnabled.WithContext("username", u.username)
+	}
+	if u.twoFactor.PendingSecret == "" {
+		return nil, ErrTwoFactorNotPending.WithContext("username", u.username)
+	}
+	secret, err := TOTPSecretFromBase32(u.twoFactor.PendingSecret)
+	if err != nil {
+		return nil, err
+	}
+	step, ok := secret.match(code, now, TOTPSkewSteps)
+	if !ok {
+		return nil, ErrInvalidOTP
+	}
+
+	codes, hashes, err := GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	u.twoFactor = TwoFactorState{
+		Secret:             secret.Base32(),
+		EnabledAt:          &now,
+		LastUsedStep:       step,
+		RecoveryCodeHashes: hashes,
+	}
+	u.touch(now)
+	return codes, nil
+}
+
+// RegenerateRecoveryCodes 重新產生復原碼（舊復原碼全部失效）
+//
+// 參數：
+//   code - 目前的 TOTP 驗證碼（不接受復原碼）
+//
+// 錯誤：
+// - 未啟用 → ErrTwoFactorNotEnabled
+// - 驗證碼錯誤 / 已使用 → ErrInvalidOTP
+func (u *AdminUser) RegenerateRecoveryCodes(code string, now time.Time) ([]string, error) {
+	if !u.TwoFactorEnabled() {
+		return nil, ErrTwoFactorNotEnabled.WithContext("username", u.username)
+	}
+	if !u.verifyTOTP(code, now) {
+		return nil, ErrInvalidOTP
+	}
+
+	codes, hashes, err := GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	u.twoFactor.RecoveryCodeHashes = hashes
+	u.touch(now)
+	return codes, nil
+}
+
+// verifySecondFactor 驗證 TOTP 驗證碼或復原碼（復原碼使用後移除）
+func (u *AdminUser) verifySecondFactor(code string, now time.Time) bool {
+	if !isRecoveryCodeFormat(code) {
+		return u.verifyTOTP(code, now)
+	}
+
+	hash := HashRecoveryCode(code)
+	for i, stored := range u.twoFactor.RecoveryCodeHashes {
+		if subtle.ConstantTimeCompare([]byte(stored), []byte(hash)) == 1 {
+			remaining := make([]string, 0, len(u.twoFactor.RecoveryCodeHashes)-1)
+			remaining = append(remaining, u.twoFactor.RecoveryCodeHashes[:i]...)
+			remaining = append(remaining, u.twoFactor.RecoveryCodeHashes[i+1:]...)
+			u.twoFactor.RecoveryCodeHashes = remaining
+			u.touch(now)
+			return true
+		}
+	}
+	return false
+}
+
+// verifyTOTP 驗證 TOTP 驗證碼（容許前後 1 個時間區間；已使用過的區間不可重複使用）
+func (u *AdminUser) verifyTOTP(code string, now time.Time) bool {
+	secret, err := TOTPSecretFromBase32(u.twoFactor.Secret)
+	if err != nil {
+		return false
+	}
+	step, ok := secret.match(code, now, TOTPSkewSteps)
+	if !ok || step <= u.twoFactor.LastUsedStep {
+		return false
+	}
+	u.twoFactor.LastUsedStep = step
+	u.touch(now)
+	return true
+}
+
+// Disable 停用帳號（已停用時不變）
+//
+// 注意：既有工作階段需由呼叫端撤銷
+func (u *AdminUser) Disable(now time.Time) {
+	if u.disabledAt != nil {
+		return
+	}
+	u.disabledAt = &now
+	u.touch(now)
+}
+
+// touch 記錄狀態變更（更新時間、遞增版本號）
+func (u *AdminUser) touch(now time.Time) {
+	u.updatedAt = now
+	u.version++
+}
+
+// IsLocked 判斷帳號在指定時間是否鎖定中
+func (u *AdminUser) IsLocked(now time.Time) bool {
+	return u.lockedUntil != nil && now.Before(*u.lockedUntil)
+}
+
+// IsDisabled 判斷帳號是否已停用
+func (u *AdminUser) IsDisabled() bool {
+	return u.disabledAt != nil
+}
+
+// TwoFactorEnabled 判斷是否已啟用兩步驟驗證
+func (u *AdminUser) TwoFactorEnabled() bool {
+	return u.twoFactor.Secret != ""
+}
+
+// RequiresTwoFactorEnrollment 判斷角色要求兩步驟驗證但尚未啟用
+func (u *AdminUser) RequiresTwoFactorEnrollment() bool {
+	return u.role.RequiresTwoFactor() && !u.TwoFactorEnabled()
+}
+
+// RemainingRecoveryCodes 尚未使用的復原碼數量
+func (u *AdminUser) RemainingRecoveryCodes() int {
+	return len(u.twoFactor.RecoveryCodeHashes)
+}
+
+// Can 判斷帳號是否具備權限（停用帳號不具任何權限）
+func (u *AdminUser) Can(permission Permission) bool {
+	return !u.IsDisabled() && u.role.Can(permission)
+}
+
+// ===========================
+// Getters
+// ===========================
+
+// AdminID 管理員 ID
+func (u *AdminUser) AdminID() AdminUserID {
+	return u.adminID
+}
+
+// Username 帳號（小寫）
+func (u *AdminUser) Username() string {
+	return u.username
+}
+
+// DisplayName 顯示名稱
+func (u *AdminUser) DisplayName() string {
+	return u.displayName
+}
+
+// PasswordHash 密碼雜湊值
+func (u *AdminUser) PasswordHash() string {
+	return u.passwordHash
+}
+
+// Role 角色
+func (u *AdminUser) Role() Role {
+	return u.role
+}
+
+// FailedLoginAttempts 連續登入失敗次數
+func (u *AdminUser) FailedLoginAttempts() int {
+	return u.failedLoginAttempts
+}
+
+// LockedUntil 鎖定到期時間（未鎖定時為 nil）
+func (u *AdminUser) LockedUntil() *time.Time {
+	return u.lockedUntil
+}
+
+// LastLoginAt 最後登入時間
+func (u *AdminUser) LastLoginAt() *time.Time {
+	return u.lastLoginAt
+}
+
+// DisabledAt 停用時間（未停用時為 nil）
+func (u *AdminUser) DisabledAt() *time.Time {
+	return u.disabledAt
+}
+
+// TwoFactor 兩步驟驗證狀態（副本，供 Repository 保存）
+func (u *AdminUser) TwoFactor() TwoFactorState {
+	state := u.twoFactor
+	state.RecoveryCodeHashes = append([]string(nil), u.twoFactor.RecoveryCodeHashes...)
+	return state
+}
+
+// CreatedAt 建立時間
+func (u *AdminUser) CreatedAt() time.Time {
+	return u.createdAt
+}
+
+// UpdatedAt 更新時間
+func (u *AdminUser) UpdatedAt() time.Time {
+	return u.updatedAt
+}
+
+// Version 返回版本號（用於樂觀鎖）
+func (u *AdminUser) Version() int {
+	return u.version
+}
+
+// PersistedVersion 返回最近一次載入或寫入時的版本號（Repository 更新時比對，0 表示尚未保存）
+func (u *AdminUser) PersistedVersion() int {
+	return u.persistedVersion
+}
+
+// MarkPersisted 標記目前版本已寫入（由 Repository 在 Save / Update 成功後呼叫）
+//
+// 使同一個聚合實例可再次 Update 而不產生誤判的版本衝突
+func (u *AdminUser) MarkPersisted() {
+	u.persistedVersion = u.version
+}
@@ -0,0 +1,214 @@
+package admin_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPassword = "correct horse battery"
+
+func newTestAdminUser(t *testing.T, role admin.Role, now time.Time) *admin.AdminUser {
+	t.Helper()
+	u, err := admin.NewAdminUser("Owner@Bar", "王姐", role, testPassword, StubPasswordHasher{}, now)
+	require.NoError(t, err)
+	return u
+}
+
+// Test 1: 建立帳號（帳號轉小寫、只保存雜湊；帳號 / 角色 / 密碼驗證）
+func TestAdminUser_New(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	hasher := StubPasswordHasher{}
+
+	// Act
+	u := newTestAdminUser(t, admin.RoleOwner, now)
+	_, badUsername := admin.NewAdminUser("a b", "", admin.RoleStaff, testPassword, hasher, now)
+	_, badRole := admin.NewAdminUser("staff", "", admin.Role("root"), testPassword, hasher, now)
+	_, weak := admin.NewAdminUser("staff", "", admin.RoleStaff, "short", hasher, now)
+
+	// Assert
+	assert.Equal(t, "owner@bar", u.Username())
+	assert.Equal(t, "王姐", u.DisplayName())
+	assert.Equal(t, "hashed:"+testPassword, u.PasswordHash())
+	assert.ErrorIs(t, badUsername, admin.ErrInvalidUsername)
+	assert.ErrorIs(t, badRole, admin.ErrInvalidRole)
+	assert.ErrorIs(t, weak, admin.ErrWeakPassword)
+}
+
+// Test 2: 登入節流（連續失敗 5 次鎖定 15 分鐘，鎖定期間正確密碼也拒絕，到期後可登入）
+func TestAdminUser_AuthenticateLockout(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	hasher := StubPasswordHasher{}
+	u := newTestAdminUser(t, admin.RoleManager, now)
+
+	// Act & Assert
+	for i := 1; i < admin.MaxFailedLoginAttempts; i++ {
+		assert.ErrorIs(t, u.Authenticate("wrong password", "", hasher, now), admin.ErrInvalidCredentials)
+		assert.Equal(t, i, u.FailedLoginAttempts())
+	}
+	assert.ErrorIs(t, u.Authenticate("wrong password", "", hasher, now), admin.ErrAccountLocked)
+	assert.True(t, u.IsLocked(now))
+	require.NotNil(t, u.LockedUntil())
+	assert.Equal(t, now.Add(admin.LockoutDuration), *u.LockedUntil())
+
+	assert.ErrorIs(t, u.Authenticate(testPassword, "", hasher, now.Add(time.Minute)), admin.ErrAccountLocked)
+
+	later := now.Add(admin.LockoutDuration)
+	require.NoError(t, u.Authenticate(testPassword, "", hasher, later))
+	assert.False(t, u.IsLocked(later))
+	assert.Equal(t, 0, u.FailedLoginAttempts())
+	require.NotNil(t, u.LastLoginAt())
+	assert.Equal(t, later, *u.LastLoginAt())
+
+	u.Disable(later)
+	assert.ErrorIs(t, u.Authenticate(testPassword, "", hasher, later), admin.ErrAdminDisabled)
+	assert.False(t, u.Can(admin.PermissionViewMembers))
+}
+
+// Test 3: 角色權限（只有 owner 可核准積分調整與管理後台帳號；店員可申請調整；店員可兌換獎勵不可管理目錄；auditor 唯讀）
+func TestRole_Permissions(t *testing.T) {
+	// Arrange
+	ownerOnly := []admin.Permission{
+		admin.PermissionAdjustPoints,
+		admin.PermissionManageAdmins,
+	}
+
+	// Act & Assert
+	for _, p := range ownerOnly {
+		assert.True(t, admin.RoleOwner.Can(p), p)
+		assert.False(t, admin.RoleManager.Can(p), p)
+		assert.False(t, admin.RoleStaff.Can(p), p)
+		assert.False(t, admin.RoleAuditor.Can(p), p)
+	}
+	assert.True(t, admin.RoleManager.Can(admin.PermissionManageMembers))
+	assert.True(t, admin.RoleAuditor.Can(admin.PermissionExportData))
+	assert.False(t, admin.RoleAuditor.Can(admin.PermissionManageSurveys))
+	assert.False(t, admin.RoleStaff.Can(admin.PermissionExportData))
+	assert.True(t, admin.RoleStaff.Can(admin.PermissionVerifyAge))
+	assert.False(t, admin.RoleAuditor.Can(admin.PermissionVerifyAge))
+	assert.True(t, admin.RoleManager.Can(admin.PermissionRequestPointsAdjustment))
+	assert.True(t, admin.RoleStaff.Can(admin.PermissionRequestPointsAdjustment))
+	assert.False(t, admin.RoleAuditor.Can(admin.PermissionRequestPointsAdjustment))
+	assert.True(t, admin.RoleStaff.Can(admin.PermissionRedeemRewards))
+	assert.False(t, admin.RoleStaff.Can(admin.PermissionManageRewards))
+	assert.True(t, admin.RoleAuditor.Can(admin.PermissionViewRewards))
+	assert.False(t, admin.RoleAuditor.Can(admin.PermissionRedeemRewards))
+
+	_, err := admin.ParseRole("guest")
+	assert.ErrorIs(t, err, admin.ErrInvalidRole)
+}
+
+// Test 4: 工作階段（只保存 Token 雜湊；到期與撤銷）
+func TestSession_ValidateAndRevoke(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	adminID := admin.NewAdminUserID()
+
+	// Act
+	s, token, err := admin.IssueSession(adminID, false, time.Hour, now)
+	require.NoError(t, err)
+
+	// Assert
+	assert.NotEmpty(t, token)
+	assert.NotEqual(t, token, s.TokenHash())
+	assert.Equal(t, admin.HashSessionToken(token), s.TokenHash())
+	assert.NoError(t, s.Validate(now.Add(59*time.Minute)))
+	assert.ErrorIs(t, s.Validate(now.Add(time.Hour)), admin.ErrSessionExpired)
+
+	assert.True(t, s.Revoke(now))
+	assert.False(t, s.Revoke(now))
+	assert.ErrorIs(t, s.Validate(now), admin.ErrSessionRevoked)
+}
+
+// Test 5: 兩步驟驗證設定與登入（時間誤差容許、同一驗證碼不可重複使用、復原碼一次性）
+func TestAdminUser_TwoFactorLogin(t *testing.T) {
+	// Arrange（固定時鐘）
+	now := time.Date(2025, 3, 1, 12, 0, 15, 0, time.UTC)
+	hasher := StubPasswordHasher{}
+	u := newTestAdminUser(t, admin.RoleOwner, now)
+	require.True(t, u.RequiresTwoFactorEnrollment())
+
+	_, notPending := u.ConfirmTOTPEnrollment("123456", now)
+	secret, err := u.BeginTOTPEnrollment(now)
+	require.NoError(t, err)
+	_, wrongCode := u.ConfirmTOTPEnrollment("000000", now)
+	require.NoError(t, u.Authenticate(testPassword, "", hasher, now))
+
+	// Act
+	codes, err := u.ConfirmTOTPEnrollment(secret.CodeAt(now), now)
+	require.NoError(t, err)
+	_, again := u.BeginTOTPEnrollment(now)
+
+	missing := u.Authenticate(testPassword, "", hasher, now)
+	replayed := u.Authenticate(testPassword, secret.CodeAt(now), hasher, now)
+	previousStep := u.Authenticate(testPassword, secret.CodeAt(now.Add(-30*time.Second)), hasher, now)
+	nextStep := u.Authenticate(testPassword, secret.CodeAt(now.Add(30*time.Second)), hasher, now)
+	tooFar := u.Authenticate(testPassword, secret.CodeAt(now.Add(90*time.Second)), hasher, now)
+	recovery := u.Authenticate(testPassword, strings.ToUpper(codes[0]), hasher, now)
+	recoveryReused := u.Authenticate(testPassword, codes[0], hasher, now)
+
+	// Assert
+	assert.ErrorIs(t, notPending, admin.ErrTwoFactorNotPending)
+	assert.ErrorIs(t, wrongCode, admin.ErrInvalidOTP)
+	assert.True(t, u.TwoFactorEnabled())
+	assert.False(t, u.RequiresTwoFactorEnrollment())
+	assert.Len(t, codes, admin.RecoveryCodeCount)
+	assert.ErrorIs(t, again, admin.ErrTwoFactorAlreadyEnabled)
+
+	assert.ErrorIs(t, missing, admin.ErrTwoFactorRequired)
+	assert.ErrorIs(t, replayed, admin.ErrInvalidOTP)
+	assert.ErrorIs(t, previousStep, admin.ErrInvalidOTP)
+	assert.NoError(t, nextStep)
+	assert.ErrorIs(t, tooFar, admin.ErrInvalidOTP)
+	assert.NoError(t, recovery)
+	assert.ErrorIs(t, recoveryReused, admin.ErrInvalidOTP)
+	assert.Equal(t, admin.RecoveryCodeCount-1, u.RemainingRecoveryCodes())
+	assert.Equal(t, 1, u.FailedLoginAttempts())
+}
+
+// Test 6: 重新產生復原碼（需目前的 TOTP 驗證碼，舊復原碼失效）
+func TestAdminUser_RegenerateRecoveryCodes(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	hasher := StubPasswordHasher{}
+	u := newTestAdminUser(t, admin.RoleManager, now)
+	_, notEnabled := u.RegenerateRecoveryCodes("123456", now)
+	secret, err := u.BeginTOTPEnrollment(now)
+	require.NoError(t, err)
+	oldCodes, err := u.ConfirmTOTPEnrollment(secret.CodeAt(now), now)
+	require.NoError(t, err)
+	later := now.Add(time.Minute)
+
+	// Act
+	_, withRecoveryCode := u.RegenerateRecoveryCodes(oldCodes[1], later)
+	newCodes, err := u.RegenerateRecoveryCodes(secret.CodeAt(later), later)
+	require.NoError(t, err)
+
+	// Assert
+	assert.ErrorIs(t, notEnabled, admin.ErrTwoFactorNotEnabled)
+	assert.ErrorIs(t, withRecoveryCode, admin.ErrInvalidOTP)
+	assert.Len(t, newCodes, admin.RecoveryCodeCount)
+	assert.ErrorIs(t, u.Authenticate(testPassword, oldCodes[0], hasher, later), admin.ErrInvalidOTP)
+	assert.NoError(t, u.Authenticate(testPassword, newCodes[0], hasher, later))
+}
+
+// ===========================
+// Stubs
+// ===========================
+
+// StubPasswordHasher 測試用雜湊（不做實際運算）
+type StubPasswordHasher struct{}
+
+func (StubPasswordHasher) Hash(password string) (string, error) {
+	return "hashed:" + password, nil
+}
+
+func (StubPasswordHasher) Verify(hash, password string) bool {
+	return hash == "hashed:"+password
+}
@@ -0,0 +1,240 @@
+package admin
+
+import "fmt"
+
+// ===========================
+// 錯誤代碼定義
+// ===========================
+
+// ErrorCode 錯誤代碼類型
+type ErrorCode string
+
+// 錯誤代碼常量
+const (
+	// 識別符相關
+	ErrCodeInvalidAdminUserID ErrorCode = "ADMIN_USER_ID_INVALID"
+	ErrCodeInvalidSessionID   ErrorCode = "ADMIN_SESSION_ID_INVALID"
+
+	// 管理員帳號相關
+	ErrCodeAdminUserNotFound ErrorCode = "ADMIN_USER_NOT_FOUND"
+	ErrCodeInvalidUsername   ErrorCode = "ADMIN_USERNAME_INVALID"
+	ErrCodeUsernameTaken     ErrorCode = "ADMIN_USERNAME_TAKEN"
+	ErrCodeInvalidRole       ErrorCode = "ADMIN_ROLE_INVALID"
+	ErrCodeWeakPassword      ErrorCode = "ADMIN_PASSWORD_WEAK"
+	ErrCodeAdminDisabled     ErrorCode = "ADMIN_USER_DISABLED"
+
+	// 並行修改（樂觀鎖）
+	ErrCodeAdminVersionConflict ErrorCode = "ADMIN_USER_VERSION_CONFLICT"
+
+	// 登入 / 工作階段相關
+	ErrCodeInvalidCredentials ErrorCode = "ADMIN_CREDENTIALS_INVALID"
+	ErrCodeAccountLocked      ErrorCode = "ADMIN_ACCOUNT_LOCKED"
+	ErrCodeUnauthenticated    ErrorCode = "ADMIN_UNAUTHENTICATED"
+	ErrCodeSessionExpired     ErrorCode = "ADMIN_SESSION_EXPIRED"
+	ErrCodeSessionRevoked     ErrorCode = "ADMIN_SESSION_REVOKED"
+
+	// 兩步驟驗證相關
+	ErrCodeTwoFactorRequired           ErrorCode = "ADMIN_TWO_FACTOR_REQUIRED"
+	ErrCodeTwoFactorEnrollmentRequired ErrorCode = "ADMIN_TWO_FACTOR_ENROLLMENT_REQUIRED"
+	ErrCodeTwoFactorAlreadyEnabled     ErrorCode = "ADMIN_TWO_FACTOR_ALREADY_ENABLED"
+	ErrCodeTwoFactorNotEnabled         ErrorCode = "ADMIN_TWO_FACTOR_NOT_ENABLED"
+	ErrCodeTwoFactorNotPending         ErrorCode = "ADMIN_TWO_FACTOR_NOT_PENDING"
+	ErrCodeInvalidOTP                  ErrorCode = "ADMIN_OTP_INVALID"
+	ErrCodeInvalidTOTPSecret           ErrorCode = "ADMIN_TOTP_SECRET_INVALID"
+
+	// 授權相關
+	ErrCodePermissionDenied ErrorCode = "ADMIN_PERMISSION_DENIED"
+)
+
+// ===========================
+// DomainError 結構
+// ===========================
+
+// DomainError 管理員領域錯誤
+// 設計原則：
+// 1. 包含結構化的錯誤代碼（用於 HTTP 狀態碼映射）
+// 2. 支持上下文信息（用於調試和日誌）
+// 3. 不可變性（創建後不可修改）
+type DomainError struct {
+	Code    ErrorCode
+	Message string
+	Context map[string]interface{}
+}
+
+// Error 實現 error 接口
+func (e *DomainError) Error() string {
+	if len(e.Context) == 0 {
+		return fmt.Sprintf("[%s] %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("[%s] %s (context: %+v)", e.Code, e.Message, e.Context)
+}
+
+// WithContext 添加上下文信息（返回新的錯誤實例，保持不可變性）
+func (e *DomainError) WithContext(keyValues ...interface{}) error {
+	if len(keyValues)%2 != 0 {
+		panic("WithContext requires even number of arguments (key-value pairs)")
+	}
+
+	ctx := make(map[string]interface{}, len(e.Context)+len(keyValues)/2)
+
+	// 複製現有上下文
+	for k, v := range e.Context {
+		ctx[k] = v
+	}
+
+	// 添加新上下文
+	for i := 0; i < len(keyValues); i += 2 {
+		key, ok := keyValues[i].(string)
+		if !ok {
+			panic(fmt.Sprintf("context key must be string, got %T", keyValues[i]))
+		}
+		ctx[key] = keyValues[i+1]
+	}
+
+	return &DomainError{
+		Code:    e.Code,
+		Message: e.Message,
+		Context: ctx,
+	}
+}
+
+// Is 實現 errors.Is 接口（用於錯誤類型判斷）
+func (e *DomainError) Is(target error) bool {
+	t, ok := target.(*DomainError)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+// ===========================
+// 預定義錯誤
+// ===========================
+
+// 識別符相關錯誤
+var (
+	ErrInvalidAdminUserID = &DomainError{
+		Code:    ErrCodeInvalidAdminUserID,
+		Message: "無效的管理員 ID",
+	}
+
+	ErrInvalidSessionID = &DomainError{
+		Code:    ErrCodeInvalidSessionID,
+		Message: "無效的工作階段 ID",
+	}
+)
+
+// 管理員帳號相關錯誤
+var (
+	ErrAdminUserNotFound = &DomainError{
+		Code:    ErrCodeAdminUserNotFound,
+		Message: "管理員不存在",
+	}
+
+	ErrInvalidUsername = &DomainError{
+		Code:    ErrCodeInvalidUsername,
+		Message: "帳號須為 3-100 字的英數字、點、底線、連字號或 @",
+	}
+
+	ErrUsernameTaken = &DomainError{
+		Code:    ErrCodeUsernameTaken,
+		Message: "帳號已被使用",
+	}
+
+	ErrInvalidRole = &DomainError{
+		Code:    ErrCodeInvalidRole,
+		Message: "無效的管理員角色",
+	}
+
+	ErrWeakPassword = &DomainError{
+		Code:    ErrCodeWeakPassword,
+		Message: "密碼長度須為 12-72 bytes",
+	}
+
+	ErrAdminDisabled = &DomainError{
+		Code:    ErrCodeAdminDisabled,
+		Message: "管理員帳號已停用",
+	}
+
+	// ErrAdminVersionConflict 管理員帳號已被其他操作更新（樂觀鎖衝突）
+	//
+	// 處理方式：重新載入帳號後再試一次（例如同時送出的登入請求）
+	ErrAdminVersionConflict = &DomainError{
+		Code:    ErrCodeAdminVersionConflict,
+		Message: "管理員帳號已被其他操作更新，請重新再試",
+	}
+)
+
+// 登入 / 工作階段相關錯誤
+var (
+	ErrInvalidCredentials = &DomainError{
+		Code:    ErrCodeInvalidCredentials,
+		Message: "帳號或密碼錯誤",
+	}
+
+	ErrAccountLocked = &DomainError{
+		Code:    ErrCodeAccountLocked,
+		Message: "登入失敗次數過多，帳號暫時鎖定",
+	}
+
+	ErrUnauthenticated = &DomainError{
+		Code:    ErrCodeUnauthenticated,
+		Message: "尚未登入或登入憑證無效",
+	}
+
+	ErrSessionExpired = &DomainError{
+		Code:    ErrCodeSessionExpired,
+		Message: "登入已過期，請重新登入",
+	}
+
+	ErrSessionRevoked = &DomainError{
+		Code:    ErrCodeSessionRevoked,
+		Message: "登入已被撤銷，請重新登入",
+	}
+)
+
+// 兩步驟驗證相關錯誤
+var (
+	ErrTwoFactorRequired = &DomainError{
+		Code:    ErrCodeTwoFactorRequired,
+		Message: "需要輸入兩步驟驗證碼",
+	}
+
+	ErrTwoFactorEnrollmentRequired = &DomainError{
+		Code:    ErrCodeTwoFactorEnrollmentRequired,
+		Message: "此角色須先設定兩步驟驗證",
+	}
+
+	ErrTwoFactorAlreadyEnabled = &DomainError{
+		Code:    ErrCodeTwoFactorAlreadyEnabled,
+		Message: "已啟用兩步驟驗證",
+	}
+
+	ErrTwoFactorNotEnabled = &DomainError{
+		Code:    ErrCodeTwoFactorNotEnabled,
+		Message: "尚未啟用兩步驟驗證",
+	}
+
+	ErrTwoFactorNotPending = &DomainError{
+		Code:    ErrCodeTwoFactorNotPending,
+		Message: "沒有進行中的兩步驟驗證設定",
+	}
+
+	ErrInvalidOTP = &DomainError{
+		Code:    ErrCodeInvalidOTP,
+		Message: "驗證碼錯誤或已使用",
+	}
+
+	ErrInvalidTOTPSecret = &DomainError{
+		Code:    ErrCodeInvalidTOTPSecret,
+		Message: "無效的 TOTP 金鑰",
+	}
+)
+
+// 授權相關錯誤
+var (
+	ErrPermissionDenied = &DomainError{
+		Code:    ErrCodePermissionDenied,
+		Message: "權限不足",
+	}
+)
@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// 實體 ID 類型定義
+// ===========================
+
+// 設計原則：使用泛型 EntityID[T]（與其他上下文一致）
+
+// ===========================
+// AdminUserID - 管理員 ID
+// ===========================
+
+// AdminUserMarker 是 AdminUserID 的標記類型
+type AdminUserMarker struct{}
+
+// AdminUserID 管理員的唯一標識符
+type AdminUserID = shared.EntityID[AdminUserMarker]
+
+// NewAdminUserID 生成新的管理員 ID（UUID v4）
+func NewAdminUserID() AdminUserID {
+	return shared.NewEntityID[AdminUserMarker]()
+}
+
+// AdminUserIDFromString 從字串解析管理員 ID
+//
+// 返回：
+//   AdminUserID - 解析成功的 ID
+//   error - 解析失敗（返回 ErrInvalidAdminUserID）
+func AdminUserIDFromString(s string) (AdminUserID, error) {
+	return shared.EntityIDFromString[AdminUserMarker](s, ErrInvalidAdminUserID)
+}
+
+// ===========================
+// SessionID - 登入工作階段 ID
+// ===========================
+
+// SessionMarker 是 SessionID 的標記類型
+type SessionMarker struct{}
+
+// SessionID 登入工作階段的唯一標識符
+type SessionID = shared.EntityID[SessionMarker]
+
+// NewSessionID 生成新的工作階段 ID（UUID v4）
+func NewSessionID() SessionID {
+	return shared.NewEntityID[SessionMarker]()
+}
+
+// SessionIDFromString 從字串解析工作階段 ID
+func SessionIDFromString(s string) (SessionID, error) {
+	return shared.EntityIDFromString[SessionMarker](s, ErrInvalidSessionID)
+}
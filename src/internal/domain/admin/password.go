@@ -0,0 +1,38 @@
+package admin
+
+// ===========================
+// 密碼
+// ===========================
+
+// 密碼規則
+const (
+	minPasswordLength = 12
+	maxPasswordLength = 72 // bcrypt 僅使用前 72 bytes，超過的部分會被忽略
+)
+
+// PasswordHasher 密碼雜湊介面
+//
+// 設計原則：
+// - 領域層只保存雜湊值，不接觸演算法細節
+// - 雜湊值需自帶 salt 與成本參數（例如 bcrypt 的 "$2a$12$..."）
+//
+// 實作：infrastructure/security.BcryptPasswordHasher
+type PasswordHasher interface {
+	// Hash 計算密碼雜湊
+	Hash(password string) (string, error)
+
+	// Verify 比對密碼與雜湊值（需以固定時間比較）
+	Verify(hash, password string) bool
+}
+
+// ValidatePassword 檢查密碼是否符合規則
+//
+// 規則：長度 12-72 bytes
+//
+// 錯誤：不符合規則 → ErrWeakPassword
+func ValidatePassword(password string) error {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return ErrWeakPassword.WithContext("length", len(password))
+	}
+	return nil
+}
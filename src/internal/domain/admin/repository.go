@@ -0,0 +1,62 @@
+package admin
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// AdminUser Repository 介面
+// ===========================
+
+// AdminUserRepository 管理員帳號倉儲介面
+//
+// 設計原則：
+// 1. Save 為 Upsert（建立新帳號）；修改已存在的帳號一律使用 Update（樂觀鎖）
+// 2. 帳號唯一（username）
+// 3. 事務支持：寫操作 ctx 必須 non-nil，讀操作 ctx 可為 nil
+type AdminUserRepository interface {
+	// Save 保存管理員帳號（新增或更新）
+	Save(ctx shared.TransactionContext, user *AdminUser) error
+
+	// Update 更新已存在的管理員帳號（樂觀鎖）
+	//
+	// 業務規則：
+	// - 以 admin_id 與 PersistedVersion() 為條件更新，寫入新的 Version()
+	// - 版本號不符（已被其他操作更新，例如並行的登入請求）→ ErrAdminVersionConflict
+	// - 帳號不存在 → ErrAdminUserNotFound
+	Update(ctx shared.TransactionContext, user *AdminUser) error
+
+	// FindByID 根據 ID 查詢管理員帳號
+	//
+	// 返回：找到的帳號，或 ErrAdminUserNotFound
+	FindByID(ctx shared.TransactionContext, adminID AdminUserID) (*AdminUser, error)
+
+	// FindByUsername 根據帳號（小寫）查詢管理員
+	//
+	// 返回：找到的帳號，或 ErrAdminUserNotFound
+	FindByUsername(ctx shared.TransactionContext, username string) (*AdminUser, error)
+
+	// ExistsByUsername 檢查帳號是否已被使用
+	ExistsByUsername(ctx shared.TransactionContext, username string) (bool, error)
+
+	// Count 管理員帳號總數（含停用帳號）
+	Count(ctx shared.TransactionContext) (int, error)
+}
+
+// ===========================
+// Session Repository 介面
+// ===========================
+
+// SessionRepository 登入工作階段倉儲介面
+type SessionRepository interface {
+	// Save 保存工作階段（新增或更新）
+	Save(ctx shared.TransactionContext, session *Session) error
+
+	// FindByTokenHash 根據 Token 雜湊查詢工作階段
+	//
+	// 返回：找到的工作階段，或 ErrUnauthenticated
+	FindByTokenHash(ctx shared.TransactionContext, tokenHash string) (*Session, error)
+
+	// FindActiveByAdminID 查詢管理員尚未撤銷的工作階段（含已過期）
+	FindActiveByAdminID(ctx shared.TransactionContext, adminID AdminUserID) ([]*Session, error)
+}
@@ -0,0 +1,151 @@
+package admin
+
+// ===========================
+// Role 管理員角色
+// ===========================
+
+// Role 管理員角色（決定可執行的操作）
+//
+// 角色說明：
+// - owner: 店主，完整權限（含調整積分、管理後台帳號）
+// - manager: 店長，日常營運（會員、交易審核、問卷、群發、申請積分調整）
+// - staff: 店員，查詢會員與積分、現場查驗證件確認會員成年、申請積分調整、兌換獎勵
+// - auditor: 稽核，唯讀所有資料（含匯出），不可修改
+type Role string
+
+const (
+	RoleOwner   Role = "owner"
+	RoleManager Role = "manager"
+	RoleStaff   Role = "staff"
+	RoleAuditor Role = "auditor"
+)
+
+// ParseRole 從字串解析角色
+//
+// 錯誤：未知角色 → ErrInvalidRole
+func ParseRole(s string) (Role, error) {
+	role := Role(s)
+	if !role.IsValid() {
+		return "", ErrInvalidRole.WithContext("role", s)
+	}
+	return role, nil
+}
+
+// String 返回角色字串
+func (r Role) String() string {
+	return string(r)
+}
+
+// IsValid 判斷角色是否有效
+func (r Role) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// RequiresTwoFactor 判斷角色是否必須啟用兩步驟驗證
+//
+// 業務規則：owner（可調整積分）與 manager（可凍結帳戶、核准交易）必須啟用
+func (r Role) RequiresTwoFactor() bool {
+	return r == RoleOwner || r == RoleManager
+}
+
+// Can 判斷角色是否具備權限
+func (r Role) Can(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Permissions 返回角色具備的權限（副本）
+func (r Role) Permissions() []Permission {
+	permissions := make([]Permission, len(rolePermissions[r]))
+	copy(permissions, rolePermissions[r])
+	return permissions
+}
+
+// ===========================
+// Permission 權限
+// ===========================
+
+// Permission 管理後台操作權限（格式：resource:action）
+type Permission string
+
+const (
+	PermissionViewMembers   Permission = "members:read"
+	PermissionManageMembers Permission = "members:write"      // 凍結 / 解凍帳戶、更換 / 解除手機綁定等
+	PermissionAdjustPoints  Permission = "points:adjust"      // 核准 / 駁回積分調整、合併重複會員（僅 owner）
+	PermissionVerifyAge     Permission = "members:verify_age" // 查驗證件後標記會員已成年（含店員）
+
+	// PermissionRequestPointsAdjustment 申請積分調整（含店員；非 owner 的申請一律需 owner 核准後才入帳），
+	// 並可查詢、撤回自己的申請
+	PermissionRequestPointsAdjustment Permission = "points:request_adjustment"
+
+	PermissionViewTransactions   Permission = "transactions:read"
+	PermissionReviewTransactions Permission = "transactions:review" // 詐騙案件 / iChef 差異審核
+
+	PermissionViewSurveys   Permission = "surveys:read"
+	PermissionManageSurveys Permission = "surveys:write"
+	PermissionExportData    Permission = "data:export"
+
+	PermissionViewBroadcasts   Permission = "broadcasts:read"
+	PermissionManageBroadcasts Permission = "broadcasts:write"
+
+	// PermissionViewConversionRules 查詢積分轉換規則（規則由 POINTS_CONVERSION_RATE 設定，不提供線上變更）
+	PermissionViewConversionRules Permission = "conversion_rules:read"
+
+	PermissionViewRewards   Permission = "rewards:read"
+	PermissionManageRewards Permission = "rewards:write"  // 新增兌換目錄中的獎勵
+	PermissionRedeemRewards Permission = "rewards:redeem" // 現場為會員兌換獎勵（含店員；酒類獎勵需已驗證成年）
+
+	PermissionManageAdmins Permission = "admins:write" // 建立 / 停用後台帳號（僅 owner）
+)
+
+// String 返回權限字串
+func (p Permission) String() string {
+	return string(p)
+}
+
+// rolePermissions 角色權限對照表
+//
+// 業務規則：
+// - 只有 owner 可以核准積分調整與管理後台帳號
+// - owner / manager / staff 皆可申請積分調整（owner 門檻內立即入帳；其他申請人一律待 owner 核准）
+var rolePermissions = map[Role][]Permission{
+	RoleOwner: {
+		PermissionViewMembers, PermissionManageMembers, PermissionAdjustPoints, PermissionVerifyAge,
+		PermissionRequestPointsAdjustment,
+		PermissionViewTransactions, PermissionReviewTransactions,
+		PermissionViewSurveys, PermissionManageSurveys, PermissionExportData,
+		PermissionViewBroadcasts, PermissionManageBroadcasts,
+		PermissionViewConversionRules,
+		PermissionViewRewards, PermissionManageRewards, PermissionRedeemRewards,
+		PermissionManageAdmins,
+	},
+	RoleManager: {
+		PermissionViewMembers, PermissionManageMembers, PermissionVerifyAge,
+		PermissionRequestPointsAdjustment,
+		PermissionViewTransactions, PermissionReviewTransactions,
+		PermissionViewSurveys, PermissionManageSurveys, PermissionExportData,
+		PermissionViewBroadcasts, PermissionManageBroadcasts,
+		PermissionViewConversionRules,
+		PermissionViewRewards, PermissionManageRewards, PermissionRedeemRewards,
+	},
+	RoleStaff: {
+		PermissionViewMembers, PermissionVerifyAge,
+		PermissionRequestPointsAdjustment,
+		PermissionViewSurveys,
+		PermissionViewConversionRules,
+		PermissionViewRewards, PermissionRedeemRewards,
+	},
+	RoleAuditor: {
+		PermissionViewMembers,
+		PermissionViewTransactions,
+		PermissionViewSurveys, PermissionExportData,
+		PermissionViewBroadcasts,
+		PermissionViewConversionRules,
+		PermissionViewRewards,
+	},
+}
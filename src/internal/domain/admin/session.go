@@ -0,0 +1,184 @@
+package admin
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// DefaultSessionTTL 工作階段預設有效期
+const DefaultSessionTTL = 12 * time.Hour
+
+// sessionTokenBytes 工作階段 Token 隨機位元組數（256 bits）
+const sessionTokenBytes = 32
+
+// ===========================
+// Session 聚合根
+// ===========================
+
+// Session 管理員登入工作階段
+//
+// 職責：
+// - 對應一組 Bearer Token（登入時發放）
+// - 記錄到期時間與撤銷時間（登出、停用帳號時撤銷）
+// - 記錄是否已通過兩步驟驗證（登入時驗證，或於此工作階段中完成設定）
+//
+// 設計原則：
+// - Token 為不透明隨機字串，僅回傳給用戶端一次
+// - 只保存 Token 的 SHA-256 雜湊（資料庫外洩時無法直接冒用）
+type Session struct {
+	sessionID SessionID
+	adminID   AdminUserID
+	tokenHash string
+	issuedAt  time.Time
+	expiresAt time.Time
+	revokedAt *time.Time
+
+	twoFactorVerified bool
+}
+
+// IssueSession 為管理員發放新的工作階段
+//
+// 參數：
+//   twoFactorVerified - 登入時是否已通過兩步驟驗證
+//   ttl - 有效期（<= 0 時使用 DefaultSessionTTL）
+//
+// 返回：
+//   *Session - 新工作階段
+//   string - 明文 Token（僅此一次，需回傳給用戶端）
+func IssueSession(
+	adminID AdminUserID,
+	twoFactorVerified bool,
+	ttl time.Duration,
+	now time.Time,
+) (*Session, string, error) {
+	if ttl <= 0 {
+		ttl = DefaultSessionTTL
+	}
+
+	raw := make([]byte, sessionTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("failed to generate session token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	return &Session{
+		sessionID: NewSessionID(),
+		adminID:   adminID,
+		tokenHash: HashSessionToken(token),
+		issuedAt:  now,
+		expiresAt: now.Add(ttl),
+
+		twoFactorVerified: twoFactorVerified,
+	}, token, nil
+}
+
+// ReconstructSession 從持久化存儲重建工作階段
+//
+// 設計原則：僅供 Repository 使用
+func ReconstructSession(
+	sessionID SessionID,
+	adminID AdminUserID,
+	tokenHash string,
+	issuedAt time.Time,
+	expiresAt time.Time,
+	revokedAt *time.Time,
+	twoFactorVerified bool,
+) *Session {
+	return &Session{
+		sessionID: sessionID,
+		adminID:   adminID,
+		tokenHash: tokenHash,
+		issuedAt:  issuedAt,
+		expiresAt: expiresAt,
+		revokedAt: revokedAt,
+
+		twoFactorVerified: twoFactorVerified,
+	}
+}
+
+// HashSessionToken 計算 Token 雜湊（查詢工作階段用）
+func HashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// ===========================
+// 業務方法
+// ===========================
+
+// Validate 檢查工作階段在指定時間是否有效
+//
+// 錯誤：
+// - 已撤銷 → ErrSessionRevoked
+// - 已過期 → ErrSessionExpired
+func (s *Session) Validate(now time.Time) error {
+	if s.revokedAt != nil {
+		return ErrSessionRevoked.WithContext("session_id", s.sessionID.String())
+	}
+	if !now.Before(s.expiresAt) {
+		return ErrSessionExpired.WithContext(
+			"session_id", s.sessionID.String(),
+			"expires_at", s.expiresAt,
+		)
+	}
+	return nil
+}
+
+// Revoke 撤銷工作階段
+//
+// 返回：是否有變更（已撤銷時返回 false）
+func (s *Session) Revoke(now time.Time) bool {
+	if s.revokedAt != nil {
+		return false
+	}
+	s.revokedAt = &now
+	return true
+}
+
+// MarkTwoFactorVerified 標記已通過兩步驟驗證（於此工作階段中完成設定時）
+func (s *Session) MarkTwoFactorVerified() {
+	s.twoFactorVerified = true
+}
+
+// ===========================
+// Getters
+// ===========================
+
+// SessionID 工作階段 ID
+func (s *Session) SessionID() SessionID {
+	return s.sessionID
+}
+
+// AdminID 管理員 ID
+func (s *Session) AdminID() AdminUserID {
+	return s.adminID
+}
+
+// TokenHash Token 雜湊值
+func (s *Session) TokenHash() string {
+	return s.tokenHash
+}
+
+// IssuedAt 發放時間
+func (s *Session) IssuedAt() time.Time {
+	return s.issuedAt
+}
+
+// ExpiresAt 到期時間
+func (s *Session) ExpiresAt() time.Time {
+	return s.expiresAt
+}
+
+// RevokedAt 撤銷時間（未撤銷時為 nil）
+func (s *Session) RevokedAt() *time.Time {
+	return s.revokedAt
+}
+
+// TwoFactorVerified 是否已通過兩步驟驗證
+func (s *Session) TwoFactorVerified() bool {
+	return s.twoFactorVerified
+}
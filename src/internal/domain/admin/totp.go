@@ -0,0 +1,188 @@
+package admin
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP 參數（RFC 6238 預設值，Google Authenticator 等 App 皆支援）
+const (
+	totpDigits      = 6
+	totpPeriod      = 30 // 秒
+	totpSecretBytes = 20 // 160 bits（RFC 4226 建議）
+
+	// TOTPSkewSteps 驗證時容許的時間誤差（前後各 1 個 30 秒區間）
+	TOTPSkewSteps = 1
+)
+
+// 復原碼參數
+const (
+	// RecoveryCodeCount 每次產生的復原碼數量
+	RecoveryCodeCount = 10
+
+	recoveryCodeLength = 10 // base32 字元數（50 bits）
+)
+
+// totpEncoding Base32（無 padding，Authenticator App 的標準格式）
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// ===========================
+// TOTPSecret 值對象
+// ===========================
+
+// TOTPSecret TOTP 共享金鑰（RFC 6238，HMAC-SHA1、6 位數、30 秒）
+//
+// 設計原則：
+// - 以 Base32 字串保存與顯示（Authenticator App 手動輸入格式）
+// - 不可變
+type TOTPSecret struct {
+	key []byte
+}
+
+// GenerateTOTPSecret 產生隨機 TOTP 金鑰
+func GenerateTOTPSecret() (TOTPSecret, error) {
+	key := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(key); err != nil {
+		return TOTPSecret{}, fmt.Errorf("failed to generate TOTP secret: %w", err)
+	}
+	return TOTPSecret{key: key}, nil
+}
+
+// TOTPSecretFromBase32 從 Base32 字串解析 TOTP 金鑰（不區分大小寫，忽略空白與 padding）
+//
+// 錯誤：格式錯誤 → ErrInvalidTOTPSecret
+func TOTPSecretFromBase32(s string) (TOTPSecret, error) {
+	normalized := strings.ToUpper(strings.TrimRight(strings.ReplaceAll(s, " ", ""), "="))
+	key, err := totpEncoding.DecodeString(normalized)
+	if err != nil || len(key) == 0 {
+		return TOTPSecret{}, ErrInvalidTOTPSecret
+	}
+	return TOTPSecret{key: key}, nil
+}
+
+// Base32 返回 Base32 字串
+func (s TOTPSecret) Base32() string {
+	return totpEncoding.EncodeToString(s.key)
+}
+
+// IsZero 判斷是否為空金鑰
+func (s TOTPSecret) IsZero() bool {
+	return len(s.key) == 0
+}
+
+// ProvisioningURI 產生 otpauth:// URI（轉成 QR Code 供 Authenticator App 掃描）
+//
+// 格式：otpauth://totp/{issuer}:{account}?secret=...&issuer=...&algorithm=SHA1&digits=6&period=30
+func (s TOTPSecret) ProvisioningURI(issuer, accountName string) string {
+	query := url.Values{}
+	query.Set("secret", s.Base32())
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(totpPeriod))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + accountName,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
+
+// CodeAt 計算指定時間的驗證碼
+func (s TOTPSecret) CodeAt(t time.Time) string {
+	return s.codeForStep(totpStep(t))
+}
+
+// match 驗證驗證碼（容許前後 skew 個時間區間）
+//
+// 返回：符合的時間區間編號（防止重放用），以及是否符合
+func (s TOTPSecret) match(code string, now time.Time, skew int) (int64, bool) {
+	code = strings.ReplaceAll(code, " ", "")
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	current := totpStep(now)
+	for offset := -int64(skew); offset <= int64(skew); offset++ {
+		step := current + offset
+		if subtle.ConstantTimeCompare([]byte(s.codeForStep(step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// codeForStep HOTP（RFC 4226）：HMAC-SHA1 + dynamic truncation
+func (s TOTPSecret) codeForStep(step int64) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+
+	mac := hmac.New(sha1.New, s.key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
+
+// totpStep 時間區間編號（Unix 秒 / 30）
+func totpStep(t time.Time) int64 {
+	return t.Unix() / totpPeriod
+}
+
+// ===========================
+// 復原碼
+// ===========================
+
+// GenerateRecoveryCodes 產生一次性復原碼
+//
+// 返回：
+//   codes - 明文復原碼（格式 xxxxx-xxxxx，僅顯示給管理員這一次）
+//   hashes - 雜湊值（保存用）
+func GenerateRecoveryCodes() (codes []string, hashes []string, err error) {
+	codes = make([]string, 0, RecoveryCodeCount)
+	hashes = make([]string, 0, RecoveryCodeCount)
+	for i := 0; i < RecoveryCodeCount; i++ {
+		raw := make([]byte, 8)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		encoded := strings.ToLower(totpEncoding.EncodeToString(raw))[:recoveryCodeLength]
+		code := encoded[:recoveryCodeLength/2] + "-" + encoded[recoveryCodeLength/2:]
+		codes = append(codes, code)
+		hashes = append(hashes, HashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode 計算復原碼雜湊（忽略大小寫、空白與連字號）
+//
+// 設計原則：復原碼為 50 bits 隨機值，SHA-256 即足以防止資料庫外洩後被還原
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// isRecoveryCodeFormat 判斷輸入是否為復原碼格式（非 6 位數字）
+func isRecoveryCodeFormat(code string) bool {
+	normalized := strings.NewReplacer("-", "", " ", "").Replace(code)
+	return len(normalized) == recoveryCodeLength
+}
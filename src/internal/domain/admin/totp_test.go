@@ -0,0 +1,83 @@
+package admin_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret RFC 6238 附錄 B 測試金鑰（ASCII "12345678901234567890"）
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// Test 1: RFC 6238 測試向量（SHA1，取末 6 位數）
+func TestTOTPSecret_RFC6238Vectors(t *testing.T) {
+	// Arrange
+	secret, err := admin.TOTPSecretFromBase32(strings.ToLower(rfcSecret))
+	require.NoError(t, err)
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+
+	// Act & Assert
+	for unix, expected := range vectors {
+		assert.Equal(t, expected, secret.CodeAt(time.Unix(unix, 0)), "T=%d", unix)
+	}
+	assert.Equal(t, rfcSecret, secret.Base32())
+}
+
+// Test 2: Provisioning URI（otpauth 格式）與金鑰解析
+func TestTOTPSecret_ProvisioningURI(t *testing.T) {
+	// Arrange
+	secret, err := admin.TOTPSecretFromBase32(rfcSecret)
+	require.NoError(t, err)
+
+	// Act
+	uri, err := url.Parse(secret.ProvisioningURI("Bar CRM", "owner@bar"))
+	require.NoError(t, err)
+	_, invalid := admin.TOTPSecretFromBase32("not base32!")
+	generated, err := admin.GenerateTOTPSecret()
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "totp", uri.Host)
+	assert.Equal(t, "/Bar CRM:owner@bar", uri.Path)
+	assert.Equal(t, rfcSecret, uri.Query().Get("secret"))
+	assert.Equal(t, "Bar CRM", uri.Query().Get("issuer"))
+	assert.Equal(t, "SHA1", uri.Query().Get("algorithm"))
+	assert.Equal(t, "6", uri.Query().Get("digits"))
+	assert.Equal(t, "30", uri.Query().Get("period"))
+	assert.ErrorIs(t, invalid, admin.ErrInvalidTOTPSecret)
+	assert.Len(t, generated.Base32(), 32)
+}
+
+// Test 3: 復原碼（數量、格式；雜湊忽略大小寫與連字號）
+func TestGenerateRecoveryCodes(t *testing.T) {
+	// Act
+	codes, hashes, err := admin.GenerateRecoveryCodes()
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, codes, admin.RecoveryCodeCount)
+	require.Len(t, hashes, admin.RecoveryCodeCount)
+	seen := make(map[string]bool)
+	for i, code := range codes {
+		assert.Len(t, code, 11)
+		assert.Equal(t, "-", code[5:6])
+		assert.Equal(t, hashes[i], admin.HashRecoveryCode(code))
+		assert.Equal(t, hashes[i], admin.HashRecoveryCode(strings.ToUpper(strings.ReplaceAll(code, "-", ""))))
+		assert.NotContains(t, hashes[i], code)
+		seen[code] = true
+	}
+	assert.Len(t, seen, admin.RecoveryCodeCount)
+}
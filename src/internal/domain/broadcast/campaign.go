@@ -0,0 +1,239 @@
+package broadcast
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ===========================
+// Campaign 聚合根
+// ===========================
+
+// Campaign 群發活動（目標分眾 + 訊息內容 + 預定發送時間）
+//
+// 職責：
+// - 記錄目標分眾與訊息內容（建立後不可修改，需調整時取消後重建）
+// - 管理活動狀態（scheduled → sending → completed / cancelled）
+// - 完成時記錄投遞統計
+//
+// 設計原則：
+// - 收件者名單於開始發送時建立快照（Recipient），之後新增的符合條件會員不會收到
+// - 投遞進度記錄在 Recipient（每批提交），行程重啟後可從未完成的批次續傳
+type Campaign struct {
+	campaignID  CampaignID
+	name        string
+	segment     Segment
+	message     MessageTemplate
+	status      CampaignStatus
+	scheduledAt time.Time
+	createdBy   string
+	summary     DeliverySummary
+	startedAt   *time.Time
+	completedAt *time.Time
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewCampaign 創建群發活動
+//
+// 參數：
+//   scheduledAt - 預定發送時間（立即發送時傳入 now）
+//   createdBy - 建立者（管理員帳號）
+//
+// 錯誤：
+// - 名稱空白或超過 100 字 → ErrInvalidCampaignName
+// - 預定時間早於現在 → ErrInvalidSchedule
+func NewCampaign(
+	name string,
+	segment Segment,
+	message MessageTemplate,
+	scheduledAt time.Time,
+	createdBy string,
+	now time.Time,
+) (*Campaign, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxCampaignNameLength {
+		return nil, ErrInvalidCampaignName.WithContext("name", name)
+	}
+	if scheduledAt.Before(now) {
+		return nil, ErrInvalidSchedule.WithContext(
+			"scheduled_at", scheduledAt,
+			"now", now,
+		)
+	}
+
+	return &Campaign{
+		campaignID:  NewCampaignID(),
+		name:        name,
+		segment:     segment,
+		message:     message,
+		status:      CampaignStatusScheduled,
+		scheduledAt: scheduledAt,
+		createdBy:   createdBy,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// ReconstructCampaign 從持久化存儲重建群發活動
+//
+// 設計原則：僅供 Repository 使用
+func ReconstructCampaign(
+	campaignID CampaignID,
+	name string,
+	segment Segment,
+	message MessageTemplate,
+	status CampaignStatus,
+	scheduledAt time.Time,
+	createdBy string,
+	summary DeliverySummary,
+	startedAt, completedAt *time.Time,
+	createdAt, updatedAt time.Time,
+) (*Campaign, error) {
+	if !status.IsValid() {
+		return nil, ErrInvalidCampaignState.WithContext(
+			"campaign_id", campaignID.String(),
+			"status", status.String(),
+		)
+	}
+
+	return &Campaign{
+		campaignID:  campaignID,
+		name:        name,
+		segment:     segment,
+		message:     message,
+		status:      status,
+		scheduledAt: scheduledAt,
+		createdBy:   createdBy,
+		summary:     summary,
+		startedAt:   startedAt,
+		completedAt: completedAt,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}, nil
+}
+
+// ===========================
+// 命令方法
+// ===========================
+
+// StartAt 開始發送（呼叫端同時建立收件者名單快照）
+//
+// 業務規則：僅已到預定時間的 scheduled 活動可開始
+func (c *Campaign) StartAt(now time.Time) error {
+	if !c.IsDueAt(now) {
+		return ErrInvalidCampaignState.WithContext(
+			"campaign_id", c.campaignID.String(),
+			"status", c.status.String(),
+			"scheduled_at", c.scheduledAt,
+			"operation", "start",
+		)
+	}
+	c.status = CampaignStatusSending
+	c.startedAt = &now
+	c.updatedAt = now
+	return nil
+}
+
+// CompleteAt 完成發送並記錄投遞統計
+//
+// 業務規則：僅 sending 狀態且所有收件者皆已處理（無 pending）時可完成
+func (c *Campaign) CompleteAt(summary DeliverySummary, now time.Time) error {
+	if c.status != CampaignStatusSending || summary.Pending > 0 {
+		return ErrInvalidCampaignState.WithContext(
+			"campaign_id", c.campaignID.String(),
+			"status", c.status.String(),
+			"pending", summary.Pending,
+			"operation", "complete",
+		)
+	}
+	c.status = CampaignStatusCompleted
+	c.summary = summary
+	c.completedAt = &now
+	c.updatedAt = now
+	return nil
+}
+
+// CancelAt 取消活動（尚未送出的收件者不再發送）
+func (c *Campaign) CancelAt(now time.Time) error {
+	if c.status != CampaignStatusScheduled && c.status != CampaignStatusSending {
+		return ErrInvalidCampaignState.WithContext(
+			"campaign_id", c.campaignID.String(),
+			"status", c.status.String(),
+			"operation", "cancel",
+		)
+	}
+	c.status = CampaignStatusCancelled
+	c.updatedAt = now
+	return nil
+}
+
+// ===========================
+// 查詢方法
+// ===========================
+
+// IsDueAt 是否已到預定發送時間（僅 scheduled 狀態）
+func (c *Campaign) IsDueAt(now time.Time) bool {
+	return c.status == CampaignStatusScheduled && !now.Before(c.scheduledAt)
+}
+
+// CampaignID 活動 ID
+func (c *Campaign) CampaignID() CampaignID {
+	return c.campaignID
+}
+
+// Name 活動名稱
+func (c *Campaign) Name() string {
+	return c.name
+}
+
+// Segment 目標分眾條件
+func (c *Campaign) Segment() Segment {
+	return c.segment
+}
+
+// Message 訊息內容
+func (c *Campaign) Message() MessageTemplate {
+	return c.message
+}
+
+// Status 活動狀態
+func (c *Campaign) Status() CampaignStatus {
+	return c.status
+}
+
+// ScheduledAt 預定發送時間
+func (c *Campaign) ScheduledAt() time.Time {
+	return c.scheduledAt
+}
+
+// CreatedBy 建立者
+func (c *Campaign) CreatedBy() string {
+	return c.createdBy
+}
+
+// Summary 投遞統計（完成時記錄；發送中請查詢 RecipientRepository.Summarize）
+func (c *Campaign) Summary() DeliverySummary {
+	return c.summary
+}
+
+// StartedAt 開始發送時間（未開始時為 nil）
+func (c *Campaign) StartedAt() *time.Time {
+	return c.startedAt
+}
+
+// CompletedAt 完成時間（未完成時為 nil）
+func (c *Campaign) CompletedAt() *time.Time {
+	return c.completedAt
+}
+
+// CreatedAt 創建時間
+func (c *Campaign) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt 更新時間
+func (c *Campaign) UpdatedAt() time.Time {
+	return c.updatedAt
+}
@@ -0,0 +1,111 @@
+package broadcast_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMemberID = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+
+func newTestCampaign(t *testing.T, scheduledAt, now time.Time) *broadcast.Campaign {
+	t.Helper()
+	segment, err := broadcast.NewSegment(100, 30)
+	require.NoError(t, err)
+	message, err := broadcast.NewMessageTemplate("好久不見！本週回店出示訊息享招待一杯")
+	require.NoError(t, err)
+	c, err := broadcast.NewCampaign("30 天未回訪", segment, message, scheduledAt, "manager", now)
+	require.NoError(t, err)
+	return c
+}
+
+// Test 1: 活動生命週期（到預定時間才可開始、仍有 pending 收件者不可完成）
+func TestCampaign_Lifecycle(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+	c := newTestCampaign(t, now.Add(time.Hour), now)
+
+	// Act & Assert
+	err := c.StartAt(now)
+	assert.ErrorIs(t, err, broadcast.ErrInvalidCampaignState)
+
+	require.NoError(t, c.StartAt(now.Add(time.Hour)))
+	assert.Equal(t, broadcast.CampaignStatusSending, c.Status())
+
+	err = c.CompleteAt(broadcast.DeliverySummary{Pending: 1, Sent: 2}, now.Add(2*time.Hour))
+	assert.ErrorIs(t, err, broadcast.ErrInvalidCampaignState)
+
+	summary := broadcast.DeliverySummary{Sent: 2, Failed: 1, Excluded: 1}
+	require.NoError(t, c.CompleteAt(summary, now.Add(2*time.Hour)))
+	assert.Equal(t, broadcast.CampaignStatusCompleted, c.Status())
+	assert.Equal(t, 4, c.Summary().Total())
+	assert.ErrorIs(t, c.CancelAt(now.Add(3*time.Hour)), broadcast.ErrInvalidCampaignState)
+}
+
+// Test 2: 建立活動驗證（名稱、預定時間、分眾條件、訊息內容）
+func TestCampaign_Validation(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+	segment, err := broadcast.NewSegment(0, 0)
+	require.NoError(t, err)
+	message, err := broadcast.NewMessageTemplate("hi")
+	require.NoError(t, err)
+
+	// Act & Assert
+	_, err = broadcast.NewCampaign("  ", segment, message, now, "manager", now)
+	assert.ErrorIs(t, err, broadcast.ErrInvalidCampaignName)
+
+	_, err = broadcast.NewCampaign("活動", segment, message, now.Add(-time.Minute), "manager", now)
+	assert.ErrorIs(t, err, broadcast.ErrInvalidSchedule)
+
+	_, err = broadcast.NewSegment(-1, 30)
+	assert.ErrorIs(t, err, broadcast.ErrInvalidSegment)
+
+	_, err = broadcast.NewMessageTemplate(" \n ")
+	assert.ErrorIs(t, err, broadcast.ErrInvalidMessage)
+	assert.False(t, segment.HasInactivityFilter())
+}
+
+// Test 3: 收件者批次重試（失敗保留 batchKey，達上限後標記 failed）
+func TestRecipient_RecordFailureKeepsBatch(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+	memberID, err := broadcast.MemberIDFromString(testMemberID)
+	require.NoError(t, err)
+	r := broadcast.NewRecipient(broadcast.NewCampaignID(), memberID, "U1234", now)
+	require.NoError(t, r.AssignBatchAt("batch-1", now))
+
+	// Act
+	for i := 0; i < broadcast.MaxDeliveryAttempts-1; i++ {
+		require.NoError(t, r.RecordFailureAt("503", now))
+		assert.Equal(t, broadcast.RecipientStatusPending, r.Status())
+	}
+	require.NoError(t, r.RecordFailureAt("503", now))
+
+	// Assert
+	assert.Equal(t, broadcast.RecipientStatusFailed, r.Status())
+	assert.Equal(t, "batch-1", r.BatchKey())
+	assert.Equal(t, broadcast.MaxDeliveryAttempts, r.Attempts())
+	assert.ErrorIs(t, r.MarkSentAt(now), broadcast.ErrInvalidRecipientState)
+}
+
+// Test 4: 已分批的收件者不可重新分批；排除需有效原因
+func TestRecipient_AssignAndExclude(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+	memberID, err := broadcast.MemberIDFromString(testMemberID)
+	require.NoError(t, err)
+	r := broadcast.NewRecipient(broadcast.NewCampaignID(), memberID, "U1234", now)
+
+	// Act & Assert
+	require.NoError(t, r.AssignBatchAt("batch-1", now))
+	assert.ErrorIs(t, r.AssignBatchAt("batch-2", now), broadcast.ErrInvalidRecipientState)
+	assert.ErrorIs(t, r.ExcludeAt("spam", now), broadcast.ErrInvalidExclusionReason)
+
+	require.NoError(t, r.ExcludeAt(broadcast.ExclusionReasonUnsubscribed, now))
+	assert.Equal(t, broadcast.RecipientStatusExcluded, r.Status())
+	assert.Equal(t, broadcast.ExclusionReasonUnsubscribed, r.ExclusionReason())
+}
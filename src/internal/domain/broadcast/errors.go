@@ -0,0 +1,164 @@
+package broadcast
+
+import "fmt"
+
+// ===========================
+// 錯誤代碼定義
+// ===========================
+
+// ErrorCode 錯誤代碼類型
+type ErrorCode string
+
+// 錯誤代碼常量
+const (
+	// 識別符相關
+	ErrCodeInvalidCampaignID ErrorCode = "BROADCAST_CAMPAIGN_ID_INVALID"
+	ErrCodeInvalidMemberID   ErrorCode = "BROADCAST_MEMBER_ID_INVALID"
+
+	// 活動相關
+	ErrCodeCampaignNotFound     ErrorCode = "BROADCAST_CAMPAIGN_NOT_FOUND"
+	ErrCodeInvalidCampaignState ErrorCode = "BROADCAST_CAMPAIGN_STATE_INVALID"
+	ErrCodeInvalidCampaignName  ErrorCode = "BROADCAST_CAMPAIGN_NAME_INVALID"
+	ErrCodeInvalidSchedule      ErrorCode = "BROADCAST_SCHEDULE_INVALID"
+	ErrCodeInvalidSegment       ErrorCode = "BROADCAST_SEGMENT_INVALID"
+	ErrCodeInvalidMessage       ErrorCode = "BROADCAST_MESSAGE_INVALID"
+
+	// 收件者相關
+	ErrCodeInvalidRecipientState ErrorCode = "BROADCAST_RECIPIENT_STATE_INVALID"
+
+	// 排除名單相關
+	ErrCodeInvalidExclusionReason ErrorCode = "BROADCAST_EXCLUSION_REASON_INVALID"
+	ErrCodeExclusionNotFound      ErrorCode = "BROADCAST_EXCLUSION_NOT_FOUND"
+)
+
+// ===========================
+// DomainError 結構
+// ===========================
+
+// DomainError 群發訊息領域錯誤
+// 設計原則：
+// 1. 包含結構化的錯誤代碼（用於 HTTP 狀態碼映射）
+// 2. 支持上下文信息（用於調試和日誌）
+// 3. 不可變性（創建後不可修改）
+type DomainError struct {
+	Code    ErrorCode
+	Message string
+	Context map[string]interface{}
+}
+
+// Error 實現 error 接口
+func (e *DomainError) Error() string {
+	if len(e.Context) == 0 {
+		return fmt.Sprintf("[%s] %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("[%s] %s (context: %+v)", e.Code, e.Message, e.Context)
+}
+
+// WithContext 添加上下文信息（返回新的錯誤實例，保持不可變性）
+func (e *DomainError) WithContext(keyValues ...interface{}) error {
+	if len(keyValues)%2 != 0 {
+		panic("WithContext requires even number of arguments (key-value pairs)")
+	}
+
+	ctx := make(map[string]interface{}, len(e.Context)+len(keyValues)/2)
+
+	// 複製現有上下文
+	for k, v := range e.Context {
+		ctx[k] = v
+	}
+
+	// 添加新上下文
+	for i := 0; i < len(keyValues); i += 2 {
+		key, ok := keyValues[i].(string)
+		if !ok {
+			panic(fmt.Sprintf("context key must be string, got %T", keyValues[i]))
+		}
+		ctx[key] = keyValues[i+1]
+	}
+
+	return &DomainError{
+		Code:    e.Code,
+		Message: e.Message,
+		Context: ctx,
+	}
+}
+
+// Is 實現 errors.Is 接口（用於錯誤類型判斷）
+func (e *DomainError) Is(target error) bool {
+	t, ok := target.(*DomainError)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+// ===========================
+// 預定義錯誤
+// ===========================
+
+// 識別符相關錯誤
+var (
+	ErrInvalidCampaignID = &DomainError{
+		Code:    ErrCodeInvalidCampaignID,
+		Message: "無效的群發活動 ID",
+	}
+
+	ErrInvalidMemberID = &DomainError{
+		Code:    ErrCodeInvalidMemberID,
+		Message: "無效的會員 ID",
+	}
+)
+
+// 活動相關錯誤
+var (
+	ErrCampaignNotFound = &DomainError{
+		Code:    ErrCodeCampaignNotFound,
+		Message: "群發活動不存在",
+	}
+
+	ErrInvalidCampaignState = &DomainError{
+		Code:    ErrCodeInvalidCampaignState,
+		Message: "群發活動狀態不允許此操作",
+	}
+
+	ErrInvalidCampaignName = &DomainError{
+		Code:    ErrCodeInvalidCampaignName,
+		Message: "活動名稱不可為空且不可超過 100 字",
+	}
+
+	ErrInvalidSchedule = &DomainError{
+		Code:    ErrCodeInvalidSchedule,
+		Message: "預定發送時間不可早於現在",
+	}
+
+	ErrInvalidSegment = &DomainError{
+		Code:    ErrCodeInvalidSegment,
+		Message: "無效的目標分眾條件",
+	}
+
+	ErrInvalidMessage = &DomainError{
+		Code:    ErrCodeInvalidMessage,
+		Message: "訊息內容不可為空且不可超過 5000 字",
+	}
+)
+
+// 收件者相關錯誤
+var (
+	ErrInvalidRecipientState = &DomainError{
+		Code:    ErrCodeInvalidRecipientState,
+		Message: "收件者狀態不允許此操作",
+	}
+)
+
+// 排除名單相關錯誤
+var (
+	ErrInvalidExclusionReason = &DomainError{
+		Code:    ErrCodeInvalidExclusionReason,
+		Message: "無效的排除原因",
+	}
+
+	ErrExclusionNotFound = &DomainError{
+		Code:    ErrCodeExclusionNotFound,
+		Message: "會員不在排除名單中",
+	}
+)
@@ -0,0 +1,55 @@
+package broadcast
+
+import (
+	"time"
+)
+
+// ===========================
+// Exclusion 排除名單
+// ===========================
+
+// Exclusion 不接收群發訊息的會員
+//
+// 設計原則：
+// - 每位會員最多一筆（以最新原因為準）
+// - 建立收件者名單與每批發送前皆會檢查（發送期間取消訂閱的會員不會再收到）
+type Exclusion struct {
+	memberID  MemberID
+	reason    ExclusionReason
+	createdAt time.Time
+}
+
+// NewExclusion 創建排除紀錄
+//
+// 錯誤：原因無效 → ErrInvalidExclusionReason
+func NewExclusion(memberID MemberID, reason ExclusionReason, now time.Time) (*Exclusion, error) {
+	if !reason.IsValid() {
+		return nil, ErrInvalidExclusionReason.WithContext(
+			"member_id", memberID.String(),
+			"reason", reason.String(),
+		)
+	}
+	return &Exclusion{memberID: memberID, reason: reason, createdAt: now}, nil
+}
+
+// ReconstructExclusion 從持久化存儲重建排除紀錄
+//
+// 設計原則：僅供 Repository 使用
+func ReconstructExclusion(memberID MemberID, reason ExclusionReason, createdAt time.Time) *Exclusion {
+	return &Exclusion{memberID: memberID, reason: reason, createdAt: createdAt}
+}
+
+// MemberID 會員 ID
+func (e *Exclusion) MemberID() MemberID {
+	return e.memberID
+}
+
+// Reason 排除原因
+func (e *Exclusion) Reason() ExclusionReason {
+	return e.reason
+}
+
+// CreatedAt 排除時間
+func (e *Exclusion) CreatedAt() time.Time {
+	return e.createdAt
+}
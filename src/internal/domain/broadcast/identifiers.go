@@ -0,0 +1,53 @@
+package broadcast
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// 實體 ID 類型定義
+// ===========================
+
+// 設計原則：使用泛型 EntityID[T]（與其他上下文一致）
+//
+// 注意：broadcast.MemberID 與其他上下文的 ID 是不同類型
+// 跨上下文傳遞時使用 String() 轉換
+
+// ===========================
+// CampaignID - 群發活動 ID
+// ===========================
+
+// CampaignMarker 是 CampaignID 的標記類型
+type CampaignMarker struct{}
+
+// CampaignID 群發活動的唯一標識符
+type CampaignID = shared.EntityID[CampaignMarker]
+
+// NewCampaignID 生成新的群發活動 ID（UUID v4）
+func NewCampaignID() CampaignID {
+	return shared.NewEntityID[CampaignMarker]()
+}
+
+// CampaignIDFromString 從字串解析群發活動 ID
+//
+// 返回：
+//   CampaignID - 解析成功的 ID
+//   error - 解析失敗（返回 ErrInvalidCampaignID）
+func CampaignIDFromString(s string) (CampaignID, error) {
+	return shared.EntityIDFromString[CampaignMarker](s, ErrInvalidCampaignID)
+}
+
+// ===========================
+// MemberID - 會員 ID（引用）
+// ===========================
+
+// MemberMarker 是 MemberID 的標記類型
+type MemberMarker struct{}
+
+// MemberID 會員的唯一標識符（群發上下文內的引用）
+type MemberID = shared.EntityID[MemberMarker]
+
+// MemberIDFromString 從字串解析會員 ID
+func MemberIDFromString(s string) (MemberID, error) {
+	return shared.EntityIDFromString[MemberMarker](s, ErrInvalidMemberID)
+}
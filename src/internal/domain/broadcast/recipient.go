@@ -0,0 +1,228 @@
+package broadcast
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ===========================
+// Recipient 收件者
+// ===========================
+
+// Recipient 群發活動的單一收件者（投遞紀錄）
+//
+// 續傳設計：
+// - 發送前先將一批收件者標記同一個 batchKey 並提交
+// - batchKey 作為 LINE X-Line-Retry-Key：行程在推播後、記錄結果前中斷時，
+//   重啟後以相同 batchKey 重送，LINE 不會重複發送（409 視為已送達）
+// - 推播失敗時保留 batchKey，重試沿用同一批次
+type Recipient struct {
+	campaignID      CampaignID
+	memberID        MemberID
+	lineUserID      string
+	status          RecipientStatus
+	batchKey        string
+	attempts        int
+	lastError       string
+	exclusionReason ExclusionReason
+	sentAt          *time.Time
+	createdAt       time.Time
+	updatedAt       time.Time
+}
+
+// NewBatchKey 生成發送批次識別碼（UUID，同時作為 LINE X-Line-Retry-Key）
+func NewBatchKey() string {
+	return uuid.New().String()
+}
+
+// NewRecipient 創建收件者（pending，尚未分批）
+func NewRecipient(campaignID CampaignID, memberID MemberID, lineUserID string, now time.Time) *Recipient {
+	return &Recipient{
+		campaignID: campaignID,
+		memberID:   memberID,
+		lineUserID: lineUserID,
+		status:     RecipientStatusPending,
+		createdAt:  now,
+		updatedAt:  now,
+	}
+}
+
+// ReconstructRecipient 從持久化存儲重建收件者
+//
+// 設計原則：僅供 Repository 使用
+func ReconstructRecipient(
+	campaignID CampaignID,
+	memberID MemberID,
+	lineUserID string,
+	status RecipientStatus,
+	batchKey string,
+	attempts int,
+	lastError string,
+	exclusionReason ExclusionReason,
+	sentAt *time.Time,
+	createdAt, updatedAt time.Time,
+) (*Recipient, error) {
+	if !status.IsValid() {
+		return nil, ErrInvalidRecipientState.WithContext(
+			"campaign_id", campaignID.String(),
+			"member_id", memberID.String(),
+			"status", status.String(),
+		)
+	}
+
+	return &Recipient{
+		campaignID:      campaignID,
+		memberID:        memberID,
+		lineUserID:      lineUserID,
+		status:          status,
+		batchKey:        batchKey,
+		attempts:        attempts,
+		lastError:       lastError,
+		exclusionReason: exclusionReason,
+		sentAt:          sentAt,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+	}, nil
+}
+
+// ===========================
+// 命令方法
+// ===========================
+
+// AssignBatchAt 分配至發送批次
+//
+// 業務規則：僅尚未分批的 pending 收件者可分配
+func (r *Recipient) AssignBatchAt(batchKey string, now time.Time) error {
+	if err := r.requirePending("assign_batch"); err != nil {
+		return err
+	}
+	if r.batchKey != "" {
+		return ErrInvalidRecipientState.WithContext(
+			"campaign_id", r.campaignID.String(),
+			"member_id", r.memberID.String(),
+			"batch_key", r.batchKey,
+			"operation", "assign_batch",
+		)
+	}
+	r.batchKey = batchKey
+	r.updatedAt = now
+	return nil
+}
+
+// MarkSentAt 標記已送出
+func (r *Recipient) MarkSentAt(now time.Time) error {
+	if err := r.requirePending("mark_sent"); err != nil {
+		return err
+	}
+	r.status = RecipientStatusSent
+	r.attempts++
+	r.lastError = ""
+	r.sentAt = &now
+	r.updatedAt = now
+	return nil
+}
+
+// RecordFailureAt 記錄所屬批次推播失敗
+//
+// 業務規則：
+// - 未達 MaxDeliveryAttempts → 維持 pending（保留 batchKey，下次以同一批次重試）
+// - 達到上限 → failed
+func (r *Recipient) RecordFailureAt(reason string, now time.Time) error {
+	if err := r.requirePending("record_failure"); err != nil {
+		return err
+	}
+	r.attempts++
+	r.lastError = reason
+	if r.attempts >= MaxDeliveryAttempts {
+		r.status = RecipientStatusFailed
+	}
+	r.updatedAt = now
+	return nil
+}
+
+// ExcludeAt 排除收件者（取消訂閱 / 封鎖官方帳號）
+func (r *Recipient) ExcludeAt(reason ExclusionReason, now time.Time) error {
+	if err := r.requirePending("exclude"); err != nil {
+		return err
+	}
+	if !reason.IsValid() {
+		return ErrInvalidExclusionReason.WithContext("reason", reason.String())
+	}
+	r.status = RecipientStatusExcluded
+	r.exclusionReason = reason
+	r.updatedAt = now
+	return nil
+}
+
+// requirePending 檢查收件者為 pending 狀態
+func (r *Recipient) requirePending(operation string) error {
+	if r.status != RecipientStatusPending {
+		return ErrInvalidRecipientState.WithContext(
+			"campaign_id", r.campaignID.String(),
+			"member_id", r.memberID.String(),
+			"status", r.status.String(),
+			"operation", operation,
+		)
+	}
+	return nil
+}
+
+// ===========================
+// 查詢方法
+// ===========================
+
+// CampaignID 活動 ID
+func (r *Recipient) CampaignID() CampaignID {
+	return r.campaignID
+}
+
+// MemberID 會員 ID
+func (r *Recipient) MemberID() MemberID {
+	return r.memberID
+}
+
+// LineUserID 建立名單時的 LINE User ID
+func (r *Recipient) LineUserID() string {
+	return r.lineUserID
+}
+
+// Status 投遞狀態
+func (r *Recipient) Status() RecipientStatus {
+	return r.status
+}
+
+// BatchKey 所屬批次（尚未分批時為空字串）
+func (r *Recipient) BatchKey() string {
+	return r.batchKey
+}
+
+// Attempts 推播嘗試次數
+func (r *Recipient) Attempts() int {
+	return r.attempts
+}
+
+// LastError 最近一次失敗原因
+func (r *Recipient) LastError() string {
+	return r.lastError
+}
+
+// ExclusionReason 排除原因（未排除時為空字串）
+func (r *Recipient) ExclusionReason() ExclusionReason {
+	return r.exclusionReason
+}
+
+// SentAt 送出時間（未送出時為 nil）
+func (r *Recipient) SentAt() *time.Time {
+	return r.sentAt
+}
+
+// CreatedAt 創建時間
+func (r *Recipient) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt 更新時間
+func (r *Recipient) UpdatedAt() time.Time {
+	return r.updatedAt
+}
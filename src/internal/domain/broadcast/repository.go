@@ -0,0 +1,97 @@
+package broadcast
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// Campaign Repository 介面
+// ===========================
+
+// CampaignRepository 群發活動倉儲介面
+//
+// 設計原則：
+// 1. Save 為 Upsert（新增或更新）
+// 2. 事務支持：寫操作 ctx 必須 non-nil，讀操作 ctx 可為 nil
+type CampaignRepository interface {
+	// Save 保存群發活動（新增或更新）
+	Save(ctx shared.TransactionContext, campaign *Campaign) error
+
+	// FindByID 根據 ID 查詢群發活動
+	//
+	// 返回：找到的活動，或 ErrCampaignNotFound
+	FindByID(ctx shared.TransactionContext, id CampaignID) (*Campaign, error)
+
+	// FindSendable 查詢需要處理的活動：已到預定時間的 scheduled 活動，以及尚未完成的 sending 活動
+	// （依預定時間排序）
+	FindSendable(ctx shared.TransactionContext, now time.Time) ([]*Campaign, error)
+}
+
+// ===========================
+// Recipient Repository 介面
+// ===========================
+
+// RecipientRepository 收件者倉儲介面
+//
+// 設計原則：同一活動內會員唯一（campaign_id + member_id）
+type RecipientRepository interface {
+	// SaveAll 保存多位收件者（新增或更新）
+	SaveAll(ctx shared.TransactionContext, recipients []*Recipient) error
+
+	// FindInFlightBatch 查詢已分批但尚未完成的批次（續傳 / 重試用）
+	//
+	// 返回：最早分配的一個批次的 pending 收件者；沒有時返回空切片
+	FindInFlightBatch(ctx shared.TransactionContext, campaignID CampaignID) ([]*Recipient, error)
+
+	// FindUnassigned 查詢尚未分批的 pending 收件者（依建立順序）
+	FindUnassigned(ctx shared.TransactionContext, campaignID CampaignID, limit int) ([]*Recipient, error)
+
+	// FindByCampaignID 查詢活動的收件者（status 為空字串時不限狀態）
+	FindByCampaignID(ctx shared.TransactionContext, campaignID CampaignID, status RecipientStatus) ([]*Recipient, error)
+
+	// Summarize 統計活動各狀態的收件者數
+	Summarize(ctx shared.TransactionContext, campaignID CampaignID) (DeliverySummary, error)
+}
+
+// ===========================
+// Exclusion Repository 介面
+// ===========================
+
+// ExclusionRepository 排除名單倉儲介面
+type ExclusionRepository interface {
+	// Save 保存排除紀錄（會員已在名單中時更新原因）
+	Save(ctx shared.TransactionContext, exclusion *Exclusion) error
+
+	// Delete 將會員移出排除名單
+	//
+	// 返回：ErrExclusionNotFound（會員不在名單中）
+	Delete(ctx shared.TransactionContext, memberID MemberID) error
+
+	// FindByMemberID 查詢會員的排除紀錄
+	//
+	// 返回：找到的紀錄，或 ErrExclusionNotFound
+	FindByMemberID(ctx shared.TransactionContext, memberID MemberID) (*Exclusion, error)
+
+	// FindByMemberIDs 批次查詢排除紀錄（不在名單中的會員不會出現在結果中）
+	FindByMemberIDs(ctx shared.TransactionContext, memberIDs []MemberID) ([]*Exclusion, error)
+}
+
+// ===========================
+// 跨上下文查詢介面
+// ===========================
+
+// Audience 符合目標分眾的會員
+type Audience struct {
+	MemberID   MemberID
+	LineUserID string
+}
+
+// AudienceQuery 目標分眾查詢（唯讀，跨會員 / 積分 / 發票上下文）
+//
+// 設計原則：僅返回目前存在的會員（不含已刪除），不檢查排除名單
+type AudienceQuery interface {
+	// FindAudience 查詢符合分眾條件的會員（依會員建立時間排序）
+	FindAudience(ctx shared.TransactionContext, segment Segment, now time.Time) ([]Audience, error)
+}
@@ -0,0 +1,213 @@
+package broadcast
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ===========================
+// CampaignStatus 活動狀態
+// ===========================
+
+// CampaignStatus 群發活動狀態
+//
+// 狀態轉換：
+//   scheduled → sending（到達預定時間，建立收件者名單）
+//   sending → completed（所有收件者皆已處理）
+//   scheduled / sending → cancelled（管理員取消；已送出的批次無法收回）
+type CampaignStatus string
+
+const (
+	CampaignStatusScheduled CampaignStatus = "scheduled" // 等待發送
+	CampaignStatusSending   CampaignStatus = "sending"   // 發送中
+	CampaignStatusCompleted CampaignStatus = "completed" // 已完成
+	CampaignStatusCancelled CampaignStatus = "cancelled" // 已取消
+)
+
+// String 返回狀態字串
+func (s CampaignStatus) String() string {
+	return string(s)
+}
+
+// IsValid 判斷狀態是否有效
+func (s CampaignStatus) IsValid() bool {
+	switch s {
+	case CampaignStatusScheduled, CampaignStatusSending, CampaignStatusCompleted, CampaignStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// ===========================
+// RecipientStatus 收件者狀態
+// ===========================
+
+// RecipientStatus 單一收件者的投遞狀態
+//
+// 狀態轉換：
+//   pending → sent（所屬批次推播成功）
+//   pending → failed（所屬批次達到重試上限）
+//   pending → excluded（發送前已取消訂閱 / 封鎖官方帳號）
+type RecipientStatus string
+
+const (
+	RecipientStatusPending  RecipientStatus = "pending"  // 等待發送（未分批，或所屬批次等待重試）
+	RecipientStatusSent     RecipientStatus = "sent"     // 已送出
+	RecipientStatusFailed   RecipientStatus = "failed"   // 發送失敗
+	RecipientStatusExcluded RecipientStatus = "excluded" // 已排除
+)
+
+// String 返回狀態字串
+func (s RecipientStatus) String() string {
+	return string(s)
+}
+
+// IsValid 判斷狀態是否有效
+func (s RecipientStatus) IsValid() bool {
+	switch s {
+	case RecipientStatusPending, RecipientStatusSent, RecipientStatusFailed, RecipientStatusExcluded:
+		return true
+	default:
+		return false
+	}
+}
+
+// ===========================
+// ExclusionReason 排除原因
+// ===========================
+
+// ExclusionReason 會員不接收群發訊息的原因
+type ExclusionReason string
+
+const (
+	ExclusionReasonUnsubscribed ExclusionReason = "unsubscribed" // 會員取消訂閱
+	ExclusionReasonBlocked      ExclusionReason = "blocked"      // 會員封鎖官方帳號
+)
+
+// String 返回原因字串
+func (r ExclusionReason) String() string {
+	return string(r)
+}
+
+// IsValid 判斷原因是否有效
+func (r ExclusionReason) IsValid() bool {
+	return r == ExclusionReasonUnsubscribed || r == ExclusionReasonBlocked
+}
+
+// 發送規則
+const (
+	MaxBatchSize        = 500 // 單批收件者上限（LINE multicast 限制）
+	MaxDeliveryAttempts = 3   // 單批推播失敗重試上限
+
+	maxCampaignNameLength = 100
+	maxMessageLength      = 5000 // LINE 文字訊息長度上限
+)
+
+// ===========================
+// Segment 目標分眾
+// ===========================
+
+// Segment 目標分眾條件（值對象）
+//
+// 條件（皆為 AND）：
+// - 可用積分 ≥ minAvailablePoints
+// - inactiveDays > 0 時：近 inactiveDays 天內沒有消費（以發票日期判斷，不含驗證失敗的發票）
+// - verifiedAdultsOnly 時：僅限已由店員查驗證件的成年會員（酒類促銷必須設定）
+//
+// 範例：NewSegment(100, 30) → 「積分 ≥ 100 且 30 天未消費」的會員
+type Segment struct {
+	minAvailablePoints int
+	inactiveDays       int
+	verifiedAdultsOnly bool
+}
+
+// NewSegment 創建目標分眾條件
+//
+// 錯誤：積分門檻或天數為負數 → ErrInvalidSegment
+func NewSegment(minAvailablePoints, inactiveDays int) (Segment, error) {
+	if minAvailablePoints < 0 || inactiveDays < 0 {
+		return Segment{}, ErrInvalidSegment.WithContext(
+			"min_available_points", minAvailablePoints,
+			"inactive_days", inactiveDays,
+		)
+	}
+	return Segment{minAvailablePoints: minAvailablePoints, inactiveDays: inactiveDays}, nil
+}
+
+// WithVerifiedAdultsOnly 返回限制（或不限制）已驗證成年會員的分眾條件
+func (s Segment) WithVerifiedAdultsOnly(only bool) Segment {
+	s.verifiedAdultsOnly = only
+	return s
+}
+
+// MinAvailablePoints 可用積分門檻
+func (s Segment) MinAvailablePoints() int {
+	return s.minAvailablePoints
+}
+
+// InactiveDays 未消費天數（0 表示不限）
+func (s Segment) InactiveDays() int {
+	return s.inactiveDays
+}
+
+// HasInactivityFilter 是否限制未消費天數
+func (s Segment) HasInactivityFilter() bool {
+	return s.inactiveDays > 0
+}
+
+// VerifiedAdultsOnly 是否僅限已驗證成年的會員
+func (s Segment) VerifiedAdultsOnly() bool {
+	return s.verifiedAdultsOnly
+}
+
+// InactiveSince 未消費區間的起點（該時間之後沒有消費才符合條件）
+func (s Segment) InactiveSince(now time.Time) time.Time {
+	return now.AddDate(0, 0, -s.inactiveDays)
+}
+
+// ===========================
+// MessageTemplate 訊息內容
+// ===========================
+
+// MessageTemplate 群發訊息內容（值對象）
+//
+// 設計考量：multicast 對同一批收件者送出相同內容，因此不支援個人化欄位
+type MessageTemplate struct {
+	text string
+}
+
+// NewMessageTemplate 創建訊息內容
+//
+// 錯誤：空白或超過 5000 字 → ErrInvalidMessage
+func NewMessageTemplate(text string) (MessageTemplate, error) {
+	if strings.TrimSpace(text) == "" || utf8.RuneCountInString(text) > maxMessageLength {
+		return MessageTemplate{}, ErrInvalidMessage.WithContext(
+			"length", utf8.RuneCountInString(text),
+		)
+	}
+	return MessageTemplate{text: text}, nil
+}
+
+// Text 訊息文字
+func (m MessageTemplate) Text() string {
+	return m.text
+}
+
+// ===========================
+// DeliverySummary 投遞統計
+// ===========================
+
+// DeliverySummary 群發活動的收件者統計
+type DeliverySummary struct {
+	Pending  int
+	Sent     int
+	Failed   int
+	Excluded int
+}
+
+// Total 收件者總數
+func (s DeliverySummary) Total() int {
+	return s.Pending + s.Sent + s.Failed + s.Excluded
+}
@@ -0,0 +1,199 @@
+package conversation
+
+import (
+	"strings"
+	"time"
+)
+
+// ===========================
+// RegistrationSession 聚合根
+// ===========================
+
+// RegistrationSession LINE 註冊對話會話
+//
+// 職責：
+// - 記錄每位 LINE 用戶在註冊流程中的進度（跨多則訊息）
+// - 管理逾時、重試次數與確認步驟
+//
+// 設計原則：
+// - 以 LINE UserID 為識別（註冊完成前尚無 MemberID）
+// - 手機號碼格式由 Application Layer 以 member.NewPhoneNumber 驗證後傳入
+// - 每次狀態變更重新計算逾時時間
+type RegistrationSession struct {
+	lineUserID     string
+	state          SessionState
+	phoneNumber    string // 等待確認的手機號碼
+	failedAttempts int
+	expiresAt      time.Time
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+// StartRegistrationSession 開始註冊會話（等待輸入手機號碼）
+func StartRegistrationSession(lineUserID string, now time.Time) (*RegistrationSession, error) {
+	if strings.TrimSpace(lineUserID) == "" {
+		return nil, ErrInvalidLineUserID
+	}
+
+	return &RegistrationSession{
+		lineUserID: lineUserID,
+		state:      SessionStateAwaitingPhone,
+		expiresAt:  now.Add(PhoneInputTimeout),
+		createdAt:  now,
+		updatedAt:  now,
+	}, nil
+}
+
+// ReconstructRegistrationSession 從持久化存儲重建會話
+//
+// 設計原則：僅供 Repository 使用
+func ReconstructRegistrationSession(
+	lineUserID string,
+	state SessionState,
+	phoneNumber string,
+	failedAttempts int,
+	expiresAt, createdAt, updatedAt time.Time,
+) (*RegistrationSession, error) {
+	if !state.IsValid() {
+		return nil, ErrInvalidSessionState.WithContext(
+			"line_user_id", lineUserID,
+			"state", state.String(),
+			"reason", "invalid state in database",
+		)
+	}
+
+	return &RegistrationSession{
+		lineUserID:     lineUserID,
+		state:          state,
+		phoneNumber:    phoneNumber,
+		failedAttempts: failedAttempts,
+		expiresAt:      expiresAt,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}, nil
+}
+
+// ===========================
+// 業務方法
+// ===========================
+
+// IsExpiredAt 判斷會話是否已逾時
+func (s *RegistrationSession) IsExpiredAt(now time.Time) bool {
+	return !now.Before(s.expiresAt)
+}
+
+// RecordInvalidPhoneAt 記錄一次手機號碼格式錯誤
+//
+// 返回：
+//   ErrRetryLimitExceeded - 已達重試上限（呼叫端應結束會話）
+//   ErrInvalidSessionState - 不在等待輸入手機號碼狀態
+func (s *RegistrationSession) RecordInvalidPhoneAt(now time.Time) error {
+	if s.state != SessionStateAwaitingPhone {
+		return s.invalidState("record_invalid_phone")
+	}
+
+	s.failedAttempts++
+	s.touch(now)
+
+	if s.failedAttempts >= MaxPhoneAttempts {
+		return ErrRetryLimitExceeded.WithContext(
+			"line_user_id", s.lineUserID,
+			"attempts", s.failedAttempts,
+		)
+	}
+	return nil
+}
+
+// AcceptPhoneNumberAt 接受格式正確的手機號碼，進入確認步驟
+func (s *RegistrationSession) AcceptPhoneNumberAt(phoneNumber string, now time.Time) error {
+	if s.state != SessionStateAwaitingPhone {
+		return s.invalidState("accept_phone_number")
+	}
+
+	s.state = SessionStateAwaitingConfirmation
+	s.phoneNumber = phoneNumber
+	s.touch(now)
+	return nil
+}
+
+// RejectPhoneNumberAt 退回輸入手機號碼步驟（用戶否認 / 手機號碼已被註冊）
+func (s *RegistrationSession) RejectPhoneNumberAt(now time.Time) error {
+	if s.state != SessionStateAwaitingConfirmation {
+		return s.invalidState("reject_phone_number")
+	}
+
+	s.state = SessionStateAwaitingPhone
+	s.phoneNumber = ""
+	s.touch(now)
+	return nil
+}
+
+// ConfirmedPhoneNumber 返回已確認的手機號碼（僅等待確認狀態）
+func (s *RegistrationSession) ConfirmedPhoneNumber() (string, error) {
+	if s.state != SessionStateAwaitingConfirmation {
+		return "", s.invalidState("confirm_phone_number")
+	}
+	return s.phoneNumber, nil
+}
+
+// touch 更新時間並依目前狀態重新計算逾時
+func (s *RegistrationSession) touch(now time.Time) {
+	s.updatedAt = now
+	s.expiresAt = now.Add(s.state.timeout())
+}
+
+func (s *RegistrationSession) invalidState(action string) error {
+	return ErrInvalidSessionState.WithContext(
+		"line_user_id", s.lineUserID,
+		"state", s.state.String(),
+		"action", action,
+	)
+}
+
+// ===========================
+// Getters
+// ===========================
+
+// LineUserID 返回 LINE UserID
+func (s *RegistrationSession) LineUserID() string {
+	return s.lineUserID
+}
+
+// State 返回會話狀態
+func (s *RegistrationSession) State() SessionState {
+	return s.state
+}
+
+// PhoneNumber 返回等待確認的手機號碼（等待輸入時為空字串）
+func (s *RegistrationSession) PhoneNumber() string {
+	return s.phoneNumber
+}
+
+// FailedAttempts 返回格式錯誤次數
+func (s *RegistrationSession) FailedAttempts() int {
+	return s.failedAttempts
+}
+
+// RemainingAttempts 返回剩餘重試次數
+func (s *RegistrationSession) RemainingAttempts() int {
+	remaining := MaxPhoneAttempts - s.failedAttempts
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// ExpiresAt 返回逾時時間
+func (s *RegistrationSession) ExpiresAt() time.Time {
+	return s.expiresAt
+}
+
+// CreatedAt 返回建立時間
+func (s *RegistrationSession) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// UpdatedAt 返回最後更新時間
+func (s *RegistrationSession) UpdatedAt() time.Time {
+	return s.updatedAt
+}
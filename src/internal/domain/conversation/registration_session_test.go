@@ -0,0 +1,78 @@
+package conversation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/conversation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLineUserID = "U4af4980629a1b2c3d4e5f6a7b8c9d0e1"
+
+var testNow = time.Date(2025, 1, 8, 7, 30, 0, 0, time.UTC)
+
+// Test 1: 開始會話等待輸入手機號碼，5 分鐘後逾時
+func TestStartRegistrationSession_AwaitingPhone(t *testing.T) {
+	s, err := conversation.StartRegistrationSession(testLineUserID, testNow)
+
+	require.NoError(t, err)
+	assert.Equal(t, conversation.SessionStateAwaitingPhone, s.State())
+	assert.Equal(t, conversation.MaxPhoneAttempts, s.RemainingAttempts())
+	assert.False(t, s.IsExpiredAt(testNow.Add(conversation.PhoneInputTimeout-time.Second)))
+	assert.True(t, s.IsExpiredAt(testNow.Add(conversation.PhoneInputTimeout)))
+
+	_, err = conversation.StartRegistrationSession(" ", testNow)
+	assert.ErrorIs(t, err, conversation.ErrInvalidLineUserID)
+}
+
+// Test 2: 格式錯誤達重試上限
+func TestRegistrationSession_RecordInvalidPhone_RetryLimit(t *testing.T) {
+	s, err := conversation.StartRegistrationSession(testLineUserID, testNow)
+	require.NoError(t, err)
+
+	require.NoError(t, s.RecordInvalidPhoneAt(testNow.Add(time.Minute)))
+	require.NoError(t, s.RecordInvalidPhoneAt(testNow.Add(2*time.Minute)))
+	assert.Equal(t, 1, s.RemainingAttempts())
+	assert.Equal(t, testNow.Add(2*time.Minute+conversation.PhoneInputTimeout), s.ExpiresAt())
+
+	err = s.RecordInvalidPhoneAt(testNow.Add(3 * time.Minute))
+	assert.ErrorIs(t, err, conversation.ErrRetryLimitExceeded)
+	assert.Equal(t, 0, s.RemainingAttempts())
+}
+
+// Test 3: 確認步驟（否認後退回輸入手機號碼）
+func TestRegistrationSession_ConfirmationFlow(t *testing.T) {
+	s, err := conversation.StartRegistrationSession(testLineUserID, testNow)
+	require.NoError(t, err)
+
+	_, err = s.ConfirmedPhoneNumber()
+	assert.ErrorIs(t, err, conversation.ErrInvalidSessionState)
+
+	require.NoError(t, s.AcceptPhoneNumberAt("0912345678", testNow))
+	assert.Equal(t, conversation.SessionStateAwaitingConfirmation, s.State())
+	assert.Equal(t, testNow.Add(conversation.ConfirmationTimeout), s.ExpiresAt())
+	assert.ErrorIs(t, s.RecordInvalidPhoneAt(testNow), conversation.ErrInvalidSessionState)
+
+	require.NoError(t, s.RejectPhoneNumberAt(testNow.Add(time.Minute)))
+	assert.Equal(t, conversation.SessionStateAwaitingPhone, s.State())
+	assert.Empty(t, s.PhoneNumber())
+
+	require.NoError(t, s.AcceptPhoneNumberAt("0987654321", testNow.Add(2*time.Minute)))
+	phone, err := s.ConfirmedPhoneNumber()
+	require.NoError(t, err)
+	assert.Equal(t, "0987654321", phone)
+}
+
+// Test 4: 取消與確認關鍵字
+func TestKeywords(t *testing.T) {
+	assert.True(t, conversation.IsCancelKeyword(" 取消 "))
+	assert.True(t, conversation.IsCancelKeyword("CANCEL"))
+	assert.False(t, conversation.IsCancelKeyword("0912345678"))
+
+	assert.Equal(t, conversation.ConfirmationYes, conversation.ParseConfirmation("是"))
+	assert.Equal(t, conversation.ConfirmationYes, conversation.ParseConfirmation("Yes"))
+	assert.Equal(t, conversation.ConfirmationNo, conversation.ParseConfirmation("否"))
+	assert.Equal(t, conversation.ConfirmationUnknown, conversation.ParseConfirmation("好喔"))
+}
@@ -0,0 +1,26 @@
+package conversation
+
+import "github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+
+// ===========================
+// RegistrationSession Repository 介面
+// ===========================
+
+// RegistrationSessionRepository 註冊會話倉儲介面
+//
+// 設計原則：
+// 1. 每位 LINE 用戶最多一個會話（以 LINE UserID 為主鍵）
+// 2. 會話結束（完成 / 取消 / 逾時）時刪除
+// 3. 事務支持：寫操作 ctx 必須 non-nil，讀操作 ctx 可為 nil
+type RegistrationSessionRepository interface {
+	// Save 保存會話（新增或覆蓋）
+	Save(ctx shared.TransactionContext, session *RegistrationSession) error
+
+	// FindByLineUserID 查詢會話
+	//
+	// 返回：找到的會話，或 ErrSessionNotFound
+	FindByLineUserID(ctx shared.TransactionContext, lineUserID string) (*RegistrationSession, error)
+
+	// Delete 刪除會話（不存在時不返回錯誤）
+	Delete(ctx shared.TransactionContext, lineUserID string) error
+}
@@ -0,0 +1,101 @@
+package conversation
+
+import (
+	"strings"
+	"time"
+)
+
+// ===========================
+// SessionState 註冊會話狀態
+// ===========================
+
+// SessionState 註冊會話狀態
+//
+// 狀態轉換：
+//   awaiting_phone → awaiting_confirmation（手機號碼格式正確）
+//   awaiting_confirmation → awaiting_phone（用戶否認 / 手機號碼已被註冊）
+// 註冊完成、取消、逾時、超過重試次數時刪除會話（回到閒置）
+type SessionState string
+
+const (
+	SessionStateAwaitingPhone        SessionState = "awaiting_phone"        // 等待輸入手機號碼
+	SessionStateAwaitingConfirmation SessionState = "awaiting_confirmation" // 等待確認手機號碼
+)
+
+// String 返回狀態字串
+func (s SessionState) String() string {
+	return string(s)
+}
+
+// IsValid 判斷狀態是否有效
+func (s SessionState) IsValid() bool {
+	switch s {
+	case SessionStateAwaitingPhone, SessionStateAwaitingConfirmation:
+		return true
+	default:
+		return false
+	}
+}
+
+// timeout 返回狀態的逾時時間（docs/product/ui-ux/linebot-conversation-flows.md 會話狀態管理）
+func (s SessionState) timeout() time.Duration {
+	if s == SessionStateAwaitingConfirmation {
+		return ConfirmationTimeout
+	}
+	return PhoneInputTimeout
+}
+
+// 會話規則
+const (
+	PhoneInputTimeout   = 5 * time.Minute  // 等待輸入手機號碼（REGISTRATION）
+	ConfirmationTimeout = 10 * time.Minute // 等待確認（AWAITING_CONFIRMATION）
+	MaxPhoneAttempts    = 3                // 手機號碼格式錯誤重試上限
+)
+
+// ===========================
+// 關鍵字
+// ===========================
+
+// cancelKeywords 取消註冊關鍵字
+var cancelKeywords = map[string]bool{
+	"取消":     true,
+	"離開":     true,
+	"cancel": true,
+}
+
+// IsCancelKeyword 判斷是否為取消關鍵字（忽略前後空白與大小寫）
+func IsCancelKeyword(text string) bool {
+	return cancelKeywords[normalizeKeyword(text)]
+}
+
+// ConfirmationAnswer 確認回覆
+type ConfirmationAnswer int
+
+const (
+	ConfirmationUnknown ConfirmationAnswer = iota // 無法辨識
+	ConfirmationYes                               // 確認
+	ConfirmationNo                                // 否認（重新輸入）
+)
+
+var (
+	confirmYesKeywords = map[string]bool{"是": true, "確認": true, "對": true, "y": true, "yes": true}
+	confirmNoKeywords  = map[string]bool{"否": true, "不是": true, "修改": true, "n": true, "no": true}
+)
+
+// ParseConfirmation 解析確認回覆
+func ParseConfirmation(text string) ConfirmationAnswer {
+	keyword := normalizeKeyword(text)
+	switch {
+	case confirmYesKeywords[keyword]:
+		return ConfirmationYes
+	case confirmNoKeywords[keyword]:
+		return ConfirmationNo
+	default:
+		return ConfirmationUnknown
+	}
+}
+
+// normalizeKeyword 去除前後空白並轉小寫
+func normalizeKeyword(text string) string {
+	return strings.ToLower(strings.TrimSpace(text))
+}
@@ -0,0 +1,340 @@
+package external
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// SystemResolver 系統自動結案時記錄的審核人員
+const SystemResolver = "system"
+
+// ===========================
+// DiscrepancyReview 聚合根
+// ===========================
+
+// DiscrepancyReview 差異審核案件聚合根
+//
+// 使用場景：
+// - iChef 記錄與掃描發票的差異超出自動匹配容差、但在人工審核容差內
+// - 管理員核准 → 以 iChef 資料驗證交易並發放積分
+// - 管理員駁回 → 交易標記失敗，不發放積分
+//
+// 不變量（Invariants）：
+// 1. 每個案件對應一筆交易（transactionID 非空）
+// 2. 狀態只能 pending → approved / rejected（結案後不可變更）
+// 3. 結案時必須記錄審核人員（resolvedBy）與結案時間
+//
+// 設計原則：
+// - 保存 POS 與掃描雙方資料快照（審核時不需重新查詢 iChef 匯入批次）
+// - 不直接修改 InvoiceTransaction（由 Application Layer 協調）
+type DiscrepancyReview struct {
+	// 識別欄位
+	reviewID      ReviewID
+	transactionID TransactionID
+	invoiceNumber string
+
+	// 資料快照
+	posAmount      int
+	posInvoiceDate time.Time
+	scannedAmount  int
+	scannedDate    time.Time
+	amountDelta    int
+	dateOffsetDays int
+
+	// 審核結果
+	status         ReviewStatus
+	resolvedBy     string
+	resolutionNote string
+	resolvedAt     *time.Time
+
+	// 審計欄位
+	createdAt time.Time
+	updatedAt time.Time
+
+	// 待發布的領域事件
+	events []shared.DomainEvent
+}
+
+// ===========================
+// 建構函數（工廠方法）
+// ===========================
+
+// OpenDiscrepancyReview 開立差異審核案件
+//
+// 參數：
+//   transactionID - 掃描發票對應的交易 ID
+//   invoiceNumber - 發票號碼（已正規化）
+//   pos - iChef 記錄
+//   scanned - 會員掃描的發票
+//   result - InvoiceMatchingService 的匹配結果
+func OpenDiscrepancyReview(
+	transactionID TransactionID,
+	invoiceNumber string,
+	pos MatchableInvoice,
+	scanned MatchableInvoice,
+	result MatchResult,
+) (*DiscrepancyReview, error) {
+	if transactionID.IsEmpty() {
+		return nil, ErrInvalidTransactionID.WithContext(
+			"reason", "transactionID cannot be empty",
+		)
+	}
+
+	now := time.Now()
+
+	review := &DiscrepancyReview{
+		reviewID:       NewReviewID(),
+		transactionID:  transactionID,
+		invoiceNumber:  invoiceNumber,
+		posAmount:      pos.GetAmount(),
+		posInvoiceDate: pos.GetInvoiceDate(),
+		scannedAmount:  scanned.GetAmount(),
+		scannedDate:    scanned.GetInvoiceDate(),
+		amountDelta:    result.AmountDelta(),
+		dateOffsetDays: result.DateOffsetDays(),
+		status:         ReviewStatusPending,
+		createdAt:      now,
+		updatedAt:      now,
+		events:         make([]shared.DomainEvent, 0),
+	}
+
+	review.addEvent(NewDiscrepancyReviewOpenedEvent(
+		review.reviewID,
+		transactionID,
+		review.amountDelta,
+		review.dateOffsetDays,
+	))
+
+	return review, nil
+}
+
+// ReconstructDiscrepancyReview 從持久化存儲重建聚合根
+//
+// 設計原則：
+// - 僅供 Repository 使用
+// - 不發布事件（事件已發生過）
+func ReconstructDiscrepancyReview(
+	reviewID ReviewID,
+	transactionID TransactionID,
+	invoiceNumber string,
+	posAmount int,
+	posInvoiceDate time.Time,
+	scannedAmount int,
+	scannedDate time.Time,
+	amountDelta int,
+	dateOffsetDays int,
+	status ReviewStatus,
+	resolvedBy string,
+	resolutionNote string,
+	resolvedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*DiscrepancyReview, error) {
+	if reviewID.IsEmpty() {
+		return nil, ErrInvalidReviewID.WithContext(
+			"reason", "invalid review ID in database",
+		)
+	}
+
+	if transactionID.IsEmpty() {
+		return nil, ErrInvalidTransactionID.WithContext(
+			"reason", "invalid transaction ID in database",
+		)
+	}
+
+	if !status.IsValid() {
+		return nil, ErrInvalidReviewStatus.WithContext(
+			"status", status.String(),
+			"reason", "invalid status in database",
+		)
+	}
+
+	if status != ReviewStatusPending && resolvedBy == "" {
+		return nil, ErrReviewerRequired.WithContext(
+			"review_id", reviewID.String(),
+			"reason", "closed review without resolver in database",
+		)
+	}
+
+	return &DiscrepancyReview{
+		reviewID:       reviewID,
+		transactionID:  transactionID,
+		invoiceNumber:  invoiceNumber,
+		posAmount:      posAmount,
+		posInvoiceDate: posInvoiceDate,
+		scannedAmount:  scannedAmount,
+		scannedDate:    scannedDate,
+		amountDelta:    amountDelta,
+		dateOffsetDays: dateOffsetDays,
+		status:         status,
+		resolvedBy:     resolvedBy,
+		resolutionNote: resolutionNote,
+		resolvedAt:     resolvedAt,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+		events:         make([]shared.DomainEvent, 0),
+	}, nil
+}
+
+// ===========================
+// 命令方法（狀態變更）
+// ===========================
+
+// Approve 核准差異（以 iChef 資料為準，發放積分）
+//
+// 參數：
+//   resolvedBy - 審核人員識別（必填，審計用途）
+//   note - 審核備註
+func (r *DiscrepancyReview) Approve(resolvedBy, note string) error {
+	return r.resolve(ReviewStatusApproved, resolvedBy, note)
+}
+
+// Reject 駁回差異（不發放積分）
+//
+// 注意：交易須仍為 imported（由 Application Layer 檢查），已入帳的交易不可經由駁回標記失敗
+func (r *DiscrepancyReview) Reject(resolvedBy, note string) error {
+	return r.resolve(ReviewStatusRejected, resolvedBy, note)
+}
+
+// CloseAsVerified 交易已由其他途徑驗證並入帳時結案（例如重新匯入的 iChef 記錄自動匹配）
+//
+// 以核准結案，審核人員記錄為 SystemResolver
+func (r *DiscrepancyReview) CloseAsVerified(note string) error {
+	return r.resolve(ReviewStatusApproved, SystemResolver, note)
+}
+
+// resolve 結案（私有方法，Approve / Reject 共用）
+func (r *DiscrepancyReview) resolve(status ReviewStatus, resolvedBy, note string) error {
+	if r.status != ReviewStatusPending {
+		return ErrReviewAlreadyClosed.WithContext(
+			"review_id", r.reviewID.String(),
+			"status", r.status.String(),
+		)
+	}
+
+	resolvedBy = strings.TrimSpace(resolvedBy)
+	if resolvedBy == "" {
+		return ErrReviewerRequired.WithContext(
+			"review_id", r.reviewID.String(),
+		)
+	}
+
+	now := time.Now()
+	r.status = status
+	r.resolvedBy = resolvedBy
+	r.resolutionNote = note
+	r.resolvedAt = &now
+	r.updatedAt = now
+
+	r.addEvent(NewDiscrepancyReviewResolvedEvent(
+		r.reviewID,
+		r.transactionID,
+		status,
+		resolvedBy,
+		note,
+	))
+
+	return nil
+}
+
+// ===========================
+// 查詢方法
+// ===========================
+
+// ReviewID 返回案件 ID
+func (r *DiscrepancyReview) ReviewID() ReviewID {
+	return r.reviewID
+}
+
+// TransactionID 返回交易 ID
+func (r *DiscrepancyReview) TransactionID() TransactionID {
+	return r.transactionID
+}
+
+// InvoiceNumber 返回發票號碼
+func (r *DiscrepancyReview) InvoiceNumber() string {
+	return r.invoiceNumber
+}
+
+// PosAmount 返回 iChef 金額
+func (r *DiscrepancyReview) PosAmount() int {
+	return r.posAmount
+}
+
+// PosInvoiceDate 返回 iChef 發票日期
+func (r *DiscrepancyReview) PosInvoiceDate() time.Time {
+	return r.posInvoiceDate
+}
+
+// ScannedAmount 返回掃描發票金額
+func (r *DiscrepancyReview) ScannedAmount() int {
+	return r.scannedAmount
+}
+
+// ScannedDate 返回掃描發票日期
+func (r *DiscrepancyReview) ScannedDate() time.Time {
+	return r.scannedDate
+}
+
+// AmountDelta 返回金額差（絕對值）
+func (r *DiscrepancyReview) AmountDelta() int {
+	return r.amountDelta
+}
+
+// DateOffsetDays 返回日期差（絕對值）
+func (r *DiscrepancyReview) DateOffsetDays() int {
+	return r.dateOffsetDays
+}
+
+// Status 返回審核狀態
+func (r *DiscrepancyReview) Status() ReviewStatus {
+	return r.status
+}
+
+// IsPending 判斷是否待處理
+func (r *DiscrepancyReview) IsPending() bool {
+	return r.status == ReviewStatusPending
+}
+
+// ResolvedBy 返回審核人員（未結案時為空字串）
+func (r *DiscrepancyReview) ResolvedBy() string {
+	return r.resolvedBy
+}
+
+// ResolutionNote 返回審核備註
+func (r *DiscrepancyReview) ResolutionNote() string {
+	return r.resolutionNote
+}
+
+// ResolvedAt 返回結案時間（未結案時為 nil）
+func (r *DiscrepancyReview) ResolvedAt() *time.Time {
+	return r.resolvedAt
+}
+
+// CreatedAt 返回創建時間
+func (r *DiscrepancyReview) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt 返回最後更新時間
+func (r *DiscrepancyReview) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// ===========================
+// 事件管理
+// ===========================
+
+// addEvent 添加領域事件到待發布列表（私有方法）
+func (r *DiscrepancyReview) addEvent(event shared.DomainEvent) {
+	r.events = append(r.events, event)
+}
+
+// PullEvents 獲取所有待發布事件並清空列表
+func (r *DiscrepancyReview) PullEvents() []shared.DomainEvent {
+	events := r.events
+	r.events = make([]shared.DomainEvent, 0)
+	return events
+}
@@ -0,0 +1,106 @@
+package external_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/external"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestReview 創建測試用審核案件（金額差 30 元）
+func newTestReview(t *testing.T) *external.DiscrepancyReview {
+	day := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	pos := stubInvoice{amount: 1030, date: day}
+	scanned := stubInvoice{amount: 1000, date: day}
+	result := external.NewInvoiceMatchingService(external.DefaultMatchingPolicy()).Match(pos, scanned)
+
+	review, err := external.OpenDiscrepancyReview(
+		shared.NewEntityID[external.TransactionMarker](),
+		"AB12345678",
+		pos,
+		scanned,
+		result,
+	)
+	require.NoError(t, err)
+	return review
+}
+
+// Test 1: 開立案件保存雙方資料快照
+func TestOpenDiscrepancyReview_Success(t *testing.T) {
+	review := newTestReview(t)
+
+	assert.True(t, review.IsPending())
+	assert.Equal(t, 1030, review.PosAmount())
+	assert.Equal(t, 1000, review.ScannedAmount())
+	assert.Equal(t, 30, review.AmountDelta())
+
+	events := review.PullEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "external.discrepancy_review_opened", events[0].EventType())
+}
+
+// Test 2: 空交易 ID
+func TestOpenDiscrepancyReview_EmptyTransactionID_ReturnsError(t *testing.T) {
+	review, err := external.OpenDiscrepancyReview(
+		external.TransactionID{}, "AB12345678", stubInvoice{}, stubInvoice{}, external.MatchResult{},
+	)
+
+	assert.Nil(t, review)
+	assert.ErrorIs(t, err, external.ErrInvalidTransactionID)
+}
+
+// Test 3: 核准記錄審核人員
+func TestDiscrepancyReview_Approve_RecordsResolver(t *testing.T) {
+	review := newTestReview(t)
+	review.PullEvents()
+
+	err := review.Approve("admin@bar", "POS 金額正確")
+
+	require.NoError(t, err)
+	assert.Equal(t, external.ReviewStatusApproved, review.Status())
+	assert.Equal(t, "admin@bar", review.ResolvedBy())
+	assert.Equal(t, "POS 金額正確", review.ResolutionNote())
+	assert.NotNil(t, review.ResolvedAt())
+
+	events := review.PullEvents()
+	require.Len(t, events, 1)
+	resolved, ok := events[0].(*external.DiscrepancyReviewResolvedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "admin@bar", resolved.ResolvedBy())
+}
+
+// Test 4: 缺少審核人員
+func TestDiscrepancyReview_Reject_WithoutResolver_ReturnsError(t *testing.T) {
+	review := newTestReview(t)
+
+	err := review.Reject("  ", "")
+
+	assert.ErrorIs(t, err, external.ErrReviewerRequired)
+	assert.True(t, review.IsPending())
+}
+
+// Test 5: 已結案不可再次處理
+func TestDiscrepancyReview_AlreadyClosed_ReturnsError(t *testing.T) {
+	review := newTestReview(t)
+	require.NoError(t, review.Reject("admin", "金額不符"))
+
+	err := review.Approve("admin", "改判")
+
+	assert.ErrorIs(t, err, external.ErrReviewAlreadyClosed)
+	assert.Equal(t, external.ReviewStatusRejected, review.Status())
+}
+
+// Test 6: 交易已由其他途徑驗證 → 系統以核准結案
+func TestDiscrepancyReview_CloseAsVerified(t *testing.T) {
+	review := newTestReview(t)
+
+	err := review.CloseAsVerified("ichef_exact_match")
+
+	require.NoError(t, err)
+	assert.Equal(t, external.ReviewStatusApproved, review.Status())
+	assert.Equal(t, external.SystemResolver, review.ResolvedBy())
+	assert.ErrorIs(t, review.Reject("admin", "金額不符"), external.ErrReviewAlreadyClosed)
+}
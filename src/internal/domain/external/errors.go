@@ -0,0 +1,152 @@
+package external
+
+import "fmt"
+
+// ===========================
+// 錯誤代碼定義
+// ===========================
+
+// ErrorCode 錯誤代碼類型
+type ErrorCode string
+
+// 錯誤代碼常量
+const (
+	// 識別符相關
+	ErrCodeInvalidReviewID      ErrorCode = "REVIEW_ID_INVALID"
+	ErrCodeInvalidTransactionID ErrorCode = "TRANSACTION_ID_INVALID"
+
+	// 匹配規則相關
+	ErrCodeInvalidMatchingTolerance ErrorCode = "MATCHING_TOLERANCE_INVALID"
+	ErrCodeInvalidMatchingPolicy    ErrorCode = "MATCHING_POLICY_INVALID"
+
+	// 審核案件相關
+	ErrCodeInvalidReviewStatus ErrorCode = "REVIEW_STATUS_INVALID"
+	ErrCodeReviewAlreadyClosed ErrorCode = "REVIEW_ALREADY_CLOSED"
+	ErrCodeReviewerRequired    ErrorCode = "REVIEW_RESOLVER_REQUIRED"
+
+	// Repository 相關
+	ErrCodeReviewNotFound      ErrorCode = "REVIEW_NOT_FOUND"
+	ErrCodeReviewAlreadyExists ErrorCode = "REVIEW_ALREADY_EXISTS"
+)
+
+// ===========================
+// DomainError 結構
+// ===========================
+
+// DomainError 外部系統整合領域錯誤
+// 設計原則：
+// 1. 包含結構化的錯誤代碼（用於 HTTP 狀態碼映射）
+// 2. 支持上下文信息（用於調試和日誌）
+// 3. 不可變性（創建後不可修改）
+type DomainError struct {
+	Code    ErrorCode
+	Message string
+	Context map[string]interface{}
+}
+
+// Error 實現 error 接口
+func (e *DomainError) Error() string {
+	if len(e.Context) == 0 {
+		return fmt.Sprintf("[%s] %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("[%s] %s (context: %+v)", e.Code, e.Message, e.Context)
+}
+
+// WithContext 添加上下文信息（返回新的錯誤實例，保持不可變性）
+func (e *DomainError) WithContext(keyValues ...interface{}) error {
+	if len(keyValues)%2 != 0 {
+		panic("WithContext requires even number of arguments (key-value pairs)")
+	}
+
+	ctx := make(map[string]interface{}, len(e.Context)+len(keyValues)/2)
+
+	// 複製現有上下文
+	for k, v := range e.Context {
+		ctx[k] = v
+	}
+
+	// 添加新上下文
+	for i := 0; i < len(keyValues); i += 2 {
+		key, ok := keyValues[i].(string)
+		if !ok {
+			panic(fmt.Sprintf("context key must be string, got %T", keyValues[i]))
+		}
+		ctx[key] = keyValues[i+1]
+	}
+
+	return &DomainError{
+		Code:    e.Code,
+		Message: e.Message,
+		Context: ctx,
+	}
+}
+
+// Is 實現 errors.Is 接口（用於錯誤類型判斷）
+func (e *DomainError) Is(target error) bool {
+	t, ok := target.(*DomainError)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+// ===========================
+// 預定義錯誤
+// ===========================
+
+// 識別符相關錯誤
+var (
+	ErrInvalidReviewID = &DomainError{
+		Code:    ErrCodeInvalidReviewID,
+		Message: "無效的審核案件 ID",
+	}
+
+	ErrInvalidTransactionID = &DomainError{
+		Code:    ErrCodeInvalidTransactionID,
+		Message: "無效的交易 ID",
+	}
+)
+
+// 匹配規則相關錯誤
+var (
+	ErrInvalidMatchingTolerance = &DomainError{
+		Code:    ErrCodeInvalidMatchingTolerance,
+		Message: "匹配容差無效（金額差 0-1000 元，日期差 0-7 天）",
+	}
+
+	ErrInvalidMatchingPolicy = &DomainError{
+		Code:    ErrCodeInvalidMatchingPolicy,
+		Message: "匹配策略無效（人工審核容差必須涵蓋自動匹配容差）",
+	}
+)
+
+// 審核案件相關錯誤
+var (
+	ErrInvalidReviewStatus = &DomainError{
+		Code:    ErrCodeInvalidReviewStatus,
+		Message: "無效的審核狀態",
+	}
+
+	ErrReviewAlreadyClosed = &DomainError{
+		Code:    ErrCodeReviewAlreadyClosed,
+		Message: "審核案件已結案，無法再次處理",
+	}
+
+	ErrReviewerRequired = &DomainError{
+		Code:    ErrCodeReviewerRequired,
+		Message: "必須記錄審核人員",
+	}
+)
+
+// Repository 相關錯誤
+var (
+	ErrReviewNotFound = &DomainError{
+		Code:    ErrCodeReviewNotFound,
+		Message: "審核案件不存在",
+	}
+
+	ErrReviewAlreadyExists = &DomainError{
+		Code:    ErrCodeReviewAlreadyExists,
+		Message: "此交易已有待處理的審核案件",
+	}
+)
@@ -0,0 +1,157 @@
+package external
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ===========================
+// DiscrepancyReviewOpened 領域事件
+// ===========================
+
+// DiscrepancyReviewOpenedEvent 差異審核案件已建立事件
+type DiscrepancyReviewOpenedEvent struct {
+	eventID        string
+	reviewID       ReviewID
+	transactionID  TransactionID
+	amountDelta    int
+	dateOffsetDays int
+	occurredAt     time.Time
+}
+
+// NewDiscrepancyReviewOpenedEvent 創建審核案件已建立事件
+func NewDiscrepancyReviewOpenedEvent(
+	reviewID ReviewID,
+	transactionID TransactionID,
+	amountDelta int,
+	dateOffsetDays int,
+) *DiscrepancyReviewOpenedEvent {
+	return &DiscrepancyReviewOpenedEvent{
+		eventID:        uuid.New().String(),
+		reviewID:       reviewID,
+		transactionID:  transactionID,
+		amountDelta:    amountDelta,
+		dateOffsetDays: dateOffsetDays,
+		occurredAt:     time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *DiscrepancyReviewOpenedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *DiscrepancyReviewOpenedEvent) EventType() string {
+	return "external.discrepancy_review_opened"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *DiscrepancyReviewOpenedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *DiscrepancyReviewOpenedEvent) AggregateID() string {
+	return e.reviewID.String()
+}
+
+// ReviewID 獲取審核案件 ID
+func (e *DiscrepancyReviewOpenedEvent) ReviewID() ReviewID {
+	return e.reviewID
+}
+
+// TransactionID 獲取交易 ID
+func (e *DiscrepancyReviewOpenedEvent) TransactionID() TransactionID {
+	return e.transactionID
+}
+
+// AmountDelta 獲取金額差
+func (e *DiscrepancyReviewOpenedEvent) AmountDelta() int {
+	return e.amountDelta
+}
+
+// DateOffsetDays 獲取日期差
+func (e *DiscrepancyReviewOpenedEvent) DateOffsetDays() int {
+	return e.dateOffsetDays
+}
+
+// ===========================
+// DiscrepancyReviewResolved 領域事件
+// ===========================
+
+// DiscrepancyReviewResolvedEvent 差異審核案件已結案事件（核准或駁回）
+type DiscrepancyReviewResolvedEvent struct {
+	eventID        string
+	reviewID       ReviewID
+	transactionID  TransactionID
+	status         ReviewStatus
+	resolvedBy     string
+	resolutionNote string
+	occurredAt     time.Time
+}
+
+// NewDiscrepancyReviewResolvedEvent 創建審核案件已結案事件
+func NewDiscrepancyReviewResolvedEvent(
+	reviewID ReviewID,
+	transactionID TransactionID,
+	status ReviewStatus,
+	resolvedBy string,
+	resolutionNote string,
+) *DiscrepancyReviewResolvedEvent {
+	return &DiscrepancyReviewResolvedEvent{
+		eventID:        uuid.New().String(),
+		reviewID:       reviewID,
+		transactionID:  transactionID,
+		status:         status,
+		resolvedBy:     resolvedBy,
+		resolutionNote: resolutionNote,
+		occurredAt:     time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *DiscrepancyReviewResolvedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *DiscrepancyReviewResolvedEvent) EventType() string {
+	return "external.discrepancy_review_resolved"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *DiscrepancyReviewResolvedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *DiscrepancyReviewResolvedEvent) AggregateID() string {
+	return e.reviewID.String()
+}
+
+// ReviewID 獲取審核案件 ID
+func (e *DiscrepancyReviewResolvedEvent) ReviewID() ReviewID {
+	return e.reviewID
+}
+
+// TransactionID 獲取交易 ID
+func (e *DiscrepancyReviewResolvedEvent) TransactionID() TransactionID {
+	return e.transactionID
+}
+
+// Status 獲取結案狀態（approved / rejected）
+func (e *DiscrepancyReviewResolvedEvent) Status() ReviewStatus {
+	return e.status
+}
+
+// ResolvedBy 獲取審核人員
+func (e *DiscrepancyReviewResolvedEvent) ResolvedBy() string {
+	return e.resolvedBy
+}
+
+// ResolutionNote 獲取審核備註
+func (e *DiscrepancyReviewResolvedEvent) ResolutionNote() string {
+	return e.resolutionNote
+}
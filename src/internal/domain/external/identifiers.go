@@ -0,0 +1,57 @@
+package external
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// 實體 ID 類型定義
+// ===========================
+
+// 設計原則：使用泛型 EntityID[T]（與其他上下文一致）
+//
+// 注意：external.TransactionID 與 invoice.TransactionID 是不同類型
+// 跨上下文傳遞時使用 String() 轉換
+
+// ===========================
+// ReviewID - 差異審核案件 ID
+// ===========================
+
+// ReviewMarker 是 ReviewID 的標記類型
+type ReviewMarker struct{}
+
+// ReviewID 差異審核案件的唯一標識符
+type ReviewID = shared.EntityID[ReviewMarker]
+
+// NewReviewID 生成新的審核案件 ID（UUID v4）
+func NewReviewID() ReviewID {
+	return shared.NewEntityID[ReviewMarker]()
+}
+
+// ReviewIDFromString 從字串解析審核案件 ID
+//
+// 返回：
+//   ReviewID - 解析成功的 ID
+//   error - 解析失敗（返回 ErrInvalidReviewID）
+func ReviewIDFromString(s string) (ReviewID, error) {
+	return shared.EntityIDFromString[ReviewMarker](s, ErrInvalidReviewID)
+}
+
+// ===========================
+// TransactionID - 發票交易 ID（引用）
+// ===========================
+
+// TransactionMarker 是 TransactionID 的標記類型
+type TransactionMarker struct{}
+
+// TransactionID 發票交易的唯一標識符（外部整合上下文內的引用）
+type TransactionID = shared.EntityID[TransactionMarker]
+
+// TransactionIDFromString 從字串解析交易 ID
+//
+// 返回：
+//   TransactionID - 解析成功的 ID
+//   error - 解析失敗（返回 ErrInvalidTransactionID）
+func TransactionIDFromString(s string) (TransactionID, error) {
+	return shared.EntityIDFromString[TransactionMarker](s, ErrInvalidTransactionID)
+}
@@ -0,0 +1,104 @@
+package external
+
+import "time"
+
+// ===========================
+// MatchableInvoice 介面
+// ===========================
+
+// MatchableInvoice 可進行匹配的發票資料
+//
+// 實作者：
+// - invoice.InvoiceTransaction（會員掃描的發票）
+// - iChef 匯入記錄（POS 端資料，由 Application Layer 提供）
+//
+// 設計原則：結構化型別，外部上下文不 import invoice 包
+type MatchableInvoice interface {
+	GetAmount() int
+	GetInvoiceDate() time.Time
+}
+
+// ===========================
+// MatchResult 匹配結果值對象
+// ===========================
+
+// MatchResult 單筆發票的匹配結果
+type MatchResult struct {
+	outcome        MatchOutcome
+	amountDelta    int // 金額差（絕對值）
+	dateOffsetDays int // 日期差（絕對值，日曆日）
+}
+
+// Outcome 返回匹配結果
+func (r MatchResult) Outcome() MatchOutcome {
+	return r.outcome
+}
+
+// AmountDelta 返回金額差（絕對值）
+func (r MatchResult) AmountDelta() int {
+	return r.amountDelta
+}
+
+// DateOffsetDays 返回日期差（絕對值）
+func (r MatchResult) DateOffsetDays() int {
+	return r.dateOffsetDays
+}
+
+// IsExact 判斷是否完全一致（金額與日期皆無差異）
+func (r MatchResult) IsExact() bool {
+	return r.amountDelta == 0 && r.dateOffsetDays == 0
+}
+
+// ===========================
+// InvoiceMatchingService 領域服務
+// ===========================
+
+// InvoiceMatchingService iChef 發票匹配服務
+//
+// 職責：
+// - 比對 iChef 記錄與會員掃描的發票（金額、日期）
+// - 依 MatchingPolicy 判定 matched / near_miss / mismatch
+//
+// 設計原則：
+// - 無狀態（策略在建構時注入，不可變）
+// - 不負責狀態變更（由 Application Layer 協調 InvoiceTransaction 與 DiscrepancyReview）
+type InvoiceMatchingService struct {
+	policy MatchingPolicy
+}
+
+// NewInvoiceMatchingService 創建匹配服務
+func NewInvoiceMatchingService(policy MatchingPolicy) *InvoiceMatchingService {
+	return &InvoiceMatchingService{policy: policy}
+}
+
+// Policy 返回目前使用的匹配策略
+func (s *InvoiceMatchingService) Policy() MatchingPolicy {
+	return s.policy
+}
+
+// Match 比對 POS 記錄與掃描的發票
+//
+// 參數：
+//   pos - iChef 記錄（權威資料）
+//   scanned - 會員掃描的發票
+func (s *InvoiceMatchingService) Match(pos, scanned MatchableInvoice) MatchResult {
+	amountDelta := pos.GetAmount() - scanned.GetAmount()
+	if amountDelta < 0 {
+		amountDelta = -amountDelta
+	}
+	dateOffset := calendarDayOffset(pos.GetInvoiceDate(), scanned.GetInvoiceDate())
+
+	outcome := MatchOutcomeMismatch
+	switch {
+	case s.policy.AutoMatch().Allows(amountDelta, dateOffset):
+		outcome = MatchOutcomeMatched
+	case s.policy.Review().Allows(amountDelta, dateOffset):
+		outcome = MatchOutcomeNearMiss
+	}
+
+	return MatchResult{
+		outcome:        outcome,
+		amountDelta:    amountDelta,
+		dateOffsetDays: dateOffset,
+	}
+}
@@ -0,0 +1,100 @@
+package external_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/external"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubInvoice 測試用 MatchableInvoice
+type stubInvoice struct {
+	amount int
+	date   time.Time
+}
+
+func (s stubInvoice) GetAmount() int            { return s.amount }
+func (s stubInvoice) GetInvoiceDate() time.Time { return s.date }
+
+// ===========================
+// MatchingTolerance / MatchingPolicy 測試
+// ===========================
+
+// Test 1: 容差範圍驗證
+func TestNewMatchingTolerance_OutOfRange_ReturnsError(t *testing.T) {
+	_, err := external.NewMatchingTolerance(-1, 0)
+	assert.ErrorIs(t, err, external.ErrInvalidMatchingTolerance)
+
+	_, err = external.NewMatchingTolerance(1001, 0)
+	assert.ErrorIs(t, err, external.ErrInvalidMatchingTolerance)
+
+	_, err = external.NewMatchingTolerance(0, 8)
+	assert.ErrorIs(t, err, external.ErrInvalidMatchingTolerance)
+
+	tolerance, err := external.NewMatchingTolerance(1000, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 1000, tolerance.MaxAmountDelta())
+	assert.Equal(t, 7, tolerance.MaxDateOffsetDays())
+}
+
+// Test 2: 審核容差必須涵蓋自動匹配容差
+func TestNewMatchingPolicy_ReviewNarrowerThanAuto_ReturnsError(t *testing.T) {
+	auto, _ := external.NewMatchingTolerance(10, 1)
+	review, _ := external.NewMatchingTolerance(5, 2)
+
+	_, err := external.NewMatchingPolicy(auto, review)
+
+	assert.ErrorIs(t, err, external.ErrInvalidMatchingPolicy)
+}
+
+// ===========================
+// InvoiceMatchingService 測試
+// ===========================
+
+// Test 3: 依預設策略分類
+func TestInvoiceMatchingService_Match_DefaultPolicy(t *testing.T) {
+	service := external.NewInvoiceMatchingService(external.DefaultMatchingPolicy())
+	day := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	scanned := stubInvoice{amount: 1000, date: day}
+
+	tests := []struct {
+		name    string
+		pos     stubInvoice
+		outcome external.MatchOutcome
+		delta   int
+		days    int
+	}{
+		{"exact", stubInvoice{1000, day}, external.MatchOutcomeMatched, 0, 0},
+		{"amount within review", stubInvoice{1050, day}, external.MatchOutcomeNearMiss, 50, 0},
+		{"previous calendar day", stubInvoice{1000, time.Date(2025, 1, 14, 23, 59, 0, 0, time.UTC)}, external.MatchOutcomeNearMiss, 0, 1},
+		{"amount too far", stubInvoice{1051, day}, external.MatchOutcomeMismatch, 51, 0},
+		{"date too far", stubInvoice{1000, day.AddDate(0, 0, 2)}, external.MatchOutcomeMismatch, 0, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := service.Match(tt.pos, scanned)
+
+			assert.Equal(t, tt.outcome, result.Outcome())
+			assert.Equal(t, tt.delta, result.AmountDelta())
+			assert.Equal(t, tt.days, result.DateOffsetDays())
+		})
+	}
+}
+
+// Test 4: 自訂自動匹配容差
+func TestInvoiceMatchingService_Match_CustomAutoTolerance(t *testing.T) {
+	auto, _ := external.NewMatchingTolerance(5, 0)
+	review, _ := external.NewMatchingTolerance(100, 3)
+	policy, err := external.NewMatchingPolicy(auto, review)
+	require.NoError(t, err)
+	service := external.NewInvoiceMatchingService(policy)
+	day := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	result := service.Match(stubInvoice{995, day}, stubInvoice{1000, day})
+
+	assert.Equal(t, external.MatchOutcomeMatched, result.Outcome())
+	assert.False(t, result.IsExact())
+}
@@ -0,0 +1,36 @@
+package external
+
+import "github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+
+// ===========================
+// DiscrepancyReview Repository 介面
+// ===========================
+
+// DiscrepancyReviewRepository 差異審核案件倉儲介面
+//
+// 設計原則：
+// 1. 依賴倒置原則（DIP）：Domain Layer 定義介面，Infrastructure Layer 實作
+// 2. 事務支持：寫操作 ctx 必須 non-nil，讀操作 ctx 可為 nil
+type DiscrepancyReviewRepository interface {
+	// Save 保存新的審核案件
+	Save(ctx shared.TransactionContext, review *DiscrepancyReview) error
+
+	// Update 更新審核案件（核准 / 駁回）
+	//
+	// 錯誤：ErrReviewNotFound（如果案件不存在）
+	Update(ctx shared.TransactionContext, review *DiscrepancyReview) error
+
+	// FindByID 根據案件 ID 查找
+	//
+	// 返回：找到的案件，或 ErrReviewNotFound
+	FindByID(ctx shared.TransactionContext, id ReviewID) (*DiscrepancyReview, error)
+
+	// FindPendingByTransactionID 查找交易的待處理案件
+	//
+	// 使用場景：重複匯入時避免同一交易開立多個案件
+	// 返回：找到的案件，或 ErrReviewNotFound
+	FindPendingByTransactionID(ctx shared.TransactionContext, transactionID TransactionID) (*DiscrepancyReview, error)
+
+	// FindPending 分頁查詢待處理案件（依建立時間升冪，先進先審）
+	FindPending(ctx shared.TransactionContext, limit, offset int) ([]*DiscrepancyReview, error)
+}
@@ -0,0 +1,200 @@
+package external
+
+import "time"
+
+// ===========================
+// MatchingTolerance 匹配容差值對象
+// ===========================
+
+// 容差上限（防止設定錯誤導致大量誤判為匹配）
+const (
+	MaxAllowedAmountDelta    = 1000 // 金額差上限（元）
+	MaxAllowedDateOffsetDays = 7    // 日期差上限（天）
+)
+
+// MatchingTolerance iChef 與掃描發票之間可接受的差異範圍
+//
+// 業務規則：
+// 1. 金額差 0-1000 元（絕對值）
+// 2. 日期差 0-7 天（以日曆日計算）
+//
+// 設計原則：
+// - 不可變性（unexported fields）
+// - 自我驗證（建構函數強制範圍檢查）
+type MatchingTolerance struct {
+	maxAmountDelta    int
+	maxDateOffsetDays int
+}
+
+// NewMatchingTolerance 創建匹配容差（Checked Constructor）
+func NewMatchingTolerance(maxAmountDelta, maxDateOffsetDays int) (MatchingTolerance, error) {
+	if maxAmountDelta < 0 || maxAmountDelta > MaxAllowedAmountDelta {
+		return MatchingTolerance{}, ErrInvalidMatchingTolerance.WithContext(
+			"max_amount_delta", maxAmountDelta,
+		)
+	}
+	if maxDateOffsetDays < 0 || maxDateOffsetDays > MaxAllowedDateOffsetDays {
+		return MatchingTolerance{}, ErrInvalidMatchingTolerance.WithContext(
+			"max_date_offset_days", maxDateOffsetDays,
+		)
+	}
+	return MatchingTolerance{
+		maxAmountDelta:    maxAmountDelta,
+		maxDateOffsetDays: maxDateOffsetDays,
+	}, nil
+}
+
+// ExactMatchTolerance 零容差（金額與日期必須完全一致）
+func ExactMatchTolerance() MatchingTolerance {
+	return MatchingTolerance{}
+}
+
+// MaxAmountDelta 返回金額差上限（元）
+func (t MatchingTolerance) MaxAmountDelta() int {
+	return t.maxAmountDelta
+}
+
+// MaxDateOffsetDays 返回日期差上限（天）
+func (t MatchingTolerance) MaxDateOffsetDays() int {
+	return t.maxDateOffsetDays
+}
+
+// Allows 判斷差異是否在容差內
+//
+// 參數皆為絕對值（由 InvoiceMatchingService 計算）
+func (t MatchingTolerance) Allows(amountDelta, dateOffsetDays int) bool {
+	return amountDelta <= t.maxAmountDelta && dateOffsetDays <= t.maxDateOffsetDays
+}
+
+// Covers 判斷此容差是否涵蓋另一個容差（兩個維度皆 >=）
+func (t MatchingTolerance) Covers(other MatchingTolerance) bool {
+	return t.maxAmountDelta >= other.maxAmountDelta &&
+		t.maxDateOffsetDays >= other.maxDateOffsetDays
+}
+
+// ===========================
+// MatchingPolicy 匹配策略值對象
+// ===========================
+
+// MatchingPolicy iChef 匹配策略（兩段式容差）
+//
+// 判定規則：
+//   差異在 autoMatch 內 → 自動驗證並發放積分
+//   差異在 review 內    → 進入差異審核佇列，由管理員核准或駁回
+//   超出 review         → 不匹配
+//
+// 不變量：review 必須涵蓋 autoMatch
+type MatchingPolicy struct {
+	autoMatch MatchingTolerance
+	review    MatchingTolerance
+}
+
+// NewMatchingPolicy 創建匹配策略（Checked Constructor）
+func NewMatchingPolicy(autoMatch, review MatchingTolerance) (MatchingPolicy, error) {
+	if !review.Covers(autoMatch) {
+		return MatchingPolicy{}, ErrInvalidMatchingPolicy.WithContext(
+			"auto_amount_delta", autoMatch.MaxAmountDelta(),
+			"auto_date_offset_days", autoMatch.MaxDateOffsetDays(),
+			"review_amount_delta", review.MaxAmountDelta(),
+			"review_date_offset_days", review.MaxDateOffsetDays(),
+		)
+	}
+	return MatchingPolicy{autoMatch: autoMatch, review: review}, nil
+}
+
+// DefaultMatchingPolicy 預設匹配策略
+//
+// - 自動匹配：金額與日期完全一致（BR-005-02）
+// - 人工審核：金額差 50 元內、日期差 1 天內
+func DefaultMatchingPolicy() MatchingPolicy {
+	return MatchingPolicy{
+		autoMatch: ExactMatchTolerance(),
+		review:    MatchingTolerance{maxAmountDelta: 50, maxDateOffsetDays: 1},
+	}
+}
+
+// AutoMatch 返回自動匹配容差
+func (p MatchingPolicy) AutoMatch() MatchingTolerance {
+	return p.autoMatch
+}
+
+// Review 返回人工審核容差
+func (p MatchingPolicy) Review() MatchingTolerance {
+	return p.review
+}
+
+// ===========================
+// MatchOutcome 匹配結果枚舉
+// ===========================
+
+// MatchOutcome iChef 匹配結果
+type MatchOutcome string
+
+const (
+	MatchOutcomeMatched  MatchOutcome = "matched"   // 在自動匹配容差內
+	MatchOutcomeNearMiss MatchOutcome = "near_miss" // 在人工審核容差內
+	MatchOutcomeMismatch MatchOutcome = "mismatch"  // 超出所有容差
+)
+
+// String 返回匹配結果字串
+func (o MatchOutcome) String() string {
+	return string(o)
+}
+
+// ===========================
+// ReviewStatus 審核狀態枚舉
+// ===========================
+
+// ReviewStatus 差異審核案件狀態
+//
+// 狀態流轉：
+//   pending → approved（核准：以 iChef 資料驗證交易並發放積分）
+//   pending → rejected（駁回：交易標記失敗，不發放積分）
+type ReviewStatus string
+
+const (
+	ReviewStatusPending  ReviewStatus = "pending"
+	ReviewStatusApproved ReviewStatus = "approved"
+	ReviewStatusRejected ReviewStatus = "rejected"
+)
+
+// ParseReviewStatus 從字串解析審核狀態
+func ParseReviewStatus(s string) (ReviewStatus, error) {
+	status := ReviewStatus(s)
+	if !status.IsValid() {
+		return "", ErrInvalidReviewStatus.WithContext("status", s)
+	}
+	return status, nil
+}
+
+// String 返回狀態字串
+func (s ReviewStatus) String() string {
+	return string(s)
+}
+
+// IsValid 判斷狀態是否為已定義的值
+func (s ReviewStatus) IsValid() bool {
+	switch s {
+	case ReviewStatusPending, ReviewStatusApproved, ReviewStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// ===========================
+// 輔助函數
+// ===========================
+
+// calendarDayOffset 計算兩個時間之間的日曆日差（絕對值）
+//
+// 以各自時區的年月日比較，避免時分秒造成 23:59 與 00:01 被視為同一天
+func calendarDayOffset(a, b time.Time) int {
+	da := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	db := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(da.Sub(db).Hours() / 24)
+	if days < 0 {
+		return -days
+	}
+	return days
+}
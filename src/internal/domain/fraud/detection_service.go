@@ -0,0 +1,110 @@
+package fraud
+
+import "time"
+
+// ===========================
+// ScanActivity 評估輸入
+// ===========================
+
+// ScanActivity 單次掃描的評估資料
+//
+// 由 Application Layer 透過 ScanActivityQuery 收集後傳入
+// 設計原則：領域服務只做判斷，不負責查詢（保持純函數、易於測試）
+type ScanActivity struct {
+	Amount                  int       // 本次發票金額（元）
+	ScannedAt               time.Time // 掃描時間
+	MemberRegisteredAt      time.Time // 會員註冊時間
+	RecentScanCount         int       // 頻率區間內的掃描數（含本次）
+	ClaimsSinceRegistration int       // 註冊以來的登錄數（含本次）
+	NearbyClaimMembers      int       // 連號範圍內的其他會員數
+}
+
+// ===========================
+// Assessment 評估結果值對象
+// ===========================
+
+// Assessment 風險評估結果
+type Assessment struct {
+	signals  []RiskSignal
+	decision Decision
+}
+
+// Signals 返回觸發的風險訊號（副本）
+func (a Assessment) Signals() []RiskSignal {
+	signals := make([]RiskSignal, len(a.signals))
+	copy(signals, a.signals)
+	return signals
+}
+
+// Decision 返回處置決策
+func (a Assessment) Decision() Decision {
+	return a.decision
+}
+
+// IsSuspicious 判斷是否觸發任一風險訊號
+func (a Assessment) IsSuspicious() bool {
+	return a.decision != DecisionAllow
+}
+
+// ===========================
+// FraudDetectionService 領域服務
+// ===========================
+
+// FraudDetectionService 規則式詐騙偵測服務
+//
+// 規則：
+// 1. 掃描頻率（scan_velocity）：區間內掃描數超過上限
+// 2. 高金額（high_amount）：單張金額超過門檻
+// 3. 連號發票（sequential_invoices）：多名會員登錄相鄰號碼（撿拾他桌發票）
+// 4. 新帳戶大量登錄（new_account_heavy_claims）
+//
+// 決策：
+// - 無訊號 → allow
+// - 掃描數超過 block 上限 → block
+// - 其他任一訊號 → hold
+type FraudDetectionService struct {
+	rules FraudRules
+}
+
+// NewFraudDetectionService 創建偵測服務
+func NewFraudDetectionService(rules FraudRules) *FraudDetectionService {
+	return &FraudDetectionService{rules: rules}
+}
+
+// Rules 返回目前使用的規則
+func (s *FraudDetectionService) Rules() FraudRules {
+	return s.rules
+}
+
+// Assess 評估單次掃描的風險
+func (s *FraudDetectionService) Assess(activity ScanActivity) Assessment {
+	cfg := s.rules.config
+	signals := make([]RiskSignal, 0)
+
+	if activity.RecentScanCount > cfg.MaxScansPerWindow {
+		signals = append(signals, SignalScanVelocity)
+	}
+
+	if activity.Amount > cfg.HighAmountThreshold {
+		signals = append(signals, SignalHighAmount)
+	}
+
+	if activity.NearbyClaimMembers >= cfg.SequentialMinMembers {
+		signals = append(signals, SignalSequentialInvoices)
+	}
+
+	isNewAccount := activity.ScannedAt.Sub(activity.MemberRegisteredAt) < cfg.NewAccountAge
+	if isNewAccount && activity.ClaimsSinceRegistration > cfg.NewAccountMaxClaims {
+		signals = append(signals, SignalNewAccountHeavyClaims)
+	}
+
+	decision := DecisionAllow
+	switch {
+	case activity.RecentScanCount > cfg.BlockScansPerWindow:
+		decision = DecisionBlock
+	case len(signals) > 0:
+		decision = DecisionHold
+	}
+
+	return Assessment{signals: signals, decision: decision}
+}
@@ -0,0 +1,118 @@
+package fraud_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/fraud"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// normalActivity 一般老會員的單次掃描（不觸發任何規則）
+func normalActivity() fraud.ScanActivity {
+	now := time.Date(2025, 1, 15, 22, 0, 0, 0, time.UTC)
+	return fraud.ScanActivity{
+		Amount:                  1200,
+		ScannedAt:               now,
+		MemberRegisteredAt:      now.AddDate(0, -6, 0),
+		RecentScanCount:         1,
+		ClaimsSinceRegistration: 30,
+		NearbyClaimMembers:      0,
+	}
+}
+
+// ===========================
+// FraudRules 測試
+// ===========================
+
+// Test 1: 無效設定（block 上限小於 hold 上限）
+func TestNewFraudRules_BlockBelowHold_ReturnsError(t *testing.T) {
+	cfg := fraud.DefaultFraudRules().Config()
+	cfg.BlockScansPerWindow = cfg.MaxScansPerWindow - 1
+
+	_, err := fraud.NewFraudRules(cfg)
+
+	assert.ErrorIs(t, err, fraud.ErrInvalidFraudRules)
+}
+
+// Test 2: 連號範圍計算（保留前導零與字軌）
+func TestFraudRules_SequentialNeighbourRange(t *testing.T) {
+	rules := fraud.DefaultFraudRules()
+
+	from, to, ok := rules.SequentialNeighbourRange("AB00000003")
+
+	require.True(t, ok)
+	assert.Equal(t, "AB00000000", from)
+	assert.Equal(t, "AB00000008", to)
+
+	_, _, ok = rules.SequentialNeighbourRange("invalid")
+	assert.False(t, ok)
+}
+
+// ===========================
+// FraudDetectionService 測試
+// ===========================
+
+// Test 3: 一般掃描放行
+func TestFraudDetectionService_Assess_Normal_Allows(t *testing.T) {
+	service := fraud.NewFraudDetectionService(fraud.DefaultFraudRules())
+
+	assessment := service.Assess(normalActivity())
+
+	assert.False(t, assessment.IsSuspicious())
+	assert.Equal(t, fraud.DecisionAllow, assessment.Decision())
+	assert.Empty(t, assessment.Signals())
+}
+
+// Test 4: 各規則個別觸發 hold
+func TestFraudDetectionService_Assess_EachRule_Holds(t *testing.T) {
+	service := fraud.NewFraudDetectionService(fraud.DefaultFraudRules())
+
+	tests := []struct {
+		name   string
+		modify func(a *fraud.ScanActivity)
+		signal fraud.RiskSignal
+	}{
+		{"velocity", func(a *fraud.ScanActivity) { a.RecentScanCount = 6 }, fraud.SignalScanVelocity},
+		{"high amount", func(a *fraud.ScanActivity) { a.Amount = 25000 }, fraud.SignalHighAmount},
+		{"sequential", func(a *fraud.ScanActivity) { a.NearbyClaimMembers = 3 }, fraud.SignalSequentialInvoices},
+		{"new account", func(a *fraud.ScanActivity) {
+			a.MemberRegisteredAt = a.ScannedAt.Add(-48 * time.Hour)
+			a.ClaimsSinceRegistration = 11
+		}, fraud.SignalNewAccountHeavyClaims},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			activity := normalActivity()
+			tt.modify(&activity)
+
+			assessment := service.Assess(activity)
+
+			assert.Equal(t, fraud.DecisionHold, assessment.Decision())
+			assert.Equal(t, []fraud.RiskSignal{tt.signal}, assessment.Signals())
+		})
+	}
+}
+
+// Test 5: 老帳戶大量登錄不觸發新帳戶規則
+func TestFraudDetectionService_Assess_OldAccountManyClaims_Allows(t *testing.T) {
+	service := fraud.NewFraudDetectionService(fraud.DefaultFraudRules())
+	activity := normalActivity()
+	activity.ClaimsSinceRegistration = 500
+
+	assert.False(t, service.Assess(activity).IsSuspicious())
+}
+
+// Test 6: 掃描數超過 block 上限 → block
+func TestFraudDetectionService_Assess_ExtremeVelocity_Blocks(t *testing.T) {
+	service := fraud.NewFraudDetectionService(fraud.DefaultFraudRules())
+	activity := normalActivity()
+	activity.RecentScanCount = 16
+
+	assessment := service.Assess(activity)
+
+	assert.Equal(t, fraud.DecisionBlock, assessment.Decision())
+	assert.Contains(t, assessment.Signals(), fraud.SignalScanVelocity)
+}
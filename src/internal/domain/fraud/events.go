@@ -0,0 +1,157 @@
+package fraud
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ===========================
+// FraudCaseOpened 領域事件
+// ===========================
+
+// FraudCaseOpenedEvent 調查案件已開立事件
+//
+// 訂閱者：通知上下文（即時通知管理員）
+type FraudCaseOpenedEvent struct {
+	eventID       string
+	caseID        CaseID
+	memberID      MemberID
+	transactionID TransactionID
+	signals       []RiskSignal
+	decision      Decision
+	occurredAt    time.Time
+}
+
+// NewFraudCaseOpenedEvent 創建案件已開立事件
+func NewFraudCaseOpenedEvent(
+	caseID CaseID,
+	memberID MemberID,
+	transactionID TransactionID,
+	signals []RiskSignal,
+	decision Decision,
+) *FraudCaseOpenedEvent {
+	return &FraudCaseOpenedEvent{
+		eventID:       uuid.New().String(),
+		caseID:        caseID,
+		memberID:      memberID,
+		transactionID: transactionID,
+		signals:       signals,
+		decision:      decision,
+		occurredAt:    time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *FraudCaseOpenedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *FraudCaseOpenedEvent) EventType() string {
+	return "fraud.case_opened"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *FraudCaseOpenedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *FraudCaseOpenedEvent) AggregateID() string {
+	return e.caseID.String()
+}
+
+// MemberID 獲取會員 ID
+func (e *FraudCaseOpenedEvent) MemberID() MemberID {
+	return e.memberID
+}
+
+// TransactionID 獲取交易 ID
+func (e *FraudCaseOpenedEvent) TransactionID() TransactionID {
+	return e.transactionID
+}
+
+// Signals 獲取風險訊號
+func (e *FraudCaseOpenedEvent) Signals() []RiskSignal {
+	return e.signals
+}
+
+// Decision 獲取處置決策
+func (e *FraudCaseOpenedEvent) Decision() Decision {
+	return e.decision
+}
+
+// ===========================
+// FraudCaseResolved 領域事件
+// ===========================
+
+// FraudCaseResolvedEvent 調查案件已結案事件
+type FraudCaseResolvedEvent struct {
+	eventID       string
+	caseID        CaseID
+	memberID      MemberID
+	transactionID TransactionID
+	status        CaseStatus
+	resolvedBy    string
+	occurredAt    time.Time
+}
+
+// NewFraudCaseResolvedEvent 創建案件已結案事件
+func NewFraudCaseResolvedEvent(
+	caseID CaseID,
+	memberID MemberID,
+	transactionID TransactionID,
+	status CaseStatus,
+	resolvedBy string,
+) *FraudCaseResolvedEvent {
+	return &FraudCaseResolvedEvent{
+		eventID:       uuid.New().String(),
+		caseID:        caseID,
+		memberID:      memberID,
+		transactionID: transactionID,
+		status:        status,
+		resolvedBy:    resolvedBy,
+		occurredAt:    time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *FraudCaseResolvedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *FraudCaseResolvedEvent) EventType() string {
+	return "fraud.case_resolved"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *FraudCaseResolvedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *FraudCaseResolvedEvent) AggregateID() string {
+	return e.caseID.String()
+}
+
+// MemberID 獲取會員 ID
+func (e *FraudCaseResolvedEvent) MemberID() MemberID {
+	return e.memberID
+}
+
+// TransactionID 獲取交易 ID
+func (e *FraudCaseResolvedEvent) TransactionID() TransactionID {
+	return e.transactionID
+}
+
+// Status 獲取結案狀態（cleared / confirmed）
+func (e *FraudCaseResolvedEvent) Status() CaseStatus {
+	return e.status
+}
+
+// ResolvedBy 獲取處理人員
+func (e *FraudCaseResolvedEvent) ResolvedBy() string {
+	return e.resolvedBy
+}
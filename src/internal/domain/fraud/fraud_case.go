@@ -0,0 +1,317 @@
+package fraud
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// FraudCase 聚合根
+// ===========================
+
+// FraudCase 詐騙調查案件聚合根
+//
+// 使用場景：
+// - 掃描評估觸發風險訊號時開立（hold / block）
+// - 管理員調查後排除嫌疑（Clear）或確認詐騙（Confirm）
+//
+// 不變量（Invariants）：
+// 1. 案件必須關聯會員與交易
+// 2. 至少包含一個風險訊號（allow 不開立案件）
+// 3. 狀態只能 open → cleared / confirmed，結案時必須記錄處理人員
+//
+// 設計原則：
+// - 不直接修改 InvoiceTransaction / PointsAccount（由 Application Layer 協調）
+type FraudCase struct {
+	// 識別欄位
+	caseID        CaseID
+	memberID      MemberID
+	transactionID TransactionID
+	invoiceNumber string
+
+	// 評估結果
+	signals  []RiskSignal
+	decision Decision
+
+	// 調查結果
+	status         CaseStatus
+	resolvedBy     string
+	resolutionNote string
+	resolvedAt     *time.Time
+
+	// 審計欄位
+	createdAt time.Time
+	updatedAt time.Time
+
+	// 待發布的領域事件
+	events []shared.DomainEvent
+}
+
+// ===========================
+// 建構函數（工廠方法）
+// ===========================
+
+// OpenFraudCase 開立調查案件
+//
+// 錯誤：
+// - ErrNotSuspicious（評估結果為 allow）
+func OpenFraudCase(
+	memberID MemberID,
+	transactionID TransactionID,
+	invoiceNumber string,
+	assessment Assessment,
+) (*FraudCase, error) {
+	if memberID.IsEmpty() {
+		return nil, ErrInvalidMemberID.WithContext(
+			"reason", "memberID cannot be empty",
+		)
+	}
+
+	if transactionID.IsEmpty() {
+		return nil, ErrInvalidTransactionID.WithContext(
+			"reason", "transactionID cannot be empty",
+		)
+	}
+
+	if !assessment.IsSuspicious() {
+		return nil, ErrNotSuspicious.WithContext(
+			"transaction_id", transactionID.String(),
+		)
+	}
+
+	now := time.Now()
+
+	c := &FraudCase{
+		caseID:        NewCaseID(),
+		memberID:      memberID,
+		transactionID: transactionID,
+		invoiceNumber: invoiceNumber,
+		signals:       assessment.Signals(),
+		decision:      assessment.Decision(),
+		status:        CaseStatusOpen,
+		createdAt:     now,
+		updatedAt:     now,
+		events:        make([]shared.DomainEvent, 0),
+	}
+
+	c.addEvent(NewFraudCaseOpenedEvent(
+		c.caseID,
+		memberID,
+		transactionID,
+		c.Signals(),
+		c.decision,
+	))
+
+	return c, nil
+}
+
+// ReconstructFraudCase 從持久化存儲重建聚合根
+//
+// 設計原則：
+// - 僅供 Repository 使用
+// - 不發布事件（事件已發生過）
+func ReconstructFraudCase(
+	caseID CaseID,
+	memberID MemberID,
+	transactionID TransactionID,
+	invoiceNumber string,
+	signals []RiskSignal,
+	decision Decision,
+	status CaseStatus,
+	resolvedBy string,
+	resolutionNote string,
+	resolvedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*FraudCase, error) {
+	if caseID.IsEmpty() {
+		return nil, ErrInvalidCaseID.WithContext(
+			"reason", "invalid case ID in database",
+		)
+	}
+
+	if memberID.IsEmpty() {
+		return nil, ErrInvalidMemberID.WithContext(
+			"reason", "invalid member ID in database",
+		)
+	}
+
+	if transactionID.IsEmpty() {
+		return nil, ErrInvalidTransactionID.WithContext(
+			"reason", "invalid transaction ID in database",
+		)
+	}
+
+	if !status.IsValid() {
+		return nil, ErrInvalidCaseStatus.WithContext(
+			"status", status.String(),
+			"reason", "invalid status in database",
+		)
+	}
+
+	for _, signal := range signals {
+		if !signal.IsValid() {
+			return nil, ErrInvalidCaseStatus.WithContext(
+				"signal", signal.String(),
+				"reason", "invalid signal in database",
+			)
+		}
+	}
+
+	return &FraudCase{
+		caseID:         caseID,
+		memberID:       memberID,
+		transactionID:  transactionID,
+		invoiceNumber:  invoiceNumber,
+		signals:        signals,
+		decision:       decision,
+		status:         status,
+		resolvedBy:     resolvedBy,
+		resolutionNote: resolutionNote,
+		resolvedAt:     resolvedAt,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+		events:         make([]shared.DomainEvent, 0),
+	}, nil
+}
+
+// ===========================
+// 命令方法（狀態變更）
+// ===========================
+
+// Clear 排除嫌疑
+//
+// 後續處理（Application Layer）：暫停的交易恢復為 imported
+func (c *FraudCase) Clear(resolvedBy, note string) error {
+	return c.resolve(CaseStatusCleared, resolvedBy, note)
+}
+
+// Confirm 確認詐騙
+//
+// 後續處理（Application Layer）：暫停的交易標記失敗，可選擇凍結帳戶
+func (c *FraudCase) Confirm(resolvedBy, note string) error {
+	return c.resolve(CaseStatusConfirmed, resolvedBy, note)
+}
+
+// resolve 結案（私有方法，Clear / Confirm 共用）
+func (c *FraudCase) resolve(status CaseStatus, resolvedBy, note string) error {
+	if c.status != CaseStatusOpen {
+		return ErrCaseAlreadyClosed.WithContext(
+			"case_id", c.caseID.String(),
+			"status", c.status.String(),
+		)
+	}
+
+	resolvedBy = strings.TrimSpace(resolvedBy)
+	if resolvedBy == "" {
+		return ErrResolverRequired.WithContext(
+			"case_id", c.caseID.String(),
+		)
+	}
+
+	now := time.Now()
+	c.status = status
+	c.resolvedBy = resolvedBy
+	c.resolutionNote = note
+	c.resolvedAt = &now
+	c.updatedAt = now
+
+	c.addEvent(NewFraudCaseResolvedEvent(
+		c.caseID,
+		c.memberID,
+		c.transactionID,
+		status,
+		resolvedBy,
+	))
+
+	return nil
+}
+
+// ===========================
+// 查詢方法
+// ===========================
+
+// CaseID 返回案件 ID
+func (c *FraudCase) CaseID() CaseID {
+	return c.caseID
+}
+
+// MemberID 返回會員 ID
+func (c *FraudCase) MemberID() MemberID {
+	return c.memberID
+}
+
+// TransactionID 返回交易 ID
+func (c *FraudCase) TransactionID() TransactionID {
+	return c.transactionID
+}
+
+// InvoiceNumber 返回發票號碼
+func (c *FraudCase) InvoiceNumber() string {
+	return c.invoiceNumber
+}
+
+// Signals 返回風險訊號（副本）
+func (c *FraudCase) Signals() []RiskSignal {
+	signals := make([]RiskSignal, len(c.signals))
+	copy(signals, c.signals)
+	return signals
+}
+
+// Decision 返回處置決策
+func (c *FraudCase) Decision() Decision {
+	return c.decision
+}
+
+// Status 返回案件狀態
+func (c *FraudCase) Status() CaseStatus {
+	return c.status
+}
+
+// IsOpen 判斷案件是否調查中
+func (c *FraudCase) IsOpen() bool {
+	return c.status == CaseStatusOpen
+}
+
+// ResolvedBy 返回處理人員
+func (c *FraudCase) ResolvedBy() string {
+	return c.resolvedBy
+}
+
+// ResolutionNote 返回處理備註
+func (c *FraudCase) ResolutionNote() string {
+	return c.resolutionNote
+}
+
+// ResolvedAt 返回結案時間（未結案時為 nil）
+func (c *FraudCase) ResolvedAt() *time.Time {
+	return c.resolvedAt
+}
+
+// CreatedAt 返回創建時間
+func (c *FraudCase) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// UpdatedAt 返回最後更新時間
+func (c *FraudCase) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// ===========================
+// 事件管理
+// ===========================
+
+// addEvent 添加領域事件到待發布列表（私有方法）
+func (c *FraudCase) addEvent(event shared.DomainEvent) {
+	c.events = append(c.events, event)
+}
+
+// PullEvents 獲取所有待發布事件並清空列表
+func (c *FraudCase) PullEvents() []shared.DomainEvent {
+	events := c.events
+	c.events = make([]shared.DomainEvent, 0)
+	return events
+}
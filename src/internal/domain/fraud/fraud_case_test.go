@@ -0,0 +1,68 @@
+package fraud_test
+
+import (
+	"testing"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/fraud"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// suspiciousAssessment 觸發高金額規則的評估結果
+func suspiciousAssessment() fraud.Assessment {
+	activity := normalActivity()
+	activity.Amount = 50000
+	return fraud.NewFraudDetectionService(fraud.DefaultFraudRules()).Assess(activity)
+}
+
+// Test 7: 開立案件並發布事件
+func TestOpenFraudCase_Success(t *testing.T) {
+	c, err := fraud.OpenFraudCase(
+		shared.NewEntityID[fraud.MemberMarker](),
+		shared.NewEntityID[fraud.TransactionMarker](),
+		"AB12345678",
+		suspiciousAssessment(),
+	)
+
+	require.NoError(t, err)
+	assert.True(t, c.IsOpen())
+	assert.Equal(t, fraud.DecisionHold, c.Decision())
+	assert.Equal(t, []fraud.RiskSignal{fraud.SignalHighAmount}, c.Signals())
+	events := c.PullEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "fraud.case_opened", events[0].EventType())
+}
+
+// Test 8: 放行的評估不可開案
+func TestOpenFraudCase_NotSuspicious_ReturnsError(t *testing.T) {
+	allow := fraud.NewFraudDetectionService(fraud.DefaultFraudRules()).Assess(normalActivity())
+
+	_, err := fraud.OpenFraudCase(
+		shared.NewEntityID[fraud.MemberMarker](),
+		shared.NewEntityID[fraud.TransactionMarker](),
+		"AB12345678",
+		allow,
+	)
+
+	assert.ErrorIs(t, err, fraud.ErrNotSuspicious)
+}
+
+// Test 9: 結案記錄處理人員，且不可重複結案
+func TestFraudCase_Confirm_RecordsResolver(t *testing.T) {
+	c, err := fraud.OpenFraudCase(
+		shared.NewEntityID[fraud.MemberMarker](),
+		shared.NewEntityID[fraud.TransactionMarker](),
+		"AB12345678",
+		suspiciousAssessment(),
+	)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, c.Confirm("", "note"), fraud.ErrResolverRequired)
+	require.NoError(t, c.Confirm("admin-1", "picked up from another table"))
+
+	assert.Equal(t, fraud.CaseStatusConfirmed, c.Status())
+	assert.Equal(t, "admin-1", c.ResolvedBy())
+	require.NotNil(t, c.ResolvedAt())
+	assert.ErrorIs(t, c.Clear("admin-2", "oops"), fraud.ErrCaseAlreadyClosed)
+}
@@ -0,0 +1,68 @@
+package fraud
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// 實體 ID 類型定義
+// ===========================
+
+// 設計原則：使用泛型 EntityID[T]（與其他上下文一致）
+//
+// 注意：fraud.MemberID / fraud.TransactionID 與其他上下文的 ID 是不同類型
+// 跨上下文傳遞時使用 String() 轉換
+
+// ===========================
+// CaseID - 調查案件 ID
+// ===========================
+
+// CaseMarker 是 CaseID 的標記類型
+type CaseMarker struct{}
+
+// CaseID 詐騙調查案件的唯一標識符
+type CaseID = shared.EntityID[CaseMarker]
+
+// NewCaseID 生成新的案件 ID（UUID v4）
+func NewCaseID() CaseID {
+	return shared.NewEntityID[CaseMarker]()
+}
+
+// CaseIDFromString 從字串解析案件 ID
+//
+// 返回：
+//   CaseID - 解析成功的 ID
+//   error - 解析失敗（返回 ErrInvalidCaseID）
+func CaseIDFromString(s string) (CaseID, error) {
+	return shared.EntityIDFromString[CaseMarker](s, ErrInvalidCaseID)
+}
+
+// ===========================
+// MemberID - 會員 ID（引用）
+// ===========================
+
+// MemberMarker 是 MemberID 的標記類型
+type MemberMarker struct{}
+
+// MemberID 會員的唯一標識符（詐騙偵測上下文內的引用）
+type MemberID = shared.EntityID[MemberMarker]
+
+// MemberIDFromString 從字串解析會員 ID
+func MemberIDFromString(s string) (MemberID, error) {
+	return shared.EntityIDFromString[MemberMarker](s, ErrInvalidMemberID)
+}
+
+// ===========================
+// TransactionID - 發票交易 ID（引用）
+// ===========================
+
+// TransactionMarker 是 TransactionID 的標記類型
+type TransactionMarker struct{}
+
+// TransactionID 發票交易的唯一標識符（詐騙偵測上下文內的引用）
+type TransactionID = shared.EntityID[TransactionMarker]
+
+// TransactionIDFromString 從字串解析交易 ID
+func TransactionIDFromString(s string) (TransactionID, error) {
+	return shared.EntityIDFromString[TransactionMarker](s, ErrInvalidTransactionID)
+}
@@ -0,0 +1,61 @@
+package fraud
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// FraudCase Repository 介面
+// ===========================
+
+// FraudCaseRepository 調查案件倉儲介面
+//
+// 設計原則：
+// 1. 依賴倒置原則（DIP）：Domain Layer 定義介面，Infrastructure Layer 實作
+// 2. 事務支持：寫操作 ctx 必須 non-nil，讀操作 ctx 可為 nil
+type FraudCaseRepository interface {
+	// Save 保存新的調查案件
+	Save(ctx shared.TransactionContext, c *FraudCase) error
+
+	// Update 更新調查案件（排除 / 確認）
+	//
+	// 錯誤：ErrFraudCaseNotFound（如果案件不存在）
+	Update(ctx shared.TransactionContext, c *FraudCase) error
+
+	// FindByID 根據案件 ID 查找
+	//
+	// 返回：找到的案件，或 ErrFraudCaseNotFound
+	FindByID(ctx shared.TransactionContext, id CaseID) (*FraudCase, error)
+
+	// FindOpen 分頁查詢調查中的案件（依建立時間升冪）
+	FindOpen(ctx shared.TransactionContext, limit, offset int) ([]*FraudCase, error)
+}
+
+// ===========================
+// ScanActivityQuery 查詢介面
+// ===========================
+
+// ScanActivityQuery 掃描活動統計查詢（唯讀）
+//
+// 設計原則：
+// - 查詢發票交易資料（由 Infrastructure Layer 實作，可直接查 invoice_transactions）
+// - 只返回統計數字，不返回聚合根
+type ScanActivityQuery interface {
+	// CountScansSince 統計會員自 since 起的掃描數
+	CountScansSince(ctx shared.TransactionContext, memberID MemberID, since time.Time) (int, error)
+
+	// CountDistinctMembersInRange 統計發票號碼範圍內（含）其他會員的人數
+	//
+	// 參數：
+	//   from, to - 發票號碼範圍（同字軌，字串比較）
+	//   excludeMemberID - 排除的會員（本次掃描者）
+	//   since - 只統計此時間後的掃描
+	CountDistinctMembersInRange(
+		ctx shared.TransactionContext,
+		from, to string,
+		excludeMemberID MemberID,
+		since time.Time,
+	) (int, error)
+}
@@ -0,0 +1,208 @@
+package fraud
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ===========================
+// RiskSignal 風險訊號枚舉
+// ===========================
+
+// RiskSignal 規則觸發的風險訊號
+type RiskSignal string
+
+const (
+	SignalScanVelocity          RiskSignal = "scan_velocity"            // 短時間內掃描過多發票
+	SignalHighAmount            RiskSignal = "high_amount"              // 金額遠高於一般酒吧消費
+	SignalSequentialInvoices    RiskSignal = "sequential_invoices"      // 多名會員登錄連號發票
+	SignalNewAccountHeavyClaims RiskSignal = "new_account_heavy_claims" // 新帳戶大量登錄
+)
+
+// String 返回訊號字串
+func (s RiskSignal) String() string {
+	return string(s)
+}
+
+// IsValid 判斷訊號是否為已定義的值
+func (s RiskSignal) IsValid() bool {
+	switch s {
+	case SignalScanVelocity, SignalHighAmount, SignalSequentialInvoices, SignalNewAccountHeavyClaims:
+		return true
+	default:
+		return false
+	}
+}
+
+// ===========================
+// Decision 處置決策枚舉
+// ===========================
+
+// Decision 評估後的處置決策
+//
+// 嚴重度：allow < hold < block
+type Decision string
+
+const (
+	DecisionAllow Decision = "allow" // 無風險訊號，正常處理
+	DecisionHold  Decision = "hold"  // 暫停交易，等待人工調查
+	DecisionBlock Decision = "block" // 直接拒絕（嚴重超出頻率上限）
+)
+
+// String 返回決策字串
+func (d Decision) String() string {
+	return string(d)
+}
+
+// ParseDecision 從字串解析決策
+func ParseDecision(s string) (Decision, error) {
+	switch Decision(s) {
+	case DecisionAllow, DecisionHold, DecisionBlock:
+		return Decision(s), nil
+	default:
+		return "", ErrInvalidDecision.WithContext("decision", s)
+	}
+}
+
+// ===========================
+// CaseStatus 調查案件狀態枚舉
+// ===========================
+
+// CaseStatus 調查案件狀態
+//
+// 狀態流轉：
+//   open → cleared（排除嫌疑：暫停的交易恢復處理）
+//   open → confirmed（確認詐騙：暫停的交易標記失敗，可凍結帳戶）
+type CaseStatus string
+
+const (
+	CaseStatusOpen      CaseStatus = "open"
+	CaseStatusCleared   CaseStatus = "cleared"
+	CaseStatusConfirmed CaseStatus = "confirmed"
+)
+
+// ParseCaseStatus 從字串解析案件狀態
+func ParseCaseStatus(s string) (CaseStatus, error) {
+	status := CaseStatus(s)
+	if !status.IsValid() {
+		return "", ErrInvalidCaseStatus.WithContext("status", s)
+	}
+	return status, nil
+}
+
+// String 返回狀態字串
+func (s CaseStatus) String() string {
+	return string(s)
+}
+
+// IsValid 判斷狀態是否為已定義的值
+func (s CaseStatus) IsValid() bool {
+	switch s {
+	case CaseStatusOpen, CaseStatusCleared, CaseStatusConfirmed:
+		return true
+	default:
+		return false
+	}
+}
+
+// ===========================
+// FraudRules 偵測規則值對象
+// ===========================
+
+// FraudRulesConfig 偵測規則設定（由設定檔或管理後台提供）
+type FraudRulesConfig struct {
+	VelocityWindow       time.Duration // 掃描頻率統計區間
+	MaxScansPerWindow    int           // 區間內掃描數超過此值 → hold
+	BlockScansPerWindow  int           // 區間內掃描數超過此值 → block
+	HighAmountThreshold  int           // 單張發票金額超過此值 → hold（元）
+	SequentialRange      int           // 連號判定範圍（號碼差 ±N）
+	SequentialMinMembers int           // 範圍內其他會員數達此值 → hold
+	SequentialLookback   time.Duration // 連號統計回溯期間
+	NewAccountAge        time.Duration // 註冊未滿此期間視為新帳戶
+	NewAccountMaxClaims  int           // 新帳戶登錄數超過此值 → hold
+}
+
+// FraudRules 詐騙偵測規則（已驗證、不可變）
+type FraudRules struct {
+	config FraudRulesConfig
+}
+
+// NewFraudRules 創建偵測規則（Checked Constructor）
+//
+// 驗證：
+// - 所有期間與門檻必須 > 0
+// - BlockScansPerWindow 必須 >= MaxScansPerWindow
+func NewFraudRules(cfg FraudRulesConfig) (FraudRules, error) {
+	if cfg.VelocityWindow <= 0 || cfg.SequentialLookback <= 0 || cfg.NewAccountAge <= 0 {
+		return FraudRules{}, ErrInvalidFraudRules.WithContext(
+			"reason", "durations must be positive",
+		)
+	}
+	if cfg.MaxScansPerWindow <= 0 || cfg.HighAmountThreshold <= 0 ||
+		cfg.SequentialRange <= 0 || cfg.SequentialMinMembers <= 0 || cfg.NewAccountMaxClaims <= 0 {
+		return FraudRules{}, ErrInvalidFraudRules.WithContext(
+			"reason", "thresholds must be positive",
+		)
+	}
+	if cfg.BlockScansPerWindow < cfg.MaxScansPerWindow {
+		return FraudRules{}, ErrInvalidFraudRules.WithContext(
+			"max_scans_per_window", cfg.MaxScansPerWindow,
+			"block_scans_per_window", cfg.BlockScansPerWindow,
+		)
+	}
+	return FraudRules{config: cfg}, nil
+}
+
+// DefaultFraudRules 預設偵測規則
+//
+// - 1 小時內超過 5 張 → hold；超過 15 張 → block
+// - 單張超過 20,000 元 → hold
+// - 24 小時內 ±5 號範圍有 3 名以上其他會員登錄 → hold
+// - 註冊 7 天內登錄超過 10 張 → hold
+func DefaultFraudRules() FraudRules {
+	return FraudRules{config: FraudRulesConfig{
+		VelocityWindow:       time.Hour,
+		MaxScansPerWindow:    5,
+		BlockScansPerWindow:  15,
+		HighAmountThreshold:  20000,
+		SequentialRange:      5,
+		SequentialMinMembers: 3,
+		SequentialLookback:   24 * time.Hour,
+		NewAccountAge:        7 * 24 * time.Hour,
+		NewAccountMaxClaims:  10,
+	}}
+}
+
+// Config 返回規則設定（副本）
+func (r FraudRules) Config() FraudRulesConfig {
+	return r.config
+}
+
+// SequentialNeighbourRange 計算連號判定的發票號碼範圍
+//
+// 參數：invoiceNumber - 已正規化的發票號碼（2 字母 + 8 數字）
+// 返回：from / to（含），ok=false 表示號碼格式無法計算
+//
+// 範例：AB12345678，範圍 5 → AB12345673 ~ AB12345683
+func (r FraudRules) SequentialNeighbourRange(invoiceNumber string) (from, to string, ok bool) {
+	if len(invoiceNumber) != 10 {
+		return "", "", false
+	}
+	prefix := invoiceNumber[:2]
+	number, err := strconv.Atoi(invoiceNumber[2:])
+	if err != nil {
+		return "", "", false
+	}
+
+	low := number - r.config.SequentialRange
+	if low < 0 {
+		low = 0
+	}
+	high := number + r.config.SequentialRange
+	if high > 99999999 {
+		high = 99999999
+	}
+
+	return fmt.Sprintf("%s%08d", prefix, low), fmt.Sprintf("%s%08d", prefix, high), true
+}
@@ -0,0 +1,171 @@
+package invoice
+
+import "fmt"
+
+// ===========================
+// 錯誤代碼定義
+// ===========================
+
+// ErrorCode 錯誤代碼類型
+type ErrorCode string
+
+// 錯誤代碼常量
+const (
+	// 識別符相關
+	ErrCodeInvalidTransactionID ErrorCode = "TRANSACTION_ID_INVALID"
+	ErrCodeInvalidMemberID      ErrorCode = "MEMBER_ID_INVALID"
+
+	// 發票資料相關
+	ErrCodeInvalidInvoiceNumber ErrorCode = "INVOICE_NUMBER_INVALID"
+	ErrCodeInvalidMoney         ErrorCode = "MONEY_INVALID"
+	ErrCodeInvalidInvoiceDate   ErrorCode = "INVOICE_DATE_INVALID"
+
+	// QR Code 相關
+	ErrCodeQRCodeNotFound      ErrorCode = "QR_CODE_NOT_FOUND"
+	ErrCodeInvalidQRCodeFormat ErrorCode = "QR_CODE_FORMAT_INVALID"
+
+	// 狀態相關
+	ErrCodeInvalidTransactionStatus ErrorCode = "TRANSACTION_STATUS_INVALID"
+	ErrCodeInvalidStatusTransition  ErrorCode = "TRANSACTION_STATUS_TRANSITION_INVALID"
+
+	// Repository 相關
+	ErrCodeTransactionNotFound ErrorCode = "TRANSACTION_NOT_FOUND"
+	ErrCodeDuplicateInvoice    ErrorCode = "INVOICE_DUPLICATE"
+)
+
+// ===========================
+// DomainError 結構
+// ===========================
+
+// DomainError 發票領域錯誤
+// 設計原則：
+// 1. 包含結構化的錯誤代碼（用於 HTTP 狀態碼映射）
+// 2. 支持上下文信息（用於調試和日誌）
+// 3. 不可變性（創建後不可修改）
+type DomainError struct {
+	Code    ErrorCode
+	Message string
+	Context map[string]interface{}
+}
+
+// Error 實現 error 接口
+func (e *DomainError) Error() string {
+	if len(e.Context) == 0 {
+		return fmt.Sprintf("[%s] %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("[%s] %s (context: %+v)", e.Code, e.Message, e.Context)
+}
+
+// WithContext 添加上下文信息（返回新的錯誤實例，保持不可變性）
+func (e *DomainError) WithContext(keyValues ...interface{}) error {
+	if len(keyValues)%2 != 0 {
+		panic("WithContext requires even number of arguments (key-value pairs)")
+	}
+
+	ctx := make(map[string]interface{}, len(e.Context)+len(keyValues)/2)
+
+	// 複製現有上下文
+	for k, v := range e.Context {
+		ctx[k] = v
+	}
+
+	// 添加新上下文
+	for i := 0; i < len(keyValues); i += 2 {
+		key, ok := keyValues[i].(string)
+		if !ok {
+			panic(fmt.Sprintf("context key must be string, got %T", keyValues[i]))
+		}
+		ctx[key] = keyValues[i+1]
+	}
+
+	return &DomainError{
+		Code:    e.Code,
+		Message: e.Message,
+		Context: ctx,
+	}
+}
+
+// Is 實現 errors.Is 接口（用於錯誤類型判斷）
+func (e *DomainError) Is(target error) bool {
+	t, ok := target.(*DomainError)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+// ===========================
+// 預定義錯誤
+// ===========================
+
+// 識別符相關錯誤
+var (
+	ErrInvalidTransactionID = &DomainError{
+		Code:    ErrCodeInvalidTransactionID,
+		Message: "無效的交易 ID",
+	}
+
+	ErrInvalidMemberID = &DomainError{
+		Code:    ErrCodeInvalidMemberID,
+		Message: "無效的會員 ID",
+	}
+)
+
+// 發票資料相關錯誤
+var (
+	ErrInvalidInvoiceNumber = &DomainError{
+		Code:    ErrCodeInvalidInvoiceNumber,
+		Message: "發票號碼格式無效（必須是2個英文字母加8位數字）",
+	}
+
+	ErrInvalidMoney = &DomainError{
+		Code:    ErrCodeInvalidMoney,
+		Message: "金額不能為負數",
+	}
+
+	ErrInvalidInvoiceDate = &DomainError{
+		Code:    ErrCodeInvalidInvoiceDate,
+		Message: "發票日期無效",
+	}
+)
+
+// QR Code 相關錯誤
+var (
+	// ErrQRCodeNotFound 對應 PRD 文案：「未找到 QR Code，請重新上傳清晰的照片」
+	ErrQRCodeNotFound = &DomainError{
+		Code:    ErrCodeQRCodeNotFound,
+		Message: "未找到 QR Code，請重新上傳清晰的照片",
+	}
+
+	ErrInvalidQRCodeFormat = &DomainError{
+		Code:    ErrCodeInvalidQRCodeFormat,
+		Message: "無效的 QR Code 格式",
+	}
+)
+
+// 狀態相關錯誤
+var (
+	ErrInvalidTransactionStatus = &DomainError{
+		Code:    ErrCodeInvalidTransactionStatus,
+		Message: "無效的交易狀態",
+	}
+
+	ErrInvalidStatusTransition = &DomainError{
+		Code:    ErrCodeInvalidStatusTransition,
+		Message: "不允許的交易狀態轉換",
+	}
+)
+
+// Repository 相關錯誤
+var (
+	ErrTransactionNotFound = &DomainError{
+		Code:    ErrCodeTransactionNotFound,
+		Message: "交易不存在",
+	}
+
+	// ErrDuplicateInvoice 對應 PRD 文案：「此發票已被登錄，無法重複獲得積分」
+	ErrDuplicateInvoice = &DomainError{
+		Code:    ErrCodeDuplicateInvoice,
+		Message: "此發票已被登錄，無法重複獲得積分",
+	}
+)
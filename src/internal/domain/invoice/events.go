@@ -0,0 +1,360 @@
+package invoice
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ===========================
+// TransactionCreated 領域事件
+// ===========================
+
+// TransactionCreatedEvent 發票交易已建立事件（會員掃描發票）
+type TransactionCreatedEvent struct {
+	eventID       string
+	transactionID TransactionID
+	memberID      MemberID
+	invoiceNumber InvoiceNumber
+	amount        Money
+	occurredAt    time.Time
+}
+
+// NewTransactionCreatedEvent 創建交易已建立事件
+func NewTransactionCreatedEvent(
+	transactionID TransactionID,
+	memberID MemberID,
+	invoiceNumber InvoiceNumber,
+	amount Money,
+) *TransactionCreatedEvent {
+	return &TransactionCreatedEvent{
+		eventID:       uuid.New().String(),
+		transactionID: transactionID,
+		memberID:      memberID,
+		invoiceNumber: invoiceNumber,
+		amount:        amount,
+		occurredAt:    time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *TransactionCreatedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *TransactionCreatedEvent) EventType() string {
+	return "invoice.transaction_created"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *TransactionCreatedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *TransactionCreatedEvent) AggregateID() string {
+	return e.transactionID.String()
+}
+
+// TransactionID 獲取交易 ID
+func (e *TransactionCreatedEvent) TransactionID() TransactionID {
+	return e.transactionID
+}
+
+// MemberID 獲取會員 ID
+func (e *TransactionCreatedEvent) MemberID() MemberID {
+	return e.memberID
+}
+
+// InvoiceNumber 獲取發票號碼
+func (e *TransactionCreatedEvent) InvoiceNumber() InvoiceNumber {
+	return e.invoiceNumber
+}
+
+// Amount 獲取發票金額
+func (e *TransactionCreatedEvent) Amount() Money {
+	return e.amount
+}
+
+// ===========================
+// TransactionVerified 領域事件
+// ===========================
+
+// TransactionVerifiedEvent 發票交易已驗證事件
+//
+// 訂閱者：
+// - 積分上下文：依金額與轉換率入帳（EarnPoints）
+// - 問卷上下文：結算待發放的問卷獎勵
+type TransactionVerifiedEvent struct {
+	eventID       string
+	transactionID TransactionID
+	memberID      MemberID
+	invoiceNumber InvoiceNumber
+	invoiceDate   time.Time
+	amount        Money
+	occurredAt    time.Time
+}
+
+// NewTransactionVerifiedEvent 創建交易已驗證事件
+func NewTransactionVerifiedEvent(
+	transactionID TransactionID,
+	memberID MemberID,
+	invoiceNumber InvoiceNumber,
+	invoiceDate time.Time,
+	amount Money,
+) *TransactionVerifiedEvent {
+	return &TransactionVerifiedEvent{
+		eventID:       uuid.New().String(),
+		transactionID: transactionID,
+		memberID:      memberID,
+		invoiceNumber: invoiceNumber,
+		invoiceDate:   invoiceDate,
+		amount:        amount,
+		occurredAt:    time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *TransactionVerifiedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *TransactionVerifiedEvent) EventType() string {
+	return "invoice.transaction_verified"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *TransactionVerifiedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *TransactionVerifiedEvent) AggregateID() string {
+	return e.transactionID.String()
+}
+
+// TransactionID 獲取交易 ID
+func (e *TransactionVerifiedEvent) TransactionID() TransactionID {
+	return e.transactionID
+}
+
+// MemberID 獲取會員 ID
+func (e *TransactionVerifiedEvent) MemberID() MemberID {
+	return e.memberID
+}
+
+// InvoiceNumber 獲取發票號碼
+func (e *TransactionVerifiedEvent) InvoiceNumber() InvoiceNumber {
+	return e.invoiceNumber
+}
+
+// InvoiceDate 獲取發票日期
+func (e *TransactionVerifiedEvent) InvoiceDate() time.Time {
+	return e.invoiceDate
+}
+
+// Amount 獲取驗證後的金額
+func (e *TransactionVerifiedEvent) Amount() Money {
+	return e.amount
+}
+
+// ===========================
+// TransactionFailed 領域事件
+// ===========================
+
+// TransactionFailedEvent 發票交易已失敗事件（驗證駁回或發票作廢）
+type TransactionFailedEvent struct {
+	eventID        string
+	transactionID  TransactionID
+	memberID       MemberID
+	previousStatus TransactionStatus
+	reason         string
+	occurredAt     time.Time
+}
+
+// NewTransactionFailedEvent 創建交易已失敗事件
+func NewTransactionFailedEvent(
+	transactionID TransactionID,
+	memberID MemberID,
+	previousStatus TransactionStatus,
+	reason string,
+) *TransactionFailedEvent {
+	return &TransactionFailedEvent{
+		eventID:        uuid.New().String(),
+		transactionID:  transactionID,
+		memberID:       memberID,
+		previousStatus: previousStatus,
+		reason:         reason,
+		occurredAt:     time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *TransactionFailedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *TransactionFailedEvent) EventType() string {
+	return "invoice.transaction_failed"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *TransactionFailedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *TransactionFailedEvent) AggregateID() string {
+	return e.transactionID.String()
+}
+
+// TransactionID 獲取交易 ID
+func (e *TransactionFailedEvent) TransactionID() TransactionID {
+	return e.transactionID
+}
+
+// MemberID 獲取會員 ID
+func (e *TransactionFailedEvent) MemberID() MemberID {
+	return e.memberID
+}
+
+// PreviousStatus 獲取失敗前的狀態
+//
+// 用途：previousStatus == verified 表示發票作廢，需扣回已入帳積分
+func (e *TransactionFailedEvent) PreviousStatus() TransactionStatus {
+	return e.previousStatus
+}
+
+// Reason 獲取失敗原因
+func (e *TransactionFailedEvent) Reason() string {
+	return e.reason
+}
+
+// ===========================
+// TransactionHeld 領域事件
+// ===========================
+
+// TransactionHeldEvent 發票交易已暫停事件（疑似詐騙）
+type TransactionHeldEvent struct {
+	eventID       string
+	transactionID TransactionID
+	memberID      MemberID
+	reason        string
+	occurredAt    time.Time
+}
+
+// NewTransactionHeldEvent 創建交易已暫停事件
+func NewTransactionHeldEvent(
+	transactionID TransactionID,
+	memberID MemberID,
+	reason string,
+) *TransactionHeldEvent {
+	return &TransactionHeldEvent{
+		eventID:       uuid.New().String(),
+		transactionID: transactionID,
+		memberID:      memberID,
+		reason:        reason,
+		occurredAt:    time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *TransactionHeldEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *TransactionHeldEvent) EventType() string {
+	return "invoice.transaction_held"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *TransactionHeldEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *TransactionHeldEvent) AggregateID() string {
+	return e.transactionID.String()
+}
+
+// TransactionID 獲取交易 ID
+func (e *TransactionHeldEvent) TransactionID() TransactionID {
+	return e.transactionID
+}
+
+// MemberID 獲取會員 ID
+func (e *TransactionHeldEvent) MemberID() MemberID {
+	return e.memberID
+}
+
+// Reason 獲取暫停原因
+func (e *TransactionHeldEvent) Reason() string {
+	return e.reason
+}
+
+// ===========================
+// TransactionReleased 領域事件
+// ===========================
+
+// TransactionReleasedEvent 發票交易已解除暫停事件
+type TransactionReleasedEvent struct {
+	eventID       string
+	transactionID TransactionID
+	memberID      MemberID
+	reason        string
+	occurredAt    time.Time
+}
+
+// NewTransactionReleasedEvent 創建交易已解除暫停事件
+func NewTransactionReleasedEvent(
+	transactionID TransactionID,
+	memberID MemberID,
+	reason string,
+) *TransactionReleasedEvent {
+	return &TransactionReleasedEvent{
+		eventID:       uuid.New().String(),
+		transactionID: transactionID,
+		memberID:      memberID,
+		reason:        reason,
+		occurredAt:    time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *TransactionReleasedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *TransactionReleasedEvent) EventType() string {
+	return "invoice.transaction_released"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *TransactionReleasedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *TransactionReleasedEvent) AggregateID() string {
+	return e.transactionID.String()
+}
+
+// TransactionID 獲取交易 ID
+func (e *TransactionReleasedEvent) TransactionID() TransactionID {
+	return e.transactionID
+}
+
+// MemberID 獲取會員 ID
+func (e *TransactionReleasedEvent) MemberID() MemberID {
+	return e.memberID
+}
+
+// Reason 獲取解除暫停原因
+func (e *TransactionReleasedEvent) Reason() string {
+	return e.reason
+}
@@ -0,0 +1,67 @@
+package invoice
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// 實體 ID 類型定義
+// ===========================
+
+// 設計原則：使用泛型 EntityID[T]（與 points、member 上下文一致）
+//
+// 注意：invoice.MemberID 與 points.MemberID、member.MemberID 是不同類型
+// 跨上下文傳遞時使用 String() 轉換（Bounded Context 之間只共享 ID 值）
+
+// ===========================
+// TransactionID - 發票交易 ID
+// ===========================
+
+// TransactionMarker 是 TransactionID 的標記類型
+type TransactionMarker struct{}
+
+// TransactionID 發票交易的唯一標識符
+//
+// 實現：EntityID[TransactionMarker] 的類型別名
+// 使用：id := NewTransactionID() 或 TransactionIDFromString(s)
+type TransactionID = shared.EntityID[TransactionMarker]
+
+// NewTransactionID 生成新的交易 ID（UUID v4）
+func NewTransactionID() TransactionID {
+	return shared.NewEntityID[TransactionMarker]()
+}
+
+// TransactionIDFromString 從字串解析交易 ID
+//
+// 返回：
+//   TransactionID - 解析成功的 ID
+//   error - 解析失敗（返回 ErrInvalidTransactionID）
+func TransactionIDFromString(s string) (TransactionID, error) {
+	return shared.EntityIDFromString[TransactionMarker](s, ErrInvalidTransactionID)
+}
+
+// ===========================
+// MemberID - 會員 ID
+// ===========================
+
+// MemberMarker 是 MemberID 的標記類型
+type MemberMarker struct{}
+
+// MemberID 會員的唯一標識符（發票上下文內的引用）
+type MemberID = shared.EntityID[MemberMarker]
+
+// NewMemberID 生成新的會員 ID（UUID v4）
+//
+// 使用場景：測試；正式流程中 MemberID 由 member 上下文產生
+func NewMemberID() MemberID {
+	return shared.NewEntityID[MemberMarker]()
+}
+
+// MemberIDFromString 從字串解析會員 ID
+//
+// 返回：
+//   MemberID - 解析成功的 ID
+//   error - 解析失敗（返回 ErrInvalidMemberID）
+func MemberIDFromString(s string) (MemberID, error) {
+	return shared.EntityIDFromString[MemberMarker](s, ErrInvalidMemberID)
+}
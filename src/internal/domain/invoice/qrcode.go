@@ -0,0 +1,295 @@
+package invoice
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// InvoiceQRCode 電子發票 QR Code 值對象
+// ===========================
+
+// 電子發票證明聯左側 QR Code 固定欄位長度（財政部電子發票 QR Code 規格）
+//
+//	發票字軌號碼(10) + 開立日期(7, 民國年 yyyMMdd) + 隨機碼(4)
+//	+ 銷售額(8, 16 進位) + 總計額(8, 16 進位)
+//	+ 買方統編(8) + 賣方統編(8) + 加密驗證資訊(24)
+const (
+	qrHeaderLength = 77
+
+	// qrContinuationPrefix 右側 QR Code 固定以 "**" 開頭，內容接續左側的品目明細
+	qrContinuationPrefix = "**"
+)
+
+// 品名編碼參數
+const (
+	qrEncodingBig5   = "0"
+	qrEncodingUTF8   = "1"
+	qrEncodingBase64 = "2"
+)
+
+// InvoiceItem 發票品目明細
+//
+// 設計決策：
+// - 品目僅供顯示與稽核，不參與積分計算，數量與單價保留原始字串
+// - 品名編碼為 Big5 時保留原始位元組（顯示前需轉碼）
+type InvoiceItem struct {
+	Name      string
+	Quantity  string
+	UnitPrice string
+}
+
+// InvoiceQRCode 電子發票證明聯 QR Code 解析結果
+//
+// 業務規則：
+// 1. 證明聯並排兩個 QR Code：左側含發票資訊與部分品目，右側（"**" 開頭）接續其餘品目
+// 2. 發票號碼、日期、金額只存在於左側 QR Code
+// 3. 右側 QR Code 可缺漏（品目較少或拍攝不完整），不影響發票資訊
+//
+// 設計原則：
+// - 不可變性（unexported fields）
+// - 自我驗證（ParseInvoiceQRCode 為唯一建構方式）
+type InvoiceQRCode struct {
+	invoiceNumber InvoiceNumber
+	invoiceDate   time.Time
+	randomCode    string
+	salesAmount   Money
+	totalAmount   Money
+	buyerBAN      string
+	sellerBAN     string
+	items         []InvoiceItem
+	rawData       string
+}
+
+// ParseInvoiceQRCode 從圖片中解出的 QR Code 內容解析電子發票
+//
+// 參數：
+//
+//	payloads - 同一張照片解出的所有 QR Code 內容（順序不限）
+//
+// 錯誤：
+// - 沒有任何 QR Code → ErrQRCodeNotFound
+// - 找不到可解析的左側 QR Code（例如只拍到右側、或非電子發票的 QR Code）→ ErrInvalidQRCodeFormat
+func ParseInvoiceQRCode(payloads []string) (InvoiceQRCode, error) {
+	if len(payloads) == 0 {
+		return InvoiceQRCode{}, ErrQRCodeNotFound
+	}
+
+	var left, right string
+	var leftErr error
+	for _, payload := range payloads {
+		if strings.HasPrefix(payload, qrContinuationPrefix) {
+			if right == "" {
+				right = payload
+			}
+			continue
+		}
+		if left != "" {
+			continue
+		}
+		if err := validateQRHeader(payload); err != nil {
+			leftErr = err
+			continue
+		}
+		left = payload
+	}
+
+	if left == "" {
+		if leftErr != nil {
+			return InvoiceQRCode{}, leftErr
+		}
+		return InvoiceQRCode{}, ErrInvalidQRCodeFormat.WithContext(
+			"reason", "left QR code not found",
+		)
+	}
+	return parseInvoiceQRCode(left, right)
+}
+
+// validateQRHeader 檢查左側 QR Code 固定欄位長度
+func validateQRHeader(payload string) error {
+	if len(payload) < qrHeaderLength {
+		return ErrInvalidQRCodeFormat.WithContext(
+			"reason", "payload too short",
+			"length", len(payload),
+		)
+	}
+	return nil
+}
+
+// parseInvoiceQRCode 解析左側固定欄位與左右兩側的品目明細
+func parseInvoiceQRCode(left, right string) (InvoiceQRCode, error) {
+	number, err := NewInvoiceNumber(left[0:10])
+	if err != nil {
+		return InvoiceQRCode{}, ErrInvalidQRCodeFormat.WithContext(
+			"field", "invoice_number",
+			"value", left[0:10],
+		)
+	}
+
+	date, err := parseROCDate(left[10:17])
+	if err != nil {
+		return InvoiceQRCode{}, err
+	}
+
+	salesAmount, err := parseHexMoney("sales_amount", left[21:29])
+	if err != nil {
+		return InvoiceQRCode{}, err
+	}
+	totalAmount, err := parseHexMoney("total_amount", left[29:37])
+	if err != nil {
+		return InvoiceQRCode{}, err
+	}
+
+	rawData := left
+	if right != "" {
+		rawData = left + "\n" + right
+	}
+
+	return InvoiceQRCode{
+		invoiceNumber: number,
+		invoiceDate:   date,
+		randomCode:    left[17:21],
+		salesAmount:   salesAmount,
+		totalAmount:   totalAmount,
+		buyerBAN:      left[37:45],
+		sellerBAN:     left[45:53],
+		items:         parseQRItems(left[qrHeaderLength:], right),
+		rawData:       rawData,
+	}, nil
+}
+
+// parseROCDate 解析民國年日期（yyyMMdd，例如 1140105 → 2025-01-05）
+func parseROCDate(value string) (time.Time, error) {
+	rocYear, yearErr := strconv.Atoi(value[0:3])
+	month, monthErr := strconv.Atoi(value[3:5])
+	day, dayErr := strconv.Atoi(value[5:7])
+	if yearErr != nil || monthErr != nil || dayErr != nil {
+		return time.Time{}, ErrInvalidQRCodeFormat.WithContext(
+			"field", "invoice_date",
+			"value", value,
+		)
+	}
+
+	date := time.Date(rocYear+1911, time.Month(month), day, 0, 0, 0, 0, shared.BusinessLocation)
+	// time.Date 會正規化超出範圍的月日（例如 02/30 → 03/02），需反查確認
+	if date.Month() != time.Month(month) || date.Day() != day {
+		return time.Time{}, ErrInvalidQRCodeFormat.WithContext(
+			"field", "invoice_date",
+			"value", value,
+		)
+	}
+	return date, nil
+}
+
+// parseHexMoney 解析 8 位 16 進位金額
+func parseHexMoney(field, value string) (Money, error) {
+	amount, err := strconv.ParseInt(value, 16, 64)
+	if err != nil {
+		return Money{}, ErrInvalidQRCodeFormat.WithContext(
+			"field", field,
+			"value", value,
+		)
+	}
+	return NewMoney(int(amount))
+}
+
+// parseQRItems 解析品目明細
+//
+// 格式（左側固定欄位之後）：
+//
+//	:營業人自行使用區(10):記載完整品目筆數:交易品目總筆數:中文編碼參數:品名:數量:單價:...
+//
+// 右側 QR Code 去除 "**" 後以相同的「品名:數量:單價」格式接續
+//
+// 設計決策：品目僅供參考，欄位不完整時略過，不影響發票解析
+func parseQRItems(leftTail, right string) []InvoiceItem {
+	fields := strings.Split(strings.TrimPrefix(leftTail, ":"), ":")
+	if len(fields) < 4 {
+		return nil
+	}
+	encoding := fields[3]
+	itemFields := fields[4:]
+
+	if continuation := strings.TrimPrefix(strings.TrimPrefix(right, qrContinuationPrefix), ":"); continuation != "" {
+		if len(itemFields) > 0 && itemFields[len(itemFields)-1] == "" {
+			itemFields = itemFields[:len(itemFields)-1]
+		}
+		itemFields = append(itemFields, strings.Split(continuation, ":")...)
+	}
+
+	var items []InvoiceItem
+	for i := 0; i+2 < len(itemFields); i += 3 {
+		name := strings.TrimSpace(itemFields[i])
+		if name == "" {
+			continue
+		}
+		if encoding == qrEncodingBase64 {
+			decoded, err := base64.StdEncoding.DecodeString(name)
+			if err != nil {
+				continue
+			}
+			name = string(decoded)
+		}
+		items = append(items, InvoiceItem{
+			Name:      name,
+			Quantity:  strings.TrimSpace(itemFields[i+1]),
+			UnitPrice: strings.TrimSpace(itemFields[i+2]),
+		})
+	}
+	return items
+}
+
+// ===========================
+// Getters
+// ===========================
+
+// InvoiceNumber 返回發票號碼
+func (q InvoiceQRCode) InvoiceNumber() InvoiceNumber {
+	return q.invoiceNumber
+}
+
+// InvoiceDate 返回發票開立日期（營業所在時區午夜）
+func (q InvoiceQRCode) InvoiceDate() time.Time {
+	return q.invoiceDate
+}
+
+// RandomCode 返回發票隨機碼
+func (q InvoiceQRCode) RandomCode() string {
+	return q.randomCode
+}
+
+// SalesAmount 返回銷售額（未稅）
+func (q InvoiceQRCode) SalesAmount() Money {
+	return q.salesAmount
+}
+
+// TotalAmount 返回總計額（含稅，積分計算使用此金額）
+func (q InvoiceQRCode) TotalAmount() Money {
+	return q.totalAmount
+}
+
+// BuyerBAN 返回買方統一編號（無統編時為 00000000）
+func (q InvoiceQRCode) BuyerBAN() string {
+	return q.buyerBAN
+}
+
+// SellerBAN 返回賣方統一編號
+func (q InvoiceQRCode) SellerBAN() string {
+	return q.sellerBAN
+}
+
+// Items 返回品目明細（副本）
+func (q InvoiceQRCode) Items() []InvoiceItem {
+	items := make([]InvoiceItem, len(q.items))
+	copy(items, q.items)
+	return items
+}
+
+// RawData 返回 QR Code 原始資料（左右兩側以換行分隔，供稽核保存）
+func (q InvoiceQRCode) RawData() string {
+	return q.rawData
+}
@@ -0,0 +1,74 @@
+package invoice_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 電子發票證明聯 QR Code 樣本（左側固定欄位 77 字元 + 品目明細）
+const (
+	testQRHeader = "AB11223344" + "1140105" + "5678" + "000000ee" + "000000fa" +
+		"00000000" + "12345678" + "kH2eA0f1sXyZp3Qw9LmRtA=="
+	testQRLeft  = testQRHeader + ":**********:3:3:1:莫西多:1:180:炸雞翅:1:70"
+	testQRRight = "**:招待小菜:1:0"
+)
+
+// Test 1: 合併左右兩側 QR Code（順序不限）
+func TestParseInvoiceQRCode_LeftAndRight(t *testing.T) {
+	// Act
+	qr, err := invoice.ParseInvoiceQRCode([]string{testQRRight, testQRLeft})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "AB11223344", qr.InvoiceNumber().String())
+	assert.Equal(t, time.Date(2025, 1, 5, 0, 0, 0, 0, shared.BusinessLocation), qr.InvoiceDate())
+	assert.Equal(t, "5678", qr.RandomCode())
+	assert.Equal(t, 238, qr.SalesAmount().Amount())
+	assert.Equal(t, 250, qr.TotalAmount().Amount())
+	assert.Equal(t, "00000000", qr.BuyerBAN())
+	assert.Equal(t, "12345678", qr.SellerBAN())
+	assert.Equal(t, []invoice.InvoiceItem{
+		{Name: "莫西多", Quantity: "1", UnitPrice: "180"},
+		{Name: "炸雞翅", Quantity: "1", UnitPrice: "70"},
+		{Name: "招待小菜", Quantity: "1", UnitPrice: "0"},
+	}, qr.Items())
+	assert.Equal(t, testQRLeft+"\n"+testQRRight, qr.RawData())
+}
+
+// Test 2: 只有左側 QR Code 仍可解析發票資訊；品名 Base64 編碼
+func TestParseInvoiceQRCode_LeftOnlyBase64Items(t *testing.T) {
+	// Act
+	qr, err := invoice.ParseInvoiceQRCode([]string{testQRHeader + ":**********:1:2:2:5rCj5rOh5rC0:1:60"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []invoice.InvoiceItem{{Name: "氣泡水", Quantity: "1", UnitPrice: "60"}}, qr.Items())
+}
+
+// Test 3: 錯誤情境
+func TestParseInvoiceQRCode_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		payloads []string
+		expected error
+	}{
+		{"沒有 QR Code", nil, invoice.ErrQRCodeNotFound},
+		{"只有右側", []string{testQRRight}, invoice.ErrInvalidQRCodeFormat},
+		{"非發票 QR Code", []string{"https://example.com/menu"}, invoice.ErrInvalidQRCodeFormat},
+		{"發票號碼錯誤", []string{"AB1122334X" + testQRHeader[10:]}, invoice.ErrInvalidQRCodeFormat},
+		{"日期不存在", []string{testQRHeader[:10] + "1140230" + testQRHeader[17:]}, invoice.ErrInvalidQRCodeFormat},
+		{"金額非 16 進位", []string{testQRHeader[:29] + "0000zzzz" + testQRHeader[37:]}, invoice.ErrInvalidQRCodeFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := invoice.ParseInvoiceQRCode(tt.payloads)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
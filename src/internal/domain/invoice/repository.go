@@ -0,0 +1,44 @@
+package invoice
+
+import "github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+
+// ===========================
+// InvoiceTransaction Repository 介面
+// ===========================
+
+// InvoiceTransactionRepository 發票交易倉儲介面
+//
+// 設計原則：
+// 1. 依賴倒置原則（DIP）：Domain Layer 定義介面，Infrastructure Layer 實作
+// 2. 聚合根持久化：每個聚合根一個 Repository
+// 3. 事務支持：寫操作 ctx 必須 non-nil，讀操作 ctx 可為 nil
+//
+// 事務使用範例：
+//   txManager.InTransaction(func(ctx shared.TransactionContext) error {
+//       tx, _ := repo.FindByInvoiceNumber(ctx, invoiceNumber)
+//       tx.Verify("ichef_exact_match")
+//       return repo.Update(ctx, tx)
+//   })
+type InvoiceTransactionRepository interface {
+	// Save 保存新的發票交易
+	//
+	// 前置條件：發票號碼未被登錄過（BR-002-02）
+	// 錯誤：ErrDuplicateInvoice（如果發票號碼已存在，由唯一約束保證）
+	Save(ctx shared.TransactionContext, tx *InvoiceTransaction) error
+
+	// Update 更新發票交易（狀態、金額校正）
+	//
+	// 錯誤：ErrTransactionNotFound（如果交易不存在）
+	Update(ctx shared.TransactionContext, tx *InvoiceTransaction) error
+
+	// FindByID 根據交易 ID 查找
+	//
+	// 返回：找到的交易，或 ErrTransactionNotFound
+	FindByID(ctx shared.TransactionContext, id TransactionID) (*InvoiceTransaction, error)
+
+	// FindByInvoiceNumber 根據發票號碼查找（號碼已正規化）
+	//
+	// 使用場景：iChef 匯入比對、重複掃描檢查
+	// 返回：找到的交易，或 ErrTransactionNotFound
+	FindByInvoiceNumber(ctx shared.TransactionContext, invoiceNumber InvoiceNumber) (*InvoiceTransaction, error)
+}
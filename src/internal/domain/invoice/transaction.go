@@ -0,0 +1,406 @@
+package invoice
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// InvoiceTransaction 聚合根
+// ===========================
+
+// InvoiceTransaction 發票交易聚合根
+//
+// 聚合邊界：
+// - 一張發票對應一筆交易（發票號碼唯一）
+// - 交易狀態（imported / verified / failed）
+// - 發票資料（號碼、日期、金額）
+//
+// 不變量（Invariants）：
+// 1. 交易必須屬於一個會員（memberID 非空）
+// 2. 發票號碼必須有效且已正規化（由 InvoiceNumber VO 保證）
+// 3. 金額 >= 0（由 Money VO 保證）
+// 4. 狀態轉換合法：imported → verified / failed / held；verified → failed；held → imported / failed
+// 5. 只有 verified 狀態的交易計入累積積分（BR-002-04）
+//
+// 設計原則：
+// - 輕量級聚合：不持有 PointsAccount 引用，透過事件通知積分上下文
+// - 事件驅動：狀態變更發布 TransactionVerified / TransactionFailed 事件
+// - 實作 points.PointsCalculableTransaction（GetAmount），但不 import points 包
+type InvoiceTransaction struct {
+	// 識別欄位
+	transactionID TransactionID
+	memberID      MemberID
+
+	// 發票資料
+	invoiceNumber InvoiceNumber
+	invoiceDate   time.Time
+	amount        Money
+
+	// 狀態
+	status       TransactionStatus
+	statusReason string     // 最近一次狀態變更原因（審計用途）
+	verifiedAt   *time.Time // 驗證時間（未驗證時為 nil）
+
+	// 審計欄位
+	createdAt time.Time
+	updatedAt time.Time
+
+	// 待發布的領域事件
+	events []shared.DomainEvent
+}
+
+// ===========================
+// 建構函數（工廠方法）
+// ===========================
+
+// NewInvoiceTransaction 創建新的發票交易（會員掃描發票）
+//
+// 參數：
+//   memberID - 會員 ID（必填）
+//   invoiceNumber - 已正規化的發票號碼
+//   invoiceDate - 發票開立日期
+//   amount - 發票金額
+//
+// 業務規則：
+// - 初始狀態為 imported（待驗證，BR-002-04）
+// - 發票日期不能為零值
+// - 發布 TransactionCreatedEvent
+func NewInvoiceTransaction(
+	memberID MemberID,
+	invoiceNumber InvoiceNumber,
+	invoiceDate time.Time,
+	amount Money,
+) (*InvoiceTransaction, error) {
+	if memberID.IsEmpty() {
+		return nil, ErrInvalidMemberID.WithContext(
+			"reason", "memberID cannot be empty",
+		)
+	}
+
+	if invoiceNumber.IsZero() {
+		return nil, ErrInvalidInvoiceNumber.WithContext(
+			"reason", "invoice number cannot be empty",
+		)
+	}
+
+	if invoiceDate.IsZero() {
+		return nil, ErrInvalidInvoiceDate.WithContext(
+			"reason", "invoice date cannot be zero",
+		)
+	}
+
+	now := time.Now()
+
+	tx := &InvoiceTransaction{
+		transactionID: NewTransactionID(),
+		memberID:      memberID,
+		invoiceNumber: invoiceNumber,
+		invoiceDate:   invoiceDate,
+		amount:        amount,
+		status:        TransactionStatusImported,
+		createdAt:     now,
+		updatedAt:     now,
+		events:        make([]shared.DomainEvent, 0),
+	}
+
+	tx.addEvent(NewTransactionCreatedEvent(
+		tx.transactionID,
+		memberID,
+		invoiceNumber,
+		amount,
+	))
+
+	return tx, nil
+}
+
+// ReconstructInvoiceTransaction 從持久化存儲重建聚合根
+//
+// 設計原則：
+// - 僅供 Repository 使用
+// - 不發布事件（事件已發生過）
+// - 仍驗證關鍵欄位，防止損壞資料污染領域層
+func ReconstructInvoiceTransaction(
+	transactionID TransactionID,
+	memberID MemberID,
+	invoiceNumber InvoiceNumber,
+	invoiceDate time.Time,
+	amount Money,
+	status TransactionStatus,
+	statusReason string,
+	verifiedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*InvoiceTransaction, error) {
+	if transactionID.IsEmpty() {
+		return nil, ErrInvalidTransactionID.WithContext(
+			"reason", "invalid transaction ID in database",
+		)
+	}
+
+	if memberID.IsEmpty() {
+		return nil, ErrInvalidMemberID.WithContext(
+			"reason", "invalid member ID in database",
+		)
+	}
+
+	if !status.IsValid() {
+		return nil, ErrInvalidTransactionStatus.WithContext(
+			"status", status.String(),
+			"reason", "invalid status in database",
+		)
+	}
+
+	return &InvoiceTransaction{
+		transactionID: transactionID,
+		memberID:      memberID,
+		invoiceNumber: invoiceNumber,
+		invoiceDate:   invoiceDate,
+		amount:        amount,
+		status:        status,
+		statusReason:  statusReason,
+		verifiedAt:    verifiedAt,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+		events:        make([]shared.DomainEvent, 0),
+	}, nil
+}
+
+// ===========================
+// 命令方法（狀態變更）
+// ===========================
+
+// Verify 驗證交易（iChef 資料匹配）
+//
+// 業務規則：
+// - 只有 imported 狀態可以驗證
+// - 驗證後發布 TransactionVerifiedEvent（積分上下文據此入帳）
+func (t *InvoiceTransaction) Verify(reason string) error {
+	if t.status != TransactionStatusImported {
+		return ErrInvalidStatusTransition.WithContext(
+			"transaction_id", t.transactionID.String(),
+			"from", t.status.String(),
+			"to", TransactionStatusVerified.String(),
+		)
+	}
+
+	now := time.Now()
+	t.status = TransactionStatusVerified
+	t.statusReason = reason
+	t.verifiedAt = &now
+	t.updatedAt = now
+
+	t.addEvent(NewTransactionVerifiedEvent(
+		t.transactionID,
+		t.memberID,
+		t.invoiceNumber,
+		t.invoiceDate,
+		t.amount,
+	))
+
+	return nil
+}
+
+// VerifyWithPOSData 以 POS（iChef）資料校正後驗證交易
+//
+// 使用場景：
+// - 金額或日期在容差內但不完全一致，管理員審核通過
+// - iChef 為店家端權威資料，校正後的金額用於積分計算與後續重算
+//
+// 業務規則：
+// - 與 Verify 相同的狀態限制（只有 imported 可驗證）
+// - 先檢查狀態再修改資料，避免失敗時留下部分變更
+func (t *InvoiceTransaction) VerifyWithPOSData(
+	posAmount Money,
+	posInvoiceDate time.Time,
+	reason string,
+) error {
+	if t.status != TransactionStatusImported {
+		return ErrInvalidStatusTransition.WithContext(
+			"transaction_id", t.transactionID.String(),
+			"from", t.status.String(),
+			"to", TransactionStatusVerified.String(),
+		)
+	}
+
+	if posInvoiceDate.IsZero() {
+		return ErrInvalidInvoiceDate.WithContext(
+			"reason", "POS invoice date cannot be zero",
+		)
+	}
+
+	t.amount = posAmount
+	t.invoiceDate = posInvoiceDate
+
+	return t.Verify(reason)
+}
+
+// Hold 暫停交易（疑似詐騙，等待調查）
+//
+// 業務規則：
+// - 只有 imported 狀態可以暫停（已驗證的交易已入帳，需走作廢流程）
+// - 暫停期間 iChef 匹配不處理此交易，積分不發放
+func (t *InvoiceTransaction) Hold(reason string) error {
+	if t.status != TransactionStatusImported {
+		return ErrInvalidStatusTransition.WithContext(
+			"transaction_id", t.transactionID.String(),
+			"from", t.status.String(),
+			"to", TransactionStatusHeld.String(),
+		)
+	}
+
+	t.status = TransactionStatusHeld
+	t.statusReason = reason
+	t.updatedAt = time.Now()
+
+	t.addEvent(NewTransactionHeldEvent(t.transactionID, t.memberID, reason))
+
+	return nil
+}
+
+// Release 解除暫停（調查排除嫌疑），回到 imported 等待驗證
+func (t *InvoiceTransaction) Release(reason string) error {
+	if t.status != TransactionStatusHeld {
+		return ErrInvalidStatusTransition.WithContext(
+			"transaction_id", t.transactionID.String(),
+			"from", t.status.String(),
+			"to", TransactionStatusImported.String(),
+		)
+	}
+
+	t.status = TransactionStatusImported
+	t.statusReason = reason
+	t.updatedAt = time.Now()
+
+	t.addEvent(NewTransactionReleasedEvent(t.transactionID, t.memberID, reason))
+
+	return nil
+}
+
+// Fail 將交易標記為失敗（驗證駁回或發票作廢）
+//
+// 業務規則：
+// - imported → failed：驗證失敗 / 審核駁回（未曾入帳，積分不發放）
+// - held → failed：確認詐騙（未曾入帳，積分不發放）
+// - verified → failed：發票作廢（已入帳積分需由積分上下文扣回）
+// - failed 狀態不可再次變更
+func (t *InvoiceTransaction) Fail(reason string) error {
+	if t.status == TransactionStatusFailed {
+		return ErrInvalidStatusTransition.WithContext(
+			"transaction_id", t.transactionID.String(),
+			"from", t.status.String(),
+			"to", TransactionStatusFailed.String(),
+		)
+	}
+
+	previous := t.status
+	t.status = TransactionStatusFailed
+	t.statusReason = reason
+	t.updatedAt = time.Now()
+
+	t.addEvent(NewTransactionFailedEvent(
+		t.transactionID,
+		t.memberID,
+		previous,
+		reason,
+	))
+
+	return nil
+}
+
+// ===========================
+// 查詢方法
+// ===========================
+
+// TransactionID 返回交易 ID
+func (t *InvoiceTransaction) TransactionID() TransactionID {
+	return t.transactionID
+}
+
+// MemberID 返回會員 ID
+func (t *InvoiceTransaction) MemberID() MemberID {
+	return t.memberID
+}
+
+// InvoiceNumber 返回發票號碼
+func (t *InvoiceTransaction) InvoiceNumber() InvoiceNumber {
+	return t.invoiceNumber
+}
+
+// InvoiceDate 返回發票日期
+func (t *InvoiceTransaction) InvoiceDate() time.Time {
+	return t.invoiceDate
+}
+
+// Amount 返回發票金額
+func (t *InvoiceTransaction) Amount() Money {
+	return t.amount
+}
+
+// GetAmount 返回發票金額（元）
+//
+// 實作 points.PointsCalculableTransaction 介面（結構化型別，不需 import points）
+func (t *InvoiceTransaction) GetAmount() int {
+	return t.amount.Amount()
+}
+
+// GetInvoiceDate 返回發票日期
+//
+// 實作 external.MatchableInvoice 介面（iChef 比對使用）
+func (t *InvoiceTransaction) GetInvoiceDate() time.Time {
+	return t.invoiceDate
+}
+
+// Status 返回交易狀態
+func (t *InvoiceTransaction) Status() TransactionStatus {
+	return t.status
+}
+
+// StatusReason 返回最近一次狀態變更原因
+func (t *InvoiceTransaction) StatusReason() string {
+	return t.statusReason
+}
+
+// IsHeld 判斷交易是否暫停中（疑似詐騙）
+func (t *InvoiceTransaction) IsHeld() bool {
+	return t.status == TransactionStatusHeld
+}
+
+// IsVerified 判斷交易是否已驗證（計入累積積分）
+func (t *InvoiceTransaction) IsVerified() bool {
+	return t.status == TransactionStatusVerified
+}
+
+// VerifiedAt 返回驗證時間（未驗證時為 nil）
+func (t *InvoiceTransaction) VerifiedAt() *time.Time {
+	return t.verifiedAt
+}
+
+// CreatedAt 返回創建時間
+func (t *InvoiceTransaction) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// UpdatedAt 返回最後更新時間
+func (t *InvoiceTransaction) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// ===========================
+// 事件管理
+// ===========================
+
+// addEvent 添加領域事件到待發布列表（私有方法）
+func (t *InvoiceTransaction) addEvent(event shared.DomainEvent) {
+	t.events = append(t.events, event)
+}
+
+// PullEvents 獲取所有待發布事件並清空列表
+//
+// 設計原則：與 PointsAccount.PullEvents 一致（Pull 模式，只讀取一次）
+func (t *InvoiceTransaction) PullEvents() []shared.DomainEvent {
+	events := t.events
+	t.events = make([]shared.DomainEvent, 0)
+	return events
+}
@@ -0,0 +1,186 @@
+package invoice_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestTransaction 創建測試用交易（AB12345678，1000 元）
+func newTestTransaction(t *testing.T) *invoice.InvoiceTransaction {
+	number, err := invoice.NewInvoiceNumber("AB12345678")
+	require.NoError(t, err)
+	amount, err := invoice.NewMoney(1000)
+	require.NoError(t, err)
+
+	tx, err := invoice.NewInvoiceTransaction(
+		invoice.NewMemberID(),
+		number,
+		time.Date(2025, 1, 15, 20, 0, 0, 0, time.UTC),
+		amount,
+	)
+	require.NoError(t, err)
+	return tx
+}
+
+// ===========================
+// InvoiceNumber 值對象測試
+// ===========================
+
+// Test 1: 發票號碼正規化（小寫、空白、連字號）
+func TestNewInvoiceNumber_Normalizes(t *testing.T) {
+	number, err := invoice.NewInvoiceNumber(" ab-1234 5678 ")
+
+	require.NoError(t, err)
+	assert.Equal(t, "AB12345678", number.String())
+}
+
+// Test 2: 無效格式
+func TestNewInvoiceNumber_InvalidFormat_ReturnsError(t *testing.T) {
+	for _, input := range []string{"", "A123456789", "AB1234567", "1212345678"} {
+		_, err := invoice.NewInvoiceNumber(input)
+		assert.ErrorIs(t, err, invoice.ErrInvalidInvoiceNumber, input)
+	}
+}
+
+// Test 3: 負數金額
+func TestNewMoney_Negative_ReturnsError(t *testing.T) {
+	_, err := invoice.NewMoney(-1)
+	assert.ErrorIs(t, err, invoice.ErrInvalidMoney)
+}
+
+// ===========================
+// InvoiceTransaction 聚合測試
+// ===========================
+
+// Test 4: 新交易為 imported 狀態並發布建立事件
+func TestNewInvoiceTransaction_Success(t *testing.T) {
+	tx := newTestTransaction(t)
+
+	assert.Equal(t, invoice.TransactionStatusImported, tx.Status())
+	assert.False(t, tx.IsVerified())
+	assert.Nil(t, tx.VerifiedAt())
+	assert.Equal(t, 1000, tx.GetAmount())
+
+	events := tx.PullEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "invoice.transaction_created", events[0].EventType())
+}
+
+// Test 5: 空會員 ID
+func TestNewInvoiceTransaction_EmptyMemberID_ReturnsError(t *testing.T) {
+	number, _ := invoice.NewInvoiceNumber("AB12345678")
+	amount, _ := invoice.NewMoney(100)
+
+	tx, err := invoice.NewInvoiceTransaction(invoice.MemberID{}, number, time.Now(), amount)
+
+	assert.Nil(t, tx)
+	assert.ErrorIs(t, err, invoice.ErrInvalidMemberID)
+}
+
+// Test 6: 驗證交易
+func TestInvoiceTransaction_Verify_Success(t *testing.T) {
+	tx := newTestTransaction(t)
+	tx.PullEvents()
+
+	err := tx.Verify("ichef_exact_match")
+
+	require.NoError(t, err)
+	assert.True(t, tx.IsVerified())
+	assert.NotNil(t, tx.VerifiedAt())
+	assert.Equal(t, "ichef_exact_match", tx.StatusReason())
+
+	events := tx.PullEvents()
+	require.Len(t, events, 1)
+	verified, ok := events[0].(*invoice.TransactionVerifiedEvent)
+	require.True(t, ok)
+	assert.Equal(t, 1000, verified.Amount().Amount())
+}
+
+// Test 7: 已驗證的交易不能再次驗證
+func TestInvoiceTransaction_Verify_AlreadyVerified_ReturnsError(t *testing.T) {
+	tx := newTestTransaction(t)
+	require.NoError(t, tx.Verify("first"))
+
+	err := tx.Verify("second")
+
+	assert.ErrorIs(t, err, invoice.ErrInvalidStatusTransition)
+}
+
+// Test 8: 以 POS 資料校正後驗證
+func TestInvoiceTransaction_VerifyWithPOSData_CorrectsAmountAndDate(t *testing.T) {
+	tx := newTestTransaction(t)
+	posAmount, _ := invoice.NewMoney(980)
+	posDate := time.Date(2025, 1, 14, 23, 50, 0, 0, time.UTC)
+
+	err := tx.VerifyWithPOSData(posAmount, posDate, "discrepancy_approved")
+
+	require.NoError(t, err)
+	assert.True(t, tx.IsVerified())
+	assert.Equal(t, 980, tx.GetAmount())
+	assert.Equal(t, posDate, tx.InvoiceDate())
+}
+
+// Test 9: 狀態不符時不修改資料
+func TestInvoiceTransaction_VerifyWithPOSData_FailedTransaction_KeepsData(t *testing.T) {
+	tx := newTestTransaction(t)
+	require.NoError(t, tx.Fail("rejected"))
+	posAmount, _ := invoice.NewMoney(980)
+
+	err := tx.VerifyWithPOSData(posAmount, time.Now(), "late")
+
+	assert.ErrorIs(t, err, invoice.ErrInvalidStatusTransition)
+	assert.Equal(t, 1000, tx.GetAmount())
+}
+
+// Test 10: 標記失敗並記錄前一狀態
+func TestInvoiceTransaction_Fail_RecordsPreviousStatus(t *testing.T) {
+	tx := newTestTransaction(t)
+	require.NoError(t, tx.Verify("ok"))
+	tx.PullEvents()
+
+	err := tx.Fail("invoice_voided")
+
+	require.NoError(t, err)
+	assert.Equal(t, invoice.TransactionStatusFailed, tx.Status())
+	events := tx.PullEvents()
+	require.Len(t, events, 1)
+	failed, ok := events[0].(*invoice.TransactionFailedEvent)
+	require.True(t, ok)
+	assert.Equal(t, invoice.TransactionStatusVerified, failed.PreviousStatus())
+
+	assert.ErrorIs(t, tx.Fail("again"), invoice.ErrInvalidStatusTransition)
+}
+
+// Test 11: 暫停後不可驗證，解除暫停後恢復 imported
+func TestInvoiceTransaction_HoldAndRelease(t *testing.T) {
+	tx := newTestTransaction(t)
+	tx.PullEvents()
+
+	require.NoError(t, tx.Hold("fraud_case:1"))
+	assert.True(t, tx.IsHeld())
+	assert.ErrorIs(t, tx.Verify("ok"), invoice.ErrInvalidStatusTransition)
+
+	require.NoError(t, tx.Release("cleared"))
+	assert.Equal(t, invoice.TransactionStatusImported, tx.Status())
+
+	events := tx.PullEvents()
+	require.Len(t, events, 2)
+	assert.Equal(t, "invoice.transaction_held", events[0].EventType())
+	assert.Equal(t, "invoice.transaction_released", events[1].EventType())
+}
+
+// Test 12: 只有 imported 可暫停，只有 held 可解除；held 可直接標記失敗
+func TestInvoiceTransaction_Hold_InvalidTransitions(t *testing.T) {
+	tx := newTestTransaction(t)
+	assert.ErrorIs(t, tx.Release("nothing held"), invoice.ErrInvalidStatusTransition)
+
+	require.NoError(t, tx.Hold("fraud_case:1"))
+	assert.ErrorIs(t, tx.Hold("again"), invoice.ErrInvalidStatusTransition)
+
+	require.NoError(t, tx.Fail("fraud_confirmed"))
+	assert.Equal(t, invoice.TransactionStatusFailed, tx.Status())
+}
@@ -0,0 +1,154 @@
+package invoice
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ===========================
+// InvoiceNumber 發票號碼值對象
+// ===========================
+
+// InvoiceNumber 統一發票號碼值對象
+//
+// 業務規則：
+// 1. 格式：2 個大寫英文字母 + 8 位數字（例如：AB12345678）
+// 2. 正規化：轉為大寫、移除空白與連字號（BR-005-06）
+//
+// 設計原則：
+// - 不可變性（unexported field）
+// - 自我驗證（建構函數強制正規化 + 驗證）
+// - 值相等（Equals 比較正規化後的字串）
+type InvoiceNumber struct {
+	value string
+}
+
+// invoiceNumberPattern 統一發票號碼格式
+var invoiceNumberPattern = regexp.MustCompile(`^[A-Z]{2}[0-9]{8}$`)
+
+// NewInvoiceNumber 創建發票號碼（Checked Constructor）
+//
+// 正規化規則：
+// - 移除前後空白、內部空白及連字號（"ab-1234 5678" → "AB12345678"）
+// - 轉為大寫
+//
+// 錯誤：
+// - 正規化後不符合格式 → ErrInvalidInvoiceNumber
+func NewInvoiceNumber(value string) (InvoiceNumber, error) {
+	normalized := normalizeInvoiceNumber(value)
+	if !invoiceNumberPattern.MatchString(normalized) {
+		return InvoiceNumber{}, ErrInvalidInvoiceNumber.WithContext(
+			"input", value,
+			"normalized", normalized,
+		)
+	}
+	return InvoiceNumber{value: normalized}, nil
+}
+
+// normalizeInvoiceNumber 正規化發票號碼（大寫、移除空白和連字號）
+func normalizeInvoiceNumber(value string) string {
+	replacer := strings.NewReplacer(" ", "", "-", "", "\t", "")
+	return strings.ToUpper(replacer.Replace(strings.TrimSpace(value)))
+}
+
+// String 返回正規化後的發票號碼
+func (n InvoiceNumber) String() string {
+	return n.value
+}
+
+// Equals 比較兩個發票號碼是否相等
+func (n InvoiceNumber) Equals(other InvoiceNumber) bool {
+	return n.value == other.value
+}
+
+// IsZero 檢查是否為零值
+func (n InvoiceNumber) IsZero() bool {
+	return n.value == ""
+}
+
+// ===========================
+// Money 金額值對象
+// ===========================
+
+// Money 新台幣金額值對象（單位：元，整數）
+//
+// 建構約束：金額必須 >= 0
+//
+// 設計決策：
+// - 發票金額在台灣統一發票上為整數元，不需要 decimal
+// - 積分計算時再轉換為 decimal（見 points.PointsCalculationService）
+type Money struct {
+	amount int
+}
+
+// NewMoney 建構函數（checked 版本）
+func NewMoney(amount int) (Money, error) {
+	if amount < 0 {
+		return Money{}, ErrInvalidMoney.WithContext(
+			"attempted_value", amount,
+			"constraint", ">= 0",
+		)
+	}
+	return Money{amount: amount}, nil
+}
+
+// Amount 返回金額（元）
+func (m Money) Amount() int {
+	return m.amount
+}
+
+// Equals 比較兩個金額是否相等
+func (m Money) Equals(other Money) bool {
+	return m.amount == other.amount
+}
+
+// ===========================
+// TransactionStatus 交易狀態枚舉
+// ===========================
+
+// TransactionStatus 發票交易狀態
+//
+// 狀態流轉（BR-005-03）：
+//   imported (待驗證) → verified (已驗證)
+//   imported (待驗證) → failed   (驗證失敗 / 審核駁回)
+//   verified (已驗證) → failed   (發票作廢)
+//   imported (待驗證) → held     (疑似詐騙，暫停處理)
+//   held     (暫停)   → imported (調查排除) / failed (確認詐騙)
+//
+// 設計原則：
+// - 使用字串常量（與資料庫欄位值一致，便於查詢與報表）
+// - 狀態轉換規則由 InvoiceTransaction 聚合根負責
+type TransactionStatus string
+
+const (
+	TransactionStatusImported TransactionStatus = "imported" // 待驗證：會員已掃描，等待 iChef 核對
+	TransactionStatusVerified TransactionStatus = "verified" // 已驗證：已計入累積積分
+	TransactionStatusFailed   TransactionStatus = "failed"   // 失敗：驗證失敗或發票作廢，不計積分
+	TransactionStatusHeld     TransactionStatus = "held"     // 暫停：疑似詐騙，調查結束前不驗證、不計積分
+)
+
+// ParseTransactionStatus 從字串解析交易狀態
+//
+// 使用場景：從資料庫或 API 請求讀取狀態
+func ParseTransactionStatus(s string) (TransactionStatus, error) {
+	status := TransactionStatus(s)
+	if !status.IsValid() {
+		return "", ErrInvalidTransactionStatus.WithContext("status", s)
+	}
+	return status, nil
+}
+
+// String 返回狀態字串
+func (s TransactionStatus) String() string {
+	return string(s)
+}
+
+// IsValid 判斷狀態是否為已定義的值
+func (s TransactionStatus) IsValid() bool {
+	switch s {
+	case TransactionStatusImported, TransactionStatusVerified, TransactionStatusFailed, TransactionStatusHeld:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,93 @@
+package member
+
+import (
+	"strings"
+	"time"
+)
+
+// ===========================
+// AgeVerification Value Object
+// ===========================
+
+// LegalDrinkingAge 法定飲酒年齡
+const LegalDrinkingAge = 18
+
+// AgeVerificationMethod 年齡驗證方式
+type AgeVerificationMethod string
+
+// 驗證方式
+const (
+	AgeVerifiedByStaffIDCheck AgeVerificationMethod = "staff_id_check" // 店員現場查驗身分證件
+)
+
+// String 返回驗證方式
+func (m AgeVerificationMethod) String() string {
+	return string(m)
+}
+
+// IsValid 檢查驗證方式是否有效
+func (m AgeVerificationMethod) IsValid() bool {
+	return m == AgeVerifiedByStaffIDCheck
+}
+
+// AgeVerification 成年驗證紀錄（值對象）
+//
+// 欄位：
+// - verifiedBy: 查驗的店員 / 管理員帳號
+// - method: 驗證方式（目前僅店員查驗身分證件）
+// - verifiedAt: 驗證時間
+//
+// 設計原則：
+// - 零值表示未驗證
+// - 僅記錄查驗結果，不保存證件號碼等個人資料
+type AgeVerification struct {
+	verifiedBy string
+	method     AgeVerificationMethod
+	verifiedAt time.Time
+}
+
+// NewAgeVerification 建立成年驗證紀錄（Checked Constructor）
+//
+// 錯誤：驗證人員為空、驗證方式無效 → ErrInvalidAgeVerification
+func NewAgeVerification(verifiedBy string, method AgeVerificationMethod, verifiedAt time.Time) (AgeVerification, error) {
+	verifiedBy = strings.TrimSpace(verifiedBy)
+	if verifiedBy == "" {
+		return AgeVerification{}, ErrInvalidAgeVerification.WithContext("reason", "verifier is required")
+	}
+	if !method.IsValid() {
+		return AgeVerification{}, ErrInvalidAgeVerification.WithContext("method", method.String())
+	}
+	return AgeVerification{verifiedBy: verifiedBy, method: method, verifiedAt: verifiedAt}, nil
+}
+
+// ReconstructAgeVerification 重建成年驗證紀錄（用於從資料庫載入，verifiedAt 為 nil 表示未驗證）
+func ReconstructAgeVerification(verifiedBy string, method AgeVerificationMethod, verifiedAt *time.Time) AgeVerification {
+	if verifiedAt == nil {
+		return AgeVerification{}
+	}
+	return AgeVerification{verifiedBy: verifiedBy, method: method, verifiedAt: *verifiedAt}
+}
+
+// IsZero 判斷是否未驗證
+func (v AgeVerification) IsZero() bool {
+	return v.verifiedAt.IsZero()
+}
+
+// VerifiedBy 返回查驗人員
+func (v AgeVerification) VerifiedBy() string {
+	return v.verifiedBy
+}
+
+// Method 返回驗證方式
+func (v AgeVerification) Method() AgeVerificationMethod {
+	return v.method
+}
+
+// VerifiedAt 返回驗證時間（未驗證時為 nil）
+func (v AgeVerification) VerifiedAt() *time.Time {
+	if v.IsZero() {
+		return nil
+	}
+	at := v.verifiedAt
+	return &at
+}
@@ -0,0 +1,127 @@
+package member
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// Birthday Value Object
+// ===========================
+
+// birthdayLayout 生日格式（YYYY-MM-DD）
+const birthdayLayout = "2006-01-02"
+
+// minBirthYear 可接受的最早出生年份
+const minBirthYear = 1900
+
+// Birthday 會員生日（值對象，選填）
+//
+// 設計原則：
+// - 零值表示未填寫
+// - 僅保存日期（不含時間與時區），以營業時區（Asia/Taipei）判斷是否為生日當天
+// - 2/29 出生的會員在非閏年以 2/28 慶祝
+type Birthday struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// ParseBirthday 解析生日（Checked Constructor）
+//
+// 參數：
+// - s: YYYY-MM-DD
+// - now: 目前時間（生日不可晚於營業時區的今天）
+//
+// 錯誤：格式錯誤、日期不存在、早於 1900 年或晚於今天 → ErrInvalidBirthday
+func ParseBirthday(s string, now time.Time) (Birthday, error) {
+	s = strings.TrimSpace(s)
+	date, err := time.Parse(birthdayLayout, s)
+	if err != nil {
+		return Birthday{}, ErrInvalidBirthday.WithContext("birthday", s, "format", "YYYY-MM-DD")
+	}
+
+	today := now.In(shared.BusinessLocation).Format(birthdayLayout)
+	if date.Year() < minBirthYear || s > today {
+		return Birthday{}, ErrInvalidBirthday.WithContext("birthday", s, "reason", "birthday is out of range")
+	}
+	return Birthday{year: date.Year(), month: date.Month(), day: date.Day()}, nil
+}
+
+// ReconstructBirthday 重建生日（用於從資料庫載入，空字串表示未填寫）
+func ReconstructBirthday(s string) (Birthday, error) {
+	if s == "" {
+		return Birthday{}, nil
+	}
+	date, err := time.Parse(birthdayLayout, s)
+	if err != nil {
+		return Birthday{}, ErrInvalidBirthday.WithContext("birthday", s, "reason", "invalid birthday in database")
+	}
+	return Birthday{year: date.Year(), month: date.Month(), day: date.Day()}, nil
+}
+
+// IsZero 判斷是否未填寫
+func (b Birthday) IsZero() bool {
+	return b.year == 0
+}
+
+// String 返回 YYYY-MM-DD（未填寫時為空字串）
+func (b Birthday) String() string {
+	if b.IsZero() {
+		return ""
+	}
+	return time.Date(b.year, b.month, b.day, 0, 0, 0, 0, time.UTC).Format(birthdayLayout)
+}
+
+// IsCelebratedOn 判斷指定時間（營業時區）是否為會員的生日
+func (b Birthday) IsCelebratedOn(at time.Time) bool {
+	if b.IsZero() {
+		return false
+	}
+	for _, monthDay := range CelebratedMonthDays(at) {
+		if b.monthDay() == monthDay {
+			return true
+		}
+	}
+	return false
+}
+
+// AgeOn 返回指定時間（營業時區）的足歲年齡（未填寫時為 0）
+//
+// 2/29 出生的會員在非閏年 3/1 增加一歲
+func (b Birthday) AgeOn(at time.Time) int {
+	if b.IsZero() {
+		return 0
+	}
+	local := at.In(shared.BusinessLocation)
+	age := local.Year() - b.year
+	if local.Month() < b.month || (local.Month() == b.month && local.Day() < b.day) {
+		age--
+	}
+	return age
+}
+
+// monthDay 返回 MM-DD
+func (b Birthday) monthDay() string {
+	return b.String()[len("2006-"):]
+}
+
+// CelebratedMonthDays 返回指定時間（營業時區）慶祝生日的月日（MM-DD）
+//
+// 範例：
+// - 2025-05-20 → [05-20]
+// - 2025-02-28（非閏年）→ [02-28, 02-29]
+//
+// 使用場景：Repository 依月日查詢當天生日的會員
+func CelebratedMonthDays(at time.Time) []string {
+	local := at.In(shared.BusinessLocation)
+	monthDays := []string{local.Format("01-02")}
+
+	tomorrow := local.AddDate(0, 0, 1)
+	if local.Month() == time.February && local.Day() == 28 && tomorrow.Month() == time.March {
+		monthDays = append(monthDays, "02-29")
+	}
+	return monthDays
+}
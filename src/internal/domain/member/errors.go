@@ -17,6 +17,19 @@ const (
 	ErrCodeInvalidMemberID          ErrorCode = "INVALID_MEMBER_ID"
 	ErrCodeInvalidDisplayName       ErrorCode = "INVALID_DISPLAY_NAME"
 	ErrCodePhoneAlreadyBound        ErrorCode = "PHONE_ALREADY_BOUND"
+	ErrCodeInvalidMemberSearch      ErrorCode = "INVALID_MEMBER_SEARCH"
+	ErrCodeInvalidSearchCursor      ErrorCode = "INVALID_SEARCH_CURSOR"
+	ErrCodeInvalidPhoneNumberChange ErrorCode = "INVALID_PHONE_NUMBER_CHANGE"
+	ErrCodePhoneNotBound            ErrorCode = "PHONE_NOT_BOUND"
+	ErrCodeMemberVersionConflict    ErrorCode = "MEMBER_VERSION_CONFLICT"
+	ErrCodeInvalidMemberProfile     ErrorCode = "INVALID_MEMBER_PROFILE"
+	ErrCodeInvalidMemberMerge       ErrorCode = "INVALID_MEMBER_MERGE"
+	ErrCodeMemberMerged             ErrorCode = "MEMBER_MERGED"
+	ErrCodeInvalidBirthday          ErrorCode = "INVALID_BIRTHDAY"
+	ErrCodeBirthdayAlreadySet       ErrorCode = "BIRTHDAY_ALREADY_SET"
+	ErrCodeInvalidAgeVerification   ErrorCode = "INVALID_AGE_VERIFICATION"
+	ErrCodeMemberUnderage           ErrorCode = "MEMBER_UNDERAGE"
+	ErrCodeAgeVerificationRequired  ErrorCode = "AGE_VERIFICATION_REQUIRED"
 )
 
 // DomainError Member Domain 錯誤結構
@@ -163,6 +176,7 @@ var (
 	//
 	// 觸發條件：
 	// - 顯示名稱為空字串
+	// - 顯示名稱超過 100 字（變更名稱時檢查）
 	ErrInvalidDisplayName = &DomainError{
 		Code:    ErrCodeInvalidDisplayName,
 		Message: "顯示名稱不能為空",
@@ -179,4 +193,116 @@ var (
 		Code:    ErrCodePhoneAlreadyBound,
 		Message: "手機號碼已綁定，無法修改（需管理員介入）",
 	}
+
+	// ErrInvalidMemberSearch 會員搜尋條件無效
+	//
+	// 觸發條件：
+	// - 手機末碼不是 1–10 位數字、名稱關鍵字過長
+	// - 註冊時間或積分區間的起點大於終點
+	// - 排序欄位或每頁筆數無效
+	ErrInvalidMemberSearch = &DomainError{
+		Code:    ErrCodeInvalidMemberSearch,
+		Message: "會員搜尋條件無效",
+	}
+
+	// ErrInvalidSearchCursor 分頁游標無效（格式錯誤或與排序方式不一致）
+	ErrInvalidSearchCursor = &DomainError{
+		Code:    ErrCodeInvalidSearchCursor,
+		Message: "分頁游標無效",
+	}
+
+	// ErrInvalidPhoneNumberChange 手機號碼異動無效
+	//
+	// 觸發條件：
+	// - 未填寫原因（或超過 200 字）、缺少操作者
+	// - 新號碼與目前號碼相同
+	ErrInvalidPhoneNumberChange = &DomainError{
+		Code:    ErrCodeInvalidPhoneNumberChange,
+		Message: "手機號碼異動無效",
+	}
+
+	// ErrPhoneNotBound 會員尚未綁定手機號碼（無法解除綁定）
+	ErrPhoneNotBound = &DomainError{
+		Code:    ErrCodePhoneNotBound,
+		Message: "會員尚未綁定手機號碼",
+	}
+
+	// ErrMemberVersionConflict 會員資料已被其他操作更新（樂觀鎖衝突）
+	//
+	// 處理方式：重新載入會員後再試一次
+	ErrMemberVersionConflict = &DomainError{
+		Code:    ErrCodeMemberVersionConflict,
+		Message: "會員資料已被其他操作更新，請重新載入後再試",
+	}
+
+	// ErrInvalidMemberProfile 會員補充資料無效
+	//
+	// 觸發條件：
+	// - Email 格式無效或超過 254 字元
+	// - 店家備註超過 500 字
+	ErrInvalidMemberProfile = &DomainError{
+		Code:    ErrCodeInvalidMemberProfile,
+		Message: "會員資料格式無效",
+	}
+
+	// ErrInvalidMemberMerge 會員合併無效
+	//
+	// 觸發條件：
+	// - 未填寫原因（或超過 200 字）、缺少操作者
+	// - 保留會員與被合併會員相同
+	ErrInvalidMemberMerge = &DomainError{
+		Code:    ErrCodeInvalidMemberMerge,
+		Message: "會員合併無效",
+	}
+
+	// ErrMemberMerged 會員已被合併到其他會員（已停用）
+	//
+	// 觸發條件：
+	// - 合併已停用的會員（不論作為保留或被合併的一方）
+	// - 已停用的會員綁定或更換手機號碼
+	ErrMemberMerged = &DomainError{
+		Code:    ErrCodeMemberMerged,
+		Message: "會員已合併至其他會員",
+	}
+
+	// ErrInvalidBirthday 生日無效
+	//
+	// 觸發條件：
+	// - 不是 YYYY-MM-DD 格式或日期不存在
+	// - 早於 1900 年或晚於今天
+	ErrInvalidBirthday = &DomainError{
+		Code:    ErrCodeInvalidBirthday,
+		Message: "生日格式無效（格式：YYYY-MM-DD）",
+	}
+
+	// ErrBirthdayAlreadySet 會員已填寫生日（會員不可自行修改，需管理員介入）
+	ErrBirthdayAlreadySet = &DomainError{
+		Code:    ErrCodeBirthdayAlreadySet,
+		Message: "生日已填寫，如需修改請洽店家",
+	}
+
+	// ErrInvalidAgeVerification 年齡驗證紀錄無效
+	//
+	// 觸發條件：
+	// - 缺少驗證人員
+	// - 驗證方式無效
+	ErrInvalidAgeVerification = &DomainError{
+		Code:    ErrCodeInvalidAgeVerification,
+		Message: "年齡驗證紀錄無效",
+	}
+
+	// ErrMemberUnderage 會員未滿法定飲酒年齡（依已填寫的生日判斷，無法驗證為成年）
+	ErrMemberUnderage = &DomainError{
+		Code:    ErrCodeMemberUnderage,
+		Message: "會員未滿 18 歲，無法驗證為成年",
+	}
+
+	// ErrAgeVerificationRequired 會員尚未完成成年驗證
+	//
+	// 觸發條件：
+	// - 兌換酒類獎勵、參加酒類促銷等僅限已驗證成年會員的操作
+	ErrAgeVerificationRequired = &DomainError{
+		Code:    ErrCodeAgeVerificationRequired,
+		Message: "會員尚未完成成年驗證，請出示身分證件由店員查驗",
+	}
 )
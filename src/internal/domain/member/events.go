@@ -0,0 +1,249 @@
+package member
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ===========================
+// MemberUnfollowed 領域事件
+// ===========================
+
+// MemberUnfollowedEvent 會員封鎖官方帳號事件（LINE unfollow）
+//
+// 訂閱者：
+// - 群發：加入排除名單（原因 blocked）
+// - 推播：無法送達的通知改為取消（由推播排程檢查 IsReachable）
+type MemberUnfollowedEvent struct {
+	eventID    string
+	memberID   MemberID
+	lineUserID LineUserID
+	occurredAt time.Time
+}
+
+// NewMemberUnfollowedEvent 創建封鎖事件
+func NewMemberUnfollowedEvent(memberID MemberID, lineUserID LineUserID, occurredAt time.Time) *MemberUnfollowedEvent {
+	return &MemberUnfollowedEvent{
+		eventID:    uuid.New().String(),
+		memberID:   memberID,
+		lineUserID: lineUserID,
+		occurredAt: occurredAt,
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *MemberUnfollowedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *MemberUnfollowedEvent) EventType() string {
+	return "member.unfollowed"
+}
+
+// OccurredAt 實現 DomainEvent 介面（封鎖時間）
+func (e *MemberUnfollowedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *MemberUnfollowedEvent) AggregateID() string {
+	return e.memberID.String()
+}
+
+// MemberID 獲取會員 ID
+func (e *MemberUnfollowedEvent) MemberID() MemberID {
+	return e.memberID
+}
+
+// LineUserID 獲取 LINE UserID
+func (e *MemberUnfollowedEvent) LineUserID() LineUserID {
+	return e.lineUserID
+}
+
+// ===========================
+// MemberRefollowed 領域事件
+// ===========================
+
+// MemberRefollowedEvent 會員解除封鎖事件（封鎖後再次 follow）
+type MemberRefollowedEvent struct {
+	eventID      string
+	memberID     MemberID
+	lineUserID   LineUserID
+	unfollowedAt time.Time
+	occurredAt   time.Time
+}
+
+// NewMemberRefollowedEvent 創建解除封鎖事件
+func NewMemberRefollowedEvent(
+	memberID MemberID,
+	lineUserID LineUserID,
+	unfollowedAt time.Time,
+	occurredAt time.Time,
+) *MemberRefollowedEvent {
+	return &MemberRefollowedEvent{
+		eventID:      uuid.New().String(),
+		memberID:     memberID,
+		lineUserID:   lineUserID,
+		unfollowedAt: unfollowedAt,
+		occurredAt:   occurredAt,
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *MemberRefollowedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *MemberRefollowedEvent) EventType() string {
+	return "member.refollowed"
+}
+
+// OccurredAt 實現 DomainEvent 介面（解除封鎖時間）
+func (e *MemberRefollowedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *MemberRefollowedEvent) AggregateID() string {
+	return e.memberID.String()
+}
+
+// MemberID 獲取會員 ID
+func (e *MemberRefollowedEvent) MemberID() MemberID {
+	return e.memberID
+}
+
+// LineUserID 獲取 LINE UserID
+func (e *MemberRefollowedEvent) LineUserID() LineUserID {
+	return e.lineUserID
+}
+
+// UnfollowedAt 獲取先前的封鎖時間
+func (e *MemberRefollowedEvent) UnfollowedAt() time.Time {
+	return e.unfollowedAt
+}
+
+// ===========================
+// MemberPhoneNumberChanged 領域事件
+// ===========================
+
+// MemberPhoneNumberChangedEvent 管理員更換或解除會員手機號碼事件
+//
+// 事件 ID 與異動紀錄 ID 相同（可由事件追溯稽核紀錄）
+type MemberPhoneNumberChangedEvent struct {
+	change *PhoneNumberChange
+}
+
+// NewMemberPhoneNumberChangedEvent 創建手機號碼異動事件
+func NewMemberPhoneNumberChangedEvent(change *PhoneNumberChange) *MemberPhoneNumberChangedEvent {
+	return &MemberPhoneNumberChangedEvent{change: change}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *MemberPhoneNumberChangedEvent) EventID() string {
+	return e.change.ChangeID()
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *MemberPhoneNumberChangedEvent) EventType() string {
+	return "member.phone_number_changed"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *MemberPhoneNumberChangedEvent) OccurredAt() time.Time {
+	return e.change.ChangedAt()
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *MemberPhoneNumberChangedEvent) AggregateID() string {
+	return e.change.MemberID().String()
+}
+
+// MemberID 獲取會員 ID
+func (e *MemberPhoneNumberChangedEvent) MemberID() MemberID {
+	return e.change.MemberID()
+}
+
+// Action 獲取異動類型（rebind / unbind）
+func (e *MemberPhoneNumberChangedEvent) Action() PhoneNumberChangeAction {
+	return e.change.Action()
+}
+
+// PreviousPhoneNumber 獲取異動前號碼
+func (e *MemberPhoneNumberChangedEvent) PreviousPhoneNumber() PhoneNumber {
+	return e.change.PreviousPhoneNumber()
+}
+
+// PhoneNumber 獲取異動後號碼（解除綁定時為零值）
+func (e *MemberPhoneNumberChangedEvent) PhoneNumber() PhoneNumber {
+	return e.change.PhoneNumber()
+}
+
+// Reason 獲取異動原因
+func (e *MemberPhoneNumberChangedEvent) Reason() string {
+	return e.change.Reason()
+}
+
+// OperatorID 獲取操作的管理員
+func (e *MemberPhoneNumberChangedEvent) OperatorID() string {
+	return e.change.OperatorID()
+}
+
+// ===========================
+// MemberMerged 領域事件
+// ===========================
+
+// MemberMergedEvent 重複會員已合併事件（發布於被合併的會員）
+//
+// 事件 ID 與合併紀錄 ID 相同（可由事件追溯稽核紀錄）
+type MemberMergedEvent struct {
+	merge *MemberMerge
+}
+
+// NewMemberMergedEvent 創建會員合併事件
+func NewMemberMergedEvent(merge *MemberMerge) *MemberMergedEvent {
+	return &MemberMergedEvent{merge: merge}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *MemberMergedEvent) EventID() string {
+	return e.merge.MergeID()
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *MemberMergedEvent) EventType() string {
+	return "member.merged"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *MemberMergedEvent) OccurredAt() time.Time {
+	return e.merge.MergedAt()
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *MemberMergedEvent) AggregateID() string {
+	return e.merge.SecondaryMemberID().String()
+}
+
+// PrimaryMemberID 獲取保留的會員 ID
+func (e *MemberMergedEvent) PrimaryMemberID() MemberID {
+	return e.merge.PrimaryMemberID()
+}
+
+// SecondaryMemberID 獲取被合併的會員 ID
+func (e *MemberMergedEvent) SecondaryMemberID() MemberID {
+	return e.merge.SecondaryMemberID()
+}
+
+// Transfer 獲取移轉的積分與歷史筆數
+func (e *MemberMergedEvent) Transfer() MergeTransfer {
+	return e.merge.Transfer()
+}
+
+// OperatorID 獲取操作的管理員
+func (e *MemberMergedEvent) OperatorID() string {
+	return e.merge.OperatorID()
+}
@@ -2,6 +2,8 @@ package member
 
 import (
 	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
 )
 
 // ===========================
@@ -14,13 +16,21 @@ import (
 // - 會員基本信息（ID, LINE UserID, DisplayName）
 // - 手機號碼綁定（PhoneNumber）
 // - 註冊狀態（CreatedAt, UpdatedAt）
+// - 可觸及狀態（UnfollowedAt：封鎖官方帳號後無法推播）
+// - 補充資料（Profile：Email、店家備註、生日）
+// - 成年驗證（AgeVerification：店員查驗證件的人員、方式與時間）
+// - 合併停用狀態（MergedInto：重複會員合併後保留的會員）
 //
 // 不變量（Invariants）：
 // 1. 會員必須有 LINE UserID（註冊來源）
 // 2. 會員必須有顯示名稱
-// 3. 手機號碼綁定後不可變更（業務規則：需管理員介入）
+// 3. 手機號碼綁定後會員不可自行變更（僅管理員可更換或解除綁定，需填寫原因）
 // 4. CreatedAt 不可變更
 // 5. UpdatedAt 在每次狀態變更時更新
+// 6. 封鎖後再次 follow 恢復可觸及，不需重新註冊
+// 7. 每次狀態變更遞增 Version，Repository.Update 以載入時版本號偵測並行修改
+// 8. 被合併的會員停用（保留紀錄指向保留的會員），不可再綁定手機號碼或再次合併
+// 9. 酒類促銷與獎勵僅限已驗證成年的會員；已填寫的生日未滿 18 歲時不可驗證為成年
 //
 // 設計原則：
 // - Tell, Don't Ask：通過方法封裝行為，而非暴露狀態
@@ -39,10 +49,29 @@ type Member struct {
 	// 綁定信息
 	phoneNumber PhoneNumber
 
+	// 可觸及狀態
+	unfollowedAt *time.Time // 封鎖官方帳號的時間（nil 表示可觸及）
+
+	// 補充資料
+	profile MemberProfile
+
+	// 成年驗證（零值表示未驗證）
+	ageVerification AgeVerification
+
+	// 合併停用狀態（mergedInto 為零值表示未被合併）
+	mergedInto MemberID
+	mergedAt   *time.Time
+
 	// 審計欄位
 	createdAt time.Time
 	updatedAt time.Time
 	version   int // 樂觀鎖版本號（Optimistic Locking）
+
+	// persistedVersion 最近一次載入或寫入時的版本號（更新時比對，0 表示尚未保存）
+	persistedVersion int
+
+	// 領域事件
+	events []shared.DomainEvent
 }
 
 // NewMember 創建新會員（Checked Constructor）
@@ -85,6 +114,7 @@ func NewMember(lineUserID LineUserID, displayName string) (*Member, error) {
 		createdAt:   now,
 		updatedAt:   now,
 		version:     1, // 初始版本為 1
+		events:      make([]shared.DomainEvent, 0),
 	}
 
 	return member, nil
@@ -97,6 +127,9 @@ func NewMember(lineUserID LineUserID, displayName string) (*Member, error) {
 // - lineUserID: LINE UserID
 // - displayName: 顯示名稱
 // - phoneNumber: 手機號碼（可能為零值）
+// - unfollowedAt: 封鎖時間（nil 表示可觸及）
+// - profile: 補充資料
+// - ageVerification: 成年驗證紀錄（零值表示未驗證）
 // - createdAt: 創建時間
 // - updatedAt: 更新時間
 // - version: 樂觀鎖版本號
@@ -113,6 +146,9 @@ func ReconstructMember(
 	lineUserID LineUserID,
 	displayName string,
 	phoneNumber PhoneNumber,
+	unfollowedAt *time.Time,
+	profile MemberProfile,
+	ageVerification AgeVerification,
 	createdAt time.Time,
 	updatedAt time.Time,
 	version int,
@@ -123,16 +159,60 @@ func ReconstructMember(
 	}
 
 	return &Member{
-		memberID:    memberID,
-		lineUserID:  lineUserID,
-		displayName: displayName,
-		phoneNumber: phoneNumber,
-		createdAt:   createdAt,
-		updatedAt:   updatedAt,
-		version:     version,
+		memberID:     memberID,
+		lineUserID:   lineUserID,
+		displayName:  displayName,
+		phoneNumber:  phoneNumber,
+		unfollowedAt: unfollowedAt,
+		profile:      profile,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+		version:      version,
+
+		ageVerification:  ageVerification,
+		persistedVersion: version,
+		events:           make([]shared.DomainEvent, 0), // 重建時不包含事件
 	}, nil
 }
 
+// ReconstructMergedMember 重建已被合併（停用）的會員
+//
+// 使用場景：Repository 讀取到合併標記時使用（其餘參數同 ReconstructMember）
+func ReconstructMergedMember(
+	memberID MemberID,
+	lineUserID LineUserID,
+	displayName string,
+	phoneNumber PhoneNumber,
+	unfollowedAt *time.Time,
+	profile MemberProfile,
+	ageVerification AgeVerification,
+	createdAt time.Time,
+	updatedAt time.Time,
+	version int,
+	mergedInto MemberID,
+	mergedAt time.Time,
+) (*Member, error) {
+	m, err := ReconstructMember(
+		memberID,
+		lineUserID,
+		displayName,
+		phoneNumber,
+		unfollowedAt,
+		profile,
+		ageVerification,
+		createdAt,
+		updatedAt,
+		version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mergedInto = mergedInto
+	m.mergedAt = &mergedAt
+	return m, nil
+}
+
 // ===========================
 // Member Aggregate Behavior Methods
 // ===========================
@@ -154,6 +234,11 @@ func ReconstructMember(
 // - 會員首次綁定手機號碼
 // - 註冊流程中的必要步驟
 func (m *Member) BindPhoneNumber(phoneNumber PhoneNumber) error {
+	// 0. 已被合併的會員不可綁定
+	if m.IsMerged() {
+		return m.errMerged()
+	}
+
 	// 1. 檢查是否已綁定
 	if !m.phoneNumber.IsZero() {
 		return ErrPhoneAlreadyBound.WithContext(
@@ -172,6 +257,336 @@ func (m *Member) BindPhoneNumber(phoneNumber PhoneNumber) error {
 	return nil
 }
 
+// ChangeDisplayName 變更顯示名稱（由 LINE Profile 同步或管理員修改）
+//
+// 參數：
+// - displayName: 新顯示名稱（去除前後空白）
+// - at: 變更時間
+//
+// 業務規則：
+// 1. 顯示名稱不能為空，且不超過 100 字
+// 2. 與目前名稱相同時不變更（不遞增版本號）
+//
+// 返回：
+// - bool: 狀態是否變更
+// - error: 名稱無效時返回 ErrInvalidDisplayName
+func (m *Member) ChangeDisplayName(displayName string, at time.Time) (bool, error) {
+	displayName, err := normalizeDisplayName(displayName)
+	if err != nil {
+		return false, err
+	}
+	if displayName == m.displayName {
+		return false, nil
+	}
+
+	m.displayName = displayName
+	m.updatedAt = at
+	m.version++
+	return true, nil
+}
+
+// UpdateProfile 更新補充資料（管理後台）
+//
+// 參數：
+// - profile: 新的補充資料（已由 NewMemberProfile 驗證）
+// - at: 變更時間
+//
+// 業務規則：
+// - 已驗證成年的會員變更生日時重新檢查年齡：變更時未滿 18 歲 → 撤銷成年驗證（需重新查驗證件）
+//
+// 返回：
+// - bool: 狀態是否變更（內容相同時不遞增版本號）
+func (m *Member) UpdateProfile(profile MemberProfile, at time.Time) bool {
+	if m.profile.Equals(profile) {
+		return false
+	}
+
+	birthday := profile.Birthday()
+	if m.IsVerifiedAdult() && birthday != m.profile.Birthday() &&
+		!birthday.IsZero() && birthday.AgeOn(at) < LegalDrinkingAge {
+		m.ageVerification = AgeVerification{}
+	}
+
+	m.profile = profile
+	m.updatedAt = at
+	m.version++
+	return true
+}
+
+// RecordBirthday 會員自行填寫生日（LINE Bot）
+//
+// 參數：
+// - birthday: 生日（已由 ParseBirthday 驗證）
+// - at: 填寫時間
+//
+// 業務規則：
+// 1. 生日不可為零值
+// 2. 尚未填寫時設定；與目前生日相同時不變更（不遞增版本號）
+// 3. 已填寫不同的生日 → ErrBirthdayAlreadySet（避免重複領取生日禮，修改需管理員於後台操作）
+//
+// 返回：
+// - bool: 狀態是否變更
+func (m *Member) RecordBirthday(birthday Birthday, at time.Time) (bool, error) {
+	if birthday.IsZero() {
+		return false, ErrInvalidBirthday.WithContext("reason", "birthday is required")
+	}
+
+	current := m.profile.Birthday()
+	if current == birthday {
+		return false, nil
+	}
+	if !current.IsZero() {
+		return false, ErrBirthdayAlreadySet.WithContext(
+			"member_id", m.memberID.String(),
+			"birthday", current.String(),
+		)
+	}
+	return m.UpdateProfile(m.profile.WithBirthday(birthday), at), nil
+}
+
+// VerifyAge 記錄店員查驗證件後的成年驗證
+//
+// 參數：
+// - verification: 驗證紀錄（已由 NewAgeVerification 驗證）
+//
+// 業務規則：
+// 1. 已被合併的會員返回 ErrMemberMerged
+// 2. 已填寫生日且驗證時未滿 18 歲 → ErrMemberUnderage
+// 3. 已驗證時不變更（保留最初的驗證紀錄，不遞增版本號）
+//
+// 返回：
+// - bool: 狀態是否變更
+func (m *Member) VerifyAge(verification AgeVerification) (bool, error) {
+	if m.IsMerged() {
+		return false, m.errMerged()
+	}
+	if verification.IsZero() {
+		return false, ErrInvalidAgeVerification.WithContext("reason", "verification is required")
+	}
+
+	birthday := m.profile.Birthday()
+	if !birthday.IsZero() && birthday.AgeOn(verification.verifiedAt) < LegalDrinkingAge {
+		return false, ErrMemberUnderage.WithContext(
+			"member_id", m.memberID.String(),
+			"birthday", birthday.String(),
+		)
+	}
+	if m.IsVerifiedAdult() {
+		return false, nil
+	}
+
+	m.ageVerification = verification
+	m.updatedAt = verification.verifiedAt
+	m.version++
+	return true, nil
+}
+
+// EnsureVerifiedAdult 檢查會員是否已驗證成年（酒類促銷、獎勵兌換等資格檢查）
+//
+// 錯誤：尚未驗證 → ErrAgeVerificationRequired
+func (m *Member) EnsureVerifiedAdult() error {
+	if !m.IsVerifiedAdult() {
+		return ErrAgeVerificationRequired.WithContext("member_id", m.memberID.String())
+	}
+	return nil
+}
+
+// RebindPhoneNumber 管理員更換會員手機號碼
+//
+// 參數：
+// - phoneNumber: 新手機號碼
+// - reason: 更換原因（必填，例如「會員換號」）
+// - operatorID: 操作的管理員
+// - at: 操作時間
+//
+// 業務規則：
+// 1. 原因與操作者必填；新號碼不可與目前號碼相同
+// 2. 尚未綁定時視為由管理員代為綁定（已被合併的會員返回 ErrMemberMerged）
+// 3. 新號碼的唯一性由 Application Layer 檢查（並由資料庫約束保證）
+// 4. 版本號遞增，發布 MemberPhoneNumberChangedEvent
+//
+// 返回：
+// - *PhoneNumberChange: 異動紀錄（由 Application Layer 保存為稽核軌跡）
+// - error: 參數無效時返回 ErrInvalidPhoneNumberChange
+func (m *Member) RebindPhoneNumber(phoneNumber PhoneNumber, reason, operatorID string, at time.Time) (*PhoneNumberChange, error) {
+	if m.IsMerged() {
+		return nil, m.errMerged()
+	}
+	if phoneNumber.IsZero() {
+		return nil, ErrInvalidPhoneNumberChange.WithContext("reason", "new phone number is required")
+	}
+	if m.phoneNumber.Equals(phoneNumber) {
+		return nil, ErrInvalidPhoneNumberChange.WithContext(
+			"reason", "new phone number is the same as the current one",
+			"phone_number", phoneNumber.String(),
+		)
+	}
+	return m.changePhoneNumber(PhoneNumberRebound, phoneNumber, reason, operatorID, at)
+}
+
+// UnbindPhoneNumber 管理員解除會員手機號碼綁定
+//
+// 參數：
+// - reason: 解除原因（必填）
+// - operatorID: 操作的管理員
+// - at: 操作時間
+//
+// 業務規則：
+// 1. 原因與操作者必填
+// 2. 尚未綁定時返回 ErrPhoneNotBound
+// 3. 解除後會員可重新綁定（號碼也可由其他會員綁定）
+// 4. 版本號遞增，發布 MemberPhoneNumberChangedEvent
+func (m *Member) UnbindPhoneNumber(reason, operatorID string, at time.Time) (*PhoneNumberChange, error) {
+	if m.phoneNumber.IsZero() {
+		return nil, ErrPhoneNotBound.WithContext("member_id", m.memberID.String())
+	}
+	return m.changePhoneNumber(PhoneNumberUnbound, PhoneNumber{}, reason, operatorID, at)
+}
+
+// changePhoneNumber 變更手機號碼並記錄異動（私有方法）
+func (m *Member) changePhoneNumber(
+	action PhoneNumberChangeAction,
+	phoneNumber PhoneNumber,
+	reason, operatorID string,
+	at time.Time,
+) (*PhoneNumberChange, error) {
+	change, err := NewPhoneNumberChange(m.memberID, action, m.phoneNumber, phoneNumber, reason, operatorID, at)
+	if err != nil {
+		return nil, err
+	}
+
+	m.phoneNumber = phoneNumber
+	m.updatedAt = at
+	m.version++
+	m.addEvent(NewMemberPhoneNumberChangedEvent(change))
+	return change, nil
+}
+
+// MergeInto 將此會員（重複註冊的次要會員）合併到保留的會員
+//
+// 參數：
+// - survivor: 保留的會員
+// - reason: 合併原因（必填）
+// - operatorID: 操作的管理員
+// - at: 合併時間
+//
+// 業務規則：
+// 1. 不可與自身合併；任一方已被合併時返回 ErrMemberMerged
+// 2. 此會員停用並記錄保留的會員（墓碑紀錄），LINE UserID 保留以便追溯
+// 3. 釋出手機號碼（之後可由管理員更換到保留的會員）
+// 4. 積分與歷史資料的移轉由 Application Layer 在同一事務內完成，並以 MemberMerge.RecordTransfer 記錄
+// 5. 雙方版本號皆遞增（保留的會員也需寫回，並行合併或編輯時產生版本衝突），發布 MemberMergedEvent
+//
+// 返回：
+// - *MemberMerge: 合併紀錄（由 Application Layer 保存為稽核軌跡）
+// - error: 參數無效時返回 ErrInvalidMemberMerge
+func (m *Member) MergeInto(survivor *Member, reason, operatorID string, at time.Time) (*MemberMerge, error) {
+	if survivor.memberID.Equals(m.memberID) {
+		return nil, ErrInvalidMemberMerge.WithContext(
+			"member_id", m.memberID.String(),
+			"reason", "cannot merge a member into itself",
+		)
+	}
+	if m.IsMerged() {
+		return nil, m.errMerged()
+	}
+	if survivor.IsMerged() {
+		return nil, survivor.errMerged()
+	}
+
+	merge, err := newMemberMerge(survivor.memberID, m.memberID, m.phoneNumber, reason, operatorID, at)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mergedInto = survivor.memberID
+	m.mergedAt = &at
+	m.phoneNumber = PhoneNumber{}
+	m.updatedAt = at
+	m.version++
+	m.addEvent(NewMemberMergedEvent(merge))
+
+	survivor.updatedAt = at
+	survivor.version++
+	return merge, nil
+}
+
+// errMerged 返回已被合併的錯誤（附帶保留的會員 ID）
+func (m *Member) errMerged() error {
+	return ErrMemberMerged.WithContext(
+		"member_id", m.memberID.String(),
+		"merged_into", m.mergedInto.String(),
+	)
+}
+
+// MarkUnfollowed 標記會員封鎖官方帳號（LINE unfollow 事件）
+//
+// 參數：
+// - at: 封鎖時間（LINE 事件時間）
+//
+// 業務規則：
+// 1. 已封鎖時忽略（Webhook 可能重送）
+// 2. 記錄封鎖時間，之後不再推播與群發
+// 3. 發布 MemberUnfollowedEvent
+//
+// 返回：
+// - bool: 狀態是否變更
+func (m *Member) MarkUnfollowed(at time.Time) bool {
+	if !m.IsReachable() {
+		return false
+	}
+
+	m.unfollowedAt = &at
+	m.updatedAt = at
+	m.version++
+	m.addEvent(NewMemberUnfollowedEvent(m.memberID, m.lineUserID, at))
+	return true
+}
+
+// MarkRefollowed 標記會員解除封鎖（封鎖後再次 follow）
+//
+// 參數：
+// - at: 解除封鎖時間（LINE 事件時間）
+//
+// 業務規則：
+// 1. 未封鎖時忽略
+// 2. 事件時間早於封鎖時間時忽略（Webhook 重送的舊事件）
+// 3. 恢復可觸及，保留會員資料與手機綁定（不需重新註冊）
+// 4. 發布 MemberRefollowedEvent
+//
+// 返回：
+// - bool: 狀態是否變更
+func (m *Member) MarkRefollowed(at time.Time) bool {
+	if m.IsReachable() || at.Before(*m.unfollowedAt) {
+		return false
+	}
+
+	unfollowedAt := *m.unfollowedAt
+	m.unfollowedAt = nil
+	m.updatedAt = at
+	m.version++
+	m.addEvent(NewMemberRefollowedEvent(m.memberID, m.lineUserID, unfollowedAt, at))
+	return true
+}
+
+// ===========================
+// Domain Events
+// ===========================
+
+// addEvent 添加領域事件到待發布列表（私有方法）
+func (m *Member) addEvent(event shared.DomainEvent) {
+	m.events = append(m.events, event)
+}
+
+// PullEvents 獲取所有待發布事件並清空列表
+//
+// 使用方式：Repository.Save() 成功後由 Application Layer 取得並發布
+func (m *Member) PullEvents() []shared.DomainEvent {
+	events := m.events
+	m.events = make([]shared.DomainEvent, 0)
+	return events
+}
+
 // ===========================
 // Member Aggregate Getters
 // ===========================
@@ -201,6 +616,46 @@ func (m *Member) HasPhoneNumber() bool {
 	return !m.phoneNumber.IsZero()
 }
 
+// Profile 返回補充資料
+func (m *Member) Profile() MemberProfile {
+	return m.profile
+}
+
+// IsVerifiedAdult 是否已驗證成年
+func (m *Member) IsVerifiedAdult() bool {
+	return !m.ageVerification.IsZero()
+}
+
+// AgeVerification 返回成年驗證紀錄（未驗證時為零值）
+func (m *Member) AgeVerification() AgeVerification {
+	return m.ageVerification
+}
+
+// IsReachable 檢查是否可推播（未封鎖官方帳號）
+func (m *Member) IsReachable() bool {
+	return m.unfollowedAt == nil
+}
+
+// UnfollowedAt 返回封鎖時間（可觸及時為 nil）
+func (m *Member) UnfollowedAt() *time.Time {
+	return m.unfollowedAt
+}
+
+// IsMerged 檢查是否已被合併到其他會員（停用）
+func (m *Member) IsMerged() bool {
+	return m.mergedAt != nil
+}
+
+// MergedInto 返回保留的會員 ID（未被合併時為零值）
+func (m *Member) MergedInto() MemberID {
+	return m.mergedInto
+}
+
+// MergedAt 返回合併時間（未被合併時為 nil）
+func (m *Member) MergedAt() *time.Time {
+	return m.mergedAt
+}
+
 // CreatedAt 返回創建時間
 func (m *Member) CreatedAt() time.Time {
 	return m.createdAt
@@ -215,3 +670,15 @@ func (m *Member) UpdatedAt() time.Time {
 func (m *Member) Version() int {
 	return m.version
 }
+
+// PersistedVersion 返回最近一次載入或寫入時的版本號（Repository 更新時比對，0 表示尚未保存）
+func (m *Member) PersistedVersion() int {
+	return m.persistedVersion
+}
+
+// MarkPersisted 標記目前版本已寫入（由 Repository 在 Save / Update 成功後呼叫）
+//
+// 使同一個聚合實例可再次 Update 而不產生誤判的版本衝突
+func (m *Member) MarkPersisted() {
+	m.persistedVersion = m.version
+}
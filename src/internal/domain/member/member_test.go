@@ -1,9 +1,12 @@
 package member
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -114,6 +117,9 @@ func TestReconstructMember_ValidData_Success(t *testing.T) {
 		lineUserID,
 		"John Doe",
 		phoneNumber,
+		nil, // unfollowedAt
+		MemberProfile{},
+		AgeVerification{}, // 未驗證
 		createdAt,
 		updatedAt,
 		1, // version
@@ -145,6 +151,9 @@ func TestReconstructMember_NoPhoneNumber_Success(t *testing.T) {
 		lineUserID,
 		"John Doe",
 		zeroPhoneNumber,
+		nil, // unfollowedAt
+		MemberProfile{},
+		AgeVerification{}, // 未驗證
 		createdAt,
 		updatedAt,
 		1, // version
@@ -171,6 +180,9 @@ func TestReconstructMember_EmptyDisplayName_ReturnsError(t *testing.T) {
 		lineUserID,
 		"", // 空的顯示名稱
 		zeroPhoneNumber,
+		nil, // unfollowedAt
+		MemberProfile{},
+		AgeVerification{}, // 未驗證
 		createdAt,
 		updatedAt,
 		1, // version
@@ -222,3 +234,330 @@ func TestMember_Getters_ReturnCorrectValues(t *testing.T) {
 	assert.False(t, member.CreatedAt().IsZero())
 	assert.False(t, member.UpdatedAt().IsZero())
 }
+
+// Test 11: Unfollow marks member unreachable and is idempotent
+func TestMember_MarkUnfollowed_RecordsTimeAndEvent(t *testing.T) {
+	// Arrange
+	lineUserID, _ := NewLineUserID("U1234567890abcdef1234567890abcdef")
+	member, _ := NewMember(lineUserID, "John Doe")
+	unfollowedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// Act
+	changed := member.MarkUnfollowed(unfollowedAt)
+	redelivered := member.MarkUnfollowed(unfollowedAt.Add(time.Minute))
+
+	// Assert
+	assert.True(t, changed)
+	assert.False(t, redelivered, "redelivered unfollow should be ignored")
+	assert.False(t, member.IsReachable())
+	require.NotNil(t, member.UnfollowedAt())
+	assert.Equal(t, unfollowedAt, *member.UnfollowedAt())
+	assert.Equal(t, unfollowedAt, member.UpdatedAt(), "updatedAt should use the event time")
+	assert.Equal(t, 2, member.Version())
+
+	events := member.PullEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "member.unfollowed", events[0].EventType())
+	assert.Equal(t, unfollowedAt, events[0].OccurredAt())
+	assert.Empty(t, member.PullEvents())
+}
+
+// Test 12: Re-follow restores reachability without losing registration; stale follow is ignored
+func TestMember_MarkRefollowed_RestoresReachability(t *testing.T) {
+	// Arrange
+	lineUserID, _ := NewLineUserID("U1234567890abcdef1234567890abcdef")
+	member, _ := NewMember(lineUserID, "John Doe")
+	phoneNumber, _ := NewPhoneNumber("0912345678")
+	require.NoError(t, member.BindPhoneNumber(phoneNumber))
+	unfollowedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	member.MarkUnfollowed(unfollowedAt)
+	member.PullEvents()
+
+	// Act
+	stale := member.MarkRefollowed(unfollowedAt.Add(-time.Hour))
+	changed := member.MarkRefollowed(unfollowedAt.Add(24 * time.Hour))
+
+	// Assert
+	assert.False(t, stale)
+	assert.True(t, changed)
+	assert.True(t, member.IsReachable())
+	assert.Nil(t, member.UnfollowedAt())
+	assert.True(t, member.HasPhoneNumber(), "re-follow should not require re-registration")
+	assert.Equal(t, unfollowedAt.Add(24*time.Hour), member.UpdatedAt(), "updatedAt should use the event time")
+
+	events := member.PullEvents()
+	require.Len(t, events, 1)
+	refollowed, ok := events[0].(*MemberRefollowedEvent)
+	require.True(t, ok)
+	assert.Equal(t, unfollowedAt, refollowed.UnfollowedAt())
+	assert.False(t, member.MarkRefollowed(unfollowedAt.Add(48*time.Hour)))
+}
+
+// Test 13: Admin rebind replaces the phone number, bumps version and emits an event
+func TestMember_RebindPhoneNumber_RecordsChange(t *testing.T) {
+	// Arrange
+	lineUserID, _ := NewLineUserID("U1234567890abcdef1234567890abcdef")
+	member, _ := NewMember(lineUserID, "John Doe")
+	oldPhone, _ := NewPhoneNumber("0912345678")
+	newPhone, _ := NewPhoneNumber("0987654321")
+	require.NoError(t, member.BindPhoneNumber(oldPhone))
+	at := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// Act
+	_, missingReason := member.RebindPhoneNumber(newPhone, "  ", "admin", at)
+	_, samePhone := member.RebindPhoneNumber(oldPhone, "會員換號", "admin", at)
+	change, err := member.RebindPhoneNumber(newPhone, "會員換號", "admin", at)
+
+	// Assert
+	assert.ErrorIs(t, missingReason, ErrInvalidPhoneNumberChange)
+	assert.ErrorIs(t, samePhone, ErrInvalidPhoneNumberChange)
+	require.NoError(t, err)
+	assert.True(t, member.PhoneNumber().Equals(newPhone))
+	assert.Equal(t, 3, member.Version())
+	assert.Equal(t, PhoneNumberRebound, change.Action())
+	assert.True(t, change.PreviousPhoneNumber().Equals(oldPhone))
+	assert.Equal(t, "admin", change.OperatorID())
+
+	events := member.PullEvents()
+	require.Len(t, events, 1)
+	changed, ok := events[0].(*MemberPhoneNumberChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "member.phone_number_changed", changed.EventType())
+	assert.Equal(t, change.ChangeID(), changed.EventID())
+	assert.Equal(t, "會員換號", changed.Reason())
+}
+
+// Test 14: Admin unbind clears the phone number so the member can bind again
+func TestMember_UnbindPhoneNumber_AllowsRebinding(t *testing.T) {
+	// Arrange
+	lineUserID, _ := NewLineUserID("U1234567890abcdef1234567890abcdef")
+	member, _ := NewMember(lineUserID, "John Doe")
+	phoneNumber, _ := NewPhoneNumber("0912345678")
+	at := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	_, notBound := member.UnbindPhoneNumber("門號停用", "admin", at)
+	require.NoError(t, member.BindPhoneNumber(phoneNumber))
+
+	// Act
+	change, err := member.UnbindPhoneNumber("門號停用", "admin", at)
+
+	// Assert
+	assert.ErrorIs(t, notBound, ErrPhoneNotBound)
+	require.NoError(t, err)
+	assert.False(t, member.HasPhoneNumber())
+	assert.Equal(t, PhoneNumberUnbound, change.Action())
+	assert.True(t, change.PhoneNumber().IsZero())
+	assert.Len(t, member.PullEvents(), 1)
+	assert.NoError(t, member.BindPhoneNumber(phoneNumber), "member should be able to bind again after unbind")
+}
+
+// Test 15: Display name and profile changes bump version only when content changes
+func TestMember_ChangeDisplayNameAndProfile(t *testing.T) {
+	// Arrange
+	lineUserID, _ := NewLineUserID("U1234567890abcdef1234567890abcdef")
+	member, _ := NewMember(lineUserID, "John Doe")
+	at := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	profile, err := NewMemberProfile("John@Example.com", "喜歡 IPA")
+	require.NoError(t, err)
+
+	// Act
+	unchanged, unchangedErr := member.ChangeDisplayName("  John Doe ", at)
+	_, emptyErr := member.ChangeDisplayName("   ", at)
+	renamed, renameErr := member.ChangeDisplayName("小明", at)
+	updated := member.UpdateProfile(profile, at)
+	updatedAgain := member.UpdateProfile(profile, at)
+
+	// Assert
+	require.NoError(t, unchangedErr)
+	assert.False(t, unchanged)
+	assert.ErrorIs(t, emptyErr, ErrInvalidDisplayName)
+	require.NoError(t, renameErr)
+	assert.True(t, renamed)
+	assert.Equal(t, "小明", member.DisplayName())
+	assert.True(t, updated)
+	assert.False(t, updatedAgain)
+	assert.Equal(t, "john@example.com", member.Profile().Email())
+	assert.Equal(t, "喜歡 IPA", member.Profile().Note())
+	assert.Equal(t, 3, member.Version())
+	assert.Equal(t, at, member.UpdatedAt())
+}
+
+// Test 16: Profile validation rejects malformed email and overlong note
+func TestNewMemberProfile_Validation(t *testing.T) {
+	// Act
+	empty, emptyErr := NewMemberProfile("", "")
+	_, badEmail := NewMemberProfile("not-an-email", "")
+	_, namedEmail := NewMemberProfile("John <john@example.com>", "")
+	_, longNote := NewMemberProfile("", strings.Repeat("酒", 501))
+
+	// Assert
+	require.NoError(t, emptyErr)
+	assert.Empty(t, empty.Email())
+	assert.ErrorIs(t, badEmail, ErrInvalidMemberProfile)
+	assert.ErrorIs(t, namedEmail, ErrInvalidMemberProfile)
+	assert.ErrorIs(t, longNote, ErrInvalidMemberProfile)
+}
+
+// Test 17: MergeInto retires the secondary member, releases its phone and records the survivor
+func TestMember_MergeInto(t *testing.T) {
+	// Arrange
+	primaryLine, _ := NewLineUserID("U1234567890abcdef1234567890abcdef")
+	secondaryLine, _ := NewLineUserID("Uabcdef1234567890abcdef1234567890")
+	primary, _ := NewMember(primaryLine, "John Doe")
+	secondary, _ := NewMember(secondaryLine, "John (new phone)")
+	phone, _ := NewPhoneNumber("0987654321")
+	require.NoError(t, secondary.BindPhoneNumber(phone))
+	at := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	primaryVersion := primary.Version()
+
+	// Act
+	_, selfErr := secondary.MergeInto(secondary, "重複註冊", "admin", at)
+	_, missingReason := secondary.MergeInto(primary, " ", "admin", at)
+	merge, err := secondary.MergeInto(primary, "重複註冊", "admin", at)
+	_, againErr := secondary.MergeInto(primary, "重複註冊", "admin", at)
+	_, intoMergedErr := primary.MergeInto(secondary, "重複註冊", "admin", at)
+
+	// Assert
+	assert.ErrorIs(t, selfErr, ErrInvalidMemberMerge)
+	assert.ErrorIs(t, missingReason, ErrInvalidMemberMerge)
+	require.NoError(t, err)
+	assert.True(t, secondary.IsMerged())
+	assert.Equal(t, primary.MemberID(), secondary.MergedInto())
+	assert.False(t, secondary.HasPhoneNumber())
+	assert.Equal(t, "0987654321", merge.ReleasedPhoneNumber().String())
+	assert.Equal(t, primary.MemberID(), merge.PrimaryMemberID())
+	assert.Equal(t, primaryVersion+1, primary.Version(), "survivor must be written back under the optimistic lock")
+	assert.Equal(t, at, primary.UpdatedAt())
+	assert.ErrorIs(t, againErr, ErrMemberMerged)
+	assert.ErrorIs(t, intoMergedErr, ErrMemberMerged)
+	assert.ErrorIs(t, secondary.BindPhoneNumber(phone), ErrMemberMerged)
+
+	events := secondary.PullEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "member.merged", events[0].EventType())
+	assert.Equal(t, merge.MergeID(), events[0].EventID())
+}
+
+// Test 18: Birthday parsing, LINE self-service recording and Feb 29 celebration
+func TestMember_RecordBirthday(t *testing.T) {
+	// Arrange
+	lineUserID, _ := NewLineUserID("U1234567890abcdef1234567890abcdef")
+	member, _ := NewMember(lineUserID, "John Doe")
+	now := time.Date(2025, 2, 27, 17, 0, 0, 0, time.UTC) // 台北 2025-02-28 01:00
+	birthday, err := ParseBirthday(" 1992-02-29 ", now)
+	require.NoError(t, err)
+	other, err := ParseBirthday("1990-05-20", now)
+	require.NoError(t, err)
+
+	// Act
+	_, badFormat := ParseBirthday("1992/02/29", now)
+	_, notADate := ParseBirthday("1993-02-29", now)
+	_, future := ParseBirthday("2025-03-01", now)
+	recorded, recordErr := member.RecordBirthday(birthday, now)
+	again, againErr := member.RecordBirthday(birthday, now)
+	_, changeErr := member.RecordBirthday(other, now)
+
+	// Assert
+	assert.ErrorIs(t, badFormat, ErrInvalidBirthday)
+	assert.ErrorIs(t, notADate, ErrInvalidBirthday)
+	assert.ErrorIs(t, future, ErrInvalidBirthday)
+	require.NoError(t, recordErr)
+	assert.True(t, recorded)
+	require.NoError(t, againErr)
+	assert.False(t, again)
+	assert.ErrorIs(t, changeErr, ErrBirthdayAlreadySet)
+	assert.Equal(t, "1992-02-29", member.Profile().Birthday().String())
+	assert.Equal(t, 2, member.Version())
+
+	assert.True(t, birthday.IsCelebratedOn(now), "Feb 29 birthdays are celebrated on Feb 28 in non-leap years")
+	assert.False(t, birthday.IsCelebratedOn(time.Date(2024, 2, 28, 12, 0, 0, 0, shared.BusinessLocation)))
+	assert.True(t, birthday.IsCelebratedOn(time.Date(2024, 2, 29, 12, 0, 0, 0, shared.BusinessLocation)))
+	assert.False(t, other.IsCelebratedOn(time.Date(2025, 5, 20, 16, 0, 0, 0, time.UTC)), "May 21 in Taipei")
+	assert.Equal(t, []string{"02-28", "02-29"}, CelebratedMonthDays(now))
+}
+
+// Test 19: Staff ID check verifies adults once; members whose birthday shows they are under 18 are refused
+func TestMember_VerifyAge(t *testing.T) {
+	// Arrange
+	lineUserID, _ := NewLineUserID("U1234567890abcdef1234567890abcdef")
+	adult, _ := NewMember(lineUserID, "John Doe")
+	minor, _ := NewMember(lineUserID, "Jane Doe")
+	now := time.Date(2025, 5, 19, 16, 30, 0, 0, time.UTC) // 台北 2025-05-20 00:30
+	eighteenToday, err := ParseBirthday("2007-05-20", now)
+	require.NoError(t, err)
+	eighteenTomorrow, err := ParseBirthday("2007-05-21", now)
+	require.NoError(t, err)
+	adult.UpdateProfile(adult.Profile().WithBirthday(eighteenToday), now)
+	minor.UpdateProfile(minor.Profile().WithBirthday(eighteenTomorrow), now)
+	verification, err := NewAgeVerification(" staff01 ", AgeVerifiedByStaffIDCheck, now)
+	require.NoError(t, err)
+	later, err := NewAgeVerification("manager01", AgeVerifiedByStaffIDCheck, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	// Act
+	_, noVerifier := NewAgeVerification(" ", AgeVerifiedByStaffIDCheck, now)
+	_, badMethod := NewAgeVerification("staff01", AgeVerificationMethod("selfie"), now)
+	requiredErr := adult.EnsureVerifiedAdult()
+	verified, verifyErr := adult.VerifyAge(verification)
+	again, againErr := adult.VerifyAge(later)
+	_, underageErr := minor.VerifyAge(verification)
+
+	// Assert
+	assert.ErrorIs(t, noVerifier, ErrInvalidAgeVerification)
+	assert.ErrorIs(t, badMethod, ErrInvalidAgeVerification)
+	assert.ErrorIs(t, requiredErr, ErrAgeVerificationRequired)
+	require.NoError(t, verifyErr)
+	assert.True(t, verified)
+	require.NoError(t, againErr)
+	assert.False(t, again, "the original verification record is kept")
+	assert.NoError(t, adult.EnsureVerifiedAdult())
+	assert.Equal(t, "staff01", adult.AgeVerification().VerifiedBy())
+	assert.Equal(t, AgeVerifiedByStaffIDCheck, adult.AgeVerification().Method())
+	assert.Equal(t, now, *adult.AgeVerification().VerifiedAt())
+	assert.Equal(t, 3, adult.Version())
+
+	assert.ErrorIs(t, underageErr, ErrMemberUnderage)
+	assert.False(t, minor.IsVerifiedAdult())
+	assert.ErrorIs(t, minor.EnsureVerifiedAdult(), ErrAgeVerificationRequired)
+}
+
+// Test 20: Changing the birthday of a verified member rechecks the age; an under-18 birthday revokes the verification
+func TestMember_BirthdayChange_RechecksAgeVerification(t *testing.T) {
+	// Arrange
+	lineUserID, _ := NewLineUserID("U1234567890abcdef1234567890abcdef")
+	selfService, _ := NewMember(lineUserID, "John Doe")
+	corrected, _ := NewMember(lineUserID, "Jane Doe")
+	now := time.Date(2025, 5, 19, 16, 30, 0, 0, time.UTC) // 台北 2025-05-20 00:30
+	adultBirthday, err := ParseBirthday("1990-05-20", now)
+	require.NoError(t, err)
+	otherAdultBirthday, err := ParseBirthday("1991-01-01", now)
+	require.NoError(t, err)
+	minorBirthday, err := ParseBirthday("2007-05-21", now)
+	require.NoError(t, err)
+	verification, err := NewAgeVerification("staff01", AgeVerifiedByStaffIDCheck, now)
+	require.NoError(t, err)
+	_, err = selfService.VerifyAge(verification)
+	require.NoError(t, err)
+	corrected.UpdateProfile(corrected.Profile().WithBirthday(adultBirthday), now)
+	_, err = corrected.VerifyAge(verification)
+	require.NoError(t, err)
+
+	// Act
+	recorded, recordErr := selfService.RecordBirthday(minorBirthday, now.Add(time.Hour))
+	adultChanged := corrected.UpdateProfile(corrected.Profile().WithBirthday(otherAdultBirthday), now.Add(time.Hour))
+	keptAfterAdultChange := corrected.IsVerifiedAdult()
+	minorChanged := corrected.UpdateProfile(corrected.Profile().WithBirthday(minorBirthday), now.Add(2*time.Hour))
+
+	// Assert
+	require.NoError(t, recordErr)
+	assert.True(t, recorded)
+	assert.False(t, selfService.IsVerifiedAdult(), "an under-18 birthday revokes the ID check")
+	assert.ErrorIs(t, selfService.EnsureVerifiedAdult(), ErrAgeVerificationRequired)
+
+	assert.True(t, adultChanged)
+	assert.True(t, keptAfterAdultChange, "an adult birthday keeps the verification")
+	assert.True(t, minorChanged)
+	assert.False(t, corrected.IsVerifiedAdult())
+	assert.True(t, corrected.AgeVerification().IsZero())
+	_, reverifyErr := corrected.VerifyAge(verification)
+	assert.ErrorIs(t, reverifyErr, ErrMemberUnderage)
+}
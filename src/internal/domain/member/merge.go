@@ -0,0 +1,176 @@
+package member
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// 會員合併紀錄（重複會員合併）
+// ===========================
+
+// maxMemberMergeReasonLength 合併原因長度上限（字元數）
+const maxMemberMergeReasonLength = 200
+
+// MergeTransfer 合併時從次要會員移轉到保留會員的資料（稽核用途）
+//
+// 欄位：
+// - EarnedPoints / UsedPoints: 移轉的累積獲得 / 使用積分
+// - Transactions / SurveyResponses / Adjustments: 改歸屬的發票交易、問卷回覆、積分調整筆數
+type MergeTransfer struct {
+	EarnedPoints    int
+	UsedPoints      int
+	Transactions    int
+	SurveyResponses int
+	Adjustments     int
+}
+
+// MemberMerge 會員合併紀錄（稽核軌跡，合併完成後不可變更）
+//
+// 欄位：
+// - primaryMemberID: 保留的會員
+// - secondaryMemberID: 被合併（停用）的會員
+// - releasedPhone: 次要會員釋出的手機號碼（未綁定時為零值）
+// - transfer: 移轉的積分與歷史筆數
+type MemberMerge struct {
+	mergeID           string
+	primaryMemberID   MemberID
+	secondaryMemberID MemberID
+	reason            string
+	operatorID        string
+	releasedPhone     PhoneNumber
+	transfer          MergeTransfer
+	mergedAt          time.Time
+}
+
+// newMemberMerge 建立會員合併紀錄（由 Member.MergeInto 呼叫）
+//
+// 錯誤：原因為空或過長、操作者為空 → ErrInvalidMemberMerge
+func newMemberMerge(
+	primaryMemberID MemberID,
+	secondaryMemberID MemberID,
+	releasedPhone PhoneNumber,
+	reason string,
+	operatorID string,
+	mergedAt time.Time,
+) (*MemberMerge, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrInvalidMemberMerge.WithContext("reason", "reason is required")
+	}
+	if utf8.RuneCountInString(reason) > maxMemberMergeReasonLength {
+		return nil, ErrInvalidMemberMerge.WithContext("reason", "reason must not exceed 200 characters")
+	}
+	if strings.TrimSpace(operatorID) == "" {
+		return nil, ErrInvalidMemberMerge.WithContext("reason", "operator is required")
+	}
+
+	return &MemberMerge{
+		mergeID:           uuid.New().String(),
+		primaryMemberID:   primaryMemberID,
+		secondaryMemberID: secondaryMemberID,
+		reason:            reason,
+		operatorID:        operatorID,
+		releasedPhone:     releasedPhone,
+		mergedAt:          mergedAt,
+	}, nil
+}
+
+// ReconstructMemberMerge 重建會員合併紀錄（用於從資料庫載入）
+func ReconstructMemberMerge(
+	mergeID string,
+	primaryMemberID MemberID,
+	secondaryMemberID MemberID,
+	reason string,
+	operatorID string,
+	releasedPhone PhoneNumber,
+	transfer MergeTransfer,
+	mergedAt time.Time,
+) *MemberMerge {
+	return &MemberMerge{
+		mergeID:           mergeID,
+		primaryMemberID:   primaryMemberID,
+		secondaryMemberID: secondaryMemberID,
+		reason:            reason,
+		operatorID:        operatorID,
+		releasedPhone:     releasedPhone,
+		transfer:          transfer,
+		mergedAt:          mergedAt,
+	}
+}
+
+// RecordTransfer 記錄移轉的積分與歷史筆數
+//
+// 使用場景：Application Layer 在同一事務內完成積分合併與歷史改歸屬後、保存紀錄前填入
+func (m *MemberMerge) RecordTransfer(transfer MergeTransfer) {
+	m.transfer = transfer
+}
+
+// MergeID 返回紀錄 ID
+func (m *MemberMerge) MergeID() string {
+	return m.mergeID
+}
+
+// PrimaryMemberID 返回保留的會員 ID
+func (m *MemberMerge) PrimaryMemberID() MemberID {
+	return m.primaryMemberID
+}
+
+// SecondaryMemberID 返回被合併的會員 ID
+func (m *MemberMerge) SecondaryMemberID() MemberID {
+	return m.secondaryMemberID
+}
+
+// Reason 返回合併原因
+func (m *MemberMerge) Reason() string {
+	return m.reason
+}
+
+// OperatorID 返回操作的管理員
+func (m *MemberMerge) OperatorID() string {
+	return m.operatorID
+}
+
+// ReleasedPhoneNumber 返回次要會員釋出的手機號碼（可能為零值）
+func (m *MemberMerge) ReleasedPhoneNumber() PhoneNumber {
+	return m.releasedPhone
+}
+
+// Transfer 返回移轉的積分與歷史筆數
+func (m *MemberMerge) Transfer() MergeTransfer {
+	return m.transfer
+}
+
+// MergedAt 返回合併時間
+func (m *MemberMerge) MergedAt() time.Time {
+	return m.mergedAt
+}
+
+// ===========================
+// Repository Interfaces
+// ===========================
+
+// MemberMergeRepository 會員合併紀錄倉儲（僅新增，不可修改或刪除）
+type MemberMergeRepository interface {
+	// Save 新增合併紀錄（ctx 必須 non-nil，與會員合併在同一事務）
+	//
+	// 錯誤：次要會員已有合併紀錄 → ErrMemberMerged
+	Save(ctx shared.TransactionContext, merge *MemberMerge) error
+
+	// FindByMemberID 查詢會員（作為保留或被合併的一方）的合併紀錄（新到舊）
+	FindByMemberID(ctx shared.TransactionContext, memberID MemberID) ([]*MemberMerge, error)
+}
+
+// MemberHistoryReassigner 會員歷史資料改歸屬（會員合併時使用）
+//
+// 由各 Bounded Context 的 Infrastructure Layer 實作（發票交易、問卷回覆、積分調整）
+type MemberHistoryReassigner interface {
+	// ReassignMember 將 from 會員的所有紀錄改歸屬 to 會員（ctx 必須 non-nil）
+	//
+	// 返回：改歸屬的筆數
+	ReassignMember(ctx shared.TransactionContext, from, to MemberID) (int, error)
+}
@@ -0,0 +1,167 @@
+package member
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// 手機號碼異動紀錄（管理員更換 / 解除綁定）
+// ===========================
+
+// maxPhoneNumberChangeReasonLength 異動原因長度上限（字元數）
+const maxPhoneNumberChangeReasonLength = 200
+
+// PhoneNumberChangeAction 手機號碼異動類型
+type PhoneNumberChangeAction string
+
+// 異動類型
+const (
+	PhoneNumberRebound PhoneNumberChangeAction = "rebind" // 更換號碼（或由管理員代為綁定）
+	PhoneNumberUnbound PhoneNumberChangeAction = "unbind" // 解除綁定
+)
+
+// String 返回異動類型
+func (a PhoneNumberChangeAction) String() string {
+	return string(a)
+}
+
+// IsValid 檢查異動類型是否有效
+func (a PhoneNumberChangeAction) IsValid() bool {
+	return a == PhoneNumberRebound || a == PhoneNumberUnbound
+}
+
+// PhoneNumberChange 手機號碼異動紀錄（稽核軌跡，建立後不可變更）
+//
+// 欄位：
+// - previousPhone: 異動前號碼（管理員代為綁定時為零值）
+// - newPhone: 異動後號碼（解除綁定時為零值）
+// - reason / operatorID: 異動原因與操作的管理員
+type PhoneNumberChange struct {
+	changeID      string
+	memberID      MemberID
+	action        PhoneNumberChangeAction
+	previousPhone PhoneNumber
+	newPhone      PhoneNumber
+	reason        string
+	operatorID    string
+	changedAt     time.Time
+}
+
+// NewPhoneNumberChange 建立手機號碼異動紀錄（由 Member.RebindPhoneNumber / UnbindPhoneNumber 呼叫）
+//
+// 錯誤：原因為空或過長、操作者為空、異動類型無效 → ErrInvalidPhoneNumberChange
+func NewPhoneNumberChange(
+	memberID MemberID,
+	action PhoneNumberChangeAction,
+	previousPhone PhoneNumber,
+	newPhone PhoneNumber,
+	reason string,
+	operatorID string,
+	changedAt time.Time,
+) (*PhoneNumberChange, error) {
+	reason = strings.TrimSpace(reason)
+	if !action.IsValid() {
+		return nil, ErrInvalidPhoneNumberChange.WithContext("action", action.String())
+	}
+	if reason == "" {
+		return nil, ErrInvalidPhoneNumberChange.WithContext("reason", "reason is required")
+	}
+	if utf8.RuneCountInString(reason) > maxPhoneNumberChangeReasonLength {
+		return nil, ErrInvalidPhoneNumberChange.WithContext("reason", "reason must not exceed 200 characters")
+	}
+	if strings.TrimSpace(operatorID) == "" {
+		return nil, ErrInvalidPhoneNumberChange.WithContext("reason", "operator is required")
+	}
+
+	return &PhoneNumberChange{
+		changeID:      uuid.New().String(),
+		memberID:      memberID,
+		action:        action,
+		previousPhone: previousPhone,
+		newPhone:      newPhone,
+		reason:        reason,
+		operatorID:    operatorID,
+		changedAt:     changedAt,
+	}, nil
+}
+
+// ReconstructPhoneNumberChange 重建異動紀錄（用於從資料庫載入）
+func ReconstructPhoneNumberChange(
+	changeID string,
+	memberID MemberID,
+	action PhoneNumberChangeAction,
+	previousPhone PhoneNumber,
+	newPhone PhoneNumber,
+	reason string,
+	operatorID string,
+	changedAt time.Time,
+) *PhoneNumberChange {
+	return &PhoneNumberChange{
+		changeID:      changeID,
+		memberID:      memberID,
+		action:        action,
+		previousPhone: previousPhone,
+		newPhone:      newPhone,
+		reason:        reason,
+		operatorID:    operatorID,
+		changedAt:     changedAt,
+	}
+}
+
+// ChangeID 返回紀錄 ID
+func (c *PhoneNumberChange) ChangeID() string {
+	return c.changeID
+}
+
+// MemberID 返回會員 ID
+func (c *PhoneNumberChange) MemberID() MemberID {
+	return c.memberID
+}
+
+// Action 返回異動類型
+func (c *PhoneNumberChange) Action() PhoneNumberChangeAction {
+	return c.action
+}
+
+// PreviousPhoneNumber 返回異動前號碼（可能為零值）
+func (c *PhoneNumberChange) PreviousPhoneNumber() PhoneNumber {
+	return c.previousPhone
+}
+
+// PhoneNumber 返回異動後號碼（解除綁定時為零值）
+func (c *PhoneNumberChange) PhoneNumber() PhoneNumber {
+	return c.newPhone
+}
+
+// Reason 返回異動原因
+func (c *PhoneNumberChange) Reason() string {
+	return c.reason
+}
+
+// OperatorID 返回操作的管理員
+func (c *PhoneNumberChange) OperatorID() string {
+	return c.operatorID
+}
+
+// ChangedAt 返回異動時間
+func (c *PhoneNumberChange) ChangedAt() time.Time {
+	return c.changedAt
+}
+
+// ===========================
+// PhoneNumberChangeRepository Interface
+// ===========================
+
+// PhoneNumberChangeRepository 手機號碼異動紀錄倉儲（僅新增，不可修改或刪除）
+type PhoneNumberChangeRepository interface {
+	// Save 新增異動紀錄（ctx 必須 non-nil，與會員更新在同一事務）
+	Save(ctx shared.TransactionContext, change *PhoneNumberChange) error
+
+	// FindByMemberID 查詢會員的異動紀錄（新到舊）
+	FindByMemberID(ctx shared.TransactionContext, memberID MemberID) ([]*PhoneNumberChange, error)
+}
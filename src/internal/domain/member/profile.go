@@ -0,0 +1,98 @@
+package member
+
+import (
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+)
+
+// ===========================
+// MemberProfile Value Object
+// ===========================
+
+// 會員資料欄位長度上限（字元數）
+const (
+	maxDisplayNameLength = 100
+	maxEmailLength       = 254
+	maxNoteLength        = 500
+)
+
+// MemberProfile 會員補充資料（值對象，由管理後台維護）
+//
+// 欄位（皆為選填）：
+// - Email: 電子郵件（小寫儲存）
+// - Note: 店家備註（僅管理後台可見）
+// - Birthday: 生日（會員於 LINE 填寫或由管理後台維護，用於生日禮）
+//
+// 設計原則：
+// - 以 NewMemberProfile 建立（驗證並正規化），生日以 WithBirthday 設定
+// - 不可變：更新時以新的值對象整體替換
+type MemberProfile struct {
+	email    string
+	note     string
+	birthday Birthday
+}
+
+// NewMemberProfile 建立會員補充資料（Checked Constructor）
+//
+// 錯誤：Email 格式無效或過長、備註超過 500 字 → ErrInvalidMemberProfile
+func NewMemberProfile(email, note string) (MemberProfile, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	note = strings.TrimSpace(note)
+
+	if email != "" {
+		addr, err := mail.ParseAddress(email)
+		if err != nil || addr.Address != email || len(email) > maxEmailLength {
+			return MemberProfile{}, ErrInvalidMemberProfile.WithContext("email", email)
+		}
+	}
+	if utf8.RuneCountInString(note) > maxNoteLength {
+		return MemberProfile{}, ErrInvalidMemberProfile.WithContext("note", "note must not exceed 500 characters")
+	}
+	return MemberProfile{email: email, note: note}, nil
+}
+
+// ReconstructMemberProfile 重建會員補充資料（用於從資料庫載入，不驗證）
+func ReconstructMemberProfile(email, note string) MemberProfile {
+	return MemberProfile{email: email, note: note}
+}
+
+// WithBirthday 返回設定生日後的補充資料（零值表示清除）
+func (p MemberProfile) WithBirthday(birthday Birthday) MemberProfile {
+	p.birthday = birthday
+	return p
+}
+
+// Email 返回電子郵件（未填寫時為空字串）
+func (p MemberProfile) Email() string {
+	return p.email
+}
+
+// Note 返回店家備註
+func (p MemberProfile) Note() string {
+	return p.note
+}
+
+// Birthday 返回生日（未填寫時為零值）
+func (p MemberProfile) Birthday() Birthday {
+	return p.birthday
+}
+
+// Equals 比較兩個會員補充資料是否相同
+func (p MemberProfile) Equals(other MemberProfile) bool {
+	return p == other
+}
+
+// normalizeDisplayName 驗證並正規化顯示名稱（去除前後空白）
+//
+// 錯誤：空字串或超過 100 字 → ErrInvalidDisplayName
+func normalizeDisplayName(displayName string) (string, error) {
+	displayName = strings.TrimSpace(displayName)
+	if displayName == "" {
+		return "", ErrInvalidDisplayName
+	}
+	if utf8.RuneCountInString(displayName) > maxDisplayNameLength {
+		return "", ErrInvalidDisplayName.WithContext("reason", "display name must not exceed 100 characters")
+	}
+	return displayName, nil
+}
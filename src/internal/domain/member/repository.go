@@ -1,6 +1,8 @@
 package member
 
 import (
+	"time"
+
 	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
 )
 
@@ -20,6 +22,7 @@ import (
 //
 // Write Operations (寫操作) - ctx 必須 non-nil (強制事務)：
 //   - Save(): 創建或更新會員（必須在事務中保證原子性）
+//   - Update(): 更新已存在的會員（樂觀鎖：比對載入時的版本號）
 //
 // Read Operations (讀操作) - ctx 可為 nil (可選事務參與)：
 //   - FindByMemberID(): 根據 ID 查詢
@@ -48,7 +51,8 @@ import (
 //    member, err := repo.FindByLineUserID(nil, lineUserID)
 //
 // 注意事項：
-// - Save() 用於新增和更新（Upsert 模式，基於 MemberID）
+// - Save() 用於新增會員（Upsert 模式，基於 MemberID，不檢查版本號）
+// - 已載入會員的變更一律使用 Update()（樂觀鎖，避免 LINE Bot 與管理後台互相覆蓋）
 // - FindByXXX() 找不到時返回 ErrMemberNotFound
 // - ExistsByXXX() 用於檢查重複性（效能優化，只執行 COUNT）
 //
@@ -70,6 +74,22 @@ type MemberRepository interface {
 	// - PhoneNumber 唯一性由資料庫約束保證
 	Save(ctx shared.TransactionContext, member *Member) error
 
+	// Update 更新已存在的會員（樂觀鎖）
+	//
+	// 參數：
+	// - ctx: 事務上下文（必須 non-nil，寫操作需要事務）
+	// - member: 由 FindByXXX 載入並修改後的會員聚合
+	//
+	// 返回：
+	// - error: 更新失敗時返回錯誤
+	//
+	// 業務規則：
+	// - 以 member_id 與 PersistedVersion() 為條件更新，寫入新的 Version()
+	// - 版本號不符（已被其他操作更新）→ ErrMemberVersionConflict
+	// - 會員不存在 → ErrMemberNotFound
+	// - PhoneNumber 唯一性由資料庫約束保證 → ErrPhoneNumberAlreadyBound
+	Update(ctx shared.TransactionContext, member *Member) error
+
 	// FindByMemberID 根據會員 ID 查找會員
 	//
 	// 參數：
@@ -126,3 +146,17 @@ type MemberRepository interface {
 	// - 效能優化：比 Find 更輕量
 	ExistsByLineUserID(ctx shared.TransactionContext, lineUserID LineUserID) (bool, error)
 }
+
+// ===========================
+// BirthdayMemberQuery Interface
+// ===========================
+
+// BirthdayMemberQuery 生日會員查詢（生日禮排程使用，唯讀）
+//
+// 設計原則：
+// - 以 CelebratedMonthDays 計算的月日查詢（2/29 出生的會員在非閏年 2/28 一併返回）
+// - 排除已被合併（停用）的會員
+type BirthdayMemberQuery interface {
+	// FindCelebratingOn 查詢指定時間（營業時區）生日的會員 ID（依會員 ID 排序）
+	FindCelebratingOn(ctx shared.TransactionContext, at time.Time) ([]MemberID, error)
+}
@@ -0,0 +1,254 @@
+package member
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// 會員搜尋（管理後台，唯讀）
+// ===========================
+
+// 搜尋筆數限制
+const (
+	DefaultMemberSearchLimit = 20
+	MaxMemberSearchLimit     = 100
+
+	maxNameKeywordLength = 50
+)
+
+// MemberSortField 搜尋結果排序欄位
+type MemberSortField string
+
+// 排序欄位
+const (
+	MemberSortByCreatedAt       MemberSortField = "created_at"
+	MemberSortByDisplayName     MemberSortField = "display_name"
+	MemberSortByAvailablePoints MemberSortField = "available_points"
+)
+
+// ParseMemberSortField 解析排序欄位（空字串視為 created_at）
+func ParseMemberSortField(s string) (MemberSortField, error) {
+	if s == "" {
+		return MemberSortByCreatedAt, nil
+	}
+	field := MemberSortField(s)
+	switch field {
+	case MemberSortByCreatedAt, MemberSortByDisplayName, MemberSortByAvailablePoints:
+		return field, nil
+	default:
+		return "", ErrInvalidMemberSearch.WithContext("sort", s)
+	}
+}
+
+// String 返回排序欄位
+func (f MemberSortField) String() string {
+	return string(f)
+}
+
+// MemberSearchCriteria 會員搜尋條件
+//
+// 欄位（皆為選填，多個條件以 AND 組合）：
+// - PhoneSuffix: 手機號碼末碼（1–10 位數字）
+// - NameKeyword: 顯示名稱部分比對（支援中文，不分大小寫僅限英文字母）
+// - RegisteredFrom / RegisteredTo: 註冊時間區間 [from, to)
+// - Reachable: 是否可觸及（nil 表示不限）
+// - MinAvailablePoints / MaxAvailablePoints: 可用積分區間（含端點；無積分帳戶視為 0）
+// - Sort / Descending: 排序（同值時依 member_id 排序，確保分頁穩定）
+// - Limit: 每頁筆數（0 表示預設值）
+// - Cursor: 上一頁返回的 NextCursor（keyset 分頁）
+type MemberSearchCriteria struct {
+	PhoneSuffix        string
+	NameKeyword        string
+	RegisteredFrom     *time.Time
+	RegisteredTo       *time.Time
+	Reachable          *bool
+	MinAvailablePoints *int
+	MaxAvailablePoints *int
+	Sort               MemberSortField
+	Descending         bool
+	Limit              int
+	Cursor             *MemberSearchCursor
+}
+
+// Normalize 驗證並正規化搜尋條件（去除空白、套用預設排序與筆數）
+//
+// 錯誤：
+// - 條件格式或範圍無效 → ErrInvalidMemberSearch
+// - 游標與排序方式不一致 → ErrInvalidSearchCursor
+func (c MemberSearchCriteria) Normalize() (MemberSearchCriteria, error) {
+	c.PhoneSuffix = strings.TrimSpace(c.PhoneSuffix)
+	c.NameKeyword = strings.TrimSpace(c.NameKeyword)
+
+	if c.PhoneSuffix != "" && (len(c.PhoneSuffix) > 10 || !isDigits(c.PhoneSuffix)) {
+		return c, ErrInvalidMemberSearch.WithContext("phone_suffix", c.PhoneSuffix)
+	}
+	if utf8.RuneCountInString(c.NameKeyword) > maxNameKeywordLength {
+		return c, ErrInvalidMemberSearch.WithContext("name", c.NameKeyword)
+	}
+	if c.RegisteredFrom != nil && c.RegisteredTo != nil && !c.RegisteredFrom.Before(*c.RegisteredTo) {
+		return c, ErrInvalidMemberSearch.WithContext("reason", "registered_from must be before registered_to")
+	}
+	if c.MinAvailablePoints != nil && c.MaxAvailablePoints != nil && *c.MinAvailablePoints > *c.MaxAvailablePoints {
+		return c, ErrInvalidMemberSearch.WithContext("reason", "min_points must not exceed max_points")
+	}
+
+	if c.Sort == "" {
+		c.Sort = MemberSortByCreatedAt
+	}
+	if _, err := ParseMemberSortField(c.Sort.String()); err != nil {
+		return c, err
+	}
+
+	switch {
+	case c.Limit == 0:
+		c.Limit = DefaultMemberSearchLimit
+	case c.Limit < 0 || c.Limit > MaxMemberSearchLimit:
+		return c, ErrInvalidMemberSearch.WithContext("limit", "must be between 1 and 100")
+	}
+
+	if c.Cursor != nil && (c.Cursor.Sort != c.Sort || c.Cursor.Descending != c.Descending) {
+		return c, ErrInvalidSearchCursor.WithContext("reason", "cursor was issued for a different sort order")
+	}
+	return c, nil
+}
+
+// isDigits 是否全為數字
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ===========================
+// MemberSearchCursor keyset 分頁游標
+// ===========================
+
+// MemberSearchCursor 分頁游標（上一頁最後一筆的排序值與會員 ID）
+//
+// 設計原則：
+// - 以 (排序值, member_id) 作為 keyset，資料異動時不會跳過或重複
+// - 對外以 base64url 編碼的不透明字串傳遞
+type MemberSearchCursor struct {
+	Sort       MemberSortField `json:"s"`
+	Descending bool            `json:"d,omitempty"`
+	SortValue  string          `json:"v"`
+	MemberID   string          `json:"m"`
+}
+
+// NewMemberSearchCursor 由搜尋結果建立下一頁游標
+func NewMemberSearchCursor(sort MemberSortField, descending bool, last MemberSummary) MemberSearchCursor {
+	var value string
+	switch sort {
+	case MemberSortByDisplayName:
+		value = last.DisplayName
+	case MemberSortByAvailablePoints:
+		value = strconv.Itoa(last.AvailablePoints)
+	default:
+		value = last.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return MemberSearchCursor{
+		Sort:       sort,
+		Descending: descending,
+		SortValue:  value,
+		MemberID:   last.MemberID.String(),
+	}
+}
+
+// ParseMemberSearchCursor 解析游標字串
+//
+// 錯誤：格式無效 → ErrInvalidSearchCursor
+func ParseMemberSearchCursor(s string) (*MemberSearchCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidSearchCursor.WithContext("cursor", s)
+	}
+	var cursor MemberSearchCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, ErrInvalidSearchCursor.WithContext("cursor", s)
+	}
+	if _, err := ParseMemberSortField(cursor.Sort.String()); err != nil || cursor.Sort == "" {
+		return nil, ErrInvalidSearchCursor.WithContext("cursor", s)
+	}
+	if _, err := MemberIDFromString(cursor.MemberID); err != nil {
+		return nil, ErrInvalidSearchCursor.WithContext("cursor", s)
+	}
+	if cursor.Sort == MemberSortByCreatedAt {
+		if _, err := time.Parse(time.RFC3339Nano, cursor.SortValue); err != nil {
+			return nil, ErrInvalidSearchCursor.WithContext("cursor", s)
+		}
+	}
+	if cursor.Sort == MemberSortByAvailablePoints {
+		if _, err := strconv.Atoi(cursor.SortValue); err != nil {
+			return nil, ErrInvalidSearchCursor.WithContext("cursor", s)
+		}
+	}
+	return &cursor, nil
+}
+
+// String 編碼為不透明字串
+func (c MemberSearchCursor) String() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// CreatedAtValue 排序值（created_at 排序時使用）
+func (c MemberSearchCursor) CreatedAtValue() time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, c.SortValue)
+	return t
+}
+
+// AvailablePointsValue 排序值（available_points 排序時使用）
+func (c MemberSearchCursor) AvailablePointsValue() int {
+	n, _ := strconv.Atoi(c.SortValue)
+	return n
+}
+
+// ===========================
+// 搜尋結果（讀取模型）
+// ===========================
+
+// MemberSummary 會員搜尋結果（會員資料 + 積分餘額）
+//
+// 欄位：
+// - HasPointsAccount: 是否已建立積分帳戶（未建立時積分皆為 0）
+type MemberSummary struct {
+	MemberID         MemberID
+	LineUserID       string
+	DisplayName      string
+	PhoneNumber      string
+	IsReachable      bool
+	CreatedAt        time.Time
+	HasPointsAccount bool
+	EarnedPoints     int
+	UsedPoints       int
+	AvailablePoints  int
+}
+
+// MemberSearchPage 一頁搜尋結果
+//
+// 欄位：
+// - NextCursor: 下一頁游標（nil 表示已無資料）
+type MemberSearchPage struct {
+	Items      []MemberSummary
+	NextCursor *MemberSearchCursor
+}
+
+// MemberSearchQuery 會員搜尋查詢（唯讀，跨會員 / 積分上下文）
+//
+// 設計原則：
+// - 查詢條件與排序由資料庫處理（不載入全部會員）
+// - 不含已刪除的會員
+type MemberSearchQuery interface {
+	// Search 依條件搜尋會員（criteria 需先經 Normalize）
+	Search(ctx shared.TransactionContext, criteria MemberSearchCriteria) (*MemberSearchPage, error)
+}
@@ -0,0 +1,66 @@
+package member
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// MemberSearchCriteria / Cursor Tests
+// ===========================
+
+// Test 1: Normalize applies defaults and rejects invalid criteria
+func TestMemberSearchCriteria_Normalize(t *testing.T) {
+	// Arrange
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+	minPoints, maxPoints := 100, 10
+
+	// Act
+	normalized, err := MemberSearchCriteria{PhoneSuffix: " 5678 ", NameKeyword: " 小明 "}.Normalize()
+	_, badSuffix := MemberSearchCriteria{PhoneSuffix: "56a8"}.Normalize()
+	_, badPeriod := MemberSearchCriteria{RegisteredFrom: &from, RegisteredTo: &to}.Normalize()
+	_, badPoints := MemberSearchCriteria{MinAvailablePoints: &minPoints, MaxAvailablePoints: &maxPoints}.Normalize()
+	_, badSort := MemberSearchCriteria{Sort: "phone_number"}.Normalize()
+	_, badLimit := MemberSearchCriteria{Limit: MaxMemberSearchLimit + 1}.Normalize()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "5678", normalized.PhoneSuffix)
+	assert.Equal(t, "小明", normalized.NameKeyword)
+	assert.Equal(t, MemberSortByCreatedAt, normalized.Sort)
+	assert.Equal(t, DefaultMemberSearchLimit, normalized.Limit)
+	assert.ErrorIs(t, badSuffix, ErrInvalidMemberSearch)
+	assert.ErrorIs(t, badPeriod, ErrInvalidMemberSearch)
+	assert.ErrorIs(t, badPoints, ErrInvalidMemberSearch)
+	assert.ErrorIs(t, badSort, ErrInvalidMemberSearch)
+	assert.ErrorIs(t, badLimit, ErrInvalidMemberSearch)
+}
+
+// Test 2: Cursor round trip and mismatch detection
+func TestMemberSearchCursor_RoundTrip(t *testing.T) {
+	// Arrange
+	createdAt := time.Date(2025, 3, 1, 12, 30, 0, 123, time.UTC)
+	summary := MemberSummary{MemberID: NewMemberID(), CreatedAt: createdAt, AvailablePoints: 42}
+	byDate := NewMemberSearchCursor(MemberSortByCreatedAt, false, summary)
+	byPoints := NewMemberSearchCursor(MemberSortByAvailablePoints, true, summary)
+
+	// Act
+	parsedDate, dateErr := ParseMemberSearchCursor(byDate.String())
+	parsedPoints, pointsErr := ParseMemberSearchCursor(byPoints.String())
+	_, garbage := ParseMemberSearchCursor("not-a-cursor")
+	_, mismatch := MemberSearchCriteria{Sort: MemberSortByCreatedAt, Cursor: parsedPoints}.Normalize()
+
+	// Assert
+	require.NoError(t, dateErr)
+	require.NoError(t, pointsErr)
+	assert.True(t, createdAt.Equal(parsedDate.CreatedAtValue()))
+	assert.Equal(t, summary.MemberID.String(), parsedDate.MemberID)
+	assert.Equal(t, 42, parsedPoints.AvailablePointsValue())
+	assert.True(t, parsedPoints.Descending)
+	assert.ErrorIs(t, garbage, ErrInvalidSearchCursor)
+	assert.ErrorIs(t, mismatch, ErrInvalidSearchCursor)
+}
@@ -0,0 +1,141 @@
+package notification
+
+import "fmt"
+
+// ===========================
+// 錯誤代碼定義
+// ===========================
+
+// ErrorCode 錯誤代碼類型
+type ErrorCode string
+
+// 錯誤代碼常量
+const (
+	// 識別符相關
+	ErrCodeInvalidNotificationID ErrorCode = "NOTIFICATION_ID_INVALID"
+	ErrCodeInvalidMemberID       ErrorCode = "NOTIFICATION_MEMBER_ID_INVALID"
+
+	// 通知相關
+	ErrCodeNotificationNotFound     ErrorCode = "NOTIFICATION_NOT_FOUND"
+	ErrCodeInvalidNotificationState ErrorCode = "NOTIFICATION_STATE_INVALID"
+	ErrCodeInvalidPointsChange      ErrorCode = "NOTIFICATION_POINTS_CHANGE_INVALID"
+
+	// 通知偏好相關
+	ErrCodePreferenceNotFound ErrorCode = "NOTIFICATION_PREFERENCE_NOT_FOUND"
+	ErrCodeInvalidQuietHours  ErrorCode = "NOTIFICATION_QUIET_HOURS_INVALID"
+	ErrCodeInvalidLocale      ErrorCode = "NOTIFICATION_LOCALE_INVALID"
+)
+
+// ===========================
+// DomainError 結構
+// ===========================
+
+// DomainError 通知領域錯誤
+// 設計原則：
+// 1. 包含結構化的錯誤代碼（用於 HTTP 狀態碼映射）
+// 2. 支持上下文信息（用於調試和日誌）
+// 3. 不可變性（創建後不可修改）
+type DomainError struct {
+	Code    ErrorCode
+	Message string
+	Context map[string]interface{}
+}
+
+// Error 實現 error 接口
+func (e *DomainError) Error() string {
+	if len(e.Context) == 0 {
+		return fmt.Sprintf("[%s] %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("[%s] %s (context: %+v)", e.Code, e.Message, e.Context)
+}
+
+// WithContext 添加上下文信息（返回新的錯誤實例，保持不可變性）
+func (e *DomainError) WithContext(keyValues ...interface{}) error {
+	if len(keyValues)%2 != 0 {
+		panic("WithContext requires even number of arguments (key-value pairs)")
+	}
+
+	ctx := make(map[string]interface{}, len(e.Context)+len(keyValues)/2)
+
+	// 複製現有上下文
+	for k, v := range e.Context {
+		ctx[k] = v
+	}
+
+	// 添加新上下文
+	for i := 0; i < len(keyValues); i += 2 {
+		key, ok := keyValues[i].(string)
+		if !ok {
+			panic(fmt.Sprintf("context key must be string, got %T", keyValues[i]))
+		}
+		ctx[key] = keyValues[i+1]
+	}
+
+	return &DomainError{
+		Code:    e.Code,
+		Message: e.Message,
+		Context: ctx,
+	}
+}
+
+// Is 實現 errors.Is 接口（用於錯誤類型判斷）
+func (e *DomainError) Is(target error) bool {
+	t, ok := target.(*DomainError)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+// ===========================
+// 預定義錯誤
+// ===========================
+
+// 識別符相關錯誤
+var (
+	ErrInvalidNotificationID = &DomainError{
+		Code:    ErrCodeInvalidNotificationID,
+		Message: "無效的通知 ID",
+	}
+
+	ErrInvalidMemberID = &DomainError{
+		Code:    ErrCodeInvalidMemberID,
+		Message: "無效的會員 ID",
+	}
+)
+
+// 通知相關錯誤
+var (
+	ErrNotificationNotFound = &DomainError{
+		Code:    ErrCodeNotificationNotFound,
+		Message: "通知不存在",
+	}
+
+	ErrInvalidNotificationState = &DomainError{
+		Code:    ErrCodeInvalidNotificationState,
+		Message: "通知狀態不允許此操作",
+	}
+
+	ErrInvalidPointsChange = &DomainError{
+		Code:    ErrCodeInvalidPointsChange,
+		Message: "無效的積分異動內容",
+	}
+)
+
+// 通知偏好相關錯誤
+var (
+	ErrPreferenceNotFound = &DomainError{
+		Code:    ErrCodePreferenceNotFound,
+		Message: "會員尚未設定通知偏好",
+	}
+
+	ErrInvalidQuietHours = &DomainError{
+		Code:    ErrCodeInvalidQuietHours,
+		Message: "無效的勿擾時段",
+	}
+
+	ErrInvalidLocale = &DomainError{
+		Code:    ErrCodeInvalidLocale,
+		Message: "不支援的語系",
+	}
+)
@@ -0,0 +1,53 @@
+package notification
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// 實體 ID 類型定義
+// ===========================
+
+// 設計原則：使用泛型 EntityID[T]（與其他上下文一致）
+//
+// 注意：notification.MemberID 與其他上下文的 ID 是不同類型
+// 跨上下文傳遞時使用 String() 轉換
+
+// ===========================
+// NotificationID - 通知 ID
+// ===========================
+
+// NotificationMarker 是 NotificationID 的標記類型
+type NotificationMarker struct{}
+
+// NotificationID 通知的唯一標識符
+type NotificationID = shared.EntityID[NotificationMarker]
+
+// NewNotificationID 生成新的通知 ID（UUID v4）
+func NewNotificationID() NotificationID {
+	return shared.NewEntityID[NotificationMarker]()
+}
+
+// NotificationIDFromString 從字串解析通知 ID
+//
+// 返回：
+//   NotificationID - 解析成功的 ID
+//   error - 解析失敗（返回 ErrInvalidNotificationID）
+func NotificationIDFromString(s string) (NotificationID, error) {
+	return shared.EntityIDFromString[NotificationMarker](s, ErrInvalidNotificationID)
+}
+
+// ===========================
+// MemberID - 會員 ID（引用）
+// ===========================
+
+// MemberMarker 是 MemberID 的標記類型
+type MemberMarker struct{}
+
+// MemberID 會員的唯一標識符（通知上下文內的引用）
+type MemberID = shared.EntityID[MemberMarker]
+
+// MemberIDFromString 從字串解析會員 ID
+func MemberIDFromString(s string) (MemberID, error) {
+	return shared.EntityIDFromString[MemberMarker](s, ErrInvalidMemberID)
+}
@@ -0,0 +1,309 @@
+package notification
+
+import (
+	"time"
+)
+
+// ===========================
+// Notification 聚合根
+// ===========================
+
+// Notification 推播給會員的通知（含投遞狀態）
+//
+// 職責：
+// - 合併短時間內的多筆積分事件（批次推播，避免連續掃描多張發票時收到多則通知）
+// - 記錄預計推播時間（批次等待 / 勿擾時段延後 / 失敗重試）
+// - 追蹤投遞結果（sent / failed / cancelled）與重試次數
+//
+// 設計原則：
+// - 以來源事件 ID 去重（事件重送時不重複通知）
+// - 僅 pending 狀態可合併或變更
+// - 推播內容於推播時依會員語系產生（不存儲渲染後的文字）
+type Notification struct {
+	notificationID   NotificationID
+	memberID         MemberID
+	notificationType NotificationType
+	status           NotificationStatus
+	earnedPoints     int
+	deductedPoints   int
+	availablePoints  int
+	sourceEventIDs   []string
+	lastEventAt      time.Time
+	scheduledAt      time.Time // 最早可推播時間
+	attempts         int
+	lastError        string
+	sentAt           *time.Time
+	createdAt        time.Time
+	updatedAt        time.Time
+}
+
+// NewPointsActivityNotification 創建積分異動通知
+//
+// 參數：
+//   change - 第一筆積分事件
+//   scheduledAt - 最早可推播時間（事件時間 + BatchWindow，並已考慮勿擾時段）
+func NewPointsActivityNotification(
+	memberID MemberID,
+	change PointsChange,
+	scheduledAt time.Time,
+	now time.Time,
+) (*Notification, error) {
+	if err := change.validate(); err != nil {
+		return nil, err
+	}
+
+	return &Notification{
+		notificationID:   NewNotificationID(),
+		memberID:         memberID,
+		notificationType: NotificationTypePointsActivity,
+		status:           NotificationStatusPending,
+		earnedPoints:     change.Earned,
+		deductedPoints:   change.Deducted,
+		availablePoints:  change.AvailablePoints,
+		sourceEventIDs:   []string{change.EventID},
+		lastEventAt:      change.OccurredAt,
+		scheduledAt:      scheduledAt,
+		createdAt:        now,
+		updatedAt:        now,
+	}, nil
+}
+
+// ReconstructNotification 從持久化存儲重建通知
+//
+// 設計原則：僅供 Repository 使用
+func ReconstructNotification(
+	notificationID NotificationID,
+	memberID MemberID,
+	notificationType NotificationType,
+	status NotificationStatus,
+	earnedPoints, deductedPoints, availablePoints int,
+	sourceEventIDs []string,
+	lastEventAt, scheduledAt time.Time,
+	attempts int,
+	lastError string,
+	sentAt *time.Time,
+	createdAt, updatedAt time.Time,
+) (*Notification, error) {
+	if !notificationType.IsValid() || !status.IsValid() {
+		return nil, ErrInvalidNotificationState.WithContext(
+			"notification_id", notificationID.String(),
+			"type", notificationType.String(),
+			"status", status.String(),
+		)
+	}
+
+	return &Notification{
+		notificationID:   notificationID,
+		memberID:         memberID,
+		notificationType: notificationType,
+		status:           status,
+		earnedPoints:     earnedPoints,
+		deductedPoints:   deductedPoints,
+		availablePoints:  availablePoints,
+		sourceEventIDs:   sourceEventIDs,
+		lastEventAt:      lastEventAt,
+		scheduledAt:      scheduledAt,
+		attempts:         attempts,
+		lastError:        lastError,
+		sentAt:           sentAt,
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+	}, nil
+}
+
+// ===========================
+// 命令方法
+// ===========================
+
+// MergeAt 合併另一筆積分事件（批次推播）
+//
+// 業務規則：
+// - 僅 pending 狀態可合併
+// - 同一事件重複合併時忽略（返回 false）
+// - 可用積分以最新事件為準
+// - 不延後預計推播時間（連續事件不會無限延後通知）
+//
+// 返回：是否合併
+func (n *Notification) MergeAt(change PointsChange, now time.Time) (bool, error) {
+	if err := n.requirePending("merge"); err != nil {
+		return false, err
+	}
+	if err := change.validate(); err != nil {
+		return false, err
+	}
+	if n.ContainsEvent(change.EventID) {
+		return false, nil
+	}
+
+	n.earnedPoints += change.Earned
+	n.deductedPoints += change.Deducted
+	if !change.OccurredAt.Before(n.lastEventAt) {
+		n.availablePoints = change.AvailablePoints
+		n.lastEventAt = change.OccurredAt
+	}
+	n.sourceEventIDs = append(n.sourceEventIDs, change.EventID)
+	n.updatedAt = now
+	return true, nil
+}
+
+// DeferUntilAt 延後推播（勿擾時段）
+func (n *Notification) DeferUntilAt(scheduledAt, now time.Time) error {
+	if err := n.requirePending("defer"); err != nil {
+		return err
+	}
+	n.scheduledAt = scheduledAt
+	n.updatedAt = now
+	return nil
+}
+
+// MarkSentAt 標記已推播
+func (n *Notification) MarkSentAt(now time.Time) error {
+	if err := n.requirePending("mark_sent"); err != nil {
+		return err
+	}
+	n.status = NotificationStatusSent
+	n.attempts++
+	n.lastError = ""
+	n.sentAt = &now
+	n.updatedAt = now
+	return nil
+}
+
+// RecordFailureAt 記錄推播失敗
+//
+// 業務規則：
+// - 未達 MaxDeliveryAttempts → 維持 pending，RetryDelay 後重試
+// - 達到上限 → failed
+func (n *Notification) RecordFailureAt(reason string, now time.Time) error {
+	if err := n.requirePending("record_failure"); err != nil {
+		return err
+	}
+	n.attempts++
+	n.lastError = reason
+	if n.attempts >= MaxDeliveryAttempts {
+		n.status = NotificationStatusFailed
+	} else {
+		n.scheduledAt = now.Add(RetryDelay)
+	}
+	n.updatedAt = now
+	return nil
+}
+
+// CancelAt 取消推播（會員關閉通知 / 無法推播）
+func (n *Notification) CancelAt(reason string, now time.Time) error {
+	if err := n.requirePending("cancel"); err != nil {
+		return err
+	}
+	n.status = NotificationStatusCancelled
+	n.lastError = reason
+	n.updatedAt = now
+	return nil
+}
+
+// requirePending 檢查通知為 pending 狀態
+func (n *Notification) requirePending(operation string) error {
+	if n.status != NotificationStatusPending {
+		return ErrInvalidNotificationState.WithContext(
+			"notification_id", n.notificationID.String(),
+			"status", n.status.String(),
+			"operation", operation,
+		)
+	}
+	return nil
+}
+
+// ===========================
+// 查詢方法
+// ===========================
+
+// IsDueAt 是否已到推播時間
+func (n *Notification) IsDueAt(now time.Time) bool {
+	return n.status == NotificationStatusPending && !now.Before(n.scheduledAt)
+}
+
+// ContainsEvent 是否已包含指定事件
+func (n *Notification) ContainsEvent(eventID string) bool {
+	for _, id := range n.sourceEventIDs {
+		if id == eventID {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationID 通知 ID
+func (n *Notification) NotificationID() NotificationID {
+	return n.notificationID
+}
+
+// MemberID 會員 ID
+func (n *Notification) MemberID() MemberID {
+	return n.memberID
+}
+
+// Type 通知類型
+func (n *Notification) Type() NotificationType {
+	return n.notificationType
+}
+
+// Status 投遞狀態
+func (n *Notification) Status() NotificationStatus {
+	return n.status
+}
+
+// EarnedPoints 合併後獲得的積分
+func (n *Notification) EarnedPoints() int {
+	return n.earnedPoints
+}
+
+// DeductedPoints 合併後使用的積分
+func (n *Notification) DeductedPoints() int {
+	return n.deductedPoints
+}
+
+// AvailablePoints 最新可用積分
+func (n *Notification) AvailablePoints() int {
+	return n.availablePoints
+}
+
+// SourceEventIDs 來源事件 ID（返回副本）
+func (n *Notification) SourceEventIDs() []string {
+	ids := make([]string, len(n.sourceEventIDs))
+	copy(ids, n.sourceEventIDs)
+	return ids
+}
+
+// LastEventAt 最新事件時間
+func (n *Notification) LastEventAt() time.Time {
+	return n.lastEventAt
+}
+
+// ScheduledAt 最早可推播時間
+func (n *Notification) ScheduledAt() time.Time {
+	return n.scheduledAt
+}
+
+// Attempts 推播嘗試次數
+func (n *Notification) Attempts() int {
+	return n.attempts
+}
+
+// LastError 最近一次失敗 / 取消原因
+func (n *Notification) LastError() string {
+	return n.lastError
+}
+
+// SentAt 推播時間（未推播時為 nil）
+func (n *Notification) SentAt() *time.Time {
+	return n.sentAt
+}
+
+// CreatedAt 創建時間
+func (n *Notification) CreatedAt() time.Time {
+	return n.createdAt
+}
+
+// UpdatedAt 更新時間
+func (n *Notification) UpdatedAt() time.Time {
+	return n.updatedAt
+}
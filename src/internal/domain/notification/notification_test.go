@@ -0,0 +1,153 @@
+package notification_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/notification"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMemberID = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+
+func newTestNotification(t *testing.T, now time.Time) *notification.Notification {
+	t.Helper()
+	memberID, err := notification.MemberIDFromString(testMemberID)
+	require.NoError(t, err)
+	n, err := notification.NewPointsActivityNotification(memberID, notification.PointsChange{
+		EventID:         "event-1",
+		Earned:          3,
+		AvailablePoints: 103,
+		OccurredAt:      now,
+	}, now.Add(notification.BatchWindow), now)
+	require.NoError(t, err)
+	return n
+}
+
+// Test 1: 合併連續事件（去重、可用積分以最新事件為準、不延後推播時間）
+func TestNotification_MergeAt(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+	n := newTestNotification(t, now)
+
+	// Act
+	merged, err := n.MergeAt(notification.PointsChange{
+		EventID:         "event-2",
+		Earned:          5,
+		AvailablePoints: 108,
+		OccurredAt:      now.Add(30 * time.Second),
+	}, now.Add(30*time.Second))
+	require.NoError(t, err)
+	duplicate, err := n.MergeAt(notification.PointsChange{
+		EventID:         "event-2",
+		Earned:          5,
+		AvailablePoints: 108,
+		OccurredAt:      now.Add(30 * time.Second),
+	}, now.Add(time.Minute))
+	require.NoError(t, err)
+
+	// Assert
+	assert.True(t, merged)
+	assert.False(t, duplicate)
+	assert.Equal(t, 8, n.EarnedPoints())
+	assert.Equal(t, 108, n.AvailablePoints())
+	assert.Equal(t, []string{"event-1", "event-2"}, n.SourceEventIDs())
+	assert.Equal(t, now.Add(notification.BatchWindow), n.ScheduledAt())
+	assert.False(t, n.IsDueAt(now.Add(time.Minute)))
+	assert.True(t, n.IsDueAt(now.Add(notification.BatchWindow)))
+}
+
+// Test 2: 推播失敗重試，達上限後標記 failed
+func TestNotification_RecordFailureAt(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+	n := newTestNotification(t, now)
+
+	// Act & Assert
+	require.NoError(t, n.RecordFailureAt("status 500", now))
+	assert.Equal(t, notification.NotificationStatusPending, n.Status())
+	assert.Equal(t, now.Add(notification.RetryDelay), n.ScheduledAt())
+
+	for i := 1; i < notification.MaxDeliveryAttempts; i++ {
+		require.NoError(t, n.RecordFailureAt("status 500", now))
+	}
+	assert.Equal(t, notification.NotificationStatusFailed, n.Status())
+	assert.Equal(t, notification.MaxDeliveryAttempts, n.Attempts())
+	assert.Equal(t, "status 500", n.LastError())
+
+	_, err := n.MergeAt(notification.PointsChange{EventID: "event-3", Earned: 1, OccurredAt: now}, now)
+	assert.ErrorIs(t, err, notification.ErrInvalidNotificationState)
+}
+
+// Test 3: 標記已推播後不可再變更
+func TestNotification_MarkSentAt(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+	n := newTestNotification(t, now)
+
+	// Act
+	err := n.MarkSentAt(now.Add(notification.BatchWindow))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, notification.NotificationStatusSent, n.Status())
+	require.NotNil(t, n.SentAt())
+	assert.Equal(t, 1, n.Attempts())
+	assert.ErrorIs(t, n.CancelAt("disabled", now), notification.ErrInvalidNotificationState)
+}
+
+// Test 4: 勿擾時段（跨午夜、台北時間）
+func TestQuietHours_DeliverableAt(t *testing.T) {
+	taipei := shared.BusinessLocation
+	overnight, err := notification.NewQuietHours(23*60, 8*60)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		quiet    notification.QuietHours
+		at       time.Time
+		expected time.Time
+	}{
+		{
+			name:     "預設 02:00-10:00，凌晨 3 點延後至 10 點",
+			quiet:    notification.DefaultQuietHours,
+			at:       time.Date(2025, 1, 9, 3, 0, 0, 0, taipei),
+			expected: time.Date(2025, 1, 9, 10, 0, 0, 0, taipei),
+		},
+		{
+			name:     "預設時段外不延後",
+			quiet:    notification.DefaultQuietHours,
+			at:       time.Date(2025, 1, 9, 1, 59, 0, 0, taipei),
+			expected: time.Date(2025, 1, 9, 1, 59, 0, 0, taipei),
+		},
+		{
+			name:     "跨午夜時段，23:30 延後至隔日 08:00",
+			quiet:    overnight,
+			at:       time.Date(2025, 1, 8, 23, 30, 0, 0, taipei),
+			expected: time.Date(2025, 1, 9, 8, 0, 0, 0, taipei),
+		},
+		{
+			name:     "以台北時間判斷（UTC 19:00 = 台北 03:00）",
+			quiet:    notification.DefaultQuietHours,
+			at:       time.Date(2025, 1, 8, 19, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 9, 10, 0, 0, 0, taipei),
+		},
+		{
+			name:     "不啟用勿擾時段",
+			quiet:    notification.NoQuietHours,
+			at:       time.Date(2025, 1, 9, 3, 0, 0, 0, taipei),
+			expected: time.Date(2025, 1, 9, 3, 0, 0, 0, taipei),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, tt.expected.Equal(tt.quiet.DeliverableAt(tt.at)), "got %s", tt.quiet.DeliverableAt(tt.at))
+		})
+	}
+
+	_, err = notification.NewQuietHours(-1, 24*60)
+	assert.ErrorIs(t, err, notification.ErrInvalidQuietHours)
+}
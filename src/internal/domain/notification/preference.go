@@ -0,0 +1,114 @@
+package notification
+
+import (
+	"time"
+)
+
+// ===========================
+// NotificationPreference 聚合根
+// ===========================
+
+// NotificationPreference 會員通知偏好
+//
+// 職責：
+// - 會員是否接收積分異動推播
+// - 勿擾時段與通知語系
+//
+// 設計原則：
+// - 以 MemberID 為識別（每位會員最多一筆）
+// - 尚未設定的會員使用 DefaultNotificationPreference（接收推播、預設勿擾時段、繁體中文）
+type NotificationPreference struct {
+	memberID              MemberID
+	pointsActivityEnabled bool
+	quietHours            QuietHours
+	locale                Locale
+	createdAt             time.Time
+	updatedAt             time.Time
+}
+
+// DefaultNotificationPreference 預設通知偏好
+func DefaultNotificationPreference(memberID MemberID, now time.Time) *NotificationPreference {
+	return &NotificationPreference{
+		memberID:              memberID,
+		pointsActivityEnabled: true,
+		quietHours:            DefaultQuietHours,
+		locale:                DefaultLocale,
+		createdAt:             now,
+		updatedAt:             now,
+	}
+}
+
+// ReconstructNotificationPreference 從持久化存儲重建通知偏好
+//
+// 設計原則：僅供 Repository 使用
+func ReconstructNotificationPreference(
+	memberID MemberID,
+	pointsActivityEnabled bool,
+	quietHours QuietHours,
+	locale Locale,
+	createdAt, updatedAt time.Time,
+) (*NotificationPreference, error) {
+	if _, err := ParseLocale(locale.String()); err != nil {
+		return nil, err
+	}
+
+	return &NotificationPreference{
+		memberID:              memberID,
+		pointsActivityEnabled: pointsActivityEnabled,
+		quietHours:            quietHours,
+		locale:                locale,
+		createdAt:             createdAt,
+		updatedAt:             updatedAt,
+	}, nil
+}
+
+// ===========================
+// 命令方法
+// ===========================
+
+// UpdateAt 更新通知偏好
+func (p *NotificationPreference) UpdateAt(
+	pointsActivityEnabled bool,
+	quietHours QuietHours,
+	locale Locale,
+	now time.Time,
+) {
+	p.pointsActivityEnabled = pointsActivityEnabled
+	p.quietHours = quietHours
+	p.locale = locale
+	p.updatedAt = now
+}
+
+// ===========================
+// 查詢方法
+// ===========================
+
+// MemberID 會員 ID
+func (p *NotificationPreference) MemberID() MemberID {
+	return p.memberID
+}
+
+// PointsActivityEnabled 是否接收積分異動推播
+func (p *NotificationPreference) PointsActivityEnabled() bool {
+	return p.pointsActivityEnabled
+}
+
+// QuietHours 勿擾時段
+func (p *NotificationPreference) QuietHours() QuietHours {
+	return p.quietHours
+}
+
+// Locale 通知語系
+func (p *NotificationPreference) Locale() Locale {
+	return p.locale
+}
+
+// CreatedAt 創建時間
+func (p *NotificationPreference) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+// UpdatedAt 更新時間
+func (p *NotificationPreference) UpdatedAt() time.Time {
+	return p.updatedAt
+}
@@ -0,0 +1,53 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// Notification Repository 介面
+// ===========================
+
+// NotificationRepository 通知倉儲介面
+//
+// 設計原則：
+// 1. Save 為 Upsert（新增或更新），同時記錄來源事件 ID
+// 2. 來源事件 ID 全域唯一（同一事件只會出現在一則通知中）
+// 3. 事務支持：寫操作 ctx 必須 non-nil，讀操作 ctx 可為 nil
+type NotificationRepository interface {
+	// Save 保存通知（新增或更新）
+	Save(ctx shared.TransactionContext, n *Notification) error
+
+	// FindByID 根據 ID 查詢通知
+	//
+	// 返回：找到的通知，或 ErrNotificationNotFound
+	FindByID(ctx shared.TransactionContext, id NotificationID) (*Notification, error)
+
+	// FindPendingByMemberID 查詢會員尚未推播的通知（批次合併用）
+	//
+	// 返回：最新一則 pending 通知，或 ErrNotificationNotFound
+	FindPendingByMemberID(ctx shared.TransactionContext, memberID MemberID, notificationType NotificationType) (*Notification, error)
+
+	// FindDue 查詢已到推播時間的 pending 通知（依預計推播時間排序）
+	FindDue(ctx shared.TransactionContext, now time.Time, limit int) ([]*Notification, error)
+
+	// ExistsBySourceEventID 檢查事件是否已產生通知（事件重送去重）
+	ExistsBySourceEventID(ctx shared.TransactionContext, eventID string) (bool, error)
+}
+
+// ===========================
+// NotificationPreference Repository 介面
+// ===========================
+
+// NotificationPreferenceRepository 通知偏好倉儲介面
+type NotificationPreferenceRepository interface {
+	// Save 保存通知偏好（新增或更新）
+	Save(ctx shared.TransactionContext, preference *NotificationPreference) error
+
+	// FindByMemberID 查詢會員通知偏好
+	//
+	// 返回：找到的偏好，或 ErrPreferenceNotFound（呼叫端應使用 DefaultNotificationPreference）
+	FindByMemberID(ctx shared.TransactionContext, memberID MemberID) (*NotificationPreference, error)
+}
@@ -0,0 +1,230 @@
+package notification
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// NotificationType 通知類型
+// ===========================
+
+// NotificationType 通知類型
+type NotificationType string
+
+const (
+	NotificationTypePointsActivity NotificationType = "points_activity" // 積分異動（獲得 / 使用）
+)
+
+// String 返回類型字串
+func (t NotificationType) String() string {
+	return string(t)
+}
+
+// IsValid 判斷類型是否有效
+func (t NotificationType) IsValid() bool {
+	return t == NotificationTypePointsActivity
+}
+
+// ===========================
+// NotificationStatus 通知狀態
+// ===========================
+
+// NotificationStatus 通知投遞狀態
+//
+// 狀態轉換：
+//   pending → sent（推播成功）
+//   pending → failed（達到重試上限）
+//   pending → cancelled（會員關閉通知 / 無法推播）
+type NotificationStatus string
+
+const (
+	NotificationStatusPending   NotificationStatus = "pending"   // 等待推播（批次合併中 / 勿擾時段延後 / 等待重試）
+	NotificationStatusSent      NotificationStatus = "sent"      // 已推播
+	NotificationStatusFailed    NotificationStatus = "failed"    // 推播失敗（已達重試上限）
+	NotificationStatusCancelled NotificationStatus = "cancelled" // 已取消
+)
+
+// String 返回狀態字串
+func (s NotificationStatus) String() string {
+	return string(s)
+}
+
+// IsValid 判斷狀態是否有效
+func (s NotificationStatus) IsValid() bool {
+	switch s {
+	case NotificationStatusPending, NotificationStatusSent, NotificationStatusFailed, NotificationStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// 投遞規則
+const (
+	BatchWindow         = 2 * time.Minute // 首筆事件後等待合併的時間（連續掃描多張發票只推播一次）
+	MaxDeliveryAttempts = 3               // 推播失敗重試上限
+	RetryDelay          = 5 * time.Minute // 推播失敗後的重試間隔
+)
+
+// ===========================
+// PointsChange 積分異動
+// ===========================
+
+// PointsChange 單一積分事件的異動內容（值對象）
+//
+// 欄位：
+// - EventID: 來源領域事件 ID（去重用）
+// - Earned / Deducted: 獲得與使用的積分（擇一大於 0）
+// - AvailablePoints: 異動後的可用積分
+// - OccurredAt: 事件發生時間
+type PointsChange struct {
+	EventID         string
+	Earned          int
+	Deducted        int
+	AvailablePoints int
+	OccurredAt      time.Time
+}
+
+// validate 檢查異動內容
+func (c PointsChange) validate() error {
+	if c.EventID == "" || c.Earned < 0 || c.Deducted < 0 || c.AvailablePoints < 0 {
+		return ErrInvalidPointsChange.WithContext(
+			"event_id", c.EventID,
+			"earned", c.Earned,
+			"deducted", c.Deducted,
+			"available_points", c.AvailablePoints,
+		)
+	}
+	return nil
+}
+
+// ===========================
+// Locale 語系
+// ===========================
+
+// Locale 通知語系
+type Locale string
+
+const (
+	LocaleZhTW Locale = "zh-TW" // 繁體中文（預設）
+	LocaleEn   Locale = "en"    // 英文
+)
+
+// DefaultLocale 預設語系
+const DefaultLocale = LocaleZhTW
+
+// ParseLocale 解析語系字串
+//
+// 返回：支援的語系，或 ErrInvalidLocale
+func ParseLocale(s string) (Locale, error) {
+	switch Locale(s) {
+	case LocaleZhTW, LocaleEn:
+		return Locale(s), nil
+	default:
+		return "", ErrInvalidLocale.WithContext("locale", s)
+	}
+}
+
+// String 返回語系字串
+func (l Locale) String() string {
+	return string(l)
+}
+
+// ===========================
+// QuietHours 勿擾時段
+// ===========================
+
+// minutesPerDay 一天的分鐘數
+const minutesPerDay = 24 * 60
+
+// QuietHours 勿擾時段（值對象，以台北時間判斷）
+//
+// 設計原則：
+// - 以「當日分鐘數」表示起訖，可跨午夜（例如 02:00 ~ 10:00、23:00 ~ 08:00）
+// - 起訖相同表示不啟用
+// - 勿擾時段內產生的通知延後至時段結束時推播（不丟棄）
+type QuietHours struct {
+	startMinute int
+	endMinute   int
+}
+
+// DefaultQuietHours 預設勿擾時段 02:00 ~ 10:00
+//
+// 酒吧營業至深夜，凌晨的發票驗證（iChef 匯入）不應在會員睡覺時推播
+var DefaultQuietHours = QuietHours{startMinute: 2 * 60, endMinute: 10 * 60}
+
+// NoQuietHours 不啟用勿擾時段
+var NoQuietHours = QuietHours{}
+
+// NewQuietHours 創建勿擾時段
+//
+// 參數：
+//   startMinute / endMinute - 當日分鐘數（0 ~ 1439），相同表示不啟用
+func NewQuietHours(startMinute, endMinute int) (QuietHours, error) {
+	if startMinute < 0 || startMinute >= minutesPerDay || endMinute < 0 || endMinute >= minutesPerDay {
+		return QuietHours{}, ErrInvalidQuietHours.WithContext(
+			"start_minute", startMinute,
+			"end_minute", endMinute,
+		)
+	}
+	if startMinute == endMinute {
+		return NoQuietHours, nil
+	}
+	return QuietHours{startMinute: startMinute, endMinute: endMinute}, nil
+}
+
+// StartMinute 起始時間（當日分鐘數）
+func (q QuietHours) StartMinute() int {
+	return q.startMinute
+}
+
+// EndMinute 結束時間（當日分鐘數）
+func (q QuietHours) EndMinute() int {
+	return q.endMinute
+}
+
+// IsEnabled 是否啟用
+func (q QuietHours) IsEnabled() bool {
+	return q.startMinute != q.endMinute
+}
+
+// Contains 判斷指定時間是否在勿擾時段內（起始含、結束不含）
+func (q QuietHours) Contains(t time.Time) bool {
+	if !q.IsEnabled() {
+		return false
+	}
+	local := t.In(shared.BusinessLocation)
+	minute := local.Hour()*60 + local.Minute()
+	if q.startMinute < q.endMinute {
+		return minute >= q.startMinute && minute < q.endMinute
+	}
+	return minute >= q.startMinute || minute < q.endMinute
+}
+
+// DeliverableAt 返回可推播的最早時間
+//
+// 業務規則：
+// - 不在勿擾時段內 → 原時間
+// - 在勿擾時段內 → 時段結束時間（跨午夜時為隔日）
+func (q QuietHours) DeliverableAt(t time.Time) time.Time {
+	if !q.Contains(t) {
+		return t
+	}
+	local := t.In(shared.BusinessLocation)
+	end := time.Date(local.Year(), local.Month(), local.Day(), q.endMinute/60, q.endMinute%60, 0, 0, shared.BusinessLocation)
+	if !end.After(local) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return end
+}
+
+// String 返回 HH:MM-HH:MM 格式
+func (q QuietHours) String() string {
+	if !q.IsEnabled() {
+		return "off"
+	}
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", q.startMinute/60, q.startMinute%60, q.endMinute/60, q.endMinute%60)
+}
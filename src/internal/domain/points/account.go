@@ -19,6 +19,7 @@ import (
 // 2. 不變條件：UsedPoints <= EarnedPoints（必須在每個修改方法末尾檢查）
 // 3. 事件驅動：所有狀態變更都發布領域事件
 // 4. Tell, Don't Ask：封裝業務邏輯，不暴露內部狀態供外部判斷
+// 5. 每次狀態變更遞增 Version，Repository.Update 以載入時版本號偵測並行修改
 //
 // 業務不變條件：
 // - EarnedPoints >= 0（累積獲得的積分總數）
@@ -34,9 +35,16 @@ type PointsAccount struct {
 	earnedPoints PointsAmount // 累積獲得積分
 	usedPoints   PointsAmount // 累積使用積分
 
+	// 凍結狀態（nil 表示未凍結）
+	freeze *AccountFreeze
+
 	// 審計字段
 	createdAt time.Time
 	updatedAt time.Time
+	version   int // 樂觀鎖版本號（Optimistic Locking）
+
+	// persistedVersion 最近一次載入或寫入時的版本號（更新時比對，0 表示尚未保存）
+	persistedVersion int
 
 	// 待發布的領域事件
 	events []shared.DomainEvent
@@ -77,6 +85,7 @@ func NewPointsAccount(memberID MemberID) (*PointsAccount, error) {
 		usedPoints:   newPointsAmountUnchecked(0),
 		createdAt:    now,
 		updatedAt:    now,
+		version:      1, // 初始版本為 1
 		events:       make([]shared.DomainEvent, 0),
 	}
 
@@ -130,6 +139,23 @@ func (a *PointsAccount) UpdatedAt() time.Time {
 	return a.updatedAt
 }
 
+// Version 獲取版本號（用於樂觀鎖）
+func (a *PointsAccount) Version() int {
+	return a.version
+}
+
+// PersistedVersion 獲取最近一次載入或寫入時的版本號（Repository 更新時比對，0 表示尚未保存）
+func (a *PointsAccount) PersistedVersion() int {
+	return a.persistedVersion
+}
+
+// MarkPersisted 標記目前版本已寫入（由 Repository 在 Save / Update 成功後呼叫）
+//
+// 使同一個聚合實例可再次 Update 而不產生誤判的版本衝突
+func (a *PointsAccount) MarkPersisted() {
+	a.persistedVersion = a.version
+}
+
 // GetAvailablePoints 獲取可用積分（派生值）
 //
 // 業務規則：
@@ -223,6 +249,7 @@ func (a *PointsAccount) EarnPoints(
 
 	a.earnedPoints = newEarnedPoints
 	a.updatedAt = time.Now()
+	a.version++
 
 	// 發布領域事件
 	// 事件將在 Repository.Save() 成功後通過 PullEvents() 獲取並發布
@@ -251,6 +278,7 @@ func (a *PointsAccount) EarnPoints(
 // - amount 已通過 NewPointsAmount 驗證，保證 >= 0
 //
 // 業務規則：
+// - 凍結中的帳戶不可扣減（ErrAccountFrozen）
 // - 必須先檢查可用積分是否足夠（前置條件）
 // - 零積分也接受（業務上可能存在測試場景）
 //
@@ -266,6 +294,15 @@ func (a *PointsAccount) DeductPoints(
 	amount PointsAmount,
 	reason string,
 ) error {
+	// 前置條件：調查中的帳戶不可使用積分
+	if a.freeze != nil {
+		return ErrAccountFrozen.WithContext(
+			"account_id", a.accountID.String(),
+			"freeze_reason", a.freeze.Reason(),
+			"reason", reason,
+		)
+	}
+
 	// 前置條件：檢查是否有足夠積分
 	available := a.GetAvailablePoints()
 	if amount.GreaterThan(available) {
@@ -286,6 +323,7 @@ func (a *PointsAccount) DeductPoints(
 
 	a.usedPoints = newUsedPoints
 	a.updatedAt = time.Now()
+	a.version++
 
 	// 發布領域事件
 	// 事件將在 Repository.Save() 成功後通過 PullEvents() 獲取並發布
@@ -298,6 +336,217 @@ func (a *PointsAccount) DeductPoints(
 	return nil
 }
 
+// ===========================
+// Freeze / Unfreeze 命令方法
+// ===========================
+
+// Freeze 凍結帳戶（詐騙調查期間）
+//
+// 參數：
+//   reason - 凍結原因（必填）
+//   frozenBy - 操作人員（必填，審計用途）
+//
+// 業務規則：
+// - 凍結期間 DeductPoints 返回 ErrAccountFrozen
+// - 凍結不影響 EarnPoints（調查結果確認後再由管理員調整）
+// - 已凍結的帳戶不可重複凍結
+func (a *PointsAccount) Freeze(reason, frozenBy string) error {
+	if a.freeze != nil {
+		return ErrAccountFrozen.WithContext(
+			"account_id", a.accountID.String(),
+			"reason", "account is already frozen",
+		)
+	}
+
+	now := time.Now()
+	freeze, err := NewAccountFreeze(reason, frozenBy, now)
+	if err != nil {
+		return err
+	}
+
+	a.freeze = &freeze
+	a.updatedAt = now
+	a.version++
+
+	a.addEvent(NewPointsAccountFrozenEvent(a.accountID, freeze.Reason(), freeze.FrozenBy()))
+
+	return nil
+}
+
+// Unfreeze 解除凍結
+//
+// 錯誤：ErrAccountNotFrozen（如果帳戶未凍結）
+func (a *PointsAccount) Unfreeze(releasedBy string) error {
+	if a.freeze == nil {
+		return ErrAccountNotFrozen.WithContext(
+			"account_id", a.accountID.String(),
+		)
+	}
+
+	a.freeze = nil
+	a.updatedAt = time.Now()
+	a.version++
+
+	a.addEvent(NewPointsAccountUnfrozenEvent(a.accountID, releasedBy))
+
+	return nil
+}
+
+// ===========================
+// ApplyAdjustment 命令方法
+// ===========================
+
+// ApplyAdjustment 執行積分人工調整
+//
+// 業務規則：
+// - 正數加到 earnedPoints；負數加到 usedPoints（與 DeductPoints 一致，維持 usedPoints <= earnedPoints）
+// - 扣點不可超過可用積分（ErrInsufficientPoints）
+// - 凍結中的帳戶仍可調整（調查結果確認後由管理員追回或補償）
+// - 需核准的調整必須先經 PointsAdjustment.Approve
+//
+// 副作用：
+// - 發布 PointsAdjustedEvent，並將事件 ID 記錄到 adjustment（審計關聯）
+//
+// 錯誤：
+// - 調整不屬於此帳戶 → ErrAdjustmentAccountMismatch
+// - 已處理 → ErrAdjustmentNotPending
+// - 尚未核准 → ErrAdjustmentApprovalRequired
+func (a *PointsAccount) ApplyAdjustment(adjustment *PointsAdjustment, now time.Time) error {
+	if err := adjustment.checkApplicable(a.accountID); err != nil {
+		return err
+	}
+
+	delta := adjustment.Delta()
+	if delta > 0 {
+		amount, err := NewPointsAmount(delta)
+		if err != nil {
+			return err
+		}
+		newEarnedPoints, err := a.earnedPoints.Add(amount)
+		if err != nil {
+			return err
+		}
+		a.earnedPoints = newEarnedPoints
+	} else {
+		amount, err := NewPointsAmount(-delta)
+		if err != nil {
+			return err
+		}
+		available := a.GetAvailablePoints()
+		if amount.GreaterThan(available) {
+			return ErrInsufficientPoints.WithContext(
+				"requested", amount.Value(),
+				"available", available.Value(),
+				"adjustment_id", adjustment.AdjustmentID().String(),
+			)
+		}
+		newUsedPoints, err := a.usedPoints.Add(amount)
+		if err != nil {
+			return err
+		}
+		a.usedPoints = newUsedPoints
+	}
+	a.updatedAt = now
+	a.version++
+
+	event := NewPointsAdjustedEvent(
+		a.accountID,
+		adjustment.AdjustmentID(),
+		delta,
+		adjustment.Reason(),
+		adjustment.RequestedBy(),
+		adjustment.ReviewedBy(),
+	)
+	a.addEvent(event)
+	adjustment.markApplied(event.EventID(), now)
+
+	return nil
+}
+
+// IsFrozen 判斷帳戶是否凍結中
+func (a *PointsAccount) IsFrozen() bool {
+	return a.freeze != nil
+}
+
+// FreezeInfo 返回凍結資訊（未凍結時為 nil）
+func (a *PointsAccount) FreezeInfo() *AccountFreeze {
+	return a.freeze
+}
+
+// ===========================
+// AbsorbAccount 命令方法
+// ===========================
+
+// AbsorbAccount 併入另一個積分帳戶（重複會員合併）
+//
+// 參數：
+//   source - 被併入的帳戶（次要會員）
+//   mergeID - 會員合併稽核紀錄 ID
+//   now - 合併時間
+//
+// 業務規則：
+// - earnedPoints、usedPoints 分別累加（兩帳戶各自滿足不變條件，相加後仍滿足）
+// - 被併入的帳戶歸零（不刪除，保留帳戶紀錄供追溯）
+// - 任一帳戶凍結中不可合併（詐騙調查結束後再處理）
+//
+// 副作用：
+// - 發布 PointsAccountMergedEvent（發布於保留的帳戶）
+//
+// 錯誤：
+// - 與自身合併 → ErrInvalidAccountMerge
+// - 任一帳戶凍結中 → ErrAccountFrozen
+// - 累加溢位 → PointsAmount 溢位錯誤
+func (a *PointsAccount) AbsorbAccount(source *PointsAccount, mergeID string, now time.Time) error {
+	if source.accountID.Equals(a.accountID) {
+		return ErrInvalidAccountMerge.WithContext(
+			"account_id", a.accountID.String(),
+			"reason", "cannot merge an account into itself",
+		)
+	}
+	for _, account := range []*PointsAccount{a, source} {
+		if account.freeze != nil {
+			return ErrAccountFrozen.WithContext(
+				"account_id", account.accountID.String(),
+				"freeze_reason", account.freeze.Reason(),
+				"reason", "member merge",
+			)
+		}
+	}
+
+	newEarnedPoints, err := a.earnedPoints.Add(source.earnedPoints)
+	if err != nil {
+		return err
+	}
+	newUsedPoints, err := a.usedPoints.Add(source.usedPoints)
+	if err != nil {
+		return err
+	}
+
+	movedEarned, movedUsed := source.earnedPoints.Value(), source.usedPoints.Value()
+	a.earnedPoints = newEarnedPoints
+	a.usedPoints = newUsedPoints
+	a.updatedAt = now
+	a.version++
+	source.earnedPoints = newPointsAmountUnchecked(0)
+	source.usedPoints = newPointsAmountUnchecked(0)
+	source.updatedAt = now
+	source.version++
+
+	a.assertInvariants()
+	source.assertInvariants()
+
+	a.addEvent(NewPointsAccountMergedEvent(
+		a.accountID,
+		source.accountID,
+		mergeID,
+		movedEarned,
+		movedUsed,
+		now,
+	))
+
+	return nil
+}
+
 // ===========================
 // RecalculatePoints 命令方法
 // ===========================
@@ -397,6 +646,7 @@ func (a *PointsAccount) RecalculatePoints(
 	oldEarnedPoints := a.earnedPoints
 	a.earnedPoints = newEarnedPoints
 	a.updatedAt = time.Now()
+	a.version++
 
 	// 發布事件（含審計信息）
 	a.addEvent(NewPointsRecalculatedEvent(
@@ -430,6 +680,7 @@ func (a *PointsAccount) RecalculatePoints(
 //   usedPoints - 累積使用積分（原始 int 值）
 //   createdAt - 創建時間
 //   updatedAt - 最後更新時間
+//   version - 樂觀鎖版本號
 //
 // 返回：
 //   *PointsAccount - 重建的聚合根
@@ -443,6 +694,7 @@ func ReconstructPointsAccount(
 	usedPoints int,
 	createdAt time.Time,
 	updatedAt time.Time,
+	version int,
 ) (*PointsAccount, error) {
 	// 1. 驗證 ID 有效性
 	if accountID.IsEmpty() {
@@ -490,10 +742,43 @@ func ReconstructPointsAccount(
 		usedPoints:   usedAmount,
 		createdAt:    createdAt,
 		updatedAt:    updatedAt,
-		events:       make([]shared.DomainEvent, 0), // 重建時不包含事件
+		version:      version,
+
+		persistedVersion: version,
+		events:           make([]shared.DomainEvent, 0), // 重建時不包含事件
 	}, nil
 }
 
+// ReconstructFrozenPointsAccount 重建凍結中的積分帳戶
+//
+// 使用場景：Repository 讀取到凍結標記時使用（其餘驗證同 ReconstructPointsAccount）
+func ReconstructFrozenPointsAccount(
+	accountID AccountID,
+	memberID MemberID,
+	earnedPoints int,
+	usedPoints int,
+	freeze AccountFreeze,
+	createdAt time.Time,
+	updatedAt time.Time,
+	version int,
+) (*PointsAccount, error) {
+	account, err := ReconstructPointsAccount(
+		accountID,
+		memberID,
+		earnedPoints,
+		usedPoints,
+		createdAt,
+		updatedAt,
+		version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	account.freeze = &freeze
+	return account, nil
+}
+
 // ===========================
 // 不變條件檢查（調試用）
 // ===========================
@@ -550,6 +550,7 @@ func TestReconstructPointsAccount_ValidData_Success(t *testing.T) {
 		50,  // usedPoints
 		createdAt,
 		updatedAt,
+		3, // version
 	)
 
 	// Assert
@@ -560,6 +561,8 @@ func TestReconstructPointsAccount_ValidData_Success(t *testing.T) {
 	assert.Equal(t, 150, account.EarnedPoints().Value())
 	assert.Equal(t, 50, account.UsedPoints().Value())
 	assert.Equal(t, 100, account.GetAvailablePoints().Value())
+	assert.Equal(t, 3, account.Version())
+	assert.Equal(t, 3, account.PersistedVersion())
 	assert.Len(t, account.PullEvents(), 0, "重建時不應包含事件")
 }
 
@@ -636,6 +639,7 @@ func TestReconstructPointsAccount_InvalidInputs(t *testing.T) {
 				tt.used,
 				now,
 				now,
+				1,
 			)
 
 			// Assert
@@ -645,3 +649,154 @@ func TestReconstructPointsAccount_InvalidInputs(t *testing.T) {
 		})
 	}
 }
+
+// ===========================
+// Freeze / Unfreeze 測試
+// ===========================
+
+// Test 71: 凍結中的帳戶不可扣減積分
+func TestPointsAccount_Freeze_BlocksDeductPoints(t *testing.T) {
+	// Arrange
+	account, _ := points.NewPointsAccount(points.NewMemberID())
+	earned, _ := points.NewPointsAmount(100)
+	require.NoError(t, account.EarnPoints(earned, points.PointsSourceInvoice, "TX001", "test"))
+	account.PullEvents()
+
+	// Act
+	err := account.Freeze("掃描頻率異常", "owner01")
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, account.IsFrozen())
+	events := account.PullEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "points.account_frozen", events[0].EventType())
+
+	deduct, _ := points.NewPointsAmount(10)
+	err = account.DeductPoints(deduct, "兌換商品")
+	assert.ErrorIs(t, err, points.ErrAccountFrozen)
+	assert.Equal(t, 100, account.GetAvailablePoints().Value())
+
+	// 凍結期間仍可累積積分
+	assert.NoError(t, account.EarnPoints(deduct, points.PointsSourceSurvey, "S001", "survey"))
+}
+
+// Test 72: 解除凍結後可扣減積分
+func TestPointsAccount_Unfreeze_AllowsDeductPoints(t *testing.T) {
+	// Arrange
+	account, _ := points.NewPointsAccount(points.NewMemberID())
+	earned, _ := points.NewPointsAmount(100)
+	require.NoError(t, account.EarnPoints(earned, points.PointsSourceInvoice, "TX001", "test"))
+	require.NoError(t, account.Freeze("調查中", "owner01"))
+
+	// Act
+	err := account.Unfreeze("owner01")
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, account.IsFrozen())
+	deduct, _ := points.NewPointsAmount(10)
+	assert.NoError(t, account.DeductPoints(deduct, "兌換商品"))
+	assert.ErrorIs(t, account.Unfreeze("owner01"), points.ErrAccountNotFrozen)
+}
+
+// Test 73: 凍結必須提供原因與操作人員
+func TestPointsAccount_Freeze_MissingReason_ReturnsError(t *testing.T) {
+	// Arrange
+	account, _ := points.NewPointsAccount(points.NewMemberID())
+
+	// Act
+	err := account.Freeze(" ", "owner01")
+
+	// Assert
+	assert.ErrorIs(t, err, points.ErrInvalidAccountFreeze)
+	assert.False(t, account.IsFrozen())
+}
+
+// ===========================
+// AbsorbAccount 測試
+// ===========================
+
+// Test 78: 合併帳戶累加已獲得與已使用積分，被併入帳戶歸零且仍可重建
+func TestPointsAccount_AbsorbAccount_MovesTotals(t *testing.T) {
+	// Arrange
+	primary, _ := points.NewPointsAccount(points.NewMemberID())
+	secondary, _ := points.NewPointsAccount(points.NewMemberID())
+	earned, _ := points.NewPointsAmount(100)
+	used, _ := points.NewPointsAmount(30)
+	require.NoError(t, primary.EarnPoints(earned, points.PointsSourceInvoice, "TX001", "test"))
+	require.NoError(t, secondary.EarnPoints(earned, points.PointsSourceInvoice, "TX002", "test"))
+	require.NoError(t, secondary.DeductPoints(used, "兌換商品"))
+	primary.PullEvents()
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// Act
+	err := primary.AbsorbAccount(secondary, "merge-1", now)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 200, primary.EarnedPoints().Value())
+	assert.Equal(t, 30, primary.UsedPoints().Value())
+	assert.Equal(t, 0, secondary.GetAvailablePoints().Value())
+	events := primary.PullEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "points.account_merged", events[0].EventType())
+
+	for _, account := range []*points.PointsAccount{primary, secondary} {
+		_, err := points.ReconstructPointsAccount(
+			account.AccountID(), account.MemberID(),
+			account.EarnedPoints().Value(), account.UsedPoints().Value(),
+			account.CreatedAt(), account.UpdatedAt(), account.Version(),
+		)
+		assert.NoError(t, err)
+	}
+}
+
+// Test 79: 凍結中的帳戶或與自身合併時拒絕
+func TestPointsAccount_AbsorbAccount_Rejected(t *testing.T) {
+	// Arrange
+	primary, _ := points.NewPointsAccount(points.NewMemberID())
+	secondary, _ := points.NewPointsAccount(points.NewMemberID())
+	earned, _ := points.NewPointsAmount(50)
+	require.NoError(t, secondary.EarnPoints(earned, points.PointsSourceInvoice, "TX001", "test"))
+	require.NoError(t, secondary.Freeze("調查中", "owner01"))
+	now := time.Now()
+
+	// Act
+	frozenErr := primary.AbsorbAccount(secondary, "merge-1", now)
+	selfErr := primary.AbsorbAccount(primary, "merge-2", now)
+
+	// Assert
+	assert.ErrorIs(t, frozenErr, points.ErrAccountFrozen)
+	assert.ErrorIs(t, selfErr, points.ErrInvalidAccountMerge)
+	assert.Equal(t, 0, primary.EarnedPoints().Value())
+	assert.Equal(t, 50, secondary.EarnedPoints().Value())
+}
+
+// Test 85: 每次狀態變更遞增版本號；MarkPersisted 後以新版本作為比對基準
+func TestPointsAccount_Version_IncrementsOnChange(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	account, err := points.NewPointsAccount(points.NewMemberID())
+	require.NoError(t, err)
+	other, err := points.NewPointsAccount(points.NewMemberID())
+	require.NoError(t, err)
+	amount, err := points.NewPointsAmount(10)
+	require.NoError(t, err)
+	account.MarkPersisted()
+	other.MarkPersisted()
+
+	// Act
+	require.NoError(t, account.EarnPoints(amount, points.PointsSourceInvoice, "tx-1", "消費"))
+	require.NoError(t, account.DeductPoints(amount, "兌換"))
+	require.NoError(t, account.Freeze("調查中", "admin"))
+	require.NoError(t, account.Unfreeze("admin"))
+	require.NoError(t, account.AbsorbAccount(other, "merge-1", now))
+
+	// Assert
+	assert.Equal(t, 6, account.Version())
+	assert.Equal(t, 1, account.PersistedVersion())
+	assert.Equal(t, 2, other.Version())
+	account.MarkPersisted()
+	assert.Equal(t, 6, account.PersistedVersion())
+}
@@ -0,0 +1,499 @@
+package points
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DefaultAdjustmentApprovalThreshold 預設核准門檻（單次調整超過 500 點需第二位管理員核准）
+const DefaultAdjustmentApprovalThreshold = 500
+
+// maxAdjustmentNoteLength 調整說明最大長度（字元數）
+const maxAdjustmentNoteLength = 500
+
+// ===========================
+// AdjustmentReason 調整原因代碼
+// ===========================
+
+// AdjustmentReason 積分人工調整原因（報表分類用，細節寫在說明）
+type AdjustmentReason string
+
+const (
+	AdjustmentReasonGoodwill        AdjustmentReason = "goodwill"         // 招待 / 公關贈點
+	AdjustmentReasonServiceRecovery AdjustmentReason = "service_recovery" // 客訴補償
+	AdjustmentReasonMissedInvoice   AdjustmentReason = "missed_invoice"   // 補登未入帳的消費
+	AdjustmentReasonCorrection      AdjustmentReason = "correction"       // 更正錯誤 / 重複入帳
+	AdjustmentReasonFraudReversal   AdjustmentReason = "fraud_reversal"   // 追回詐騙取得的積分
+)
+
+// ParseAdjustmentReason 從字串解析調整原因
+//
+// 錯誤：未知原因 → ErrInvalidAdjustmentReason
+func ParseAdjustmentReason(s string) (AdjustmentReason, error) {
+	reason := AdjustmentReason(s)
+	if !reason.IsValid() {
+		return "", ErrInvalidAdjustmentReason.WithContext("reason", s)
+	}
+	return reason, nil
+}
+
+// String 返回原因代碼字串
+func (r AdjustmentReason) String() string {
+	return string(r)
+}
+
+// IsValid 判斷原因代碼是否為已定義的值
+func (r AdjustmentReason) IsValid() bool {
+	switch r {
+	case AdjustmentReasonGoodwill,
+		AdjustmentReasonServiceRecovery,
+		AdjustmentReasonMissedInvoice,
+		AdjustmentReasonCorrection,
+		AdjustmentReasonFraudReversal:
+		return true
+	default:
+		return false
+	}
+}
+
+// ===========================
+// AdjustmentStatus 調整狀態
+// ===========================
+
+// AdjustmentStatus 積分人工調整狀態
+//
+// 狀態流轉：
+//   pending_approval → applied（未超過門檻時立即入帳；超過門檻時由第二位管理員核准後入帳）
+//   pending_approval → rejected（駁回，不異動積分）
+type AdjustmentStatus string
+
+const (
+	AdjustmentStatusPendingApproval AdjustmentStatus = "pending_approval"
+	AdjustmentStatusApplied         AdjustmentStatus = "applied"
+	AdjustmentStatusRejected        AdjustmentStatus = "rejected"
+)
+
+// ParseAdjustmentStatus 從字串解析調整狀態
+func ParseAdjustmentStatus(s string) (AdjustmentStatus, error) {
+	status := AdjustmentStatus(s)
+	if !status.IsValid() {
+		return "", ErrInvalidAdjustment.WithContext("status", s)
+	}
+	return status, nil
+}
+
+// String 返回狀態字串
+func (s AdjustmentStatus) String() string {
+	return string(s)
+}
+
+// IsValid 判斷狀態是否為已定義的值
+func (s AdjustmentStatus) IsValid() bool {
+	switch s {
+	case AdjustmentStatusPendingApproval, AdjustmentStatusApplied, AdjustmentStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// ===========================
+// AdjustmentApprovalPolicy 核准規則值對象
+// ===========================
+
+// AdjustmentApprovalPolicy 雙人覆核（maker-checker）規則
+//
+// 業務規則：
+// - 單次調整點數絕對值超過門檻時，需由申請人以外的管理員核准後才入帳
+// - 門檻只適用於本身具核准權限的申請人；其他申請人的調整一律需核准（見 ForRequester）
+type AdjustmentApprovalPolicy struct {
+	threshold int
+}
+
+// NewAdjustmentApprovalPolicy 建構函數（threshold = 0 表示所有調整皆需核准）
+//
+// 錯誤：門檻為負數 → ErrInvalidApprovalThreshold
+func NewAdjustmentApprovalPolicy(threshold int) (AdjustmentApprovalPolicy, error) {
+	if threshold < 0 {
+		return AdjustmentApprovalPolicy{}, ErrInvalidApprovalThreshold.WithContext("threshold", threshold)
+	}
+	return AdjustmentApprovalPolicy{threshold: threshold}, nil
+}
+
+// Threshold 返回核准門檻
+func (p AdjustmentApprovalPolicy) Threshold() int {
+	return p.threshold
+}
+
+// ForRequester 依申請人是否具核准權限返回適用的規則（不具核准權限時門檻為 0，一律需核准）
+func (p AdjustmentApprovalPolicy) ForRequester(canApprove bool) AdjustmentApprovalPolicy {
+	if !canApprove {
+		return AdjustmentApprovalPolicy{threshold: 0}
+	}
+	return p
+}
+
+// RequiresApproval 判斷調整點數是否需要核准
+func (p AdjustmentApprovalPolicy) RequiresApproval(delta int) bool {
+	if delta < 0 {
+		delta = -delta
+	}
+	return delta > p.threshold
+}
+
+// ===========================
+// PointsAdjustment 聚合根
+// ===========================
+
+// PointsAdjustment 積分人工調整申請（同時作為審計紀錄）
+//
+// 職責：
+// - 記錄申請人、原因代碼、說明與調整點數（正數加點、負數扣點）
+// - 記錄核准 / 駁回的管理員與時間
+// - 入帳後記錄對應的積分帳戶事件 ID（points.adjusted）
+//
+// 不變量（Invariants）：
+// 1. 調整點數不為 0，說明與申請人必填
+// 2. 需核准的調整只能由申請人以外的管理員核准
+// 3. 只有 pending_approval 可核准 / 駁回 / 入帳
+//
+// 設計原則：
+// - 入帳由 PointsAccount.ApplyAdjustment 執行（同一事務保存兩個聚合）
+type PointsAdjustment struct {
+	adjustmentID AdjustmentID
+	accountID    AccountID
+	memberID     MemberID
+
+	delta            int
+	reason           AdjustmentReason
+	note             string
+	requiresApproval bool
+
+	status      AdjustmentStatus
+	requestedBy string
+	requestedAt time.Time
+	reviewedBy  string
+	reviewNote  string
+	reviewedAt  *time.Time
+
+	accountEventID string
+	appliedAt      *time.Time
+
+	updatedAt time.Time
+}
+
+// RequestPointsAdjustment 建立積分調整申請
+//
+// 參數：
+//   delta - 調整點數（正數加點、負數扣點）
+//   note - 說明（必填，最多 500 字）
+//   requestedBy - 申請的管理員（必填，審計用途）
+//   policy - 核准規則（決定是否需要第二位管理員核准）
+//
+// 錯誤：
+// - 點數為 0、說明或申請人空白、說明過長 → ErrInvalidAdjustment
+// - 原因代碼無效 → ErrInvalidAdjustmentReason
+func RequestPointsAdjustment(
+	account *PointsAccount,
+	delta int,
+	reason AdjustmentReason,
+	note string,
+	requestedBy string,
+	policy AdjustmentApprovalPolicy,
+	now time.Time,
+) (*PointsAdjustment, error) {
+	if !reason.IsValid() {
+		return nil, ErrInvalidAdjustmentReason.WithContext("reason", reason.String())
+	}
+
+	note = strings.TrimSpace(note)
+	requestedBy = strings.TrimSpace(requestedBy)
+	if delta == 0 || note == "" || requestedBy == "" {
+		return nil, ErrInvalidAdjustment.WithContext(
+			"delta", delta,
+			"note_provided", note != "",
+			"requested_by", requestedBy,
+		)
+	}
+	if utf8.RuneCountInString(note) > maxAdjustmentNoteLength {
+		return nil, ErrInvalidAdjustment.WithContext(
+			"note_length", utf8.RuneCountInString(note),
+			"max_length", maxAdjustmentNoteLength,
+		)
+	}
+
+	return &PointsAdjustment{
+		adjustmentID:     NewAdjustmentID(),
+		accountID:        account.AccountID(),
+		memberID:         account.MemberID(),
+		delta:            delta,
+		reason:           reason,
+		note:             note,
+		requiresApproval: policy.RequiresApproval(delta),
+		status:           AdjustmentStatusPendingApproval,
+		requestedBy:      requestedBy,
+		requestedAt:      now,
+		updatedAt:        now,
+	}, nil
+}
+
+// ReconstructPointsAdjustment 從持久化存儲重建積分調整
+//
+// 設計原則：僅供 Repository 使用
+func ReconstructPointsAdjustment(
+	adjustmentID AdjustmentID,
+	accountID AccountID,
+	memberID MemberID,
+	delta int,
+	reason AdjustmentReason,
+	note string,
+	requiresApproval bool,
+	status AdjustmentStatus,
+	requestedBy string,
+	requestedAt time.Time,
+	reviewedBy string,
+	reviewNote string,
+	reviewedAt *time.Time,
+	accountEventID string,
+	appliedAt *time.Time,
+	updatedAt time.Time,
+) (*PointsAdjustment, error) {
+	if adjustmentID.IsEmpty() || accountID.IsEmpty() || memberID.IsEmpty() {
+		return nil, ErrInvalidAdjustmentID.WithContext(
+			"reason", "invalid adjustment, account or member ID in database",
+		)
+	}
+	if !reason.IsValid() {
+		return nil, ErrInvalidAdjustmentReason.WithContext(
+			"reason", reason.String(),
+		)
+	}
+	if !status.IsValid() || delta == 0 {
+		return nil, ErrInvalidAdjustment.WithContext(
+			"status", status.String(),
+			"delta", delta,
+			"reason", "invalid adjustment in database",
+		)
+	}
+
+	return &PointsAdjustment{
+		adjustmentID:     adjustmentID,
+		accountID:        accountID,
+		memberID:         memberID,
+		delta:            delta,
+		reason:           reason,
+		note:             note,
+		requiresApproval: requiresApproval,
+		status:           status,
+		requestedBy:      requestedBy,
+		requestedAt:      requestedAt,
+		reviewedBy:       reviewedBy,
+		reviewNote:       reviewNote,
+		reviewedAt:       reviewedAt,
+		accountEventID:   accountEventID,
+		appliedAt:        appliedAt,
+		updatedAt:        updatedAt,
+	}, nil
+}
+
+// ===========================
+// 命令方法（狀態變更）
+// ===========================
+
+// Approve 核准調整（後續由 PointsAccount.ApplyAdjustment 入帳）
+//
+// 錯誤：
+// - 已處理 → ErrAdjustmentNotPending
+// - 核准人空白 → ErrInvalidAdjustment
+// - 核准人即申請人 → ErrAdjustmentSelfApproval
+func (a *PointsAdjustment) Approve(approvedBy, note string, now time.Time) error {
+	approvedBy, err := a.checkReviewable(approvedBy)
+	if err != nil {
+		return err
+	}
+	if approvedBy == a.requestedBy {
+		return ErrAdjustmentSelfApproval.WithContext(
+			"adjustment_id", a.adjustmentID.String(),
+			"requested_by", a.requestedBy,
+		)
+	}
+
+	a.reviewedBy = approvedBy
+	a.reviewNote = strings.TrimSpace(note)
+	a.reviewedAt = &now
+	a.updatedAt = now
+	return nil
+}
+
+// Reject 駁回調整（不異動積分；申請人撤回自己的申請見 Withdraw）
+//
+// 錯誤：
+// - 已處理 → ErrAdjustmentNotPending
+// - 駁回人空白 → ErrInvalidAdjustment
+func (a *PointsAdjustment) Reject(rejectedBy, note string, now time.Time) error {
+	rejectedBy, err := a.checkReviewable(rejectedBy)
+	if err != nil {
+		return err
+	}
+
+	a.status = AdjustmentStatusRejected
+	a.reviewedBy = rejectedBy
+	a.reviewNote = strings.TrimSpace(note)
+	a.reviewedAt = &now
+	a.updatedAt = now
+	return nil
+}
+
+// Withdraw 申請人撤回自己待核准的調整（狀態同駁回，審核人記為申請人）
+//
+// 錯誤：
+// - 已處理 → ErrAdjustmentNotPending
+// - 撤回人空白 → ErrInvalidAdjustment
+// - 撤回人不是申請人 → ErrAdjustmentNotRequester
+func (a *PointsAdjustment) Withdraw(requestedBy, note string, now time.Time) error {
+	requestedBy, err := a.checkReviewable(requestedBy)
+	if err != nil {
+		return err
+	}
+	if requestedBy != a.requestedBy {
+		return ErrAdjustmentNotRequester.WithContext(
+			"adjustment_id", a.adjustmentID.String(),
+			"requested_by", a.requestedBy,
+		)
+	}
+	return a.Reject(requestedBy, note, now)
+}
+
+// checkReviewable 檢查是否可核准 / 駁回，返回去除空白的審核人
+func (a *PointsAdjustment) checkReviewable(reviewer string) (string, error) {
+	if a.status != AdjustmentStatusPendingApproval {
+		return "", ErrAdjustmentNotPending.WithContext(
+			"adjustment_id", a.adjustmentID.String(),
+			"status", a.status.String(),
+		)
+	}
+	reviewer = strings.TrimSpace(reviewer)
+	if reviewer == "" {
+		return "", ErrInvalidAdjustment.WithContext(
+			"adjustment_id", a.adjustmentID.String(),
+			"reason", "reviewer is required",
+		)
+	}
+	return reviewer, nil
+}
+
+// checkApplicable 檢查是否可入帳（由 PointsAccount.ApplyAdjustment 呼叫）
+func (a *PointsAdjustment) checkApplicable(accountID AccountID) error {
+	if a.accountID != accountID {
+		return ErrAdjustmentAccountMismatch.WithContext(
+			"adjustment_id", a.adjustmentID.String(),
+			"account_id", accountID.String(),
+		)
+	}
+	if a.status != AdjustmentStatusPendingApproval {
+		return ErrAdjustmentNotPending.WithContext(
+			"adjustment_id", a.adjustmentID.String(),
+			"status", a.status.String(),
+		)
+	}
+	if a.requiresApproval && a.reviewedBy == "" {
+		return ErrAdjustmentApprovalRequired.WithContext(
+			"adjustment_id", a.adjustmentID.String(),
+			"delta", a.delta,
+		)
+	}
+	return nil
+}
+
+// markApplied 標記已入帳並記錄對應的帳戶事件
+func (a *PointsAdjustment) markApplied(accountEventID string, now time.Time) {
+	a.status = AdjustmentStatusApplied
+	a.accountEventID = accountEventID
+	a.appliedAt = &now
+	a.updatedAt = now
+}
+
+// ===========================
+// 查詢方法
+// ===========================
+
+// AdjustmentID 調整 ID
+func (a *PointsAdjustment) AdjustmentID() AdjustmentID {
+	return a.adjustmentID
+}
+
+// AccountID 積分帳戶 ID
+func (a *PointsAdjustment) AccountID() AccountID {
+	return a.accountID
+}
+
+// MemberID 會員 ID
+func (a *PointsAdjustment) MemberID() MemberID {
+	return a.memberID
+}
+
+// Delta 調整點數（正數加點、負數扣點）
+func (a *PointsAdjustment) Delta() int {
+	return a.delta
+}
+
+// Reason 原因代碼
+func (a *PointsAdjustment) Reason() AdjustmentReason {
+	return a.reason
+}
+
+// Note 說明
+func (a *PointsAdjustment) Note() string {
+	return a.note
+}
+
+// RequiresApproval 是否需要第二位管理員核准
+func (a *PointsAdjustment) RequiresApproval() bool {
+	return a.requiresApproval
+}
+
+// Status 狀態
+func (a *PointsAdjustment) Status() AdjustmentStatus {
+	return a.status
+}
+
+// RequestedBy 申請的管理員
+func (a *PointsAdjustment) RequestedBy() string {
+	return a.requestedBy
+}
+
+// RequestedAt 申請時間
+func (a *PointsAdjustment) RequestedAt() time.Time {
+	return a.requestedAt
+}
+
+// ReviewedBy 核准 / 駁回的管理員（未審核時為空字串）
+func (a *PointsAdjustment) ReviewedBy() string {
+	return a.reviewedBy
+}
+
+// ReviewNote 審核備註
+func (a *PointsAdjustment) ReviewNote() string {
+	return a.reviewNote
+}
+
+// ReviewedAt 審核時間（未審核時為 nil）
+func (a *PointsAdjustment) ReviewedAt() *time.Time {
+	return a.reviewedAt
+}
+
+// AccountEventID 入帳時的帳戶事件 ID（points.adjusted，未入帳時為空字串）
+func (a *PointsAdjustment) AccountEventID() string {
+	return a.accountEventID
+}
+
+// AppliedAt 入帳時間（未入帳時為 nil）
+func (a *PointsAdjustment) AppliedAt() *time.Time {
+	return a.appliedAt
+}
+
+// UpdatedAt 最後更新時間
+func (a *PointsAdjustment) UpdatedAt() time.Time {
+	return a.updatedAt
+}
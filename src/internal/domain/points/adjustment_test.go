@@ -0,0 +1,198 @@
+package points_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// PointsAdjustment 測試
+// ===========================
+
+// newAdjustmentTestAccount 建立已有 100 點的帳戶
+func newAdjustmentTestAccount(t *testing.T) *points.PointsAccount {
+	t.Helper()
+	account, err := points.NewPointsAccount(points.NewMemberID())
+	require.NoError(t, err)
+	amount, err := points.NewPointsAmount(100)
+	require.NoError(t, err)
+	require.NoError(t, account.EarnPoints(amount, points.PointsSourceInvoice, "tx-1", "消費"))
+	account.PullEvents()
+	return account
+}
+
+// Test 74: 未超過門檻的調整立即入帳，並記錄帳戶事件 ID
+func TestPointsAccount_ApplyAdjustment_BelowThreshold(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	account := newAdjustmentTestAccount(t)
+	policy, err := points.NewAdjustmentApprovalPolicy(50)
+	require.NoError(t, err)
+	credit, err := points.RequestPointsAdjustment(account, 50, points.AdjustmentReasonGoodwill, " 生日招待 ", "owner", policy, now)
+	require.NoError(t, err)
+	debit, err := points.RequestPointsAdjustment(account, -30, points.AdjustmentReasonCorrection, "重複入帳", "owner", policy, now)
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, account.ApplyAdjustment(credit, now))
+	require.NoError(t, account.ApplyAdjustment(debit, now))
+	events := account.PullEvents()
+
+	// Assert
+	assert.False(t, credit.RequiresApproval())
+	assert.Equal(t, "生日招待", credit.Note())
+	assert.Equal(t, 150, account.EarnedPoints().Value())
+	assert.Equal(t, 30, account.UsedPoints().Value())
+	assert.Equal(t, 120, account.GetAvailablePoints().Value())
+
+	require.Len(t, events, 2)
+	adjusted, ok := events[0].(*points.PointsAdjustedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "points.adjusted", adjusted.EventType())
+	assert.Equal(t, credit.AdjustmentID(), adjusted.AdjustmentID())
+	assert.Equal(t, 50, adjusted.Delta())
+	assert.Equal(t, points.AdjustmentStatusApplied, credit.Status())
+	assert.Equal(t, adjusted.EventID(), credit.AccountEventID())
+	assert.Equal(t, events[1].EventID(), debit.AccountEventID())
+	assert.Equal(t, now, *credit.AppliedAt())
+
+	assert.ErrorIs(t, account.ApplyAdjustment(credit, now), points.ErrAdjustmentNotPending)
+}
+
+// Test 75: 超過門檻需由另一位管理員核准後才可入帳
+func TestPointsAdjustment_MakerChecker(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	account := newAdjustmentTestAccount(t)
+	policy, err := points.NewAdjustmentApprovalPolicy(50)
+	require.NoError(t, err)
+	adjustment, err := points.RequestPointsAdjustment(account, -80, points.AdjustmentReasonFraudReversal, "詐騙追回", "alice", policy, now)
+	require.NoError(t, err)
+
+	// Act
+	beforeApproval := account.ApplyAdjustment(adjustment, now)
+	selfApproval := adjustment.Approve(" alice ", "", now)
+	require.NoError(t, adjustment.Approve("bob", "已確認", now.Add(time.Hour)))
+	require.NoError(t, account.ApplyAdjustment(adjustment, now.Add(time.Hour)))
+	events := account.PullEvents()
+
+	// Assert
+	assert.True(t, adjustment.RequiresApproval())
+	assert.ErrorIs(t, beforeApproval, points.ErrAdjustmentApprovalRequired)
+	assert.ErrorIs(t, selfApproval, points.ErrAdjustmentSelfApproval)
+	assert.Equal(t, "bob", adjustment.ReviewedBy())
+	assert.Equal(t, "已確認", adjustment.ReviewNote())
+	assert.Equal(t, points.AdjustmentStatusApplied, adjustment.Status())
+	assert.Equal(t, 20, account.GetAvailablePoints().Value())
+	require.Len(t, events, 1)
+	assert.Equal(t, "bob", events[0].(*points.PointsAdjustedEvent).ApprovedBy())
+	assert.Equal(t, "alice", events[0].(*points.PointsAdjustedEvent).RequestedBy())
+}
+
+// Test 76: 駁回後不可核准；扣點超過可用積分；調整不屬於此帳戶
+func TestPointsAdjustment_RejectAndGuards(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	account := newAdjustmentTestAccount(t)
+	other := newAdjustmentTestAccount(t)
+	policy, err := points.NewAdjustmentApprovalPolicy(points.DefaultAdjustmentApprovalThreshold)
+	require.NoError(t, err)
+	rejected, err := points.RequestPointsAdjustment(account, 1000, points.AdjustmentReasonGoodwill, "開幕活動", "alice", policy, now)
+	require.NoError(t, err)
+	overdraw, err := points.RequestPointsAdjustment(account, -101, points.AdjustmentReasonCorrection, "更正", "alice", policy, now)
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, rejected.Reject("alice", "金額錯誤，撤回", now))
+	approveAfterReject := rejected.Approve("bob", "", now)
+	insufficient := account.ApplyAdjustment(overdraw, now)
+	mismatch := other.ApplyAdjustment(overdraw, now)
+
+	// Assert
+	assert.Equal(t, points.AdjustmentStatusRejected, rejected.Status())
+	assert.Equal(t, "alice", rejected.ReviewedBy())
+	assert.Empty(t, rejected.AccountEventID())
+	assert.ErrorIs(t, approveAfterReject, points.ErrAdjustmentNotPending)
+	assert.ErrorIs(t, insufficient, points.ErrInsufficientPoints)
+	assert.ErrorIs(t, mismatch, points.ErrAdjustmentAccountMismatch)
+	assert.Equal(t, points.AdjustmentStatusPendingApproval, overdraw.Status())
+	assert.Equal(t, 100, account.GetAvailablePoints().Value())
+	assert.Empty(t, account.PullEvents())
+}
+
+// Test 77: 申請驗證（原因代碼、說明必填、點數不為 0、門檻不為負數）
+func TestRequestPointsAdjustment_Validation(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	account := newAdjustmentTestAccount(t)
+	policy, err := points.NewAdjustmentApprovalPolicy(0)
+	require.NoError(t, err)
+
+	// Act
+	_, badReason := points.RequestPointsAdjustment(account, 10, points.AdjustmentReason("because"), "說明", "owner", policy, now)
+	_, noNote := points.RequestPointsAdjustment(account, 10, points.AdjustmentReasonGoodwill, "  ", "owner", policy, now)
+	_, zero := points.RequestPointsAdjustment(account, 0, points.AdjustmentReasonGoodwill, "說明", "owner", policy, now)
+	_, noRequester := points.RequestPointsAdjustment(account, 10, points.AdjustmentReasonGoodwill, "說明", "", policy, now)
+	_, tooLong := points.RequestPointsAdjustment(account, 10, points.AdjustmentReasonGoodwill, strings.Repeat("字", 501), "owner", policy, now)
+	_, negativeThreshold := points.NewAdjustmentApprovalPolicy(-1)
+	parsed, parseErr := points.ParseAdjustmentReason("missed_invoice")
+
+	// Assert
+	assert.ErrorIs(t, badReason, points.ErrInvalidAdjustmentReason)
+	assert.ErrorIs(t, noNote, points.ErrInvalidAdjustment)
+	assert.ErrorIs(t, zero, points.ErrInvalidAdjustment)
+	assert.ErrorIs(t, noRequester, points.ErrInvalidAdjustment)
+	assert.ErrorIs(t, tooLong, points.ErrInvalidAdjustment)
+	assert.ErrorIs(t, negativeThreshold, points.ErrInvalidApprovalThreshold)
+	assert.True(t, policy.RequiresApproval(1))
+	assert.True(t, policy.RequiresApproval(-1))
+	require.NoError(t, parseErr)
+	assert.Equal(t, points.AdjustmentReasonMissedInvoice, parsed)
+}
+
+// Test 83: 門檻只適用於具核准權限的申請人；其他申請人的調整一律需核准
+func TestAdjustmentApprovalPolicy_ForRequester(t *testing.T) {
+	// Arrange
+	policy, err := points.NewAdjustmentApprovalPolicy(100)
+	require.NoError(t, err)
+
+	// Act
+	owner := policy.ForRequester(true)
+	staff := policy.ForRequester(false)
+
+	// Assert
+	assert.False(t, owner.RequiresApproval(80))
+	assert.True(t, owner.RequiresApproval(-101))
+	assert.True(t, staff.RequiresApproval(1))
+	assert.True(t, staff.RequiresApproval(-1))
+	assert.Equal(t, 100, policy.Threshold())
+}
+
+// Test 84: 只有申請人可撤回自己待核准的調整；撤回後狀態同駁回
+func TestPointsAdjustment_Withdraw(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	account := newAdjustmentTestAccount(t)
+	policy, err := points.NewAdjustmentApprovalPolicy(points.DefaultAdjustmentApprovalThreshold)
+	require.NoError(t, err)
+	adjustment, err := points.RequestPointsAdjustment(account, 1000, points.AdjustmentReasonGoodwill, "開幕活動", "alice", policy, now)
+	require.NoError(t, err)
+
+	// Act
+	notRequester := adjustment.Withdraw("bob", "", now)
+	require.NoError(t, adjustment.Withdraw(" alice ", "金額錯誤", now))
+	again := adjustment.Withdraw("alice", "", now)
+
+	// Assert
+	assert.ErrorIs(t, notRequester, points.ErrAdjustmentNotRequester)
+	assert.Equal(t, points.AdjustmentStatusRejected, adjustment.Status())
+	assert.Equal(t, "alice", adjustment.ReviewedBy())
+	assert.Equal(t, "金額錯誤", adjustment.ReviewNote())
+	assert.ErrorIs(t, again, points.ErrAdjustmentNotPending)
+	assert.Equal(t, 100, account.GetAvailablePoints().Value())
+}
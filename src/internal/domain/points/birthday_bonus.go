@@ -0,0 +1,119 @@
+package points
+
+import (
+	"fmt"
+	"time"
+)
+
+// ===========================
+// BirthdayBonusPolicy 生日禮規則值對象
+// ===========================
+
+// BirthdayBonusPolicy 生日禮規則
+//
+// 業務規則：會員生日當天（營業時區）贈送固定點數，每位會員每年一次
+type BirthdayBonusPolicy struct {
+	points PointsAmount
+}
+
+// NewBirthdayBonusPolicy 建構函數
+//
+// 錯誤：點數 <= 0 → ErrInvalidBirthdayBonus
+func NewBirthdayBonusPolicy(points int) (BirthdayBonusPolicy, error) {
+	if points <= 0 {
+		return BirthdayBonusPolicy{}, ErrInvalidBirthdayBonus.WithContext("points", points)
+	}
+	amount, err := NewPointsAmount(points)
+	if err != nil {
+		return BirthdayBonusPolicy{}, err
+	}
+	return BirthdayBonusPolicy{points: amount}, nil
+}
+
+// Points 返回生日禮點數
+func (p BirthdayBonusPolicy) Points() PointsAmount {
+	return p.points
+}
+
+// ===========================
+// BirthdayBonusGrant 生日禮發放紀錄
+// ===========================
+
+// BirthdayBonusGrant 生日禮發放紀錄（同時作為冪等鍵）
+//
+// 不變量（Invariants）：
+// 1. 每位會員每年（營業時區的年份）最多一筆，由 Repository 以 (member_id, year) 唯一約束保證
+// 2. 建立紀錄與入帳在同一事務（GrantBirthdayBonus）
+type BirthdayBonusGrant struct {
+	memberID  MemberID
+	accountID AccountID
+	year      int
+	points    PointsAmount
+	grantedAt time.Time
+}
+
+// GrantBirthdayBonus 發放生日禮：入帳到積分帳戶並建立發放紀錄
+//
+// 參數：
+//   account - 會員的積分帳戶
+//   policy - 生日禮規則
+//   year - 發放年份（營業時區）
+//   now - 發放時間
+//
+// 業務規則：
+// - EarnPoints(PointsSourceBirthday, "birthday-<year>")
+// - 凍結中的帳戶仍可累積積分（與發票積分一致）
+//
+// 返回：發放紀錄（由 Application Layer 與帳戶同一事務保存）
+func GrantBirthdayBonus(
+	account *PointsAccount,
+	policy BirthdayBonusPolicy,
+	year int,
+	now time.Time,
+) (*BirthdayBonusGrant, error) {
+	if policy.Points().IsZero() {
+		return nil, ErrInvalidBirthdayBonus.WithContext("reason", "policy is not configured")
+	}
+
+	if err := account.EarnPoints(
+		policy.Points(),
+		PointsSourceBirthday,
+		fmt.Sprintf("birthday-%d", year),
+		fmt.Sprintf("%d 年生日禮", year),
+	); err != nil {
+		return nil, err
+	}
+
+	return &BirthdayBonusGrant{
+		memberID:  account.MemberID(),
+		accountID: account.AccountID(),
+		year:      year,
+		points:    policy.Points(),
+		grantedAt: now,
+	}, nil
+}
+
+// MemberID 返回會員 ID
+func (g *BirthdayBonusGrant) MemberID() MemberID {
+	return g.memberID
+}
+
+// AccountID 返回入帳的積分帳戶 ID
+func (g *BirthdayBonusGrant) AccountID() AccountID {
+	return g.accountID
+}
+
+// Year 返回發放年份
+func (g *BirthdayBonusGrant) Year() int {
+	return g.year
+}
+
+// Points 返回發放點數
+func (g *BirthdayBonusGrant) Points() PointsAmount {
+	return g.points
+}
+
+// GrantedAt 返回發放時間
+func (g *BirthdayBonusGrant) GrantedAt() time.Time {
+	return g.grantedAt
+}
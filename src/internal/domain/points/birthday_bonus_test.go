@@ -0,0 +1,47 @@
+package points_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// BirthdayBonus 測試
+// ===========================
+
+// Test 81: 生日禮入帳並建立當年度發放紀錄；點數必須大於 0
+func TestGrantBirthdayBonus(t *testing.T) {
+	// Arrange
+	account, err := points.NewPointsAccount(points.NewMemberID())
+	require.NoError(t, err)
+	account.PullEvents()
+	policy, err := points.NewBirthdayBonusPolicy(50)
+	require.NoError(t, err)
+	now := time.Date(2025, 5, 20, 9, 0, 0, 0, time.UTC)
+
+	// Act
+	grant, err := points.GrantBirthdayBonus(account, policy, 2025, now)
+	_, zeroErr := points.NewBirthdayBonusPolicy(0)
+	_, unconfiguredErr := points.GrantBirthdayBonus(account, points.BirthdayBonusPolicy{}, 2025, now)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 50, account.EarnedPoints().Value())
+	assert.Equal(t, account.MemberID(), grant.MemberID())
+	assert.Equal(t, account.AccountID(), grant.AccountID())
+	assert.Equal(t, 2025, grant.Year())
+	assert.Equal(t, 50, grant.Points().Value())
+	assert.Equal(t, now, grant.GrantedAt())
+	events := account.PullEvents()
+	require.Len(t, events, 1)
+	earned, ok := events[0].(*points.PointsEarnedEvent)
+	require.True(t, ok)
+	assert.Equal(t, points.PointsSourceBirthday, earned.Source())
+	assert.Equal(t, "birthday-2025", earned.SourceID())
+	assert.ErrorIs(t, zeroErr, points.ErrInvalidBirthdayBonus)
+	assert.ErrorIs(t, unconfiguredErr, points.ErrInvalidBirthdayBonus)
+}
@@ -17,18 +17,46 @@ const (
 	ErrCodeInsufficientPoints   ErrorCode = "POINTS_INSUFFICIENT"
 
 	// 轉換率相關
-	ErrCodeInvalidConversionRate ErrorCode = "CONVERSION_RATE_INVALID"
+	ErrCodeInvalidConversionRate    ErrorCode = "CONVERSION_RATE_INVALID"
+	ErrCodeInvalidEarningMultiplier ErrorCode = "EARNING_MULTIPLIER_INVALID"
 
 	// 帳戶相關
 	ErrCodeInvalidAccountID   ErrorCode = "ACCOUNT_ID_INVALID"
 	ErrCodeInvalidMemberID    ErrorCode = "MEMBER_ID_INVALID"
 	ErrCodeInvariantViolation ErrorCode = "INVARIANT_VIOLATION"
 
+	// 帳戶凍結相關
+	ErrCodeAccountFrozen        ErrorCode = "ACCOUNT_FROZEN"
+	ErrCodeAccountNotFrozen     ErrorCode = "ACCOUNT_NOT_FROZEN"
+	ErrCodeInvalidAccountFreeze ErrorCode = "ACCOUNT_FREEZE_INVALID"
+
 	// 日期範圍相關
 	ErrCodeInvalidDateRange ErrorCode = "DATE_RANGE_INVALID"
 
 	// 積分來源相關
 	ErrCodeInvalidPointsSource ErrorCode = "POINTS_SOURCE_INVALID"
+
+	// 人工調整相關
+	ErrCodeInvalidAdjustmentID        ErrorCode = "POINTS_ADJUSTMENT_ID_INVALID"
+	ErrCodeInvalidAdjustment          ErrorCode = "POINTS_ADJUSTMENT_INVALID"
+	ErrCodeInvalidAdjustmentReason    ErrorCode = "POINTS_ADJUSTMENT_REASON_INVALID"
+	ErrCodeInvalidApprovalThreshold   ErrorCode = "POINTS_ADJUSTMENT_THRESHOLD_INVALID"
+	ErrCodeAdjustmentNotPending       ErrorCode = "POINTS_ADJUSTMENT_NOT_PENDING"
+	ErrCodeAdjustmentApprovalRequired ErrorCode = "POINTS_ADJUSTMENT_APPROVAL_REQUIRED"
+	ErrCodeAdjustmentSelfApproval     ErrorCode = "POINTS_ADJUSTMENT_SELF_APPROVAL"
+	ErrCodeAdjustmentNotRequester     ErrorCode = "POINTS_ADJUSTMENT_NOT_REQUESTER"
+	ErrCodeAdjustmentAccountMismatch  ErrorCode = "POINTS_ADJUSTMENT_ACCOUNT_MISMATCH"
+
+	// 帳戶合併相關
+	ErrCodeInvalidAccountMerge ErrorCode = "ACCOUNT_MERGE_INVALID"
+
+	// 生日禮相關
+	ErrCodeInvalidBirthdayBonus        ErrorCode = "BIRTHDAY_BONUS_INVALID"
+	ErrCodeBirthdayBonusAlreadyGranted ErrorCode = "BIRTHDAY_BONUS_ALREADY_GRANTED"
+
+	// 兌換獎勵相關
+	ErrCodeInvalidRewardID ErrorCode = "REWARD_ID_INVALID"
+	ErrCodeInvalidReward   ErrorCode = "REWARD_INVALID"
 )
 
 // ===========================
@@ -125,6 +153,11 @@ var (
 		Code:    ErrCodeInvalidConversionRate,
 		Message: "轉換率必須在 1-1000 之間",
 	}
+
+	ErrInvalidEarningMultiplier = &DomainError{
+		Code:    ErrCodeInvalidEarningMultiplier,
+		Message: "積分倍率必須在 1-10 之間",
+	}
 )
 
 // 帳戶相關錯誤
@@ -155,6 +188,24 @@ var (
 	}
 )
 
+// 帳戶凍結相關錯誤
+var (
+	ErrAccountFrozen = &DomainError{
+		Code:    ErrCodeAccountFrozen,
+		Message: "帳戶調查中，暫停使用積分",
+	}
+
+	ErrAccountNotFrozen = &DomainError{
+		Code:    ErrCodeAccountNotFrozen,
+		Message: "帳戶未被凍結",
+	}
+
+	ErrInvalidAccountFreeze = &DomainError{
+		Code:    ErrCodeInvalidAccountFreeze,
+		Message: "凍結帳戶必須提供原因與操作人員",
+	}
+)
+
 // 日期範圍相關錯誤
 var (
 	ErrInvalidDateRange = &DomainError{
@@ -170,3 +221,79 @@ var (
 		Message: "無效的積分來源",
 	}
 )
+
+// 人工調整相關錯誤
+var (
+	ErrInvalidAdjustmentID = &DomainError{
+		Code:    ErrCodeInvalidAdjustmentID,
+		Message: "無效的積分調整 ID",
+	}
+
+	ErrInvalidAdjustment = &DomainError{
+		Code:    ErrCodeInvalidAdjustment,
+		Message: "積分調整必須提供非零點數、說明與申請人員",
+	}
+
+	ErrInvalidAdjustmentReason = &DomainError{
+		Code:    ErrCodeInvalidAdjustmentReason,
+		Message: "無效的積分調整原因",
+	}
+
+	ErrInvalidApprovalThreshold = &DomainError{
+		Code:    ErrCodeInvalidApprovalThreshold,
+		Message: "積分調整核准門檻不能為負數",
+	}
+
+	ErrAdjustmentNotPending = &DomainError{
+		Code:    ErrCodeAdjustmentNotPending,
+		Message: "積分調整已處理，不可重複核准或駁回",
+	}
+
+	ErrAdjustmentApprovalRequired = &DomainError{
+		Code:    ErrCodeAdjustmentApprovalRequired,
+		Message: "積分調整超過門檻，需經另一位管理員核准",
+	}
+
+	ErrAdjustmentSelfApproval = &DomainError{
+		Code:    ErrCodeAdjustmentSelfApproval,
+		Message: "不可核准自己申請的積分調整",
+	}
+
+	ErrAdjustmentNotRequester = &DomainError{
+		Code:    ErrCodeAdjustmentNotRequester,
+		Message: "只能撤回自己申請的積分調整",
+	}
+
+	ErrAdjustmentAccountMismatch = &DomainError{
+		Code:    ErrCodeAdjustmentAccountMismatch,
+		Message: "積分調整不屬於此帳戶",
+	}
+
+	ErrInvalidAccountMerge = &DomainError{
+		Code:    ErrCodeInvalidAccountMerge,
+		Message: "積分帳戶無法合併",
+	}
+
+	ErrInvalidBirthdayBonus = &DomainError{
+		Code:    ErrCodeInvalidBirthdayBonus,
+		Message: "生日禮點數必須大於 0",
+	}
+
+	ErrBirthdayBonusAlreadyGranted = &DomainError{
+		Code:    ErrCodeBirthdayBonusAlreadyGranted,
+		Message: "會員今年已領取生日禮",
+	}
+)
+
+// 兌換獎勵相關錯誤
+var (
+	ErrInvalidRewardID = &DomainError{
+		Code:    ErrCodeInvalidRewardID,
+		Message: "無效的獎勵 ID",
+	}
+
+	ErrInvalidReward = &DomainError{
+		Code:    ErrCodeInvalidReward,
+		Message: "獎勵必須提供名稱與大於 0 的兌換點數",
+	}
+)
@@ -12,10 +12,10 @@ import (
 
 // PointsAccountCreatedEvent 積分帳戶創建事件
 type PointsAccountCreatedEvent struct {
-	eventID     string
-	accountID   AccountID
-	memberID    MemberID
-	occurredAt  time.Time
+	eventID    string
+	accountID  AccountID
+	memberID   MemberID
+	occurredAt time.Time
 }
 
 // NewPointsAccountCreatedEvent 創建帳戶創建事件
@@ -294,3 +294,289 @@ func (e *PointsRecalculatedEvent) ConversionRate() int {
 func (e *PointsRecalculatedEvent) TriggeredBy() string {
 	return e.triggeredBy
 }
+
+// ===========================
+// PointsAccountFrozen 領域事件
+// ===========================
+
+// PointsAccountFrozenEvent 積分帳戶已凍結事件
+type PointsAccountFrozenEvent struct {
+	eventID    string
+	accountID  AccountID
+	reason     string
+	frozenBy   string
+	occurredAt time.Time
+}
+
+// NewPointsAccountFrozenEvent 創建帳戶已凍結事件
+func NewPointsAccountFrozenEvent(
+	accountID AccountID,
+	reason string,
+	frozenBy string,
+) *PointsAccountFrozenEvent {
+	return &PointsAccountFrozenEvent{
+		eventID:    uuid.New().String(),
+		accountID:  accountID,
+		reason:     reason,
+		frozenBy:   frozenBy,
+		occurredAt: time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *PointsAccountFrozenEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *PointsAccountFrozenEvent) EventType() string {
+	return "points.account_frozen"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *PointsAccountFrozenEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *PointsAccountFrozenEvent) AggregateID() string {
+	return e.accountID.String()
+}
+
+// AccountID 獲取帳戶 ID
+func (e *PointsAccountFrozenEvent) AccountID() AccountID {
+	return e.accountID
+}
+
+// Reason 獲取凍結原因
+func (e *PointsAccountFrozenEvent) Reason() string {
+	return e.reason
+}
+
+// FrozenBy 獲取凍結操作人員
+func (e *PointsAccountFrozenEvent) FrozenBy() string {
+	return e.frozenBy
+}
+
+// ===========================
+// PointsAccountUnfrozen 領域事件
+// ===========================
+
+// PointsAccountUnfrozenEvent 積分帳戶已解除凍結事件
+type PointsAccountUnfrozenEvent struct {
+	eventID    string
+	accountID  AccountID
+	releasedBy string
+	occurredAt time.Time
+}
+
+// NewPointsAccountUnfrozenEvent 創建帳戶已解除凍結事件
+func NewPointsAccountUnfrozenEvent(accountID AccountID, releasedBy string) *PointsAccountUnfrozenEvent {
+	return &PointsAccountUnfrozenEvent{
+		eventID:    uuid.New().String(),
+		accountID:  accountID,
+		releasedBy: releasedBy,
+		occurredAt: time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *PointsAccountUnfrozenEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *PointsAccountUnfrozenEvent) EventType() string {
+	return "points.account_unfrozen"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *PointsAccountUnfrozenEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *PointsAccountUnfrozenEvent) AggregateID() string {
+	return e.accountID.String()
+}
+
+// AccountID 獲取帳戶 ID
+func (e *PointsAccountUnfrozenEvent) AccountID() AccountID {
+	return e.accountID
+}
+
+// ReleasedBy 獲取解除凍結操作人員
+func (e *PointsAccountUnfrozenEvent) ReleasedBy() string {
+	return e.releasedBy
+}
+
+// ===========================
+// PointsAdjusted 領域事件
+// ===========================
+
+// PointsAdjustedEvent 積分已人工調整事件
+//
+// 審計：事件 ID 記錄在 PointsAdjustment.AccountEventID（申請紀錄 ↔ 帳戶異動）
+type PointsAdjustedEvent struct {
+	eventID      string
+	accountID    AccountID
+	adjustmentID AdjustmentID
+	delta        int
+	reason       AdjustmentReason
+	requestedBy  string
+	approvedBy   string // 未超過門檻、不需核准時為空字串
+	occurredAt   time.Time
+}
+
+// NewPointsAdjustedEvent 創建積分已人工調整事件
+func NewPointsAdjustedEvent(
+	accountID AccountID,
+	adjustmentID AdjustmentID,
+	delta int,
+	reason AdjustmentReason,
+	requestedBy string,
+	approvedBy string,
+) *PointsAdjustedEvent {
+	return &PointsAdjustedEvent{
+		eventID:      uuid.New().String(),
+		accountID:    accountID,
+		adjustmentID: adjustmentID,
+		delta:        delta,
+		reason:       reason,
+		requestedBy:  requestedBy,
+		approvedBy:   approvedBy,
+		occurredAt:   time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *PointsAdjustedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *PointsAdjustedEvent) EventType() string {
+	return "points.adjusted"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *PointsAdjustedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *PointsAdjustedEvent) AggregateID() string {
+	return e.accountID.String()
+}
+
+// AccountID 獲取帳戶 ID
+func (e *PointsAdjustedEvent) AccountID() AccountID {
+	return e.accountID
+}
+
+// AdjustmentID 獲取調整申請 ID
+func (e *PointsAdjustedEvent) AdjustmentID() AdjustmentID {
+	return e.adjustmentID
+}
+
+// Delta 獲取調整點數（正數加點、負數扣點）
+func (e *PointsAdjustedEvent) Delta() int {
+	return e.delta
+}
+
+// Reason 獲取原因代碼
+func (e *PointsAdjustedEvent) Reason() AdjustmentReason {
+	return e.reason
+}
+
+// RequestedBy 獲取申請的管理員
+func (e *PointsAdjustedEvent) RequestedBy() string {
+	return e.requestedBy
+}
+
+// ApprovedBy 獲取核准的管理員
+func (e *PointsAdjustedEvent) ApprovedBy() string {
+	return e.approvedBy
+}
+
+// ===========================
+// PointsAccountMerged 領域事件
+// ===========================
+
+// PointsAccountMergedEvent 積分帳戶已合併事件（重複會員合併）
+//
+// 事件發布於保留的帳戶；mergeID 對應會員合併稽核紀錄
+type PointsAccountMergedEvent struct {
+	eventID         string
+	accountID       AccountID
+	sourceAccountID AccountID
+	mergeID         string
+	earnedPoints    int
+	usedPoints      int
+	occurredAt      time.Time
+}
+
+// NewPointsAccountMergedEvent 創建積分帳戶已合併事件
+func NewPointsAccountMergedEvent(
+	accountID AccountID,
+	sourceAccountID AccountID,
+	mergeID string,
+	earnedPoints int,
+	usedPoints int,
+	occurredAt time.Time,
+) *PointsAccountMergedEvent {
+	return &PointsAccountMergedEvent{
+		eventID:         uuid.New().String(),
+		accountID:       accountID,
+		sourceAccountID: sourceAccountID,
+		mergeID:         mergeID,
+		earnedPoints:    earnedPoints,
+		usedPoints:      usedPoints,
+		occurredAt:      occurredAt,
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *PointsAccountMergedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *PointsAccountMergedEvent) EventType() string {
+	return "points.account_merged"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *PointsAccountMergedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *PointsAccountMergedEvent) AggregateID() string {
+	return e.accountID.String()
+}
+
+// AccountID 獲取保留的帳戶 ID
+func (e *PointsAccountMergedEvent) AccountID() AccountID {
+	return e.accountID
+}
+
+// SourceAccountID 獲取被併入的帳戶 ID
+func (e *PointsAccountMergedEvent) SourceAccountID() AccountID {
+	return e.sourceAccountID
+}
+
+// MergeID 獲取會員合併紀錄 ID
+func (e *PointsAccountMergedEvent) MergeID() string {
+	return e.mergeID
+}
+
+// EarnedPoints 獲取移入的累積獲得積分
+func (e *PointsAccountMergedEvent) EarnedPoints() int {
+	return e.earnedPoints
+}
+
+// UsedPoints 獲取移入的累積使用積分
+func (e *PointsAccountMergedEvent) UsedPoints() int {
+	return e.usedPoints
+}
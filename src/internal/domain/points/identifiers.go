@@ -98,6 +98,50 @@ func MemberIDFromString(s string) (MemberID, error) {
 	return shared.EntityIDFromString[MemberMarker](s, ErrInvalidMemberID)
 }
 
+// ===========================
+// AdjustmentID - 積分人工調整 ID
+// ===========================
+
+// AdjustmentMarker 是 AdjustmentID 的標記類型
+type AdjustmentMarker struct{}
+
+// AdjustmentID 積分人工調整的唯一標識符
+type AdjustmentID = shared.EntityID[AdjustmentMarker]
+
+// NewAdjustmentID 生成新的積分調整 ID（UUID v4）
+func NewAdjustmentID() AdjustmentID {
+	return shared.NewEntityID[AdjustmentMarker]()
+}
+
+// AdjustmentIDFromString 從字串解析積分調整 ID
+//
+// 錯誤：解析失敗返回 ErrInvalidAdjustmentID
+func AdjustmentIDFromString(s string) (AdjustmentID, error) {
+	return shared.EntityIDFromString[AdjustmentMarker](s, ErrInvalidAdjustmentID)
+}
+
+// ===========================
+// RewardID - 兌換獎勵 ID
+// ===========================
+
+// RewardMarker 是 RewardID 的標記類型
+type RewardMarker struct{}
+
+// RewardID 兌換目錄中獎勵的唯一標識符
+type RewardID = shared.EntityID[RewardMarker]
+
+// NewRewardID 生成新的獎勵 ID（UUID v4）
+func NewRewardID() RewardID {
+	return shared.NewEntityID[RewardMarker]()
+}
+
+// RewardIDFromString 從字串解析獎勵 ID
+//
+// 錯誤：解析失敗返回 ErrInvalidRewardID
+func RewardIDFromString(s string) (RewardID, error) {
+	return shared.EntityIDFromString[RewardMarker](s, ErrInvalidRewardID)
+}
+
 // ===========================
 // 設計優勢說明
 // ===========================
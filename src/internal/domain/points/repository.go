@@ -67,7 +67,9 @@ type PointsAccountRepository interface {
 	//
 	// 前置條件：帳戶已存在
 	// 後置條件：帳戶狀態已更新
-	// 錯誤：ErrAccountNotFound（如果帳戶不存在）
+	// 錯誤：
+	// - ErrAccountNotFound（如果帳戶不存在）
+	// - ErrAccountVersionConflict（載入後已被其他操作更新，樂觀鎖）
 	Update(ctx shared.TransactionContext, account *PointsAccount) error
 }
 
@@ -118,15 +120,101 @@ type PointsAccountAdminRepository interface {
 	// 當前為空，等待實作狀態管理方法
 }
 
+// ===========================
+// PointsAdjustment Repository 介面
+// ===========================
+
+// PointsAdjustmentRepository 積分人工調整倉儲介面
+//
+// 設計原則：
+// 1. Save 為 Upsert（申請、核准、駁回皆保存同一筆紀錄，作為審計軌跡）
+// 2. 事務支持：寫操作 ctx 必須 non-nil，讀操作 ctx 可為 nil
+type PointsAdjustmentRepository interface {
+	// Save 保存積分調整（新增或更新）
+	Save(ctx shared.TransactionContext, adjustment *PointsAdjustment) error
+
+	// FindByID 根據 ID 查詢積分調整
+	//
+	// 返回：找到的調整，或 ErrAdjustmentNotFound
+	FindByID(ctx shared.TransactionContext, adjustmentID AdjustmentID) (*PointsAdjustment, error)
+
+	// FindByStatus 查詢指定狀態的積分調整（依申請時間排序，最多 limit 筆）
+	FindByStatus(ctx shared.TransactionContext, status AdjustmentStatus, limit int) ([]*PointsAdjustment, error)
+
+	// FindByMemberID 查詢會員的積分調整紀錄（依申請時間新到舊，最多 limit 筆）
+	FindByMemberID(ctx shared.TransactionContext, memberID MemberID, limit int) ([]*PointsAdjustment, error)
+
+	// FindByRequestedBy 查詢指定管理員申請的積分調整（依申請時間新到舊，最多 limit 筆）
+	FindByRequestedBy(ctx shared.TransactionContext, requestedBy string, limit int) ([]*PointsAdjustment, error)
+}
+
+// ===========================
+// Reward Repository 介面
+// ===========================
+
+// RewardRepository 兌換目錄倉儲介面
+//
+// 設計原則：
+// 1. Save 為 Upsert（新增或更新）
+// 2. 事務支持：寫操作 ctx 必須 non-nil，讀操作 ctx 可為 nil
+type RewardRepository interface {
+	// Save 保存獎勵（新增或更新）
+	Save(ctx shared.TransactionContext, reward *Reward) error
+
+	// FindByID 根據 ID 查詢獎勵
+	//
+	// 返回：找到的獎勵，或 ErrRewardNotFound
+	FindByID(ctx shared.TransactionContext, rewardID RewardID) (*Reward, error)
+
+	// FindAll 查詢兌換目錄（依兌換點數由低到高）
+	FindAll(ctx shared.TransactionContext) ([]*Reward, error)
+}
+
+// ===========================
+// BirthdayBonusGrant Repository 介面
+// ===========================
+
+// BirthdayBonusGrantRepository 生日禮發放紀錄倉儲介面
+//
+// 設計原則：
+// 1. 僅新增（發放紀錄不修改、不刪除）
+// 2. (member_id, year) 唯一約束保證排程重跑或並行執行時不重複發放
+type BirthdayBonusGrantRepository interface {
+	// Save 新增發放紀錄（ctx 必須 non-nil，與積分入帳同一事務）
+	//
+	// 錯誤：會員當年度已有紀錄 → ErrBirthdayBonusAlreadyGranted
+	Save(ctx shared.TransactionContext, grant *BirthdayBonusGrant) error
+
+	// ExistsForYear 檢查會員當年度是否已發放
+	ExistsForYear(ctx shared.TransactionContext, memberID MemberID, year int) (bool, error)
+}
+
+// ===========================
+// EarningMultiplierQuery 查詢介面
+// ===========================
+
+// EarningMultiplierQuery 會員積分倍率查詢（唯讀）
+//
+// 設計原則：
+// - 倍率由會員等級決定（由會員等級上下文實作）
+// - 積分計算只依賴倍率，不依賴等級規則
+type EarningMultiplierQuery interface {
+	// MultiplierFor 返回會員目前的積分倍率（尚未評估等級的會員返回基本倍率）
+	MultiplierFor(ctx shared.TransactionContext, memberID MemberID) (EarningMultiplier, error)
+}
+
 // ===========================
 // Repository 錯誤定義
 // ===========================
 
 // Repository 相關錯誤代碼
 const (
-	ErrCodeAccountNotFound      ErrorCode = "ACCOUNT_NOT_FOUND"
-	ErrCodeAccountAlreadyExists ErrorCode = "ACCOUNT_ALREADY_EXISTS"
-	ErrCodeRepositoryError      ErrorCode = "REPOSITORY_ERROR"
+	ErrCodeAccountNotFound        ErrorCode = "ACCOUNT_NOT_FOUND"
+	ErrCodeAccountAlreadyExists   ErrorCode = "ACCOUNT_ALREADY_EXISTS"
+	ErrCodeAccountVersionConflict ErrorCode = "ACCOUNT_VERSION_CONFLICT"
+	ErrCodeRepositoryError        ErrorCode = "REPOSITORY_ERROR"
+	ErrCodeAdjustmentNotFound     ErrorCode = "POINTS_ADJUSTMENT_NOT_FOUND"
+	ErrCodeRewardNotFound         ErrorCode = "REWARD_NOT_FOUND"
 )
 
 // Repository 錯誤實例
@@ -143,6 +231,26 @@ var (
 		Message: "積分帳戶已存在",
 	}
 
+	// ErrAccountVersionConflict 帳戶已被其他操作更新（樂觀鎖衝突）
+	//
+	// 處理方式：重新載入帳戶後再試一次（例如同時進行的合併、調整核准、獎勵入帳）
+	ErrAccountVersionConflict = &DomainError{
+		Code:    ErrCodeAccountVersionConflict,
+		Message: "積分帳戶已被其他操作更新，請重新再試",
+	}
+
+	// ErrAdjustmentNotFound 積分調整不存在
+	ErrAdjustmentNotFound = &DomainError{
+		Code:    ErrCodeAdjustmentNotFound,
+		Message: "積分調整不存在",
+	}
+
+	// ErrRewardNotFound 獎勵不存在
+	ErrRewardNotFound = &DomainError{
+		Code:    ErrCodeRewardNotFound,
+		Message: "兌換獎勵不存在",
+	}
+
 	// ErrRepositoryError 倉儲操作錯誤（通用）
 	ErrRepositoryError = &DomainError{
 		Code:    ErrCodeRepositoryError,
@@ -0,0 +1,134 @@
+package points
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxRewardNameLength 獎勵名稱最大長度（字元數）
+const maxRewardNameLength = 100
+
+// ===========================
+// Reward 聚合根
+// ===========================
+
+// Reward 兌換目錄中的獎勵（以積分兌換的商品 / 招待）
+//
+// 職責：
+// - 保存獎勵名稱與兌換所需點數
+// - 標記是否為酒類獎勵（兌換前需確認會員已驗證成年）
+//
+// 業務規則：
+// - 名稱必填（最多 100 字），兌換點數必須大於 0
+// - 酒類獎勵不得兌換給未驗證成年的會員（成年驗證屬會員上下文，由 Application Layer 檢查）
+//
+// 設計原則：
+// - 兌換扣點由 PointsAccount.DeductPoints 執行（發布 points.deducted）
+type Reward struct {
+	rewardID   RewardID
+	name       string
+	pointsCost PointsAmount
+	alcoholic  bool
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+// NewReward 建立獎勵
+//
+// 參數：
+//   name - 獎勵名稱（必填，最多 100 字）
+//   pointsCost - 兌換所需點數（必須大於 0）
+//   alcoholic - 是否為酒類獎勵
+//
+// 錯誤：名稱空白或過長、點數 <= 0 → ErrInvalidReward
+func NewReward(name string, pointsCost int, alcoholic bool, now time.Time) (*Reward, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxRewardNameLength || pointsCost <= 0 {
+		return nil, ErrInvalidReward.WithContext(
+			"name_length", utf8.RuneCountInString(name),
+			"points_cost", pointsCost,
+		)
+	}
+
+	return &Reward{
+		rewardID:   NewRewardID(),
+		name:       name,
+		pointsCost: newPointsAmountUnchecked(pointsCost),
+		alcoholic:  alcoholic,
+		createdAt:  now,
+		updatedAt:  now,
+	}, nil
+}
+
+// ReconstructReward 從持久化存儲重建獎勵
+//
+// 設計原則：僅供 Repository 使用
+func ReconstructReward(
+	rewardID RewardID,
+	name string,
+	pointsCost int,
+	alcoholic bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*Reward, error) {
+	if rewardID.IsEmpty() {
+		return nil, ErrInvalidRewardID.WithContext("reason", "invalid reward ID in database")
+	}
+	cost, err := NewPointsAmount(pointsCost)
+	if err != nil {
+		return nil, err
+	}
+	return &Reward{
+		rewardID:   rewardID,
+		name:       name,
+		pointsCost: cost,
+		alcoholic:  alcoholic,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}, nil
+}
+
+// RequiresVerifiedAdult 判斷兌換前是否需確認會員已驗證成年（酒類獎勵）
+func (r *Reward) RequiresVerifiedAdult() bool {
+	return r.alcoholic
+}
+
+// RedemptionReason 兌換扣點的原因說明（寫入 points.deducted 事件）
+func (r *Reward) RedemptionReason() string {
+	return "兌換獎勵：" + r.name
+}
+
+// ===========================
+// Getters
+// ===========================
+
+// RewardID 獎勵 ID
+func (r *Reward) RewardID() RewardID {
+	return r.rewardID
+}
+
+// Name 獎勵名稱
+func (r *Reward) Name() string {
+	return r.name
+}
+
+// PointsCost 兌換所需點數
+func (r *Reward) PointsCost() PointsAmount {
+	return r.pointsCost
+}
+
+// Alcoholic 是否為酒類獎勵
+func (r *Reward) Alcoholic() bool {
+	return r.alcoholic
+}
+
+// CreatedAt 建立時間
+func (r *Reward) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt 更新時間
+func (r *Reward) UpdatedAt() time.Time {
+	return r.updatedAt
+}
@@ -0,0 +1,41 @@
+package points_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// Reward 測試
+// ===========================
+
+// Test 82: 建立獎勵（名稱必填、點數大於 0；酒類獎勵需驗證成年）
+func TestNewReward(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// Act
+	beer, err := points.NewReward(" 生啤酒一杯 ", 120, true, now)
+	require.NoError(t, err)
+	fries, err := points.NewReward("薯條", 80, false, now)
+	require.NoError(t, err)
+	_, noName := points.NewReward("  ", 80, false, now)
+	_, zeroCost := points.NewReward("薯條", 0, false, now)
+	_, tooLong := points.NewReward(strings.Repeat("酒", 101), 80, true, now)
+
+	// Assert
+	assert.Equal(t, "生啤酒一杯", beer.Name())
+	assert.Equal(t, 120, beer.PointsCost().Value())
+	assert.True(t, beer.RequiresVerifiedAdult())
+	assert.Equal(t, "兌換獎勵：生啤酒一杯", beer.RedemptionReason())
+	assert.False(t, fries.RequiresVerifiedAdult())
+	assert.False(t, beer.RewardID().IsEmpty())
+	assert.ErrorIs(t, noName, points.ErrInvalidReward)
+	assert.ErrorIs(t, zeroCost, points.ErrInvalidReward)
+	assert.ErrorIs(t, tooLong, points.ErrInvalidReward)
+}
@@ -60,6 +60,28 @@ func (s *PointsCalculationService) CalculateFromAmount(
 	return NewPointsAmount(int(pointsValue))
 }
 
+// CalculateWithMultiplier 根據消費金額、轉換率與積分倍率計算積分
+//
+// 業務規則：
+// - 積分 = floor(金額 / 轉換率 × 倍率)
+// - 倍率為 1 時與 CalculateFromAmount 結果相同
+//
+// 使用場景：發票入帳時依會員等級加成（倍率由 EarningMultiplierQuery 提供）
+func (s *PointsCalculationService) CalculateWithMultiplier(
+	amount decimal.Decimal,
+	rate ConversionRate,
+	multiplier EarningMultiplier,
+) (PointsAmount, error) {
+	rateValue := decimal.NewFromInt(int64(rate.Value()))
+
+	pointsValue := amount.Mul(multiplier.Value()).Div(rateValue).Floor().IntPart()
+	if pointsValue < 0 {
+		pointsValue = 0
+	}
+
+	return NewPointsAmount(int(pointsValue))
+}
+
 // ===========================
 // 設計決策說明
 // ===========================
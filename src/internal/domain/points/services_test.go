@@ -6,6 +6,7 @@ import (
 	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // ===== PointsCalculationService 測試 =====
@@ -165,3 +166,25 @@ func TestPointsCalculationService_Stateless(t *testing.T) {
 	assert.Equal(t, 1, result1.Value())
 	assert.Equal(t, 2, result2.Value())
 }
+
+// Test 80: CalculateWithMultiplier 依倍率加成後向下取整，倍率超出範圍時拒絕
+func TestPointsCalculationService_CalculateWithMultiplier(t *testing.T) {
+	// Arrange
+	service := points.NewPointsCalculationService()
+	rate, _ := points.NewConversionRate(100)
+	amount, _ := decimal.NewFromString("1250")
+	gold, err := points.NewEarningMultiplier(decimal.RequireFromString("1.5"))
+	require.NoError(t, err)
+
+	// Act
+	boosted, err := service.CalculateWithMultiplier(amount, rate, gold)
+	require.NoError(t, err)
+	base, err := service.CalculateWithMultiplier(amount, rate, points.BaseEarningMultiplier())
+	require.NoError(t, err)
+	_, belowOne := points.NewEarningMultiplier(decimal.RequireFromString("0.5"))
+
+	// Assert
+	assert.Equal(t, 18, boosted.Value(), "floor(1250 / 100 × 1.5) = 18")
+	assert.Equal(t, 12, base.Value(), "基本倍率與 CalculateFromAmount 相同")
+	assert.ErrorIs(t, belowOne, points.ErrInvalidEarningMultiplier)
+}
@@ -1,7 +1,10 @@
 package points
 
 import (
+	"strings"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // PointsAmount 積分數量值對象
@@ -122,6 +125,51 @@ func (r ConversionRate) Equals(other ConversionRate) bool {
 	return r.value == other.value
 }
 
+// ===========================
+// EarningMultiplier 積分倍率值對象
+// ===========================
+
+// 積分倍率範圍
+var (
+	minEarningMultiplier = decimal.NewFromInt(1)
+	maxEarningMultiplier = decimal.NewFromInt(10)
+)
+
+// EarningMultiplier 積分倍率值對象（例如會員等級加成 1.5 倍）
+// 建構約束：範圍 1-10（倍率只加成，不減少基本積分）
+type EarningMultiplier struct {
+	value decimal.Decimal
+}
+
+// NewEarningMultiplier 建構函數
+func NewEarningMultiplier(value decimal.Decimal) (EarningMultiplier, error) {
+	if value.LessThan(minEarningMultiplier) || value.GreaterThan(maxEarningMultiplier) {
+		return EarningMultiplier{}, ErrInvalidEarningMultiplier.WithContext(
+			"attempted_value", value.String(),
+			"constraint", "1-10",
+		)
+	}
+	return EarningMultiplier{value: value}, nil
+}
+
+// BaseEarningMultiplier 基本倍率（1 倍，無加成）
+func BaseEarningMultiplier() EarningMultiplier {
+	return EarningMultiplier{value: minEarningMultiplier}
+}
+
+// Value 返回倍率（零值視為 1 倍）
+func (m EarningMultiplier) Value() decimal.Decimal {
+	if m.value.IsZero() {
+		return minEarningMultiplier
+	}
+	return m.value
+}
+
+// Equals 比較兩個倍率是否相同
+func (m EarningMultiplier) Equals(other EarningMultiplier) bool {
+	return m.Value().Equal(other.Value())
+}
+
 // NOTE: CalculatePoints 方法已移除
 // 原因：違反依賴倒置原則（DIP）- ConversionRate 不應依賴 PointsAmount
 // 替代方案：使用 PointsCalculationService.CalculateFromAmount()
@@ -247,6 +295,7 @@ func (dr DateRange) Overlaps(other DateRange) bool {
 // - V3.2: Redemption（積分兌換）
 // - V3.3: Expiration（積分過期）
 // - V4.0: Transfer（積分轉讓）
+// - Birthday（生日禮）
 type PointsSource int
 
 const (
@@ -256,6 +305,7 @@ const (
 	PointsSourceRedemption                     // 兌換：使用積分兌換商品（負積分）（V3.2+）
 	PointsSourceExpiration                     // 過期：積分過期扣除（負積分）（V3.3+）
 	PointsSourceTransfer                       // 轉讓：積分轉讓給他人（V4.0+）
+	PointsSourceBirthday                       // 生日禮：每年生日當天贈送積分
 )
 
 // String 返回積分來源的字符串表示（僅用於調試和日誌）
@@ -290,6 +340,8 @@ func (s PointsSource) String() string {
 		return "PointsSource(Expiration)"
 	case PointsSourceTransfer:
 		return "PointsSource(Transfer)"
+	case PointsSourceBirthday:
+		return "PointsSource(Birthday)"
 	default:
 		return "PointsSource(Unknown)"
 	}
@@ -306,5 +358,48 @@ func (s PointsSource) String() string {
 // - true: 枚舉值在有效範圍內（不包含 Undefined）
 // - false: 枚舉值無效（包括 Undefined 和超出範圍的值）
 func (s PointsSource) IsValid() bool {
-	return s >= PointsSourceInvoice && s <= PointsSourceTransfer
+	return s >= PointsSourceInvoice && s <= PointsSourceBirthday
+}
+
+// ===========================
+// AccountFreeze 帳戶凍結值對象
+// ===========================
+
+// AccountFreeze 帳戶凍結資訊（調查期間暫停使用積分）
+//
+// 業務規則：
+// - 必須記錄凍結原因與操作人員（審計用途）
+// - 凍結期間不可扣減積分（DeductPoints），但仍可累積積分
+type AccountFreeze struct {
+	reason   string
+	frozenBy string
+	frozenAt time.Time
+}
+
+// NewAccountFreeze 建構函數（checked 版本）
+func NewAccountFreeze(reason, frozenBy string, frozenAt time.Time) (AccountFreeze, error) {
+	reason = strings.TrimSpace(reason)
+	frozenBy = strings.TrimSpace(frozenBy)
+	if reason == "" || frozenBy == "" {
+		return AccountFreeze{}, ErrInvalidAccountFreeze.WithContext(
+			"reason", reason,
+			"frozen_by", frozenBy,
+		)
+	}
+	return AccountFreeze{reason: reason, frozenBy: frozenBy, frozenAt: frozenAt}, nil
+}
+
+// Reason 返回凍結原因
+func (f AccountFreeze) Reason() string {
+	return f.reason
+}
+
+// FrozenBy 返回凍結操作人員
+func (f AccountFreeze) FrozenBy() string {
+	return f.frozenBy
+}
+
+// FrozenAt 返回凍結時間
+func (f AccountFreeze) FrozenAt() time.Time {
+	return f.frozenAt
 }
@@ -655,6 +655,7 @@ func TestPointsSource_String(t *testing.T) {
 		{"兌換來源", points.PointsSourceRedemption, "PointsSource(Redemption)"},
 		{"過期來源", points.PointsSourceExpiration, "PointsSource(Expiration)"},
 		{"轉讓來源", points.PointsSourceTransfer, "PointsSource(Transfer)"},
+		{"生日禮來源", points.PointsSourceBirthday, "PointsSource(Birthday)"},
 	}
 
 	for _, tt := range tests {
@@ -692,6 +693,7 @@ func TestPointsSource_IsValid(t *testing.T) {
 		{"Redemption 有效", points.PointsSourceRedemption, true},
 		{"Expiration 有效", points.PointsSourceExpiration, true},
 		{"Transfer 有效", points.PointsSourceTransfer, true},
+		{"Birthday 有效", points.PointsSourceBirthday, true},
 		{"負數無效", points.PointsSource(-1), false},
 		{"超出範圍無效", points.PointsSource(999), false},
 		{"零值（Undefined）無效", points.PointsSourceUndefined, false}, // 0 is PointsSourceUndefined now
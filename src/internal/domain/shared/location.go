@@ -0,0 +1,18 @@
+package shared
+
+import "time"
+
+// BusinessLocation 營業所在時區（Asia/Taipei）
+//
+// 用途：統計時段、每日排程等需以店家當地時間判斷的業務規則
+//
+// 注意：執行環境缺少 tzdata 時退回固定 UTC+8（台灣無日光節約時間，結果相同）
+var BusinessLocation = loadBusinessLocation()
+
+func loadBusinessLocation() *time.Location {
+	loc, err := time.LoadLocation("Asia/Taipei")
+	if err != nil {
+		return time.FixedZone("Asia/Taipei", 8*60*60)
+	}
+	return loc
+}
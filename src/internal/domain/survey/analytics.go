@@ -0,0 +1,354 @@
+package survey
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// DateRange 統計區間
+// ===========================
+
+// DateRange 統計區間 [from, to)
+type DateRange struct {
+	from time.Time
+	to   time.Time
+}
+
+// NewDateRange 建構函數（checked 版本）
+//
+// 驗證：from 必須早於 to
+func NewDateRange(from, to time.Time) (DateRange, error) {
+	if !from.Before(to) {
+		return DateRange{}, ErrInvalidAnalyticsQuery.WithContext(
+			"from", from,
+			"to", to,
+			"reason", "from must be before to",
+		)
+	}
+	return DateRange{from: from, to: to}, nil
+}
+
+// From 返回起始時間（含）
+func (r DateRange) From() time.Time {
+	return r.from
+}
+
+// To 返回結束時間（不含）
+func (r DateRange) To() time.Time {
+	return r.to
+}
+
+// ===========================
+// 統計讀取模型
+// ===========================
+
+// RatingSample 評分樣本（提交時間 + 分數）
+//
+// 用途：NPS 時間窗、星期 / 時段分析
+type RatingSample struct {
+	SubmittedAt time.Time
+	Rating      int
+}
+
+// TextAnswerEntry 文字答案列表項目
+type TextAnswerEntry struct {
+	ResponseID  ResponseID
+	Text        string
+	SubmittedAt time.Time
+}
+
+// ResponseRecord 問卷回覆匯出紀錄（含所有答案）
+type ResponseRecord struct {
+	ResponseID    ResponseID
+	TransactionID TransactionID
+	MemberID      MemberID
+	BonusStatus   BonusStatus
+	SubmittedAt   time.Time
+	Answers       []Answer
+}
+
+// ===========================
+// RatingSummary 評分摘要
+// ===========================
+
+// RatingSummary 評分題統計（分佈與平均）
+type RatingSummary struct {
+	distribution map[int]int
+	count        int
+	sum          int
+}
+
+// NewRatingSummary 由評分分佈建立摘要
+//
+// 設計原則：1-5 分皆有鍵值（沒有作答的分數為 0），忽略範圍外的分數
+func NewRatingSummary(distribution map[int]int) RatingSummary {
+	summary := RatingSummary{distribution: make(map[int]int, MaxRating)}
+	for score := MinRating; score <= MaxRating; score++ {
+		count := distribution[score]
+		summary.distribution[score] = count
+		summary.count += count
+		summary.sum += score * count
+	}
+	return summary
+}
+
+// Distribution 返回評分分佈（副本）
+func (s RatingSummary) Distribution() map[int]int {
+	distribution := make(map[int]int, len(s.distribution))
+	for score, count := range s.distribution {
+		distribution[score] = count
+	}
+	return distribution
+}
+
+// Count 返回作答數
+func (s RatingSummary) Count() int {
+	return s.count
+}
+
+// Average 返回平均分數（沒有作答時為 0）
+func (s RatingSummary) Average() float64 {
+	if s.count == 0 {
+		return 0
+	}
+	return float64(s.sum) / float64(s.count)
+}
+
+// ===========================
+// NPSScore 淨推薦分數
+// ===========================
+
+// NPSScore NPS 風格分數（依 1-5 分評分換算）
+//
+// 分類規則：
+// - 5 分：推薦者（Promoter）
+// - 4 分：中立者（Passive）
+// - 1-3 分：批評者（Detractor）
+//
+// 分數 = (推薦者 - 批評者) / 總數 × 100，範圍 -100 ~ 100
+type NPSScore struct {
+	promoters  int
+	passives   int
+	detractors int
+}
+
+// Add 加入一筆評分（不可變，返回新值）
+func (s NPSScore) Add(rating int) NPSScore {
+	switch {
+	case rating == MaxRating:
+		s.promoters++
+	case rating == MaxRating-1:
+		s.passives++
+	case rating >= MinRating:
+		s.detractors++
+	}
+	return s
+}
+
+// Promoters 返回推薦者人數
+func (s NPSScore) Promoters() int {
+	return s.promoters
+}
+
+// Passives 返回中立者人數
+func (s NPSScore) Passives() int {
+	return s.passives
+}
+
+// Detractors 返回批評者人數
+func (s NPSScore) Detractors() int {
+	return s.detractors
+}
+
+// Total 返回總作答數
+func (s NPSScore) Total() int {
+	return s.promoters + s.passives + s.detractors
+}
+
+// Score 返回 NPS 分數（沒有作答時為 0）
+func (s NPSScore) Score() float64 {
+	total := s.Total()
+	if total == 0 {
+		return 0
+	}
+	return float64(s.promoters-s.detractors) / float64(total) * 100
+}
+
+// NPSWindow 單一時間窗的 NPS
+type NPSWindow struct {
+	Start time.Time
+	End   time.Time
+	Score NPSScore
+}
+
+// ===========================
+// TimeSlot 營業時段
+// ===========================
+
+// TimeSlot 營業時段（依 Asia/Taipei 當地時間）
+type TimeSlot string
+
+const (
+	TimeSlotLunch     TimeSlot = "lunch"      // 午間：11:00-14:00
+	TimeSlotAfternoon TimeSlot = "afternoon"  // 午後：14:00-18:00
+	TimeSlotEvening   TimeSlot = "evening"    // 晚間：18:00-21:00
+	TimeSlotLateNight TimeSlot = "late_night" // 深夜：21:00-03:00
+	TimeSlotOther     TimeSlot = "other"      // 其他：03:00-11:00
+)
+
+// timeSlotOrder 時段顯示順序
+var timeSlotOrder = []TimeSlot{
+	TimeSlotLunch,
+	TimeSlotAfternoon,
+	TimeSlotEvening,
+	TimeSlotLateNight,
+	TimeSlotOther,
+}
+
+// TimeSlotOf 判斷時間所屬營業時段
+func TimeSlotOf(t time.Time) TimeSlot {
+	hour := t.In(shared.BusinessLocation).Hour()
+	switch {
+	case hour >= 11 && hour < 14:
+		return TimeSlotLunch
+	case hour >= 14 && hour < 18:
+		return TimeSlotAfternoon
+	case hour >= 18 && hour < 21:
+		return TimeSlotEvening
+	case hour >= 21 || hour < 3:
+		return TimeSlotLateNight
+	default:
+		return TimeSlotOther
+	}
+}
+
+// String 返回時段字串
+func (s TimeSlot) String() string {
+	return string(s)
+}
+
+// Breakdown 分組統計（星期 / 時段）
+type Breakdown struct {
+	Key       string
+	Count     int
+	RatingSum int
+	NPS       NPSScore
+}
+
+// Average 返回平均分數（沒有作答時為 0）
+func (b Breakdown) Average() float64 {
+	if b.Count == 0 {
+		return 0
+	}
+	return float64(b.RatingSum) / float64(b.Count)
+}
+
+// add 加入一筆樣本（私有方法）
+func (b *Breakdown) add(sample RatingSample) {
+	b.Count++
+	b.RatingSum += sample.Rating
+	b.NPS = b.NPS.Add(sample.Rating)
+}
+
+// ===========================
+// SurveyAnalyticsService 領域服務
+// ===========================
+
+// 趨勢時間窗數量上限（避免過細的時間窗造成大量空資料）
+const maxNPSWindows = 366
+
+// SurveyAnalyticsService 問卷統計領域服務
+//
+// 職責：依評分樣本計算 NPS 趨勢、星期與時段分組
+//
+// 設計原則：無狀態，時間判斷一律使用 Asia/Taipei 當地時間
+type SurveyAnalyticsService struct{}
+
+// NewSurveyAnalyticsService 創建統計服務
+func NewSurveyAnalyticsService() *SurveyAnalyticsService {
+	return &SurveyAnalyticsService{}
+}
+
+// NPSTrend 依固定時間窗計算 NPS 趨勢
+//
+// 時間窗自 period.From 起算，最後一個時間窗截止於 period.To
+//
+// 錯誤：ErrInvalidAnalyticsQuery（時間窗 <= 0 或數量超過上限）
+func (s *SurveyAnalyticsService) NPSTrend(
+	samples []RatingSample,
+	period DateRange,
+	window time.Duration,
+) ([]NPSWindow, error) {
+	if window <= 0 {
+		return nil, ErrInvalidAnalyticsQuery.WithContext(
+			"window", window.String(),
+			"reason", "window must be positive",
+		)
+	}
+
+	span := period.to.Sub(period.from)
+	count := int(span / window)
+	if span%window != 0 {
+		count++
+	}
+	if count > maxNPSWindows {
+		return nil, ErrInvalidAnalyticsQuery.WithContext(
+			"window", window.String(),
+			"windows", count,
+			"reason", "too many windows for the period",
+		)
+	}
+
+	windows := make([]NPSWindow, count)
+	for i := range windows {
+		start := period.from.Add(time.Duration(i) * window)
+		end := start.Add(window)
+		if end.After(period.to) {
+			end = period.to
+		}
+		windows[i] = NPSWindow{Start: start, End: end}
+	}
+
+	for _, sample := range samples {
+		if sample.SubmittedAt.Before(period.from) || !sample.SubmittedAt.Before(period.to) {
+			continue
+		}
+		i := int(sample.SubmittedAt.Sub(period.from) / window)
+		windows[i].Score = windows[i].Score.Add(sample.Rating)
+	}
+
+	return windows, nil
+}
+
+// ByWeekday 依星期分組（週一至週日）
+func (s *SurveyAnalyticsService) ByWeekday(samples []RatingSample) []Breakdown {
+	breakdowns := make([]Breakdown, 7)
+	for i := range breakdowns {
+		breakdowns[i].Key = time.Weekday((i + 1) % 7).String()
+	}
+
+	for _, sample := range samples {
+		weekday := sample.SubmittedAt.In(shared.BusinessLocation).Weekday()
+		breakdowns[(int(weekday)+6)%7].add(sample)
+	}
+
+	return breakdowns
+}
+
+// ByTimeSlot 依營業時段分組
+func (s *SurveyAnalyticsService) ByTimeSlot(samples []RatingSample) []Breakdown {
+	index := make(map[TimeSlot]int, len(timeSlotOrder))
+	breakdowns := make([]Breakdown, len(timeSlotOrder))
+	for i, slot := range timeSlotOrder {
+		breakdowns[i].Key = slot.String()
+		index[slot] = i
+	}
+
+	for _, sample := range samples {
+		breakdowns[index[TimeSlotOf(sample.SubmittedAt)]].add(sample)
+	}
+
+	return breakdowns
+}
@@ -0,0 +1,104 @@
+package survey_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// 問卷統計測試
+// ===========================
+
+// taipeiTime 建立 Asia/Taipei 當地時間
+func taipeiTime(year int, month time.Month, day, hour int) time.Time {
+	return time.Date(year, month, day, hour, 0, 0, 0, shared.BusinessLocation)
+}
+
+// Test 20: 評分摘要補齊 1-5 分並計算平均
+func TestNewRatingSummary_DistributionAndAverage(t *testing.T) {
+	// Act
+	summary := survey.NewRatingSummary(map[int]int{5: 3, 4: 1, 2: 1, 9: 7})
+
+	// Assert
+	assert.Equal(t, 5, summary.Count())
+	assert.InDelta(t, 4.2, summary.Average(), 0.0001)
+	assert.Equal(t, map[int]int{1: 0, 2: 1, 3: 0, 4: 1, 5: 3}, summary.Distribution())
+	assert.Equal(t, 0.0, survey.NewRatingSummary(nil).Average())
+}
+
+// Test 21: NPS 分數（5 推薦、4 中立、1-3 批評）
+func TestNPSScore_Score(t *testing.T) {
+	// Arrange
+	var score survey.NPSScore
+	for _, rating := range []int{5, 5, 5, 4, 3, 1} {
+		score = score.Add(rating)
+	}
+
+	// Assert
+	assert.Equal(t, 3, score.Promoters())
+	assert.Equal(t, 1, score.Passives())
+	assert.Equal(t, 2, score.Detractors())
+	assert.InDelta(t, 16.6667, score.Score(), 0.001)
+}
+
+// Test 22: NPS 趨勢依時間窗切分，最後一窗截止於區間結束
+func TestSurveyAnalyticsService_NPSTrend(t *testing.T) {
+	// Arrange
+	service := survey.NewSurveyAnalyticsService()
+	from := taipeiTime(2025, 3, 1, 0)
+	period, err := survey.NewDateRange(from, from.Add(10*24*time.Hour))
+	require.NoError(t, err)
+	samples := []survey.RatingSample{
+		{SubmittedAt: from.Add(time.Hour), Rating: 5},
+		{SubmittedAt: from.Add(8 * 24 * time.Hour), Rating: 2},
+		{SubmittedAt: from.Add(11 * 24 * time.Hour), Rating: 5}, // 區間外
+	}
+
+	// Act
+	trend, err := service.NPSTrend(samples, period, 7*24*time.Hour)
+	_, invalidErr := service.NPSTrend(samples, period, 0)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, trend, 2)
+	assert.Equal(t, 100.0, trend[0].Score.Score())
+	assert.Equal(t, -100.0, trend[1].Score.Score())
+	assert.True(t, trend[1].End.Equal(period.To()))
+	assert.ErrorIs(t, invalidErr, survey.ErrInvalidAnalyticsQuery)
+}
+
+// Test 23: 星期與時段分組依台北時間判斷
+func TestSurveyAnalyticsService_WeekdayAndTimeSlot(t *testing.T) {
+	// Arrange
+	service := survey.NewSurveyAnalyticsService()
+	// 2025-03-07 為週五；深夜 01:00 屬於週六的 late_night
+	samples := []survey.RatingSample{
+		{SubmittedAt: taipeiTime(2025, 3, 7, 19), Rating: 5},
+		{SubmittedAt: taipeiTime(2025, 3, 8, 1).UTC(), Rating: 3},
+		{SubmittedAt: taipeiTime(2025, 3, 8, 22), Rating: 4},
+	}
+
+	// Act
+	byWeekday := service.ByWeekday(samples)
+	byTimeSlot := service.ByTimeSlot(samples)
+
+	// Assert
+	require.Len(t, byWeekday, 7)
+	assert.Equal(t, "Monday", byWeekday[0].Key)
+	assert.Equal(t, 1, byWeekday[4].Count) // Friday
+	assert.Equal(t, "Saturday", byWeekday[5].Key)
+	assert.Equal(t, 2, byWeekday[5].Count)
+	assert.InDelta(t, 3.5, byWeekday[5].Average(), 0.0001)
+
+	slots := make(map[string]int)
+	for _, b := range byTimeSlot {
+		slots[b.Key] = b.Count
+	}
+	assert.Equal(t, 1, slots["evening"])
+	assert.Equal(t, 2, slots["late_night"])
+}
@@ -0,0 +1,252 @@
+package survey
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ===========================
+// DisplayCondition 顯示條件值對象
+// ===========================
+
+// ConditionOperator 顯示條件運算子
+type ConditionOperator string
+
+const (
+	ConditionRatingAtMost  ConditionOperator = "rating_lte" // 評分 ≤ 值（例：≤ 2 時詢問哪裡不滿意）
+	ConditionRatingAtLeast ConditionOperator = "rating_gte" // 評分 ≥ 值
+	ConditionChoiceEquals  ConditionOperator = "choice_eq"  // 選擇了指定選項（例：選「吧台」時詢問調酒）
+)
+
+// String 返回運算子字串
+func (o ConditionOperator) String() string {
+	return string(o)
+}
+
+// sourceType 返回運算子適用的來源題型
+func (o ConditionOperator) sourceType() (QuestionType, bool) {
+	switch o {
+	case ConditionRatingAtMost, ConditionRatingAtLeast:
+		return QuestionTypeRating, true
+	case ConditionChoiceEquals:
+		return QuestionTypeMultipleChoice, true
+	default:
+		return "", false
+	}
+}
+
+// DisplayCondition 題目顯示條件（依先前題目的答案決定是否顯示）
+//
+// 設計原則：
+// - 一個題目可有多個條件，全部成立才顯示（AND）
+// - 來源題目未作答或本身被隱藏時，條件不成立（隱藏會連鎖傳遞）
+// - 條件引用的題目是否存在、是否形成循環，由 Survey 儲存時檢查
+type DisplayCondition struct {
+	sourceQuestionID QuestionID
+	operator         ConditionOperator
+	rating           int
+	option           string
+}
+
+// NewDisplayCondition 建構函數（checked 版本）
+//
+// 參數：
+//   value - 評分條件為 1-5 的數字字串；選項條件為選項文字
+//
+// 錯誤：ErrInvalidDisplayCondition
+func NewDisplayCondition(sourceQuestionID QuestionID, operator ConditionOperator, value string) (DisplayCondition, error) {
+	if sourceQuestionID.IsEmpty() {
+		return DisplayCondition{}, ErrInvalidDisplayCondition.WithContext(
+			"reason", "source question cannot be empty",
+		)
+	}
+
+	sourceType, ok := operator.sourceType()
+	if !ok {
+		return DisplayCondition{}, ErrInvalidDisplayCondition.WithContext(
+			"operator", operator.String(),
+			"reason", "unknown operator",
+		)
+	}
+
+	value = strings.TrimSpace(value)
+	condition := DisplayCondition{sourceQuestionID: sourceQuestionID, operator: operator}
+
+	if sourceType == QuestionTypeRating {
+		rating, err := strconv.Atoi(value)
+		if err != nil || rating < MinRating || rating > MaxRating {
+			return DisplayCondition{}, ErrInvalidDisplayCondition.WithContext(
+				"operator", operator.String(),
+				"value", value,
+				"reason", "rating condition requires a value between 1 and 5",
+			)
+		}
+		condition.rating = rating
+		return condition, nil
+	}
+
+	if value == "" {
+		return DisplayCondition{}, ErrInvalidDisplayCondition.WithContext(
+			"operator", operator.String(),
+			"reason", "choice condition requires an option",
+		)
+	}
+	condition.option = value
+	return condition, nil
+}
+
+// SourceQuestionID 返回來源題目 ID
+func (c DisplayCondition) SourceQuestionID() QuestionID {
+	return c.sourceQuestionID
+}
+
+// Operator 返回運算子
+func (c DisplayCondition) Operator() ConditionOperator {
+	return c.operator
+}
+
+// Value 返回條件值（字串形式，與 NewDisplayCondition 參數一致）
+func (c DisplayCondition) Value() string {
+	if c.option != "" {
+		return c.option
+	}
+	return strconv.Itoa(c.rating)
+}
+
+// matches 判斷答案是否滿足條件
+func (c DisplayCondition) matches(answer Answer) bool {
+	switch c.operator {
+	case ConditionRatingAtMost:
+		return answer.Type() == QuestionTypeRating && answer.Rating() <= c.rating
+	case ConditionRatingAtLeast:
+		return answer.Type() == QuestionTypeRating && answer.Rating() >= c.rating
+	case ConditionChoiceEquals:
+		return answer.Type() == QuestionTypeMultipleChoice && answer.Text() == c.option
+	default:
+		return false
+	}
+}
+
+// ===========================
+// 條件驗證與顯示判斷（Survey 內部使用）
+// ===========================
+
+// validateConditions 驗證題目清單的顯示條件
+//
+// 驗證規則：
+// 1. 引用的題目必須存在於本問卷（ErrUnknownConditionQuestion）
+// 2. 運算子與來源題型相符，選項必須存在（ErrInvalidDisplayCondition）
+// 3. 條件不可形成循環（含引用自己）（ErrDisplayConditionCycle）
+func validateConditions(questions []Question) error {
+	index := make(map[string]Question, len(questions))
+	for _, q := range questions {
+		index[q.QuestionID().String()] = q
+	}
+
+	for _, q := range questions {
+		for _, c := range q.conditions {
+			source, ok := index[c.sourceQuestionID.String()]
+			if !ok {
+				return ErrUnknownConditionQuestion.WithContext(
+					"question_id", q.QuestionID().String(),
+					"source_question_id", c.sourceQuestionID.String(),
+				)
+			}
+
+			sourceType, _ := c.operator.sourceType()
+			if source.Type() != sourceType {
+				return ErrInvalidDisplayCondition.WithContext(
+					"question_id", q.QuestionID().String(),
+					"source_question_id", c.sourceQuestionID.String(),
+					"operator", c.operator.String(),
+					"source_type", source.Type().String(),
+				)
+			}
+
+			if sourceType == QuestionTypeMultipleChoice && !source.hasOption(c.option) {
+				return ErrInvalidDisplayCondition.WithContext(
+					"question_id", q.QuestionID().String(),
+					"source_question_id", c.sourceQuestionID.String(),
+					"option", c.option,
+					"reason", "option does not exist in source question",
+				)
+			}
+		}
+	}
+
+	// 深度優先搜尋偵測循環（white / gray / black 標記）
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(questions))
+
+	var visit func(q Question) error
+	visit = func(q Question) error {
+		id := q.QuestionID().String()
+		switch state[id] {
+		case visiting:
+			return ErrDisplayConditionCycle.WithContext("question_id", id)
+		case visited:
+			return nil
+		}
+
+		state[id] = visiting
+		for _, c := range q.conditions {
+			if err := visit(index[c.sourceQuestionID.String()]); err != nil {
+				return err
+			}
+		}
+		state[id] = visited
+		return nil
+	}
+
+	for _, q := range questions {
+		if err := visit(q); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// visibility 依答案計算每題是否顯示（題目 ID → 是否顯示）
+//
+// 前置條件：條件已通過 validateConditions（無循環、引用皆存在）
+func visibility(questions []Question, answers map[string]Answer) map[string]bool {
+	index := make(map[string]Question, len(questions))
+	for _, q := range questions {
+		index[q.QuestionID().String()] = q
+	}
+
+	visible := make(map[string]bool, len(questions))
+	resolved := make(map[string]bool, len(questions))
+
+	var resolve func(q Question) bool
+	resolve = func(q Question) bool {
+		id := q.QuestionID().String()
+		if resolved[id] {
+			return visible[id]
+		}
+
+		shown := true
+		for _, c := range q.conditions {
+			sourceID := c.sourceQuestionID.String()
+			answer, answered := answers[sourceID]
+			if !resolve(index[sourceID]) || !answered || !c.matches(answer) {
+				shown = false
+				break
+			}
+		}
+
+		visible[id] = shown
+		resolved[id] = true
+		return shown
+	}
+
+	for _, q := range questions {
+		resolve(q)
+	}
+	return visible
+}
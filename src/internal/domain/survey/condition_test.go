@@ -0,0 +1,113 @@
+package survey_test
+
+import (
+	"testing"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// 顯示條件（分支）測試
+// ===========================
+
+// branchingSurvey 建立分支問卷：
+// 1. 整體評分（必填）
+// 2. 哪裡不滿意（必填，評分 ≤ 2 才顯示）
+// 3. 座位區（必填）
+// 4. 調酒評價（必填，選「吧台」才顯示）
+func branchingSurvey(t *testing.T) (*survey.Survey, []survey.Question) {
+	rating, err := survey.NewQuestion("整體滿意度", survey.QuestionTypeRating, nil, true)
+	require.NoError(t, err)
+	complaint, err := survey.NewQuestion("哪裡不滿意？", survey.QuestionTypeText, nil, true)
+	require.NoError(t, err)
+	seating, err := survey.NewQuestion("座位區", survey.QuestionTypeMultipleChoice, []string{"吧台", "包廂"}, true)
+	require.NoError(t, err)
+	cocktail, err := survey.NewQuestion("調酒評價", survey.QuestionTypeRating, nil, true)
+	require.NoError(t, err)
+
+	lowRating, err := survey.NewDisplayCondition(rating.QuestionID(), survey.ConditionRatingAtMost, "2")
+	require.NoError(t, err)
+	barSeating, err := survey.NewDisplayCondition(seating.QuestionID(), survey.ConditionChoiceEquals, "吧台")
+	require.NoError(t, err)
+
+	questions := []survey.Question{rating, complaint.WithConditions(lowRating), seating, cocktail.WithConditions(barSeating)}
+	s, err := survey.NewSurvey("分支問卷", "", questions)
+	require.NoError(t, err)
+	return s, questions
+}
+
+// Test 24: 被隱藏的必填題視為非必填；顯示中的必填題仍需作答
+func TestSurvey_ValidateAnswers_HiddenRequiredNotRequired(t *testing.T) {
+	// Arrange
+	s, q := branchingSurvey(t)
+	high, _ := survey.NewRatingAnswer(q[0].QuestionID(), 5)
+	low, _ := survey.NewRatingAnswer(q[0].QuestionID(), 2)
+	booth, _ := survey.NewChoiceAnswer(q[2].QuestionID(), "包廂")
+
+	// Act
+	highErr := s.ValidateAnswers([]survey.Answer{high, booth})
+	lowErr := s.ValidateAnswers([]survey.Answer{low, booth})
+
+	// Assert
+	assert.NoError(t, highErr)
+	assert.ErrorIs(t, lowErr, survey.ErrMissingRequiredAnswer)
+	assert.Len(t, s.VisibleQuestions([]survey.Answer{low, booth}), 3)
+}
+
+// Test 25: 回答被隱藏的題目時拒絕
+func TestSurvey_ValidateAnswers_HiddenQuestionAnswered(t *testing.T) {
+	// Arrange
+	s, q := branchingSurvey(t)
+	high, _ := survey.NewRatingAnswer(q[0].QuestionID(), 4)
+	booth, _ := survey.NewChoiceAnswer(q[2].QuestionID(), "包廂")
+	cocktail, _ := survey.NewRatingAnswer(q[3].QuestionID(), 5)
+
+	// Act
+	err := s.ValidateAnswers([]survey.Answer{high, booth, cocktail})
+
+	// Assert
+	assert.ErrorIs(t, err, survey.ErrHiddenQuestionAnswered)
+}
+
+// Test 26: 儲存時檢查循環與不存在的題目引用
+func TestNewSurvey_InvalidConditions_ReturnsError(t *testing.T) {
+	// Arrange
+	a, err := survey.NewQuestion("A", survey.QuestionTypeRating, nil, false)
+	require.NoError(t, err)
+	b, err := survey.NewQuestion("B", survey.QuestionTypeRating, nil, false)
+	require.NoError(t, err)
+	aOnB, _ := survey.NewDisplayCondition(b.QuestionID(), survey.ConditionRatingAtLeast, "3")
+	bOnA, _ := survey.NewDisplayCondition(a.QuestionID(), survey.ConditionRatingAtLeast, "3")
+	unknown, _ := survey.NewDisplayCondition(survey.NewQuestionID(), survey.ConditionRatingAtLeast, "3")
+	choiceOnRating, _ := survey.NewDisplayCondition(a.QuestionID(), survey.ConditionChoiceEquals, "吧台")
+
+	// Act
+	_, cycleErr := survey.NewSurvey("Q", "", []survey.Question{a.WithConditions(aOnB), b.WithConditions(bOnA)})
+	_, selfErr := survey.NewSurvey("Q", "", []survey.Question{a.WithConditions(bOnA)})
+	_, unknownErr := survey.NewSurvey("Q", "", []survey.Question{a, b.WithConditions(unknown)})
+	_, typeErr := survey.NewSurvey("Q", "", []survey.Question{a, b.WithConditions(choiceOnRating)})
+	_, forwardErr := survey.NewSurvey("Q", "", []survey.Question{a.WithConditions(aOnB), b})
+
+	// Assert
+	assert.ErrorIs(t, cycleErr, survey.ErrDisplayConditionCycle)
+	assert.ErrorIs(t, selfErr, survey.ErrDisplayConditionCycle)
+	assert.ErrorIs(t, unknownErr, survey.ErrUnknownConditionQuestion)
+	assert.ErrorIs(t, typeErr, survey.ErrInvalidDisplayCondition)
+	assert.NoError(t, forwardErr)
+}
+
+// Test 27: 條件值驗證（評分 1-5、未知運算子）
+func TestNewDisplayCondition_InvalidValue_ReturnsError(t *testing.T) {
+	id := survey.NewQuestionID()
+
+	_, err := survey.NewDisplayCondition(id, survey.ConditionRatingAtMost, "6")
+	assert.ErrorIs(t, err, survey.ErrInvalidDisplayCondition)
+
+	_, err = survey.NewDisplayCondition(id, survey.ConditionOperator("contains"), "x")
+	assert.ErrorIs(t, err, survey.ErrInvalidDisplayCondition)
+
+	_, err = survey.NewDisplayCondition(id, survey.ConditionChoiceEquals, " ")
+	assert.ErrorIs(t, err, survey.ErrInvalidDisplayCondition)
+}
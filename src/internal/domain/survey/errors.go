@@ -0,0 +1,353 @@
+package survey
+
+import "fmt"
+
+// ===========================
+// 錯誤代碼定義
+// ===========================
+
+// ErrorCode 錯誤代碼類型
+type ErrorCode string
+
+// 錯誤代碼常量
+const (
+	// 識別符相關
+	ErrCodeInvalidSurveyID      ErrorCode = "SURVEY_ID_INVALID"
+	ErrCodeInvalidQuestionID    ErrorCode = "QUESTION_ID_INVALID"
+	ErrCodeInvalidTransactionID ErrorCode = "TRANSACTION_ID_INVALID"
+	ErrCodeInvalidMemberID      ErrorCode = "MEMBER_ID_INVALID"
+	ErrCodeInvalidTokenID       ErrorCode = "SURVEY_TOKEN_ID_INVALID"
+	ErrCodeInvalidResponseID    ErrorCode = "SURVEY_RESPONSE_ID_INVALID"
+
+	// 問卷定義相關
+	ErrCodeInvalidSurveyTitle     ErrorCode = "SURVEY_TITLE_INVALID"
+	ErrCodeSurveyHasNoQuestions   ErrorCode = "SURVEY_NO_QUESTIONS"
+	ErrCodeDuplicateQuestion      ErrorCode = "SURVEY_DUPLICATE_QUESTION"
+	ErrCodeInvalidQuestionText    ErrorCode = "QUESTION_TEXT_INVALID"
+	ErrCodeInvalidQuestionType    ErrorCode = "QUESTION_TYPE_INVALID"
+	ErrCodeInvalidQuestionOptions ErrorCode = "QUESTION_OPTIONS_INVALID"
+
+	// 啟用狀態相關
+	ErrCodeSurveyAlreadyActive ErrorCode = "SURVEY_ALREADY_ACTIVE"
+	ErrCodeSurveyNotActive     ErrorCode = "SURVEY_NOT_ACTIVE"
+
+	// 答案驗證相關
+	ErrCodeInvalidRating          ErrorCode = "RATING_INVALID"
+	ErrCodeInvalidTextAnswer      ErrorCode = "TEXT_ANSWER_INVALID"
+	ErrCodeInvalidChoice          ErrorCode = "CHOICE_INVALID"
+	ErrCodeAnswerTypeMismatch     ErrorCode = "ANSWER_TYPE_MISMATCH"
+	ErrCodeUnknownQuestion        ErrorCode = "UNKNOWN_QUESTION"
+	ErrCodeDuplicateAnswer        ErrorCode = "DUPLICATE_ANSWER"
+	ErrCodeMissingRequiredAnswer  ErrorCode = "MISSING_REQUIRED_ANSWER"
+	ErrCodeHiddenQuestionAnswered ErrorCode = "HIDDEN_QUESTION_ANSWERED"
+
+	// 顯示條件（分支）相關
+	ErrCodeInvalidDisplayCondition  ErrorCode = "DISPLAY_CONDITION_INVALID"
+	ErrCodeUnknownConditionQuestion ErrorCode = "DISPLAY_CONDITION_UNKNOWN_QUESTION"
+	ErrCodeDisplayConditionCycle    ErrorCode = "DISPLAY_CONDITION_CYCLE"
+
+	// 問卷 Token 相關
+	ErrCodeInvalidSigningKey  ErrorCode = "SURVEY_SIGNING_KEY_INVALID"
+	ErrCodeInvalidTokenTTL    ErrorCode = "SURVEY_TOKEN_TTL_INVALID"
+	ErrCodeInvalidSurveyToken ErrorCode = "SURVEY_TOKEN_INVALID"
+	ErrCodeSurveyTokenExpired ErrorCode = "SURVEY_TOKEN_EXPIRED"
+	ErrCodeSurveyTokenUsed    ErrorCode = "SURVEY_TOKEN_USED"
+	ErrCodeSurveyNotEligible  ErrorCode = "SURVEY_NOT_ELIGIBLE"
+
+	// 問卷回覆相關
+	ErrCodeResponseAlreadySubmitted ErrorCode = "SURVEY_RESPONSE_ALREADY_SUBMITTED"
+	ErrCodeBonusAlreadyAwarded      ErrorCode = "SURVEY_BONUS_ALREADY_AWARDED"
+	ErrCodeInvalidBonusStatus       ErrorCode = "SURVEY_BONUS_STATUS_INVALID"
+
+	// 問卷統計相關
+	ErrCodeInvalidAnalyticsQuery ErrorCode = "SURVEY_ANALYTICS_QUERY_INVALID"
+
+	// Repository 相關
+	ErrCodeSurveyNotFound   ErrorCode = "SURVEY_NOT_FOUND"
+	ErrCodeNoActiveSurvey   ErrorCode = "NO_ACTIVE_SURVEY"
+	ErrCodeResponseNotFound ErrorCode = "SURVEY_RESPONSE_NOT_FOUND"
+)
+
+// ===========================
+// DomainError 結構
+// ===========================
+
+// DomainError 問卷領域錯誤
+// 設計原則：
+// 1. 包含結構化的錯誤代碼（用於 HTTP 狀態碼映射）
+// 2. 支持上下文信息（用於調試和日誌）
+// 3. 不可變性（創建後不可修改）
+type DomainError struct {
+	Code    ErrorCode
+	Message string
+	Context map[string]interface{}
+}
+
+// Error 實現 error 接口
+func (e *DomainError) Error() string {
+	if len(e.Context) == 0 {
+		return fmt.Sprintf("[%s] %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("[%s] %s (context: %+v)", e.Code, e.Message, e.Context)
+}
+
+// WithContext 添加上下文信息（返回新的錯誤實例，保持不可變性）
+func (e *DomainError) WithContext(keyValues ...interface{}) error {
+	if len(keyValues)%2 != 0 {
+		panic("WithContext requires even number of arguments (key-value pairs)")
+	}
+
+	ctx := make(map[string]interface{}, len(e.Context)+len(keyValues)/2)
+
+	// 複製現有上下文
+	for k, v := range e.Context {
+		ctx[k] = v
+	}
+
+	// 添加新上下文
+	for i := 0; i < len(keyValues); i += 2 {
+		key, ok := keyValues[i].(string)
+		if !ok {
+			panic(fmt.Sprintf("context key must be string, got %T", keyValues[i]))
+		}
+		ctx[key] = keyValues[i+1]
+	}
+
+	return &DomainError{
+		Code:    e.Code,
+		Message: e.Message,
+		Context: ctx,
+	}
+}
+
+// Is 實現 errors.Is 接口（用於錯誤類型判斷）
+func (e *DomainError) Is(target error) bool {
+	t, ok := target.(*DomainError)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+// ===========================
+// 預定義錯誤
+// ===========================
+
+// 識別符相關錯誤
+var (
+	ErrInvalidSurveyID = &DomainError{
+		Code:    ErrCodeInvalidSurveyID,
+		Message: "無效的問卷 ID",
+	}
+
+	ErrInvalidQuestionID = &DomainError{
+		Code:    ErrCodeInvalidQuestionID,
+		Message: "無效的題目 ID",
+	}
+
+	ErrInvalidTransactionID = &DomainError{
+		Code:    ErrCodeInvalidTransactionID,
+		Message: "無效的交易 ID",
+	}
+
+	ErrInvalidMemberID = &DomainError{
+		Code:    ErrCodeInvalidMemberID,
+		Message: "無效的會員 ID",
+	}
+
+	ErrInvalidTokenID = &DomainError{
+		Code:    ErrCodeInvalidTokenID,
+		Message: "無效的問卷 Token ID",
+	}
+
+	ErrInvalidResponseID = &DomainError{
+		Code:    ErrCodeInvalidResponseID,
+		Message: "無效的問卷回覆 ID",
+	}
+)
+
+// 問卷定義相關錯誤
+var (
+	ErrInvalidSurveyTitle = &DomainError{
+		Code:    ErrCodeInvalidSurveyTitle,
+		Message: "問卷標題不可為空且不可超過 100 字",
+	}
+
+	ErrSurveyHasNoQuestions = &DomainError{
+		Code:    ErrCodeSurveyHasNoQuestions,
+		Message: "問卷至少需要一個題目",
+	}
+
+	ErrDuplicateQuestion = &DomainError{
+		Code:    ErrCodeDuplicateQuestion,
+		Message: "問卷中有重複的題目",
+	}
+
+	ErrInvalidQuestionText = &DomainError{
+		Code:    ErrCodeInvalidQuestionText,
+		Message: "題目內容不可為空且不可超過 200 字",
+	}
+
+	ErrInvalidQuestionType = &DomainError{
+		Code:    ErrCodeInvalidQuestionType,
+		Message: "無效的題型",
+	}
+
+	ErrInvalidQuestionOptions = &DomainError{
+		Code:    ErrCodeInvalidQuestionOptions,
+		Message: "選擇題至少需要兩個不重複的選項，其他題型不可設定選項",
+	}
+)
+
+// 啟用狀態相關錯誤
+var (
+	ErrSurveyAlreadyActive = &DomainError{
+		Code:    ErrCodeSurveyAlreadyActive,
+		Message: "問卷已啟用",
+	}
+
+	ErrSurveyNotActive = &DomainError{
+		Code:    ErrCodeSurveyNotActive,
+		Message: "問卷未啟用",
+	}
+)
+
+// 答案驗證相關錯誤
+var (
+	ErrInvalidRating = &DomainError{
+		Code:    ErrCodeInvalidRating,
+		Message: "評分必須在 1-5 之間",
+	}
+
+	ErrInvalidTextAnswer = &DomainError{
+		Code:    ErrCodeInvalidTextAnswer,
+		Message: "文字答案不可為空且不可超過 500 字",
+	}
+
+	ErrInvalidChoice = &DomainError{
+		Code:    ErrCodeInvalidChoice,
+		Message: "選項不在題目的選項清單中",
+	}
+
+	ErrAnswerTypeMismatch = &DomainError{
+		Code:    ErrCodeAnswerTypeMismatch,
+		Message: "答案類型與題型不符",
+	}
+
+	ErrUnknownQuestion = &DomainError{
+		Code:    ErrCodeUnknownQuestion,
+		Message: "題目不屬於此問卷",
+	}
+
+	ErrDuplicateAnswer = &DomainError{
+		Code:    ErrCodeDuplicateAnswer,
+		Message: "同一題目只能作答一次",
+	}
+
+	ErrMissingRequiredAnswer = &DomainError{
+		Code:    ErrCodeMissingRequiredAnswer,
+		Message: "必填題未填寫",
+	}
+
+	ErrHiddenQuestionAnswered = &DomainError{
+		Code:    ErrCodeHiddenQuestionAnswered,
+		Message: "回答了不應顯示的題目",
+	}
+)
+
+// 顯示條件（分支）相關錯誤
+var (
+	ErrInvalidDisplayCondition = &DomainError{
+		Code:    ErrCodeInvalidDisplayCondition,
+		Message: "無效的題目顯示條件",
+	}
+
+	ErrUnknownConditionQuestion = &DomainError{
+		Code:    ErrCodeUnknownConditionQuestion,
+		Message: "顯示條件引用了不存在的題目",
+	}
+
+	ErrDisplayConditionCycle = &DomainError{
+		Code:    ErrCodeDisplayConditionCycle,
+		Message: "題目顯示條件形成循環",
+	}
+)
+
+// 問卷 Token 相關錯誤
+//
+// 設計原則：無效、過期、已使用使用不同錯誤代碼（前端顯示與監控區分）
+var (
+	ErrInvalidSigningKey = &DomainError{
+		Code:    ErrCodeInvalidSigningKey,
+		Message: "問卷 Token 簽章金鑰設定無效",
+	}
+
+	ErrInvalidTokenTTL = &DomainError{
+		Code:    ErrCodeInvalidTokenTTL,
+		Message: "問卷 Token 有效期設定無效",
+	}
+
+	ErrInvalidSurveyToken = &DomainError{
+		Code:    ErrCodeInvalidSurveyToken,
+		Message: "問卷連結已失效",
+	}
+
+	ErrSurveyTokenExpired = &DomainError{
+		Code:    ErrCodeSurveyTokenExpired,
+		Message: "問卷連結已過期",
+	}
+
+	ErrSurveyTokenUsed = &DomainError{
+		Code:    ErrCodeSurveyTokenUsed,
+		Message: "您已填寫過此問卷",
+	}
+
+	ErrSurveyNotEligible = &DomainError{
+		Code:    ErrCodeSurveyNotEligible,
+		Message: "此交易無法填寫問卷",
+	}
+)
+
+// 問卷回覆相關錯誤
+var (
+	ErrResponseAlreadySubmitted = &DomainError{
+		Code:    ErrCodeResponseAlreadySubmitted,
+		Message: "此交易已填寫過問卷",
+	}
+
+	ErrBonusAlreadyAwarded = &DomainError{
+		Code:    ErrCodeBonusAlreadyAwarded,
+		Message: "問卷獎勵積分已發放",
+	}
+
+	ErrInvalidBonusStatus = &DomainError{
+		Code:    ErrCodeInvalidBonusStatus,
+		Message: "無效的問卷獎勵狀態",
+	}
+)
+
+// 問卷統計相關錯誤
+var (
+	ErrInvalidAnalyticsQuery = &DomainError{
+		Code:    ErrCodeInvalidAnalyticsQuery,
+		Message: "無效的問卷統計查詢",
+	}
+)
+
+// Repository 相關錯誤
+var (
+	ErrSurveyNotFound = &DomainError{
+		Code:    ErrCodeSurveyNotFound,
+		Message: "問卷不存在",
+	}
+
+	ErrNoActiveSurvey = &DomainError{
+		Code:    ErrCodeNoActiveSurvey,
+		Message: "目前沒有啟用中的問卷",
+	}
+
+	ErrResponseNotFound = &DomainError{
+		Code:    ErrCodeResponseNotFound,
+		Message: "問卷回覆不存在",
+	}
+)
@@ -0,0 +1,347 @@
+package survey
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ===========================
+// SurveyCreated 領域事件
+// ===========================
+
+// SurveyCreatedEvent 問卷已建立事件
+type SurveyCreatedEvent struct {
+	eventID       string
+	surveyID      SurveyID
+	title         string
+	questionCount int
+	occurredAt    time.Time
+}
+
+// NewSurveyCreatedEvent 創建問卷已建立事件
+func NewSurveyCreatedEvent(
+	surveyID SurveyID,
+	title string,
+	questionCount int,
+) *SurveyCreatedEvent {
+	return &SurveyCreatedEvent{
+		eventID:       uuid.New().String(),
+		surveyID:      surveyID,
+		title:         title,
+		questionCount: questionCount,
+		occurredAt:    time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *SurveyCreatedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *SurveyCreatedEvent) EventType() string {
+	return "survey.created"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *SurveyCreatedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *SurveyCreatedEvent) AggregateID() string {
+	return e.surveyID.String()
+}
+
+// SurveyID 獲取問卷 ID
+func (e *SurveyCreatedEvent) SurveyID() SurveyID {
+	return e.surveyID
+}
+
+// Title 獲取問卷標題
+func (e *SurveyCreatedEvent) Title() string {
+	return e.title
+}
+
+// QuestionCount 獲取題目數量
+func (e *SurveyCreatedEvent) QuestionCount() int {
+	return e.questionCount
+}
+
+// ===========================
+// SurveyRevised 領域事件
+// ===========================
+
+// SurveyRevisedEvent 問卷已修訂事件
+type SurveyRevisedEvent struct {
+	eventID       string
+	surveyID      SurveyID
+	questionCount int
+	occurredAt    time.Time
+}
+
+// NewSurveyRevisedEvent 創建問卷已修訂事件
+func NewSurveyRevisedEvent(
+	surveyID SurveyID,
+	questionCount int,
+) *SurveyRevisedEvent {
+	return &SurveyRevisedEvent{
+		eventID:       uuid.New().String(),
+		surveyID:      surveyID,
+		questionCount: questionCount,
+		occurredAt:    time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *SurveyRevisedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *SurveyRevisedEvent) EventType() string {
+	return "survey.revised"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *SurveyRevisedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *SurveyRevisedEvent) AggregateID() string {
+	return e.surveyID.String()
+}
+
+// SurveyID 獲取問卷 ID
+func (e *SurveyRevisedEvent) SurveyID() SurveyID {
+	return e.surveyID
+}
+
+// QuestionCount 獲取修訂後題目數量
+func (e *SurveyRevisedEvent) QuestionCount() int {
+	return e.questionCount
+}
+
+// ===========================
+// SurveyActivated 領域事件
+// ===========================
+
+// SurveyActivatedEvent 問卷已啟用事件
+type SurveyActivatedEvent struct {
+	eventID    string
+	surveyID   SurveyID
+	occurredAt time.Time
+}
+
+// NewSurveyActivatedEvent 創建問卷已啟用事件
+func NewSurveyActivatedEvent(surveyID SurveyID) *SurveyActivatedEvent {
+	return &SurveyActivatedEvent{
+		eventID:    uuid.New().String(),
+		surveyID:   surveyID,
+		occurredAt: time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *SurveyActivatedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *SurveyActivatedEvent) EventType() string {
+	return "survey.activated"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *SurveyActivatedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *SurveyActivatedEvent) AggregateID() string {
+	return e.surveyID.String()
+}
+
+// SurveyID 獲取問卷 ID
+func (e *SurveyActivatedEvent) SurveyID() SurveyID {
+	return e.surveyID
+}
+
+// ===========================
+// SurveyDeactivated 領域事件
+// ===========================
+
+// SurveyDeactivatedEvent 問卷已停用事件
+type SurveyDeactivatedEvent struct {
+	eventID    string
+	surveyID   SurveyID
+	occurredAt time.Time
+}
+
+// NewSurveyDeactivatedEvent 創建問卷已停用事件
+func NewSurveyDeactivatedEvent(surveyID SurveyID) *SurveyDeactivatedEvent {
+	return &SurveyDeactivatedEvent{
+		eventID:    uuid.New().String(),
+		surveyID:   surveyID,
+		occurredAt: time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *SurveyDeactivatedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *SurveyDeactivatedEvent) EventType() string {
+	return "survey.deactivated"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *SurveyDeactivatedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *SurveyDeactivatedEvent) AggregateID() string {
+	return e.surveyID.String()
+}
+
+// SurveyID 獲取問卷 ID
+func (e *SurveyDeactivatedEvent) SurveyID() SurveyID {
+	return e.surveyID
+}
+
+// ===========================
+// SurveyResponseSubmitted 領域事件
+// ===========================
+
+// SurveyResponseSubmittedEvent 問卷回覆已提交事件
+type SurveyResponseSubmittedEvent struct {
+	eventID       string
+	responseID    ResponseID
+	surveyID      SurveyID
+	transactionID TransactionID
+	memberID      MemberID
+	occurredAt    time.Time
+}
+
+// NewSurveyResponseSubmittedEvent 創建問卷回覆已提交事件
+func NewSurveyResponseSubmittedEvent(
+	responseID ResponseID,
+	surveyID SurveyID,
+	transactionID TransactionID,
+	memberID MemberID,
+) *SurveyResponseSubmittedEvent {
+	return &SurveyResponseSubmittedEvent{
+		eventID:       uuid.New().String(),
+		responseID:    responseID,
+		surveyID:      surveyID,
+		transactionID: transactionID,
+		memberID:      memberID,
+		occurredAt:    time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *SurveyResponseSubmittedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *SurveyResponseSubmittedEvent) EventType() string {
+	return "survey.response_submitted"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *SurveyResponseSubmittedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *SurveyResponseSubmittedEvent) AggregateID() string {
+	return e.responseID.String()
+}
+
+// SurveyID 獲取問卷 ID
+func (e *SurveyResponseSubmittedEvent) SurveyID() SurveyID {
+	return e.surveyID
+}
+
+// TransactionID 獲取交易 ID
+func (e *SurveyResponseSubmittedEvent) TransactionID() TransactionID {
+	return e.transactionID
+}
+
+// MemberID 獲取會員 ID
+func (e *SurveyResponseSubmittedEvent) MemberID() MemberID {
+	return e.memberID
+}
+
+// ===========================
+// SurveyBonusAwarded 領域事件
+// ===========================
+
+// SurveyBonusAwardedEvent 問卷獎勵已發放事件
+type SurveyBonusAwardedEvent struct {
+	eventID       string
+	responseID    ResponseID
+	transactionID TransactionID
+	memberID      MemberID
+	points        int
+	occurredAt    time.Time
+}
+
+// NewSurveyBonusAwardedEvent 創建問卷獎勵已發放事件
+func NewSurveyBonusAwardedEvent(
+	responseID ResponseID,
+	transactionID TransactionID,
+	memberID MemberID,
+	points int,
+) *SurveyBonusAwardedEvent {
+	return &SurveyBonusAwardedEvent{
+		eventID:       uuid.New().String(),
+		responseID:    responseID,
+		transactionID: transactionID,
+		memberID:      memberID,
+		points:        points,
+		occurredAt:    time.Now(),
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *SurveyBonusAwardedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *SurveyBonusAwardedEvent) EventType() string {
+	return "survey.bonus_awarded"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *SurveyBonusAwardedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *SurveyBonusAwardedEvent) AggregateID() string {
+	return e.responseID.String()
+}
+
+// TransactionID 獲取交易 ID
+func (e *SurveyBonusAwardedEvent) TransactionID() TransactionID {
+	return e.transactionID
+}
+
+// MemberID 獲取會員 ID
+func (e *SurveyBonusAwardedEvent) MemberID() MemberID {
+	return e.memberID
+}
+
+// Points 獲取發放積分
+func (e *SurveyBonusAwardedEvent) Points() int {
+	return e.points
+}
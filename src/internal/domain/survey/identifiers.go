@@ -0,0 +1,126 @@
+package survey
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// 實體 ID 類型定義
+// ===========================
+
+// 設計原則：使用泛型 EntityID[T]（與其他上下文一致）
+
+// ===========================
+// SurveyID - 問卷 ID
+// ===========================
+
+// SurveyMarker 是 SurveyID 的標記類型
+type SurveyMarker struct{}
+
+// SurveyID 問卷的唯一標識符
+type SurveyID = shared.EntityID[SurveyMarker]
+
+// NewSurveyID 生成新的問卷 ID（UUID v4）
+func NewSurveyID() SurveyID {
+	return shared.NewEntityID[SurveyMarker]()
+}
+
+// SurveyIDFromString 從字串解析問卷 ID
+//
+// 返回：
+//   SurveyID - 解析成功的 ID
+//   error - 解析失敗（返回 ErrInvalidSurveyID）
+func SurveyIDFromString(s string) (SurveyID, error) {
+	return shared.EntityIDFromString[SurveyMarker](s, ErrInvalidSurveyID)
+}
+
+// ===========================
+// QuestionID - 題目 ID
+// ===========================
+
+// QuestionMarker 是 QuestionID 的標記類型
+type QuestionMarker struct{}
+
+// QuestionID 題目的唯一標識符
+//
+// 設計原則：修訂問卷時保留既有題目 ID，已提交的答案仍可對應
+type QuestionID = shared.EntityID[QuestionMarker]
+
+// NewQuestionID 生成新的題目 ID（UUID v4）
+func NewQuestionID() QuestionID {
+	return shared.NewEntityID[QuestionMarker]()
+}
+
+// QuestionIDFromString 從字串解析題目 ID
+func QuestionIDFromString(s string) (QuestionID, error) {
+	return shared.EntityIDFromString[QuestionMarker](s, ErrInvalidQuestionID)
+}
+
+// ===========================
+// ResponseID - 問卷回覆 ID
+// ===========================
+
+// ResponseMarker 是 ResponseID 的標記類型
+type ResponseMarker struct{}
+
+// ResponseID 問卷回覆的唯一標識符
+type ResponseID = shared.EntityID[ResponseMarker]
+
+// NewResponseID 生成新的回覆 ID（UUID v4）
+func NewResponseID() ResponseID {
+	return shared.NewEntityID[ResponseMarker]()
+}
+
+// ResponseIDFromString 從字串解析回覆 ID
+func ResponseIDFromString(s string) (ResponseID, error) {
+	return shared.EntityIDFromString[ResponseMarker](s, ErrInvalidResponseID)
+}
+
+// ===========================
+// TransactionID / MemberID - 跨上下文引用
+// ===========================
+
+// 注意：survey.TransactionID / survey.MemberID 與 invoice、member 上下文的 ID 是不同類型
+// 跨上下文傳遞時使用 String() 轉換
+
+// TransactionMarker 是 TransactionID 的標記類型
+type TransactionMarker struct{}
+
+// TransactionID 發票交易 ID（引用 Invoice Context）
+type TransactionID = shared.EntityID[TransactionMarker]
+
+// TransactionIDFromString 從字串解析交易 ID
+func TransactionIDFromString(s string) (TransactionID, error) {
+	return shared.EntityIDFromString[TransactionMarker](s, ErrInvalidTransactionID)
+}
+
+// MemberMarker 是 MemberID 的標記類型
+type MemberMarker struct{}
+
+// MemberID 會員 ID（引用 Member Context）
+type MemberID = shared.EntityID[MemberMarker]
+
+// MemberIDFromString 從字串解析會員 ID
+func MemberIDFromString(s string) (MemberID, error) {
+	return shared.EntityIDFromString[MemberMarker](s, ErrInvalidMemberID)
+}
+
+// ===========================
+// TokenID - 問卷 Token ID
+// ===========================
+
+// TokenMarker 是 TokenID 的標記類型
+type TokenMarker struct{}
+
+// TokenID 問卷 Token 的唯一標識符（用於單次使用檢查）
+type TokenID = shared.EntityID[TokenMarker]
+
+// NewTokenID 生成新的 Token ID（UUID v4）
+func NewTokenID() TokenID {
+	return shared.NewEntityID[TokenMarker]()
+}
+
+// TokenIDFromString 從字串解析 Token ID
+func TokenIDFromString(s string) (TokenID, error) {
+	return shared.EntityIDFromString[TokenMarker](s, ErrInvalidTokenID)
+}
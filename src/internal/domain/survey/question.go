@@ -0,0 +1,212 @@
+package survey
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// 題目內容長度上限（字元數）
+const maxQuestionTextLength = 200
+
+// ===========================
+// Question 實體
+// ===========================
+
+// Question 問卷題目（Survey 聚合內的實體）
+//
+// 設計原則：
+// - 不可變：修訂問卷時以新的題目清單整批替換
+// - 題目順序由 Survey 內的清單順序決定
+//
+// 業務規則：
+// - 選擇題至少兩個不重複的選項（單選）
+// - 文字題、評分題不可設定選項
+// - 可設定顯示條件（分支），被隱藏的必填題視為非必填
+type Question struct {
+	questionID   QuestionID
+	text         string
+	questionType QuestionType
+	options      []string
+	required     bool
+	conditions   []DisplayCondition
+}
+
+// NewQuestion 創建新題目（生成新的題目 ID）
+func NewQuestion(text string, questionType QuestionType, options []string, required bool) (Question, error) {
+	return ReconstructQuestion(NewQuestionID(), text, questionType, options, required)
+}
+
+// ReconstructQuestion 以既有 ID 建立題目
+//
+// 用途：
+// - Repository 從資料庫重建
+// - 修訂問卷時保留既有題目（已提交的答案仍可對應）
+func ReconstructQuestion(
+	questionID QuestionID,
+	text string,
+	questionType QuestionType,
+	options []string,
+	required bool,
+) (Question, error) {
+	if questionID.IsEmpty() {
+		return Question{}, ErrInvalidQuestionID.WithContext(
+			"reason", "questionID cannot be empty",
+		)
+	}
+
+	text = strings.TrimSpace(text)
+	if text == "" || utf8.RuneCountInString(text) > maxQuestionTextLength {
+		return Question{}, ErrInvalidQuestionText.WithContext(
+			"question_id", questionID.String(),
+			"length", utf8.RuneCountInString(text),
+		)
+	}
+
+	if !questionType.IsValid() {
+		return Question{}, ErrInvalidQuestionType.WithContext(
+			"question_type", string(questionType),
+		)
+	}
+
+	normalized, err := normalizeOptions(questionType, options)
+	if err != nil {
+		return Question{}, err
+	}
+
+	return Question{
+		questionID:   questionID,
+		text:         text,
+		questionType: questionType,
+		options:      normalized,
+		required:     required,
+	}, nil
+}
+
+// normalizeOptions 驗證並整理選項（去除前後空白）
+func normalizeOptions(questionType QuestionType, options []string) ([]string, error) {
+	if questionType != QuestionTypeMultipleChoice {
+		if len(options) > 0 {
+			return nil, ErrInvalidQuestionOptions.WithContext(
+				"question_type", questionType.String(),
+				"reason", "only multiple_choice questions can have options",
+			)
+		}
+		return nil, nil
+	}
+
+	normalized := make([]string, 0, len(options))
+	seen := make(map[string]bool, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" || seen[option] {
+			return nil, ErrInvalidQuestionOptions.WithContext(
+				"option", option,
+				"reason", "options must be non-empty and unique",
+			)
+		}
+		seen[option] = true
+		normalized = append(normalized, option)
+	}
+
+	if len(normalized) < 2 {
+		return nil, ErrInvalidQuestionOptions.WithContext(
+			"option_count", len(normalized),
+			"reason", "multiple_choice requires at least 2 options",
+		)
+	}
+
+	return normalized, nil
+}
+
+// ValidateAnswer 驗證答案是否符合題型
+//
+// 錯誤：
+// - ErrUnknownQuestion（答案不屬於此題）
+// - ErrAnswerTypeMismatch（答案類型與題型不符）
+// - ErrInvalidChoice（選項不在清單中）
+func (q Question) ValidateAnswer(answer Answer) error {
+	if !answer.QuestionID().Equals(q.questionID) {
+		return ErrUnknownQuestion.WithContext(
+			"question_id", answer.QuestionID().String(),
+		)
+	}
+
+	if answer.Type() != q.questionType {
+		return ErrAnswerTypeMismatch.WithContext(
+			"question_id", q.questionID.String(),
+			"question_type", q.questionType.String(),
+			"answer_type", answer.Type().String(),
+		)
+	}
+
+	if q.questionType == QuestionTypeMultipleChoice && !q.hasOption(answer.Text()) {
+		return ErrInvalidChoice.WithContext(
+			"question_id", q.questionID.String(),
+			"choice", answer.Text(),
+		)
+	}
+
+	return nil
+}
+
+// WithConditions 返回設定顯示條件後的題目副本（全部成立才顯示）
+//
+// 注意：條件引用是否有效（題目存在、題型相符、無循環）由 Survey 檢查
+func (q Question) WithConditions(conditions ...DisplayCondition) Question {
+	q.conditions = make([]DisplayCondition, len(conditions))
+	copy(q.conditions, conditions)
+	return q
+}
+
+// hasOption 判斷選項是否存在
+func (q Question) hasOption(option string) bool {
+	for _, o := range q.options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+// ===========================
+// Getter Methods
+// ===========================
+
+// QuestionID 返回題目 ID
+func (q Question) QuestionID() QuestionID {
+	return q.questionID
+}
+
+// Text 返回題目內容
+func (q Question) Text() string {
+	return q.text
+}
+
+// Type 返回題型
+func (q Question) Type() QuestionType {
+	return q.questionType
+}
+
+// Options 返回選項（副本）
+func (q Question) Options() []string {
+	options := make([]string, len(q.options))
+	copy(options, q.options)
+	return options
+}
+
+// IsRequired 判斷是否為必填題
+func (q Question) IsRequired() bool {
+	return q.required
+}
+
+// Conditions 返回顯示條件（副本）
+func (q Question) Conditions() []DisplayCondition {
+	conditions := make([]DisplayCondition, len(q.conditions))
+	copy(conditions, q.conditions)
+	return conditions
+}
+
+// IsConditional 判斷是否有顯示條件
+func (q Question) IsConditional() bool {
+	return len(q.conditions) > 0
+}
@@ -0,0 +1,134 @@
+package survey
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// Survey Repository 介面
+// ===========================
+
+// SurveyRepository 問卷倉儲介面
+//
+// 設計原則：
+// 1. 依賴倒置原則（DIP）：Domain Layer 定義介面，Infrastructure Layer 實作
+// 2. 以聚合為單位存取：題目隨問卷一併保存與載入
+// 3. 事務支持：寫操作 ctx 必須 non-nil，讀操作 ctx 可為 nil
+type SurveyRepository interface {
+	// Save 保存新的問卷（含題目）
+	Save(ctx shared.TransactionContext, s *Survey) error
+
+	// Update 更新問卷（整批替換題目清單）
+	//
+	// 錯誤：ErrSurveyNotFound（如果問卷不存在）
+	Update(ctx shared.TransactionContext, s *Survey) error
+
+	// FindByID 根據問卷 ID 查找
+	//
+	// 返回：找到的問卷，或 ErrSurveyNotFound
+	FindByID(ctx shared.TransactionContext, id SurveyID) (*Survey, error)
+
+	// FindActive 查找啟用中的問卷
+	//
+	// 返回：啟用中的問卷，或 ErrNoActiveSurvey
+	FindActive(ctx shared.TransactionContext) (*Survey, error)
+
+	// FindAll 分頁查詢所有問卷（依建立時間降冪）
+	FindAll(ctx shared.TransactionContext, limit, offset int) ([]*Survey, error)
+}
+
+// ===========================
+// SurveyTokenUsage Repository 介面
+// ===========================
+
+// SurveyTokenUsageRepository 問卷 Token 使用紀錄倉儲介面
+//
+// 用途：Token 單次使用（提交問卷後即失效，防止重複提交刷積分）
+type SurveyTokenUsageRepository interface {
+	// MarkUsed 記錄 Token 已使用
+	//
+	// 錯誤：ErrSurveyTokenUsed（Token 已使用過）
+	MarkUsed(ctx shared.TransactionContext, tokenID TokenID, transactionID TransactionID, usedAt time.Time) error
+
+	// IsUsed 判斷 Token 是否已使用
+	IsUsed(ctx shared.TransactionContext, tokenID TokenID) (bool, error)
+}
+
+// ===========================
+// SurveyResponse Repository 介面
+// ===========================
+
+// SurveyResponseRepository 問卷回覆倉儲介面
+type SurveyResponseRepository interface {
+	// Save 保存新的問卷回覆（含答案）
+	//
+	// 錯誤：ErrResponseAlreadySubmitted（同一交易已有回覆，由唯一約束保證）
+	Save(ctx shared.TransactionContext, r *SurveyResponse) error
+
+	// Update 更新問卷回覆（獎勵狀態）
+	//
+	// 錯誤：ErrResponseNotFound（如果回覆不存在）
+	Update(ctx shared.TransactionContext, r *SurveyResponse) error
+
+	// FindByTransactionID 根據交易 ID 查找
+	//
+	// 使用場景：交易驗證後結算待發放的問卷獎勵
+	// 返回：找到的回覆，或 ErrResponseNotFound
+	FindByTransactionID(ctx shared.TransactionContext, transactionID TransactionID) (*SurveyResponse, error)
+}
+
+// ===========================
+// PendingBonusQuery 查詢介面
+// ===========================
+
+// PendingBonusQuery 可結算的待發放問卷獎勵查詢（補結算排程使用，唯讀）
+//
+// 使用場景：
+// - 提交問卷與交易驗證同時發生時，驗證事件可能早於回覆寫入而找不到回覆
+// - 排程補結算：獎勵仍為 pending 但交易已驗證的回覆
+type PendingBonusQuery interface {
+	// FindSettleable 查詢獎勵待發放且交易已驗證的交易 ID（依提交時間排序，最多 limit 筆）
+	FindSettleable(ctx shared.TransactionContext, limit int) ([]TransactionID, error)
+}
+
+// ===========================
+// SurveyAnalyticsQuery 查詢介面
+// ===========================
+
+// SurveyAnalyticsQuery 問卷統計查詢（唯讀，US-006.4）
+//
+// 設計原則：
+// - 直接查詢 survey_responses / survey_answers（GROUP BY 聚合）
+// - 所有查詢都以提交時間區間 [from, to) 過濾（使用 survey_id + submitted_at 複合索引）
+type SurveyAnalyticsQuery interface {
+	// CountResponses 統計區間內的回覆數
+	CountResponses(ctx shared.TransactionContext, surveyID SurveyID, period DateRange) (int, error)
+
+	// RatingDistribution 評分題分佈（分數 → 人數）
+	RatingDistribution(ctx shared.TransactionContext, surveyID SurveyID, questionID QuestionID, period DateRange) (map[int]int, error)
+
+	// ChoiceCounts 選擇題各選項人數（選項 → 人數）
+	ChoiceCounts(ctx shared.TransactionContext, surveyID SurveyID, questionID QuestionID, period DateRange) (map[string]int, error)
+
+	// TextAnswers 分頁查詢文字題答案（依提交時間降冪）
+	//
+	// 返回：當頁答案與區間內總筆數
+	TextAnswers(
+		ctx shared.TransactionContext,
+		surveyID SurveyID,
+		questionID QuestionID,
+		period DateRange,
+		limit, offset int,
+	) ([]TextAnswerEntry, int, error)
+
+	// RatingSamples 評分題樣本（依提交時間升冪）
+	RatingSamples(ctx shared.TransactionContext, surveyID SurveyID, questionID QuestionID, period DateRange) ([]RatingSample, error)
+
+	// StreamResponses 逐筆讀取區間內的回覆（依提交時間升冪，用於 CSV 匯出）
+	//
+	// 設計原則：以資料庫游標逐筆處理，不一次載入全部回覆
+	// fn 返回錯誤時停止讀取並返回該錯誤
+	StreamResponses(ctx shared.TransactionContext, surveyID SurveyID, period DateRange, fn func(ResponseRecord) error) error
+}
@@ -0,0 +1,260 @@
+package survey
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// SurveyResponse 聚合根
+// ===========================
+
+// SurveyResponse 問卷回覆聚合根
+//
+// 使用場景：
+// - 會員透過問卷連結提交答案（US-004）
+// - 交易驗證後發放 1 點問卷獎勵
+//
+// 不變量（Invariants）：
+// 1. 每筆交易只能有一份回覆（由 Repository 唯一約束保證）
+// 2. 答案必須符合提交當下的問卷定義
+// 3. 獎勵狀態只能 pending → awarded，且只發放一次
+//
+// 設計原則：
+// - 不直接修改 PointsAccount（由 Application Layer 協調入帳）
+type SurveyResponse struct {
+	// 識別欄位
+	responseID    ResponseID
+	surveyID      SurveyID
+	transactionID TransactionID
+	memberID      MemberID
+
+	// 答案
+	answers []Answer
+
+	// 獎勵狀態
+	bonusStatus    BonusStatus
+	bonusAwardedAt *time.Time
+
+	// 審計欄位
+	submittedAt time.Time
+	updatedAt   time.Time
+
+	// 待發布的領域事件
+	events []shared.DomainEvent
+}
+
+// ===========================
+// 建構函數（工廠方法）
+// ===========================
+
+// SubmitSurveyResponse 提交問卷回覆
+//
+// 驗證：
+// - 交易與會員不可為空
+// - 答案必須通過問卷驗證（Survey.ValidateAnswers）
+//
+// 初始狀態：獎勵待發放（pending）
+func SubmitSurveyResponse(
+	s *Survey,
+	transactionID TransactionID,
+	memberID MemberID,
+	answers []Answer,
+) (*SurveyResponse, error) {
+	if transactionID.IsEmpty() {
+		return nil, ErrInvalidTransactionID.WithContext(
+			"reason", "transactionID cannot be empty",
+		)
+	}
+
+	if memberID.IsEmpty() {
+		return nil, ErrInvalidMemberID.WithContext(
+			"reason", "memberID cannot be empty",
+		)
+	}
+
+	if err := s.ValidateAnswers(answers); err != nil {
+		return nil, err
+	}
+
+	copied := make([]Answer, len(answers))
+	copy(copied, answers)
+
+	now := time.Now()
+
+	r := &SurveyResponse{
+		responseID:    NewResponseID(),
+		surveyID:      s.SurveyID(),
+		transactionID: transactionID,
+		memberID:      memberID,
+		answers:       copied,
+		bonusStatus:   BonusStatusPending,
+		submittedAt:   now,
+		updatedAt:     now,
+		events:        make([]shared.DomainEvent, 0),
+	}
+
+	r.addEvent(NewSurveyResponseSubmittedEvent(
+		r.responseID,
+		r.surveyID,
+		transactionID,
+		memberID,
+	))
+
+	return r, nil
+}
+
+// ReconstructSurveyResponse 從持久化存儲重建聚合根
+//
+// 設計原則：
+// - 僅供 Repository 使用
+// - 不發布事件（事件已發生過）
+func ReconstructSurveyResponse(
+	responseID ResponseID,
+	surveyID SurveyID,
+	transactionID TransactionID,
+	memberID MemberID,
+	answers []Answer,
+	bonusStatus BonusStatus,
+	bonusAwardedAt *time.Time,
+	submittedAt time.Time,
+	updatedAt time.Time,
+) (*SurveyResponse, error) {
+	if responseID.IsEmpty() {
+		return nil, ErrInvalidResponseID.WithContext(
+			"reason", "invalid response ID in database",
+		)
+	}
+
+	if transactionID.IsEmpty() {
+		return nil, ErrInvalidTransactionID.WithContext(
+			"reason", "invalid transaction ID in database",
+		)
+	}
+
+	if !bonusStatus.IsValid() {
+		return nil, ErrInvalidBonusStatus.WithContext(
+			"status", bonusStatus.String(),
+			"reason", "invalid bonus status in database",
+		)
+	}
+
+	return &SurveyResponse{
+		responseID:     responseID,
+		surveyID:       surveyID,
+		transactionID:  transactionID,
+		memberID:       memberID,
+		answers:        answers,
+		bonusStatus:    bonusStatus,
+		bonusAwardedAt: bonusAwardedAt,
+		submittedAt:    submittedAt,
+		updatedAt:      updatedAt,
+		events:         make([]shared.DomainEvent, 0),
+	}, nil
+}
+
+// ===========================
+// 命令方法（狀態變更）
+// ===========================
+
+// AwardBonus 標記獎勵積分已發放
+//
+// 前置條件：獎勵狀態為 pending
+// 錯誤：ErrBonusAlreadyAwarded（重複發放）
+//
+// 注意：積分入帳由 Application Layer 於同一事務中完成
+func (r *SurveyResponse) AwardBonus() error {
+	if r.bonusStatus == BonusStatusAwarded {
+		return ErrBonusAlreadyAwarded.WithContext(
+			"response_id", r.responseID.String(),
+			"transaction_id", r.transactionID.String(),
+		)
+	}
+
+	now := time.Now()
+	r.bonusStatus = BonusStatusAwarded
+	r.bonusAwardedAt = &now
+	r.updatedAt = now
+
+	r.addEvent(NewSurveyBonusAwardedEvent(
+		r.responseID,
+		r.transactionID,
+		r.memberID,
+		SurveyBonusPoints,
+	))
+
+	return nil
+}
+
+// ===========================
+// 查詢方法
+// ===========================
+
+// ResponseID 返回回覆 ID
+func (r *SurveyResponse) ResponseID() ResponseID {
+	return r.responseID
+}
+
+// SurveyID 返回問卷 ID
+func (r *SurveyResponse) SurveyID() SurveyID {
+	return r.surveyID
+}
+
+// TransactionID 返回交易 ID
+func (r *SurveyResponse) TransactionID() TransactionID {
+	return r.transactionID
+}
+
+// MemberID 返回會員 ID
+func (r *SurveyResponse) MemberID() MemberID {
+	return r.memberID
+}
+
+// Answers 返回答案（副本）
+func (r *SurveyResponse) Answers() []Answer {
+	answers := make([]Answer, len(r.answers))
+	copy(answers, r.answers)
+	return answers
+}
+
+// BonusStatus 返回獎勵狀態
+func (r *SurveyResponse) BonusStatus() BonusStatus {
+	return r.bonusStatus
+}
+
+// IsBonusPending 判斷獎勵是否待發放
+func (r *SurveyResponse) IsBonusPending() bool {
+	return r.bonusStatus == BonusStatusPending
+}
+
+// BonusAwardedAt 返回獎勵發放時間（未發放時為 nil）
+func (r *SurveyResponse) BonusAwardedAt() *time.Time {
+	return r.bonusAwardedAt
+}
+
+// SubmittedAt 返回提交時間
+func (r *SurveyResponse) SubmittedAt() time.Time {
+	return r.submittedAt
+}
+
+// UpdatedAt 返回最後更新時間
+func (r *SurveyResponse) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// ===========================
+// 事件管理
+// ===========================
+
+// addEvent 添加領域事件到待發布列表（私有方法）
+func (r *SurveyResponse) addEvent(event shared.DomainEvent) {
+	r.events = append(r.events, event)
+}
+
+// PullEvents 獲取所有待發布事件並清空列表
+func (r *SurveyResponse) PullEvents() []shared.DomainEvent {
+	events := r.events
+	r.events = make([]shared.DomainEvent, 0)
+	return events
+}
@@ -0,0 +1,89 @@
+package survey_test
+
+import (
+	"testing"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// SurveyResponse 測試
+// ===========================
+
+// validAnswers 建立符合 testQuestions 的答案（評分 + 選擇）
+func validAnswers(t *testing.T, s *survey.Survey) []survey.Answer {
+	questions := s.Questions()
+	rating, err := survey.NewRatingAnswer(questions[0].QuestionID(), 5)
+	require.NoError(t, err)
+	choice, err := survey.NewChoiceAnswer(questions[2].QuestionID(), "會")
+	require.NoError(t, err)
+	return []survey.Answer{rating, choice}
+}
+
+// Test 17: 提交回覆後獎勵待發放，並發布提交事件
+func TestSubmitSurveyResponse_PendingBonus(t *testing.T) {
+	// Arrange
+	s := newTestSurvey(t)
+	transactionID := shared.NewEntityID[survey.TransactionMarker]()
+	memberID := shared.NewEntityID[survey.MemberMarker]()
+
+	// Act
+	r, err := survey.SubmitSurveyResponse(s, transactionID, memberID, validAnswers(t, s))
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, r.IsBonusPending())
+	assert.Nil(t, r.BonusAwardedAt())
+	assert.Len(t, r.Answers(), 2)
+	events := r.PullEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "survey.response_submitted", events[0].EventType())
+}
+
+// Test 18: 答案不符合問卷定義時拒絕提交
+func TestSubmitSurveyResponse_MissingRequired_ReturnsError(t *testing.T) {
+	// Arrange
+	s := newTestSurvey(t)
+	answers := validAnswers(t, s)[:1]
+
+	// Act
+	_, err := survey.SubmitSurveyResponse(
+		s,
+		shared.NewEntityID[survey.TransactionMarker](),
+		shared.NewEntityID[survey.MemberMarker](),
+		answers,
+	)
+
+	// Assert
+	assert.ErrorIs(t, err, survey.ErrMissingRequiredAnswer)
+}
+
+// Test 19: 獎勵只能發放一次
+func TestSurveyResponse_AwardBonus_OnlyOnce(t *testing.T) {
+	// Arrange
+	s := newTestSurvey(t)
+	r, err := survey.SubmitSurveyResponse(
+		s,
+		shared.NewEntityID[survey.TransactionMarker](),
+		shared.NewEntityID[survey.MemberMarker](),
+		validAnswers(t, s),
+	)
+	require.NoError(t, err)
+	r.PullEvents()
+
+	// Act
+	firstErr := r.AwardBonus()
+	secondErr := r.AwardBonus()
+
+	// Assert
+	assert.NoError(t, firstErr)
+	assert.ErrorIs(t, secondErr, survey.ErrBonusAlreadyAwarded)
+	assert.Equal(t, survey.BonusStatusAwarded, r.BonusStatus())
+	assert.NotNil(t, r.BonusAwardedAt())
+	events := r.PullEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "survey.bonus_awarded", events[0].EventType())
+}
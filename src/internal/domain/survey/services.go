@@ -0,0 +1,49 @@
+package survey
+
+// ===========================
+// SurveyActivationService 領域服務
+// ===========================
+
+// SurveyActivationService 問卷啟用服務
+//
+// 職責：維護「同時只能有一個啟用問卷」規則（BR-004-04）
+//
+// 設計原則：
+// - 跨聚合規則放在領域服務，不讓 Survey 彼此引用
+// - 由 Application Layer 在同一事務中載入並保存兩個聚合
+type SurveyActivationService struct{}
+
+// NewSurveyActivationService 創建啟用服務
+func NewSurveyActivationService() *SurveyActivationService {
+	return &SurveyActivationService{}
+}
+
+// Activate 啟用目標問卷，並停用目前啟用中的問卷
+//
+// 參數：
+//   target - 要啟用的問卷
+//   current - 目前啟用中的問卷（可為 nil）
+//
+// 返回：
+//   deactivated - 被停用的問卷（沒有則為 nil，呼叫端需一併保存）
+//   error - ErrSurveyAlreadyActive（目標已啟用）
+func (s *SurveyActivationService) Activate(target, current *Survey) (deactivated *Survey, err error) {
+	if target.IsActive() {
+		return nil, ErrSurveyAlreadyActive.WithContext(
+			"survey_id", target.SurveyID().String(),
+		)
+	}
+
+	if current != nil && current.IsActive() && !current.SurveyID().Equals(target.SurveyID()) {
+		if err := current.Deactivate(); err != nil {
+			return nil, err
+		}
+		deactivated = current
+	}
+
+	if err := target.Activate(); err != nil {
+		return nil, err
+	}
+
+	return deactivated, nil
+}
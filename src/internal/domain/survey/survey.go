@@ -0,0 +1,360 @@
+package survey
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// 問卷標題長度上限（字元數）
+const maxSurveyTitleLength = 100
+
+// ===========================
+// Survey 聚合根
+// ===========================
+
+// Survey 問卷聚合根
+//
+// 使用場景：
+// - 管理員於後台建立、修訂問卷（不需重新部署）
+// - 會員填寫問卷時，依題目定義驗證答案
+//
+// 不變量（Invariants）：
+// 1. 標題不可為空
+// 2. 至少包含一個題目，題目 ID 不可重複
+// 3. 題目順序即清單順序
+// 4. 顯示條件只能引用本問卷的題目，且不可形成循環
+//
+// 單一啟用規則（BR-004-04）：
+// - 由 SurveyActivationService 協調（跨聚合規則）
+// - 資料庫以部分唯一索引作為最後防線
+type Survey struct {
+	// 識別欄位
+	surveyID SurveyID
+
+	// 問卷定義
+	title       string
+	description string
+	questions   []Question
+
+	// 啟用狀態
+	active bool
+
+	// 審計欄位
+	createdAt time.Time
+	updatedAt time.Time
+
+	// 待發布的領域事件
+	events []shared.DomainEvent
+}
+
+// ===========================
+// 建構函數（工廠方法）
+// ===========================
+
+// NewSurvey 創建新問卷（預設未啟用）
+//
+// 錯誤：
+// - ErrInvalidSurveyTitle
+// - ErrSurveyHasNoQuestions
+// - ErrDuplicateQuestion
+// - ErrUnknownConditionQuestion / ErrInvalidDisplayCondition / ErrDisplayConditionCycle
+func NewSurvey(title, description string, questions []Question) (*Survey, error) {
+	title, description, err := validateDefinition(title, description, questions)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	s := &Survey{
+		surveyID:    NewSurveyID(),
+		title:       title,
+		description: description,
+		questions:   copyQuestions(questions),
+		active:      false,
+		createdAt:   now,
+		updatedAt:   now,
+		events:      make([]shared.DomainEvent, 0),
+	}
+
+	s.addEvent(NewSurveyCreatedEvent(s.surveyID, s.title, len(s.questions)))
+
+	return s, nil
+}
+
+// ReconstructSurvey 從持久化存儲重建聚合根
+//
+// 設計原則：
+// - 僅供 Repository 使用
+// - 不發布事件（事件已發生過）
+// - 仍檢查不變量（防禦資料損毀）
+func ReconstructSurvey(
+	surveyID SurveyID,
+	title string,
+	description string,
+	questions []Question,
+	active bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*Survey, error) {
+	if surveyID.IsEmpty() {
+		return nil, ErrInvalidSurveyID.WithContext(
+			"reason", "invalid survey ID in database",
+		)
+	}
+
+	title, description, err := validateDefinition(title, description, questions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Survey{
+		surveyID:    surveyID,
+		title:       title,
+		description: description,
+		questions:   copyQuestions(questions),
+		active:      active,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+		events:      make([]shared.DomainEvent, 0),
+	}, nil
+}
+
+// validateDefinition 驗證問卷定義（標題與題目清單）
+func validateDefinition(title, description string, questions []Question) (string, string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" || utf8.RuneCountInString(title) > maxSurveyTitleLength {
+		return "", "", ErrInvalidSurveyTitle.WithContext(
+			"length", utf8.RuneCountInString(title),
+		)
+	}
+
+	if len(questions) == 0 {
+		return "", "", ErrSurveyHasNoQuestions
+	}
+
+	seen := make(map[string]bool, len(questions))
+	for _, q := range questions {
+		id := q.QuestionID().String()
+		if seen[id] {
+			return "", "", ErrDuplicateQuestion.WithContext("question_id", id)
+		}
+		seen[id] = true
+	}
+
+	if err := validateConditions(questions); err != nil {
+		return "", "", err
+	}
+
+	return title, strings.TrimSpace(description), nil
+}
+
+// copyQuestions 複製題目清單（防止外部修改）
+func copyQuestions(questions []Question) []Question {
+	copied := make([]Question, len(questions))
+	copy(copied, questions)
+	return copied
+}
+
+// ===========================
+// 業務方法
+// ===========================
+
+// Revise 修訂問卷定義（標題、說明、題目清單）
+//
+// 業務規則：
+// - 啟用中的問卷也可修訂（管理員即時調整，不需重新部署）
+// - 以 ReconstructQuestion 保留既有題目 ID，已提交的答案仍可對應
+func (s *Survey) Revise(title, description string, questions []Question) error {
+	title, description, err := validateDefinition(title, description, questions)
+	if err != nil {
+		return err
+	}
+
+	s.title = title
+	s.description = description
+	s.questions = copyQuestions(questions)
+	s.updatedAt = time.Now()
+
+	s.addEvent(NewSurveyRevisedEvent(s.surveyID, len(s.questions)))
+
+	return nil
+}
+
+// Activate 啟用問卷
+//
+// 注意：停用其他問卷由 SurveyActivationService 負責
+func (s *Survey) Activate() error {
+	if s.active {
+		return ErrSurveyAlreadyActive.WithContext(
+			"survey_id", s.surveyID.String(),
+		)
+	}
+
+	s.active = true
+	s.updatedAt = time.Now()
+
+	s.addEvent(NewSurveyActivatedEvent(s.surveyID))
+
+	return nil
+}
+
+// Deactivate 停用問卷
+func (s *Survey) Deactivate() error {
+	if !s.active {
+		return ErrSurveyNotActive.WithContext(
+			"survey_id", s.surveyID.String(),
+		)
+	}
+
+	s.active = false
+	s.updatedAt = time.Now()
+
+	s.addEvent(NewSurveyDeactivatedEvent(s.surveyID))
+
+	return nil
+}
+
+// ValidateAnswers 依題目定義驗證一份答案
+//
+// 驗證規則：
+// 1. 每個答案必須對應本問卷的題目（ErrUnknownQuestion）
+// 2. 同一題只能作答一次（ErrDuplicateAnswer）
+// 3. 答案類型與題型一致、選項存在（Question.ValidateAnswer）
+// 4. 依顯示條件被隱藏的題目不可作答（ErrHiddenQuestionAnswered）
+// 5. 顯示中的必填題皆有作答（ErrMissingRequiredAnswer，隱藏的必填題視為非必填）
+func (s *Survey) ValidateAnswers(answers []Answer) error {
+	answered := make(map[string]Answer, len(answers))
+
+	for _, answer := range answers {
+		id := answer.QuestionID().String()
+
+		q, ok := s.Question(answer.QuestionID())
+		if !ok {
+			return ErrUnknownQuestion.WithContext(
+				"survey_id", s.surveyID.String(),
+				"question_id", id,
+			)
+		}
+
+		if _, duplicated := answered[id]; duplicated {
+			return ErrDuplicateAnswer.WithContext("question_id", id)
+		}
+		answered[id] = answer
+
+		if err := q.ValidateAnswer(answer); err != nil {
+			return err
+		}
+	}
+
+	visible := visibility(s.questions, answered)
+
+	for _, q := range s.questions {
+		id := q.QuestionID().String()
+		_, isAnswered := answered[id]
+
+		if isAnswered && !visible[id] {
+			return ErrHiddenQuestionAnswered.WithContext(
+				"question_id", id,
+				"question_text", q.Text(),
+			)
+		}
+
+		if q.IsRequired() && visible[id] && !isAnswered {
+			return ErrMissingRequiredAnswer.WithContext(
+				"question_id", id,
+				"question_text", q.Text(),
+			)
+		}
+	}
+
+	return nil
+}
+
+// VisibleQuestions 依目前答案返回應顯示的題目（依問卷順序）
+//
+// 使用場景：問卷頁面依已填答案即時顯示 / 隱藏題目
+func (s *Survey) VisibleQuestions(answers []Answer) []Question {
+	answered := make(map[string]Answer, len(answers))
+	for _, answer := range answers {
+		answered[answer.QuestionID().String()] = answer
+	}
+
+	visible := visibility(s.questions, answered)
+
+	questions := make([]Question, 0, len(s.questions))
+	for _, q := range s.questions {
+		if visible[q.QuestionID().String()] {
+			questions = append(questions, q)
+		}
+	}
+	return questions
+}
+
+// ===========================
+// Getter Methods
+// ===========================
+
+// SurveyID 返回問卷 ID
+func (s *Survey) SurveyID() SurveyID {
+	return s.surveyID
+}
+
+// Title 返回標題
+func (s *Survey) Title() string {
+	return s.title
+}
+
+// Description 返回說明
+func (s *Survey) Description() string {
+	return s.description
+}
+
+// Questions 返回題目清單（依順序，副本）
+func (s *Survey) Questions() []Question {
+	return copyQuestions(s.questions)
+}
+
+// Question 根據 ID 查找題目
+func (s *Survey) Question(id QuestionID) (Question, bool) {
+	for _, q := range s.questions {
+		if q.QuestionID().Equals(id) {
+			return q, true
+		}
+	}
+	return Question{}, false
+}
+
+// IsActive 判斷是否啟用中
+func (s *Survey) IsActive() bool {
+	return s.active
+}
+
+// CreatedAt 返回創建時間
+func (s *Survey) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// UpdatedAt 返回更新時間
+func (s *Survey) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// ===========================
+// 事件管理
+// ===========================
+
+// addEvent 添加領域事件到待發布列表（私有方法）
+func (s *Survey) addEvent(event shared.DomainEvent) {
+	s.events = append(s.events, event)
+}
+
+// PullEvents 獲取所有待發布事件並清空列表
+func (s *Survey) PullEvents() []shared.DomainEvent {
+	events := s.events
+	s.events = make([]shared.DomainEvent, 0)
+	return events
+}
@@ -0,0 +1,202 @@
+package survey_test
+
+import (
+	"testing"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testQuestions 建立測試用題目：評分（必填）、文字（選填）、選擇（必填）
+func testQuestions(t *testing.T) []survey.Question {
+	rating, err := survey.NewQuestion("您對今日的用餐體驗滿意嗎？", survey.QuestionTypeRating, nil, true)
+	require.NoError(t, err)
+	text, err := survey.NewQuestion("您最喜歡哪道菜？", survey.QuestionTypeText, nil, false)
+	require.NoError(t, err)
+	choice, err := survey.NewQuestion("您會推薦給朋友嗎？", survey.QuestionTypeMultipleChoice, []string{"會", "不會", "不確定"}, true)
+	require.NoError(t, err)
+	return []survey.Question{rating, text, choice}
+}
+
+func newTestSurvey(t *testing.T) *survey.Survey {
+	s, err := survey.NewSurvey("餐廳滿意度問卷", "填寫可得 1 點", testQuestions(t))
+	require.NoError(t, err)
+	return s
+}
+
+// ===========================
+// Question 測試
+// ===========================
+
+// Test 1: 選擇題至少兩個不重複選項
+func TestNewQuestion_MultipleChoice_InvalidOptions(t *testing.T) {
+	_, err := survey.NewQuestion("Q", survey.QuestionTypeMultipleChoice, []string{"A"}, true)
+	assert.ErrorIs(t, err, survey.ErrInvalidQuestionOptions)
+
+	_, err = survey.NewQuestion("Q", survey.QuestionTypeMultipleChoice, []string{"A", " A "}, true)
+	assert.ErrorIs(t, err, survey.ErrInvalidQuestionOptions)
+}
+
+// Test 2: 非選擇題不可設定選項、題型必須有效
+func TestNewQuestion_InvalidTypeOrOptions(t *testing.T) {
+	_, err := survey.NewQuestion("Q", survey.QuestionTypeRating, []string{"A", "B"}, true)
+	assert.ErrorIs(t, err, survey.ErrInvalidQuestionOptions)
+
+	_, err = survey.NewQuestion("Q", survey.QuestionType("slider"), nil, true)
+	assert.ErrorIs(t, err, survey.ErrInvalidQuestionType)
+
+	_, err = survey.NewQuestion("   ", survey.QuestionTypeText, nil, true)
+	assert.ErrorIs(t, err, survey.ErrInvalidQuestionText)
+}
+
+// ===========================
+// Answer 測試
+// ===========================
+
+// Test 3: 評分必須在 1-5 之間
+func TestNewRatingAnswer_OutOfRange_ReturnsError(t *testing.T) {
+	id := survey.NewQuestionID()
+
+	_, err := survey.NewRatingAnswer(id, 0)
+	assert.ErrorIs(t, err, survey.ErrInvalidRating)
+	_, err = survey.NewRatingAnswer(id, 6)
+	assert.ErrorIs(t, err, survey.ErrInvalidRating)
+
+	answer, err := survey.NewRatingAnswer(id, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 5, answer.Rating())
+}
+
+// ===========================
+// Survey 聚合測試
+// ===========================
+
+// Test 4: 建立問卷（預設未啟用，保留題目順序）
+func TestNewSurvey_Success(t *testing.T) {
+	s := newTestSurvey(t)
+
+	assert.False(t, s.IsActive())
+	require.Len(t, s.Questions(), 3)
+	assert.Equal(t, survey.QuestionTypeRating, s.Questions()[0].Type())
+	assert.Equal(t, survey.QuestionTypeMultipleChoice, s.Questions()[2].Type())
+	events := s.PullEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "survey.created", events[0].EventType())
+}
+
+// Test 5: 不變量：至少一個題目、標題不可為空、題目不可重複
+func TestNewSurvey_InvalidDefinition_ReturnsError(t *testing.T) {
+	questions := testQuestions(t)
+
+	_, err := survey.NewSurvey("問卷", "", nil)
+	assert.ErrorIs(t, err, survey.ErrSurveyHasNoQuestions)
+
+	_, err = survey.NewSurvey(" ", "", questions)
+	assert.ErrorIs(t, err, survey.ErrInvalidSurveyTitle)
+
+	_, err = survey.NewSurvey("問卷", "", []survey.Question{questions[0], questions[0]})
+	assert.ErrorIs(t, err, survey.ErrDuplicateQuestion)
+}
+
+// Test 6: 修訂問卷保留既有題目 ID
+func TestSurvey_Revise_KeepsExistingQuestionIDs(t *testing.T) {
+	s := newTestSurvey(t)
+	original := s.Questions()
+	reworded, err := survey.ReconstructQuestion(original[0].QuestionID(), "今晚的整體體驗？", survey.QuestionTypeRating, nil, true)
+	require.NoError(t, err)
+
+	err = s.Revise("新版問卷", "", []survey.Question{reworded, original[2]})
+
+	require.NoError(t, err)
+	assert.Equal(t, "新版問卷", s.Title())
+	require.Len(t, s.Questions(), 2)
+	assert.Equal(t, original[0].QuestionID(), s.Questions()[0].QuestionID())
+	assert.Equal(t, "今晚的整體體驗？", s.Questions()[0].Text())
+}
+
+// Test 7: 啟用 / 停用狀態轉換
+func TestSurvey_ActivateDeactivate(t *testing.T) {
+	s := newTestSurvey(t)
+	s.PullEvents()
+
+	require.NoError(t, s.Activate())
+	assert.ErrorIs(t, s.Activate(), survey.ErrSurveyAlreadyActive)
+	require.NoError(t, s.Deactivate())
+	assert.ErrorIs(t, s.Deactivate(), survey.ErrSurveyNotActive)
+
+	events := s.PullEvents()
+	require.Len(t, events, 2)
+	assert.Equal(t, "survey.activated", events[0].EventType())
+	assert.Equal(t, "survey.deactivated", events[1].EventType())
+}
+
+// Test 8: 驗證完整答案
+func TestSurvey_ValidateAnswers_Success(t *testing.T) {
+	s := newTestSurvey(t)
+	q := s.Questions()
+	rating, _ := survey.NewRatingAnswer(q[0].QuestionID(), 4)
+	choice, _ := survey.NewChoiceAnswer(q[2].QuestionID(), "會")
+
+	assert.NoError(t, s.ValidateAnswers([]survey.Answer{rating, choice}))
+}
+
+// Test 9: 答案驗證失敗情境
+func TestSurvey_ValidateAnswers_Failures(t *testing.T) {
+	s := newTestSurvey(t)
+	q := s.Questions()
+	rating, _ := survey.NewRatingAnswer(q[0].QuestionID(), 4)
+	choice, _ := survey.NewChoiceAnswer(q[2].QuestionID(), "會")
+	wrongChoice, _ := survey.NewChoiceAnswer(q[2].QuestionID(), "也許")
+	textOnRating, _ := survey.NewTextAnswer(q[0].QuestionID(), "很好")
+	unknown, _ := survey.NewRatingAnswer(survey.NewQuestionID(), 3)
+
+	tests := []struct {
+		name    string
+		answers []survey.Answer
+		want    error
+	}{
+		{"missing required", []survey.Answer{rating}, survey.ErrMissingRequiredAnswer},
+		{"choice not in options", []survey.Answer{rating, wrongChoice}, survey.ErrInvalidChoice},
+		{"type mismatch", []survey.Answer{textOnRating, choice}, survey.ErrAnswerTypeMismatch},
+		{"unknown question", []survey.Answer{rating, choice, unknown}, survey.ErrUnknownQuestion},
+		{"duplicate answer", []survey.Answer{rating, rating, choice}, survey.ErrDuplicateAnswer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, s.ValidateAnswers(tt.answers), tt.want)
+		})
+	}
+}
+
+// ===========================
+// SurveyActivationService 測試
+// ===========================
+
+// Test 10: 啟用問卷時自動停用其他問卷
+func TestSurveyActivationService_Activate_OnlyOneActive(t *testing.T) {
+	service := survey.NewSurveyActivationService()
+	current := newTestSurvey(t)
+	require.NoError(t, current.Activate())
+	target := newTestSurvey(t)
+
+	deactivated, err := service.Activate(target, current)
+
+	require.NoError(t, err)
+	assert.True(t, target.IsActive())
+	assert.False(t, current.IsActive())
+	assert.Same(t, current, deactivated)
+}
+
+// Test 11: 沒有啟用中的問卷
+func TestSurveyActivationService_Activate_NoCurrent(t *testing.T) {
+	service := survey.NewSurveyActivationService()
+	target := newTestSurvey(t)
+
+	deactivated, err := service.Activate(target, nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, deactivated)
+	assert.True(t, target.IsActive())
+}
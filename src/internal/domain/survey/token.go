@@ -0,0 +1,322 @@
+package survey
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// ===========================
+// SigningKey 簽章金鑰值對象
+// ===========================
+
+// 簽章金鑰最短長度（bytes，HMAC-SHA256 建議至少 32 bytes）
+const minSigningKeyLength = 32
+
+// SigningKey HMAC 簽章金鑰
+//
+// 設計原則：
+// - keyID 寫入 Token，驗證時據此挑選金鑰（支援金鑰輪替）
+// - secret 不提供 getter，避免外洩至日誌
+type SigningKey struct {
+	keyID  string
+	secret []byte
+}
+
+// NewSigningKey 建構函數（checked 版本）
+//
+// 驗證：
+// - keyID 不可為空、不可包含 "."（Token 分隔字元）
+// - secret 至少 32 bytes
+func NewSigningKey(keyID string, secret []byte) (SigningKey, error) {
+	keyID = strings.TrimSpace(keyID)
+	if keyID == "" || strings.Contains(keyID, ".") {
+		return SigningKey{}, ErrInvalidSigningKey.WithContext(
+			"key_id", keyID,
+			"reason", "key ID must be non-empty and must not contain '.'",
+		)
+	}
+	if len(secret) < minSigningKeyLength {
+		return SigningKey{}, ErrInvalidSigningKey.WithContext(
+			"key_id", keyID,
+			"reason", "secret must be at least 32 bytes",
+		)
+	}
+
+	copied := make([]byte, len(secret))
+	copy(copied, secret)
+	return SigningKey{keyID: keyID, secret: copied}, nil
+}
+
+// KeyID 返回金鑰 ID
+func (k SigningKey) KeyID() string {
+	return k.keyID
+}
+
+// sign 計算 HMAC-SHA256 簽章
+func (k SigningKey) sign(data string) []byte {
+	mac := hmac.New(sha256.New, k.secret)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// ===========================
+// SigningKeyRing 金鑰環
+// ===========================
+
+// SigningKeyRing 簽章金鑰環
+//
+// 金鑰輪替流程：
+// 1. 新金鑰設為 current，舊金鑰移至 previous
+// 2. 新 Token 一律以 current 簽章
+// 3. previous 僅用於驗證，待舊 Token 全部過期（TTL）後移除
+type SigningKeyRing struct {
+	current SigningKey
+	keys    map[string]SigningKey
+}
+
+// NewSigningKeyRing 創建金鑰環
+//
+// 錯誤：ErrInvalidSigningKey（金鑰 ID 重複）
+func NewSigningKeyRing(current SigningKey, previous ...SigningKey) (SigningKeyRing, error) {
+	keys := map[string]SigningKey{current.keyID: current}
+	for _, k := range previous {
+		if _, exists := keys[k.keyID]; exists {
+			return SigningKeyRing{}, ErrInvalidSigningKey.WithContext(
+				"key_id", k.keyID,
+				"reason", "duplicate key ID",
+			)
+		}
+		keys[k.keyID] = k
+	}
+	return SigningKeyRing{current: current, keys: keys}, nil
+}
+
+// ===========================
+// SurveyToken 值對象
+// ===========================
+
+// SurveyToken 已驗證的問卷 Token
+//
+// 使用場景（BR-004-05）：會員不需登入，以 Token 開啟問卷頁面
+//
+// Token 格式：<keyID>.<base64url(payload JSON)>.<base64url(HMAC-SHA256)>
+//
+// 綁定簽發當下啟用中的問卷：之後啟用其他問卷，會員仍填寫並以原問卷驗證答案
+type SurveyToken struct {
+	value         string
+	tokenID       TokenID
+	surveyID      SurveyID
+	transactionID TransactionID
+	memberID      MemberID
+	issuedAt      time.Time
+	expiresAt     time.Time
+}
+
+// Value 返回 Token 字串（放入問卷連結）
+func (t SurveyToken) Value() string {
+	return t.value
+}
+
+// TokenID 返回 Token ID
+func (t SurveyToken) TokenID() TokenID {
+	return t.tokenID
+}
+
+// SurveyID 返回綁定的問卷 ID
+func (t SurveyToken) SurveyID() SurveyID {
+	return t.surveyID
+}
+
+// TransactionID 返回綁定的交易 ID
+func (t SurveyToken) TransactionID() TransactionID {
+	return t.transactionID
+}
+
+// MemberID 返回綁定的會員 ID
+func (t SurveyToken) MemberID() MemberID {
+	return t.memberID
+}
+
+// IssuedAt 返回簽發時間
+func (t SurveyToken) IssuedAt() time.Time {
+	return t.issuedAt
+}
+
+// ExpiresAt 返回到期時間
+func (t SurveyToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+// tokenPayload Token 內容（JSON 序列化格式）
+type tokenPayload struct {
+	TokenID       string `json:"jti"`
+	SurveyID      string `json:"sid"`
+	TransactionID string `json:"tx"`
+	MemberID      string `json:"mid"`
+	IssuedAt      int64  `json:"iat"`
+	ExpiresAt     int64  `json:"exp"`
+}
+
+// ===========================
+// SurveyTokenService 領域服務
+// ===========================
+
+// DefaultSurveyTokenTTL 預設 Token 有效期（BR-004-06：30 天）
+const DefaultSurveyTokenTTL = 30 * 24 * time.Hour
+
+// SurveyTokenService 問卷 Token 簽發與驗證服務
+//
+// 安全設計：
+// - HMAC-SHA256 簽章，防止竄改網址中的交易 ID 刷取積分
+// - 驗證使用 hmac.Equal（固定時間比較）
+// - 單次使用由 SurveyTokenUsageRepository 記錄（本服務只負責簽章與期限）
+type SurveyTokenService struct {
+	keys SigningKeyRing
+	ttl  time.Duration
+}
+
+// NewSurveyTokenService 創建 Token 服務
+//
+// 錯誤：ErrInvalidTokenTTL（ttl <= 0）
+func NewSurveyTokenService(keys SigningKeyRing, ttl time.Duration) (*SurveyTokenService, error) {
+	if ttl <= 0 {
+		return nil, ErrInvalidTokenTTL.WithContext("ttl", ttl.String())
+	}
+	return &SurveyTokenService{keys: keys, ttl: ttl}, nil
+}
+
+// Issue 簽發 Token（以目前時間）
+func (s *SurveyTokenService) Issue(surveyID SurveyID, transactionID TransactionID, memberID MemberID) (SurveyToken, error) {
+	return s.IssueAt(surveyID, transactionID, memberID, time.Now())
+}
+
+// IssueAt 以指定時間簽發 Token
+func (s *SurveyTokenService) IssueAt(
+	surveyID SurveyID,
+	transactionID TransactionID,
+	memberID MemberID,
+	now time.Time,
+) (SurveyToken, error) {
+	if surveyID.IsEmpty() {
+		return SurveyToken{}, ErrInvalidSurveyID.WithContext(
+			"reason", "surveyID cannot be empty",
+		)
+	}
+	if transactionID.IsEmpty() {
+		return SurveyToken{}, ErrInvalidTransactionID.WithContext(
+			"reason", "transactionID cannot be empty",
+		)
+	}
+	if memberID.IsEmpty() {
+		return SurveyToken{}, ErrInvalidMemberID.WithContext(
+			"reason", "memberID cannot be empty",
+		)
+	}
+
+	token := SurveyToken{
+		tokenID:       NewTokenID(),
+		surveyID:      surveyID,
+		transactionID: transactionID,
+		memberID:      memberID,
+		issuedAt:      now.Truncate(time.Second),
+		expiresAt:     now.Add(s.ttl).Truncate(time.Second),
+	}
+
+	payload, err := json.Marshal(tokenPayload{
+		TokenID:       token.tokenID.String(),
+		SurveyID:      surveyID.String(),
+		TransactionID: transactionID.String(),
+		MemberID:      memberID.String(),
+		IssuedAt:      token.issuedAt.Unix(),
+		ExpiresAt:     token.expiresAt.Unix(),
+	})
+	if err != nil {
+		return SurveyToken{}, err
+	}
+
+	key := s.keys.current
+	signed := key.keyID + "." + base64.RawURLEncoding.EncodeToString(payload)
+	token.value = signed + "." + base64.RawURLEncoding.EncodeToString(key.sign(signed))
+
+	return token, nil
+}
+
+// Verify 驗證 Token（以目前時間）
+func (s *SurveyTokenService) Verify(raw string) (SurveyToken, error) {
+	return s.VerifyAt(raw, time.Now())
+}
+
+// VerifyAt 以指定時間驗證 Token
+//
+// 錯誤：
+// - ErrInvalidSurveyToken（格式錯誤、未知金鑰、簽章不符、內容無效）
+// - ErrSurveyTokenExpired（已超過有效期）
+func (s *SurveyTokenService) VerifyAt(raw string, now time.Time) (SurveyToken, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return SurveyToken{}, ErrInvalidSurveyToken.WithContext("reason", "malformed token")
+	}
+
+	key, ok := s.keys.keys[parts[0]]
+	if !ok {
+		return SurveyToken{}, ErrInvalidSurveyToken.WithContext(
+			"reason", "unknown signing key",
+			"key_id", parts[0],
+		)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, key.sign(parts[0]+"."+parts[1])) {
+		return SurveyToken{}, ErrInvalidSurveyToken.WithContext("reason", "signature mismatch")
+	}
+
+	payloadJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return SurveyToken{}, ErrInvalidSurveyToken.WithContext("reason", "malformed payload")
+	}
+
+	var payload tokenPayload
+	if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+		return SurveyToken{}, ErrInvalidSurveyToken.WithContext("reason", "malformed payload")
+	}
+
+	tokenID, err := TokenIDFromString(payload.TokenID)
+	if err != nil {
+		return SurveyToken{}, ErrInvalidSurveyToken.WithContext("reason", "invalid token ID")
+	}
+	surveyID, err := SurveyIDFromString(payload.SurveyID)
+	if err != nil {
+		return SurveyToken{}, ErrInvalidSurveyToken.WithContext("reason", "invalid survey ID")
+	}
+	transactionID, err := TransactionIDFromString(payload.TransactionID)
+	if err != nil {
+		return SurveyToken{}, ErrInvalidSurveyToken.WithContext("reason", "invalid transaction ID")
+	}
+	memberID, err := MemberIDFromString(payload.MemberID)
+	if err != nil {
+		return SurveyToken{}, ErrInvalidSurveyToken.WithContext("reason", "invalid member ID")
+	}
+
+	token := SurveyToken{
+		value:         raw,
+		tokenID:       tokenID,
+		surveyID:      surveyID,
+		transactionID: transactionID,
+		memberID:      memberID,
+		issuedAt:      time.Unix(payload.IssuedAt, 0),
+		expiresAt:     time.Unix(payload.ExpiresAt, 0),
+	}
+
+	if !now.Before(token.expiresAt) {
+		return SurveyToken{}, ErrSurveyTokenExpired.WithContext(
+			"token_id", tokenID.String(),
+			"expired_at", token.expiresAt,
+		)
+	}
+
+	return token, nil
+}
@@ -0,0 +1,119 @@
+package survey_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedNow 固定時鐘（2025-01-15 20:00 UTC）
+var fixedNow = time.Date(2025, 1, 15, 20, 0, 0, 0, time.UTC)
+
+func newSigningKey(t *testing.T, id string) survey.SigningKey {
+	key, err := survey.NewSigningKey(id, []byte(strings.Repeat(id, 32)))
+	require.NoError(t, err)
+	return key
+}
+
+func newTokenService(t *testing.T, current survey.SigningKey, previous ...survey.SigningKey) *survey.SurveyTokenService {
+	ring, err := survey.NewSigningKeyRing(current, previous...)
+	require.NoError(t, err)
+	service, err := survey.NewSurveyTokenService(ring, survey.DefaultSurveyTokenTTL)
+	require.NoError(t, err)
+	return service
+}
+
+func issueTestToken(t *testing.T, service *survey.SurveyTokenService) survey.SurveyToken {
+	token, err := service.IssueAt(
+		survey.NewSurveyID(),
+		shared.NewEntityID[survey.TransactionMarker](),
+		shared.NewEntityID[survey.MemberMarker](),
+		fixedNow,
+	)
+	require.NoError(t, err)
+	return token
+}
+
+// ===========================
+// SurveyTokenService 測試
+// ===========================
+
+// Test 12: 簽發與驗證（Token 攜帶問卷、交易與會員 ID）
+func TestSurveyTokenService_IssueAndVerify(t *testing.T) {
+	service := newTokenService(t, newSigningKey(t, "k1"))
+	issued := issueTestToken(t, service)
+
+	verified, err := service.VerifyAt(issued.Value(), fixedNow.Add(29*24*time.Hour))
+
+	require.NoError(t, err)
+	assert.Equal(t, issued.TokenID(), verified.TokenID())
+	assert.Equal(t, issued.SurveyID(), verified.SurveyID())
+	assert.Equal(t, issued.TransactionID(), verified.TransactionID())
+	assert.Equal(t, issued.MemberID(), verified.MemberID())
+	assert.True(t, verified.ExpiresAt().Equal(fixedNow.Add(survey.DefaultSurveyTokenTTL)))
+}
+
+// Test 13: 過期 Token
+func TestSurveyTokenService_Verify_Expired(t *testing.T) {
+	service := newTokenService(t, newSigningKey(t, "k1"))
+	issued := issueTestToken(t, service)
+
+	_, err := service.VerifyAt(issued.Value(), fixedNow.Add(survey.DefaultSurveyTokenTTL))
+
+	assert.ErrorIs(t, err, survey.ErrSurveyTokenExpired)
+}
+
+// Test 14: 竄改內容或格式錯誤
+func TestSurveyTokenService_Verify_Tampered(t *testing.T) {
+	service := newTokenService(t, newSigningKey(t, "k1"))
+	issued := issueTestToken(t, service)
+	other := issueTestToken(t, service)
+
+	parts := strings.Split(issued.Value(), ".")
+	otherParts := strings.Split(other.Value(), ".")
+	swapped := parts[0] + "." + otherParts[1] + "." + parts[2]
+
+	for _, raw := range []string{swapped, "garbage", issued.Value() + "x", ""} {
+		_, err := service.VerifyAt(raw, fixedNow)
+		assert.ErrorIs(t, err, survey.ErrInvalidSurveyToken, raw)
+	}
+}
+
+// Test 15: 金鑰輪替：舊金鑰簽發的 Token 在保留期間仍有效，移除後失效
+func TestSurveyTokenService_KeyRotation(t *testing.T) {
+	oldKey := newSigningKey(t, "k1")
+	newKey := newSigningKey(t, "k2")
+	issued := issueTestToken(t, newTokenService(t, oldKey))
+
+	rotated := newTokenService(t, newKey, oldKey)
+	_, err := rotated.VerifyAt(issued.Value(), fixedNow)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(issueTestToken(t, rotated).Value(), "k2."))
+
+	retired := newTokenService(t, newKey)
+	_, err = retired.VerifyAt(issued.Value(), fixedNow)
+	assert.ErrorIs(t, err, survey.ErrInvalidSurveyToken)
+}
+
+// Test 16: 金鑰與 TTL 設定驗證
+func TestSurveyTokenService_InvalidConfiguration(t *testing.T) {
+	_, err := survey.NewSigningKey("k1", []byte("short"))
+	assert.ErrorIs(t, err, survey.ErrInvalidSigningKey)
+
+	_, err = survey.NewSigningKey("k.1", []byte(strings.Repeat("x", 32)))
+	assert.ErrorIs(t, err, survey.ErrInvalidSigningKey)
+
+	key := newSigningKey(t, "k1")
+	_, err = survey.NewSigningKeyRing(key, key)
+	assert.ErrorIs(t, err, survey.ErrInvalidSigningKey)
+
+	ring, err := survey.NewSigningKeyRing(key)
+	require.NoError(t, err)
+	_, err = survey.NewSurveyTokenService(ring, 0)
+	assert.ErrorIs(t, err, survey.ErrInvalidTokenTTL)
+}
@@ -0,0 +1,196 @@
+package survey
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ===========================
+// QuestionType 題型
+// ===========================
+
+// QuestionType 題型（BR-004-01：文字題、選擇題、評分題）
+type QuestionType string
+
+const (
+	QuestionTypeText           QuestionType = "text"            // 文字題
+	QuestionTypeMultipleChoice QuestionType = "multiple_choice" // 選擇題（單選）
+	QuestionTypeRating         QuestionType = "rating"          // 評分題（1-5 星）
+)
+
+// ParseQuestionType 從字串解析題型
+func ParseQuestionType(s string) (QuestionType, error) {
+	qt := QuestionType(s)
+	if !qt.IsValid() {
+		return "", ErrInvalidQuestionType.WithContext("question_type", s)
+	}
+	return qt, nil
+}
+
+// String 返回題型字串
+func (t QuestionType) String() string {
+	return string(t)
+}
+
+// IsValid 判斷題型是否有效
+func (t QuestionType) IsValid() bool {
+	switch t {
+	case QuestionTypeText, QuestionTypeMultipleChoice, QuestionTypeRating:
+		return true
+	default:
+		return false
+	}
+}
+
+// ===========================
+// RatingScore 評分值對象
+// ===========================
+
+// 評分範圍
+const (
+	MinRating = 1
+	MaxRating = 5
+)
+
+// RatingScore 評分（1-5 星）
+type RatingScore struct {
+	value int
+}
+
+// NewRatingScore 建構函數（checked 版本）
+func NewRatingScore(value int) (RatingScore, error) {
+	if value < MinRating || value > MaxRating {
+		return RatingScore{}, ErrInvalidRating.WithContext(
+			"attempted_value", value,
+			"constraint", "1-5",
+		)
+	}
+	return RatingScore{value: value}, nil
+}
+
+// Value 返回評分數值
+func (r RatingScore) Value() int {
+	return r.value
+}
+
+// ===========================
+// Answer 答案值對象
+// ===========================
+
+// 文字答案長度上限（字元數）
+const maxTextAnswerLength = 500
+
+// Answer 單題答案
+//
+// 設計原則：
+// - 答案記錄自身類型，由 Question.ValidateAnswer 比對題型
+// - 文字題 / 選擇題使用 text，評分題使用 rating
+type Answer struct {
+	questionID QuestionID
+	answerType QuestionType
+	text       string
+	rating     int
+}
+
+// NewTextAnswer 創建文字題答案
+//
+// 驗證：去除前後空白後不可為空，且不超過 500 字
+func NewTextAnswer(questionID QuestionID, text string) (Answer, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || utf8.RuneCountInString(text) > maxTextAnswerLength {
+		return Answer{}, ErrInvalidTextAnswer.WithContext(
+			"question_id", questionID.String(),
+			"length", utf8.RuneCountInString(text),
+		)
+	}
+	return Answer{questionID: questionID, answerType: QuestionTypeText, text: text}, nil
+}
+
+// NewChoiceAnswer 創建選擇題答案
+//
+// 注意：選項是否存在於題目中由 Question.ValidateAnswer 檢查
+func NewChoiceAnswer(questionID QuestionID, option string) (Answer, error) {
+	option = strings.TrimSpace(option)
+	if option == "" {
+		return Answer{}, ErrInvalidChoice.WithContext(
+			"question_id", questionID.String(),
+			"reason", "option cannot be empty",
+		)
+	}
+	return Answer{questionID: questionID, answerType: QuestionTypeMultipleChoice, text: option}, nil
+}
+
+// NewRatingAnswer 創建評分題答案
+func NewRatingAnswer(questionID QuestionID, rating int) (Answer, error) {
+	score, err := NewRatingScore(rating)
+	if err != nil {
+		return Answer{}, err
+	}
+	return Answer{questionID: questionID, answerType: QuestionTypeRating, rating: score.Value()}, nil
+}
+
+// ReconstructAnswer 從持久化存儲重建答案
+//
+// 設計原則：僅供 Repository 使用（提交時已驗證）
+func ReconstructAnswer(questionID QuestionID, answerType QuestionType, text string, rating int) (Answer, error) {
+	if !answerType.IsValid() {
+		return Answer{}, ErrInvalidQuestionType.WithContext(
+			"question_id", questionID.String(),
+			"type", answerType.String(),
+			"reason", "invalid answer type in database",
+		)
+	}
+	return Answer{questionID: questionID, answerType: answerType, text: text, rating: rating}, nil
+}
+
+// QuestionID 返回題目 ID
+func (a Answer) QuestionID() QuestionID {
+	return a.questionID
+}
+
+// Type 返回答案類型
+func (a Answer) Type() QuestionType {
+	return a.answerType
+}
+
+// Text 返回文字答案或選擇的選項
+func (a Answer) Text() string {
+	return a.text
+}
+
+// Rating 返回評分（非評分題為 0）
+func (a Answer) Rating() int {
+	return a.rating
+}
+
+// ===========================
+// BonusStatus 問卷獎勵狀態
+// ===========================
+
+// SurveyBonusPoints 填寫問卷獎勵積分（US-004：每筆交易 1 點）
+const SurveyBonusPoints = 1
+
+// BonusStatus 問卷獎勵積分發放狀態
+//
+// 狀態轉換：pending → awarded（交易驗證後發放）
+type BonusStatus string
+
+const (
+	BonusStatusPending BonusStatus = "pending" // 待發放：交易尚未驗證
+	BonusStatusAwarded BonusStatus = "awarded" // 已發放
+)
+
+// String 返回狀態字串
+func (s BonusStatus) String() string {
+	return string(s)
+}
+
+// IsValid 判斷狀態是否有效
+func (s BonusStatus) IsValid() bool {
+	switch s {
+	case BonusStatusPending, BonusStatusAwarded:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,117 @@
+package tier
+
+import "fmt"
+
+// ===========================
+// 錯誤代碼定義
+// ===========================
+
+// ErrorCode 錯誤代碼類型
+type ErrorCode string
+
+// 錯誤代碼常量
+const (
+	// 識別符相關
+	ErrCodeInvalidMemberID ErrorCode = "MEMBER_ID_INVALID"
+
+	// 等級規則相關
+	ErrCodeInvalidTierLevel  ErrorCode = "TIER_LEVEL_INVALID"
+	ErrCodeInvalidTierPolicy ErrorCode = "TIER_POLICY_INVALID"
+
+	// Repository 相關
+	ErrCodeMemberTierNotFound ErrorCode = "MEMBER_TIER_NOT_FOUND"
+)
+
+// ===========================
+// DomainError 結構
+// ===========================
+
+// DomainError 會員等級領域錯誤
+// 設計原則：
+// 1. 包含結構化的錯誤代碼（用於 HTTP 狀態碼映射）
+// 2. 支持上下文信息（用於調試和日誌）
+// 3. 不可變性（創建後不可修改）
+type DomainError struct {
+	Code    ErrorCode
+	Message string
+	Context map[string]interface{}
+}
+
+// Error 實現 error 接口
+func (e *DomainError) Error() string {
+	if len(e.Context) == 0 {
+		return fmt.Sprintf("[%s] %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("[%s] %s (context: %+v)", e.Code, e.Message, e.Context)
+}
+
+// WithContext 添加上下文信息（返回新的錯誤實例，保持不可變性）
+func (e *DomainError) WithContext(keyValues ...interface{}) error {
+	if len(keyValues)%2 != 0 {
+		panic("WithContext requires even number of arguments (key-value pairs)")
+	}
+
+	ctx := make(map[string]interface{}, len(e.Context)+len(keyValues)/2)
+
+	// 複製現有上下文
+	for k, v := range e.Context {
+		ctx[k] = v
+	}
+
+	// 添加新上下文
+	for i := 0; i < len(keyValues); i += 2 {
+		key, ok := keyValues[i].(string)
+		if !ok {
+			panic(fmt.Sprintf("context key must be string, got %T", keyValues[i]))
+		}
+		ctx[key] = keyValues[i+1]
+	}
+
+	return &DomainError{
+		Code:    e.Code,
+		Message: e.Message,
+		Context: ctx,
+	}
+}
+
+// Is 實現 errors.Is 接口（用於錯誤類型判斷）
+func (e *DomainError) Is(target error) bool {
+	t, ok := target.(*DomainError)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+// ===========================
+// 預定義錯誤
+// ===========================
+
+// 識別符相關錯誤
+var (
+	ErrInvalidMemberID = &DomainError{
+		Code:    ErrCodeInvalidMemberID,
+		Message: "無效的會員 ID",
+	}
+)
+
+// 等級規則相關錯誤
+var (
+	ErrInvalidTierLevel = &DomainError{
+		Code:    ErrCodeInvalidTierLevel,
+		Message: "無效的會員等級",
+	}
+
+	ErrInvalidTierPolicy = &DomainError{
+		Code:    ErrCodeInvalidTierPolicy,
+		Message: "會員等級規則設定無效",
+	}
+)
+
+// Repository 相關錯誤
+var (
+	ErrMemberTierNotFound = &DomainError{
+		Code:    ErrCodeMemberTierNotFound,
+		Message: "會員等級紀錄不存在",
+	}
+)
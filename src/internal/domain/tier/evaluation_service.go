@@ -0,0 +1,40 @@
+package tier
+
+import "time"
+
+// ===========================
+// TierEvaluationService 領域服務
+// ===========================
+
+// TierEvaluationService 會員等級評估服務
+//
+// 輸入：近 12 個月已驗證消費（由 Application Layer 透過 VerifiedSpendQuery 統計後傳入）
+// 設計原則：領域服務只做判斷，不負責查詢（保持純函數、易於測試）
+//
+// 規則：
+// - 依 TierPolicy 門檻判定可取得的最高等級
+// - 升級立即生效；降級需先經過寬限期（見 MemberTier.applyEvaluation）
+type TierEvaluationService struct {
+	policy TierPolicy
+}
+
+// NewTierEvaluationService 創建評估服務
+func NewTierEvaluationService(policy TierPolicy) *TierEvaluationService {
+	return &TierEvaluationService{policy: policy}
+}
+
+// Policy 返回目前使用的等級規則
+func (s *TierEvaluationService) Policy() TierPolicy {
+	return s.policy
+}
+
+// Evaluate 依近 12 個月已驗證消費評估會員等級
+//
+// 參數：
+//   t - 會員等級（評估結果直接套用，事件由 PullEvents 取得）
+//   rollingSpend - WindowStart(now) 起的已驗證消費（元）
+//   now - 評估時間
+func (s *TierEvaluationService) Evaluate(t *MemberTier, rollingSpend int, now time.Time) EvaluationOutcome {
+	qualified := s.policy.QualifiedLevel(rollingSpend)
+	return t.applyEvaluation(qualified, rollingSpend, s.policy.DemotionGracePeriod(), now)
+}
@@ -0,0 +1,138 @@
+package tier_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/tier"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBasicTier 建立尚未評估的會員等級
+func newBasicTier(t *testing.T, now time.Time) *tier.MemberTier {
+	memberTier, err := tier.NewMemberTier(shared.NewEntityID[tier.MemberMarker](), now)
+	require.NoError(t, err)
+	return memberTier
+}
+
+// ===========================
+// TierPolicy 測試
+// ===========================
+
+// Test 1: 門檻必須隨等級遞增、每個等級都必須定義
+func TestNewTierPolicy_InvalidDefinitions_ReturnsError(t *testing.T) {
+	valid := tier.DefaultTierPolicy().Definitions()[1:]
+
+	unordered := append([]tier.TierDefinition(nil), valid...)
+	unordered[1].SpendThreshold = unordered[0].SpendThreshold
+	_, errUnordered := tier.NewTierPolicy(tier.TierPolicyConfig{Definitions: unordered, DemotionGracePeriod: time.Hour})
+
+	_, errMissing := tier.NewTierPolicy(tier.TierPolicyConfig{Definitions: valid[:2], DemotionGracePeriod: time.Hour})
+
+	lowMultiplier := append([]tier.TierDefinition(nil), valid...)
+	lowMultiplier[0].Multiplier = decimal.RequireFromString("0.8")
+	_, errMultiplier := tier.NewTierPolicy(tier.TierPolicyConfig{Definitions: lowMultiplier, DemotionGracePeriod: time.Hour})
+
+	_, errGrace := tier.NewTierPolicy(tier.TierPolicyConfig{Definitions: valid})
+
+	assert.ErrorIs(t, errUnordered, tier.ErrInvalidTierPolicy)
+	assert.ErrorIs(t, errMissing, tier.ErrInvalidTierPolicy)
+	assert.ErrorIs(t, errMultiplier, tier.ErrInvalidTierPolicy)
+	assert.ErrorIs(t, errGrace, tier.ErrInvalidTierPolicy)
+}
+
+// Test 2: 依消費判定等級，統計期間起點為營業時區 12 個月前的同一天
+func TestTierPolicy_QualifiedLevelAndWindow(t *testing.T) {
+	policy := tier.DefaultTierPolicy()
+	now := time.Date(2025, 3, 15, 15, 30, 0, 0, time.UTC) // 台北 23:30
+
+	assert.Equal(t, tier.LevelBasic, policy.QualifiedLevel(9999))
+	assert.Equal(t, tier.LevelSilver, policy.QualifiedLevel(10000))
+	assert.Equal(t, tier.LevelGold, policy.QualifiedLevel(79999))
+	assert.Equal(t, tier.LevelBlack, policy.QualifiedLevel(80000))
+	assert.True(t, policy.Multiplier(tier.LevelGold).Equal(decimal.RequireFromString("1.5")))
+	assert.True(t, policy.Multiplier(tier.LevelBasic).Equal(decimal.NewFromInt(1)))
+	assert.Equal(t,
+		time.Date(2024, 3, 15, 0, 0, 0, 0, shared.BusinessLocation),
+		policy.WindowStart(now),
+	)
+}
+
+// ===========================
+// TierEvaluationService 測試
+// ===========================
+
+// Test 3: 達到門檻立即升級（可跨級），並發布 tier.changed
+func TestTierEvaluationService_Evaluate_PromotesImmediately(t *testing.T) {
+	service := tier.NewTierEvaluationService(tier.DefaultTierPolicy())
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	memberTier := newBasicTier(t, now)
+
+	outcome := service.Evaluate(memberTier, 35000, now)
+
+	assert.Equal(t, tier.OutcomePromoted, outcome)
+	assert.Equal(t, tier.LevelGold, memberTier.Level())
+	assert.Equal(t, 35000, memberTier.RollingSpend())
+	events := memberTier.PullEvents()
+	require.Len(t, events, 1)
+	changed, ok := events[0].(*tier.MemberTierChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "tier.changed", changed.EventType())
+	assert.Equal(t, tier.LevelBasic, changed.PreviousLevel())
+	assert.Equal(t, tier.LevelGold, changed.NewLevel())
+	assert.True(t, changed.IsPromotion())
+}
+
+// Test 4: 消費不足時先進入寬限期，屆滿前不降級，屆滿後降至可取得等級
+func TestTierEvaluationService_Evaluate_DemotesAfterGracePeriod(t *testing.T) {
+	policy := tier.DefaultTierPolicy()
+	service := tier.NewTierEvaluationService(policy)
+	start := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	memberTier := newBasicTier(t, start)
+	service.Evaluate(memberTier, 85000, start)
+	memberTier.PullEvents()
+
+	// Act & Assert - 消費跌破黑卡門檻：進入寬限期
+	lapsed := start.AddDate(0, 6, 0)
+	assert.Equal(t, tier.OutcomeDemotionScheduled, service.Evaluate(memberTier, 12000, lapsed))
+	assert.Equal(t, tier.LevelBlack, memberTier.Level())
+	require.True(t, memberTier.IsInGracePeriod())
+	assert.Equal(t, lapsed.Add(policy.DemotionGracePeriod()), *memberTier.DemotionDueAt())
+	events := memberTier.PullEvents()
+	require.Len(t, events, 1)
+	scheduled := events[0].(*tier.MemberTierDemotionScheduledEvent)
+	assert.Equal(t, tier.LevelSilver, scheduled.QualifiedLevel())
+
+	// Act & Assert - 寬限期內：保留等級，不重複發布事件
+	assert.Equal(t, tier.OutcomeUnchanged, service.Evaluate(memberTier, 12000, lapsed.Add(24*time.Hour)))
+	assert.Equal(t, tier.LevelBlack, memberTier.Level())
+	assert.Empty(t, memberTier.PullEvents())
+
+	// Act & Assert - 寬限期屆滿：降至屆時可取得的等級
+	assert.Equal(t, tier.OutcomeDemoted, service.Evaluate(memberTier, 12000, *memberTier.DemotionDueAt()))
+	assert.Equal(t, tier.LevelSilver, memberTier.Level())
+	assert.False(t, memberTier.IsInGracePeriod())
+	events = memberTier.PullEvents()
+	require.Len(t, events, 1)
+	assert.False(t, events[0].(*tier.MemberTierChangedEvent).IsPromotion())
+}
+
+// Test 5: 寬限期內重新達標取消降級
+func TestTierEvaluationService_Evaluate_CancelsDemotionWhenRequalified(t *testing.T) {
+	service := tier.NewTierEvaluationService(tier.DefaultTierPolicy())
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	memberTier := newBasicTier(t, now)
+	service.Evaluate(memberTier, 30000, now)
+	service.Evaluate(memberTier, 20000, now.AddDate(0, 6, 0))
+	memberTier.PullEvents()
+
+	outcome := service.Evaluate(memberTier, 31000, now.AddDate(0, 6, 7))
+
+	assert.Equal(t, tier.OutcomeDemotionCancelled, outcome)
+	assert.Equal(t, tier.LevelGold, memberTier.Level())
+	assert.False(t, memberTier.IsInGracePeriod())
+	assert.Empty(t, memberTier.PullEvents())
+}
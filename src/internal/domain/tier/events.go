@@ -0,0 +1,168 @@
+package tier
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ===========================
+// MemberTierChanged 領域事件
+// ===========================
+
+// MemberTierChangedEvent 會員等級已變更事件（升級或降級）
+//
+// 訂閱者：通知上下文（推播升級 / 降級通知）
+type MemberTierChangedEvent struct {
+	eventID       string
+	memberID      MemberID
+	previousLevel Level
+	newLevel      Level
+	rollingSpend  int
+	occurredAt    time.Time
+}
+
+// NewMemberTierChangedEvent 創建等級已變更事件
+func NewMemberTierChangedEvent(
+	memberID MemberID,
+	previousLevel Level,
+	newLevel Level,
+	rollingSpend int,
+	occurredAt time.Time,
+) *MemberTierChangedEvent {
+	return &MemberTierChangedEvent{
+		eventID:       uuid.New().String(),
+		memberID:      memberID,
+		previousLevel: previousLevel,
+		newLevel:      newLevel,
+		rollingSpend:  rollingSpend,
+		occurredAt:    occurredAt,
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *MemberTierChangedEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *MemberTierChangedEvent) EventType() string {
+	return "tier.changed"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *MemberTierChangedEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *MemberTierChangedEvent) AggregateID() string {
+	return e.memberID.String()
+}
+
+// MemberID 獲取會員 ID
+func (e *MemberTierChangedEvent) MemberID() MemberID {
+	return e.memberID
+}
+
+// PreviousLevel 獲取變更前等級
+func (e *MemberTierChangedEvent) PreviousLevel() Level {
+	return e.previousLevel
+}
+
+// NewLevel 獲取變更後等級
+func (e *MemberTierChangedEvent) NewLevel() Level {
+	return e.newLevel
+}
+
+// RollingSpend 獲取評估時的近 12 個月已驗證消費（元）
+func (e *MemberTierChangedEvent) RollingSpend() int {
+	return e.rollingSpend
+}
+
+// IsPromotion 判斷是否為升級
+func (e *MemberTierChangedEvent) IsPromotion() bool {
+	return e.newLevel.IsHigherThan(e.previousLevel)
+}
+
+// ===========================
+// MemberTierDemotionScheduled 領域事件
+// ===========================
+
+// MemberTierDemotionScheduledEvent 會員等級進入降級寬限期事件
+//
+// 訂閱者：通知上下文（提醒會員於寬限期屆滿前消費以保留等級）
+type MemberTierDemotionScheduledEvent struct {
+	eventID        string
+	memberID       MemberID
+	currentLevel   Level
+	qualifiedLevel Level
+	rollingSpend   int
+	demotionDueAt  time.Time
+	occurredAt     time.Time
+}
+
+// NewMemberTierDemotionScheduledEvent 創建降級寬限期事件
+func NewMemberTierDemotionScheduledEvent(
+	memberID MemberID,
+	currentLevel Level,
+	qualifiedLevel Level,
+	rollingSpend int,
+	demotionDueAt time.Time,
+	occurredAt time.Time,
+) *MemberTierDemotionScheduledEvent {
+	return &MemberTierDemotionScheduledEvent{
+		eventID:        uuid.New().String(),
+		memberID:       memberID,
+		currentLevel:   currentLevel,
+		qualifiedLevel: qualifiedLevel,
+		rollingSpend:   rollingSpend,
+		demotionDueAt:  demotionDueAt,
+		occurredAt:     occurredAt,
+	}
+}
+
+// EventID 實現 DomainEvent 介面
+func (e *MemberTierDemotionScheduledEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType 實現 DomainEvent 介面
+func (e *MemberTierDemotionScheduledEvent) EventType() string {
+	return "tier.demotion_scheduled"
+}
+
+// OccurredAt 實現 DomainEvent 介面
+func (e *MemberTierDemotionScheduledEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// AggregateID 實現 DomainEvent 介面
+func (e *MemberTierDemotionScheduledEvent) AggregateID() string {
+	return e.memberID.String()
+}
+
+// MemberID 獲取會員 ID
+func (e *MemberTierDemotionScheduledEvent) MemberID() MemberID {
+	return e.memberID
+}
+
+// CurrentLevel 獲取目前（寬限期內保留的）等級
+func (e *MemberTierDemotionScheduledEvent) CurrentLevel() Level {
+	return e.currentLevel
+}
+
+// QualifiedLevel 獲取依目前消費可取得的等級（屆滿時降至此等級）
+func (e *MemberTierDemotionScheduledEvent) QualifiedLevel() Level {
+	return e.qualifiedLevel
+}
+
+// RollingSpend 獲取評估時的近 12 個月已驗證消費（元）
+func (e *MemberTierDemotionScheduledEvent) RollingSpend() int {
+	return e.rollingSpend
+}
+
+// DemotionDueAt 獲取寬限期屆滿時間
+func (e *MemberTierDemotionScheduledEvent) DemotionDueAt() time.Time {
+	return e.demotionDueAt
+}
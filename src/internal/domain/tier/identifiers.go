@@ -0,0 +1,29 @@
+package tier
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// 實體 ID 類型定義
+// ===========================
+
+// 設計原則：使用泛型 EntityID[T]（與其他上下文一致）
+//
+// 注意：tier.MemberID 與其他上下文的 MemberID 是不同類型
+// 跨上下文傳遞時使用 String() 轉換
+
+// ===========================
+// MemberID - 會員 ID（引用）
+// ===========================
+
+// MemberMarker 是 MemberID 的標記類型
+type MemberMarker struct{}
+
+// MemberID 會員的唯一標識符（會員等級上下文內的引用）
+type MemberID = shared.EntityID[MemberMarker]
+
+// MemberIDFromString 從字串解析會員 ID
+func MemberIDFromString(s string) (MemberID, error) {
+	return shared.EntityIDFromString[MemberMarker](s, ErrInvalidMemberID)
+}
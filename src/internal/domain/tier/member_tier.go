@@ -0,0 +1,240 @@
+package tier
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// EvaluationOutcome 評估結果枚舉
+// ===========================
+
+// EvaluationOutcome 單次等級評估的結果
+type EvaluationOutcome string
+
+const (
+	OutcomeUnchanged         EvaluationOutcome = "unchanged"          // 等級不變
+	OutcomePromoted          EvaluationOutcome = "promoted"           // 升級（立即生效）
+	OutcomeDemotionScheduled EvaluationOutcome = "demotion_scheduled" // 消費未達門檻，進入寬限期
+	OutcomeDemotionCancelled EvaluationOutcome = "demotion_cancelled" // 寬限期內重新達標，取消降級
+	OutcomeDemoted           EvaluationOutcome = "demoted"            // 寬限期屆滿，降級
+)
+
+// String 返回結果字串
+func (o EvaluationOutcome) String() string {
+	return string(o)
+}
+
+// ===========================
+// MemberTier 聚合根
+// ===========================
+
+// MemberTier 會員等級聚合根
+//
+// 使用場景：
+// - 由 TierEvaluationService 依近 12 個月已驗證消費定期評估
+// - 發票入帳時查詢目前等級決定積分倍率
+//
+// 不變量（Invariants）：
+// 1. 升級立即生效，並取消進行中的降級寬限期
+// 2. 降級必須先經過寬限期（demotionDueAt），屆滿時仍未達標才降級
+// 3. basic 等級不會有降級寬限期
+type MemberTier struct {
+	// 識別欄位
+	memberID MemberID
+
+	// 等級狀態
+	level          Level
+	rollingSpend   int
+	demotionDueAt  *time.Time
+	levelChangedAt time.Time
+	evaluatedAt    time.Time
+
+	// 審計欄位
+	createdAt time.Time
+	updatedAt time.Time
+
+	// 待發布的領域事件
+	events []shared.DomainEvent
+}
+
+// ===========================
+// 建構函數（工廠方法）
+// ===========================
+
+// NewMemberTier 建立會員等級紀錄（初始為 basic，首次評估時才依消費升級）
+func NewMemberTier(memberID MemberID, now time.Time) (*MemberTier, error) {
+	if memberID.IsEmpty() {
+		return nil, ErrInvalidMemberID.WithContext(
+			"reason", "memberID cannot be empty",
+		)
+	}
+
+	return &MemberTier{
+		memberID:       memberID,
+		level:          LevelBasic,
+		levelChangedAt: now,
+		createdAt:      now,
+		updatedAt:      now,
+		events:         make([]shared.DomainEvent, 0),
+	}, nil
+}
+
+// ReconstructMemberTier 從持久化存儲重建聚合根
+//
+// 設計原則：
+// - 僅供 Repository 使用
+// - 不發布事件（事件已發生過）
+func ReconstructMemberTier(
+	memberID MemberID,
+	level Level,
+	rollingSpend int,
+	demotionDueAt *time.Time,
+	levelChangedAt time.Time,
+	evaluatedAt time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*MemberTier, error) {
+	if memberID.IsEmpty() {
+		return nil, ErrInvalidMemberID.WithContext(
+			"reason", "invalid member ID in database",
+		)
+	}
+	if !level.IsValid() {
+		return nil, ErrInvalidTierLevel.WithContext("level", level.String())
+	}
+
+	return &MemberTier{
+		memberID:       memberID,
+		level:          level,
+		rollingSpend:   rollingSpend,
+		demotionDueAt:  demotionDueAt,
+		levelChangedAt: levelChangedAt,
+		evaluatedAt:    evaluatedAt,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+		events:         make([]shared.DomainEvent, 0),
+	}, nil
+}
+
+// ===========================
+// 業務方法
+// ===========================
+
+// applyEvaluation 套用評估結果（由 TierEvaluationService 呼叫）
+//
+// 業務規則：
+// - 可取得等級高於目前等級 → 立即升級
+// - 可取得等級低於目前等級 → 首次進入寬限期；寬限期屆滿時降至可取得等級
+// - 寬限期內重新達標 → 取消降級
+func (t *MemberTier) applyEvaluation(
+	qualified Level,
+	rollingSpend int,
+	gracePeriod time.Duration,
+	now time.Time,
+) EvaluationOutcome {
+	t.rollingSpend = rollingSpend
+	t.evaluatedAt = now
+	t.updatedAt = now
+
+	switch {
+	case qualified.IsHigherThan(t.level):
+		t.changeLevel(qualified, now)
+		return OutcomePromoted
+
+	case !t.level.IsHigherThan(qualified):
+		if t.demotionDueAt == nil {
+			return OutcomeUnchanged
+		}
+		t.demotionDueAt = nil
+		return OutcomeDemotionCancelled
+
+	case t.demotionDueAt == nil:
+		dueAt := now.Add(gracePeriod)
+		t.demotionDueAt = &dueAt
+		t.addEvent(NewMemberTierDemotionScheduledEvent(t.memberID, t.level, qualified, rollingSpend, dueAt, now))
+		return OutcomeDemotionScheduled
+
+	case now.Before(*t.demotionDueAt):
+		return OutcomeUnchanged
+
+	default:
+		t.changeLevel(qualified, now)
+		return OutcomeDemoted
+	}
+}
+
+// changeLevel 變更等級並發布 tier.changed 事件（清除寬限期）
+func (t *MemberTier) changeLevel(level Level, now time.Time) {
+	previous := t.level
+	t.level = level
+	t.demotionDueAt = nil
+	t.levelChangedAt = now
+	t.addEvent(NewMemberTierChangedEvent(t.memberID, previous, level, t.rollingSpend, now))
+}
+
+// ===========================
+// 查詢方法（Getters）
+// ===========================
+
+// MemberID 返回會員 ID
+func (t *MemberTier) MemberID() MemberID {
+	return t.memberID
+}
+
+// Level 返回目前等級
+func (t *MemberTier) Level() Level {
+	return t.level
+}
+
+// RollingSpend 返回最近一次評估的近 12 個月已驗證消費（元）
+func (t *MemberTier) RollingSpend() int {
+	return t.rollingSpend
+}
+
+// DemotionDueAt 返回降級寬限期屆滿時間（未在寬限期時為 nil）
+func (t *MemberTier) DemotionDueAt() *time.Time {
+	return t.demotionDueAt
+}
+
+// IsInGracePeriod 判斷是否在降級寬限期中
+func (t *MemberTier) IsInGracePeriod() bool {
+	return t.demotionDueAt != nil
+}
+
+// LevelChangedAt 返回最近一次等級變更時間
+func (t *MemberTier) LevelChangedAt() time.Time {
+	return t.levelChangedAt
+}
+
+// EvaluatedAt 返回最近一次評估時間（尚未評估時為零值）
+func (t *MemberTier) EvaluatedAt() time.Time {
+	return t.evaluatedAt
+}
+
+// CreatedAt 返回建立時間
+func (t *MemberTier) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// UpdatedAt 返回更新時間
+func (t *MemberTier) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// ===========================
+// 領域事件管理
+// ===========================
+
+// addEvent 添加領域事件
+func (t *MemberTier) addEvent(event shared.DomainEvent) {
+	t.events = append(t.events, event)
+}
+
+// PullEvents 獲取並清空領域事件
+func (t *MemberTier) PullEvents() []shared.DomainEvent {
+	events := t.events
+	t.events = make([]shared.DomainEvent, 0)
+	return events
+}
@@ -0,0 +1,49 @@
+package tier
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// MemberTier Repository 介面
+// ===========================
+
+// MemberTierRepository 會員等級倉儲介面
+//
+// 設計原則：
+// 1. 依賴倒置原則（DIP）：Domain Layer 定義介面，Infrastructure Layer 實作
+// 2. 事務支持：寫操作 ctx 必須 non-nil，讀操作 ctx 可為 nil
+type MemberTierRepository interface {
+	// Save 保存會員等級（新增或更新）
+	Save(ctx shared.TransactionContext, t *MemberTier) error
+
+	// FindByMemberID 根據會員 ID 查找
+	//
+	// 返回：找到的會員等級，或 ErrMemberTierNotFound
+	FindByMemberID(ctx shared.TransactionContext, memberID MemberID) (*MemberTier, error)
+
+	// FindAboveBasic 查詢等級高於 basic 的會員（定期評估時檢查是否需降級）
+	FindAboveBasic(ctx shared.TransactionContext) ([]*MemberTier, error)
+}
+
+// ===========================
+// VerifiedSpendQuery 查詢介面
+// ===========================
+
+// MemberSpend 會員的已驗證消費合計
+type MemberSpend struct {
+	MemberID MemberID
+	Amount   int // 元
+}
+
+// VerifiedSpendQuery 已驗證消費統計查詢（唯讀）
+//
+// 設計原則：
+// - 查詢發票交易資料（由 Infrastructure Layer 實作，可直接查 invoice_transactions）
+// - 只統計已驗證（verified）的交易，依發票日期判定是否在統計期間內
+type VerifiedSpendQuery interface {
+	// SumVerifiedSpendSince 統計發票日期 >= since 的已驗證消費（依會員加總，只返回有消費的會員）
+	SumVerifiedSpendSince(ctx shared.TransactionContext, since time.Time) ([]MemberSpend, error)
+}
@@ -0,0 +1,261 @@
+package tier
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/shopspring/decimal"
+)
+
+// ===========================
+// Level 會員等級枚舉
+// ===========================
+
+// Level 會員等級
+//
+// 高低：basic < silver < gold < black
+type Level string
+
+const (
+	LevelBasic  Level = "basic"  // 一般會員（未達任何等級門檻）
+	LevelSilver Level = "silver" // 銀卡
+	LevelGold   Level = "gold"   // 金卡
+	LevelBlack  Level = "black"  // 黑卡
+)
+
+// rankedLevels 由低到高排列的等級
+var rankedLevels = []Level{LevelBasic, LevelSilver, LevelGold, LevelBlack}
+
+// ParseLevel 從字串解析會員等級
+func ParseLevel(s string) (Level, error) {
+	level := Level(s)
+	if !level.IsValid() {
+		return "", ErrInvalidTierLevel.WithContext("level", s)
+	}
+	return level, nil
+}
+
+// String 返回等級字串
+func (l Level) String() string {
+	return string(l)
+}
+
+// IsValid 判斷等級是否為已定義的值
+func (l Level) IsValid() bool {
+	return l.rank() >= 0
+}
+
+// IsHigherThan 判斷是否高於另一個等級
+func (l Level) IsHigherThan(other Level) bool {
+	return l.rank() > other.rank()
+}
+
+// rank 返回等級高低順序（未定義的等級返回 -1）
+func (l Level) rank() int {
+	for i, level := range rankedLevels {
+		if level == l {
+			return i
+		}
+	}
+	return -1
+}
+
+// ===========================
+// TierDefinition 等級定義
+// ===========================
+
+// TierDefinition 單一等級的門檻與權益
+//
+// 欄位：
+// - SpendThreshold: 近 12 個月已驗證消費達此金額即取得等級（元）
+// - Multiplier: 發票積分倍率（1 ~ 10）
+// - Perks: 等級權益說明（顯示於 LINE / 管理後台）
+type TierDefinition struct {
+	Level          Level
+	SpendThreshold int
+	Multiplier     decimal.Decimal
+	Perks          []string
+}
+
+// ===========================
+// TierPolicy 等級規則值對象
+// ===========================
+
+// RollingWindowMonths 等級評估的消費統計期間（滾動 12 個月）
+const RollingWindowMonths = 12
+
+// 積分倍率範圍
+var (
+	minMultiplier = decimal.NewFromInt(1)
+	maxMultiplier = decimal.NewFromInt(10)
+)
+
+// TierPolicyConfig 等級規則設定（由設定檔或管理後台提供）
+//
+// 欄位：
+// - Definitions: silver / gold / black 的定義（basic 固定為門檻 0、倍率 1，不需設定）
+// - DemotionGracePeriod: 消費未達目前等級門檻時，降級前的寬限期
+type TierPolicyConfig struct {
+	Definitions         []TierDefinition
+	DemotionGracePeriod time.Duration
+}
+
+// TierPolicy 會員等級規則（已驗證、不可變）
+type TierPolicy struct {
+	definitions map[Level]TierDefinition
+	gracePeriod time.Duration
+}
+
+// NewTierPolicy 創建等級規則（Checked Constructor）
+//
+// 驗證：
+// - silver / gold / black 各定義一次
+// - 門檻 > 0 且隨等級嚴格遞增
+// - 倍率在 1 ~ 10 之間且隨等級不遞減
+// - 寬限期 > 0
+func NewTierPolicy(cfg TierPolicyConfig) (TierPolicy, error) {
+	if cfg.DemotionGracePeriod <= 0 {
+		return TierPolicy{}, ErrInvalidTierPolicy.WithContext(
+			"reason", "demotion grace period must be positive",
+		)
+	}
+
+	definitions := map[Level]TierDefinition{
+		LevelBasic: {Level: LevelBasic, Multiplier: minMultiplier},
+	}
+	for _, def := range cfg.Definitions {
+		if !def.Level.IsValid() || def.Level == LevelBasic {
+			return TierPolicy{}, ErrInvalidTierLevel.WithContext("level", def.Level.String())
+		}
+		if _, exists := definitions[def.Level]; exists {
+			return TierPolicy{}, ErrInvalidTierPolicy.WithContext(
+				"level", def.Level.String(),
+				"reason", "level defined more than once",
+			)
+		}
+		if def.Multiplier.LessThan(minMultiplier) || def.Multiplier.GreaterThan(maxMultiplier) {
+			return TierPolicy{}, ErrInvalidTierPolicy.WithContext(
+				"level", def.Level.String(),
+				"multiplier", def.Multiplier.String(),
+				"constraint", "1-10",
+			)
+		}
+		def.Perks = append([]string(nil), def.Perks...)
+		definitions[def.Level] = def
+	}
+
+	previous := definitions[LevelBasic]
+	for _, level := range rankedLevels[1:] {
+		def, ok := definitions[level]
+		if !ok {
+			return TierPolicy{}, ErrInvalidTierPolicy.WithContext(
+				"level", level.String(),
+				"reason", "level is not defined",
+			)
+		}
+		if def.SpendThreshold <= previous.SpendThreshold {
+			return TierPolicy{}, ErrInvalidTierPolicy.WithContext(
+				"level", level.String(),
+				"reason", "spend threshold must increase with level",
+			)
+		}
+		if def.Multiplier.LessThan(previous.Multiplier) {
+			return TierPolicy{}, ErrInvalidTierPolicy.WithContext(
+				"level", level.String(),
+				"reason", "multiplier must not decrease with level",
+			)
+		}
+		previous = def
+	}
+
+	return TierPolicy{definitions: definitions, gracePeriod: cfg.DemotionGracePeriod}, nil
+}
+
+// DefaultTierPolicy 預設等級規則
+//
+// - 銀卡：近 12 個月消費 10,000 元，積分 1.2 倍
+// - 金卡：近 12 個月消費 30,000 元，積分 1.5 倍
+// - 黑卡：近 12 個月消費 80,000 元，積分 2 倍
+// - 消費低於目前等級門檻時保留等級 30 天後才降級
+func DefaultTierPolicy() TierPolicy {
+	policy, err := NewTierPolicy(TierPolicyConfig{
+		Definitions: []TierDefinition{
+			{
+				Level:          LevelSilver,
+				SpendThreshold: 10000,
+				Multiplier:     decimal.RequireFromString("1.2"),
+				Perks:          []string{"生日當月招待一杯調酒"},
+			},
+			{
+				Level:          LevelGold,
+				SpendThreshold: 30000,
+				Multiplier:     decimal.RequireFromString("1.5"),
+				Perks:          []string{"生日當月招待一杯調酒", "週末優先訂位"},
+			},
+			{
+				Level:          LevelBlack,
+				SpendThreshold: 80000,
+				Multiplier:     decimal.NewFromInt(2),
+				Perks:          []string{"生日當月招待一瓶香檳", "週末優先訂位", "每季私人品酒會邀請"},
+			},
+		},
+		DemotionGracePeriod: 30 * 24 * time.Hour,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return policy
+}
+
+// Definition 返回等級定義（Perks 為副本）
+func (p TierPolicy) Definition(level Level) TierDefinition {
+	def, ok := p.definitions[level]
+	if !ok {
+		def = p.definitions[LevelBasic]
+	}
+	def.Perks = append([]string(nil), def.Perks...)
+	return def
+}
+
+// Definitions 返回所有等級定義（由低到高，含 basic）
+func (p TierPolicy) Definitions() []TierDefinition {
+	definitions := make([]TierDefinition, 0, len(rankedLevels))
+	for _, level := range rankedLevels {
+		definitions = append(definitions, p.Definition(level))
+	}
+	return definitions
+}
+
+// Multiplier 返回等級的積分倍率（未定義的等級返回 1）
+func (p TierPolicy) Multiplier(level Level) decimal.Decimal {
+	def, ok := p.definitions[level]
+	if !ok {
+		return minMultiplier
+	}
+	return def.Multiplier
+}
+
+// DemotionGracePeriod 返回降級寬限期
+func (p TierPolicy) DemotionGracePeriod() time.Duration {
+	return p.gracePeriod
+}
+
+// QualifiedLevel 依近 12 個月已驗證消費判定可取得的最高等級
+func (p TierPolicy) QualifiedLevel(rollingSpend int) Level {
+	qualified := LevelBasic
+	for _, level := range rankedLevels[1:] {
+		if rollingSpend >= p.definitions[level].SpendThreshold {
+			qualified = level
+		}
+	}
+	return qualified
+}
+
+// WindowStart 計算滾動消費統計期間的起點（營業時區，12 個月前的同一天 00:00）
+//
+// 範例：2025-03-15 22:00（台北）→ 2024-03-15 00:00（台北）
+func (p TierPolicy) WindowStart(now time.Time) time.Time {
+	local := now.In(shared.BusinessLocation)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, shared.BusinessLocation)
+	return today.AddDate(0, -RollingWindowMonths, 0)
+}
@@ -0,0 +1,108 @@
+package messaging
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// InMemoryEventBus
+// ===========================
+
+// InMemoryEventBus 行程內事件總線（適用於單體應用）
+//
+// 設計原則：
+// - 實作 shared.EventPublisher 與 shared.EventSubscriber
+// - 同步呼叫訂閱者（發布端在交易提交後才發布，訂閱者各自開啟交易）
+// - 單一訂閱者失敗不影響其他訂閱者，所有失敗合併後返回供發布端記錄
+// - 沒有訂閱者的事件直接略過
+type InMemoryEventBus struct {
+	mu       sync.RWMutex
+	handlers map[string][]shared.EventHandler
+}
+
+// NewInMemoryEventBus 創建事件總線
+func NewInMemoryEventBus() *InMemoryEventBus {
+	return &InMemoryEventBus{handlers: make(map[string][]shared.EventHandler)}
+}
+
+// Subscribe 訂閱事件類型（同一事件類型可有多個訂閱者，依訂閱順序呼叫）
+func (b *InMemoryEventBus) Subscribe(eventType string, handler shared.EventHandler) error {
+	if eventType == "" || handler == nil {
+		return errors.New("event type and handler are required")
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+	return nil
+}
+
+// SubscribeFunc 以函數訂閱多個事件類型
+func (b *InMemoryEventBus) SubscribeFunc(handle func(event shared.DomainEvent) error, eventTypes ...string) error {
+	for _, eventType := range eventTypes {
+		if err := b.Subscribe(eventType, NewEventHandlerFunc(eventType, handle)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Publish 發布單一事件
+func (b *InMemoryEventBus) Publish(event shared.DomainEvent) error {
+	b.mu.RLock()
+	handlers := b.handlers[event.EventType()]
+	b.mu.RUnlock()
+
+	var errs []error
+	for _, handler := range handlers {
+		if err := handler.Handle(event); err != nil {
+			errs = append(errs, fmt.Errorf("handler %T failed for event %s (%s): %w",
+				handler, event.EventID(), event.EventType(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// PublishBatch 依序發布事件（聚合的 PullEvents() 結果）
+func (b *InMemoryEventBus) PublishBatch(events []shared.DomainEvent) error {
+	var errs []error
+	for _, event := range events {
+		if err := b.Publish(event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ===========================
+// EventHandlerFunc
+// ===========================
+
+// EventHandlerFunc 以函數實作 shared.EventHandler
+//
+// 使用範例（application 層的處理器多以 Handle 處理多種事件）：
+//
+//	bus.Subscribe("member.unfollowed", messaging.NewEventHandlerFunc("member.unfollowed", handler.Handle))
+type EventHandlerFunc struct {
+	eventType string
+	handle    func(event shared.DomainEvent) error
+}
+
+// NewEventHandlerFunc 創建函數型事件處理器
+func NewEventHandlerFunc(eventType string, handle func(event shared.DomainEvent) error) *EventHandlerFunc {
+	return &EventHandlerFunc{eventType: eventType, handle: handle}
+}
+
+// Handle 處理事件
+func (h *EventHandlerFunc) Handle(event shared.DomainEvent) error {
+	return h.handle(event)
+}
+
+// EventType 訂閱的事件類型
+func (h *EventHandlerFunc) EventType() string {
+	return h.eventType
+}
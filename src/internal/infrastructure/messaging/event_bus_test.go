@@ -0,0 +1,71 @@
+package messaging
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// InMemoryEventBus 測試
+// ===========================
+
+// testEvent 測試用事件
+type testEvent struct {
+	id        string
+	eventType string
+}
+
+func (e testEvent) EventID() string       { return e.id }
+func (e testEvent) EventType() string     { return e.eventType }
+func (e testEvent) OccurredAt() time.Time { return time.Time{} }
+func (e testEvent) AggregateID() string   { return "aggregate-1" }
+
+// Test 1: 依事件類型分派給所有訂閱者，沒有訂閱者的事件略過
+func TestInMemoryEventBus_RoutesByEventType(t *testing.T) {
+	// Arrange
+	bus := NewInMemoryEventBus()
+	var received []string
+	record := func(prefix string) func(shared.DomainEvent) error {
+		return func(event shared.DomainEvent) error {
+			received = append(received, prefix+":"+event.EventID())
+			return nil
+		}
+	}
+	require.NoError(t, bus.SubscribeFunc(record("a"), "member.unfollowed", "member.refollowed"))
+	require.NoError(t, bus.SubscribeFunc(record("b"), "member.unfollowed"))
+
+	// Act
+	err := bus.PublishBatch([]shared.DomainEvent{
+		testEvent{id: "1", eventType: "member.unfollowed"},
+		testEvent{id: "2", eventType: "points.earned"},
+		testEvent{id: "3", eventType: "member.refollowed"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a:1", "b:1", "a:3"}, received)
+}
+
+// Test 2: 訂閱者失敗不影響其他訂閱者，錯誤合併返回
+func TestInMemoryEventBus_ContinuesAfterHandlerFailure(t *testing.T) {
+	// Arrange
+	bus := NewInMemoryEventBus()
+	handlerErr := errors.New("exclusion repository unavailable")
+	calls := 0
+	require.NoError(t, bus.SubscribeFunc(func(shared.DomainEvent) error { return handlerErr }, "member.unfollowed"))
+	require.NoError(t, bus.SubscribeFunc(func(shared.DomainEvent) error { calls++; return nil }, "member.unfollowed"))
+
+	// Act
+	err := bus.Publish(testEvent{id: "1", eventType: "member.unfollowed"})
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, handlerErr)
+	assert.Equal(t, 1, calls)
+	assert.Error(t, bus.Subscribe("", nil))
+}
@@ -0,0 +1,186 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// Admin Repository Integration Tests
+// ===========================
+
+// setupTestDB 創建測試資料庫（in-memory SQLite）
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err, "failed to connect to test database")
+
+	err = db.AutoMigrate(&AdminUserGORM{}, &AdminSessionGORM{})
+	require.NoError(t, err, "failed to migrate database schema")
+
+	return db
+}
+
+// Test 1: 管理員帳號保存與查詢（含登入節流狀態；帳號唯一）
+func TestAdminUserRepository_SaveAndFind(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewAdminUserRepository(db)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	u, err := admin.NewAdminUser("manager", "阿明", admin.RoleManager, "correct horse battery", StubPasswordHasher{}, now)
+	require.NoError(t, err)
+	require.ErrorIs(t, u.Authenticate("wrong password", "", StubPasswordHasher{}, now), admin.ErrInvalidCredentials)
+
+	// Act
+	require.NoError(t, repo.Save(nil, u))
+	byName, err := repo.FindByUsername(nil, "manager")
+	require.NoError(t, err)
+	byID, err := repo.FindByID(nil, u.AdminID())
+	require.NoError(t, err)
+	exists, err := repo.ExistsByUsername(nil, "manager")
+	require.NoError(t, err)
+	count, err := repo.Count(nil)
+	require.NoError(t, err)
+	_, missing := repo.FindByUsername(nil, "nobody")
+
+	duplicate, err := admin.NewAdminUser("manager", "", admin.RoleStaff, "correct horse battery", StubPasswordHasher{}, now)
+	require.NoError(t, err)
+	duplicateErr := repo.Save(nil, duplicate)
+
+	// Assert
+	assert.Equal(t, u.AdminID(), byName.AdminID())
+	assert.Equal(t, admin.RoleManager, byName.Role())
+	assert.Equal(t, "hashed:correct horse battery", byName.PasswordHash())
+	assert.Equal(t, 1, byName.FailedLoginAttempts())
+	assert.Equal(t, "阿明", byID.DisplayName())
+	assert.True(t, exists)
+	assert.Equal(t, 1, count)
+	assert.ErrorIs(t, missing, admin.ErrAdminUserNotFound)
+	assert.Error(t, duplicateErr)
+}
+
+// Test 2: 工作階段以 Token 雜湊查詢；撤銷後不再列為有效
+func TestSessionRepository_FindAndRevoke(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewSessionRepository(db)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	adminID := admin.NewAdminUserID()
+	first, token, err := admin.IssueSession(adminID, false, time.Hour, now)
+	require.NoError(t, err)
+	second, _, err := admin.IssueSession(adminID, false, time.Hour, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(nil, first))
+	require.NoError(t, repo.Save(nil, second))
+
+	// Act
+	found, err := repo.FindByTokenHash(nil, admin.HashSessionToken(token))
+	require.NoError(t, err)
+	_, unknown := repo.FindByTokenHash(nil, admin.HashSessionToken("forged"))
+
+	first.Revoke(now.Add(2 * time.Minute))
+	require.NoError(t, repo.Save(nil, first))
+	active, err := repo.FindActiveByAdminID(nil, adminID)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, first.SessionID(), found.SessionID())
+	assert.Equal(t, now.Add(time.Hour), found.ExpiresAt().UTC())
+	assert.ErrorIs(t, unknown, admin.ErrUnauthenticated)
+	require.Len(t, active, 1)
+	assert.Equal(t, second.SessionID(), active[0].SessionID())
+}
+
+// Test 3: 兩步驟驗證狀態保存（金鑰、最後使用的時間區間、剩餘復原碼）
+func TestAdminUserRepository_PersistsTwoFactorState(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewAdminUserRepository(db)
+	sessionRepo := NewSessionRepository(db)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	u, err := admin.NewAdminUser("owner", "王姐", admin.RoleOwner, "correct horse battery", StubPasswordHasher{}, now)
+	require.NoError(t, err)
+	secret, err := u.BeginTOTPEnrollment(now)
+	require.NoError(t, err)
+	codes, err := u.ConfirmTOTPEnrollment(secret.CodeAt(now), now)
+	require.NoError(t, err)
+	require.NoError(t, u.Authenticate("correct horse battery", codes[0], StubPasswordHasher{}, now))
+	session, token, err := admin.IssueSession(u.AdminID(), true, time.Hour, now)
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, repo.Save(nil, u))
+	require.NoError(t, sessionRepo.Save(nil, session))
+	found, err := repo.FindByID(nil, u.AdminID())
+	require.NoError(t, err)
+	foundSession, err := sessionRepo.FindByTokenHash(nil, admin.HashSessionToken(token))
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, u.TwoFactor(), found.TwoFactor())
+	assert.True(t, found.TwoFactorEnabled())
+	assert.Equal(t, admin.RecoveryCodeCount-1, found.RemainingRecoveryCodes())
+	assert.ErrorIs(t, found.Authenticate("correct horse battery", codes[0], StubPasswordHasher{}, now), admin.ErrInvalidOTP)
+	assert.ErrorIs(t, found.Authenticate("correct horse battery", secret.CodeAt(now), StubPasswordHasher{}, now), admin.ErrInvalidOTP)
+	assert.True(t, foundSession.TwoFactorVerified())
+}
+
+// Test 4: 並行登入以樂觀鎖偵測（過期的實例無法覆寫失敗次數與 TOTP 已使用時間區間）
+func TestAdminUserRepository_Update_DetectsConcurrentModification(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewAdminUserRepository(db)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	u, err := admin.NewAdminUser("manager", "阿明", admin.RoleManager, "correct horse battery", StubPasswordHasher{}, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(nil, u))
+
+	first, err := repo.FindByUsername(nil, "manager")
+	require.NoError(t, err)
+	second, err := repo.FindByUsername(nil, "manager")
+	require.NoError(t, err)
+
+	// Act
+	require.ErrorIs(t, first.Authenticate("wrong password", "", StubPasswordHasher{}, now), admin.ErrInvalidCredentials)
+	firstErr := repo.Update(nil, first)
+	require.ErrorIs(t, second.Authenticate("wrong password", "", StubPasswordHasher{}, now), admin.ErrInvalidCredentials)
+	staleErr := repo.Update(nil, second)
+
+	require.ErrorIs(t, first.Authenticate("wrong password", "", StubPasswordHasher{}, now), admin.ErrInvalidCredentials)
+	againErr := repo.Update(nil, first)
+
+	missing, err := admin.NewAdminUser("nobody", "", admin.RoleStaff, "correct horse battery", StubPasswordHasher{}, now)
+	require.NoError(t, err)
+	missingErr := repo.Update(nil, missing)
+
+	found, err := repo.FindByID(nil, u.AdminID())
+	require.NoError(t, err)
+
+	// Assert
+	assert.NoError(t, firstErr)
+	assert.ErrorIs(t, staleErr, admin.ErrAdminVersionConflict)
+	assert.NoError(t, againErr, "same instance can be updated again after a successful update")
+	assert.ErrorIs(t, missingErr, admin.ErrAdminUserNotFound)
+	assert.Equal(t, 2, found.FailedLoginAttempts())
+	assert.Equal(t, first.Version(), found.Version())
+}
+
+// ===========================
+// Stubs
+// ===========================
+
+// StubPasswordHasher 測試用雜湊（不做實際運算）
+type StubPasswordHasher struct{}
+
+func (StubPasswordHasher) Hash(password string) (string, error) {
+	return "hashed:" + password, nil
+}
+
+func (StubPasswordHasher) Verify(hash, password string) bool {
+	return hash == "hashed:"+password
+}
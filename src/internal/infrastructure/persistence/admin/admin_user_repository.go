@@ -0,0 +1,164 @@
+package admin
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// gormTransactionContext GORM 事務上下文
+type gormTransactionContext interface {
+	shared.TransactionContext
+	GetDB() *gorm.DB
+}
+
+// ===========================
+// AdminUserRepositoryImpl
+// ===========================
+
+// AdminUserRepositoryImpl 管理員帳號倉儲實現（GORM）
+type AdminUserRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewAdminUserRepository 創建新的管理員帳號倉儲實例
+func NewAdminUserRepository(db *gorm.DB) admin.AdminUserRepository {
+	return &AdminUserRepositoryImpl{db: db}
+}
+
+// Save 保存管理員帳號（新增或更新）
+//
+// 成功後 MarkPersisted（保存後的實例可直接 Update）
+func (r *AdminUserRepositoryImpl) Save(ctx shared.TransactionContext, user *admin.AdminUser) error {
+	if err := r.getDB(ctx).Save(toGORM(user)).Error; err != nil {
+		return err
+	}
+	user.MarkPersisted()
+	return nil
+}
+
+// Update 更新已存在的管理員帳號（樂觀鎖）
+//
+// 實作邏輯：
+// 1. UPDATE admin_users SET ... WHERE admin_id = ? AND version = ?（載入時版本號）
+// 2. 影響 0 筆時判斷帳號是否存在：存在 → 版本衝突；不存在 → 找不到
+// 3. 成功後 MarkPersisted，同一實例可繼續變更並再次 Update
+//
+// 錯誤處理：
+// - 版本號不符 → ErrAdminVersionConflict
+// - 帳號不存在 → ErrAdminUserNotFound
+func (r *AdminUserRepositoryImpl) Update(ctx shared.TransactionContext, user *admin.AdminUser) error {
+	db := r.getDB(ctx)
+	gormModel := toGORM(user)
+
+	// 使用 map 確保 NULL 值（解除鎖定）與零值（失敗次數歸零）也會寫入
+	result := db.Model(&AdminUserGORM{}).
+		Where("admin_id = ? AND version = ?", gormModel.AdminID, user.PersistedVersion()).
+		Updates(map[string]interface{}{
+			"display_name":          gormModel.DisplayName,
+			"password_hash":         gormModel.PasswordHash,
+			"role":                  gormModel.Role,
+			"failed_login_attempts": gormModel.FailedLoginAttempts,
+			"locked_until":          gormModel.LockedUntil,
+			"last_login_at":         gormModel.LastLoginAt,
+			"disabled_at":           gormModel.DisabledAt,
+			"totp_secret":           gormModel.TOTPSecret,
+			"totp_pending_secret":   gormModel.TOTPPendingSecret,
+			"totp_enabled_at":       gormModel.TOTPEnabledAt,
+			"totp_last_used_step":   gormModel.TOTPLastUsedStep,
+			"recovery_code_hashes":  gormModel.RecoveryCodeHashes,
+			"updated_at":            gormModel.UpdatedAt,
+			"version":               gormModel.Version,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		user.MarkPersisted()
+		return nil
+	}
+
+	var count int64
+	if err := db.Model(&AdminUserGORM{}).Where("admin_id = ?", gormModel.AdminID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return admin.ErrAdminUserNotFound.WithContext("admin_id", gormModel.AdminID)
+	}
+	return admin.ErrAdminVersionConflict.WithContext(
+		"admin_id", gormModel.AdminID,
+		"expected_version", strconv.Itoa(user.PersistedVersion()),
+	)
+}
+
+// FindByID 根據 ID 查詢管理員帳號
+func (r *AdminUserRepositoryImpl) FindByID(
+	ctx shared.TransactionContext,
+	adminID admin.AdminUserID,
+) (*admin.AdminUser, error) {
+	var gormModel AdminUserGORM
+	result := r.getDB(ctx).Where("admin_id = ?", adminID.String()).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, admin.ErrAdminUserNotFound.WithContext("admin_id", adminID.String())
+		}
+		return nil, result.Error
+	}
+	return gormModel.toDomain()
+}
+
+// FindByUsername 根據帳號查詢管理員
+func (r *AdminUserRepositoryImpl) FindByUsername(
+	ctx shared.TransactionContext,
+	username string,
+) (*admin.AdminUser, error) {
+	var gormModel AdminUserGORM
+	result := r.getDB(ctx).Where("username = ?", username).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, admin.ErrAdminUserNotFound.WithContext("username", username)
+		}
+		return nil, result.Error
+	}
+	return gormModel.toDomain()
+}
+
+// ExistsByUsername 檢查帳號是否已被使用
+func (r *AdminUserRepositoryImpl) ExistsByUsername(ctx shared.TransactionContext, username string) (bool, error) {
+	var count int64
+	err := r.getDB(ctx).Model(&AdminUserGORM{}).Where("username = ?", username).Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Count 管理員帳號總數
+func (r *AdminUserRepositoryImpl) Count(ctx shared.TransactionContext) (int, error) {
+	var count int64
+	if err := r.getDB(ctx).Model(&AdminUserGORM{}).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// ===========================
+// Helper Methods
+// ===========================
+
+// getDB 獲取 GORM DB 實例
+//
+// 行為：
+//   - ctx != nil: 使用事務中的 DB（從 TransactionContext 獲取）
+//   - ctx == nil: 使用預設 DB（auto-commit 模式）
+func (r *AdminUserRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
@@ -0,0 +1,173 @@
+package admin
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+)
+
+// ===========================
+// GORM Models
+// ===========================
+
+// AdminUserGORM 管理員帳號資料表模型
+//
+// 資料庫約束：
+// - admin_id: 主鍵（UUID）
+// - username: 唯一索引（小寫）
+// - recovery_code_hashes: 以逗號分隔的 SHA-256 hex
+type AdminUserGORM struct {
+	// 識別欄位
+	AdminID     string `gorm:"column:admin_id;type:varchar(36);primaryKey"`
+	Username    string `gorm:"column:username;type:varchar(100);not null;uniqueIndex"`
+	DisplayName string `gorm:"column:display_name;type:varchar(100);not null"`
+
+	// 認證與授權
+	PasswordHash        string     `gorm:"column:password_hash;type:varchar(255);not null"`
+	Role                string     `gorm:"column:role;type:varchar(20);not null"`
+	FailedLoginAttempts int        `gorm:"column:failed_login_attempts;not null"`
+	LockedUntil         *time.Time `gorm:"column:locked_until"`
+	LastLoginAt         *time.Time `gorm:"column:last_login_at"`
+	DisabledAt          *time.Time `gorm:"column:disabled_at"`
+
+	// 兩步驟驗證（TOTP）
+	TOTPSecret         string     `gorm:"column:totp_secret;type:varchar(64);not null"`
+	TOTPPendingSecret  string     `gorm:"column:totp_pending_secret;type:varchar(64);not null"`
+	TOTPEnabledAt      *time.Time `gorm:"column:totp_enabled_at"`
+	TOTPLastUsedStep   int64      `gorm:"column:totp_last_used_step;not null"`
+	RecoveryCodeHashes string     `gorm:"column:recovery_code_hashes;type:text;not null"`
+
+	// 審計欄位
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+	Version   int       `gorm:"column:version;not null;default:1"` // 樂觀鎖
+}
+
+// TableName 指定資料表名稱
+func (AdminUserGORM) TableName() string {
+	return "admin_users"
+}
+
+// AdminSessionGORM 登入工作階段資料表模型
+//
+// 資料庫約束：
+// - session_id: 主鍵（UUID）
+// - token_hash: 唯一索引（SHA-256 hex，不保存明文 Token）
+// - admin_id: 索引（撤銷管理員的所有工作階段）
+type AdminSessionGORM struct {
+	SessionID string     `gorm:"column:session_id;type:varchar(36);primaryKey"`
+	AdminID   string     `gorm:"column:admin_id;type:varchar(36);not null;index"`
+	TokenHash string     `gorm:"column:token_hash;type:varchar(64);not null;uniqueIndex"`
+	IssuedAt  time.Time  `gorm:"column:issued_at;not null"`
+	ExpiresAt time.Time  `gorm:"column:expires_at;not null"`
+	RevokedAt *time.Time `gorm:"column:revoked_at"`
+
+	TwoFactorVerified bool `gorm:"column:two_factor_verified;not null"`
+}
+
+// TableName 指定資料表名稱
+func (AdminSessionGORM) TableName() string {
+	return "admin_sessions"
+}
+
+// ===========================
+// Mapper Functions
+// ===========================
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+func (g *AdminUserGORM) toDomain() (*admin.AdminUser, error) {
+	adminID, err := admin.AdminUserIDFromString(g.AdminID)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodeHashes := make([]string, 0)
+	for _, hash := range strings.Split(g.RecoveryCodeHashes, ",") {
+		if hash != "" {
+			recoveryCodeHashes = append(recoveryCodeHashes, hash)
+		}
+	}
+
+	return admin.ReconstructAdminUser(
+		adminID,
+		g.Username,
+		g.DisplayName,
+		g.PasswordHash,
+		admin.Role(g.Role),
+		g.FailedLoginAttempts,
+		g.LockedUntil,
+		g.LastLoginAt,
+		g.DisabledAt,
+		admin.TwoFactorState{
+			Secret:             g.TOTPSecret,
+			PendingSecret:      g.TOTPPendingSecret,
+			EnabledAt:          g.TOTPEnabledAt,
+			LastUsedStep:       g.TOTPLastUsedStep,
+			RecoveryCodeHashes: recoveryCodeHashes,
+		},
+		g.CreatedAt,
+		g.UpdatedAt,
+		g.Version,
+	)
+}
+
+// toGORM 將 Domain 模型轉換為 GORM 模型
+func toGORM(u *admin.AdminUser) *AdminUserGORM {
+	twoFactor := u.TwoFactor()
+	return &AdminUserGORM{
+		AdminID:             u.AdminID().String(),
+		Username:            u.Username(),
+		DisplayName:         u.DisplayName(),
+		PasswordHash:        u.PasswordHash(),
+		Role:                u.Role().String(),
+		FailedLoginAttempts: u.FailedLoginAttempts(),
+		LockedUntil:         u.LockedUntil(),
+		LastLoginAt:         u.LastLoginAt(),
+		DisabledAt:          u.DisabledAt(),
+		TOTPSecret:          twoFactor.Secret,
+		TOTPPendingSecret:   twoFactor.PendingSecret,
+		TOTPEnabledAt:       twoFactor.EnabledAt,
+		TOTPLastUsedStep:    twoFactor.LastUsedStep,
+		RecoveryCodeHashes:  strings.Join(twoFactor.RecoveryCodeHashes, ","),
+		CreatedAt:           u.CreatedAt(),
+		UpdatedAt:           u.UpdatedAt(),
+		Version:             u.Version(),
+	}
+}
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+func (g *AdminSessionGORM) toDomain() (*admin.Session, error) {
+	sessionID, err := admin.SessionIDFromString(g.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	adminID, err := admin.AdminUserIDFromString(g.AdminID)
+	if err != nil {
+		return nil, err
+	}
+
+	return admin.ReconstructSession(
+		sessionID,
+		adminID,
+		g.TokenHash,
+		g.IssuedAt,
+		g.ExpiresAt,
+		g.RevokedAt,
+		g.TwoFactorVerified,
+	), nil
+}
+
+// toSessionGORM 將 Domain 模型轉換為 GORM 模型
+func toSessionGORM(s *admin.Session) *AdminSessionGORM {
+	return &AdminSessionGORM{
+		SessionID: s.SessionID().String(),
+		AdminID:   s.AdminID().String(),
+		TokenHash: s.TokenHash(),
+		IssuedAt:  s.IssuedAt(),
+		ExpiresAt: s.ExpiresAt(),
+		RevokedAt: s.RevokedAt(),
+
+		TwoFactorVerified: s.TwoFactorVerified(),
+	}
+}
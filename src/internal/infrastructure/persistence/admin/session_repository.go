@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"errors"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// SessionRepositoryImpl
+// ===========================
+
+// SessionRepositoryImpl 登入工作階段倉儲實現（GORM）
+type SessionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewSessionRepository 創建新的工作階段倉儲實例
+func NewSessionRepository(db *gorm.DB) admin.SessionRepository {
+	return &SessionRepositoryImpl{db: db}
+}
+
+// Save 保存工作階段（新增或更新）
+func (r *SessionRepositoryImpl) Save(ctx shared.TransactionContext, session *admin.Session) error {
+	return r.getDB(ctx).Save(toSessionGORM(session)).Error
+}
+
+// FindByTokenHash 根據 Token 雜湊查詢工作階段
+//
+// 注意：找不到時不附加 Token 雜湊於錯誤上下文（避免寫入日誌）
+func (r *SessionRepositoryImpl) FindByTokenHash(
+	ctx shared.TransactionContext,
+	tokenHash string,
+) (*admin.Session, error) {
+	var gormModel AdminSessionGORM
+	result := r.getDB(ctx).Where("token_hash = ?", tokenHash).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, admin.ErrUnauthenticated
+		}
+		return nil, result.Error
+	}
+	return gormModel.toDomain()
+}
+
+// FindActiveByAdminID 查詢管理員尚未撤銷的工作階段（依發放時間排序）
+func (r *SessionRepositoryImpl) FindActiveByAdminID(
+	ctx shared.TransactionContext,
+	adminID admin.AdminUserID,
+) ([]*admin.Session, error) {
+	var gormModels []AdminSessionGORM
+	err := r.getDB(ctx).
+		Where("admin_id = ? AND revoked_at IS NULL", adminID.String()).
+		Order("issued_at").
+		Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*admin.Session, 0, len(gormModels))
+	for i := range gormModels {
+		session, err := gormModels[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+// ===========================
+// Helper Methods
+// ===========================
+
+// getDB 獲取 GORM DB 實例（ctx 為 nil 時使用 auto-commit 模式）
+func (r *SessionRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
@@ -0,0 +1,92 @@
+package broadcast
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// AudienceQueryImpl
+// ===========================
+
+// AudienceQueryImpl 目標分眾查詢實現（GORM）
+//
+// 設計原則：
+// - 實作 broadcast.AudienceQuery 接口
+// - 直接查詢 members / points_accounts / invoice_transactions（不載入聚合）
+// - 沒有積分帳戶的會員可用積分視為 0
+// - 未消費：區間內沒有非 failed 狀態的發票（以發票日期判斷），且會員在區間開始前已註冊
+// - 已封鎖官方帳號（unfollowed_at 不為空）的會員不在分眾內
+// - 已被合併的會員（merged_into 不為空）不在分眾內（由保留的會員接收）
+// - 僅限已驗證成年時：age_verified_at 不為空
+type AudienceQueryImpl struct {
+	db *gorm.DB
+}
+
+// NewAudienceQuery 創建目標分眾查詢實例
+func NewAudienceQuery(db *gorm.DB) broadcast.AudienceQuery {
+	return &AudienceQueryImpl{db: db}
+}
+
+// FindAudience 查詢符合分眾條件的會員（依會員建立時間排序）
+func (q *AudienceQueryImpl) FindAudience(
+	ctx shared.TransactionContext,
+	segment broadcast.Segment,
+	now time.Time,
+) ([]broadcast.Audience, error) {
+	db := q.getDB(ctx).Table("members AS m").
+		Select("m.member_id, m.line_user_id").
+		Joins("LEFT JOIN points_accounts AS p ON p.member_id = m.member_id AND p.deleted_at IS NULL").
+		Where("m.deleted_at IS NULL").
+		Where("m.unfollowed_at IS NULL").
+		Where("m.merged_into IS NULL").
+		Where("COALESCE(p.earned_points - p.used_points, 0) >= ?", segment.MinAvailablePoints())
+
+	if segment.VerifiedAdultsOnly() {
+		db = db.Where("m.age_verified_at IS NOT NULL")
+	}
+
+	if segment.HasInactivityFilter() {
+		since := segment.InactiveSince(now)
+		db = db.Where("m.created_at < ?", since).
+			Where("NOT EXISTS (?)", q.getDB(ctx).Table("invoice_transactions AS t").
+				Select("1").
+				Where("t.member_id = m.member_id AND t.deleted_at IS NULL").
+				Where("t.status <> ? AND t.invoice_date >= ?", "failed", since))
+	}
+
+	var rows []struct {
+		MemberID   string
+		LineUserID string
+	}
+	if err := db.Order("m.created_at ASC").Order("m.member_id ASC").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	audience := make([]broadcast.Audience, 0, len(rows))
+	for _, row := range rows {
+		memberID, err := broadcast.MemberIDFromString(row.MemberID)
+		if err != nil {
+			return nil, err
+		}
+		audience = append(audience, broadcast.Audience{MemberID: memberID, LineUserID: row.LineUserID})
+	}
+	return audience, nil
+}
+
+// getDB 獲取 GORM DB 實例
+//
+// 行為：
+//   - ctx != nil: 使用事務中的 DB（從 TransactionContext 獲取）
+//   - ctx == nil: 使用預設 DB（auto-commit 模式）
+func (q *AudienceQueryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return q.db
+}
@@ -0,0 +1,285 @@
+package broadcast
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+	invoicepersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/invoice"
+	memberpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/member"
+	pointspersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/points"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// Broadcast Repository Integration Tests
+// ===========================
+
+// setupTestDB 創建測試資料庫（in-memory SQLite，含分眾查詢用的會員 / 積分 / 發票資料表）
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err, "failed to connect to test database")
+
+	err = db.AutoMigrate(
+		&CampaignGORM{}, &RecipientGORM{}, &ExclusionGORM{},
+		&memberpersistence.MemberGORM{},
+		&pointspersistence.PointsAccountGORM{},
+		&invoicepersistence.InvoiceTransactionGORM{},
+	)
+	require.NoError(t, err, "failed to migrate database schema")
+
+	return db
+}
+
+// seedMember 建立會員與積分帳戶，返回會員 ID
+func seedMember(t *testing.T, db *gorm.DB, lineUserID string, availablePoints int, registeredAt time.Time) string {
+	t.Helper()
+	memberID := uuid.NewString()
+	require.NoError(t, db.Create(&memberpersistence.MemberGORM{
+		MemberID:    memberID,
+		LineUserID:  lineUserID,
+		DisplayName: lineUserID,
+		CreatedAt:   registeredAt,
+		UpdatedAt:   registeredAt,
+		Version:     1,
+	}).Error)
+	require.NoError(t, db.Create(&pointspersistence.PointsAccountGORM{
+		AccountID:    uuid.NewString(),
+		MemberID:     memberID,
+		EarnedPoints: availablePoints + 10,
+		UsedPoints:   10,
+		CreatedAt:    registeredAt,
+		UpdatedAt:    registeredAt,
+	}).Error)
+	return memberID
+}
+
+// seedInvoice 建立會員的發票交易
+func seedInvoice(t *testing.T, db *gorm.DB, memberID, invoiceNumber, status string, invoiceDate time.Time) {
+	t.Helper()
+	require.NoError(t, db.Create(&invoicepersistence.InvoiceTransactionGORM{
+		TransactionID: uuid.NewString(),
+		MemberID:      memberID,
+		InvoiceNumber: invoiceNumber,
+		InvoiceDate:   invoiceDate,
+		Amount:        500,
+		Status:        status,
+		CreatedAt:     invoiceDate,
+		UpdatedAt:     invoiceDate,
+	}).Error)
+}
+
+func newTestCampaign(t *testing.T, scheduledAt, now time.Time) *broadcast.Campaign {
+	t.Helper()
+	segment, err := broadcast.NewSegment(100, 30)
+	require.NoError(t, err)
+	message, err := broadcast.NewMessageTemplate("好久不見！")
+	require.NoError(t, err)
+	c, err := broadcast.NewCampaign("30 天未回訪", segment, message, scheduledAt, "manager", now)
+	require.NoError(t, err)
+	return c
+}
+
+// Test 1: 分眾查詢（積分門檻、近期消費、註冊時間、failed 發票不算消費、封鎖者排除）
+func TestAudienceQuery_FindAudience(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	query := NewAudienceQuery(db)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	longAgo := now.AddDate(0, -6, 0)
+
+	inactive := seedMember(t, db, "U-inactive", 150, longAgo)
+	seedInvoice(t, db, inactive, "AB00000001", "verified", now.AddDate(0, 0, -45))
+	failedOnly := seedMember(t, db, "U-failed-only", 100, longAgo.Add(time.Hour))
+	seedInvoice(t, db, failedOnly, "AB00000002", "failed", now.AddDate(0, 0, -3))
+
+	recent := seedMember(t, db, "U-recent", 300, longAgo)
+	seedInvoice(t, db, recent, "AB00000003", "imported", now.AddDate(0, 0, -10))
+	seedMember(t, db, "U-low-points", 99, longAgo)
+	seedMember(t, db, "U-new", 500, now.AddDate(0, 0, -5))
+	blocked := seedMember(t, db, "U-blocked", 200, longAgo)
+	unfollowedAt := now.AddDate(0, 0, -1)
+	require.NoError(t, db.Model(&memberpersistence.MemberGORM{}).
+		Where("member_id = ?", blocked).
+		Update("unfollowed_at", unfollowedAt).Error)
+
+	segment, err := broadcast.NewSegment(100, 30)
+	require.NoError(t, err)
+
+	// Act
+	audience, err := query.FindAudience(nil, segment, now)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, audience, 2)
+	assert.Equal(t, inactive, audience[0].MemberID.String())
+	assert.Equal(t, "U-inactive", audience[0].LineUserID)
+	assert.Equal(t, failedOnly, audience[1].MemberID.String())
+}
+
+// Test 2: 收件者分批、續傳批次查詢與統計
+func TestRecipientRepository_BatchesAndSummary(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewRecipientRepository(db)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	campaignID := broadcast.NewCampaignID()
+
+	recipients := make([]*broadcast.Recipient, 0, 5)
+	for i := 0; i < 5; i++ {
+		memberID, err := broadcast.MemberIDFromString(uuid.NewString())
+		require.NoError(t, err)
+		recipients = append(recipients, broadcast.NewRecipient(campaignID, memberID, fmt.Sprintf("U%d", i), now))
+	}
+	require.NoError(t, repo.SaveAll(nil, recipients))
+
+	// Act
+	batch, err := repo.FindUnassigned(nil, campaignID, 2)
+	require.NoError(t, err)
+	for _, r := range batch {
+		require.NoError(t, r.AssignBatchAt("batch-1", now.Add(time.Minute)))
+	}
+	require.NoError(t, repo.SaveAll(nil, batch))
+
+	inFlight, err := repo.FindInFlightBatch(nil, campaignID)
+	require.NoError(t, err)
+	unassigned, err := repo.FindUnassigned(nil, campaignID, 10)
+	require.NoError(t, err)
+
+	for _, r := range inFlight {
+		require.NoError(t, r.MarkSentAt(now.Add(2*time.Minute)))
+	}
+	require.NoError(t, unassigned[0].ExcludeAt(broadcast.ExclusionReasonBlocked, now))
+	require.NoError(t, repo.SaveAll(nil, append(inFlight, unassigned[0])))
+
+	summary, err := repo.Summarize(nil, campaignID)
+	require.NoError(t, err)
+	remaining, err := repo.FindInFlightBatch(nil, campaignID)
+	require.NoError(t, err)
+	sent, err := repo.FindByCampaignID(nil, campaignID, broadcast.RecipientStatusSent)
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, inFlight, 2)
+	assert.Equal(t, "batch-1", inFlight[0].BatchKey())
+	assert.Len(t, unassigned, 3)
+	assert.Empty(t, remaining)
+	assert.Equal(t, broadcast.DeliverySummary{Pending: 2, Sent: 2, Excluded: 1}, summary)
+	require.Len(t, sent, 2)
+	require.NotNil(t, sent[0].SentAt())
+	assert.Equal(t, broadcast.ExclusionReasonBlocked, unassigned[0].ExclusionReason())
+}
+
+// Test 3: 查詢待處理活動（已到時間的 scheduled + sending，不含 completed / 未到時間）
+func TestCampaignRepository_FindSendable(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewCampaignRepository(db)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	due := newTestCampaign(t, now, now.Add(-time.Hour))
+	sending := newTestCampaign(t, now.Add(-2*time.Hour), now.Add(-3*time.Hour))
+	require.NoError(t, sending.StartAt(now.Add(-2*time.Hour)))
+	future := newTestCampaign(t, now.Add(time.Hour), now)
+	completed := newTestCampaign(t, now.Add(-time.Hour), now.Add(-2*time.Hour))
+	require.NoError(t, completed.StartAt(now.Add(-time.Hour)))
+	require.NoError(t, completed.CompleteAt(broadcast.DeliverySummary{Sent: 3, Failed: 1}, now))
+	for _, c := range []*broadcast.Campaign{due, sending, future, completed} {
+		require.NoError(t, repo.Save(nil, c))
+	}
+
+	// Act
+	sendable, err := repo.FindSendable(nil, now)
+	require.NoError(t, err)
+	found, err := repo.FindByID(nil, completed.CampaignID())
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, sendable, 2)
+	assert.Equal(t, sending.CampaignID(), sendable[0].CampaignID())
+	assert.Equal(t, due.CampaignID(), sendable[1].CampaignID())
+	assert.Equal(t, broadcast.CampaignStatusCompleted, found.Status())
+	assert.Equal(t, 4, found.Summary().Total())
+	assert.Equal(t, 30, found.Segment().InactiveDays())
+}
+
+// Test 4: 排除名單（更新原因、批次查詢、移除）
+func TestExclusionRepository_SaveFindDelete(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewExclusionRepository(db)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	memberID, err := broadcast.MemberIDFromString(uuid.NewString())
+	require.NoError(t, err)
+	otherID, err := broadcast.MemberIDFromString(uuid.NewString())
+	require.NoError(t, err)
+
+	unsubscribed, err := broadcast.NewExclusion(memberID, broadcast.ExclusionReasonUnsubscribed, now)
+	require.NoError(t, err)
+	blocked, err := broadcast.NewExclusion(memberID, broadcast.ExclusionReasonBlocked, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, repo.Save(nil, unsubscribed))
+	require.NoError(t, repo.Save(nil, blocked))
+	found, err := repo.FindByMemberIDs(nil, []broadcast.MemberID{memberID, otherID})
+	require.NoError(t, err)
+	deleteErr := repo.Delete(nil, memberID)
+	_, findErr := repo.FindByMemberID(nil, memberID)
+
+	// Assert
+	require.Len(t, found, 1)
+	assert.Equal(t, broadcast.ExclusionReasonBlocked, found[0].Reason())
+	assert.NoError(t, deleteErr)
+	assert.ErrorIs(t, findErr, broadcast.ErrExclusionNotFound)
+	assert.ErrorIs(t, repo.Delete(nil, memberID), broadcast.ErrExclusionNotFound)
+}
+
+// Test 5: 酒類促銷僅發送給已驗證成年的會員，且分眾設定隨活動保存
+func TestAudienceQuery_FindAudience_VerifiedAdultsOnly(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	query := NewAudienceQuery(db)
+	repo := NewCampaignRepository(db)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	longAgo := now.AddDate(0, -6, 0)
+
+	verified := seedMember(t, db, "U-verified", 100, longAgo)
+	seedMember(t, db, "U-unverified", 100, longAgo.Add(time.Hour))
+	verifiedAt := now.AddDate(0, 0, -7)
+	require.NoError(t, db.Model(&memberpersistence.MemberGORM{}).
+		Where("member_id = ?", verified).
+		Updates(map[string]interface{}{
+			"age_verified_by":         "staff01",
+			"age_verification_method": "staff_id_check",
+			"age_verified_at":         verifiedAt,
+		}).Error)
+
+	segment, err := broadcast.NewSegment(0, 0)
+	require.NoError(t, err)
+	adultsOnly := segment.WithVerifiedAdultsOnly(true)
+	message, err := broadcast.NewMessageTemplate("啤酒買一送一")
+	require.NoError(t, err)
+	c, err := broadcast.NewCampaign("啤酒節", adultsOnly, message, now, "manager", now)
+	require.NoError(t, err)
+
+	// Act
+	everyone, err := query.FindAudience(nil, segment, now)
+	require.NoError(t, err)
+	adults, err := query.FindAudience(nil, adultsOnly, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(nil, c))
+	found, err := repo.FindByID(nil, c.CampaignID())
+	require.NoError(t, err)
+
+	// Assert
+	assert.Len(t, everyone, 2)
+	require.Len(t, adults, 1)
+	assert.Equal(t, verified, adults[0].MemberID.String())
+	assert.True(t, found.Segment().VerifiedAdultsOnly())
+}
@@ -0,0 +1,97 @@
+package broadcast
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// gormTransactionContext GORM 事務上下文
+type gormTransactionContext interface {
+	shared.TransactionContext
+	GetDB() *gorm.DB
+}
+
+// ===========================
+// CampaignRepositoryImpl
+// ===========================
+
+// CampaignRepositoryImpl 群發活動倉儲實現（GORM）
+type CampaignRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCampaignRepository 創建新的群發活動倉儲實例
+func NewCampaignRepository(db *gorm.DB) broadcast.CampaignRepository {
+	return &CampaignRepositoryImpl{db: db}
+}
+
+// Save 保存群發活動（新增或更新）
+func (r *CampaignRepositoryImpl) Save(ctx shared.TransactionContext, campaign *broadcast.Campaign) error {
+	return r.getDB(ctx).Save(toGORM(campaign)).Error
+}
+
+// FindByID 根據 ID 查詢群發活動
+func (r *CampaignRepositoryImpl) FindByID(
+	ctx shared.TransactionContext,
+	id broadcast.CampaignID,
+) (*broadcast.Campaign, error) {
+	var gormModel CampaignGORM
+	result := r.getDB(ctx).Where("campaign_id = ?", id.String()).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, broadcast.ErrCampaignNotFound.WithContext(
+				"campaign_id", id.String(),
+			)
+		}
+		return nil, result.Error
+	}
+	return gormModel.toDomain()
+}
+
+// FindSendable 查詢已到預定時間的 scheduled 活動與 sending 活動（依預定時間排序）
+func (r *CampaignRepositoryImpl) FindSendable(
+	ctx shared.TransactionContext,
+	now time.Time,
+) ([]*broadcast.Campaign, error) {
+	var gormModels []CampaignGORM
+	result := r.getDB(ctx).
+		Where("(status = ? AND scheduled_at <= ?) OR status = ?",
+			broadcast.CampaignStatusScheduled.String(), now, broadcast.CampaignStatusSending.String()).
+		Order("scheduled_at ASC").
+		Find(&gormModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	campaigns := make([]*broadcast.Campaign, 0, len(gormModels))
+	for i := range gormModels {
+		c, err := gormModels[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		campaigns = append(campaigns, c)
+	}
+	return campaigns, nil
+}
+
+// ===========================
+// Helper Methods
+// ===========================
+
+// getDB 獲取 GORM DB 實例
+//
+// 行為：
+//   - ctx != nil: 使用事務中的 DB（從 TransactionContext 獲取）
+//   - ctx == nil: 使用預設 DB（auto-commit 模式）
+func (r *CampaignRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
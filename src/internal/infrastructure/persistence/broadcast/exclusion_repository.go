@@ -0,0 +1,106 @@
+package broadcast
+
+import (
+	"errors"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// ExclusionRepositoryImpl
+// ===========================
+
+// ExclusionRepositoryImpl 排除名單倉儲實現（GORM）
+type ExclusionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewExclusionRepository 創建新的排除名單倉儲實例
+func NewExclusionRepository(db *gorm.DB) broadcast.ExclusionRepository {
+	return &ExclusionRepositoryImpl{db: db}
+}
+
+// Save 保存排除紀錄（會員已在名單中時更新原因）
+func (r *ExclusionRepositoryImpl) Save(ctx shared.TransactionContext, exclusion *broadcast.Exclusion) error {
+	return r.getDB(ctx).Save(&ExclusionGORM{
+		MemberID:  exclusion.MemberID().String(),
+		Reason:    exclusion.Reason().String(),
+		CreatedAt: exclusion.CreatedAt(),
+	}).Error
+}
+
+// Delete 將會員移出排除名單
+func (r *ExclusionRepositoryImpl) Delete(ctx shared.TransactionContext, memberID broadcast.MemberID) error {
+	result := r.getDB(ctx).Where("member_id = ?", memberID.String()).Delete(&ExclusionGORM{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return broadcast.ErrExclusionNotFound.WithContext("member_id", memberID.String())
+	}
+	return nil
+}
+
+// FindByMemberID 查詢會員的排除紀錄
+func (r *ExclusionRepositoryImpl) FindByMemberID(
+	ctx shared.TransactionContext,
+	memberID broadcast.MemberID,
+) (*broadcast.Exclusion, error) {
+	var gormModel ExclusionGORM
+	result := r.getDB(ctx).Where("member_id = ?", memberID.String()).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, broadcast.ErrExclusionNotFound.WithContext("member_id", memberID.String())
+		}
+		return nil, result.Error
+	}
+	return gormModel.toDomain()
+}
+
+// FindByMemberIDs 批次查詢排除紀錄（每次最多 MaxBatchSize 位會員）
+func (r *ExclusionRepositoryImpl) FindByMemberIDs(
+	ctx shared.TransactionContext,
+	memberIDs []broadcast.MemberID,
+) ([]*broadcast.Exclusion, error) {
+	exclusions := make([]*broadcast.Exclusion, 0)
+	for start := 0; start < len(memberIDs); start += broadcast.MaxBatchSize {
+		end := min(start+broadcast.MaxBatchSize, len(memberIDs))
+		ids := make([]string, 0, end-start)
+		for _, id := range memberIDs[start:end] {
+			ids = append(ids, id.String())
+		}
+
+		var gormModels []ExclusionGORM
+		if err := r.getDB(ctx).Where("member_id IN ?", ids).Find(&gormModels).Error; err != nil {
+			return nil, err
+		}
+		for i := range gormModels {
+			exclusion, err := gormModels[i].toDomain()
+			if err != nil {
+				return nil, err
+			}
+			exclusions = append(exclusions, exclusion)
+		}
+	}
+	return exclusions, nil
+}
+
+// ===========================
+// Helper Methods
+// ===========================
+
+// getDB 獲取 GORM DB 實例
+//
+// 行為：
+//   - ctx != nil: 使用事務中的 DB（從 TransactionContext 獲取）
+//   - ctx == nil: 使用預設 DB（auto-commit 模式）
+func (r *ExclusionRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
@@ -0,0 +1,206 @@
+package broadcast
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+)
+
+// ===========================
+// GORM Models
+// ===========================
+
+// CampaignGORM 群發活動資料表模型
+//
+// 資料庫約束：
+// - campaign_id: 主鍵（UUID）
+// - status + scheduled_at: 複合索引（排程查詢待發送活動）
+// - verified_adults_only: 僅限已驗證成年的會員（酒類促銷）
+// - summary_*: 完成時的投遞統計
+type CampaignGORM struct {
+	// 識別欄位
+	CampaignID string `gorm:"column:campaign_id;type:varchar(36);primaryKey"`
+	Name       string `gorm:"column:name;type:varchar(100);not null"`
+
+	// 目標分眾與訊息
+	MinAvailablePoints int    `gorm:"column:min_available_points;not null"`
+	InactiveDays       int    `gorm:"column:inactive_days;not null"`
+	VerifiedAdultsOnly bool   `gorm:"column:verified_adults_only;not null;default:false"`
+	Message            string `gorm:"column:message;type:text;not null"`
+
+	// 發送狀態
+	Status          string     `gorm:"column:status;type:varchar(20);not null;index:idx_broadcast_campaigns_sendable,priority:1"`
+	ScheduledAt     time.Time  `gorm:"column:scheduled_at;not null;index:idx_broadcast_campaigns_sendable,priority:2"`
+	CreatedBy       string     `gorm:"column:created_by;type:varchar(100);not null"`
+	SummarySent     int        `gorm:"column:summary_sent;not null"`
+	SummaryFailed   int        `gorm:"column:summary_failed;not null"`
+	SummaryExcluded int        `gorm:"column:summary_excluded;not null"`
+	StartedAt       *time.Time `gorm:"column:started_at"`
+	CompletedAt     *time.Time `gorm:"column:completed_at"`
+
+	// 審計欄位
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+}
+
+// TableName 指定資料表名稱
+func (CampaignGORM) TableName() string {
+	return "broadcast_campaigns"
+}
+
+// RecipientGORM 群發收件者資料表模型
+//
+// 資料庫約束：
+// - campaign_id + member_id: 複合主鍵（同一活動內會員唯一）
+// - campaign_id + status + batch_key: 複合索引（查詢未分批 / 進行中的批次）
+type RecipientGORM struct {
+	CampaignID string `gorm:"column:campaign_id;type:varchar(36);primaryKey;index:idx_broadcast_recipients_batch,priority:1"`
+	MemberID   string `gorm:"column:member_id;type:varchar(36);primaryKey"`
+	LineUserID string `gorm:"column:line_user_id;type:varchar(33);not null"`
+
+	// 投遞狀態
+	Status          string     `gorm:"column:status;type:varchar(20);not null;index:idx_broadcast_recipients_batch,priority:2"`
+	BatchKey        string     `gorm:"column:batch_key;type:varchar(36);not null;index:idx_broadcast_recipients_batch,priority:3"`
+	Attempts        int        `gorm:"column:attempts;not null"`
+	LastError       string     `gorm:"column:last_error;type:text"`
+	ExclusionReason string     `gorm:"column:exclusion_reason;type:varchar(20)"`
+	SentAt          *time.Time `gorm:"column:sent_at"`
+
+	// 審計欄位
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+}
+
+// TableName 指定資料表名稱
+func (RecipientGORM) TableName() string {
+	return "broadcast_recipients"
+}
+
+// ExclusionGORM 群發排除名單資料表模型
+//
+// 資料庫約束：
+// - member_id: 主鍵（每位會員最多一筆）
+type ExclusionGORM struct {
+	MemberID  string    `gorm:"column:member_id;type:varchar(36);primaryKey"`
+	Reason    string    `gorm:"column:reason;type:varchar(20);not null"`
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+}
+
+// TableName 指定資料表名稱
+func (ExclusionGORM) TableName() string {
+	return "broadcast_exclusions"
+}
+
+// ===========================
+// Mapper Functions
+// ===========================
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+func (g *CampaignGORM) toDomain() (*broadcast.Campaign, error) {
+	campaignID, err := broadcast.CampaignIDFromString(g.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+	segment, err := broadcast.NewSegment(g.MinAvailablePoints, g.InactiveDays)
+	if err != nil {
+		return nil, err
+	}
+	segment = segment.WithVerifiedAdultsOnly(g.VerifiedAdultsOnly)
+	message, err := broadcast.NewMessageTemplate(g.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	return broadcast.ReconstructCampaign(
+		campaignID,
+		g.Name,
+		segment,
+		message,
+		broadcast.CampaignStatus(g.Status),
+		g.ScheduledAt,
+		g.CreatedBy,
+		broadcast.DeliverySummary{
+			Sent:     g.SummarySent,
+			Failed:   g.SummaryFailed,
+			Excluded: g.SummaryExcluded,
+		},
+		g.StartedAt,
+		g.CompletedAt,
+		g.CreatedAt,
+		g.UpdatedAt,
+	)
+}
+
+// toGORM 將 Domain 模型轉換為 GORM 模型
+func toGORM(c *broadcast.Campaign) *CampaignGORM {
+	return &CampaignGORM{
+		CampaignID:         c.CampaignID().String(),
+		Name:               c.Name(),
+		MinAvailablePoints: c.Segment().MinAvailablePoints(),
+		InactiveDays:       c.Segment().InactiveDays(),
+		VerifiedAdultsOnly: c.Segment().VerifiedAdultsOnly(),
+		Message:            c.Message().Text(),
+		Status:             c.Status().String(),
+		ScheduledAt:        c.ScheduledAt(),
+		CreatedBy:          c.CreatedBy(),
+		SummarySent:        c.Summary().Sent,
+		SummaryFailed:      c.Summary().Failed,
+		SummaryExcluded:    c.Summary().Excluded,
+		StartedAt:          c.StartedAt(),
+		CompletedAt:        c.CompletedAt(),
+		CreatedAt:          c.CreatedAt(),
+		UpdatedAt:          c.UpdatedAt(),
+	}
+}
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+func (g *RecipientGORM) toDomain() (*broadcast.Recipient, error) {
+	campaignID, err := broadcast.CampaignIDFromString(g.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+	memberID, err := broadcast.MemberIDFromString(g.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return broadcast.ReconstructRecipient(
+		campaignID,
+		memberID,
+		g.LineUserID,
+		broadcast.RecipientStatus(g.Status),
+		g.BatchKey,
+		g.Attempts,
+		g.LastError,
+		broadcast.ExclusionReason(g.ExclusionReason),
+		g.SentAt,
+		g.CreatedAt,
+		g.UpdatedAt,
+	)
+}
+
+// toRecipientGORM 將 Domain 模型轉換為 GORM 模型
+func toRecipientGORM(r *broadcast.Recipient) *RecipientGORM {
+	return &RecipientGORM{
+		CampaignID:      r.CampaignID().String(),
+		MemberID:        r.MemberID().String(),
+		LineUserID:      r.LineUserID(),
+		Status:          r.Status().String(),
+		BatchKey:        r.BatchKey(),
+		Attempts:        r.Attempts(),
+		LastError:       r.LastError(),
+		ExclusionReason: r.ExclusionReason().String(),
+		SentAt:          r.SentAt(),
+		CreatedAt:       r.CreatedAt(),
+		UpdatedAt:       r.UpdatedAt(),
+	}
+}
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+func (g *ExclusionGORM) toDomain() (*broadcast.Exclusion, error) {
+	memberID, err := broadcast.MemberIDFromString(g.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	return broadcast.ReconstructExclusion(memberID, broadcast.ExclusionReason(g.Reason), g.CreatedAt), nil
+}
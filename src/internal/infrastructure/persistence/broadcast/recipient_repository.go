@@ -0,0 +1,170 @@
+package broadcast
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// RecipientRepositoryImpl
+// ===========================
+
+// RecipientRepositoryImpl 收件者倉儲實現（GORM）
+//
+// 設計原則：
+// - 批次 Upsert（每次最多 MaxBatchSize 筆，避免超過 SQL 參數上限）
+// - 名單順序以 created_at + member_id 決定（同一快照的收件者建立時間相同）
+type RecipientRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewRecipientRepository 創建新的收件者倉儲實例
+func NewRecipientRepository(db *gorm.DB) broadcast.RecipientRepository {
+	return &RecipientRepositoryImpl{db: db}
+}
+
+// SaveAll 保存多位收件者（新增或更新）
+func (r *RecipientRepositoryImpl) SaveAll(ctx shared.TransactionContext, recipients []*broadcast.Recipient) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	gormModels := make([]RecipientGORM, 0, len(recipients))
+	for _, recipient := range recipients {
+		gormModels = append(gormModels, *toRecipientGORM(recipient))
+	}
+
+	return r.getDB(ctx).Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(gormModels); start += broadcast.MaxBatchSize {
+			end := min(start+broadcast.MaxBatchSize, len(gormModels))
+			chunk := gormModels[start:end]
+			if err := tx.Save(&chunk).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// FindInFlightBatch 查詢最早分配且尚未完成的批次
+func (r *RecipientRepositoryImpl) FindInFlightBatch(
+	ctx shared.TransactionContext,
+	campaignID broadcast.CampaignID,
+) ([]*broadcast.Recipient, error) {
+	db := r.getDB(ctx)
+
+	var batchKeys []string
+	result := db.Model(&RecipientGORM{}).
+		Where("campaign_id = ? AND status = ? AND batch_key <> ''",
+			campaignID.String(), broadcast.RecipientStatusPending.String()).
+		Order("updated_at ASC").
+		Order("batch_key ASC").
+		Limit(1).
+		Pluck("batch_key", &batchKeys)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(batchKeys) == 0 {
+		return []*broadcast.Recipient{}, nil
+	}
+
+	return r.find(db.Where("campaign_id = ? AND status = ? AND batch_key = ?",
+		campaignID.String(), broadcast.RecipientStatusPending.String(), batchKeys[0]))
+}
+
+// FindUnassigned 查詢尚未分批的 pending 收件者（依名單順序）
+func (r *RecipientRepositoryImpl) FindUnassigned(
+	ctx shared.TransactionContext,
+	campaignID broadcast.CampaignID,
+	limit int,
+) ([]*broadcast.Recipient, error) {
+	return r.find(r.getDB(ctx).
+		Where("campaign_id = ? AND status = ? AND batch_key = ''",
+			campaignID.String(), broadcast.RecipientStatusPending.String()).
+		Limit(limit))
+}
+
+// FindByCampaignID 查詢活動的收件者（status 為空字串時不限狀態）
+func (r *RecipientRepositoryImpl) FindByCampaignID(
+	ctx shared.TransactionContext,
+	campaignID broadcast.CampaignID,
+	status broadcast.RecipientStatus,
+) ([]*broadcast.Recipient, error) {
+	db := r.getDB(ctx).Where("campaign_id = ?", campaignID.String())
+	if status != "" {
+		db = db.Where("status = ?", status.String())
+	}
+	return r.find(db)
+}
+
+// Summarize 統計活動各狀態的收件者數
+func (r *RecipientRepositoryImpl) Summarize(
+	ctx shared.TransactionContext,
+	campaignID broadcast.CampaignID,
+) (broadcast.DeliverySummary, error) {
+	var rows []struct {
+		Status string
+		Count  int
+	}
+	result := r.getDB(ctx).Model(&RecipientGORM{}).
+		Select("status, COUNT(*) AS count").
+		Where("campaign_id = ?", campaignID.String()).
+		Group("status").
+		Scan(&rows)
+	if result.Error != nil {
+		return broadcast.DeliverySummary{}, result.Error
+	}
+
+	var summary broadcast.DeliverySummary
+	for _, row := range rows {
+		switch broadcast.RecipientStatus(row.Status) {
+		case broadcast.RecipientStatusPending:
+			summary.Pending = row.Count
+		case broadcast.RecipientStatusSent:
+			summary.Sent = row.Count
+		case broadcast.RecipientStatusFailed:
+			summary.Failed = row.Count
+		case broadcast.RecipientStatusExcluded:
+			summary.Excluded = row.Count
+		}
+	}
+	return summary, nil
+}
+
+// ===========================
+// Helper Methods
+// ===========================
+
+// find 依名單順序查詢並轉換為 Domain 模型
+func (r *RecipientRepositoryImpl) find(db *gorm.DB) ([]*broadcast.Recipient, error) {
+	var gormModels []RecipientGORM
+	result := db.Order("created_at ASC").Order("member_id ASC").Find(&gormModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	recipients := make([]*broadcast.Recipient, 0, len(gormModels))
+	for i := range gormModels {
+		recipient, err := gormModels[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients, nil
+}
+
+// getDB 獲取 GORM DB 實例
+//
+// 行為：
+//   - ctx != nil: 使用事務中的 DB（從 TransactionContext 獲取）
+//   - ctx == nil: 使用預設 DB（auto-commit 模式）
+func (r *RecipientRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
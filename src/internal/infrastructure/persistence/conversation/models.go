@@ -0,0 +1,64 @@
+package conversation
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/conversation"
+)
+
+// ===========================
+// GORM Models
+// ===========================
+
+// RegistrationSessionGORM 註冊會話資料表模型
+//
+// 資料庫約束：
+// - line_user_id: 主鍵（每位 LINE 用戶最多一個會話）
+// - expires_at: 索引（清理逾時會話）
+// - 會話結束時直接刪除（不使用軟刪除）
+type RegistrationSessionGORM struct {
+	LineUserID     string    `gorm:"column:line_user_id;type:varchar(33);primaryKey"`
+	State          string    `gorm:"column:state;type:varchar(30);not null"`
+	PhoneNumber    string    `gorm:"column:phone_number;type:varchar(10)"`
+	FailedAttempts int       `gorm:"column:failed_attempts;not null;default:0"`
+	ExpiresAt      time.Time `gorm:"column:expires_at;index;not null"`
+
+	// 審計欄位
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+}
+
+// TableName 指定資料表名稱
+func (RegistrationSessionGORM) TableName() string {
+	return "registration_sessions"
+}
+
+// ===========================
+// Mapper Functions
+// ===========================
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+func (g *RegistrationSessionGORM) toDomain() (*conversation.RegistrationSession, error) {
+	return conversation.ReconstructRegistrationSession(
+		g.LineUserID,
+		conversation.SessionState(g.State),
+		g.PhoneNumber,
+		g.FailedAttempts,
+		g.ExpiresAt,
+		g.CreatedAt,
+		g.UpdatedAt,
+	)
+}
+
+// toGORM 將 Domain 模型轉換為 GORM 模型
+func toGORM(s *conversation.RegistrationSession) *RegistrationSessionGORM {
+	return &RegistrationSessionGORM{
+		LineUserID:     s.LineUserID(),
+		State:          s.State().String(),
+		PhoneNumber:    s.PhoneNumber(),
+		FailedAttempts: s.FailedAttempts(),
+		ExpiresAt:      s.ExpiresAt(),
+		CreatedAt:      s.CreatedAt(),
+		UpdatedAt:      s.UpdatedAt(),
+	}
+}
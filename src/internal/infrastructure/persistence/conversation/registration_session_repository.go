@@ -0,0 +1,76 @@
+package conversation
+
+import (
+	"errors"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/conversation"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// gormTransactionContext GORM 事務上下文
+type gormTransactionContext interface {
+	shared.TransactionContext
+	GetDB() *gorm.DB
+}
+
+// ===========================
+// RegistrationSessionRepositoryImpl
+// ===========================
+
+// RegistrationSessionRepositoryImpl 註冊會話倉儲實現（GORM）
+type RegistrationSessionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewRegistrationSessionRepository 創建新的註冊會話倉儲實例
+func NewRegistrationSessionRepository(db *gorm.DB) conversation.RegistrationSessionRepository {
+	return &RegistrationSessionRepositoryImpl{db: db}
+}
+
+// Save 保存會話（主鍵存在時覆蓋）
+func (r *RegistrationSessionRepositoryImpl) Save(ctx shared.TransactionContext, s *conversation.RegistrationSession) error {
+	return r.getDB(ctx).Save(toGORM(s)).Error
+}
+
+// FindByLineUserID 查詢會話
+func (r *RegistrationSessionRepositoryImpl) FindByLineUserID(
+	ctx shared.TransactionContext,
+	lineUserID string,
+) (*conversation.RegistrationSession, error) {
+	var gormModel RegistrationSessionGORM
+	result := r.getDB(ctx).Where("line_user_id = ?", lineUserID).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, conversation.ErrSessionNotFound.WithContext(
+				"line_user_id", lineUserID,
+			)
+		}
+		return nil, result.Error
+	}
+
+	return gormModel.toDomain()
+}
+
+// Delete 刪除會話
+func (r *RegistrationSessionRepositoryImpl) Delete(ctx shared.TransactionContext, lineUserID string) error {
+	return r.getDB(ctx).Where("line_user_id = ?", lineUserID).Delete(&RegistrationSessionGORM{}).Error
+}
+
+// ===========================
+// Helper Methods
+// ===========================
+
+// getDB 獲取 GORM DB 實例
+//
+// 行為：
+//   - ctx != nil: 使用事務中的 DB（從 TransactionContext 獲取）
+//   - ctx == nil: 使用預設 DB（auto-commit 模式）
+func (r *RegistrationSessionRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
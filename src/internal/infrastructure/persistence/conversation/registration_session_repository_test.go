@@ -0,0 +1,73 @@
+package conversation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/conversation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// RegistrationSessionRepository Integration Tests
+// ===========================
+
+const testLineUserID = "U4af4980629a1b2c3d4e5f6a7b8c9d0e1"
+
+// setupTestDB 創建測試資料庫（in-memory SQLite）
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err, "failed to connect to test database")
+
+	err = db.AutoMigrate(&RegistrationSessionGORM{})
+	require.NoError(t, err, "failed to migrate database schema")
+
+	return db
+}
+
+// Test 1: Save, overwrite and reload session
+func TestRegistrationSessionRepository_SaveAndFind(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewRegistrationSessionRepository(db)
+	now := time.Date(2025, 1, 8, 7, 30, 0, 0, time.UTC)
+	session, err := conversation.StartRegistrationSession(testLineUserID, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(nil, session))
+
+	// Act
+	require.NoError(t, session.RecordInvalidPhoneAt(now.Add(time.Minute)))
+	require.NoError(t, session.AcceptPhoneNumberAt("0912345678", now.Add(2*time.Minute)))
+	require.NoError(t, repo.Save(nil, session))
+	found, err := repo.FindByLineUserID(nil, testLineUserID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, conversation.SessionStateAwaitingConfirmation, found.State())
+	assert.Equal(t, "0912345678", found.PhoneNumber())
+	assert.Equal(t, 1, found.FailedAttempts())
+	assert.True(t, session.ExpiresAt().Equal(found.ExpiresAt()))
+	assert.True(t, now.Equal(found.CreatedAt()))
+}
+
+// Test 2: Deleted session is not found
+func TestRegistrationSessionRepository_Delete(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewRegistrationSessionRepository(db)
+	session, err := conversation.StartRegistrationSession(testLineUserID, time.Now().UTC())
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(nil, session))
+
+	// Act
+	err = repo.Delete(nil, testLineUserID)
+
+	// Assert
+	require.NoError(t, err)
+	_, err = repo.FindByLineUserID(nil, testLineUserID)
+	assert.ErrorIs(t, err, conversation.ErrSessionNotFound)
+	assert.NoError(t, repo.Delete(nil, testLineUserID), "deleting a missing session is a no-op")
+}
@@ -0,0 +1,140 @@
+package external
+
+import (
+	"errors"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/external"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// gormTransactionContext GORM 事務上下文
+type gormTransactionContext interface {
+	shared.TransactionContext
+	GetDB() *gorm.DB
+}
+
+// ===========================
+// DiscrepancyReviewRepositoryImpl
+// ===========================
+
+// DiscrepancyReviewRepositoryImpl 差異審核案件倉儲實現（GORM）
+type DiscrepancyReviewRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewDiscrepancyReviewRepository 創建新的差異審核案件倉儲實例
+func NewDiscrepancyReviewRepository(db *gorm.DB) external.DiscrepancyReviewRepository {
+	return &DiscrepancyReviewRepositoryImpl{db: db}
+}
+
+// Save 保存新的審核案件
+func (r *DiscrepancyReviewRepositoryImpl) Save(ctx shared.TransactionContext, review *external.DiscrepancyReview) error {
+	return r.getDB(ctx).Create(toGORM(review)).Error
+}
+
+// Update 更新審核案件
+//
+// 錯誤處理：
+// - 案件不存在 → ErrReviewNotFound
+func (r *DiscrepancyReviewRepositoryImpl) Update(ctx shared.TransactionContext, review *external.DiscrepancyReview) error {
+	db := r.getDB(ctx)
+
+	gormModel := toGORM(review)
+
+	// 使用 Select("*") 確保零值字段也被更新
+	result := db.Model(&DiscrepancyReviewGORM{}).
+		Where("review_id = ?", gormModel.ReviewID).
+		Select("*").
+		Updates(gormModel)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return external.ErrReviewNotFound.WithContext(
+			"review_id", review.ReviewID().String(),
+			"reason", "review does not exist (Update requires existing record)",
+		)
+	}
+
+	return nil
+}
+
+// FindByID 根據案件 ID 查找
+func (r *DiscrepancyReviewRepositoryImpl) FindByID(ctx shared.TransactionContext, id external.ReviewID) (*external.DiscrepancyReview, error) {
+	var gormModel DiscrepancyReviewGORM
+	result := r.getDB(ctx).Where("review_id = ?", id.String()).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, external.ErrReviewNotFound.WithContext(
+				"review_id", id.String(),
+			)
+		}
+		return nil, result.Error
+	}
+
+	return gormModel.toDomain()
+}
+
+// FindPendingByTransactionID 查找交易的待處理案件
+func (r *DiscrepancyReviewRepositoryImpl) FindPendingByTransactionID(ctx shared.TransactionContext, transactionID external.TransactionID) (*external.DiscrepancyReview, error) {
+	var gormModel DiscrepancyReviewGORM
+	result := r.getDB(ctx).
+		Where("transaction_id = ? AND status = ?", transactionID.String(), external.ReviewStatusPending.String()).
+		First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, external.ErrReviewNotFound.WithContext(
+				"transaction_id", transactionID.String(),
+			)
+		}
+		return nil, result.Error
+	}
+
+	return gormModel.toDomain()
+}
+
+// FindPending 分頁查詢待處理案件（依建立時間升冪）
+func (r *DiscrepancyReviewRepositoryImpl) FindPending(ctx shared.TransactionContext, limit, offset int) ([]*external.DiscrepancyReview, error) {
+	var gormModels []DiscrepancyReviewGORM
+	result := r.getDB(ctx).
+		Where("status = ?", external.ReviewStatusPending.String()).
+		Order("created_at ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&gormModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	reviews := make([]*external.DiscrepancyReview, 0, len(gormModels))
+	for i := range gormModels {
+		review, err := gormModels[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+
+	return reviews, nil
+}
+
+// ===========================
+// Helper Methods
+// ===========================
+
+// getDB 獲取 GORM DB 實例
+//
+// 行為：
+//   - ctx != nil: 使用事務中的 DB（從 TransactionContext 獲取）
+//   - ctx == nil: 使用預設 DB（auto-commit 模式）
+func (r *DiscrepancyReviewRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
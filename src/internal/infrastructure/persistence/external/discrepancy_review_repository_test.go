@@ -0,0 +1,121 @@
+package external
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/external"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// DiscrepancyReviewRepository Integration Tests
+// ===========================
+
+// setupTestDB 創建測試資料庫（in-memory SQLite）
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err, "failed to connect to test database")
+
+	err = db.AutoMigrate(&DiscrepancyReviewGORM{})
+	require.NoError(t, err, "failed to migrate database schema")
+
+	return db
+}
+
+type testInvoice struct {
+	amount int
+	date   time.Time
+}
+
+func (i testInvoice) GetAmount() int            { return i.amount }
+func (i testInvoice) GetInvoiceDate() time.Time { return i.date }
+
+// createTestReview 創建測試用審核案件
+func createTestReview(t *testing.T) *external.DiscrepancyReview {
+	day := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	pos := testInvoice{amount: 1020, date: day}
+	scanned := testInvoice{amount: 1000, date: day}
+	result := external.NewInvoiceMatchingService(external.DefaultMatchingPolicy()).Match(pos, scanned)
+
+	review, err := external.OpenDiscrepancyReview(
+		shared.NewEntityID[external.TransactionMarker](),
+		"AB12345678",
+		pos,
+		scanned,
+		result,
+	)
+	require.NoError(t, err)
+	return review
+}
+
+// Test 1: Save and find pending by transaction
+func TestDiscrepancyReviewRepository_Save_FindPendingByTransactionID_Success(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewDiscrepancyReviewRepository(db)
+	review := createTestReview(t)
+
+	// Act
+	require.NoError(t, repo.Save(nil, review))
+	found, err := repo.FindPendingByTransactionID(nil, review.TransactionID())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, review.ReviewID(), found.ReviewID())
+	assert.Equal(t, 1020, found.PosAmount())
+	assert.Equal(t, 20, found.AmountDelta())
+	assert.True(t, found.IsPending())
+}
+
+// Test 2: Resolved review persists resolver and leaves the queue
+func TestDiscrepancyReviewRepository_Update_PersistsResolution(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewDiscrepancyReviewRepository(db)
+	review := createTestReview(t)
+	other := createTestReview(t)
+	require.NoError(t, repo.Save(nil, review))
+	require.NoError(t, repo.Save(nil, other))
+	require.NoError(t, review.Reject("manager01", "非本店發票"))
+
+	// Act
+	err := repo.Update(nil, review)
+
+	// Assert
+	require.NoError(t, err)
+	found, err := repo.FindByID(nil, review.ReviewID())
+	require.NoError(t, err)
+	assert.Equal(t, external.ReviewStatusRejected, found.Status())
+	assert.Equal(t, "manager01", found.ResolvedBy())
+	assert.Equal(t, "非本店發票", found.ResolutionNote())
+	assert.NotNil(t, found.ResolvedAt())
+
+	_, err = repo.FindPendingByTransactionID(nil, review.TransactionID())
+	assert.ErrorIs(t, err, external.ErrReviewNotFound)
+
+	pending, err := repo.FindPending(nil, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, other.ReviewID(), pending[0].ReviewID())
+}
+
+// Test 3: Not found
+func TestDiscrepancyReviewRepository_NotFound_ReturnsError(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewDiscrepancyReviewRepository(db)
+	review := createTestReview(t)
+
+	// Act
+	_, findErr := repo.FindByID(nil, review.ReviewID())
+	updateErr := repo.Update(nil, review)
+
+	// Assert
+	assert.ErrorIs(t, findErr, external.ErrReviewNotFound)
+	assert.ErrorIs(t, updateErr, external.ErrReviewNotFound)
+}
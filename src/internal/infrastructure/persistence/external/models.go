@@ -0,0 +1,110 @@
+package external
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/external"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// GORM Models
+// ===========================
+
+// DiscrepancyReviewGORM 差異審核案件資料表模型
+//
+// 資料庫約束：
+// - review_id: 主鍵（UUID）
+// - transaction_id + status: 複合索引（查詢交易的待處理案件）
+// - status + created_at: 複合索引（審核佇列先進先審）
+type DiscrepancyReviewGORM struct {
+	// 識別欄位
+	ReviewID      string `gorm:"column:review_id;type:varchar(36);primaryKey"` // UUID 字串
+	TransactionID string `gorm:"column:transaction_id;type:varchar(36);index:idx_review_tx_status,priority:1;not null"`
+	InvoiceNumber string `gorm:"column:invoice_number;type:varchar(10);not null"`
+
+	// 資料快照
+	PosAmount      int       `gorm:"column:pos_amount;not null"`
+	PosInvoiceDate time.Time `gorm:"column:pos_invoice_date;not null"`
+	ScannedAmount  int       `gorm:"column:scanned_amount;not null"`
+	ScannedDate    time.Time `gorm:"column:scanned_date;not null"`
+	AmountDelta    int       `gorm:"column:amount_delta;not null"`
+	DateOffsetDays int       `gorm:"column:date_offset_days;not null"`
+
+	// 審核結果
+	Status         string     `gorm:"column:status;type:varchar(20);index:idx_review_tx_status,priority:2;index:idx_review_status_created,priority:1;not null"`
+	ResolvedBy     string     `gorm:"column:resolved_by;type:varchar(100)"`
+	ResolutionNote string     `gorm:"column:resolution_note;type:text"`
+	ResolvedAt     *time.Time `gorm:"column:resolved_at"` // Nullable
+
+	// 審計欄位
+	CreatedAt time.Time      `gorm:"column:created_at;index:idx_review_status_created,priority:2;not null"`
+	UpdatedAt time.Time      `gorm:"column:updated_at;not null"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"` // 軟刪除
+}
+
+// TableName 指定資料表名稱
+func (DiscrepancyReviewGORM) TableName() string {
+	return "discrepancy_reviews"
+}
+
+// ===========================
+// Mapper Functions
+// ===========================
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+func (g *DiscrepancyReviewGORM) toDomain() (*external.DiscrepancyReview, error) {
+	reviewID, err := external.ReviewIDFromString(g.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID, err := external.TransactionIDFromString(g.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := external.ParseReviewStatus(g.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return external.ReconstructDiscrepancyReview(
+		reviewID,
+		transactionID,
+		g.InvoiceNumber,
+		g.PosAmount,
+		g.PosInvoiceDate,
+		g.ScannedAmount,
+		g.ScannedDate,
+		g.AmountDelta,
+		g.DateOffsetDays,
+		status,
+		g.ResolvedBy,
+		g.ResolutionNote,
+		g.ResolvedAt,
+		g.CreatedAt,
+		g.UpdatedAt,
+	)
+}
+
+// toGORM 將 Domain 模型轉換為 GORM 模型
+func toGORM(review *external.DiscrepancyReview) *DiscrepancyReviewGORM {
+	return &DiscrepancyReviewGORM{
+		ReviewID:       review.ReviewID().String(),
+		TransactionID:  review.TransactionID().String(),
+		InvoiceNumber:  review.InvoiceNumber(),
+		PosAmount:      review.PosAmount(),
+		PosInvoiceDate: review.PosInvoiceDate(),
+		ScannedAmount:  review.ScannedAmount(),
+		ScannedDate:    review.ScannedDate(),
+		AmountDelta:    review.AmountDelta(),
+		DateOffsetDays: review.DateOffsetDays(),
+		Status:         review.Status().String(),
+		ResolvedBy:     review.ResolvedBy(),
+		ResolutionNote: review.ResolutionNote(),
+		ResolvedAt:     review.ResolvedAt(),
+		CreatedAt:      review.CreatedAt(),
+		UpdatedAt:      review.UpdatedAt(),
+	}
+}
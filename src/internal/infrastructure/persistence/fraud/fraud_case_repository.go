@@ -0,0 +1,122 @@
+package fraud
+
+import (
+	"errors"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/fraud"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// gormTransactionContext GORM 事務上下文
+type gormTransactionContext interface {
+	shared.TransactionContext
+	GetDB() *gorm.DB
+}
+
+// ===========================
+// FraudCaseRepositoryImpl
+// ===========================
+
+// FraudCaseRepositoryImpl 調查案件倉儲實現（GORM）
+type FraudCaseRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewFraudCaseRepository 創建新的調查案件倉儲實例
+func NewFraudCaseRepository(db *gorm.DB) fraud.FraudCaseRepository {
+	return &FraudCaseRepositoryImpl{db: db}
+}
+
+// Save 保存新的調查案件
+func (r *FraudCaseRepositoryImpl) Save(ctx shared.TransactionContext, c *fraud.FraudCase) error {
+	return r.getDB(ctx).Create(toGORM(c)).Error
+}
+
+// Update 更新調查案件
+//
+// 錯誤處理：
+// - 案件不存在 → ErrFraudCaseNotFound
+func (r *FraudCaseRepositoryImpl) Update(ctx shared.TransactionContext, c *fraud.FraudCase) error {
+	db := r.getDB(ctx)
+
+	gormModel := toGORM(c)
+
+	// 使用 Select("*") 確保零值字段也被更新
+	result := db.Model(&FraudCaseGORM{}).
+		Where("case_id = ?", gormModel.CaseID).
+		Select("*").
+		Updates(gormModel)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return fraud.ErrFraudCaseNotFound.WithContext(
+			"case_id", c.CaseID().String(),
+			"reason", "case does not exist (Update requires existing record)",
+		)
+	}
+
+	return nil
+}
+
+// FindByID 根據案件 ID 查找
+func (r *FraudCaseRepositoryImpl) FindByID(ctx shared.TransactionContext, id fraud.CaseID) (*fraud.FraudCase, error) {
+	var gormModel FraudCaseGORM
+	result := r.getDB(ctx).Where("case_id = ?", id.String()).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fraud.ErrFraudCaseNotFound.WithContext(
+				"case_id", id.String(),
+			)
+		}
+		return nil, result.Error
+	}
+
+	return gormModel.toDomain()
+}
+
+// FindOpen 分頁查詢調查中的案件（依建立時間升冪）
+func (r *FraudCaseRepositoryImpl) FindOpen(ctx shared.TransactionContext, limit, offset int) ([]*fraud.FraudCase, error) {
+	var gormModels []FraudCaseGORM
+	result := r.getDB(ctx).
+		Where("status = ?", fraud.CaseStatusOpen.String()).
+		Order("created_at ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&gormModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	cases := make([]*fraud.FraudCase, 0, len(gormModels))
+	for i := range gormModels {
+		c, err := gormModels[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		cases = append(cases, c)
+	}
+
+	return cases, nil
+}
+
+// ===========================
+// Helper Methods
+// ===========================
+
+// getDB 獲取 GORM DB 實例
+//
+// 行為：
+//   - ctx != nil: 使用事務中的 DB（從 TransactionContext 獲取）
+//   - ctx == nil: 使用預設 DB（auto-commit 模式）
+func (r *FraudCaseRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
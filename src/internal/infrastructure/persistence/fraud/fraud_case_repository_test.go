@@ -0,0 +1,109 @@
+package fraud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/fraud"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// FraudCaseRepository Integration Tests
+// ===========================
+
+// setupTestDB 創建測試資料庫（in-memory SQLite）
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err, "failed to connect to test database")
+
+	err = db.AutoMigrate(&FraudCaseGORM{})
+	require.NoError(t, err, "failed to migrate database schema")
+
+	return db
+}
+
+// createTestCase 創建測試用調查案件（高金額 + 掃描頻率）
+func createTestCase(t *testing.T) *fraud.FraudCase {
+	now := time.Now()
+	assessment := fraud.NewFraudDetectionService(fraud.DefaultFraudRules()).Assess(fraud.ScanActivity{
+		Amount:                  50000,
+		ScannedAt:               now,
+		MemberRegisteredAt:      now.AddDate(-1, 0, 0),
+		RecentScanCount:         8,
+		ClaimsSinceRegistration: 20,
+	})
+
+	c, err := fraud.OpenFraudCase(
+		shared.NewEntityID[fraud.MemberMarker](),
+		shared.NewEntityID[fraud.TransactionMarker](),
+		"AB12345678",
+		assessment,
+	)
+	require.NoError(t, err)
+	return c
+}
+
+// Test 1: Save and find by ID
+func TestFraudCaseRepository_Save_FindByID_Success(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewFraudCaseRepository(db)
+	c := createTestCase(t)
+
+	// Act
+	require.NoError(t, repo.Save(nil, c))
+	found, err := repo.FindByID(nil, c.CaseID())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, c.MemberID(), found.MemberID())
+	assert.Equal(t, c.TransactionID(), found.TransactionID())
+	assert.Equal(t, []fraud.RiskSignal{fraud.SignalScanVelocity, fraud.SignalHighAmount}, found.Signals())
+	assert.Equal(t, fraud.DecisionHold, found.Decision())
+	assert.True(t, found.IsOpen())
+}
+
+// Test 2: Resolved case persists resolver and leaves the open queue
+func TestFraudCaseRepository_Update_PersistsResolution(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewFraudCaseRepository(db)
+	c := createTestCase(t)
+	require.NoError(t, repo.Save(nil, c))
+	require.NoError(t, c.Confirm("admin-1", "confirmed by CCTV"))
+
+	// Act
+	require.NoError(t, repo.Update(nil, c))
+	found, err := repo.FindByID(nil, c.CaseID())
+	require.NoError(t, err)
+	open, err := repo.FindOpen(nil, 10, 0)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, fraud.CaseStatusConfirmed, found.Status())
+	assert.Equal(t, "admin-1", found.ResolvedBy())
+	assert.Equal(t, "confirmed by CCTV", found.ResolutionNote())
+	assert.NotNil(t, found.ResolvedAt())
+	assert.Empty(t, open)
+}
+
+// Test 3: Not found errors
+func TestFraudCaseRepository_NotFound(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewFraudCaseRepository(db)
+	c := createTestCase(t)
+
+	// Act
+	_, findErr := repo.FindByID(nil, fraud.NewCaseID())
+	updateErr := repo.Update(nil, c)
+
+	// Assert
+	assert.ErrorIs(t, findErr, fraud.ErrFraudCaseNotFound)
+	assert.ErrorIs(t, updateErr, fraud.ErrFraudCaseNotFound)
+}
@@ -0,0 +1,125 @@
+package fraud
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/fraud"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// GORM Models
+// ===========================
+
+// FraudCaseGORM 詐騙調查案件資料表模型
+//
+// 資料庫約束：
+// - case_id: 主鍵（UUID）
+// - member_id / transaction_id: 查詢索引
+// - status + created_at: 複合索引（調查佇列）
+// - signals: 以逗號分隔的風險訊號（規則數量少，不另建關聯表）
+type FraudCaseGORM struct {
+	// 識別欄位
+	CaseID        string `gorm:"column:case_id;type:varchar(36);primaryKey"` // UUID 字串
+	MemberID      string `gorm:"column:member_id;type:varchar(36);index;not null"`
+	TransactionID string `gorm:"column:transaction_id;type:varchar(36);index;not null"`
+	InvoiceNumber string `gorm:"column:invoice_number;type:varchar(10);not null"`
+
+	// 評估結果
+	Signals  string `gorm:"column:signals;type:varchar(255);not null"`
+	Decision string `gorm:"column:decision;type:varchar(20);not null"`
+
+	// 調查結果
+	Status         string     `gorm:"column:status;type:varchar(20);index:idx_fraud_case_status_created,priority:1;not null"`
+	ResolvedBy     string     `gorm:"column:resolved_by;type:varchar(100)"`
+	ResolutionNote string     `gorm:"column:resolution_note;type:text"`
+	ResolvedAt     *time.Time `gorm:"column:resolved_at"` // Nullable
+
+	// 審計欄位
+	CreatedAt time.Time      `gorm:"column:created_at;index:idx_fraud_case_status_created,priority:2;not null"`
+	UpdatedAt time.Time      `gorm:"column:updated_at;not null"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"` // 軟刪除
+}
+
+// TableName 指定資料表名稱
+func (FraudCaseGORM) TableName() string {
+	return "fraud_cases"
+}
+
+// ===========================
+// Mapper Functions
+// ===========================
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+func (g *FraudCaseGORM) toDomain() (*fraud.FraudCase, error) {
+	caseID, err := fraud.CaseIDFromString(g.CaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := fraud.MemberIDFromString(g.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID, err := fraud.TransactionIDFromString(g.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	decision, err := fraud.ParseDecision(g.Decision)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := fraud.ParseCaseStatus(g.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	signals := make([]fraud.RiskSignal, 0)
+	for _, s := range strings.Split(g.Signals, ",") {
+		if s != "" {
+			signals = append(signals, fraud.RiskSignal(s))
+		}
+	}
+
+	return fraud.ReconstructFraudCase(
+		caseID,
+		memberID,
+		transactionID,
+		g.InvoiceNumber,
+		signals,
+		decision,
+		status,
+		g.ResolvedBy,
+		g.ResolutionNote,
+		g.ResolvedAt,
+		g.CreatedAt,
+		g.UpdatedAt,
+	)
+}
+
+// toGORM 將 Domain 模型轉換為 GORM 模型
+func toGORM(c *fraud.FraudCase) *FraudCaseGORM {
+	signals := make([]string, 0, len(c.Signals()))
+	for _, s := range c.Signals() {
+		signals = append(signals, s.String())
+	}
+
+	return &FraudCaseGORM{
+		CaseID:         c.CaseID().String(),
+		MemberID:       c.MemberID().String(),
+		TransactionID:  c.TransactionID().String(),
+		InvoiceNumber:  c.InvoiceNumber(),
+		Signals:        strings.Join(signals, ","),
+		Decision:       c.Decision().String(),
+		Status:         c.Status().String(),
+		ResolvedBy:     c.ResolvedBy(),
+		ResolutionNote: c.ResolutionNote(),
+		ResolvedAt:     c.ResolvedAt(),
+		CreatedAt:      c.CreatedAt(),
+		UpdatedAt:      c.UpdatedAt(),
+	}
+}
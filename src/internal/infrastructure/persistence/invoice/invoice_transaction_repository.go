@@ -0,0 +1,146 @@
+package invoice
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// gormTransactionContext GORM 事務上下文
+type gormTransactionContext interface {
+	shared.TransactionContext
+	GetDB() *gorm.DB
+}
+
+// ===========================
+// InvoiceTransactionRepositoryImpl
+// ===========================
+
+// InvoiceTransactionRepositoryImpl 發票交易倉儲實現（GORM）
+//
+// 設計原則：
+// - 實作 invoice.InvoiceTransactionRepository 接口
+// - 將 GORM 錯誤轉換為 Domain 錯誤
+type InvoiceTransactionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewInvoiceTransactionRepository 創建新的發票交易倉儲實例
+func NewInvoiceTransactionRepository(db *gorm.DB) invoice.InvoiceTransactionRepository {
+	return &InvoiceTransactionRepositoryImpl{db: db}
+}
+
+// Save 保存新的發票交易
+//
+// 錯誤處理：
+// - UNIQUE constraint 違反（invoice_number 重複）→ ErrDuplicateInvoice
+func (r *InvoiceTransactionRepositoryImpl) Save(ctx shared.TransactionContext, tx *invoice.InvoiceTransaction) error {
+	db := r.getDB(ctx)
+
+	result := db.Create(toGORM(tx))
+	if result.Error != nil {
+		if isUniqueConstraintError(result.Error) {
+			return invoice.ErrDuplicateInvoice.WithContext(
+				"invoice_number", tx.InvoiceNumber().String(),
+			)
+		}
+		return result.Error
+	}
+
+	return nil
+}
+
+// Update 更新發票交易
+//
+// 錯誤處理：
+// - 交易不存在 → ErrTransactionNotFound
+func (r *InvoiceTransactionRepositoryImpl) Update(ctx shared.TransactionContext, tx *invoice.InvoiceTransaction) error {
+	db := r.getDB(ctx)
+
+	gormModel := toGORM(tx)
+
+	// 使用 Select("*") 確保零值字段也被更新
+	result := db.Model(&InvoiceTransactionGORM{}).
+		Where("transaction_id = ?", gormModel.TransactionID).
+		Select("*").
+		Updates(gormModel)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return invoice.ErrTransactionNotFound.WithContext(
+			"transaction_id", tx.TransactionID().String(),
+			"reason", "transaction does not exist (Update requires existing record)",
+		)
+	}
+
+	return nil
+}
+
+// FindByID 根據交易 ID 查找
+func (r *InvoiceTransactionRepositoryImpl) FindByID(ctx shared.TransactionContext, id invoice.TransactionID) (*invoice.InvoiceTransaction, error) {
+	return r.findOne(ctx, "transaction_id = ?", id.String())
+}
+
+// FindByInvoiceNumber 根據發票號碼查找
+func (r *InvoiceTransactionRepositoryImpl) FindByInvoiceNumber(ctx shared.TransactionContext, invoiceNumber invoice.InvoiceNumber) (*invoice.InvoiceTransaction, error) {
+	return r.findOne(ctx, "invoice_number = ?", invoiceNumber.String())
+}
+
+// ===========================
+// Helper Methods
+// ===========================
+
+// findOne 依條件查詢單筆交易
+//
+// 錯誤處理：
+// - gorm.ErrRecordNotFound → invoice.ErrTransactionNotFound
+func (r *InvoiceTransactionRepositoryImpl) findOne(ctx shared.TransactionContext, query string, arg string) (*invoice.InvoiceTransaction, error) {
+	db := r.getDB(ctx)
+
+	var gormModel InvoiceTransactionGORM
+	result := db.Where(query, arg).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, invoice.ErrTransactionNotFound.WithContext(
+				"query", query,
+				"value", arg,
+			)
+		}
+		return nil, result.Error
+	}
+
+	return gormModel.toDomain()
+}
+
+// getDB 獲取 GORM DB 實例
+//
+// 行為：
+//   - ctx != nil: 使用事務中的 DB（從 TransactionContext 獲取）
+//   - ctx == nil: 使用預設 DB（auto-commit 模式）
+func (r *InvoiceTransactionRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
+
+// isUniqueConstraintError 判斷是否為唯一約束錯誤（PostgreSQL / SQLite / MySQL）
+func isUniqueConstraintError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	errMsg := strings.ToLower(err.Error())
+
+	return strings.Contains(errMsg, "duplicate key value violates unique constraint") ||
+		strings.Contains(errMsg, "unique constraint failed") ||
+		strings.Contains(errMsg, "duplicate entry")
+}
@@ -0,0 +1,117 @@
+package invoice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// InvoiceTransactionRepository Integration Tests
+// ===========================
+
+// setupTestDB 創建測試資料庫（in-memory SQLite）
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err, "failed to connect to test database")
+
+	err = db.AutoMigrate(&InvoiceTransactionGORM{})
+	require.NoError(t, err, "failed to migrate database schema")
+
+	return db
+}
+
+// createTestTransaction 創建測試用發票交易
+func createTestTransaction(t *testing.T, number string) *invoice.InvoiceTransaction {
+	invoiceNumber, err := invoice.NewInvoiceNumber(number)
+	require.NoError(t, err)
+	amount, err := invoice.NewMoney(1500)
+	require.NoError(t, err)
+
+	tx, err := invoice.NewInvoiceTransaction(
+		invoice.NewMemberID(),
+		invoiceNumber,
+		time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC),
+		amount,
+	)
+	require.NoError(t, err)
+	return tx
+}
+
+// Test 1: Save and find by invoice number
+func TestInvoiceTransactionRepository_Save_FindByInvoiceNumber_Success(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewInvoiceTransactionRepository(db)
+	tx := createTestTransaction(t, "AB12345678")
+
+	// Act
+	require.NoError(t, repo.Save(nil, tx))
+	found, err := repo.FindByInvoiceNumber(nil, tx.InvoiceNumber())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, tx.TransactionID(), found.TransactionID())
+	assert.Equal(t, tx.MemberID(), found.MemberID())
+	assert.Equal(t, 1500, found.GetAmount())
+	assert.Equal(t, invoice.TransactionStatusImported, found.Status())
+	assert.Nil(t, found.VerifiedAt())
+}
+
+// Test 2: Duplicate invoice number
+func TestInvoiceTransactionRepository_Save_DuplicateInvoice_ReturnsError(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewInvoiceTransactionRepository(db)
+	require.NoError(t, repo.Save(nil, createTestTransaction(t, "AB12345678")))
+
+	// Act
+	err := repo.Save(nil, createTestTransaction(t, "ab-12345678"))
+
+	// Assert
+	assert.ErrorIs(t, err, invoice.ErrDuplicateInvoice)
+}
+
+// Test 3: Update persists status change
+func TestInvoiceTransactionRepository_Update_PersistsVerification(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewInvoiceTransactionRepository(db)
+	tx := createTestTransaction(t, "AB12345678")
+	require.NoError(t, repo.Save(nil, tx))
+	posAmount, _ := invoice.NewMoney(1480)
+	require.NoError(t, tx.VerifyWithPOSData(posAmount, tx.InvoiceDate(), "discrepancy_approved"))
+
+	// Act
+	err := repo.Update(nil, tx)
+
+	// Assert
+	require.NoError(t, err)
+	found, err := repo.FindByID(nil, tx.TransactionID())
+	require.NoError(t, err)
+	assert.True(t, found.IsVerified())
+	assert.NotNil(t, found.VerifiedAt())
+	assert.Equal(t, 1480, found.GetAmount())
+	assert.Equal(t, "discrepancy_approved", found.StatusReason())
+}
+
+// Test 4: Not found
+func TestInvoiceTransactionRepository_NotFound_ReturnsError(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewInvoiceTransactionRepository(db)
+	tx := createTestTransaction(t, "AB12345678")
+
+	// Act
+	_, findErr := repo.FindByID(nil, tx.TransactionID())
+	updateErr := repo.Update(nil, tx)
+
+	// Assert
+	assert.ErrorIs(t, findErr, invoice.ErrTransactionNotFound)
+	assert.ErrorIs(t, updateErr, invoice.ErrTransactionNotFound)
+}
@@ -0,0 +1,50 @@
+package invoice
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// TransactionReassignerImpl
+// ===========================
+
+// TransactionReassignerImpl 發票交易改歸屬實現（會員合併）
+//
+// 設計原則：
+// - 實作 member.MemberHistoryReassigner 接口
+// - 包含已軟刪除的交易（完整保留歷史）
+// - 使用 UpdateColumn：不變更 updated_at（保留原交易時間）
+type TransactionReassignerImpl struct {
+	db *gorm.DB
+}
+
+// NewTransactionReassigner 創建發票交易改歸屬實例
+func NewTransactionReassigner(db *gorm.DB) member.MemberHistoryReassigner {
+	return &TransactionReassignerImpl{db: db}
+}
+
+// ReassignMember 將 from 會員的所有發票交易改歸屬 to 會員
+func (r *TransactionReassignerImpl) ReassignMember(
+	ctx shared.TransactionContext,
+	from, to member.MemberID,
+) (int, error) {
+	result := r.getDB(ctx).Unscoped().Model(&InvoiceTransactionGORM{}).
+		Where("member_id = ?", from.String()).
+		UpdateColumn("member_id", to.String())
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(result.RowsAffected), nil
+}
+
+// getDB 獲取 GORM DB 實例（ctx 可為 nil）
+func (r *TransactionReassignerImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
@@ -0,0 +1,105 @@
+package invoice
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// GORM Models
+// ===========================
+
+// InvoiceTransactionGORM 發票交易資料表模型
+//
+// 資料庫約束：
+// - transaction_id: 主鍵（UUID）
+// - invoice_number: 唯一索引（一張發票只能登錄一次，BR-002-02）
+// - member_id / status / invoice_date: 查詢索引
+type InvoiceTransactionGORM struct {
+	// 識別欄位
+	TransactionID string `gorm:"column:transaction_id;type:varchar(36);primaryKey"` // UUID 字串
+	MemberID      string `gorm:"column:member_id;type:varchar(36);index;not null"`
+
+	// 發票資料
+	InvoiceNumber string    `gorm:"column:invoice_number;type:varchar(10);uniqueIndex;not null"`
+	InvoiceDate   time.Time `gorm:"column:invoice_date;index;not null"`
+	Amount        int       `gorm:"column:amount;not null;check:amount >= 0"`
+
+	// 狀態
+	Status       string     `gorm:"column:status;type:varchar(20);index;not null"`
+	StatusReason string     `gorm:"column:status_reason;type:varchar(255)"`
+	VerifiedAt   *time.Time `gorm:"column:verified_at"` // Nullable
+
+	// 審計欄位
+	CreatedAt time.Time      `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time      `gorm:"column:updated_at;not null"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"` // 軟刪除
+}
+
+// TableName 指定資料表名稱
+func (InvoiceTransactionGORM) TableName() string {
+	return "invoice_transactions"
+}
+
+// ===========================
+// Mapper Functions
+// ===========================
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+func (g *InvoiceTransactionGORM) toDomain() (*invoice.InvoiceTransaction, error) {
+	transactionID, err := invoice.TransactionIDFromString(g.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := invoice.MemberIDFromString(g.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceNumber, err := invoice.NewInvoiceNumber(g.InvoiceNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := invoice.NewMoney(g.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := invoice.ParseTransactionStatus(g.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return invoice.ReconstructInvoiceTransaction(
+		transactionID,
+		memberID,
+		invoiceNumber,
+		g.InvoiceDate,
+		amount,
+		status,
+		g.StatusReason,
+		g.VerifiedAt,
+		g.CreatedAt,
+		g.UpdatedAt,
+	)
+}
+
+// toGORM 將 Domain 模型轉換為 GORM 模型
+func toGORM(tx *invoice.InvoiceTransaction) *InvoiceTransactionGORM {
+	return &InvoiceTransactionGORM{
+		TransactionID: tx.TransactionID().String(),
+		MemberID:      tx.MemberID().String(),
+		InvoiceNumber: tx.InvoiceNumber().String(),
+		InvoiceDate:   tx.InvoiceDate(),
+		Amount:        tx.Amount().Amount(),
+		Status:        tx.Status().String(),
+		StatusReason:  tx.StatusReason(),
+		VerifiedAt:    tx.VerifiedAt(),
+		CreatedAt:     tx.CreatedAt(),
+		UpdatedAt:     tx.UpdatedAt(),
+	}
+}
@@ -0,0 +1,74 @@
+package invoice
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/fraud"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// ScanActivityQueryImpl
+// ===========================
+
+// ScanActivityQueryImpl 掃描活動統計查詢實現（GORM）
+//
+// 設計原則：
+// - 實作 fraud.ScanActivityQuery 接口
+// - 直接查詢 invoice_transactions（掃描時間 = created_at）
+// - 統計包含所有狀態（失敗的掃描也算一次嘗試）
+type ScanActivityQueryImpl struct {
+	db *gorm.DB
+}
+
+// NewScanActivityQuery 創建掃描活動統計查詢實例
+func NewScanActivityQuery(db *gorm.DB) fraud.ScanActivityQuery {
+	return &ScanActivityQueryImpl{db: db}
+}
+
+// CountScansSince 統計會員自 since 起的掃描數
+func (q *ScanActivityQueryImpl) CountScansSince(
+	ctx shared.TransactionContext,
+	memberID fraud.MemberID,
+	since time.Time,
+) (int, error) {
+	var count int64
+	result := q.getDB(ctx).Model(&InvoiceTransactionGORM{}).
+		Where("member_id = ? AND created_at >= ?", memberID.String(), since).
+		Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(count), nil
+}
+
+// CountDistinctMembersInRange 統計發票號碼範圍內其他會員的人數
+func (q *ScanActivityQueryImpl) CountDistinctMembersInRange(
+	ctx shared.TransactionContext,
+	from, to string,
+	excludeMemberID fraud.MemberID,
+	since time.Time,
+) (int, error) {
+	var count int64
+	result := q.getDB(ctx).Model(&InvoiceTransactionGORM{}).
+		Where("invoice_number BETWEEN ? AND ?", from, to).
+		Where("member_id <> ?", excludeMemberID.String()).
+		Where("created_at >= ?", since).
+		Distinct("member_id").
+		Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(count), nil
+}
+
+// getDB 獲取 GORM DB 實例（ctx 可為 nil）
+func (q *ScanActivityQueryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return q.db
+}
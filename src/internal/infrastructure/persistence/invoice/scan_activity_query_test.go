@@ -0,0 +1,77 @@
+package invoice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/fraud"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// ScanActivityQuery Integration Tests
+// ===========================
+
+// saveScan 以指定會員保存一筆掃描
+func saveScan(t *testing.T, repo invoice.InvoiceTransactionRepository, memberID invoice.MemberID, number string) {
+	invoiceNumber, err := invoice.NewInvoiceNumber(number)
+	require.NoError(t, err)
+	amount, err := invoice.NewMoney(500)
+	require.NoError(t, err)
+	tx, err := invoice.NewInvoiceTransaction(memberID, invoiceNumber, time.Now(), amount)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(nil, tx))
+}
+
+// Test 1: Count scans by member since a point in time
+func TestScanActivityQuery_CountScansSince(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewInvoiceTransactionRepository(db)
+	query := NewScanActivityQuery(db)
+	memberID := invoice.NewMemberID()
+	saveScan(t, repo, memberID, "AB00000001")
+	saveScan(t, repo, memberID, "AB00000002")
+	saveScan(t, repo, invoice.NewMemberID(), "AB00000003")
+	fraudMemberID, err := fraud.MemberIDFromString(memberID.String())
+	require.NoError(t, err)
+
+	// Act
+	recent, err := query.CountScansSince(nil, fraudMemberID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	future, err := query.CountScansSince(nil, fraudMemberID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 2, recent)
+	assert.Equal(t, 0, future)
+}
+
+// Test 2: Count distinct other members claiming neighbouring invoice numbers
+func TestScanActivityQuery_CountDistinctMembersInRange(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewInvoiceTransactionRepository(db)
+	query := NewScanActivityQuery(db)
+	self := invoice.NewMemberID()
+	other := invoice.NewMemberID()
+	saveScan(t, repo, self, "AB12345678")
+	saveScan(t, repo, other, "AB12345676")
+	saveScan(t, repo, other, "AB12345680")
+	saveScan(t, repo, invoice.NewMemberID(), "AB12345683")
+	saveScan(t, repo, invoice.NewMemberID(), "AB12345690") // 範圍外
+	saveScan(t, repo, invoice.NewMemberID(), "CD12345679") // 不同字軌
+	selfID, err := fraud.MemberIDFromString(self.String())
+	require.NoError(t, err)
+
+	// Act
+	count, err := query.CountDistinctMembersInRange(
+		nil, "AB12345673", "AB12345683", selfID, time.Now().Add(-time.Hour),
+	)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
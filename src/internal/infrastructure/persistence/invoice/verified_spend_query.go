@@ -0,0 +1,72 @@
+package invoice
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/tier"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// VerifiedSpendQueryImpl
+// ===========================
+
+// VerifiedSpendQueryImpl 已驗證消費統計查詢實現（GORM）
+//
+// 設計原則：
+// - 實作 tier.VerifiedSpendQuery 接口
+// - 直接查詢 invoice_transactions（只統計 verified，依 invoice_date 判定統計期間）
+// - 軟刪除的交易不計入
+type VerifiedSpendQueryImpl struct {
+	db *gorm.DB
+}
+
+// NewVerifiedSpendQuery 創建已驗證消費統計查詢實例
+func NewVerifiedSpendQuery(db *gorm.DB) tier.VerifiedSpendQuery {
+	return &VerifiedSpendQueryImpl{db: db}
+}
+
+// memberSpendRow 依會員加總的查詢結果
+type memberSpendRow struct {
+	MemberID string
+	Amount   int
+}
+
+// SumVerifiedSpendSince 統計發票日期 >= since 的已驗證消費（依會員加總）
+func (q *VerifiedSpendQueryImpl) SumVerifiedSpendSince(
+	ctx shared.TransactionContext,
+	since time.Time,
+) ([]tier.MemberSpend, error) {
+	var rows []memberSpendRow
+	result := q.getDB(ctx).Model(&InvoiceTransactionGORM{}).
+		Select("member_id, SUM(amount) AS amount").
+		Where("status = ? AND invoice_date >= ?", invoice.TransactionStatusVerified.String(), since).
+		Group("member_id").
+		Order("member_id ASC").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	spends := make([]tier.MemberSpend, 0, len(rows))
+	for _, row := range rows {
+		memberID, err := tier.MemberIDFromString(row.MemberID)
+		if err != nil {
+			return nil, err
+		}
+		spends = append(spends, tier.MemberSpend{MemberID: memberID, Amount: row.Amount})
+	}
+	return spends, nil
+}
+
+// getDB 獲取 GORM DB 實例（ctx 可為 nil）
+func (q *VerifiedSpendQueryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return q.db
+}
@@ -0,0 +1,62 @@
+package invoice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/tier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// VerifiedSpendQuery Integration Tests
+// ===========================
+
+// saveClaim 以指定會員、發票日期與金額保存一筆交易（verify = true 時標記已驗證）
+func saveClaim(
+	t *testing.T,
+	repo invoice.InvoiceTransactionRepository,
+	memberID invoice.MemberID,
+	number string,
+	invoiceDate time.Time,
+	amount int,
+	verify bool,
+) {
+	invoiceNumber, err := invoice.NewInvoiceNumber(number)
+	require.NoError(t, err)
+	money, err := invoice.NewMoney(amount)
+	require.NoError(t, err)
+	tx, err := invoice.NewInvoiceTransaction(memberID, invoiceNumber, invoiceDate, money)
+	require.NoError(t, err)
+	if verify {
+		require.NoError(t, tx.Verify("ichef_matched"))
+	}
+	require.NoError(t, repo.Save(nil, tx))
+}
+
+// Test 1: Sum verified spend per member within the rolling window
+func TestVerifiedSpendQuery_SumVerifiedSpendSince(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewInvoiceTransactionRepository(db)
+	query := NewVerifiedSpendQuery(db)
+	since := time.Date(2024, 3, 15, 0, 0, 0, 0, shared.BusinessLocation)
+	regular := invoice.NewMemberID()
+	saveClaim(t, repo, regular, "AB00000001", since, 1200, true)
+	saveClaim(t, repo, regular, "AB00000002", since.AddDate(0, 6, 0), 800, true)
+	saveClaim(t, repo, regular, "AB00000003", since.AddDate(0, 0, -1), 5000, true) // 統計期間外
+	saveClaim(t, repo, regular, "AB00000004", since.AddDate(0, 7, 0), 3000, false) // 未驗證
+	saveClaim(t, repo, invoice.NewMemberID(), "AB00000005", since.AddDate(0, -2, 0), 900, true)
+
+	// Act
+	spends, err := query.SumVerifiedSpendSince(nil, since)
+
+	// Assert
+	require.NoError(t, err)
+	regularID, err := tier.MemberIDFromString(regular.String())
+	require.NoError(t, err)
+	assert.Equal(t, []tier.MemberSpend{{MemberID: regularID, Amount: 2000}}, spends)
+}
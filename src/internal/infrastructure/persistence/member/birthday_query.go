@@ -0,0 +1,65 @@
+package member
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// BirthdayMemberQueryImpl
+// ===========================
+
+// BirthdayMemberQueryImpl 生日會員查詢實現（GORM）
+//
+// 設計原則：
+// - 實作 member.BirthdayMemberQuery 接口
+// - birthday 以 YYYY-MM-DD 儲存，依月日以 LIKE '%-MM-DD' 比對（不載入聚合）
+type BirthdayMemberQueryImpl struct {
+	db *gorm.DB
+}
+
+// NewBirthdayMemberQuery 創建生日會員查詢實例
+func NewBirthdayMemberQuery(db *gorm.DB) member.BirthdayMemberQuery {
+	return &BirthdayMemberQueryImpl{db: db}
+}
+
+// FindCelebratingOn 查詢指定時間（營業時區）生日的會員 ID
+func (q *BirthdayMemberQueryImpl) FindCelebratingOn(
+	ctx shared.TransactionContext,
+	at time.Time,
+) ([]member.MemberID, error) {
+	db := q.getDB(ctx).Model(&MemberGORM{}).Where("merged_at IS NULL")
+
+	matches := q.getDB(ctx)
+	for _, monthDay := range member.CelebratedMonthDays(at) {
+		matches = matches.Or("birthday LIKE ?", "%-"+monthDay)
+	}
+
+	var ids []string
+	if err := db.Where(matches).Order("member_id ASC").Pluck("member_id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	memberIDs := make([]member.MemberID, 0, len(ids))
+	for _, id := range ids {
+		memberID, err := member.MemberIDFromString(id)
+		if err != nil {
+			return nil, err
+		}
+		memberIDs = append(memberIDs, memberID)
+	}
+	return memberIDs, nil
+}
+
+// getDB 獲取 GORM DB 實例（事務中使用事務連線）
+func (q *BirthdayMemberQueryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return q.db
+}
@@ -0,0 +1,61 @@
+package member
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// BirthdayMemberQuery Integration Tests
+// ===========================
+
+// saveMemberWithBirthday 建立已填寫生日的會員
+func saveMemberWithBirthday(t *testing.T, repo member.MemberRepository, lineUserID, birthday string) *member.Member {
+	t.Helper()
+	line, err := member.NewLineUserID(lineUserID)
+	require.NoError(t, err)
+	m, err := member.NewMember(line, "Test User")
+	require.NoError(t, err)
+	if birthday != "" {
+		parsed, err := member.ParseBirthday(birthday, time.Now())
+		require.NoError(t, err)
+		_, err = m.RecordBirthday(parsed, time.Now())
+		require.NoError(t, err)
+	}
+	require.NoError(t, repo.Save(nil, m))
+	return m
+}
+
+// Test 1: Birthdays round trip and are matched by month/day in Taipei, with Feb 29 on Feb 28 in non-leap years
+func TestBirthdayMemberQuery_FindCelebratingOn(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewMemberRepository(db)
+	query := NewBirthdayMemberQuery(db)
+	feb28 := saveMemberWithBirthday(t, repo, "U1234567890abcdef1234567890abcde1", "1990-02-28")
+	leapling := saveMemberWithBirthday(t, repo, "U1234567890abcdef1234567890abcde2", "1992-02-29")
+	saveMemberWithBirthday(t, repo, "U1234567890abcdef1234567890abcde3", "1990-03-01")
+	saveMemberWithBirthday(t, repo, "U1234567890abcdef1234567890abcde4", "")
+	merged := saveMemberWithBirthday(t, repo, "U1234567890abcdef1234567890abcde5", "1985-02-28")
+	require.NoError(t, db.Model(&MemberGORM{}).
+		Where("member_id = ?", merged.MemberID().String()).
+		Updates(map[string]interface{}{"merged_into": feb28.MemberID().String(), "merged_at": time.Now()}).Error)
+
+	// Act
+	nonLeap, err := query.FindCelebratingOn(nil, time.Date(2025, 2, 27, 16, 30, 0, 0, time.UTC)) // 台北 2/28 00:30
+	require.NoError(t, err)
+	leap, err := query.FindCelebratingOn(nil, time.Date(2024, 2, 28, 12, 0, 0, 0, shared.BusinessLocation))
+	require.NoError(t, err)
+	stored, err := repo.FindByMemberID(nil, leapling.MemberID())
+	require.NoError(t, err)
+
+	// Assert
+	assert.ElementsMatch(t, []member.MemberID{feb28.MemberID(), leapling.MemberID()}, nonLeap)
+	assert.Equal(t, []member.MemberID{feb28.MemberID()}, leap)
+	assert.Equal(t, "1992-02-29", stored.Profile().Birthday().String())
+}
@@ -0,0 +1,72 @@
+package member
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// MemberMergeRepositoryImpl
+// ===========================
+
+// MemberMergeRepositoryImpl 會員合併紀錄倉儲實現（GORM）
+//
+// 設計原則：
+// - 實作 member.MemberMergeRepository 接口
+// - 僅新增（稽核軌跡不可修改或刪除）
+type MemberMergeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewMemberMergeRepository 創建會員合併紀錄倉儲實例
+func NewMemberMergeRepository(db *gorm.DB) member.MemberMergeRepository {
+	return &MemberMergeRepositoryImpl{db: db}
+}
+
+// Save 新增合併紀錄
+//
+// 錯誤處理：
+// - UNIQUE constraint 違反（次要會員已被合併）→ ErrMemberMerged
+func (r *MemberMergeRepositoryImpl) Save(ctx shared.TransactionContext, merge *member.MemberMerge) error {
+	err := r.getDB(ctx).Create(toMemberMergeGORM(merge)).Error
+	if err != nil && isUniqueConstraintError(err) {
+		return member.ErrMemberMerged.WithContext(
+			"member_id", merge.SecondaryMemberID().String(),
+		)
+	}
+	return err
+}
+
+// FindByMemberID 查詢會員（作為保留或被合併的一方）的合併紀錄（新到舊）
+func (r *MemberMergeRepositoryImpl) FindByMemberID(
+	ctx shared.TransactionContext,
+	memberID member.MemberID,
+) ([]*member.MemberMerge, error) {
+	var models []MemberMergeGORM
+	err := r.getDB(ctx).
+		Where("primary_member_id = ? OR secondary_member_id = ?", memberID.String(), memberID.String()).
+		Order("merged_at DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	merges := make([]*member.MemberMerge, 0, len(models))
+	for i := range models {
+		merge, err := models[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		merges = append(merges, merge)
+	}
+	return merges, nil
+}
+
+// getDB 獲取 GORM DB 實例（事務中使用事務連線）
+func (r *MemberMergeRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if gormCtx, ok := ctx.(gormTransactionContext); ok {
+		return gormCtx.GetDB()
+	}
+	return r.db
+}
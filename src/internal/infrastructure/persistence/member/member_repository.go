@@ -2,6 +2,7 @@ package member
 
 import (
 	"errors"
+	"strconv"
 
 	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
 	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
@@ -50,6 +51,7 @@ func NewMemberRepository(db *gorm.DB) member.MemberRepository {
 // 2. 將 Domain 模型轉換為 GORM 模型
 // 3. 使用 GORM Save (Upsert: 存在則更新，不存在則新增)
 // 4. 處理唯一約束衝突錯誤
+// 5. 成功後 MarkPersisted（保存後的實例可直接 Update）
 //
 // 錯誤處理：
 // - UNIQUE constraint 違反 → ErrPhoneNumberAlreadyBound
@@ -73,9 +75,70 @@ func (r *MemberRepositoryImpl) Save(ctx shared.TransactionContext, m *member.Mem
 		return result.Error
 	}
 
+	m.MarkPersisted()
 	return nil
 }
 
+// Update 更新已存在的會員（樂觀鎖）
+//
+// 實作邏輯：
+// 1. UPDATE members SET ... WHERE member_id = ? AND version = ?（載入時版本號）
+// 2. 影響 0 筆時判斷會員是否存在：存在 → 版本衝突；不存在 → 找不到
+// 3. 成功後 MarkPersisted，同一實例可繼續變更並再次 Update
+//
+// 錯誤處理：
+// - 版本號不符 → ErrMemberVersionConflict
+// - 會員不存在 → ErrMemberNotFound
+// - UNIQUE constraint 違反 → ErrPhoneNumberAlreadyBound
+func (r *MemberRepositoryImpl) Update(ctx shared.TransactionContext, m *member.Member) error {
+	db := r.getDB(ctx)
+	gormModel := toGORM(m)
+
+	// 使用 map 確保 NULL 值（解除綁定、解除封鎖）也會寫入
+	result := db.Model(&MemberGORM{}).
+		Where("member_id = ? AND version = ?", gormModel.MemberID, m.PersistedVersion()).
+		Updates(map[string]interface{}{
+			"line_user_id":            gormModel.LineUserID,
+			"display_name":            gormModel.DisplayName,
+			"phone_number":            gormModel.PhoneNumber,
+			"unfollowed_at":           gormModel.UnfollowedAt,
+			"email":                   gormModel.Email,
+			"note":                    gormModel.Note,
+			"birthday":                gormModel.Birthday,
+			"age_verified_by":         gormModel.AgeVerifiedBy,
+			"age_verification_method": gormModel.AgeVerificationMethod,
+			"age_verified_at":         gormModel.AgeVerifiedAt,
+			"merged_into":             gormModel.MergedInto,
+			"merged_at":               gormModel.MergedAt,
+			"updated_at":              gormModel.UpdatedAt,
+			"version":                 gormModel.Version,
+		})
+	if result.Error != nil {
+		if isUniqueConstraintError(result.Error) {
+			return member.ErrPhoneNumberAlreadyBound.WithContext(
+				"phone_number", m.PhoneNumber().String(),
+			)
+		}
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		m.MarkPersisted()
+		return nil
+	}
+
+	var count int64
+	if err := db.Model(&MemberGORM{}).Where("member_id = ?", gormModel.MemberID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return member.ErrMemberNotFound.WithContext("member_id", gormModel.MemberID)
+	}
+	return member.ErrMemberVersionConflict.WithContext(
+		"member_id", gormModel.MemberID,
+		"expected_version", strconv.Itoa(m.PersistedVersion()),
+	)
+}
+
 // FindByMemberID 根據會員 ID 查找會員
 //
 // 實作邏輯：
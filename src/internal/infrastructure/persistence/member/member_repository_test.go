@@ -2,6 +2,7 @@ package member
 
 import (
 	"testing"
+	"time"
 
 	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
 	"github.com/stretchr/testify/assert"
@@ -21,7 +22,7 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	require.NoError(t, err, "failed to connect to test database")
 
 	// 2. 自動遷移
-	err = db.AutoMigrate(&MemberGORM{})
+	err = db.AutoMigrate(&MemberGORM{}, &PhoneNumberChangeGORM{}, &MemberMergeGORM{})
 	require.NoError(t, err, "failed to migrate database schema")
 
 	return db
@@ -149,7 +150,7 @@ func TestMemberRepository_FindByMemberID_Success(t *testing.T) {
 	repo.Save(nil, m)
 
 	// Act
-	found, err := repo.FindByMemberID(nil,m.MemberID())
+	found, err := repo.FindByMemberID(nil, m.MemberID())
 
 	// Assert
 	require.NoError(t, err)
@@ -167,7 +168,7 @@ func TestMemberRepository_FindByMemberID_NotFound_ReturnsError(t *testing.T) {
 	nonExistentID := member.NewMemberID()
 
 	// Act
-	found, err := repo.FindByMemberID(nil,nonExistentID)
+	found, err := repo.FindByMemberID(nil, nonExistentID)
 
 	// Assert
 	assert.Error(t, err)
@@ -184,7 +185,7 @@ func TestMemberRepository_FindByLineUserID_Success(t *testing.T) {
 	repo.Save(nil, m)
 
 	// Act
-	found, err := repo.FindByLineUserID(nil,m.LineUserID())
+	found, err := repo.FindByLineUserID(nil, m.LineUserID())
 
 	// Assert
 	require.NoError(t, err)
@@ -201,7 +202,7 @@ func TestMemberRepository_FindByLineUserID_NotFound_ReturnsError(t *testing.T) {
 	nonExistentLineUserID, _ := member.NewLineUserID("Uffffffffffffffffffffffffffffffff")
 
 	// Act
-	found, err := repo.FindByLineUserID(nil,nonExistentLineUserID)
+	found, err := repo.FindByLineUserID(nil, nonExistentLineUserID)
 
 	// Assert
 	assert.Error(t, err)
@@ -221,7 +222,7 @@ func TestMemberRepository_ExistsByPhoneNumber_Exists_ReturnsTrue(t *testing.T) {
 	repo.Save(nil, m)
 
 	// Act
-	exists, err := repo.ExistsByPhoneNumber(nil,phoneNumber)
+	exists, err := repo.ExistsByPhoneNumber(nil, phoneNumber)
 
 	// Assert
 	require.NoError(t, err)
@@ -236,7 +237,7 @@ func TestMemberRepository_ExistsByPhoneNumber_NotExists_ReturnsFalse(t *testing.
 	phoneNumber, _ := member.NewPhoneNumber("0987654321")
 
 	// Act
-	exists, err := repo.ExistsByPhoneNumber(nil,phoneNumber)
+	exists, err := repo.ExistsByPhoneNumber(nil, phoneNumber)
 
 	// Assert
 	require.NoError(t, err)
@@ -252,7 +253,7 @@ func TestMemberRepository_ExistsByLineUserID_Exists_ReturnsTrue(t *testing.T) {
 	repo.Save(nil, m)
 
 	// Act
-	exists, err := repo.ExistsByLineUserID(nil,m.LineUserID())
+	exists, err := repo.ExistsByLineUserID(nil, m.LineUserID())
 
 	// Assert
 	require.NoError(t, err)
@@ -267,7 +268,7 @@ func TestMemberRepository_ExistsByLineUserID_NotExists_ReturnsFalse(t *testing.T
 	nonExistentLineUserID, _ := member.NewLineUserID("Uffffffffffffffffffffffffffffffff")
 
 	// Act
-	exists, err := repo.ExistsByLineUserID(nil,nonExistentLineUserID)
+	exists, err := repo.ExistsByLineUserID(nil, nonExistentLineUserID)
 
 	// Assert
 	require.NoError(t, err)
@@ -286,7 +287,7 @@ func TestMemberRepository_RoundTrip_PreservesAllFields(t *testing.T) {
 
 	// Act - Save and retrieve
 	repo.Save(nil, m)
-	retrieved, err := repo.FindByMemberID(nil,m.MemberID())
+	retrieved, err := repo.FindByMemberID(nil, m.MemberID())
 
 	// Assert
 	require.NoError(t, err)
@@ -308,10 +309,238 @@ func TestMemberRepository_SaveAndRetrieve_NoPhoneNumber_Success(t *testing.T) {
 
 	// Act
 	repo.Save(nil, m)
-	retrieved, err := repo.FindByMemberID(nil,m.MemberID())
+	retrieved, err := repo.FindByMemberID(nil, m.MemberID())
 
 	// Assert
 	require.NoError(t, err)
 	assert.False(t, retrieved.HasPhoneNumber(), "retrieved member should not have phone number")
 	assert.True(t, retrieved.PhoneNumber().IsZero(), "phone number should be zero value")
 }
+
+// Test 15: Unfollow / re-follow state is persisted
+func TestMemberRepository_SaveAndRetrieve_Reachability(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewMemberRepository(db)
+	m := createTestMember(t)
+	unfollowedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	m.MarkUnfollowed(unfollowedAt)
+
+	// Act
+	require.NoError(t, repo.Save(nil, m))
+	unfollowed, err := repo.FindByMemberID(nil, m.MemberID())
+	require.NoError(t, err)
+	loadedUnfollowedAt := unfollowed.UnfollowedAt()
+	unfollowed.MarkRefollowed(unfollowedAt.Add(time.Hour))
+	require.NoError(t, repo.Save(nil, unfollowed))
+	refollowed, err := repo.FindByMemberID(nil, m.MemberID())
+	require.NoError(t, err)
+
+	// Assert
+	require.NotNil(t, loadedUnfollowedAt)
+	assert.True(t, unfollowedAt.Equal(*loadedUnfollowedAt))
+	assert.True(t, refollowed.IsReachable())
+	assert.Nil(t, refollowed.UnfollowedAt())
+	assert.Equal(t, 3, refollowed.Version())
+}
+
+// Test 16: Phone number change records are stored and listed newest first
+func TestPhoneNumberChangeRepository_SaveAndFindByMemberID(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewPhoneNumberChangeRepository(db)
+	memberID := member.NewMemberID()
+	oldPhone, _ := member.NewPhoneNumber("0912345678")
+	newPhone, _ := member.NewPhoneNumber("0987654321")
+	base := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	rebind, err := member.NewPhoneNumberChange(memberID, member.PhoneNumberRebound, oldPhone, newPhone, "會員換號", "admin", base)
+	require.NoError(t, err)
+	unbind, err := member.NewPhoneNumberChange(memberID, member.PhoneNumberUnbound, newPhone, member.PhoneNumber{}, "門號停用", "admin", base.Add(time.Hour))
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, repo.Save(nil, rebind))
+	require.NoError(t, repo.Save(nil, unbind))
+	changes, err := repo.FindByMemberID(nil, memberID)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, unbind.ChangeID(), changes[0].ChangeID())
+	assert.Equal(t, member.PhoneNumberUnbound, changes[0].Action())
+	assert.True(t, changes[0].PhoneNumber().IsZero())
+	assert.Equal(t, "0912345678", changes[1].PreviousPhoneNumber().String())
+	assert.Equal(t, "0987654321", changes[1].PhoneNumber().String())
+	assert.Equal(t, "會員換號", changes[1].Reason())
+	assert.Equal(t, "admin", changes[1].OperatorID())
+}
+
+// Test 17: Update checks the loaded version and writes NULL phone on unbind
+func TestMemberRepository_Update_OptimisticLock(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewMemberRepository(db)
+	m := createTestMember(t)
+	phoneNumber, _ := member.NewPhoneNumber("0912345678")
+	require.NoError(t, m.BindPhoneNumber(phoneNumber))
+	require.NoError(t, repo.Save(nil, m))
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	first, err := repo.FindByMemberID(nil, m.MemberID())
+	require.NoError(t, err)
+	second, err := repo.FindByMemberID(nil, m.MemberID())
+	require.NoError(t, err)
+
+	// Act
+	_, err = first.UnbindPhoneNumber("會員換號", "admin", now)
+	require.NoError(t, err)
+	firstErr := repo.Update(nil, first)
+	second.MarkUnfollowed(now)
+	secondErr := repo.Update(nil, second)
+	stored, err := repo.FindByMemberID(nil, m.MemberID())
+	require.NoError(t, err)
+
+	// Assert
+	require.NoError(t, firstErr)
+	assert.ErrorIs(t, secondErr, member.ErrMemberVersionConflict)
+	assert.False(t, stored.HasPhoneNumber(), "unbind should persist NULL phone number")
+	assert.True(t, stored.IsReachable(), "conflicting update must not be written")
+	assert.Equal(t, 3, stored.Version())
+
+	missing := createTestMember(t)
+	assert.ErrorIs(t, repo.Update(nil, missing), member.ErrMemberNotFound)
+}
+
+// Test 18: Display name and profile changes are persisted through Update
+func TestMemberRepository_Update_DisplayNameAndProfile(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewMemberRepository(db)
+	m := createTestMember(t)
+	require.NoError(t, repo.Save(nil, m))
+	loaded, err := repo.FindByMemberID(nil, m.MemberID())
+	require.NoError(t, err)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	profile, err := member.NewMemberProfile("test@example.com", "常客")
+	require.NoError(t, err)
+
+	// Act
+	_, err = loaded.ChangeDisplayName("新名字", now)
+	require.NoError(t, err)
+	loaded.UpdateProfile(profile, now)
+	require.NoError(t, repo.Update(nil, loaded))
+	stored, err := repo.FindByMemberID(nil, m.MemberID())
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "新名字", stored.DisplayName())
+	assert.Equal(t, "test@example.com", stored.Profile().Email())
+	assert.Equal(t, "常客", stored.Profile().Note())
+	assert.Equal(t, 3, stored.Version())
+	assert.Equal(t, 3, stored.PersistedVersion())
+}
+
+// Test 19: Merge tombstone round trips through Update and the merge record can be saved only once
+func TestMemberRepository_MergeTombstoneAndRecord(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewMemberRepository(db)
+	mergeRepo := NewMemberMergeRepository(db)
+	primary := createTestMember(t)
+	secondaryLine, _ := member.NewLineUserID("Uabcdef1234567890abcdef1234567890")
+	secondary, _ := member.NewMember(secondaryLine, "Second Account")
+	phone, _ := member.NewPhoneNumber("0987654321")
+	require.NoError(t, secondary.BindPhoneNumber(phone))
+	require.NoError(t, repo.Save(nil, primary))
+	require.NoError(t, repo.Save(nil, secondary))
+	loaded, err := repo.FindByMemberID(nil, secondary.MemberID())
+	require.NoError(t, err)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// Act
+	merge, err := loaded.MergeInto(primary, "重複註冊", "admin", now)
+	require.NoError(t, err)
+	merge.RecordTransfer(member.MergeTransfer{EarnedPoints: 120, UsedPoints: 20, Transactions: 3})
+	require.NoError(t, repo.Update(nil, loaded))
+	require.NoError(t, mergeRepo.Save(nil, merge))
+	duplicate, err := loaded.MergeInto(primary, "重複註冊", "admin", now)
+	stored, findErr := repo.FindByMemberID(nil, secondary.MemberID())
+	primaryMerges, listErr := mergeRepo.FindByMemberID(nil, primary.MemberID())
+
+	// Assert
+	assert.Nil(t, duplicate)
+	assert.ErrorIs(t, err, member.ErrMemberMerged)
+	require.NoError(t, findErr)
+	assert.True(t, stored.IsMerged())
+	assert.Equal(t, primary.MemberID(), stored.MergedInto())
+	assert.False(t, stored.HasPhoneNumber(), "phone number should be released")
+	require.NoError(t, listErr)
+	require.Len(t, primaryMerges, 1)
+	assert.Equal(t, merge.MergeID(), primaryMerges[0].MergeID())
+	assert.Equal(t, "0987654321", primaryMerges[0].ReleasedPhoneNumber().String())
+	assert.Equal(t, 120, primaryMerges[0].Transfer().EarnedPoints)
+	assert.Equal(t, 3, primaryMerges[0].Transfer().Transactions)
+	assert.ErrorIs(t, mergeRepo.Save(nil, merge), member.ErrMemberMerged)
+}
+
+// Test 20: Age verification (verifier, method, time) round trips through Update
+func TestMemberRepository_Update_AgeVerification(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewMemberRepository(db)
+	m := createTestMember(t)
+	require.NoError(t, repo.Save(nil, m))
+	loaded, err := repo.FindByMemberID(nil, m.MemberID())
+	require.NoError(t, err)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	verification, err := member.NewAgeVerification("staff01", member.AgeVerifiedByStaffIDCheck, now)
+	require.NoError(t, err)
+
+	// Act
+	unverified, err := repo.FindByMemberID(nil, m.MemberID())
+	require.NoError(t, err)
+	_, err = loaded.VerifyAge(verification)
+	require.NoError(t, err)
+	require.NoError(t, repo.Update(nil, loaded))
+	stored, err := repo.FindByMemberID(nil, m.MemberID())
+	require.NoError(t, err)
+
+	// Assert
+	assert.False(t, unverified.IsVerifiedAdult())
+	assert.Nil(t, unverified.AgeVerification().VerifiedAt())
+	assert.True(t, stored.IsVerifiedAdult())
+	assert.Equal(t, "staff01", stored.AgeVerification().VerifiedBy())
+	assert.Equal(t, member.AgeVerifiedByStaffIDCheck, stored.AgeVerification().Method())
+	assert.True(t, now.Equal(*stored.AgeVerification().VerifiedAt()))
+}
+
+// Test 21: The same instance can be saved and updated repeatedly; a stale copy still conflicts
+func TestMemberRepository_Update_ConsecutiveUpdatesOnSameInstance(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewMemberRepository(db)
+	m := createTestMember(t)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.Save(nil, m))
+	stale, err := repo.FindByMemberID(nil, m.MemberID())
+	require.NoError(t, err)
+
+	// Act
+	_, err = m.ChangeDisplayName("第一次", now)
+	require.NoError(t, err)
+	firstErr := repo.Update(nil, m)
+	_, err = m.ChangeDisplayName("第二次", now.Add(time.Minute))
+	require.NoError(t, err)
+	secondErr := repo.Update(nil, m)
+	staleErr := repo.Update(nil, stale)
+	stored, err := repo.FindByMemberID(nil, m.MemberID())
+	require.NoError(t, err)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	assert.ErrorIs(t, staleErr, member.ErrMemberVersionConflict)
+	assert.Equal(t, "第二次", stored.DisplayName())
+	assert.Equal(t, m.Version(), stored.Version())
+	assert.Equal(t, m.Version(), m.PersistedVersion())
+}
@@ -0,0 +1,181 @@
+package member
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// MemberSearchQueryImpl
+// ===========================
+
+// availablePointsExpr 可用積分（無積分帳戶視為 0）
+const availablePointsExpr = "COALESCE(p.earned_points - p.used_points, 0)"
+
+// sortColumns 排序欄位對應的 SQL 運算式
+var sortColumns = map[member.MemberSortField]string{
+	member.MemberSortByCreatedAt:       "m.created_at",
+	member.MemberSortByDisplayName:     "m.display_name",
+	member.MemberSortByAvailablePoints: availablePointsExpr,
+}
+
+// likeEscaper 跳脫 LIKE 萬用字元（搭配 ESCAPE '\'）
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// MemberSearchQueryImpl 會員搜尋查詢實現（GORM）
+//
+// 設計原則：
+// - 實作 member.MemberSearchQuery 接口
+// - members LEFT JOIN points_accounts（不載入聚合）
+// - keyset 分頁：(排序值, member_id) 大於 / 小於游標，多取一筆判斷是否有下一頁
+type MemberSearchQueryImpl struct {
+	db *gorm.DB
+}
+
+// NewMemberSearchQuery 創建會員搜尋查詢實例
+func NewMemberSearchQuery(db *gorm.DB) member.MemberSearchQuery {
+	return &MemberSearchQueryImpl{db: db}
+}
+
+// memberSummaryRow 查詢結果列
+type memberSummaryRow struct {
+	MemberID        string
+	LineUserID      string
+	DisplayName     string
+	PhoneNumber     *string
+	UnfollowedAt    *time.Time
+	CreatedAt       time.Time
+	AccountID       *string
+	EarnedPoints    int
+	UsedPoints      int
+	AvailablePoints int
+}
+
+// Search 依條件搜尋會員
+func (q *MemberSearchQueryImpl) Search(
+	ctx shared.TransactionContext,
+	criteria member.MemberSearchCriteria,
+) (*member.MemberSearchPage, error) {
+	db := q.getDB(ctx).Table("members AS m").
+		Select("m.member_id, m.line_user_id, m.display_name, m.phone_number, m.unfollowed_at, m.created_at, " +
+			"p.account_id, COALESCE(p.earned_points, 0) AS earned_points, COALESCE(p.used_points, 0) AS used_points, " +
+			availablePointsExpr + " AS available_points").
+		Joins("LEFT JOIN points_accounts AS p ON p.member_id = m.member_id AND p.deleted_at IS NULL").
+		Where("m.deleted_at IS NULL")
+
+	if criteria.PhoneSuffix != "" {
+		db = db.Where("m.phone_number LIKE ?", "%"+criteria.PhoneSuffix)
+	}
+	if criteria.NameKeyword != "" {
+		db = db.Where(`m.display_name LIKE ? ESCAPE '\'`, "%"+likeEscaper.Replace(criteria.NameKeyword)+"%")
+	}
+	if criteria.RegisteredFrom != nil {
+		db = db.Where("m.created_at >= ?", *criteria.RegisteredFrom)
+	}
+	if criteria.RegisteredTo != nil {
+		db = db.Where("m.created_at < ?", *criteria.RegisteredTo)
+	}
+	if criteria.Reachable != nil {
+		if *criteria.Reachable {
+			db = db.Where("m.unfollowed_at IS NULL")
+		} else {
+			db = db.Where("m.unfollowed_at IS NOT NULL")
+		}
+	}
+	if criteria.MinAvailablePoints != nil {
+		db = db.Where(availablePointsExpr+" >= ?", *criteria.MinAvailablePoints)
+	}
+	if criteria.MaxAvailablePoints != nil {
+		db = db.Where(availablePointsExpr+" <= ?", *criteria.MaxAvailablePoints)
+	}
+
+	column := sortColumns[criteria.Sort]
+	direction, comparator := "ASC", ">"
+	if criteria.Descending {
+		direction, comparator = "DESC", "<"
+	}
+	if cursor := criteria.Cursor; cursor != nil {
+		value := cursorValue(*cursor)
+		db = db.Where(
+			"("+column+" "+comparator+" ?) OR ("+column+" = ? AND m.member_id "+comparator+" ?)",
+			value, value, cursor.MemberID,
+		)
+	}
+
+	var rows []memberSummaryRow
+	err := db.Order(column + " " + direction).
+		Order("m.member_id " + direction).
+		Limit(criteria.Limit + 1).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(rows) > criteria.Limit
+	if hasMore {
+		rows = rows[:criteria.Limit]
+	}
+
+	page := &member.MemberSearchPage{Items: make([]member.MemberSummary, 0, len(rows))}
+	for _, row := range rows {
+		summary, err := row.toSummary()
+		if err != nil {
+			return nil, err
+		}
+		page.Items = append(page.Items, summary)
+	}
+	if hasMore {
+		next := member.NewMemberSearchCursor(criteria.Sort, criteria.Descending, page.Items[len(page.Items)-1])
+		page.NextCursor = &next
+	}
+	return page, nil
+}
+
+// cursorValue 游標排序值轉為查詢參數
+func cursorValue(cursor member.MemberSearchCursor) interface{} {
+	switch cursor.Sort {
+	case member.MemberSortByAvailablePoints:
+		return cursor.AvailablePointsValue()
+	case member.MemberSortByDisplayName:
+		return cursor.SortValue
+	default:
+		return cursor.CreatedAtValue()
+	}
+}
+
+// toSummary 轉換為讀取模型
+func (r memberSummaryRow) toSummary() (member.MemberSummary, error) {
+	memberID, err := member.MemberIDFromString(r.MemberID)
+	if err != nil {
+		return member.MemberSummary{}, err
+	}
+	summary := member.MemberSummary{
+		MemberID:         memberID,
+		LineUserID:       r.LineUserID,
+		DisplayName:      r.DisplayName,
+		IsReachable:      r.UnfollowedAt == nil,
+		CreatedAt:        r.CreatedAt,
+		HasPointsAccount: r.AccountID != nil,
+		EarnedPoints:     r.EarnedPoints,
+		UsedPoints:       r.UsedPoints,
+		AvailablePoints:  r.AvailablePoints,
+	}
+	if r.PhoneNumber != nil {
+		summary.PhoneNumber = *r.PhoneNumber
+	}
+	return summary, nil
+}
+
+// getDB 獲取 GORM DB 實例（事務中使用事務連線）
+func (q *MemberSearchQueryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return q.db
+}
@@ -0,0 +1,171 @@
+package member
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	pointspersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/points"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// MemberSearchQuery Integration Tests
+// ===========================
+
+// setupSearchTestDB 創建測試資料庫（含積分帳戶資料表）
+func setupSearchTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err, "failed to connect to test database")
+
+	err = db.AutoMigrate(&MemberGORM{}, &pointspersistence.PointsAccountGORM{})
+	require.NoError(t, err, "failed to migrate database schema")
+
+	return db
+}
+
+// searchSeed 測試會員資料
+type searchSeed struct {
+	name       string
+	phone      string
+	points     int // < 0 表示沒有積分帳戶
+	unfollowed bool
+	createdAt  time.Time
+}
+
+// seedSearchMember 建立會員（與積分帳戶），返回會員 ID
+func seedSearchMember(t *testing.T, db *gorm.DB, seed searchSeed) string {
+	t.Helper()
+	memberID := uuid.NewString()
+	model := &MemberGORM{
+		MemberID:    memberID,
+		LineUserID:  "U" + uuid.NewString()[:32],
+		DisplayName: seed.name,
+		CreatedAt:   seed.createdAt,
+		UpdatedAt:   seed.createdAt,
+		Version:     1,
+	}
+	if seed.phone != "" {
+		model.PhoneNumber = &seed.phone
+	}
+	if seed.unfollowed {
+		model.UnfollowedAt = &seed.createdAt
+	}
+	require.NoError(t, db.Create(model).Error)
+	if seed.points >= 0 {
+		require.NoError(t, db.Create(&pointspersistence.PointsAccountGORM{
+			AccountID:    uuid.NewString(),
+			MemberID:     memberID,
+			EarnedPoints: seed.points + 5,
+			UsedPoints:   5,
+			CreatedAt:    seed.createdAt,
+			UpdatedAt:    seed.createdAt,
+		}).Error)
+	}
+	return memberID
+}
+
+// search 正規化條件後執行搜尋
+func search(t *testing.T, query member.MemberSearchQuery, criteria member.MemberSearchCriteria) *member.MemberSearchPage {
+	t.Helper()
+	normalized, err := criteria.Normalize()
+	require.NoError(t, err)
+	page, err := query.Search(nil, normalized)
+	require.NoError(t, err)
+	return page
+}
+
+func names(page *member.MemberSearchPage) []string {
+	result := make([]string, 0, len(page.Items))
+	for _, item := range page.Items {
+		result = append(result, item.DisplayName)
+	}
+	return result
+}
+
+// Test 1: 篩選條件（手機末碼、中文名稱、註冊區間、可觸及、積分區間）與積分餘額
+func TestMemberSearchQuery_Filters(t *testing.T) {
+	// Arrange
+	db := setupSearchTestDB(t)
+	query := NewMemberSearchQuery(db)
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	seedSearchMember(t, db, searchSeed{name: "王小明", phone: "0912345678", points: 120, createdAt: base})
+	seedSearchMember(t, db, searchSeed{name: "王大明", phone: "0987005678", points: 30, unfollowed: true, createdAt: base.AddDate(0, 1, 0)})
+	seedSearchMember(t, db, searchSeed{name: "陳美玲", phone: "0911111111", points: -1, createdAt: base.AddDate(0, 2, 0)})
+	seedSearchMember(t, db, searchSeed{name: "100%_Amy", points: 0, createdAt: base.AddDate(0, 3, 0)})
+	reachable, minPoints, maxPoints := true, 10, 200
+	from, to := base.AddDate(0, 1, 0), base.AddDate(0, 3, 0)
+
+	// Act
+	bySuffix := search(t, query, member.MemberSearchCriteria{PhoneSuffix: "5678"})
+	byName := search(t, query, member.MemberSearchCriteria{NameKeyword: "明"})
+	byLiteralWildcard := search(t, query, member.MemberSearchCriteria{NameKeyword: "%_"})
+	byPeriod := search(t, query, member.MemberSearchCriteria{RegisteredFrom: &from, RegisteredTo: &to})
+	byReachableAndPoints := search(t, query, member.MemberSearchCriteria{
+		Reachable: &reachable, MinAvailablePoints: &minPoints, MaxAvailablePoints: &maxPoints,
+	})
+	zeroPoints := 0
+	withoutPoints := search(t, query, member.MemberSearchCriteria{MaxAvailablePoints: &zeroPoints})
+
+	// Assert
+	assert.Equal(t, []string{"王小明", "王大明"}, names(bySuffix))
+	assert.Equal(t, []string{"王小明", "王大明"}, names(byName))
+	assert.Equal(t, []string{"100%_Amy"}, names(byLiteralWildcard))
+	assert.Equal(t, []string{"王大明", "陳美玲"}, names(byPeriod))
+	require.Equal(t, []string{"王小明"}, names(byReachableAndPoints))
+	first := byReachableAndPoints.Items[0]
+	assert.Equal(t, "0912345678", first.PhoneNumber)
+	assert.True(t, first.IsReachable)
+	assert.True(t, first.HasPointsAccount)
+	assert.Equal(t, 125, first.EarnedPoints)
+	assert.Equal(t, 5, first.UsedPoints)
+	assert.Equal(t, 120, first.AvailablePoints)
+	assert.Equal(t, []string{"陳美玲", "100%_Amy"}, names(withoutPoints))
+	assert.False(t, withoutPoints.Items[0].HasPointsAccount)
+	assert.False(t, byPeriod.Items[0].IsReachable)
+}
+
+// Test 2: keyset 分頁（依可用積分降冪，同分依 member_id；游標可編碼還原）
+func TestMemberSearchQuery_KeysetPagination(t *testing.T) {
+	// Arrange
+	db := setupSearchTestDB(t)
+	query := NewMemberSearchQuery(db)
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i, points := range []int{50, 80, 50, 10, 50} {
+		seedSearchMember(t, db, searchSeed{name: string(rune('A' + i)), points: points, createdAt: base.AddDate(0, 0, i)})
+	}
+	criteria := member.MemberSearchCriteria{Sort: member.MemberSortByAvailablePoints, Descending: true, Limit: 2}
+
+	// Act
+	var pages [][]member.MemberSummary
+	for {
+		page := search(t, query, criteria)
+		pages = append(pages, page.Items)
+		if page.NextCursor == nil {
+			break
+		}
+		cursor, err := member.ParseMemberSearchCursor(page.NextCursor.String())
+		require.NoError(t, err)
+		criteria.Cursor = cursor
+	}
+
+	// Assert
+	require.Len(t, pages, 3)
+	var all []member.MemberSummary
+	for _, page := range pages {
+		all = append(all, page...)
+	}
+	require.Len(t, all, 5)
+	assert.Equal(t, 80, all[0].AvailablePoints)
+	for i := 1; i < len(all); i++ {
+		prev, curr := all[i-1], all[i]
+		assert.True(t, prev.AvailablePoints > curr.AvailablePoints ||
+			(prev.AvailablePoints == curr.AvailablePoints && prev.MemberID.String() > curr.MemberID.String()),
+			"rows must be strictly ordered without duplicates")
+	}
+	assert.Equal(t, 10, all[4].AvailablePoints)
+}
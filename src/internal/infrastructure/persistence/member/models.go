@@ -23,6 +23,11 @@ import (
 // - line_user_id: 唯一索引（防止重複註冊）
 // - phone_number: 唯一索引（防止重複綁定），可為空
 // - display_name: 不可為空
+// - unfollowed_at: 封鎖官方帳號的時間，可為空（分眾查詢排除已封鎖會員）
+// - email / note: 補充資料，空字串表示未填寫
+// - birthday: 生日（YYYY-MM-DD），空字串表示未填寫（生日禮排程以月日 LIKE 查詢）
+// - age_verified_by / age_verification_method / age_verified_at: 成年驗證紀錄（age_verified_at 為 NULL 表示未驗證，群發分眾以此篩選）
+// - merged_into / merged_at: 重複會員合併後指向保留的會員，可為空（NULL 表示未被合併）
 type MemberGORM struct {
 	// 識別欄位
 	MemberID   string `gorm:"column:member_id;type:varchar(36);primaryKey"` // UUID 字串
@@ -34,8 +39,25 @@ type MemberGORM struct {
 	// 綁定信息
 	PhoneNumber *string `gorm:"column:phone_number;type:varchar(10);uniqueIndex"` // Nullable
 
+	// 可觸及狀態
+	UnfollowedAt *time.Time `gorm:"column:unfollowed_at;index"` // Nullable（NULL 表示可觸及）
+
+	// 補充資料
+	Email    string `gorm:"column:email;type:varchar(254);not null;default:''"`
+	Note     string `gorm:"column:note;type:varchar(2000);not null;default:''"`
+	Birthday string `gorm:"column:birthday;type:varchar(10);not null;default:''"`
+
+	// 成年驗證
+	AgeVerifiedBy         string     `gorm:"column:age_verified_by;type:varchar(100);not null;default:''"`
+	AgeVerificationMethod string     `gorm:"column:age_verification_method;type:varchar(20);not null;default:''"`
+	AgeVerifiedAt         *time.Time `gorm:"column:age_verified_at"` // Nullable（NULL 表示未驗證）
+
+	// 合併停用狀態
+	MergedInto *string    `gorm:"column:merged_into;type:varchar(36);index"` // Nullable
+	MergedAt   *time.Time `gorm:"column:merged_at"`                          // Nullable
+
 	// 審計欄位
-	CreatedAt time.Time      `gorm:"column:created_at;not null"`
+	CreatedAt time.Time      `gorm:"column:created_at;index;not null"`
 	UpdatedAt time.Time      `gorm:"column:updated_at;not null"`
 	Version   int            `gorm:"column:version;not null;default:1"` // 樂觀鎖
 	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"`           // 軟刪除
@@ -86,12 +108,49 @@ func (m *MemberGORM) toDomain() (*member.Member, error) {
 	}
 	// 如果 m.PhoneNumber == nil，phoneNumber 維持零值
 
-	// 4. 重建 Domain 聚合
+	// 4. 重建補充資料（含生日）
+	birthday, err := member.ReconstructBirthday(m.Birthday)
+	if err != nil {
+		return nil, err
+	}
+	profile := member.ReconstructMemberProfile(m.Email, m.Note).WithBirthday(birthday)
+	ageVerification := member.ReconstructAgeVerification(
+		m.AgeVerifiedBy,
+		member.AgeVerificationMethod(m.AgeVerificationMethod),
+		m.AgeVerifiedAt,
+	)
+
+	// 5. 已被合併的會員需一併重建合併狀態
+	if m.MergedAt != nil && m.MergedInto != nil {
+		mergedInto, err := member.MemberIDFromString(*m.MergedInto)
+		if err != nil {
+			return nil, err
+		}
+		return member.ReconstructMergedMember(
+			memberID,
+			lineUserID,
+			m.DisplayName,
+			phoneNumber,
+			m.UnfollowedAt,
+			profile,
+			ageVerification,
+			m.CreatedAt,
+			m.UpdatedAt,
+			m.Version,
+			mergedInto,
+			*m.MergedAt,
+		)
+	}
+
+	// 6. 重建 Domain 聚合
 	return member.ReconstructMember(
 		memberID,
 		lineUserID,
 		m.DisplayName,
 		phoneNumber,
+		m.UnfollowedAt,
+		profile,
+		ageVerification,
 		m.CreatedAt,
 		m.UpdatedAt,
 		m.Version,
@@ -110,6 +169,7 @@ func (m *MemberGORM) toDomain() (*member.Member, error) {
 // - MemberID: MemberID 值對象 → 字串
 // - LineUserID: LineUserID 值對象 → 字串
 // - PhoneNumber: PhoneNumber 值對象 → *string（處理零值 → NULL）
+// - MergedInto: 未被合併 → NULL
 func toGORM(m *member.Member) *MemberGORM {
 	// 處理 PhoneNumber（零值 → NULL）
 	var phoneNumber *string
@@ -118,13 +178,191 @@ func toGORM(m *member.Member) *MemberGORM {
 		phoneNumber = &phoneStr
 	}
 
+	var mergedInto *string
+	if m.IsMerged() {
+		survivorID := m.MergedInto().String()
+		mergedInto = &survivorID
+	}
+
 	return &MemberGORM{
-		MemberID:    m.MemberID().String(),
-		LineUserID:  m.LineUserID().String(),
-		DisplayName: m.DisplayName(),
-		PhoneNumber: phoneNumber,
-		CreatedAt:   m.CreatedAt(),
-		UpdatedAt:   m.UpdatedAt(),
-		Version:     m.Version(),
+		MemberID:     m.MemberID().String(),
+		LineUserID:   m.LineUserID().String(),
+		DisplayName:  m.DisplayName(),
+		PhoneNumber:  phoneNumber,
+		UnfollowedAt: m.UnfollowedAt(),
+		Email:        m.Profile().Email(),
+		Note:         m.Profile().Note(),
+		Birthday:     m.Profile().Birthday().String(),
+		MergedInto:   mergedInto,
+		MergedAt:     m.MergedAt(),
+		CreatedAt:    m.CreatedAt(),
+		UpdatedAt:    m.UpdatedAt(),
+		Version:      m.Version(),
+
+		AgeVerifiedBy:         m.AgeVerification().VerifiedBy(),
+		AgeVerificationMethod: m.AgeVerification().Method().String(),
+		AgeVerifiedAt:         m.AgeVerification().VerifiedAt(),
+	}
+}
+
+// PhoneNumberChangeGORM 手機號碼異動紀錄資料表模型（僅新增）
+//
+// 資料庫約束：
+// - change_id: 主鍵（與 member.phone_number_changed 事件 ID 相同）
+// - member_id + changed_at: 複合索引（依會員查詢異動歷史）
+// - previous_phone / new_phone: 可為空（代為綁定 / 解除綁定）
+type PhoneNumberChangeGORM struct {
+	ChangeID      string    `gorm:"column:change_id;type:varchar(36);primaryKey"`
+	MemberID      string    `gorm:"column:member_id;type:varchar(36);not null;index:idx_phone_changes_member,priority:1"`
+	Action        string    `gorm:"column:action;type:varchar(20);not null"`
+	PreviousPhone *string   `gorm:"column:previous_phone;type:varchar(10)"`
+	NewPhone      *string   `gorm:"column:new_phone;type:varchar(10)"`
+	Reason        string    `gorm:"column:reason;type:varchar(800);not null"`
+	OperatorID    string    `gorm:"column:operator_id;type:varchar(100);not null"`
+	ChangedAt     time.Time `gorm:"column:changed_at;not null;index:idx_phone_changes_member,priority:2"`
+}
+
+// TableName 指定資料表名稱
+func (PhoneNumberChangeGORM) TableName() string {
+	return "member_phone_number_changes"
+}
+
+// toDomain 將異動紀錄模型轉換為 Domain 模型
+func (c *PhoneNumberChangeGORM) toDomain() (*member.PhoneNumberChange, error) {
+	memberID, err := member.MemberIDFromString(c.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	previousPhone, err := phoneFromNullable(c.PreviousPhone)
+	if err != nil {
+		return nil, err
+	}
+	newPhone, err := phoneFromNullable(c.NewPhone)
+	if err != nil {
+		return nil, err
+	}
+
+	return member.ReconstructPhoneNumberChange(
+		c.ChangeID,
+		memberID,
+		member.PhoneNumberChangeAction(c.Action),
+		previousPhone,
+		newPhone,
+		c.Reason,
+		c.OperatorID,
+		c.ChangedAt,
+	), nil
+}
+
+// toPhoneNumberChangeGORM 將異動紀錄轉換為 GORM 模型
+func toPhoneNumberChangeGORM(c *member.PhoneNumberChange) *PhoneNumberChangeGORM {
+	return &PhoneNumberChangeGORM{
+		ChangeID:      c.ChangeID(),
+		MemberID:      c.MemberID().String(),
+		Action:        c.Action().String(),
+		PreviousPhone: phoneToNullable(c.PreviousPhoneNumber()),
+		NewPhone:      phoneToNullable(c.PhoneNumber()),
+		Reason:        c.Reason(),
+		OperatorID:    c.OperatorID(),
+		ChangedAt:     c.ChangedAt(),
+	}
+}
+
+// phoneFromNullable NULL → 零值 PhoneNumber
+func phoneFromNullable(value *string) (member.PhoneNumber, error) {
+	if value == nil {
+		return member.PhoneNumber{}, nil
+	}
+	return member.NewPhoneNumber(*value)
+}
+
+// phoneToNullable 零值 PhoneNumber → NULL
+func phoneToNullable(phone member.PhoneNumber) *string {
+	if phone.IsZero() {
+		return nil
+	}
+	value := phone.String()
+	return &value
+}
+
+// MemberMergeGORM 會員合併紀錄資料表模型（僅新增）
+//
+// 資料庫約束：
+// - merge_id: 主鍵（與 member.merged 事件 ID 相同）
+// - secondary_member_id: 唯一索引（會員只能被合併一次）
+// - primary_member_id: 索引（查詢保留會員的合併紀錄）
+// - released_phone: 次要會員釋出的手機號碼，可為空
+type MemberMergeGORM struct {
+	MergeID           string  `gorm:"column:merge_id;type:varchar(36);primaryKey"`
+	PrimaryMemberID   string  `gorm:"column:primary_member_id;type:varchar(36);not null;index"`
+	SecondaryMemberID string  `gorm:"column:secondary_member_id;type:varchar(36);not null;uniqueIndex"`
+	Reason            string  `gorm:"column:reason;type:varchar(800);not null"`
+	OperatorID        string  `gorm:"column:operator_id;type:varchar(100);not null"`
+	ReleasedPhone     *string `gorm:"column:released_phone;type:varchar(10)"`
+
+	// 移轉的積分與歷史筆數
+	EarnedPoints    int `gorm:"column:earned_points;not null"`
+	UsedPoints      int `gorm:"column:used_points;not null"`
+	Transactions    int `gorm:"column:transactions;not null"`
+	SurveyResponses int `gorm:"column:survey_responses;not null"`
+	Adjustments     int `gorm:"column:adjustments;not null"`
+
+	MergedAt time.Time `gorm:"column:merged_at;not null"`
+}
+
+// TableName 指定資料表名稱
+func (MemberMergeGORM) TableName() string {
+	return "member_merges"
+}
+
+// toDomain 將合併紀錄模型轉換為 Domain 模型
+func (g *MemberMergeGORM) toDomain() (*member.MemberMerge, error) {
+	primaryID, err := member.MemberIDFromString(g.PrimaryMemberID)
+	if err != nil {
+		return nil, err
+	}
+	secondaryID, err := member.MemberIDFromString(g.SecondaryMemberID)
+	if err != nil {
+		return nil, err
+	}
+	releasedPhone, err := phoneFromNullable(g.ReleasedPhone)
+	if err != nil {
+		return nil, err
+	}
+
+	return member.ReconstructMemberMerge(
+		g.MergeID,
+		primaryID,
+		secondaryID,
+		g.Reason,
+		g.OperatorID,
+		releasedPhone,
+		member.MergeTransfer{
+			EarnedPoints:    g.EarnedPoints,
+			UsedPoints:      g.UsedPoints,
+			Transactions:    g.Transactions,
+			SurveyResponses: g.SurveyResponses,
+			Adjustments:     g.Adjustments,
+		},
+		g.MergedAt,
+	), nil
+}
+
+// toMemberMergeGORM 將合併紀錄轉換為 GORM 模型
+func toMemberMergeGORM(m *member.MemberMerge) *MemberMergeGORM {
+	transfer := m.Transfer()
+	return &MemberMergeGORM{
+		MergeID:           m.MergeID(),
+		PrimaryMemberID:   m.PrimaryMemberID().String(),
+		SecondaryMemberID: m.SecondaryMemberID().String(),
+		Reason:            m.Reason(),
+		OperatorID:        m.OperatorID(),
+		ReleasedPhone:     phoneToNullable(m.ReleasedPhoneNumber()),
+		EarnedPoints:      transfer.EarnedPoints,
+		UsedPoints:        transfer.UsedPoints,
+		Transactions:      transfer.Transactions,
+		SurveyResponses:   transfer.SurveyResponses,
+		Adjustments:       transfer.Adjustments,
+		MergedAt:          m.MergedAt(),
 	}
 }
@@ -0,0 +1,63 @@
+package member
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// PhoneNumberChangeRepositoryImpl
+// ===========================
+
+// PhoneNumberChangeRepositoryImpl 手機號碼異動紀錄倉儲實現（GORM）
+//
+// 設計原則：
+// - 實作 member.PhoneNumberChangeRepository 接口
+// - 僅新增（稽核軌跡不可修改或刪除）
+type PhoneNumberChangeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewPhoneNumberChangeRepository 創建手機號碼異動紀錄倉儲實例
+func NewPhoneNumberChangeRepository(db *gorm.DB) member.PhoneNumberChangeRepository {
+	return &PhoneNumberChangeRepositoryImpl{db: db}
+}
+
+// Save 新增異動紀錄
+func (r *PhoneNumberChangeRepositoryImpl) Save(ctx shared.TransactionContext, change *member.PhoneNumberChange) error {
+	return r.getDB(ctx).Create(toPhoneNumberChangeGORM(change)).Error
+}
+
+// FindByMemberID 查詢會員的異動紀錄（新到舊）
+func (r *PhoneNumberChangeRepositoryImpl) FindByMemberID(
+	ctx shared.TransactionContext,
+	memberID member.MemberID,
+) ([]*member.PhoneNumberChange, error) {
+	var models []PhoneNumberChangeGORM
+	err := r.getDB(ctx).
+		Where("member_id = ?", memberID.String()).
+		Order("changed_at DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*member.PhoneNumberChange, 0, len(models))
+	for i := range models {
+		change, err := models[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// getDB 獲取 GORM DB 實例（事務中使用事務連線）
+func (r *PhoneNumberChangeRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if gormCtx, ok := ctx.(gormTransactionContext); ok {
+		return gormCtx.GetDB()
+	}
+	return r.db
+}
@@ -0,0 +1,61 @@
+package persistence
+
+import (
+	"fmt"
+
+	adminpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/admin"
+	broadcastpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/broadcast"
+	conversationpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/conversation"
+	externalpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/external"
+	fraudpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/fraud"
+	invoicepersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/invoice"
+	memberpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/member"
+	notificationpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/notification"
+	pointspersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/points"
+	surveypersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/survey"
+	tierpersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/tier"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// Schema 遷移
+// ===========================
+
+// Models 所有 Bounded Context 的 GORM 模型（依資料表相依順序）
+func Models() []interface{} {
+	return []interface{}{
+		&memberpersistence.MemberGORM{},
+		&memberpersistence.PhoneNumberChangeGORM{},
+		&memberpersistence.MemberMergeGORM{},
+		&pointspersistence.PointsAccountGORM{},
+		&pointspersistence.PointsAdjustmentGORM{},
+		&pointspersistence.BirthdayBonusGrantGORM{},
+		&pointspersistence.RewardGORM{},
+		&invoicepersistence.InvoiceTransactionGORM{},
+		&fraudpersistence.FraudCaseGORM{},
+		&externalpersistence.DiscrepancyReviewGORM{},
+		&tierpersistence.MemberTierGORM{},
+		&surveypersistence.SurveyGORM{},
+		&surveypersistence.SurveyQuestionGORM{},
+		&surveypersistence.SurveyTokenUsageGORM{},
+		&surveypersistence.SurveyResponseGORM{},
+		&surveypersistence.SurveyAnswerGORM{},
+		&conversationpersistence.RegistrationSessionGORM{},
+		&notificationpersistence.NotificationGORM{},
+		&notificationpersistence.NotificationSourceEventGORM{},
+		&notificationpersistence.NotificationPreferenceGORM{},
+		&broadcastpersistence.CampaignGORM{},
+		&broadcastpersistence.RecipientGORM{},
+		&broadcastpersistence.ExclusionGORM{},
+		&adminpersistence.AdminUserGORM{},
+		&adminpersistence.AdminSessionGORM{},
+	}
+}
+
+// AutoMigrate 建立 / 更新所有資料表（啟動時執行）
+func AutoMigrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(Models()...); err != nil {
+		return fmt.Errorf("failed to migrate database schema: %w", err)
+	}
+	return nil
+}
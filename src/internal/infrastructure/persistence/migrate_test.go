@@ -0,0 +1,35 @@
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// TestAutoMigrate_CreatesAllTablesIdempotently 驗證啟動時的 Schema 遷移
+//
+// 測試場景：
+// 1. 空資料庫建立所有資料表（索引名稱在同一資料庫內不可重複）
+// 2. 重複執行不報錯（每次啟動都會執行）
+func TestAutoMigrate_CreatesAllTablesIdempotently(t *testing.T) {
+	// Arrange
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	// Act
+	first := AutoMigrate(db)
+	second := AutoMigrate(db)
+
+	// Assert
+	require.NoError(t, first)
+	require.NoError(t, second)
+	for _, model := range Models() {
+		assert.True(t, db.Migrator().HasTable(model), "missing table for %T", model)
+	}
+}
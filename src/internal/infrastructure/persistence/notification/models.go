@@ -0,0 +1,191 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/notification"
+)
+
+// ===========================
+// GORM Models
+// ===========================
+
+// NotificationGORM 通知資料表模型
+//
+// 資料庫約束：
+// - notification_id: 主鍵（UUID）
+// - member_id + status: 複合索引（查詢會員 pending 通知以合併）
+// - status + scheduled_at: 複合索引（排程查詢到期通知）
+type NotificationGORM struct {
+	// 識別欄位
+	NotificationID string `gorm:"column:notification_id;type:varchar(36);primaryKey"`
+	MemberID       string `gorm:"column:member_id;type:varchar(36);not null;index:idx_notifications_member_status,priority:1"`
+
+	// 通知內容
+	Type            string `gorm:"column:type;type:varchar(30);not null"`
+	EarnedPoints    int    `gorm:"column:earned_points;not null"`
+	DeductedPoints  int    `gorm:"column:deducted_points;not null"`
+	AvailablePoints int    `gorm:"column:available_points;not null"`
+
+	// 來源事件（依 position 排序）
+	SourceEvents []NotificationSourceEventGORM `gorm:"foreignKey:NotificationID;references:NotificationID"`
+
+	// 投遞狀態
+	Status      string     `gorm:"column:status;type:varchar(20);not null;index:idx_notifications_member_status,priority:2;index:idx_notifications_due,priority:1"`
+	ScheduledAt time.Time  `gorm:"column:scheduled_at;not null;index:idx_notifications_due,priority:2"`
+	Attempts    int        `gorm:"column:attempts;not null"`
+	LastError   string     `gorm:"column:last_error;type:text"`
+	SentAt      *time.Time `gorm:"column:sent_at"`
+	LastEventAt time.Time  `gorm:"column:last_event_at;not null"`
+
+	// 審計欄位
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+}
+
+// TableName 指定資料表名稱
+func (NotificationGORM) TableName() string {
+	return "notifications"
+}
+
+// NotificationSourceEventGORM 通知來源事件資料表模型
+//
+// 資料庫約束：
+// - event_id: 主鍵（同一領域事件只會出現在一則通知中，事件重送去重）
+// - notification_id + position: 唯一索引
+type NotificationSourceEventGORM struct {
+	EventID        string `gorm:"column:event_id;type:varchar(36);primaryKey"`
+	NotificationID string `gorm:"column:notification_id;type:varchar(36);not null;uniqueIndex:idx_notification_source_events_position,priority:1"`
+	Position       int    `gorm:"column:position;not null;uniqueIndex:idx_notification_source_events_position,priority:2"`
+}
+
+// TableName 指定資料表名稱
+func (NotificationSourceEventGORM) TableName() string {
+	return "notification_source_events"
+}
+
+// NotificationPreferenceGORM 通知偏好資料表模型
+//
+// 資料庫約束：
+// - member_id: 主鍵（每位會員最多一筆）
+// - quiet_start_minute / quiet_end_minute: 當日分鐘數（相同表示不啟用勿擾時段）
+type NotificationPreferenceGORM struct {
+	MemberID              string `gorm:"column:member_id;type:varchar(36);primaryKey"`
+	PointsActivityEnabled bool   `gorm:"column:points_activity_enabled;not null"`
+	QuietStartMinute      int    `gorm:"column:quiet_start_minute;not null"`
+	QuietEndMinute        int    `gorm:"column:quiet_end_minute;not null"`
+	Locale                string `gorm:"column:locale;type:varchar(10);not null"`
+
+	// 審計欄位
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+}
+
+// TableName 指定資料表名稱
+func (NotificationPreferenceGORM) TableName() string {
+	return "notification_preferences"
+}
+
+// ===========================
+// Mapper Functions
+// ===========================
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+func (g *NotificationGORM) toDomain() (*notification.Notification, error) {
+	notificationID, err := notification.NotificationIDFromString(g.NotificationID)
+	if err != nil {
+		return nil, err
+	}
+	memberID, err := notification.MemberIDFromString(g.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	eventIDs := make([]string, 0, len(g.SourceEvents))
+	for _, e := range g.SourceEvents {
+		eventIDs = append(eventIDs, e.EventID)
+	}
+
+	return notification.ReconstructNotification(
+		notificationID,
+		memberID,
+		notification.NotificationType(g.Type),
+		notification.NotificationStatus(g.Status),
+		g.EarnedPoints,
+		g.DeductedPoints,
+		g.AvailablePoints,
+		eventIDs,
+		g.LastEventAt,
+		g.ScheduledAt,
+		g.Attempts,
+		g.LastError,
+		g.SentAt,
+		g.CreatedAt,
+		g.UpdatedAt,
+	)
+}
+
+// toGORM 將 Domain 模型轉換為 GORM 模型
+func toGORM(n *notification.Notification) *NotificationGORM {
+	eventIDs := n.SourceEventIDs()
+	sourceEvents := make([]NotificationSourceEventGORM, 0, len(eventIDs))
+	for i, id := range eventIDs {
+		sourceEvents = append(sourceEvents, NotificationSourceEventGORM{
+			EventID:        id,
+			NotificationID: n.NotificationID().String(),
+			Position:       i + 1,
+		})
+	}
+
+	return &NotificationGORM{
+		NotificationID:  n.NotificationID().String(),
+		MemberID:        n.MemberID().String(),
+		Type:            n.Type().String(),
+		EarnedPoints:    n.EarnedPoints(),
+		DeductedPoints:  n.DeductedPoints(),
+		AvailablePoints: n.AvailablePoints(),
+		SourceEvents:    sourceEvents,
+		Status:          n.Status().String(),
+		ScheduledAt:     n.ScheduledAt(),
+		Attempts:        n.Attempts(),
+		LastError:       n.LastError(),
+		SentAt:          n.SentAt(),
+		LastEventAt:     n.LastEventAt(),
+		CreatedAt:       n.CreatedAt(),
+		UpdatedAt:       n.UpdatedAt(),
+	}
+}
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+func (g *NotificationPreferenceGORM) toDomain() (*notification.NotificationPreference, error) {
+	memberID, err := notification.MemberIDFromString(g.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	quietHours, err := notification.NewQuietHours(g.QuietStartMinute, g.QuietEndMinute)
+	if err != nil {
+		return nil, err
+	}
+
+	return notification.ReconstructNotificationPreference(
+		memberID,
+		g.PointsActivityEnabled,
+		quietHours,
+		notification.Locale(g.Locale),
+		g.CreatedAt,
+		g.UpdatedAt,
+	)
+}
+
+// toPreferenceGORM 將 Domain 模型轉換為 GORM 模型
+func toPreferenceGORM(p *notification.NotificationPreference) *NotificationPreferenceGORM {
+	return &NotificationPreferenceGORM{
+		MemberID:              p.MemberID().String(),
+		PointsActivityEnabled: p.PointsActivityEnabled(),
+		QuietStartMinute:      p.QuietHours().StartMinute(),
+		QuietEndMinute:        p.QuietHours().EndMinute(),
+		Locale:                p.Locale().String(),
+		CreatedAt:             p.CreatedAt(),
+		UpdatedAt:             p.UpdatedAt(),
+	}
+}
@@ -0,0 +1,64 @@
+package notification
+
+import (
+	"errors"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/notification"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// NotificationPreferenceRepositoryImpl
+// ===========================
+
+// NotificationPreferenceRepositoryImpl 通知偏好倉儲實現（GORM）
+type NotificationPreferenceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewNotificationPreferenceRepository 創建新的通知偏好倉儲實例
+func NewNotificationPreferenceRepository(db *gorm.DB) notification.NotificationPreferenceRepository {
+	return &NotificationPreferenceRepositoryImpl{db: db}
+}
+
+// Save 保存通知偏好（主鍵存在時覆蓋）
+func (r *NotificationPreferenceRepositoryImpl) Save(ctx shared.TransactionContext, p *notification.NotificationPreference) error {
+	return r.getDB(ctx).Save(toPreferenceGORM(p)).Error
+}
+
+// FindByMemberID 查詢會員通知偏好
+func (r *NotificationPreferenceRepositoryImpl) FindByMemberID(
+	ctx shared.TransactionContext,
+	memberID notification.MemberID,
+) (*notification.NotificationPreference, error) {
+	var gormModel NotificationPreferenceGORM
+	result := r.getDB(ctx).Where("member_id = ?", memberID.String()).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, notification.ErrPreferenceNotFound.WithContext(
+				"member_id", memberID.String(),
+			)
+		}
+		return nil, result.Error
+	}
+	return gormModel.toDomain()
+}
+
+// ===========================
+// Helper Methods
+// ===========================
+
+// getDB 獲取 GORM DB 實例
+//
+// 行為：
+//   - ctx != nil: 使用事務中的 DB（從 TransactionContext 獲取）
+//   - ctx == nil: 使用預設 DB（auto-commit 模式）
+func (r *NotificationPreferenceRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
@@ -0,0 +1,153 @@
+package notification
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/notification"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// gormTransactionContext GORM 事務上下文
+type gormTransactionContext interface {
+	shared.TransactionContext
+	GetDB() *gorm.DB
+}
+
+// ===========================
+// NotificationRepositoryImpl
+// ===========================
+
+// NotificationRepositoryImpl 通知倉儲實現（GORM）
+//
+// 設計原則：
+// - 通知與來源事件以聚合為單位保存（notifications + notification_source_events）
+// - 來源事件整批替換（合併事件時追加）
+type NotificationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository 創建新的通知倉儲實例
+func NewNotificationRepository(db *gorm.DB) notification.NotificationRepository {
+	return &NotificationRepositoryImpl{db: db}
+}
+
+// Save 保存通知（新增或更新，含來源事件）
+func (r *NotificationRepositoryImpl) Save(ctx shared.TransactionContext, n *notification.Notification) error {
+	gormModel := toGORM(n)
+
+	return r.getDB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("SourceEvents").Save(gormModel).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("notification_id = ?", gormModel.NotificationID).Delete(&NotificationSourceEventGORM{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&gormModel.SourceEvents).Error
+	})
+}
+
+// FindByID 根據 ID 查詢通知
+func (r *NotificationRepositoryImpl) FindByID(
+	ctx shared.TransactionContext,
+	id notification.NotificationID,
+) (*notification.Notification, error) {
+	var gormModel NotificationGORM
+	result := r.withSourceEvents(r.getDB(ctx)).Where("notification_id = ?", id.String()).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, notification.ErrNotificationNotFound.WithContext(
+				"notification_id", id.String(),
+			)
+		}
+		return nil, result.Error
+	}
+	return gormModel.toDomain()
+}
+
+// FindPendingByMemberID 查詢會員最新一則 pending 通知
+func (r *NotificationRepositoryImpl) FindPendingByMemberID(
+	ctx shared.TransactionContext,
+	memberID notification.MemberID,
+	notificationType notification.NotificationType,
+) (*notification.Notification, error) {
+	var gormModel NotificationGORM
+	result := r.withSourceEvents(r.getDB(ctx)).
+		Where("member_id = ? AND status = ? AND type = ?",
+			memberID.String(), notification.NotificationStatusPending.String(), notificationType.String()).
+		Order("created_at DESC").
+		First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, notification.ErrNotificationNotFound.WithContext(
+				"member_id", memberID.String(),
+				"status", notification.NotificationStatusPending.String(),
+			)
+		}
+		return nil, result.Error
+	}
+	return gormModel.toDomain()
+}
+
+// FindDue 查詢已到推播時間的 pending 通知（依預計推播時間排序）
+func (r *NotificationRepositoryImpl) FindDue(
+	ctx shared.TransactionContext,
+	now time.Time,
+	limit int,
+) ([]*notification.Notification, error) {
+	var gormModels []NotificationGORM
+	result := r.withSourceEvents(r.getDB(ctx)).
+		Where("status = ? AND scheduled_at <= ?", notification.NotificationStatusPending.String(), now).
+		Order("scheduled_at ASC").
+		Limit(limit).
+		Find(&gormModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	notifications := make([]*notification.Notification, 0, len(gormModels))
+	for i := range gormModels {
+		n, err := gormModels[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, nil
+}
+
+// ExistsBySourceEventID 檢查事件是否已產生通知
+func (r *NotificationRepositoryImpl) ExistsBySourceEventID(ctx shared.TransactionContext, eventID string) (bool, error) {
+	var count int64
+	result := r.getDB(ctx).Model(&NotificationSourceEventGORM{}).Where("event_id = ?", eventID).Count(&count)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return count > 0, nil
+}
+
+// ===========================
+// Helper Methods
+// ===========================
+
+// withSourceEvents 預載來源事件（依 position 排序）
+func (r *NotificationRepositoryImpl) withSourceEvents(db *gorm.DB) *gorm.DB {
+	return db.Preload("SourceEvents", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	})
+}
+
+// getDB 獲取 GORM DB 實例
+//
+// 行為：
+//   - ctx != nil: 使用事務中的 DB（從 TransactionContext 獲取）
+//   - ctx == nil: 使用預設 DB（auto-commit 模式）
+func (r *NotificationRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
@@ -0,0 +1,133 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// Notification Repository Integration Tests
+// ===========================
+
+const testMemberID = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+
+// setupTestDB 創建測試資料庫（in-memory SQLite）
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err, "failed to connect to test database")
+
+	err = db.AutoMigrate(&NotificationGORM{}, &NotificationSourceEventGORM{}, &NotificationPreferenceGORM{})
+	require.NoError(t, err, "failed to migrate database schema")
+
+	return db
+}
+
+func newPendingNotification(t *testing.T, eventID string, now time.Time) *notification.Notification {
+	t.Helper()
+	memberID, err := notification.MemberIDFromString(testMemberID)
+	require.NoError(t, err)
+	n, err := notification.NewPointsActivityNotification(memberID, notification.PointsChange{
+		EventID:         eventID,
+		Earned:          3,
+		AvailablePoints: 103,
+		OccurredAt:      now,
+	}, now.Add(notification.BatchWindow), now)
+	require.NoError(t, err)
+	return n
+}
+
+// Test 1: 保存、合併後更新並重建（含來源事件順序）
+func TestNotificationRepository_SaveMergeAndFind(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewNotificationRepository(db)
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+	n := newPendingNotification(t, "event-1", now)
+	require.NoError(t, repo.Save(nil, n))
+
+	// Act
+	pending, err := repo.FindPendingByMemberID(nil, n.MemberID(), notification.NotificationTypePointsActivity)
+	require.NoError(t, err)
+	_, err = pending.MergeAt(notification.PointsChange{
+		EventID:         "event-2",
+		Deducted:        50,
+		AvailablePoints: 53,
+		OccurredAt:      now.Add(time.Minute),
+	}, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(nil, pending))
+
+	// Assert
+	found, err := repo.FindByID(nil, n.NotificationID())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"event-1", "event-2"}, found.SourceEventIDs())
+	assert.Equal(t, 3, found.EarnedPoints())
+	assert.Equal(t, 50, found.DeductedPoints())
+	assert.Equal(t, 53, found.AvailablePoints())
+	assert.Equal(t, notification.NotificationStatusPending, found.Status())
+
+	exists, err := repo.ExistsBySourceEventID(nil, "event-2")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = repo.ExistsBySourceEventID(nil, "event-3")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+// Test 2: 查詢到期通知（排除未到期與已推播）
+func TestNotificationRepository_FindDue(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewNotificationRepository(db)
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+
+	due := newPendingNotification(t, "event-due", now)
+	notYetDue := newPendingNotification(t, "event-later", now.Add(time.Hour))
+	sent := newPendingNotification(t, "event-sent", now)
+	require.NoError(t, sent.MarkSentAt(now.Add(notification.BatchWindow)))
+	for _, n := range []*notification.Notification{due, notYetDue, sent} {
+		require.NoError(t, repo.Save(nil, n))
+	}
+
+	// Act
+	found, err := repo.FindDue(nil, now.Add(10*time.Minute), 10)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, due.NotificationID(), found[0].NotificationID())
+}
+
+// Test 3: 通知偏好保存與查詢（含關閉通知的零值）
+func TestNotificationPreferenceRepository_SaveAndFind(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewNotificationPreferenceRepository(db)
+	memberID, err := notification.MemberIDFromString(testMemberID)
+	require.NoError(t, err)
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+
+	_, err = repo.FindByMemberID(nil, memberID)
+	assert.ErrorIs(t, err, notification.ErrPreferenceNotFound)
+
+	preference := notification.DefaultNotificationPreference(memberID, now)
+	quiet, err := notification.NewQuietHours(23*60, 8*60)
+	require.NoError(t, err)
+	preference.UpdateAt(false, quiet, notification.LocaleEn, now.Add(time.Hour))
+
+	// Act
+	require.NoError(t, repo.Save(nil, preference))
+	found, err := repo.FindByMemberID(nil, memberID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, found.PointsActivityEnabled())
+	assert.Equal(t, "23:00-08:00", found.QuietHours().String())
+	assert.Equal(t, notification.LocaleEn, found.Locale())
+}
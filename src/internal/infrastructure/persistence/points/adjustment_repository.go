@@ -0,0 +1,121 @@
+package points
+
+import (
+	"errors"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// PointsAdjustmentRepositoryImpl
+// ===========================
+
+// PointsAdjustmentRepositoryImpl 積分人工調整倉儲實現（GORM）
+type PointsAdjustmentRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewPointsAdjustmentRepository 創建新的積分調整倉儲實例
+func NewPointsAdjustmentRepository(db *gorm.DB) points.PointsAdjustmentRepository {
+	return &PointsAdjustmentRepositoryImpl{db: db}
+}
+
+// Save 保存積分調整（新增或更新）
+func (r *PointsAdjustmentRepositoryImpl) Save(ctx shared.TransactionContext, adjustment *points.PointsAdjustment) error {
+	return r.getDB(ctx).Save(toAdjustmentGORM(adjustment)).Error
+}
+
+// FindByID 根據 ID 查詢積分調整
+func (r *PointsAdjustmentRepositoryImpl) FindByID(
+	ctx shared.TransactionContext,
+	adjustmentID points.AdjustmentID,
+) (*points.PointsAdjustment, error) {
+	var gormModel PointsAdjustmentGORM
+	result := r.getDB(ctx).Where("adjustment_id = ?", adjustmentID.String()).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, points.ErrAdjustmentNotFound.WithContext("adjustment_id", adjustmentID.String())
+		}
+		return nil, result.Error
+	}
+	return gormModel.toDomain()
+}
+
+// FindByStatus 查詢指定狀態的積分調整（依申請時間排序）
+func (r *PointsAdjustmentRepositoryImpl) FindByStatus(
+	ctx shared.TransactionContext,
+	status points.AdjustmentStatus,
+	limit int,
+) ([]*points.PointsAdjustment, error) {
+	var gormModels []PointsAdjustmentGORM
+	err := r.getDB(ctx).
+		Where("status = ?", status.String()).
+		Order("requested_at ASC").
+		Limit(limit).
+		Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return toAdjustmentDomains(gormModels)
+}
+
+// FindByMemberID 查詢會員的積分調整紀錄（依申請時間新到舊）
+func (r *PointsAdjustmentRepositoryImpl) FindByMemberID(
+	ctx shared.TransactionContext,
+	memberID points.MemberID,
+	limit int,
+) ([]*points.PointsAdjustment, error) {
+	var gormModels []PointsAdjustmentGORM
+	err := r.getDB(ctx).
+		Where("member_id = ?", memberID.String()).
+		Order("requested_at DESC").
+		Limit(limit).
+		Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return toAdjustmentDomains(gormModels)
+}
+
+// FindByRequestedBy 查詢指定管理員申請的積分調整（依申請時間新到舊）
+func (r *PointsAdjustmentRepositoryImpl) FindByRequestedBy(
+	ctx shared.TransactionContext,
+	requestedBy string,
+	limit int,
+) ([]*points.PointsAdjustment, error) {
+	var gormModels []PointsAdjustmentGORM
+	err := r.getDB(ctx).
+		Where("requested_by = ?", requestedBy).
+		Order("requested_at DESC").
+		Limit(limit).
+		Find(&gormModels).Error
+	if err != nil {
+		return nil, err
+	}
+	return toAdjustmentDomains(gormModels)
+}
+
+// toAdjustmentDomains 批次轉換為 Domain 模型
+func toAdjustmentDomains(gormModels []PointsAdjustmentGORM) ([]*points.PointsAdjustment, error) {
+	adjustments := make([]*points.PointsAdjustment, 0, len(gormModels))
+	for i := range gormModels {
+		adjustment, err := gormModels[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		adjustments = append(adjustments, adjustment)
+	}
+	return adjustments, nil
+}
+
+// getDB 獲取 GORM DB 實例（事務中使用事務連線）
+func (r *PointsAdjustmentRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
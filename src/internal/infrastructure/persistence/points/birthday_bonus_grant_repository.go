@@ -0,0 +1,61 @@
+package points
+
+import (
+	"strconv"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// BirthdayBonusGrantRepositoryImpl
+// ===========================
+
+// BirthdayBonusGrantRepositoryImpl 生日禮發放紀錄倉儲實現（GORM）
+type BirthdayBonusGrantRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewBirthdayBonusGrantRepository 創建生日禮發放紀錄倉儲實例
+func NewBirthdayBonusGrantRepository(db *gorm.DB) points.BirthdayBonusGrantRepository {
+	return &BirthdayBonusGrantRepositoryImpl{db: db}
+}
+
+// Save 新增發放紀錄（重複發放時返回 ErrBirthdayBonusAlreadyGranted）
+func (r *BirthdayBonusGrantRepositoryImpl) Save(ctx shared.TransactionContext, grant *points.BirthdayBonusGrant) error {
+	err := r.getDB(ctx).Create(toBirthdayBonusGrantGORM(grant)).Error
+	if err != nil && isUniqueConstraintError(err) {
+		return points.ErrBirthdayBonusAlreadyGranted.WithContext(
+			"member_id", grant.MemberID().String(),
+			"year", strconv.Itoa(grant.Year()),
+		)
+	}
+	return err
+}
+
+// ExistsForYear 檢查會員當年度是否已發放
+func (r *BirthdayBonusGrantRepositoryImpl) ExistsForYear(
+	ctx shared.TransactionContext,
+	memberID points.MemberID,
+	year int,
+) (bool, error) {
+	var count int64
+	err := r.getDB(ctx).Model(&BirthdayBonusGrantGORM{}).
+		Where("member_id = ? AND year = ?", memberID.String(), year).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// getDB 獲取 GORM DB 實例（事務中使用事務連線）
+func (r *BirthdayBonusGrantRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
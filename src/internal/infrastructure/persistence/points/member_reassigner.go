@@ -0,0 +1,58 @@
+package points
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// AdjustmentReassignerImpl
+// ===========================
+
+// AdjustmentReassignerImpl 積分調整紀錄改歸屬實現（會員合併）
+//
+// 設計原則：
+// - 實作 member.MemberHistoryReassigner 接口
+// - member_id 與 account_id 一併改為保留會員的積分帳戶（待核准的調整核准後入帳到保留帳戶）
+// - 保留會員必須已有積分帳戶（由 Application Layer 在同一事務內先行確認）
+type AdjustmentReassignerImpl struct {
+	db *gorm.DB
+}
+
+// NewAdjustmentReassigner 創建積分調整紀錄改歸屬實例
+func NewAdjustmentReassigner(db *gorm.DB) member.MemberHistoryReassigner {
+	return &AdjustmentReassignerImpl{db: db}
+}
+
+// ReassignMember 將 from 會員的所有積分調整紀錄改歸屬 to 會員
+func (r *AdjustmentReassignerImpl) ReassignMember(
+	ctx shared.TransactionContext,
+	from, to member.MemberID,
+) (int, error) {
+	db := r.getDB(ctx)
+	accountID := db.Model(&PointsAccountGORM{}).
+		Select("account_id").
+		Where("member_id = ?", to.String())
+
+	result := db.Model(&PointsAdjustmentGORM{}).
+		Where("member_id = ?", from.String()).
+		UpdateColumns(map[string]interface{}{
+			"member_id":  to.String(),
+			"account_id": gorm.Expr("(?)", accountID),
+		})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(result.RowsAffected), nil
+}
+
+// getDB 獲取 GORM DB 實例（ctx 可為 nil）
+func (r *AdjustmentReassignerImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
@@ -24,6 +24,7 @@ import (
 // - earned_points: 累積獲得積分（>= 0）
 // - used_points: 累積使用積分（>= 0）
 // - 業務不變條件：used_points <= earned_points（在 Application 層保證）
+// - version: 樂觀鎖版本號（Update 以載入時版本號比對）
 type PointsAccountGORM struct {
 	// 識別欄位
 	AccountID string `gorm:"column:account_id;type:varchar(36);primaryKey"` // UUID 字串
@@ -33,10 +34,17 @@ type PointsAccountGORM struct {
 	EarnedPoints int `gorm:"column:earned_points;not null;default:0;check:earned_points >= 0"`
 	UsedPoints   int `gorm:"column:used_points;not null;default:0;check:used_points >= 0"`
 
+	// 凍結狀態（詐騙調查）
+	Frozen       bool       `gorm:"column:frozen;not null;default:false"`
+	FreezeReason string     `gorm:"column:freeze_reason;type:varchar(255)"`
+	FrozenBy     string     `gorm:"column:frozen_by;type:varchar(100)"`
+	FrozenAt     *time.Time `gorm:"column:frozen_at"` // Nullable
+
 	// 審計欄位
 	CreatedAt time.Time      `gorm:"column:created_at;not null"`
 	UpdatedAt time.Time      `gorm:"column:updated_at;not null"`
-	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"` // 軟刪除
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"`           // 軟刪除
+	Version   int            `gorm:"column:version;not null;default:1"` // 樂觀鎖
 }
 
 // TableName 指定資料表名稱
@@ -75,7 +83,29 @@ func (g *PointsAccountGORM) toDomain() (*points.PointsAccount, error) {
 		return nil, err
 	}
 
-	// 3. 重建 Domain 聚合（使用 int，由 ReconstructPointsAccount 內部轉換）
+	// 3. 凍結中的帳戶需一併重建凍結資訊
+	if g.Frozen {
+		var frozenAt time.Time
+		if g.FrozenAt != nil {
+			frozenAt = *g.FrozenAt
+		}
+		freeze, err := points.NewAccountFreeze(g.FreezeReason, g.FrozenBy, frozenAt)
+		if err != nil {
+			return nil, err
+		}
+		return points.ReconstructFrozenPointsAccount(
+			accountID,
+			memberID,
+			g.EarnedPoints,
+			g.UsedPoints,
+			freeze,
+			g.CreatedAt,
+			g.UpdatedAt,
+			g.Version,
+		)
+	}
+
+	// 4. 重建 Domain 聚合（使用 int，由 ReconstructPointsAccount 內部轉換）
 	return points.ReconstructPointsAccount(
 		accountID,
 		memberID,
@@ -83,6 +113,7 @@ func (g *PointsAccountGORM) toDomain() (*points.PointsAccount, error) {
 		g.UsedPoints,
 		g.CreatedAt,
 		g.UpdatedAt,
+		g.Version,
 	)
 }
 
@@ -99,13 +130,185 @@ func (g *PointsAccountGORM) toDomain() (*points.PointsAccount, error) {
 //   - MemberID: MemberID 值對象 → 字串
 //   - EarnedPoints: PointsAmount 值對象 → int
 //   - UsedPoints: PointsAmount 值對象 → int
+//   - FreezeInfo: AccountFreeze 值對象 → frozen / freeze_reason / frozen_by / frozen_at
 func toGORM(account *points.PointsAccount) *PointsAccountGORM {
-	return &PointsAccountGORM{
+	model := &PointsAccountGORM{
 		AccountID:    account.AccountID().String(),
 		MemberID:     account.MemberID().String(),
 		EarnedPoints: account.EarnedPoints().Value(),
 		UsedPoints:   account.UsedPoints().Value(),
 		CreatedAt:    account.CreatedAt(),
 		UpdatedAt:    account.UpdatedAt(),
+		Version:      account.Version(),
+	}
+
+	if freeze := account.FreezeInfo(); freeze != nil {
+		frozenAt := freeze.FrozenAt()
+		model.Frozen = true
+		model.FreezeReason = freeze.Reason()
+		model.FrozenBy = freeze.FrozenBy()
+		model.FrozenAt = &frozenAt
+	}
+
+	return model
+}
+
+// PointsAdjustmentGORM 積分人工調整資料表模型（審計紀錄，不刪除）
+//
+// 資料庫約束：
+// - adjustment_id: 主鍵（UUID）
+// - member_id: 索引（會員調整紀錄查詢）
+// - status + requested_at: 複合索引（待核准清單）
+// - account_event_id: 入帳時的帳戶事件 ID（points.adjusted）
+type PointsAdjustmentGORM struct {
+	AdjustmentID string `gorm:"column:adjustment_id;type:varchar(36);primaryKey"`
+	AccountID    string `gorm:"column:account_id;type:varchar(36);not null"`
+	MemberID     string `gorm:"column:member_id;type:varchar(36);index;not null"`
+
+	Delta            int    `gorm:"column:delta;not null"`
+	Reason           string `gorm:"column:reason;type:varchar(32);not null"`
+	Note             string `gorm:"column:note;type:text;not null"`
+	RequiresApproval bool   `gorm:"column:requires_approval;not null"`
+
+	Status      string     `gorm:"column:status;type:varchar(20);index:idx_points_adjustments_status;not null"`
+	RequestedBy string     `gorm:"column:requested_by;type:varchar(100);index;not null"`
+	RequestedAt time.Time  `gorm:"column:requested_at;index:idx_points_adjustments_status;not null"`
+	ReviewedBy  string     `gorm:"column:reviewed_by;type:varchar(100)"`
+	ReviewNote  string     `gorm:"column:review_note;type:text"`
+	ReviewedAt  *time.Time `gorm:"column:reviewed_at"`
+
+	AccountEventID string     `gorm:"column:account_event_id;type:varchar(36)"`
+	AppliedAt      *time.Time `gorm:"column:applied_at"`
+
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+}
+
+// TableName 指定資料表名稱
+func (PointsAdjustmentGORM) TableName() string {
+	return "points_adjustments"
+}
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+func (g *PointsAdjustmentGORM) toDomain() (*points.PointsAdjustment, error) {
+	adjustmentID, err := points.AdjustmentIDFromString(g.AdjustmentID)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := points.AccountIDFromString(g.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	memberID, err := points.MemberIDFromString(g.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return points.ReconstructPointsAdjustment(
+		adjustmentID,
+		accountID,
+		memberID,
+		g.Delta,
+		points.AdjustmentReason(g.Reason),
+		g.Note,
+		g.RequiresApproval,
+		points.AdjustmentStatus(g.Status),
+		g.RequestedBy,
+		g.RequestedAt,
+		g.ReviewedBy,
+		g.ReviewNote,
+		g.ReviewedAt,
+		g.AccountEventID,
+		g.AppliedAt,
+		g.UpdatedAt,
+	)
+}
+
+// toAdjustmentGORM 將 Domain 模型轉換為 GORM 模型
+func toAdjustmentGORM(a *points.PointsAdjustment) *PointsAdjustmentGORM {
+	return &PointsAdjustmentGORM{
+		AdjustmentID:     a.AdjustmentID().String(),
+		AccountID:        a.AccountID().String(),
+		MemberID:         a.MemberID().String(),
+		Delta:            a.Delta(),
+		Reason:           a.Reason().String(),
+		Note:             a.Note(),
+		RequiresApproval: a.RequiresApproval(),
+		Status:           a.Status().String(),
+		RequestedBy:      a.RequestedBy(),
+		RequestedAt:      a.RequestedAt(),
+		ReviewedBy:       a.ReviewedBy(),
+		ReviewNote:       a.ReviewNote(),
+		ReviewedAt:       a.ReviewedAt(),
+		AccountEventID:   a.AccountEventID(),
+		AppliedAt:        a.AppliedAt(),
+		UpdatedAt:        a.UpdatedAt(),
+	}
+}
+
+// BirthdayBonusGrantGORM 生日禮發放紀錄資料表模型（僅新增）
+//
+// 資料庫約束：
+// - member_id + year: 複合主鍵（每位會員每年一筆，排程重跑時保持冪等）
+type BirthdayBonusGrantGORM struct {
+	MemberID  string    `gorm:"column:member_id;type:varchar(36);primaryKey"`
+	Year      int       `gorm:"column:year;primaryKey;autoIncrement:false"`
+	AccountID string    `gorm:"column:account_id;type:varchar(36);not null"`
+	Points    int       `gorm:"column:points;not null"`
+	GrantedAt time.Time `gorm:"column:granted_at;not null"`
+}
+
+// TableName 指定資料表名稱
+func (BirthdayBonusGrantGORM) TableName() string {
+	return "birthday_bonus_grants"
+}
+
+// toBirthdayBonusGrantGORM 將 Domain 模型轉換為 GORM 模型
+func toBirthdayBonusGrantGORM(g *points.BirthdayBonusGrant) *BirthdayBonusGrantGORM {
+	return &BirthdayBonusGrantGORM{
+		MemberID:  g.MemberID().String(),
+		Year:      g.Year(),
+		AccountID: g.AccountID().String(),
+		Points:    g.Points().Value(),
+		GrantedAt: g.GrantedAt(),
+	}
+}
+
+// RewardGORM 兌換目錄資料表模型
+//
+// 資料庫約束：
+// - reward_id: 主鍵（UUID）
+// - alcoholic: 酒類獎勵（兌換前需確認會員已驗證成年）
+type RewardGORM struct {
+	RewardID   string    `gorm:"column:reward_id;type:varchar(36);primaryKey"`
+	Name       string    `gorm:"column:name;type:varchar(100);not null"`
+	PointsCost int       `gorm:"column:points_cost;not null"`
+	Alcoholic  bool      `gorm:"column:alcoholic;not null;default:false"`
+	CreatedAt  time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt  time.Time `gorm:"column:updated_at;not null"`
+}
+
+// TableName 指定資料表名稱
+func (RewardGORM) TableName() string {
+	return "rewards"
+}
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+func (g *RewardGORM) toDomain() (*points.Reward, error) {
+	rewardID, err := points.RewardIDFromString(g.RewardID)
+	if err != nil {
+		return nil, err
+	}
+	return points.ReconstructReward(rewardID, g.Name, g.PointsCost, g.Alcoholic, g.CreatedAt, g.UpdatedAt)
+}
+
+// toRewardGORM 將 Domain 模型轉換為 GORM 模型
+func toRewardGORM(r *points.Reward) *RewardGORM {
+	return &RewardGORM{
+		RewardID:   r.RewardID().String(),
+		Name:       r.Name(),
+		PointsCost: r.PointsCost().Value(),
+		Alcoholic:  r.Alcoholic(),
+		CreatedAt:  r.CreatedAt(),
+		UpdatedAt:  r.UpdatedAt(),
 	}
 }
@@ -2,6 +2,7 @@ package points
 
 import (
 	"errors"
+	"strconv"
 	"strings"
 
 	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
@@ -51,6 +52,7 @@ func NewPointsAccountRepository(db *gorm.DB) points.PointsAccountRepository {
 // 2. 將 Domain 模型轉換為 GORM 模型
 // 3. 使用 GORM Create（新增記錄）
 // 4. 處理唯一約束衝突錯誤
+// 5. 成功後 MarkPersisted（保存後的實例可直接 Update）
 //
 // 錯誤處理：
 // - UNIQUE constraint 違反（member_id 重複）→ ErrAccountAlreadyExists
@@ -78,6 +80,7 @@ func (r *PointsAccountRepositoryImpl) Save(ctx shared.TransactionContext, accoun
 		return result.Error
 	}
 
+	account.MarkPersisted()
 	return nil
 }
 
@@ -149,13 +152,14 @@ func (r *PointsAccountRepositoryImpl) FindByMemberID(ctx shared.TransactionConte
 	return gormModel.toDomain()
 }
 
-// Update 更新積分帳戶
+// Update 更新積分帳戶（樂觀鎖）
 //
 // 實作邏輯：
 // 1. 從 TransactionContext 獲取 DB 實例
 // 2. 將 Domain 模型轉換為 GORM 模型
-// 3. 使用 GORM Updates 更新所有字段（包括零值）
-// 4. 檢查 RowsAffected 確保記錄存在
+// 3. UPDATE points_accounts SET ... WHERE account_id = ? AND version = ?（載入時版本號）
+// 4. 影響 0 筆時判斷帳戶是否存在：存在 → 版本衝突；不存在 → 找不到
+// 5. 成功後 MarkPersisted，同一實例可繼續變更並再次 Update
 //
 // 前置條件：
 // - 帳戶必須已存在（如果不存在，返回 ErrAccountNotFound）
@@ -166,6 +170,7 @@ func (r *PointsAccountRepositoryImpl) FindByMemberID(ctx shared.TransactionConte
 //
 // 錯誤處理：
 // - 帳戶不存在 → ErrAccountNotFound
+// - 版本號不符 → ErrAccountVersionConflict
 // - UNIQUE constraint 違反 → ErrAccountAlreadyExists
 // - 其他資料庫錯誤 → 原始錯誤
 func (r *PointsAccountRepositoryImpl) Update(ctx shared.TransactionContext, account *points.PointsAccount) error {
@@ -179,10 +184,10 @@ func (r *PointsAccountRepositoryImpl) Update(ctx shared.TransactionContext, acco
 	// 2. 轉換為 GORM 模型
 	gormModel := toGORM(account)
 
-	// 3. 執行 Updates（更新現有記錄）
+	// 3. 執行 Updates（更新現有記錄，以載入時版本號比對）
 	// 使用 Select("*") 確保零值字段也被更新
 	result := db.Model(&PointsAccountGORM{}).
-		Where("account_id = ?", gormModel.AccountID).
+		Where("account_id = ? AND version = ?", gormModel.AccountID, account.PersistedVersion()).
 		Select("*").
 		Updates(gormModel)
 
@@ -196,15 +201,26 @@ func (r *PointsAccountRepositoryImpl) Update(ctx shared.TransactionContext, acco
 		return result.Error
 	}
 
-	// 5. 檢查是否找到記錄
-	if result.RowsAffected == 0 {
+	if result.RowsAffected > 0 {
+		account.MarkPersisted()
+		return nil
+	}
+
+	// 5. 未更新任何記錄：區分帳戶不存在與版本衝突
+	var count int64
+	if err := db.Model(&PointsAccountGORM{}).Where("account_id = ?", gormModel.AccountID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
 		return points.ErrAccountNotFound.WithContext(
 			"account_id", account.AccountID().String(),
 			"reason", "account does not exist (Update requires existing record)",
 		)
 	}
-
-	return nil
+	return points.ErrAccountVersionConflict.WithContext(
+		"account_id", account.AccountID().String(),
+		"expected_version", strconv.Itoa(account.PersistedVersion()),
+	)
 }
 
 // ===========================
@@ -2,7 +2,9 @@ package points
 
 import (
 	"testing"
+	"time"
 
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
 	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,7 +23,7 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	require.NoError(t, err, "failed to connect to test database")
 
 	// 2. 自動遷移
-	err = db.AutoMigrate(&PointsAccountGORM{})
+	err = db.AutoMigrate(&PointsAccountGORM{}, &PointsAdjustmentGORM{}, &BirthdayBonusGrantGORM{}, &RewardGORM{})
 	require.NoError(t, err, "failed to migrate database schema")
 
 	return db
@@ -311,3 +313,206 @@ func TestPointsAccountRepository_Timestamps_PreservedCorrectly(t *testing.T) {
 	assert.WithinDuration(t, originalCreatedAt, found.CreatedAt(), 1000000000) // 1 second in nanoseconds
 	assert.WithinDuration(t, originalUpdatedAt, found.UpdatedAt(), 1000000000)
 }
+
+// Test 14: Freeze state round trip
+func TestPointsAccountRepository_FreezeState_RoundTrip(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewPointsAccountRepository(db)
+	account := createTestAccount(t)
+	require.NoError(t, repo.Save(nil, account))
+	require.NoError(t, account.Freeze("scan velocity investigation", "owner01"))
+
+	// Act - Freeze and retrieve
+	require.NoError(t, repo.Update(nil, account))
+	found, err := repo.FindByID(nil, account.AccountID())
+
+	// Assert
+	require.NoError(t, err)
+	require.True(t, found.IsFrozen())
+	assert.Equal(t, "scan velocity investigation", found.FreezeInfo().Reason())
+	assert.Equal(t, "owner01", found.FreezeInfo().FrozenBy())
+
+	// Act - Unfreeze and retrieve
+	require.NoError(t, found.Unfreeze("owner01"))
+	require.NoError(t, repo.Update(nil, found))
+	released, err := repo.FindByID(nil, account.AccountID())
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, released.IsFrozen())
+}
+
+// Test 15: 積分調整保存與查詢（入帳後保留帳戶事件連結；待核准清單；依申請人查詢）
+func TestPointsAdjustmentRepository_SaveAndFind(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewPointsAdjustmentRepository(db)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	account := createTestAccount(t)
+	policy, err := points.NewAdjustmentApprovalPolicy(100)
+	require.NoError(t, err)
+	small, err := points.RequestPointsAdjustment(account, 20, points.AdjustmentReasonGoodwill, "招待", "alice", policy, now)
+	require.NoError(t, err)
+	large, err := points.RequestPointsAdjustment(account, 300, points.AdjustmentReasonMissedInvoice, "補登發票", "alice", policy, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, account.ApplyAdjustment(small, now))
+	require.NoError(t, repo.Save(nil, small))
+	require.NoError(t, repo.Save(nil, large))
+
+	// Act
+	pending, err := repo.FindByStatus(nil, points.AdjustmentStatusPendingApproval, 10)
+	require.NoError(t, err)
+	found, err := repo.FindByID(nil, small.AdjustmentID())
+	require.NoError(t, err)
+	history, err := repo.FindByMemberID(nil, account.MemberID(), 10)
+	require.NoError(t, err)
+	requested, err := repo.FindByRequestedBy(nil, "alice", 10)
+	require.NoError(t, err)
+	others, err := repo.FindByRequestedBy(nil, "bob", 10)
+	require.NoError(t, err)
+	_, missing := repo.FindByID(nil, points.NewAdjustmentID())
+
+	// Assert
+	require.Len(t, pending, 1)
+	assert.Equal(t, large.AdjustmentID(), pending[0].AdjustmentID())
+	assert.True(t, pending[0].RequiresApproval())
+	assert.Equal(t, points.AdjustmentStatusApplied, found.Status())
+	assert.Equal(t, small.AccountEventID(), found.AccountEventID())
+	assert.Equal(t, points.AdjustmentReasonGoodwill, found.Reason())
+	assert.False(t, found.RequiresApproval())
+	require.NotNil(t, found.AppliedAt())
+	require.Len(t, history, 2)
+	assert.Equal(t, large.AdjustmentID(), history[0].AdjustmentID())
+	assert.Equal(t, "補登發票", history[0].Note())
+	require.Len(t, requested, 2)
+	assert.Equal(t, large.AdjustmentID(), requested[0].AdjustmentID())
+	assert.Empty(t, others)
+	assert.ErrorIs(t, missing, points.ErrAdjustmentNotFound)
+}
+
+// Test 16: 會員合併時積分調整紀錄改歸屬保留會員的帳戶
+func TestAdjustmentReassigner_ReassignMember(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	accountRepo := NewPointsAccountRepository(db)
+	adjustmentRepo := NewPointsAdjustmentRepository(db)
+	reassigner := NewAdjustmentReassigner(db)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	primary := createTestAccount(t)
+	secondary := createTestAccount(t)
+	require.NoError(t, accountRepo.Save(nil, primary))
+	require.NoError(t, accountRepo.Save(nil, secondary))
+	policy, err := points.NewAdjustmentApprovalPolicy(100)
+	require.NoError(t, err)
+	pending, err := points.RequestPointsAdjustment(secondary, 300, points.AdjustmentReasonMissedInvoice, "補登發票", "alice", policy, now)
+	require.NoError(t, err)
+	require.NoError(t, adjustmentRepo.Save(nil, pending))
+	from, _ := member.MemberIDFromString(secondary.MemberID().String())
+	to, _ := member.MemberIDFromString(primary.MemberID().String())
+
+	// Act
+	moved, err := reassigner.ReassignMember(nil, from, to)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, moved)
+	found, err := adjustmentRepo.FindByID(nil, pending.AdjustmentID())
+	require.NoError(t, err)
+	assert.Equal(t, primary.AccountID(), found.AccountID())
+	assert.Equal(t, primary.MemberID(), found.MemberID())
+}
+
+// Test 17: 生日禮發放紀錄每位會員每年只能保存一次
+func TestBirthdayBonusGrantRepository_SaveOncePerYear(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewBirthdayBonusGrantRepository(db)
+	now := time.Date(2025, 5, 20, 9, 0, 0, 0, time.UTC)
+	account := createTestAccount(t)
+	policy, err := points.NewBirthdayBonusPolicy(50)
+	require.NoError(t, err)
+	grant, err := points.GrantBirthdayBonus(account, policy, 2025, now)
+	require.NoError(t, err)
+	duplicate, err := points.GrantBirthdayBonus(account, policy, 2025, now.Add(time.Hour))
+	require.NoError(t, err)
+	nextYear, err := points.GrantBirthdayBonus(account, policy, 2026, now.AddDate(1, 0, 0))
+	require.NoError(t, err)
+
+	// Act
+	saveErr := repo.Save(nil, grant)
+	duplicateErr := repo.Save(nil, duplicate)
+	nextYearErr := repo.Save(nil, nextYear)
+	granted, err := repo.ExistsForYear(nil, account.MemberID(), 2025)
+	require.NoError(t, err)
+	notGranted, err := repo.ExistsForYear(nil, account.MemberID(), 2024)
+	require.NoError(t, err)
+
+	// Assert
+	require.NoError(t, saveErr)
+	assert.ErrorIs(t, duplicateErr, points.ErrBirthdayBonusAlreadyGranted)
+	require.NoError(t, nextYearErr)
+	assert.True(t, granted)
+	assert.False(t, notGranted)
+}
+
+// Test 18: 兌換目錄保存與查詢（保留酒類標記；依兌換點數排序）
+func TestRewardRepository_SaveAndFind(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewRewardRepository(db)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	beer, err := points.NewReward("生啤酒一杯", 120, true, now)
+	require.NoError(t, err)
+	fries, err := points.NewReward("薯條", 80, false, now)
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, repo.Save(nil, beer))
+	require.NoError(t, repo.Save(nil, fries))
+	found, err := repo.FindByID(nil, beer.RewardID())
+	require.NoError(t, err)
+	all, err := repo.FindAll(nil)
+	require.NoError(t, err)
+	_, missing := repo.FindByID(nil, points.NewRewardID())
+
+	// Assert
+	assert.Equal(t, "生啤酒一杯", found.Name())
+	assert.Equal(t, 120, found.PointsCost().Value())
+	assert.True(t, found.RequiresVerifiedAdult())
+	require.Len(t, all, 2)
+	assert.Equal(t, fries.RewardID(), all[0].RewardID())
+	assert.False(t, all[0].RequiresVerifiedAdult())
+	assert.ErrorIs(t, missing, points.ErrRewardNotFound)
+}
+
+// Test 19: 樂觀鎖（同一帳戶的兩份載入副本，後寫入者版本衝突；同一實例可連續 Update）
+func TestPointsAccountRepository_Update_VersionConflict(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewPointsAccountRepository(db)
+	account := createTestAccount(t)
+	require.NoError(t, repo.Save(nil, account))
+	first, err := repo.FindByID(nil, account.AccountID())
+	require.NoError(t, err)
+	second, err := repo.FindByID(nil, account.AccountID())
+	require.NoError(t, err)
+	amount, err := points.NewPointsAmount(10)
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, first.EarnPoints(amount, points.PointsSourceInvoice, "TX001", "消費"))
+	require.NoError(t, repo.Update(nil, first))
+	require.NoError(t, first.EarnPoints(amount, points.PointsSourceInvoice, "TX002", "消費"))
+	sameInstance := repo.Update(nil, first)
+	require.NoError(t, second.EarnPoints(amount, points.PointsSourceSurvey, "TX003", "問卷"))
+	conflict := repo.Update(nil, second)
+
+	// Assert
+	assert.NoError(t, sameInstance)
+	assert.ErrorIs(t, conflict, points.ErrAccountVersionConflict)
+	found, err := repo.FindByID(nil, account.AccountID())
+	require.NoError(t, err)
+	assert.Equal(t, 20, found.EarnedPoints().Value(), "the stale write must not overwrite the committed balance")
+	assert.Equal(t, first.Version(), found.Version())
+}
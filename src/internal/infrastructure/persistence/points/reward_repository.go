@@ -0,0 +1,69 @@
+package points
+
+import (
+	"errors"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// RewardRepositoryImpl
+// ===========================
+
+// RewardRepositoryImpl 兌換目錄倉儲實現（GORM）
+type RewardRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewRewardRepository 創建新的兌換目錄倉儲實例
+func NewRewardRepository(db *gorm.DB) points.RewardRepository {
+	return &RewardRepositoryImpl{db: db}
+}
+
+// Save 保存獎勵（新增或更新）
+func (r *RewardRepositoryImpl) Save(ctx shared.TransactionContext, reward *points.Reward) error {
+	return r.getDB(ctx).Save(toRewardGORM(reward)).Error
+}
+
+// FindByID 根據 ID 查詢獎勵
+func (r *RewardRepositoryImpl) FindByID(ctx shared.TransactionContext, rewardID points.RewardID) (*points.Reward, error) {
+	var gormModel RewardGORM
+	result := r.getDB(ctx).Where("reward_id = ?", rewardID.String()).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, points.ErrRewardNotFound.WithContext("reward_id", rewardID.String())
+		}
+		return nil, result.Error
+	}
+	return gormModel.toDomain()
+}
+
+// FindAll 查詢兌換目錄（依兌換點數由低到高）
+func (r *RewardRepositoryImpl) FindAll(ctx shared.TransactionContext) ([]*points.Reward, error) {
+	var gormModels []RewardGORM
+	if err := r.getDB(ctx).Order("points_cost ASC, name ASC").Find(&gormModels).Error; err != nil {
+		return nil, err
+	}
+
+	rewards := make([]*points.Reward, 0, len(gormModels))
+	for i := range gormModels {
+		reward, err := gormModels[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		rewards = append(rewards, reward)
+	}
+	return rewards, nil
+}
+
+// getDB 獲取 GORM DB 實例（事務中使用事務連線）
+func (r *RewardRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
@@ -52,6 +52,7 @@ func toDomain(model *PointsAccountModel) (*points.PointsAccount, error) {
 		model.UsedPoints,
 		model.CreatedAt,
 		model.UpdatedAt,
+		model.Version,
 	)
 	if err != nil {
 		// ReconstructPointsAccount 已經返回適當的 DomainError
@@ -85,6 +86,7 @@ func toGORM(account *points.PointsAccount) *PointsAccountModel {
 		UsedPoints:   account.UsedPoints().Value(),
 		CreatedAt:    account.CreatedAt(),
 		UpdatedAt:    account.UpdatedAt(),
+		Version:      account.Version(),
 		// DeletedAt 由 GORM 管理（軟刪除）
 	}
 }
@@ -19,6 +19,7 @@ type PointsAccountModel struct {
 	CreatedAt    time.Time      `gorm:"not null"`
 	UpdatedAt    time.Time      `gorm:"not null"`
 	DeletedAt    gorm.DeletedAt `gorm:"index"`
+	Version      int            `gorm:"not null;default:1"` // 樂觀鎖
 }
 
 // TableName 指定表名
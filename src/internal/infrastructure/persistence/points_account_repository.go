@@ -2,6 +2,7 @@ package persistence
 
 import (
 	"errors"
+	"strconv"
 
 	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
 	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
@@ -69,6 +70,7 @@ func (r *GORMPointsAccountRepository) Save(ctx shared.TransactionContext, accoun
 		return r.mapError(result.Error)
 	}
 
+	account.MarkPersisted()
 	return nil
 }
 
@@ -127,17 +129,18 @@ func (r *GORMPointsAccountRepository) FindByMemberID(ctx shared.TransactionConte
 //
 // 實作細節：
 // 1. 調用 toGORM() 轉換 Domain → GORM Model
-// 2. 使用 GORM Updates() 更新記錄（WHERE 條件確保只更新存在的記錄）
-// 3. 檢查 RowsAffected：如果為 0 表示記錄不存在
+// 2. 使用 GORM Updates() 更新記錄（WHERE id = ? AND version = ?，以載入時版本號比對）
+// 3. 檢查 RowsAffected：如果為 0 再查詢帳戶是否存在，區分不存在與版本衝突
 // 4. 映射錯誤
 //
 // 前置條件：帳戶已存在
 // 後置條件：帳戶狀態已更新
-// 錯誤：ErrAccountNotFound（如果帳戶不存在）
+// 錯誤：
+// - ErrAccountNotFound（如果帳戶不存在）
+// - ErrAccountVersionConflict（載入後已被其他操作更新）
 //
 // 設計原則：
-// - 單一職責：一個查詢完成更新和存在性檢查
-// - 效能優化：避免額外的 Count 查詢
+// - 單一職責：一個查詢完成更新和版本檢查，只有失敗時才額外 Count
 func (r *GORMPointsAccountRepository) Update(ctx shared.TransactionContext, account *points.PointsAccount) error {
 	// 1. 獲取事務上下文中的 DB
 	db := r.getDB(ctx)
@@ -145,9 +148,9 @@ func (r *GORMPointsAccountRepository) Update(ctx shared.TransactionContext, acco
 	// 2. Domain → GORM 轉換
 	model := toGORM(account)
 
-	// 3. 更新記錄（WHERE 確保只更新存在的記錄）
+	// 3. 更新記錄（WHERE 確保只更新存在且版本相符的記錄）
 	result := db.Model(&PointsAccountModel{}).
-		Where("id = ?", model.ID).
+		Where("id = ? AND version = ?", model.ID, account.PersistedVersion()).
 		Updates(model)
 
 	// 4. 錯誤檢查
@@ -156,15 +159,26 @@ func (r *GORMPointsAccountRepository) Update(ctx shared.TransactionContext, acco
 	}
 
 	// 5. 檢查是否真的更新了記錄
-	// RowsAffected = 0 表示記錄不存在（WHERE 條件未匹配）
-	if result.RowsAffected == 0 {
+	// RowsAffected = 0 表示記錄不存在或版本不符（WHERE 條件未匹配）
+	if result.RowsAffected > 0 {
+		account.MarkPersisted()
+		return nil
+	}
+
+	var count int64
+	if err := db.Model(&PointsAccountModel{}).Where("id = ?", model.ID).Count(&count).Error; err != nil {
+		return r.mapError(err)
+	}
+	if count == 0 {
 		return points.ErrAccountNotFound.WithContext(
 			"account_id", account.AccountID().String(),
 			"reason", "account does not exist in database",
 		)
 	}
-
-	return nil
+	return points.ErrAccountVersionConflict.WithContext(
+		"account_id", account.AccountID().String(),
+		"expected_version", strconv.Itoa(account.PersistedVersion()),
+	)
 }
 
 // ===========================
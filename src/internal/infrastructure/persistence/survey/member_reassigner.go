@@ -0,0 +1,50 @@
+package survey
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// ResponseReassignerImpl
+// ===========================
+
+// ResponseReassignerImpl 問卷回覆改歸屬實現（會員合併）
+//
+// 設計原則：
+// - 實作 member.MemberHistoryReassigner 接口
+// - 答案以 response_id 關聯，只需更新 survey_responses
+// - 使用 UpdateColumn：不變更 updated_at（保留原回覆時間）
+type ResponseReassignerImpl struct {
+	db *gorm.DB
+}
+
+// NewResponseReassigner 創建問卷回覆改歸屬實例
+func NewResponseReassigner(db *gorm.DB) member.MemberHistoryReassigner {
+	return &ResponseReassignerImpl{db: db}
+}
+
+// ReassignMember 將 from 會員的所有問卷回覆改歸屬 to 會員
+func (r *ResponseReassignerImpl) ReassignMember(
+	ctx shared.TransactionContext,
+	from, to member.MemberID,
+) (int, error) {
+	result := r.getDB(ctx).Model(&SurveyResponseGORM{}).
+		Where("member_id = ?", from.String()).
+		UpdateColumn("member_id", to.String())
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(result.RowsAffected), nil
+}
+
+// getDB 獲取 GORM DB 實例（ctx 可為 nil）
+func (r *ResponseReassignerImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
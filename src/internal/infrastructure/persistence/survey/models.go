@@ -0,0 +1,364 @@
+package survey
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// GORM Models
+// ===========================
+
+// SurveyGORM 問卷資料表模型
+//
+// 資料庫約束：
+// - survey_id: 主鍵（UUID）
+// - is_active: 部分唯一索引（WHERE is_active = true），同時只能有一個啟用問卷
+type SurveyGORM struct {
+	// 識別欄位
+	SurveyID string `gorm:"column:survey_id;type:varchar(36);primaryKey"` // UUID 字串
+
+	// 問卷定義
+	Title       string `gorm:"column:title;type:varchar(100);not null"`
+	Description string `gorm:"column:description;type:text"`
+
+	// 啟用狀態（BR-004-04：單一啟用）
+	IsActive bool `gorm:"column:is_active;not null;default:false;uniqueIndex:idx_surveys_single_active,where:is_active = true"`
+
+	// 題目（依 position 排序）
+	Questions []SurveyQuestionGORM `gorm:"foreignKey:SurveyID;references:SurveyID"`
+
+	// 審計欄位
+	CreatedAt time.Time      `gorm:"column:created_at;index;not null"`
+	UpdatedAt time.Time      `gorm:"column:updated_at;not null"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"` // 軟刪除
+}
+
+// TableName 指定資料表名稱
+func (SurveyGORM) TableName() string {
+	return "surveys"
+}
+
+// SurveyQuestionGORM 問卷題目資料表模型（Survey 聚合內的實體）
+//
+// 資料庫約束：
+// - question_id: 主鍵（UUID，修訂問卷時保留）
+// - survey_id + position: 唯一索引（題目順序）
+// - options: JSON 陣列字串（僅選擇題）
+// - display_conditions: JSON 陣列字串（顯示條件，無條件時為空字串）
+//
+// 注意：修訂問卷時整批替換題目（硬刪除），不使用軟刪除
+type SurveyQuestionGORM struct {
+	QuestionID   string `gorm:"column:question_id;type:varchar(36);primaryKey"`
+	SurveyID     string `gorm:"column:survey_id;type:varchar(36);uniqueIndex:idx_survey_questions_position,priority:1;not null"`
+	Position     int    `gorm:"column:position;uniqueIndex:idx_survey_questions_position,priority:2;not null"`
+	QuestionText string `gorm:"column:question_text;type:varchar(200);not null"`
+	QuestionType string `gorm:"column:question_type;type:varchar(20);not null"`
+	Options      string `gorm:"column:options;type:text"`
+	IsRequired   bool   `gorm:"column:is_required;not null"`
+
+	DisplayConditions string `gorm:"column:display_conditions;type:text"`
+}
+
+// displayConditionJSON 顯示條件 JSON 格式
+type displayConditionJSON struct {
+	SourceQuestionID string `json:"source_question_id"`
+	Operator         string `json:"operator"`
+	Value            string `json:"value"`
+}
+
+// TableName 指定資料表名稱
+func (SurveyQuestionGORM) TableName() string {
+	return "survey_questions"
+}
+
+// SurveyTokenUsageGORM 問卷 Token 使用紀錄資料表模型
+//
+// 資料庫約束：
+// - token_id: 主鍵（重複寫入即代表 Token 已使用）
+type SurveyTokenUsageGORM struct {
+	TokenID       string    `gorm:"column:token_id;type:varchar(36);primaryKey"`
+	TransactionID string    `gorm:"column:transaction_id;type:varchar(36);index;not null"`
+	UsedAt        time.Time `gorm:"column:used_at;not null"`
+}
+
+// TableName 指定資料表名稱
+func (SurveyTokenUsageGORM) TableName() string {
+	return "survey_token_usages"
+}
+
+// SurveyResponseGORM 問卷回覆資料表模型
+//
+// 資料庫約束：
+// - response_id: 主鍵（UUID）
+// - transaction_id: 唯一索引（每筆交易只能填寫一次）
+// - bonus_status: 索引（結算待發放獎勵）
+// - survey_id + submitted_at: 複合索引（統計報表依問卷與日期區間查詢）
+type SurveyResponseGORM struct {
+	// 識別欄位
+	ResponseID    string `gorm:"column:response_id;type:varchar(36);primaryKey"`
+	SurveyID      string `gorm:"column:survey_id;type:varchar(36);index:idx_survey_responses_survey_submitted,priority:1;not null"`
+	TransactionID string `gorm:"column:transaction_id;type:varchar(36);uniqueIndex;not null"`
+	MemberID      string `gorm:"column:member_id;type:varchar(36);index;not null"`
+
+	// 答案
+	Answers []SurveyAnswerGORM `gorm:"foreignKey:ResponseID;references:ResponseID"`
+
+	// 獎勵狀態
+	BonusStatus    string     `gorm:"column:bonus_status;type:varchar(20);index;not null"`
+	BonusAwardedAt *time.Time `gorm:"column:bonus_awarded_at"`
+
+	// 審計欄位
+	SubmittedAt time.Time `gorm:"column:submitted_at;index:idx_survey_responses_survey_submitted,priority:2;not null"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;not null"`
+}
+
+// TableName 指定資料表名稱
+func (SurveyResponseGORM) TableName() string {
+	return "survey_responses"
+}
+
+// SurveyAnswerGORM 問卷答案資料表模型（SurveyResponse 聚合內的值對象）
+//
+// 設計原則：每題一列，便於依題目統計（評分分佈、選項計數）
+// - question_id + response_id: 複合索引（依題目聚合後關聯回覆的提交時間）
+// - text_value: 文字答案或選擇的選項
+// - rating: 評分（非評分題為 0）
+type SurveyAnswerGORM struct {
+	ID         uint   `gorm:"column:id;primaryKey;autoIncrement"`
+	ResponseID string `gorm:"column:response_id;type:varchar(36);index;index:idx_survey_answers_question_response,priority:2;not null"`
+	QuestionID string `gorm:"column:question_id;type:varchar(36);index:idx_survey_answers_question_response,priority:1;not null"`
+	AnswerType string `gorm:"column:answer_type;type:varchar(20);not null"`
+	TextValue  string `gorm:"column:text_value;type:text"`
+	Rating     int    `gorm:"column:rating;not null;default:0"`
+}
+
+// TableName 指定資料表名稱
+func (SurveyAnswerGORM) TableName() string {
+	return "survey_answers"
+}
+
+// ===========================
+// Mapper Functions
+// ===========================
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+//
+// 前置條件：Questions 已依 position 排序載入
+func (g *SurveyGORM) toDomain() (*survey.Survey, error) {
+	surveyID, err := survey.SurveyIDFromString(g.SurveyID)
+	if err != nil {
+		return nil, err
+	}
+
+	questions := make([]survey.Question, 0, len(g.Questions))
+	for i := range g.Questions {
+		q, err := g.Questions[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, q)
+	}
+
+	return survey.ReconstructSurvey(
+		surveyID,
+		g.Title,
+		g.Description,
+		questions,
+		g.IsActive,
+		g.CreatedAt,
+		g.UpdatedAt,
+	)
+}
+
+// toDomain 將題目 GORM 模型轉換為 Domain 實體
+func (g *SurveyQuestionGORM) toDomain() (survey.Question, error) {
+	questionID, err := survey.QuestionIDFromString(g.QuestionID)
+	if err != nil {
+		return survey.Question{}, err
+	}
+
+	questionType, err := survey.ParseQuestionType(g.QuestionType)
+	if err != nil {
+		return survey.Question{}, err
+	}
+
+	var options []string
+	if g.Options != "" {
+		if err := json.Unmarshal([]byte(g.Options), &options); err != nil {
+			return survey.Question{}, err
+		}
+	}
+
+	q, err := survey.ReconstructQuestion(questionID, g.QuestionText, questionType, options, g.IsRequired)
+	if err != nil {
+		return survey.Question{}, err
+	}
+
+	if g.DisplayConditions == "" {
+		return q, nil
+	}
+
+	var encoded []displayConditionJSON
+	if err := json.Unmarshal([]byte(g.DisplayConditions), &encoded); err != nil {
+		return survey.Question{}, err
+	}
+
+	conditions := make([]survey.DisplayCondition, 0, len(encoded))
+	for _, c := range encoded {
+		sourceID, err := survey.QuestionIDFromString(c.SourceQuestionID)
+		if err != nil {
+			return survey.Question{}, err
+		}
+
+		condition, err := survey.NewDisplayCondition(sourceID, survey.ConditionOperator(c.Operator), c.Value)
+		if err != nil {
+			return survey.Question{}, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	return q.WithConditions(conditions...), nil
+}
+
+// encodeConditions 將顯示條件編碼為 JSON（無條件時為空字串）
+func encodeConditions(conditions []survey.DisplayCondition) (string, error) {
+	if len(conditions) == 0 {
+		return "", nil
+	}
+
+	encoded := make([]displayConditionJSON, 0, len(conditions))
+	for _, c := range conditions {
+		encoded = append(encoded, displayConditionJSON{
+			SourceQuestionID: c.SourceQuestionID().String(),
+			Operator:         c.Operator().String(),
+			Value:            c.Value(),
+		})
+	}
+
+	data, err := json.Marshal(encoded)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// toGORM 將 Domain 模型轉換為 GORM 模型
+func toGORM(s *survey.Survey) (*SurveyGORM, error) {
+	questions := make([]SurveyQuestionGORM, 0, len(s.Questions()))
+	for i, q := range s.Questions() {
+		options := ""
+		if len(q.Options()) > 0 {
+			encoded, err := json.Marshal(q.Options())
+			if err != nil {
+				return nil, err
+			}
+			options = string(encoded)
+		}
+
+		conditions, err := encodeConditions(q.Conditions())
+		if err != nil {
+			return nil, err
+		}
+
+		questions = append(questions, SurveyQuestionGORM{
+			QuestionID:   q.QuestionID().String(),
+			SurveyID:     s.SurveyID().String(),
+			Position:     i,
+			QuestionText: q.Text(),
+			QuestionType: q.Type().String(),
+			Options:      options,
+			IsRequired:   q.IsRequired(),
+
+			DisplayConditions: conditions,
+		})
+	}
+
+	return &SurveyGORM{
+		SurveyID:    s.SurveyID().String(),
+		Title:       s.Title(),
+		Description: s.Description(),
+		IsActive:    s.IsActive(),
+		Questions:   questions,
+		CreatedAt:   s.CreatedAt(),
+		UpdatedAt:   s.UpdatedAt(),
+	}, nil
+}
+
+// toDomain 將問卷回覆 GORM 模型轉換為 Domain 模型
+func (g *SurveyResponseGORM) toDomain() (*survey.SurveyResponse, error) {
+	responseID, err := survey.ResponseIDFromString(g.ResponseID)
+	if err != nil {
+		return nil, err
+	}
+
+	surveyID, err := survey.SurveyIDFromString(g.SurveyID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID, err := survey.TransactionIDFromString(g.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := survey.MemberIDFromString(g.MemberID)
+	if err != nil {
+		return nil, err
+	}
+
+	answers := make([]survey.Answer, 0, len(g.Answers))
+	for _, a := range g.Answers {
+		questionID, err := survey.QuestionIDFromString(a.QuestionID)
+		if err != nil {
+			return nil, err
+		}
+
+		answer, err := survey.ReconstructAnswer(questionID, survey.QuestionType(a.AnswerType), a.TextValue, a.Rating)
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, answer)
+	}
+
+	return survey.ReconstructSurveyResponse(
+		responseID,
+		surveyID,
+		transactionID,
+		memberID,
+		answers,
+		survey.BonusStatus(g.BonusStatus),
+		g.BonusAwardedAt,
+		g.SubmittedAt,
+		g.UpdatedAt,
+	)
+}
+
+// responseToGORM 將問卷回覆 Domain 模型轉換為 GORM 模型
+func responseToGORM(r *survey.SurveyResponse) *SurveyResponseGORM {
+	answers := make([]SurveyAnswerGORM, 0, len(r.Answers()))
+	for _, a := range r.Answers() {
+		answers = append(answers, SurveyAnswerGORM{
+			ResponseID: r.ResponseID().String(),
+			QuestionID: a.QuestionID().String(),
+			AnswerType: a.Type().String(),
+			TextValue:  a.Text(),
+			Rating:     a.Rating(),
+		})
+	}
+
+	return &SurveyResponseGORM{
+		ResponseID:     r.ResponseID().String(),
+		SurveyID:       r.SurveyID().String(),
+		TransactionID:  r.TransactionID().String(),
+		MemberID:       r.MemberID().String(),
+		Answers:        answers,
+		BonusStatus:    r.BonusStatus().String(),
+		BonusAwardedAt: r.BonusAwardedAt(),
+		SubmittedAt:    r.SubmittedAt(),
+		UpdatedAt:      r.UpdatedAt(),
+	}
+}
@@ -0,0 +1,62 @@
+package survey
+
+import (
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// PendingBonusQueryImpl
+// ===========================
+
+// PendingBonusQueryImpl 可結算的待發放問卷獎勵查詢實現（GORM）
+//
+// 設計原則：
+// - 實作 survey.PendingBonusQuery 接口
+// - 直接查詢 survey_responses / invoice_transactions（不載入聚合）
+// - 已刪除的交易不結算
+type PendingBonusQueryImpl struct {
+	db *gorm.DB
+}
+
+// NewPendingBonusQuery 創建查詢實例
+func NewPendingBonusQuery(db *gorm.DB) survey.PendingBonusQuery {
+	return &PendingBonusQueryImpl{db: db}
+}
+
+// FindSettleable 查詢獎勵待發放且交易已驗證的交易 ID（依提交時間排序）
+func (q *PendingBonusQueryImpl) FindSettleable(ctx shared.TransactionContext, limit int) ([]survey.TransactionID, error) {
+	var ids []string
+	err := q.getDB(ctx).Table("survey_responses AS r").
+		Joins("JOIN invoice_transactions AS t ON t.transaction_id = r.transaction_id AND t.deleted_at IS NULL").
+		Where("r.bonus_status = ?", survey.BonusStatusPending.String()).
+		Where("t.status = ?", "verified").
+		Order("r.submitted_at ASC").
+		Order("r.response_id ASC").
+		Limit(limit).
+		Pluck("r.transaction_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	transactionIDs := make([]survey.TransactionID, 0, len(ids))
+	for _, id := range ids {
+		transactionID, err := survey.TransactionIDFromString(id)
+		if err != nil {
+			return nil, err
+		}
+		transactionIDs = append(transactionIDs, transactionID)
+	}
+	return transactionIDs, nil
+}
+
+// getDB 獲取 GORM DB 實例（ctx 可為 nil）
+func (q *PendingBonusQueryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return q.db
+}
@@ -0,0 +1,65 @@
+package survey
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	invoicepersistence "github.com/jackyeh168/bar_crm/src/internal/infrastructure/persistence/invoice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// PendingBonusQuery Integration Tests
+// ===========================
+
+// seedBonusTransaction 建立指定狀態的發票交易
+func seedBonusTransaction(t *testing.T, db *gorm.DB, transactionID survey.TransactionID, invoiceNumber, status string) {
+	t.Helper()
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, db.Create(&invoicepersistence.InvoiceTransactionGORM{
+		TransactionID: transactionID.String(),
+		MemberID:      shared.NewEntityID[survey.MemberMarker]().String(),
+		InvoiceNumber: invoiceNumber,
+		InvoiceDate:   now,
+		Amount:        500,
+		Status:        status,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}).Error)
+}
+
+// Test 1: Only pending bonuses on verified transactions are settleable
+func TestPendingBonusQuery_FindSettleable(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&invoicepersistence.InvoiceTransactionGORM{}))
+	repo := NewSurveyResponseRepository(db)
+	query := NewPendingBonusQuery(db)
+	s := createTestSurvey(t)
+
+	verified := shared.NewEntityID[survey.TransactionMarker]()
+	imported := shared.NewEntityID[survey.TransactionMarker]()
+	awarded := shared.NewEntityID[survey.TransactionMarker]()
+	seedBonusTransaction(t, db, verified, "AB12345678", "verified")
+	seedBonusTransaction(t, db, imported, "AB12345679", "imported")
+	seedBonusTransaction(t, db, awarded, "AB12345680", "verified")
+	for _, transactionID := range []survey.TransactionID{verified, imported, awarded} {
+		response := createTestResponse(t, s, transactionID)
+		if transactionID == awarded {
+			require.NoError(t, response.AwardBonus())
+		}
+		require.NoError(t, repo.Save(nil, response))
+	}
+
+	// Act
+	settleable, err := query.FindSettleable(nil, 10)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, settleable, 1)
+	assert.Equal(t, verified, settleable[0])
+}
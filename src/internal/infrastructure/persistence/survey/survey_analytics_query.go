@@ -0,0 +1,317 @@
+package survey
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// SurveyAnalyticsQuery 實現
+// ===========================
+
+// surveyAnalyticsQuery 問卷統計查詢（GORM）
+//
+// 設計原則：
+// - 聚合在資料庫完成（GROUP BY），只取回彙總結果
+// - 區間過濾使用 survey_responses(survey_id, submitted_at) 複合索引
+// - 答案以 survey_answers(question_id, response_id) 複合索引關聯回覆
+type surveyAnalyticsQuery struct {
+	db *gorm.DB
+}
+
+// NewSurveyAnalyticsQuery 創建問卷統計查詢實例
+func NewSurveyAnalyticsQuery(db *gorm.DB) survey.SurveyAnalyticsQuery {
+	return &surveyAnalyticsQuery{db: db}
+}
+
+// CountResponses 統計區間內的回覆數
+func (q *surveyAnalyticsQuery) CountResponses(
+	ctx shared.TransactionContext,
+	surveyID survey.SurveyID,
+	period survey.DateRange,
+) (int, error) {
+	var count int64
+	result := q.getDB(ctx).Model(&SurveyResponseGORM{}).
+		Where("survey_id = ? AND submitted_at >= ? AND submitted_at < ?", surveyID.String(), period.From(), period.To()).
+		Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(count), nil
+}
+
+// RatingDistribution 評分題分佈
+func (q *surveyAnalyticsQuery) RatingDistribution(
+	ctx shared.TransactionContext,
+	surveyID survey.SurveyID,
+	questionID survey.QuestionID,
+	period survey.DateRange,
+) (map[int]int, error) {
+	var rows []struct {
+		Rating int
+		Count  int
+	}
+	result := q.answers(ctx, surveyID, questionID, period).
+		Select("a.rating AS rating, COUNT(*) AS count").
+		Group("a.rating").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	distribution := make(map[int]int, len(rows))
+	for _, row := range rows {
+		distribution[row.Rating] = row.Count
+	}
+	return distribution, nil
+}
+
+// ChoiceCounts 選擇題各選項人數
+func (q *surveyAnalyticsQuery) ChoiceCounts(
+	ctx shared.TransactionContext,
+	surveyID survey.SurveyID,
+	questionID survey.QuestionID,
+	period survey.DateRange,
+) (map[string]int, error) {
+	var rows []struct {
+		Choice string
+		Count  int
+	}
+	result := q.answers(ctx, surveyID, questionID, period).
+		Select("a.text_value AS choice, COUNT(*) AS count").
+		Group("a.text_value").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Choice] = row.Count
+	}
+	return counts, nil
+}
+
+// TextAnswers 分頁查詢文字題答案（依提交時間降冪）
+func (q *surveyAnalyticsQuery) TextAnswers(
+	ctx shared.TransactionContext,
+	surveyID survey.SurveyID,
+	questionID survey.QuestionID,
+	period survey.DateRange,
+	limit, offset int,
+) ([]survey.TextAnswerEntry, int, error) {
+	var total int64
+	if err := q.answers(ctx, surveyID, questionID, period).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rows []struct {
+		ResponseID  string
+		TextValue   string
+		SubmittedAt time.Time
+	}
+	result := q.answers(ctx, surveyID, questionID, period).
+		Select("a.response_id AS response_id, a.text_value AS text_value, r.submitted_at AS submitted_at").
+		Order("r.submitted_at DESC").
+		Order("a.response_id").
+		Limit(limit).
+		Offset(offset).
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	entries := make([]survey.TextAnswerEntry, 0, len(rows))
+	for _, row := range rows {
+		responseID, err := survey.ResponseIDFromString(row.ResponseID)
+		if err != nil {
+			return nil, 0, err
+		}
+		entries = append(entries, survey.TextAnswerEntry{
+			ResponseID:  responseID,
+			Text:        row.TextValue,
+			SubmittedAt: row.SubmittedAt,
+		})
+	}
+	return entries, int(total), nil
+}
+
+// RatingSamples 評分題樣本（依提交時間升冪）
+func (q *surveyAnalyticsQuery) RatingSamples(
+	ctx shared.TransactionContext,
+	surveyID survey.SurveyID,
+	questionID survey.QuestionID,
+	period survey.DateRange,
+) ([]survey.RatingSample, error) {
+	var rows []struct {
+		SubmittedAt time.Time
+		Rating      int
+	}
+	result := q.answers(ctx, surveyID, questionID, period).
+		Select("r.submitted_at AS submitted_at, a.rating AS rating").
+		Order("r.submitted_at ASC").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	samples := make([]survey.RatingSample, 0, len(rows))
+	for _, row := range rows {
+		samples = append(samples, survey.RatingSample{SubmittedAt: row.SubmittedAt, Rating: row.Rating})
+	}
+	return samples, nil
+}
+
+// StreamResponses 逐筆讀取區間內的回覆（依提交時間升冪）
+//
+// 實作邏輯：
+// 1. survey_responses LEFT JOIN survey_answers，依 (submitted_at, response_id, answer id) 排序
+// 2. 以游標逐列讀取，response_id 變更時將前一份回覆交給 fn
+func (q *surveyAnalyticsQuery) StreamResponses(
+	ctx shared.TransactionContext,
+	surveyID survey.SurveyID,
+	period survey.DateRange,
+	fn func(survey.ResponseRecord) error,
+) error {
+	rows, err := q.getDB(ctx).Table("survey_responses AS r").
+		Select("r.response_id, r.transaction_id, r.member_id, r.bonus_status, r.submitted_at, "+
+			"a.question_id, a.answer_type, a.text_value, a.rating").
+		Joins("LEFT JOIN survey_answers AS a ON a.response_id = r.response_id").
+		Where("r.survey_id = ? AND r.submitted_at >= ? AND r.submitted_at < ?", surveyID.String(), period.From(), period.To()).
+		Order("r.submitted_at ASC").
+		Order("r.response_id ASC").
+		Order("a.id ASC").
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var current *survey.ResponseRecord
+	for rows.Next() {
+		var (
+			responseID, transactionID, memberID, bonusStatus string
+			submittedAt                                      time.Time
+			questionID, answerType, textValue                *string
+			rating                                           *int
+		)
+		if err := rows.Scan(
+			&responseID, &transactionID, &memberID, &bonusStatus, &submittedAt,
+			&questionID, &answerType, &textValue, &rating,
+		); err != nil {
+			return err
+		}
+
+		if current == nil || current.ResponseID.String() != responseID {
+			if current != nil {
+				if err := fn(*current); err != nil {
+					return err
+				}
+			}
+
+			current, err = newResponseRecord(responseID, transactionID, memberID, bonusStatus, submittedAt)
+			if err != nil {
+				return err
+			}
+		}
+
+		if questionID == nil {
+			continue
+		}
+
+		answer, err := reconstructStreamedAnswer(*questionID, answerType, textValue, rating)
+		if err != nil {
+			return err
+		}
+		current.Answers = append(current.Answers, answer)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if current != nil {
+		return fn(*current)
+	}
+	return nil
+}
+
+// ===========================
+// Helper Methods
+// ===========================
+
+// answers 建立答案查詢（關聯回覆以套用問卷與區間條件）
+func (q *surveyAnalyticsQuery) answers(
+	ctx shared.TransactionContext,
+	surveyID survey.SurveyID,
+	questionID survey.QuestionID,
+	period survey.DateRange,
+) *gorm.DB {
+	return q.getDB(ctx).Table("survey_answers AS a").
+		Joins("JOIN survey_responses AS r ON r.response_id = a.response_id").
+		Where("a.question_id = ?", questionID.String()).
+		Where("r.survey_id = ? AND r.submitted_at >= ? AND r.submitted_at < ?", surveyID.String(), period.From(), period.To())
+}
+
+// getDB 獲取 GORM DB 實例（ctx 可為 nil）
+func (q *surveyAnalyticsQuery) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return q.db
+}
+
+// newResponseRecord 由查詢列建立匯出紀錄
+func newResponseRecord(
+	responseID, transactionID, memberID, bonusStatus string,
+	submittedAt time.Time,
+) (*survey.ResponseRecord, error) {
+	rid, err := survey.ResponseIDFromString(responseID)
+	if err != nil {
+		return nil, err
+	}
+
+	tid, err := survey.TransactionIDFromString(transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	mid, err := survey.MemberIDFromString(memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &survey.ResponseRecord{
+		ResponseID:    rid,
+		TransactionID: tid,
+		MemberID:      mid,
+		BonusStatus:   survey.BonusStatus(bonusStatus),
+		SubmittedAt:   submittedAt,
+	}, nil
+}
+
+// reconstructStreamedAnswer 由 LEFT JOIN 查詢列重建答案
+func reconstructStreamedAnswer(questionID string, answerType, textValue *string, rating *int) (survey.Answer, error) {
+	qid, err := survey.QuestionIDFromString(questionID)
+	if err != nil {
+		return survey.Answer{}, err
+	}
+
+	var answerTypeValue, text string
+	var ratingValue int
+	if answerType != nil {
+		answerTypeValue = *answerType
+	}
+	if textValue != nil {
+		text = *textValue
+	}
+	if rating != nil {
+		ratingValue = *rating
+	}
+
+	return survey.ReconstructAnswer(qid, survey.QuestionType(answerTypeValue), text, ratingValue)
+}
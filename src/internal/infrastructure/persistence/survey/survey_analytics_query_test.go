@@ -0,0 +1,133 @@
+package survey
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// SurveyAnalyticsQuery Integration Tests
+// ===========================
+
+// analyticsStart 統計區間起點（UTC，SQLite 以字串比較時間）
+var analyticsStart = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+// seedAnalyticsSurvey 建立含評分、選擇、文字題的問卷
+func seedAnalyticsSurvey(t *testing.T) *survey.Survey {
+	rating, err := survey.NewQuestion("整體滿意度", survey.QuestionTypeRating, nil, true)
+	require.NoError(t, err)
+	choice, err := survey.NewQuestion("座位區", survey.QuestionTypeMultipleChoice, []string{"吧台", "包廂"}, true)
+	require.NoError(t, err)
+	text, err := survey.NewQuestion("其他建議", survey.QuestionTypeText, nil, false)
+	require.NoError(t, err)
+
+	s, err := survey.NewSurvey("滿意度問卷", "", []survey.Question{rating, choice, text})
+	require.NoError(t, err)
+	return s
+}
+
+// seedAnalyticsResponse 建立回覆並指定提交時間
+func seedAnalyticsResponse(
+	t *testing.T,
+	db *gorm.DB,
+	s *survey.Survey,
+	rating int,
+	choice, text string,
+	submittedAt time.Time,
+) {
+	questions := s.Questions()
+	ratingAnswer, err := survey.NewRatingAnswer(questions[0].QuestionID(), rating)
+	require.NoError(t, err)
+	choiceAnswer, err := survey.NewChoiceAnswer(questions[1].QuestionID(), choice)
+	require.NoError(t, err)
+	answers := []survey.Answer{ratingAnswer, choiceAnswer}
+	if text != "" {
+		textAnswer, err := survey.NewTextAnswer(questions[2].QuestionID(), text)
+		require.NoError(t, err)
+		answers = append(answers, textAnswer)
+	}
+
+	r, err := survey.SubmitSurveyResponse(
+		s,
+		shared.NewEntityID[survey.TransactionMarker](),
+		shared.NewEntityID[survey.MemberMarker](),
+		answers,
+	)
+	require.NoError(t, err)
+	require.NoError(t, NewSurveyResponseRepository(db).Save(nil, r))
+	require.NoError(t, db.Model(&SurveyResponseGORM{}).
+		Where("response_id = ?", r.ResponseID().String()).
+		Update("submitted_at", submittedAt).Error)
+}
+
+// Test 1: Aggregates only include responses within the date range
+func TestSurveyAnalyticsQuery_Aggregates(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	s := seedAnalyticsSurvey(t)
+	seedAnalyticsResponse(t, db, s, 5, "吧台", "調酒很好喝", analyticsStart.Add(1*time.Hour))
+	seedAnalyticsResponse(t, db, s, 5, "包廂", "", analyticsStart.Add(2*time.Hour))
+	seedAnalyticsResponse(t, db, s, 2, "吧台", "上菜太慢", analyticsStart.Add(3*time.Hour))
+	seedAnalyticsResponse(t, db, s, 1, "吧台", "區間外", analyticsStart.Add(-time.Hour))
+	query := NewSurveyAnalyticsQuery(db)
+	period, err := survey.NewDateRange(analyticsStart, analyticsStart.Add(24*time.Hour))
+	require.NoError(t, err)
+	questions := s.Questions()
+
+	// Act
+	count, err := query.CountResponses(nil, s.SurveyID(), period)
+	require.NoError(t, err)
+	distribution, err := query.RatingDistribution(nil, s.SurveyID(), questions[0].QuestionID(), period)
+	require.NoError(t, err)
+	choices, err := query.ChoiceCounts(nil, s.SurveyID(), questions[1].QuestionID(), period)
+	require.NoError(t, err)
+	texts, total, err := query.TextAnswers(nil, s.SurveyID(), questions[2].QuestionID(), period, 1, 0)
+	require.NoError(t, err)
+	samples, err := query.RatingSamples(nil, s.SurveyID(), questions[0].QuestionID(), period)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 3, count)
+	assert.Equal(t, map[int]int{5: 2, 2: 1}, distribution)
+	assert.Equal(t, map[string]int{"吧台": 2, "包廂": 1}, choices)
+	assert.Equal(t, 2, total)
+	require.Len(t, texts, 1)
+	assert.Equal(t, "上菜太慢", texts[0].Text) // 依提交時間降冪
+	require.Len(t, samples, 3)
+	assert.Equal(t, 5, samples[0].Rating)
+	assert.Equal(t, 2, samples[2].Rating)
+}
+
+// Test 2: StreamResponses groups answers per response in submission order
+func TestSurveyAnalyticsQuery_StreamResponses(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	s := seedAnalyticsSurvey(t)
+	seedAnalyticsResponse(t, db, s, 4, "包廂", "", analyticsStart.Add(2*time.Hour))
+	seedAnalyticsResponse(t, db, s, 5, "吧台", "調酒很好喝", analyticsStart.Add(1*time.Hour))
+	query := NewSurveyAnalyticsQuery(db)
+	period, err := survey.NewDateRange(analyticsStart, analyticsStart.Add(24*time.Hour))
+	require.NoError(t, err)
+
+	// Act
+	var records []survey.ResponseRecord
+	err = query.StreamResponses(nil, s.SurveyID(), period, func(record survey.ResponseRecord) error {
+		records = append(records, record)
+		return nil
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Len(t, records[0].Answers, 3)
+	assert.Equal(t, 5, records[0].Answers[0].Rating())
+	assert.Equal(t, "調酒很好喝", records[0].Answers[2].Text())
+	assert.Len(t, records[1].Answers, 2)
+	assert.Equal(t, survey.BonusStatusPending, records[1].BonusStatus)
+}
@@ -0,0 +1,200 @@
+package survey
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"gorm.io/gorm"
+)
+
+// gormTransactionContext GORM 事務上下文
+type gormTransactionContext interface {
+	shared.TransactionContext
+	GetDB() *gorm.DB
+}
+
+// ===========================
+// SurveyRepositoryImpl
+// ===========================
+
+// SurveyRepositoryImpl 問卷倉儲實現（GORM）
+//
+// 設計原則：
+// - 實作 survey.SurveyRepository 接口
+// - 問卷與題目以聚合為單位保存（surveys + survey_questions）
+// - 將 GORM 錯誤轉換為 Domain 錯誤
+type SurveyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewSurveyRepository 創建新的問卷倉儲實例
+func NewSurveyRepository(db *gorm.DB) survey.SurveyRepository {
+	return &SurveyRepositoryImpl{db: db}
+}
+
+// Save 保存新的問卷（含題目）
+//
+// 錯誤處理：
+// - 部分唯一索引衝突（已有其他啟用問卷）→ ErrSurveyAlreadyActive
+func (r *SurveyRepositoryImpl) Save(ctx shared.TransactionContext, s *survey.Survey) error {
+	gormModel, err := toGORM(s)
+	if err != nil {
+		return err
+	}
+
+	return r.getDB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Questions").Create(gormModel).Error; err != nil {
+			return r.translateError(err, s)
+		}
+		return tx.Create(&gormModel.Questions).Error
+	})
+}
+
+// Update 更新問卷（整批替換題目清單）
+//
+// 實作邏輯：
+// 1. 更新 surveys 欄位（Select("*") 確保零值也被更新，例如 is_active=false）
+// 2. 刪除舊題目後重新寫入（題目 ID 由 Domain 保留）
+//
+// 錯誤處理：
+// - 問卷不存在 → ErrSurveyNotFound
+// - 部分唯一索引衝突 → ErrSurveyAlreadyActive
+func (r *SurveyRepositoryImpl) Update(ctx shared.TransactionContext, s *survey.Survey) error {
+	gormModel, err := toGORM(s)
+	if err != nil {
+		return err
+	}
+
+	return r.getDB(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&SurveyGORM{}).
+			Where("survey_id = ?", gormModel.SurveyID).
+			Select("*").
+			Omit("Questions", "CreatedAt").
+			Updates(gormModel)
+		if result.Error != nil {
+			return r.translateError(result.Error, s)
+		}
+
+		if result.RowsAffected == 0 {
+			return survey.ErrSurveyNotFound.WithContext(
+				"survey_id", s.SurveyID().String(),
+				"reason", "survey does not exist (Update requires existing record)",
+			)
+		}
+
+		if err := tx.Where("survey_id = ?", gormModel.SurveyID).Delete(&SurveyQuestionGORM{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&gormModel.Questions).Error
+	})
+}
+
+// FindByID 根據問卷 ID 查找
+func (r *SurveyRepositoryImpl) FindByID(ctx shared.TransactionContext, id survey.SurveyID) (*survey.Survey, error) {
+	var gormModel SurveyGORM
+	result := r.withQuestions(r.getDB(ctx)).Where("survey_id = ?", id.String()).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, survey.ErrSurveyNotFound.WithContext(
+				"survey_id", id.String(),
+			)
+		}
+		return nil, result.Error
+	}
+
+	return gormModel.toDomain()
+}
+
+// FindActive 查找啟用中的問卷
+func (r *SurveyRepositoryImpl) FindActive(ctx shared.TransactionContext) (*survey.Survey, error) {
+	var gormModel SurveyGORM
+	result := r.withQuestions(r.getDB(ctx)).Where("is_active = ?", true).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, survey.ErrNoActiveSurvey
+		}
+		return nil, result.Error
+	}
+
+	return gormModel.toDomain()
+}
+
+// FindAll 分頁查詢所有問卷（依建立時間降冪）
+func (r *SurveyRepositoryImpl) FindAll(ctx shared.TransactionContext, limit, offset int) ([]*survey.Survey, error) {
+	var gormModels []SurveyGORM
+	result := r.withQuestions(r.getDB(ctx)).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&gormModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	surveys := make([]*survey.Survey, 0, len(gormModels))
+	for i := range gormModels {
+		s, err := gormModels[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		surveys = append(surveys, s)
+	}
+
+	return surveys, nil
+}
+
+// ===========================
+// Helper Methods
+// ===========================
+
+// withQuestions 預載題目（依 position 排序）
+func (r *SurveyRepositoryImpl) withQuestions(db *gorm.DB) *gorm.DB {
+	return db.Preload("Questions", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	})
+}
+
+// translateError 將唯一約束錯誤轉換為 Domain 錯誤
+func (r *SurveyRepositoryImpl) translateError(err error, s *survey.Survey) error {
+	if isUniqueConstraintError(err) && s.IsActive() {
+		return survey.ErrSurveyAlreadyActive.WithContext(
+			"survey_id", s.SurveyID().String(),
+			"reason", "another survey is already active",
+		)
+	}
+	return err
+}
+
+// getDB 獲取 GORM DB 實例
+//
+// 行為：
+//   - ctx != nil: 使用事務中的 DB（從 TransactionContext 獲取）
+//   - ctx == nil: 使用預設 DB（auto-commit 模式）
+func (r *SurveyRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
+
+// isUniqueConstraintError 判斷是否為唯一約束錯誤
+//
+// 支持的資料庫：
+// - PostgreSQL: "duplicate key value violates unique constraint"
+// - SQLite: "UNIQUE constraint failed"
+// - MySQL: "Duplicate entry"
+func isUniqueConstraintError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	errMsg := strings.ToLower(err.Error())
+
+	return strings.Contains(errMsg, "duplicate key value violates unique constraint") ||
+		strings.Contains(errMsg, "unique constraint failed") ||
+		strings.Contains(errMsg, "duplicate entry")
+}
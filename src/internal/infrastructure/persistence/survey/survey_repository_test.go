@@ -0,0 +1,158 @@
+package survey
+
+import (
+	"testing"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// SurveyRepository Integration Tests
+// ===========================
+
+// setupTestDB 創建測試資料庫（in-memory SQLite）
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err, "failed to connect to test database")
+
+	err = db.AutoMigrate(
+		&SurveyGORM{},
+		&SurveyQuestionGORM{},
+		&SurveyTokenUsageGORM{},
+		&SurveyResponseGORM{},
+		&SurveyAnswerGORM{},
+	)
+	require.NoError(t, err, "failed to migrate database schema")
+
+	return db
+}
+
+// createTestSurvey 創建測試用問卷（評分、選擇題）
+func createTestSurvey(t *testing.T) *survey.Survey {
+	rating, err := survey.NewQuestion("整體滿意度", survey.QuestionTypeRating, nil, true)
+	require.NoError(t, err)
+	choice, err := survey.NewQuestion("座位區", survey.QuestionTypeMultipleChoice, []string{"吧台", "包廂"}, false)
+	require.NoError(t, err)
+
+	s, err := survey.NewSurvey("滿意度問卷", "說明", []survey.Question{rating, choice})
+	require.NoError(t, err)
+	return s
+}
+
+// Test 1: Save and find by ID (questions in order)
+func TestSurveyRepository_Save_FindByID_Success(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewSurveyRepository(db)
+	s := createTestSurvey(t)
+
+	// Act
+	require.NoError(t, repo.Save(nil, s))
+	found, err := repo.FindByID(nil, s.SurveyID())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "滿意度問卷", found.Title())
+	require.Len(t, found.Questions(), 2)
+	assert.Equal(t, s.Questions()[0].QuestionID(), found.Questions()[0].QuestionID())
+	assert.Equal(t, []string{"吧台", "包廂"}, found.Questions()[1].Options())
+	assert.False(t, found.Questions()[1].IsRequired())
+}
+
+// Test 2: Update replaces questions and persists activation
+func TestSurveyRepository_Update_ReplacesQuestions(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewSurveyRepository(db)
+	s := createTestSurvey(t)
+	require.NoError(t, repo.Save(nil, s))
+
+	text, err := survey.NewQuestion("其他建議", survey.QuestionTypeText, nil, false)
+	require.NoError(t, err)
+	require.NoError(t, s.Revise("新版", "", []survey.Question{text, s.Questions()[0]}))
+	require.NoError(t, s.Activate())
+
+	// Act
+	require.NoError(t, repo.Update(nil, s))
+	found, err := repo.FindActive(nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, s.SurveyID(), found.SurveyID())
+	assert.Equal(t, "新版", found.Title())
+	require.Len(t, found.Questions(), 2)
+	assert.Equal(t, survey.QuestionTypeText, found.Questions()[0].Type())
+	assert.Equal(t, s.Questions()[1].QuestionID(), found.Questions()[1].QuestionID())
+
+	var count int64
+	db.Model(&SurveyQuestionGORM{}).Count(&count)
+	assert.Equal(t, int64(2), count)
+}
+
+// Test 3: Partial unique index rejects a second active survey
+func TestSurveyRepository_SecondActiveSurvey_ReturnsError(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewSurveyRepository(db)
+	first := createTestSurvey(t)
+	second := createTestSurvey(t)
+	require.NoError(t, first.Activate())
+	require.NoError(t, repo.Save(nil, first))
+	require.NoError(t, repo.Save(nil, second))
+
+	// Act
+	require.NoError(t, second.Activate())
+	err := repo.Update(nil, second)
+
+	// Assert
+	assert.ErrorIs(t, err, survey.ErrSurveyAlreadyActive)
+}
+
+// Test 4: Not found errors
+func TestSurveyRepository_NotFound(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewSurveyRepository(db)
+
+	// Act
+	_, findErr := repo.FindByID(nil, survey.NewSurveyID())
+	_, activeErr := repo.FindActive(nil)
+	updateErr := repo.Update(nil, createTestSurvey(t))
+
+	// Assert
+	assert.ErrorIs(t, findErr, survey.ErrSurveyNotFound)
+	assert.ErrorIs(t, activeErr, survey.ErrNoActiveSurvey)
+	assert.ErrorIs(t, updateErr, survey.ErrSurveyNotFound)
+}
+
+// Test 5: Display conditions round-trip
+func TestSurveyRepository_DisplayConditions_RoundTrip(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewSurveyRepository(db)
+	rating, err := survey.NewQuestion("整體滿意度", survey.QuestionTypeRating, nil, true)
+	require.NoError(t, err)
+	followUp, err := survey.NewQuestion("哪裡需要改進？", survey.QuestionTypeText, nil, true)
+	require.NoError(t, err)
+	condition, err := survey.NewDisplayCondition(rating.QuestionID(), survey.ConditionRatingAtMost, "2")
+	require.NoError(t, err)
+	s, err := survey.NewSurvey("滿意度問卷", "", []survey.Question{rating, followUp.WithConditions(condition)})
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, repo.Save(nil, s))
+	found, err := repo.FindByID(nil, s.SurveyID())
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, found.Questions()[0].IsConditional())
+	conditions := found.Questions()[1].Conditions()
+	require.Len(t, conditions, 1)
+	assert.Equal(t, rating.QuestionID(), conditions[0].SourceQuestionID())
+	assert.Equal(t, survey.ConditionRatingAtMost, conditions[0].Operator())
+	assert.Equal(t, "2", conditions[0].Value())
+}
@@ -0,0 +1,107 @@
+package survey
+
+import (
+	"errors"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// SurveyResponseRepositoryImpl
+// ===========================
+
+// SurveyResponseRepositoryImpl 問卷回覆倉儲實現（GORM）
+//
+// 設計原則：
+// - 回覆與答案以聚合為單位保存（survey_responses + survey_answers）
+// - 答案提交後不可修改，Update 只更新獎勵狀態
+type SurveyResponseRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewSurveyResponseRepository 創建新的問卷回覆倉儲實例
+func NewSurveyResponseRepository(db *gorm.DB) survey.SurveyResponseRepository {
+	return &SurveyResponseRepositoryImpl{db: db}
+}
+
+// Save 保存新的問卷回覆（含答案）
+//
+// 錯誤處理：
+// - transaction_id 唯一約束衝突 → ErrResponseAlreadySubmitted
+func (r *SurveyResponseRepositoryImpl) Save(ctx shared.TransactionContext, response *survey.SurveyResponse) error {
+	gormModel := responseToGORM(response)
+
+	return r.getDB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Answers").Create(gormModel).Error; err != nil {
+			if isUniqueConstraintError(err) {
+				return survey.ErrResponseAlreadySubmitted.WithContext(
+					"transaction_id", response.TransactionID().String(),
+				)
+			}
+			return err
+		}
+
+		if len(gormModel.Answers) == 0 {
+			return nil
+		}
+		return tx.Create(&gormModel.Answers).Error
+	})
+}
+
+// Update 更新問卷回覆（獎勵狀態）
+//
+// 錯誤處理：
+// - 回覆不存在 → ErrResponseNotFound
+func (r *SurveyResponseRepositoryImpl) Update(ctx shared.TransactionContext, response *survey.SurveyResponse) error {
+	gormModel := responseToGORM(response)
+
+	result := r.getDB(ctx).Model(&SurveyResponseGORM{}).
+		Where("response_id = ?", gormModel.ResponseID).
+		Select("BonusStatus", "BonusAwardedAt", "UpdatedAt").
+		Updates(gormModel)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return survey.ErrResponseNotFound.WithContext(
+			"response_id", response.ResponseID().String(),
+			"reason", "response does not exist (Update requires existing record)",
+		)
+	}
+
+	return nil
+}
+
+// FindByTransactionID 根據交易 ID 查找
+func (r *SurveyResponseRepositoryImpl) FindByTransactionID(ctx shared.TransactionContext, transactionID survey.TransactionID) (*survey.SurveyResponse, error) {
+	var gormModel SurveyResponseGORM
+	result := r.getDB(ctx).
+		Preload("Answers", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id ASC")
+		}).
+		Where("transaction_id = ?", transactionID.String()).
+		First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, survey.ErrResponseNotFound.WithContext(
+				"transaction_id", transactionID.String(),
+			)
+		}
+		return nil, result.Error
+	}
+
+	return gormModel.toDomain()
+}
+
+// getDB 獲取 GORM DB 實例（ctx 可為 nil）
+func (r *SurveyResponseRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
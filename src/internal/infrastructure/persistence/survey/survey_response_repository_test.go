@@ -0,0 +1,84 @@
+package survey
+
+import (
+	"testing"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// SurveyResponseRepository Integration Tests
+// ===========================
+
+// createTestResponse 創建測試用問卷回覆（評分 + 選擇題）
+func createTestResponse(t *testing.T, s *survey.Survey, transactionID survey.TransactionID) *survey.SurveyResponse {
+	questions := s.Questions()
+	rating, err := survey.NewRatingAnswer(questions[0].QuestionID(), 4)
+	require.NoError(t, err)
+	choice, err := survey.NewChoiceAnswer(questions[1].QuestionID(), "吧台")
+	require.NoError(t, err)
+
+	r, err := survey.SubmitSurveyResponse(
+		s,
+		transactionID,
+		shared.NewEntityID[survey.MemberMarker](),
+		[]survey.Answer{rating, choice},
+	)
+	require.NoError(t, err)
+	return r
+}
+
+// Test 1: Save, update bonus status and find by transaction ID
+func TestSurveyResponseRepository_SaveUpdateFind(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewSurveyResponseRepository(db)
+	transactionID := shared.NewEntityID[survey.TransactionMarker]()
+	response := createTestResponse(t, createTestSurvey(t), transactionID)
+	require.NoError(t, repo.Save(nil, response))
+	require.NoError(t, response.AwardBonus())
+
+	// Act
+	err := repo.Update(nil, response)
+	require.NoError(t, err)
+	found, err := repo.FindByTransactionID(nil, transactionID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, survey.BonusStatusAwarded, found.BonusStatus())
+	assert.NotNil(t, found.BonusAwardedAt())
+	require.Len(t, found.Answers(), 2)
+	assert.Equal(t, 4, found.Answers()[0].Rating())
+	assert.Equal(t, "吧台", found.Answers()[1].Text())
+}
+
+// Test 2: One response per transaction
+func TestSurveyResponseRepository_DuplicateTransaction_ReturnsError(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewSurveyResponseRepository(db)
+	s := createTestSurvey(t)
+	transactionID := shared.NewEntityID[survey.TransactionMarker]()
+	require.NoError(t, repo.Save(nil, createTestResponse(t, s, transactionID)))
+
+	// Act
+	err := repo.Save(nil, createTestResponse(t, s, transactionID))
+
+	// Assert
+	assert.ErrorIs(t, err, survey.ErrResponseAlreadySubmitted)
+}
+
+// Test 3: Not found
+func TestSurveyResponseRepository_FindByTransactionID_NotFound(t *testing.T) {
+	// Arrange
+	repo := NewSurveyResponseRepository(setupTestDB(t))
+
+	// Act
+	_, err := repo.FindByTransactionID(nil, shared.NewEntityID[survey.TransactionMarker]())
+
+	// Assert
+	assert.ErrorIs(t, err, survey.ErrResponseNotFound)
+}
@@ -0,0 +1,73 @@
+package survey
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// SurveyTokenUsageRepositoryImpl
+// ===========================
+
+// SurveyTokenUsageRepositoryImpl 問卷 Token 使用紀錄倉儲實現（GORM）
+//
+// 設計原則：以主鍵唯一約束保證單次使用（併發提交時只有一筆成功）
+type SurveyTokenUsageRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewSurveyTokenUsageRepository 創建新的 Token 使用紀錄倉儲實例
+func NewSurveyTokenUsageRepository(db *gorm.DB) survey.SurveyTokenUsageRepository {
+	return &SurveyTokenUsageRepositoryImpl{db: db}
+}
+
+// MarkUsed 記錄 Token 已使用
+//
+// 錯誤處理：
+// - 主鍵衝突 → ErrSurveyTokenUsed
+func (r *SurveyTokenUsageRepositoryImpl) MarkUsed(
+	ctx shared.TransactionContext,
+	tokenID survey.TokenID,
+	transactionID survey.TransactionID,
+	usedAt time.Time,
+) error {
+	result := r.getDB(ctx).Create(&SurveyTokenUsageGORM{
+		TokenID:       tokenID.String(),
+		TransactionID: transactionID.String(),
+		UsedAt:        usedAt,
+	})
+	if result.Error != nil {
+		if isUniqueConstraintError(result.Error) {
+			return survey.ErrSurveyTokenUsed.WithContext(
+				"token_id", tokenID.String(),
+			)
+		}
+		return result.Error
+	}
+	return nil
+}
+
+// IsUsed 判斷 Token 是否已使用
+func (r *SurveyTokenUsageRepositoryImpl) IsUsed(ctx shared.TransactionContext, tokenID survey.TokenID) (bool, error) {
+	var count int64
+	result := r.getDB(ctx).Model(&SurveyTokenUsageGORM{}).
+		Where("token_id = ?", tokenID.String()).
+		Count(&count)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return count > 0, nil
+}
+
+// getDB 獲取 GORM DB 實例（ctx 可為 nil）
+func (r *SurveyTokenUsageRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
@@ -0,0 +1,38 @@
+package survey
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// SurveyTokenUsageRepository Integration Tests
+// ===========================
+
+// Test 1: Token can be marked used only once
+func TestSurveyTokenUsageRepository_MarkUsed_OnlyOnce(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewSurveyTokenUsageRepository(db)
+	tokenID := survey.NewTokenID()
+	transactionID := shared.NewEntityID[survey.TransactionMarker]()
+
+	// Act
+	usedBefore, err := repo.IsUsed(nil, tokenID)
+	require.NoError(t, err)
+	firstErr := repo.MarkUsed(nil, tokenID, transactionID, time.Now())
+	secondErr := repo.MarkUsed(nil, tokenID, transactionID, time.Now())
+	usedAfter, err := repo.IsUsed(nil, tokenID)
+	require.NoError(t, err)
+
+	// Assert
+	assert.False(t, usedBefore)
+	assert.NoError(t, firstErr)
+	assert.ErrorIs(t, secondErr, survey.ErrSurveyTokenUsed)
+	assert.True(t, usedAfter)
+}
@@ -0,0 +1,92 @@
+package tier
+
+import (
+	"errors"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/tier"
+	"gorm.io/gorm"
+)
+
+// gormTransactionContext GORM 事務上下文
+type gormTransactionContext interface {
+	shared.TransactionContext
+	GetDB() *gorm.DB
+}
+
+// ===========================
+// MemberTierRepositoryImpl
+// ===========================
+
+// MemberTierRepositoryImpl 會員等級倉儲實現（GORM）
+type MemberTierRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewMemberTierRepository 創建新的會員等級倉儲實例
+func NewMemberTierRepository(db *gorm.DB) tier.MemberTierRepository {
+	return &MemberTierRepositoryImpl{db: db}
+}
+
+// Save 保存會員等級（主鍵存在時覆蓋）
+func (r *MemberTierRepositoryImpl) Save(ctx shared.TransactionContext, t *tier.MemberTier) error {
+	return r.getDB(ctx).Save(toGORM(t)).Error
+}
+
+// FindByMemberID 根據會員 ID 查找
+func (r *MemberTierRepositoryImpl) FindByMemberID(
+	ctx shared.TransactionContext,
+	memberID tier.MemberID,
+) (*tier.MemberTier, error) {
+	var gormModel MemberTierGORM
+	result := r.getDB(ctx).Where("member_id = ?", memberID.String()).First(&gormModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, tier.ErrMemberTierNotFound.WithContext(
+				"member_id", memberID.String(),
+			)
+		}
+		return nil, result.Error
+	}
+	return gormModel.toDomain()
+}
+
+// FindAboveBasic 查詢等級高於 basic 的會員
+func (r *MemberTierRepositoryImpl) FindAboveBasic(ctx shared.TransactionContext) ([]*tier.MemberTier, error) {
+	var gormModels []MemberTierGORM
+	result := r.getDB(ctx).
+		Where("level <> ?", tier.LevelBasic.String()).
+		Order("member_id ASC").
+		Find(&gormModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	tiers := make([]*tier.MemberTier, 0, len(gormModels))
+	for i := range gormModels {
+		t, err := gormModels[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		tiers = append(tiers, t)
+	}
+	return tiers, nil
+}
+
+// ===========================
+// Helper Methods
+// ===========================
+
+// getDB 獲取 GORM DB 實例
+//
+// 行為：
+//   - ctx != nil: 使用事務中的 DB（從 TransactionContext 獲取）
+//   - ctx == nil: 使用預設 DB（auto-commit 模式）
+func (r *MemberTierRepositoryImpl) getDB(ctx shared.TransactionContext) *gorm.DB {
+	if ctx != nil {
+		if txCtx, ok := ctx.(gormTransactionContext); ok {
+			return txCtx.GetDB()
+		}
+	}
+	return r.db
+}
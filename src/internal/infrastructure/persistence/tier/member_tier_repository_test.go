@@ -0,0 +1,88 @@
+package tier
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/tier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// ===========================
+// MemberTierRepository Integration Tests
+// ===========================
+
+// setupTestDB 創建測試資料庫（in-memory SQLite）
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err, "failed to connect to test database")
+
+	err = db.AutoMigrate(&MemberTierGORM{})
+	require.NoError(t, err, "failed to migrate database schema")
+
+	return db
+}
+
+// evaluatedTier 建立並以指定消費評估的會員等級
+func evaluatedTier(t *testing.T, spend int, now time.Time) *tier.MemberTier {
+	memberTier, err := tier.NewMemberTier(shared.NewEntityID[tier.MemberMarker](), now)
+	require.NoError(t, err)
+	tier.NewTierEvaluationService(tier.DefaultTierPolicy()).Evaluate(memberTier, spend, now)
+	return memberTier
+}
+
+// Test 1: Save upserts and FindByMemberID restores level and grace period
+func TestMemberTierRepository_SaveAndFind(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewMemberTierRepository(db)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	memberTier := evaluatedTier(t, 35000, now)
+	require.NoError(t, repo.Save(nil, memberTier))
+
+	tier.NewTierEvaluationService(tier.DefaultTierPolicy()).Evaluate(memberTier, 15000, now.AddDate(0, 1, 0))
+	require.NoError(t, repo.Save(nil, memberTier))
+
+	// Act
+	found, err := repo.FindByMemberID(nil, memberTier.MemberID())
+	_, missing := repo.FindByMemberID(nil, shared.NewEntityID[tier.MemberMarker]())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, tier.LevelGold, found.Level())
+	assert.Equal(t, 15000, found.RollingSpend())
+	require.True(t, found.IsInGracePeriod())
+	assert.True(t, memberTier.DemotionDueAt().Equal(*found.DemotionDueAt()))
+	assert.ErrorIs(t, missing, tier.ErrMemberTierNotFound)
+}
+
+// Test 2: FindAboveBasic excludes basic members
+func TestMemberTierRepository_FindAboveBasic(t *testing.T) {
+	// Arrange
+	db := setupTestDB(t)
+	repo := NewMemberTierRepository(db)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	silver := evaluatedTier(t, 12000, now)
+	black := evaluatedTier(t, 90000, now)
+	require.NoError(t, repo.Save(nil, silver))
+	require.NoError(t, repo.Save(nil, black))
+	require.NoError(t, repo.Save(nil, evaluatedTier(t, 500, now)))
+
+	// Act
+	tiers, err := repo.FindAboveBasic(nil)
+
+	// Assert
+	require.NoError(t, err)
+	levels := make(map[string]tier.Level, len(tiers))
+	for _, found := range tiers {
+		levels[found.MemberID().String()] = found.Level()
+	}
+	assert.Equal(t, map[string]tier.Level{
+		silver.MemberID().String(): tier.LevelSilver,
+		black.MemberID().String():  tier.LevelBlack,
+	}, levels)
+}
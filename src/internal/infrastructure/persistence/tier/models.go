@@ -0,0 +1,77 @@
+package tier
+
+import (
+	"time"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/tier"
+)
+
+// ===========================
+// GORM Models
+// ===========================
+
+// MemberTierGORM 會員等級資料表模型
+//
+// 資料庫約束：
+// - member_id: 主鍵（每位會員最多一筆）
+// - level: 查詢索引（定期評估時找出高於 basic 的會員）
+// - demotion_due_at: 降級寬限期屆滿時間（未在寬限期時為 NULL）
+type MemberTierGORM struct {
+	MemberID      string     `gorm:"column:member_id;type:varchar(36);primaryKey"`
+	Level         string     `gorm:"column:level;type:varchar(20);index;not null"`
+	RollingSpend  int        `gorm:"column:rolling_spend;not null"`
+	DemotionDueAt *time.Time `gorm:"column:demotion_due_at"` // Nullable
+
+	LevelChangedAt time.Time `gorm:"column:level_changed_at;not null"`
+	EvaluatedAt    time.Time `gorm:"column:evaluated_at;not null"`
+
+	// 審計欄位
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+}
+
+// TableName 指定資料表名稱
+func (MemberTierGORM) TableName() string {
+	return "member_tiers"
+}
+
+// ===========================
+// Mapper Functions
+// ===========================
+
+// toDomain 將 GORM 模型轉換為 Domain 模型
+func (g *MemberTierGORM) toDomain() (*tier.MemberTier, error) {
+	memberID, err := tier.MemberIDFromString(g.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	level, err := tier.ParseLevel(g.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	return tier.ReconstructMemberTier(
+		memberID,
+		level,
+		g.RollingSpend,
+		g.DemotionDueAt,
+		g.LevelChangedAt,
+		g.EvaluatedAt,
+		g.CreatedAt,
+		g.UpdatedAt,
+	)
+}
+
+// toGORM 將 Domain 模型轉換為 GORM 模型
+func toGORM(t *tier.MemberTier) *MemberTierGORM {
+	return &MemberTierGORM{
+		MemberID:       t.MemberID().String(),
+		Level:          t.Level().String(),
+		RollingSpend:   t.RollingSpend(),
+		DemotionDueAt:  t.DemotionDueAt(),
+		LevelChangedAt: t.LevelChangedAt(),
+		EvaluatedAt:    t.EvaluatedAt(),
+		CreatedAt:      t.CreatedAt(),
+		UpdatedAt:      t.UpdatedAt(),
+	}
+}
@@ -0,0 +1,151 @@
+package qrcode
+
+import (
+	"image"
+	"image/color"
+)
+
+// ===========================
+// bitMatrix 二值化影像
+// ===========================
+
+// bitMatrix 二值矩陣（true = 深色模組 / 像素）
+type bitMatrix struct {
+	width  int
+	height int
+	bits   []bool
+}
+
+func newBitMatrix(width, height int) *bitMatrix {
+	return &bitMatrix{width: width, height: height, bits: make([]bool, width*height)}
+}
+
+func (m *bitMatrix) get(x, y int) bool {
+	return m.bits[y*m.width+x]
+}
+
+func (m *bitMatrix) set(x, y int, dark bool) {
+	m.bits[y*m.width+x] = dark
+}
+
+// bit 返回 0 / 1（讀取格式與版本資訊用）
+func (m *bitMatrix) bit(x, y int) int {
+	if m.get(x, y) {
+		return 1
+	}
+	return 0
+}
+
+// setRegion 將矩形區域設為深色
+func (m *bitMatrix) setRegion(left, top, width, height int) {
+	for y := top; y < top+height; y++ {
+		for x := left; x < left+width; x++ {
+			m.set(x, y, true)
+		}
+	}
+}
+
+// inBounds 判斷座標是否在影像內
+func (m *bitMatrix) inBounds(x, y int) bool {
+	return x >= 0 && y >= 0 && x < m.width && y < m.height
+}
+
+// ===========================
+// 灰階轉換與二值化
+// ===========================
+
+// maxImageDimension 超過此邊長的照片先縮小（手機照片常見 4000px，縮小後足以辨識發票 QR Code）
+const maxImageDimension = 1600
+
+// luminanceImage 灰階影像
+type luminanceImage struct {
+	width  int
+	height int
+	pixels []uint8
+}
+
+// toLuminance 轉為灰階並視需要以區塊平均縮小
+func toLuminance(img image.Image) *luminanceImage {
+	bounds := img.Bounds()
+	scale := (max(bounds.Dx(), bounds.Dy()) + maxImageDimension - 1) / maxImageDimension
+	if scale < 1 {
+		scale = 1
+	}
+
+	width, height := bounds.Dx()/scale, bounds.Dy()/scale
+	lum := &luminanceImage{width: width, height: height, pixels: make([]uint8, width*height)}
+	gray := grayReader(img)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			sum := 0
+			for dy := 0; dy < scale; dy++ {
+				for dx := 0; dx < scale; dx++ {
+					sum += int(gray(bounds.Min.X+x*scale+dx, bounds.Min.Y+y*scale+dy))
+				}
+			}
+			lum.pixels[y*width+x] = uint8(sum / (scale * scale))
+		}
+	}
+	return lum
+}
+
+// grayReader 返回讀取單一像素亮度的函數（JPEG / 灰階影像直接讀取 Y 通道）
+func grayReader(img image.Image) func(x, y int) uint8 {
+	switch src := img.(type) {
+	case *image.YCbCr:
+		return func(x, y int) uint8 {
+			return src.Y[src.YOffset(x, y)]
+		}
+	case *image.Gray:
+		return func(x, y int) uint8 {
+			return src.GrayAt(x, y).Y
+		}
+	default:
+		return func(x, y int) uint8 {
+			return color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y
+		}
+	}
+}
+
+// 區域門檻參數
+const (
+	// thresholdWindowDivisor 區域視窗邊長 = 影像長邊 / thresholdWindowDivisor
+	thresholdWindowDivisor = 8
+
+	// minThresholdWindow 最小區域視窗邊長（像素）
+	minThresholdWindow = 16
+
+	// thresholdPercent 低於區域平均亮度此百分比視為深色
+	thresholdPercent = 12
+)
+
+// binarize 區域平均門檻二值化（Bradley 演算法）
+//
+// 設計考量：手機照片常有光線不均與陰影，固定門檻無法同時處理亮部與暗部的 QR Code
+func binarize(lum *luminanceImage) *bitMatrix {
+	width, height := lum.width, lum.height
+	stride := width + 1
+	integral := make([]int64, stride*(height+1))
+	for y := 0; y < height; y++ {
+		var rowSum int64
+		for x := 0; x < width; x++ {
+			rowSum += int64(lum.pixels[y*width+x])
+			integral[(y+1)*stride+x+1] = integral[y*stride+x+1] + rowSum
+		}
+	}
+
+	half := max(max(width, height)/thresholdWindowDivisor, minThresholdWindow) / 2
+	matrix := newBitMatrix(width, height)
+	for y := 0; y < height; y++ {
+		top, bottom := max(y-half, 0), min(y+half+1, height)
+		for x := 0; x < width; x++ {
+			left, right := max(x-half, 0), min(x+half+1, width)
+			count := int64((right - left) * (bottom - top))
+			sum := integral[bottom*stride+right] - integral[top*stride+right] -
+				integral[bottom*stride+left] + integral[top*stride+left]
+			pixel := int64(lum.pixels[y*width+x])
+			matrix.set(x, y, pixel*count*100 < sum*(100-thresholdPercent))
+		}
+	}
+	return matrix
+}
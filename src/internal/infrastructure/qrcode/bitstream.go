@@ -0,0 +1,206 @@
+package qrcode
+
+import (
+	"errors"
+	"strings"
+)
+
+// ===========================
+// 資料位元流解碼
+// ===========================
+
+// errInvalidBitstream 資料位元流格式錯誤
+var errInvalidBitstream = errors.New("qrcode: invalid data bitstream")
+
+// 編碼模式指示符
+const (
+	modeTerminator       = 0x0
+	modeNumeric          = 0x1
+	modeAlphanumeric     = 0x2
+	modeStructuredAppend = 0x3
+	modeByte             = 0x4
+	modeFNC1First        = 0x5
+	modeECI              = 0x7
+	modeKanji            = 0x8
+	modeFNC1Second       = 0x9
+)
+
+// alphanumericChars 英數模式字元表
+const alphanumericChars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+// bitReader 依序讀取位元
+type bitReader struct {
+	data   []byte
+	offset int
+}
+
+func (r *bitReader) available() int {
+	return len(r.data)*8 - r.offset
+}
+
+func (r *bitReader) read(n int) (int, error) {
+	if n > r.available() {
+		return 0, errInvalidBitstream
+	}
+	value := 0
+	for i := 0; i < n; i++ {
+		b := r.data[r.offset>>3] >> (7 - r.offset&7) & 1
+		value = value<<1 | int(b)
+		r.offset++
+	}
+	return value, nil
+}
+
+// characterCountBits 字元數欄位長度（依版本區間）
+func characterCountBits(mode, version int) int {
+	index := 0
+	if version >= 27 {
+		index = 2
+	} else if version >= 10 {
+		index = 1
+	}
+	switch mode {
+	case modeNumeric:
+		return [3]int{10, 12, 14}[index]
+	case modeAlphanumeric:
+		return [3]int{9, 11, 13}[index]
+	case modeByte:
+		return [3]int{8, 16, 16}[index]
+	default:
+		return [3]int{8, 10, 12}[index]
+	}
+}
+
+// decodeBitstream 將資料碼字解碼為文字
+//
+// 支援模式：數字、英數、位元組、漢字（以 Shift_JIS 位元組輸出），ECI / 結構化附加 / FNC1 僅略過標頭
+// 位元組模式原樣輸出（電子發票 QR Code 以 UTF-8 或 Big5 位元組編碼，由呼叫端依內容參數處理）
+func decodeBitstream(data []byte, version int) (string, error) {
+	r := &bitReader{data: data}
+	var out strings.Builder
+
+	for r.available() >= 4 {
+		mode, _ := r.read(4)
+		switch mode {
+		case modeTerminator:
+			return out.String(), nil
+		case modeStructuredAppend:
+			if _, err := r.read(16); err != nil {
+				return "", err
+			}
+		case modeFNC1First:
+		case modeFNC1Second:
+			if _, err := r.read(8); err != nil {
+				return "", err
+			}
+		case modeECI:
+			if err := skipECIDesignator(r); err != nil {
+				return "", err
+			}
+		case modeNumeric, modeAlphanumeric, modeByte, modeKanji:
+			count, err := r.read(characterCountBits(mode, version))
+			if err != nil {
+				return "", err
+			}
+			if err := decodeSegment(r, &out, mode, count); err != nil {
+				return "", err
+			}
+		default:
+			return "", errInvalidBitstream
+		}
+	}
+	return out.String(), nil
+}
+
+// skipECIDesignator 略過 ECI 指定值（1～3 位元組）
+func skipECIDesignator(r *bitReader) error {
+	first, err := r.read(8)
+	if err != nil {
+		return err
+	}
+	switch {
+	case first&0x80 == 0:
+		return nil
+	case first&0xC0 == 0x80:
+		_, err = r.read(8)
+	case first&0xE0 == 0xC0:
+		_, err = r.read(16)
+	default:
+		err = errInvalidBitstream
+	}
+	return err
+}
+
+// decodeSegment 解碼單一區段
+func decodeSegment(r *bitReader, out *strings.Builder, mode, count int) error {
+	switch mode {
+	case modeNumeric:
+		for ; count >= 3; count -= 3 {
+			v, err := r.read(10)
+			if err != nil || v >= 1000 {
+				return errInvalidBitstream
+			}
+			out.WriteByte(byte('0' + v/100))
+			out.WriteByte(byte('0' + v/10%10))
+			out.WriteByte(byte('0' + v%10))
+		}
+		switch count {
+		case 2:
+			v, err := r.read(7)
+			if err != nil || v >= 100 {
+				return errInvalidBitstream
+			}
+			out.WriteByte(byte('0' + v/10))
+			out.WriteByte(byte('0' + v%10))
+		case 1:
+			v, err := r.read(4)
+			if err != nil || v >= 10 {
+				return errInvalidBitstream
+			}
+			out.WriteByte(byte('0' + v))
+		}
+
+	case modeAlphanumeric:
+		for ; count >= 2; count -= 2 {
+			v, err := r.read(11)
+			if err != nil || v >= 45*45 {
+				return errInvalidBitstream
+			}
+			out.WriteByte(alphanumericChars[v/45])
+			out.WriteByte(alphanumericChars[v%45])
+		}
+		if count == 1 {
+			v, err := r.read(6)
+			if err != nil || v >= 45 {
+				return errInvalidBitstream
+			}
+			out.WriteByte(alphanumericChars[v])
+		}
+
+	case modeByte:
+		for i := 0; i < count; i++ {
+			v, err := r.read(8)
+			if err != nil {
+				return err
+			}
+			out.WriteByte(byte(v))
+		}
+
+	case modeKanji:
+		for i := 0; i < count; i++ {
+			v, err := r.read(13)
+			if err != nil {
+				return err
+			}
+			assembled := (v/0xC0)<<8 | v%0xC0
+			if assembled < 0x1F00 {
+				assembled += 0x8140
+			} else {
+				assembled += 0xC140
+			}
+			out.WriteByte(byte(assembled >> 8))
+			out.WriteByte(byte(assembled))
+		}
+	}
+	return nil
+}
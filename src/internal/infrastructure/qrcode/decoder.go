@@ -0,0 +1,176 @@
+package qrcode
+
+import (
+	"bytes"
+	"image"
+	_ "image/jpeg" // 註冊 JPEG 解碼器
+	_ "image/png"  // 註冊 PNG 解碼器
+	"sort"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+)
+
+// ===========================
+// Decoder
+// ===========================
+
+// Decoder QR Code 解碼器（純 Go 實作，不依賴 cgo 或外部服務）
+//
+// 處理流程：
+// 1. 解碼 JPEG / PNG 並轉為灰階（過大的照片先縮小）
+// 2. 區域門檻二值化
+// 3. 尋找定位圖形並分組（一張照片可含多個 QR Code，例如電子發票並排的左右兩個）
+// 4. 透視變換取樣、讀取格式資訊、解除遮罩、Reed-Solomon 糾錯、解碼資料
+//
+// 設計原則：
+// - 無狀態，可安全地被多個 goroutine 共用
+// - 實作 presentation/linebot.QRCodeDecoder
+type Decoder struct{}
+
+// NewDecoder 創建 QR Code 解碼器
+func NewDecoder() *Decoder {
+	return &Decoder{}
+}
+
+// Decode 解析圖片中所有 QR Code 的內容（依 QR Code 在圖片中的位置由左至右排序）
+//
+// 錯誤：
+// - 無法解碼圖片、或找不到可解析的 QR Code → invoice.ErrQRCodeNotFound
+func (d *Decoder) Decode(data []byte) ([]string, error) {
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, invoice.ErrQRCodeNotFound.WithContext(
+			"reason", "unsupported image",
+			"error", err.Error(),
+		)
+	}
+
+	results := decodeAll(binarize(toLuminance(img)))
+	if len(results) == 0 {
+		return nil, invoice.ErrQRCodeNotFound.WithContext(
+			"format", format,
+			"width", img.Bounds().Dx(),
+			"height", img.Bounds().Dy(),
+		)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].center.x < results[j].center.x
+	})
+	payloads := make([]string, len(results))
+	for i, r := range results {
+		payloads[i] = r.text
+	}
+	return payloads, nil
+}
+
+// decodeResult 單一 QR Code 的解碼結果
+type decodeResult struct {
+	text   string
+	center point
+}
+
+// decodeAll 依定位圖形組合逐一嘗試解碼；成功後排除已使用的定位圖形
+func decodeAll(image *bitMatrix) []decodeResult {
+	patterns := findFinderPatterns(image)
+	if len(patterns) < 3 {
+		return nil
+	}
+
+	used := map[*finderPattern]bool{}
+	var results []decodeResult
+	for _, triple := range groupFinderPatterns(patterns) {
+		if used[triple.topLeft] || used[triple.topRight] || used[triple.bottomLeft] {
+			continue
+		}
+		text, ok := decodeTriple(image, triple)
+		if !ok {
+			continue
+		}
+		used[triple.topLeft], used[triple.topRight], used[triple.bottomLeft] = true, true, true
+		results = append(results, decodeResult{
+			text: text,
+			center: point{
+				x: (triple.topRight.center.x + triple.bottomLeft.center.x) / 2,
+				y: (triple.topRight.center.y + triple.bottomLeft.center.y) / 2,
+			},
+		})
+	}
+	return results
+}
+
+// decodeTriple 以定位圖形組合解碼（依估算邊長與是否使用校正圖形逐一嘗試）
+func decodeTriple(image *bitMatrix, triple finderTriple) (string, bool) {
+	for _, dimension := range triple.estimateDimensions() {
+		for _, transform := range candidateTransforms(image, triple, dimension) {
+			grid, ok := sampleGrid(image, transform, dimension, triple.moduleSize)
+			if !ok {
+				continue
+			}
+			if text, err := decodeGrid(grid); err == nil {
+				return text, true
+			}
+		}
+	}
+	return "", false
+}
+
+// candidateTransforms 建立模組座標到影像座標的變換
+//
+// 對應點：三個定位圖形中心 + 右下角（優先使用校正圖形，找不到時以平行四邊形推估）
+func candidateTransforms(image *bitMatrix, triple finderTriple, dimension int) []perspectiveTransform {
+	tl, tr, bl := triple.topLeft.center, triple.topRight.center, triple.bottomLeft.center
+	last := float64(dimension) - 3.5
+	src := [4]point{{3.5, 3.5}, {last, 3.5}, {3.5, last}, {last, last}}
+	bottomRight := point{x: tr.x - tl.x + bl.x, y: tr.y - tl.y + bl.y}
+
+	var transforms []perspectiveTransform
+	if dimension > dimensionForVersion(1) {
+		// 右下校正圖形中心位於模組座標 (dimension-6.5, dimension-6.5)
+		modulesBetween := float64(dimension) - 7
+		ux := point{x: (tr.x - tl.x) / modulesBetween, y: (tr.y - tl.y) / modulesBetween}
+		uy := point{x: (bl.x - tl.x) / modulesBetween, y: (bl.y - tl.y) / modulesBetween}
+		correction := 1 - 3/modulesBetween
+		estimate := point{
+			x: tl.x + correction*(bottomRight.x-tl.x),
+			y: tl.y + correction*(bottomRight.y-tl.y),
+		}
+		if alignment, ok := findAlignmentPattern(image, estimate, ux, uy); ok {
+			alignmentSrc := src
+			alignmentSrc[3] = point{x: float64(dimension) - 6.5, y: float64(dimension) - 6.5}
+			if t, ok := newPerspectiveTransform(alignmentSrc, [4]point{tl, tr, bl, alignment}); ok {
+				transforms = append(transforms, t)
+			}
+		}
+	}
+
+	if t, ok := newPerspectiveTransform(src, [4]point{tl, tr, bl, bottomRight}); ok {
+		transforms = append(transforms, t)
+	}
+	return transforms
+}
+
+// decodeGrid 解碼取樣後的模組矩陣
+func decodeGrid(grid *bitMatrix) (string, error) {
+	version := (grid.width - 17) / 4
+	if version < minVersion || version > maxVersion || dimensionForVersion(version) != grid.width {
+		return "", errInvalidBitstream
+	}
+
+	format, ok := readFormatInfo(grid)
+	if !ok {
+		return "", errInvalidBitstream
+	}
+	if version >= 7 {
+		// 版本資訊與估算邊長不符時由呼叫端改試其他邊長
+		if decoded, ok := readVersionInfo(grid); !ok || decoded != version {
+			return "", errInvalidBitstream
+		}
+	}
+
+	data, err := correctCodewords(readCodewords(grid, version, format.mask), version, format.level)
+	if err != nil {
+		return "", err
+	}
+	return decodeBitstream(data, version)
+}
@@ -0,0 +1,143 @@
+package qrcode
+
+import (
+	"encoding/json"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// QR Code Decoder Tests
+// ===========================
+
+// loadCorpus 讀取樣本照片與預期解碼結果（testdata/corpus.json）
+//
+// 樣本涵蓋：並排左右 QR Code、旋轉、透視、倒置、光線不均、JPEG 壓縮雜訊、大尺寸照片、只拍到左側、無 QR Code
+func loadCorpus(t *testing.T) map[string][]string {
+	t.Helper()
+	raw, err := os.ReadFile(filepath.Join("testdata", "corpus.json"))
+	require.NoError(t, err)
+	var corpus map[string][]string
+	require.NoError(t, json.Unmarshal(raw, &corpus))
+	require.NotEmpty(t, corpus)
+	return corpus
+}
+
+// Test 1: 樣本照片解碼（結果依照片中由左至右排序）
+func TestDecoder_DecodeCorpus(t *testing.T) {
+	corpus := loadCorpus(t)
+	names := make([]string, 0, len(corpus))
+	for name := range corpus {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	decoder := NewDecoder()
+	for _, name := range names {
+		expected := corpus[name]
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			data, err := os.ReadFile(filepath.Join("testdata", name))
+			require.NoError(t, err)
+
+			// Act
+			payloads, err := decoder.Decode(data)
+
+			// Assert
+			if len(expected) == 0 {
+				assert.ErrorIs(t, err, invoice.ErrQRCodeNotFound)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, expected, payloads)
+		})
+	}
+}
+
+// Test 2: 非圖片資料返回未找到 QR Code
+func TestDecoder_InvalidImage(t *testing.T) {
+	// Act
+	_, err := NewDecoder().Decode([]byte("not an image"))
+
+	// Assert
+	assert.ErrorIs(t, err, invoice.ErrQRCodeNotFound)
+}
+
+// Test 3: 解碼結果可直接交由發票解析（左右 QR Code 合併品目）
+func TestDecoder_FeedsInvoiceParser(t *testing.T) {
+	// Arrange
+	data, err := os.ReadFile(filepath.Join("testdata", "invoice_pair_rotated.jpg"))
+	require.NoError(t, err)
+	payloads, err := NewDecoder().Decode(data)
+	require.NoError(t, err)
+
+	// Act
+	qr, err := invoice.ParseInvoiceQRCode(payloads)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "AB11223344", qr.InvoiceNumber().String())
+	assert.Equal(t, 250, qr.TotalAmount().Amount())
+	assert.Equal(t, []invoice.InvoiceItem{
+		{Name: "莫西多", Quantity: "1", UnitPrice: "180"},
+		{Name: "炸雞翅", Quantity: "1", UnitPrice: "70"},
+		{Name: "招待小菜", Quantity: "1", UnitPrice: "0"},
+	}, qr.Items())
+}
+
+// rsEncode 計算 Reed-Solomon 糾錯碼字（測試用）
+func rsEncode(data []byte, ecCount int) []byte {
+	generator := []byte{1}
+	for i := 0; i < ecCount; i++ {
+		next := make([]byte, len(generator)+1)
+		for j, c := range generator {
+			next[j] ^= c
+			next[j+1] ^= gfMul(c, gfPow(i))
+		}
+		generator = next
+	}
+
+	remainder := make([]byte, ecCount)
+	for _, d := range data {
+		factor := d ^ remainder[0]
+		copy(remainder, remainder[1:])
+		remainder[ecCount-1] = 0
+		for j := 0; j < ecCount; j++ {
+			remainder[j] ^= gfMul(generator[j+1], factor)
+		}
+	}
+	return append(append([]byte{}, data...), remainder...)
+}
+
+// Test 4: Reed-Solomon 修正 ecCount/2 個錯誤，超出時返回錯誤
+func TestRSCorrect(t *testing.T) {
+	// Arrange
+	r := rand.New(rand.NewSource(1))
+	data := make([]byte, 40)
+	r.Read(data)
+	const ecCount = 18
+	encoded := rsEncode(data, ecCount)
+
+	corrupt := func(errors int) []byte {
+		block := append([]byte{}, encoded...)
+		for _, i := range r.Perm(len(block))[:errors] {
+			block[i] ^= byte(r.Intn(255) + 1)
+		}
+		return block
+	}
+
+	// Act & Assert
+	for errors := 0; errors <= ecCount/2; errors++ {
+		block := corrupt(errors)
+		require.NoError(t, rsCorrect(block, ecCount), "errors=%d", errors)
+		assert.Equal(t, encoded, block, "errors=%d", errors)
+	}
+	assert.Error(t, rsCorrect(corrupt(ecCount), ecCount))
+}
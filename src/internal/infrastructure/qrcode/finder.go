@@ -0,0 +1,412 @@
+package qrcode
+
+import (
+	"math"
+	"sort"
+)
+
+// ===========================
+// 定位圖形（Finder Pattern）偵測
+// ===========================
+
+// point 影像座標
+type point struct {
+	x float64
+	y float64
+}
+
+func distance(a, b point) float64 {
+	return math.Hypot(a.x-b.x, a.y-b.y)
+}
+
+// finderPattern 定位圖形候選（深:淺:深:淺:深 = 1:1:3:1:1）
+type finderPattern struct {
+	center     point
+	moduleSize float64
+	count      int // 被掃描線命中的次數（越多越可信）
+}
+
+// 偵測參數
+const (
+	// maxScanRows 每張影像最多掃描的列數（超過時跳列掃描）
+	maxScanRows = 400
+
+	// maxFinderCandidates 參與組合的定位圖形候選上限（並排兩個 QR Code 共 6 個）
+	maxFinderCandidates = 15
+)
+
+// finderPatternFinder 逐列掃描尋找定位圖形
+type finderPatternFinder struct {
+	image    *bitMatrix
+	patterns []*finderPattern
+}
+
+// findFinderPatterns 返回所有定位圖形候選（依命中次數排序）
+func findFinderPatterns(image *bitMatrix) []*finderPattern {
+	f := &finderPatternFinder{image: image}
+	step := max(image.height/maxScanRows, 1)
+	for y := step / 2; y < image.height; y += step {
+		f.scanRow(y)
+	}
+
+	patterns := f.patterns
+	confirmed := make([]*finderPattern, 0, len(patterns))
+	for _, p := range patterns {
+		if p.count >= 2 {
+			confirmed = append(confirmed, p)
+		}
+	}
+	if len(confirmed) >= 3 {
+		patterns = confirmed
+	}
+
+	sort.SliceStable(patterns, func(i, j int) bool {
+		return patterns[i].count > patterns[j].count
+	})
+	if len(patterns) > maxFinderCandidates {
+		patterns = patterns[:maxFinderCandidates]
+	}
+	return patterns
+}
+
+// scanRow 掃描單列，記錄深淺交替的 5 段長度
+func (f *finderPatternFinder) scanRow(y int) {
+	var counts [5]int
+	state := 0
+	for x := 0; x < f.image.width; x++ {
+		if f.image.get(x, y) {
+			if state == 1 || state == 3 {
+				state++
+			}
+			counts[state]++
+			continue
+		}
+
+		switch {
+		case state == 1 || state == 3:
+			counts[state]++
+		case state == 4:
+			if isFinderRatio(counts) {
+				f.tryCenter(counts, x, y)
+			}
+			counts = [5]int{counts[2], counts[3], counts[4], 1, 0}
+			state = 3
+		case counts[state] > 0:
+			state++
+			counts[state]++
+		}
+	}
+	if state == 4 && isFinderRatio(counts) {
+		f.tryCenter(counts, f.image.width, y)
+	}
+}
+
+// isFinderRatio 檢查 5 段長度是否符合 1:1:3:1:1（容許 50% 誤差）
+func isFinderRatio(counts [5]int) bool {
+	total := 0
+	for _, c := range counts {
+		if c == 0 {
+			return false
+		}
+		total += c
+	}
+	if total < 7 {
+		return false
+	}
+
+	module := float64(total) / 7
+	variance := module / 2
+	return math.Abs(module-float64(counts[0])) < variance &&
+		math.Abs(module-float64(counts[1])) < variance &&
+		math.Abs(3*module-float64(counts[2])) < 3*variance &&
+		math.Abs(module-float64(counts[3])) < variance &&
+		math.Abs(module-float64(counts[4])) < variance
+}
+
+func sumCounts(counts [5]int) int {
+	return counts[0] + counts[1] + counts[2] + counts[3] + counts[4]
+}
+
+// tryCenter 以垂直與水平交叉檢查確認候選中心
+func (f *finderPatternFinder) tryCenter(counts [5]int, endX, y int) {
+	total := sumCounts(counts)
+	centerX := float64(endX-counts[4]-counts[3]) - float64(counts[2])/2
+
+	centerY, ok := f.crossCheck(int(centerX), y, 0, 1, counts[2], total)
+	if !ok {
+		return
+	}
+	centerX, ok = f.crossCheck(int(centerX), int(centerY), 1, 0, counts[2], total)
+	if !ok {
+		return
+	}
+	f.addPattern(point{x: centerX, y: centerY}, float64(total)/7)
+}
+
+// crossCheck 從 (x, y) 沿 (dx, dy) 方向雙向量測 5 段長度，返回該方向上的中心座標
+func (f *finderPatternFinder) crossCheck(x, y, dx, dy, maxCount, originalTotal int) (float64, bool) {
+	image := f.image
+	if !image.inBounds(x, y) || !image.get(x, y) {
+		return 0, false
+	}
+
+	var counts [5]int
+	// 反方向：中心深色 → 淺色 → 外框深色
+	cx, cy := x, y
+	for i, dark := range []bool{true, false, true} {
+		state := 2 - i
+		for image.inBounds(cx, cy) && image.get(cx, cy) == dark && (state == 2 || counts[state] <= maxCount) {
+			counts[state]++
+			cx, cy = cx-dx, cy-dy
+		}
+		if state != 0 && !image.inBounds(cx, cy) {
+			return 0, false
+		}
+		if state != 2 && counts[state] > maxCount {
+			return 0, false
+		}
+	}
+
+	// 正方向：中心深色 → 淺色 → 外框深色
+	cx, cy = x+dx, y+dy
+	for i, dark := range []bool{true, false, true} {
+		state := 2 + i
+		for image.inBounds(cx, cy) && image.get(cx, cy) == dark && (state == 2 || counts[state] <= maxCount) {
+			counts[state]++
+			cx, cy = cx+dx, cy+dy
+		}
+		if state != 4 && !image.inBounds(cx, cy) {
+			return 0, false
+		}
+		if state != 2 && counts[state] > maxCount {
+			return 0, false
+		}
+	}
+
+	total := sumCounts(counts)
+	if 5*abs(total-originalTotal) >= 2*originalTotal || !isFinderRatio(counts) {
+		return 0, false
+	}
+
+	end := cx*dx + cy*dy
+	return float64(end-counts[4]-counts[3]) - float64(counts[2])/2, true
+}
+
+// addPattern 合併鄰近的重複偵測（加權平均中心與模組大小）
+func (f *finderPatternFinder) addPattern(center point, moduleSize float64) {
+	for _, p := range f.patterns {
+		if math.Abs(p.center.x-center.x) <= p.moduleSize &&
+			math.Abs(p.center.y-center.y) <= p.moduleSize &&
+			math.Abs(p.moduleSize-moduleSize) <= math.Max(1, p.moduleSize) {
+			n := float64(p.count)
+			p.center = point{
+				x: (p.center.x*n + center.x) / (n + 1),
+				y: (p.center.y*n + center.y) / (n + 1),
+			}
+			p.moduleSize = (p.moduleSize*n + moduleSize) / (n + 1)
+			p.count++
+			return
+		}
+	}
+	f.patterns = append(f.patterns, &finderPattern{center: center, moduleSize: moduleSize, count: 1})
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// ===========================
+// 定位圖形分組
+// ===========================
+
+// finderTriple 同一個 QR Code 的三個定位圖形
+type finderTriple struct {
+	topLeft    *finderPattern
+	topRight   *finderPattern
+	bottomLeft *finderPattern
+	moduleSize float64
+	score      float64 // 與理想等腰直角三角形的偏差（越小越好）
+}
+
+// 分組參數
+const (
+	maxModuleSizeRatio = 1.5
+	maxLegRatio        = 1.4
+	maxHypotenuseError = 0.2
+	minModulesBetween  = 13 // 版本 1：21 - 7 - 1
+	maxModulesBetween  = 180
+)
+
+// groupFinderPatterns 列出可能屬於同一個 QR Code 的定位圖形組合（依偏差排序）
+//
+// 設計考量：發票證明聯並排兩個 QR Code，共 6 個定位圖形；
+// 跨 QR Code 的組合通常無法構成等腰直角三角形，少數誤組合由後續解碼失敗排除
+func groupFinderPatterns(patterns []*finderPattern) []finderTriple {
+	var triples []finderTriple
+	for i := 0; i < len(patterns); i++ {
+		for j := i + 1; j < len(patterns); j++ {
+			for k := j + 1; k < len(patterns); k++ {
+				if triple, ok := newFinderTriple(patterns[i], patterns[j], patterns[k]); ok {
+					triples = append(triples, triple)
+				}
+			}
+		}
+	}
+	sort.Slice(triples, func(i, j int) bool {
+		return triples[i].score < triples[j].score
+	})
+	return triples
+}
+
+// newFinderTriple 判斷三個定位圖形能否構成 QR Code 並排定方位
+func newFinderTriple(a, b, c *finderPattern) (finderTriple, bool) {
+	minSize := math.Min(a.moduleSize, math.Min(b.moduleSize, c.moduleSize))
+	maxSize := math.Max(a.moduleSize, math.Max(b.moduleSize, c.moduleSize))
+	if maxSize > minSize*maxModuleSizeRatio {
+		return finderTriple{}, false
+	}
+
+	// 斜邊對角為左上角
+	ab, bc, ac := distance(a.center, b.center), distance(b.center, c.center), distance(a.center, c.center)
+	corner, p, q, hypotenuse := c, a, b, ab
+	if bc >= ab && bc >= ac {
+		corner, p, q, hypotenuse = a, b, c, bc
+	} else if ac >= ab && ac >= bc {
+		corner, p, q, hypotenuse = b, a, c, ac
+	}
+
+	leg1, leg2 := distance(corner.center, p.center), distance(corner.center, q.center)
+	legRatio := math.Max(leg1, leg2) / math.Min(leg1, leg2)
+	if legRatio > maxLegRatio {
+		return finderTriple{}, false
+	}
+	expectedHypotenuse := math.Hypot(leg1, leg2)
+	hypotenuseError := math.Abs(hypotenuse-expectedHypotenuse) / expectedHypotenuse
+	if hypotenuseError > maxHypotenuseError {
+		return finderTriple{}, false
+	}
+
+	moduleSize := (a.moduleSize + b.moduleSize + c.moduleSize) / 3
+	modulesBetween := (leg1 + leg2) / 2 / moduleSize
+	if modulesBetween < minModulesBetween || modulesBetween > maxModulesBetween {
+		return finderTriple{}, false
+	}
+
+	// 外積判斷順逆時針：確保 topRight 在 topLeft 的順時針方向
+	cross := (q.center.x-corner.center.x)*(p.center.y-corner.center.y) -
+		(q.center.y-corner.center.y)*(p.center.x-corner.center.x)
+	bottomLeft, topRight := p, q
+	if cross < 0 {
+		bottomLeft, topRight = q, p
+	}
+
+	return finderTriple{
+		topLeft:    corner,
+		topRight:   topRight,
+		bottomLeft: bottomLeft,
+		moduleSize: moduleSize,
+		score:      (legRatio - 1) + hypotenuseError + (maxSize/minSize - 1),
+	}, true
+}
+
+// contains 判斷組合是否使用了指定定位圖形
+func (t finderTriple) contains(p *finderPattern) bool {
+	return t.topLeft == p || t.topRight == p || t.bottomLeft == p
+}
+
+// estimateDimensions 依定位圖形距離估算模組邊長（優先返回最接近的合法邊長）
+func (t finderTriple) estimateDimensions() []int {
+	between := (distance(t.topLeft.center, t.topRight.center) +
+		distance(t.topLeft.center, t.bottomLeft.center)) / 2 / t.moduleSize
+	estimate := int(math.Round(between)) + 7
+
+	version := int(math.Round(float64(estimate-17) / 4))
+	var dimensions []int
+	for _, v := range []int{version, version - 1, version + 1} {
+		if v >= minVersion && v <= maxVersion {
+			dimensions = append(dimensions, dimensionForVersion(v))
+		}
+	}
+	return dimensions
+}
+
+// ===========================
+// 校正圖形（Alignment Pattern）
+// ===========================
+
+// alignmentSearchRadius 校正圖形搜尋半徑（模組數）
+const alignmentSearchRadius = 5
+
+// findAlignmentPattern 在預估位置附近搜尋右下校正圖形
+//
+// 參數：
+//
+//	estimate - 預估中心（影像座標）
+//	ux, uy   - 一個模組在 QR Code 橫向、縱向的影像位移
+//
+// 比對 5×5 圖形：中心深色、距離 1 模組的環為淺色、距離 2 模組的環為深色
+func findAlignmentPattern(image *bitMatrix, estimate, ux, uy point) (point, bool) {
+	moduleSize := math.Hypot(ux.x, ux.y)
+	step := math.Max(moduleSize/3, 1)
+	radius := alignmentSearchRadius * moduleSize
+
+	bestScore := 0
+	var best []point
+	for dy := -radius; dy <= radius; dy += step {
+		for dx := -radius; dx <= radius; dx += step {
+			candidate := point{x: estimate.x + dx, y: estimate.y + dy}
+			score := alignmentScore(image, candidate, ux, uy)
+			switch {
+			case score > bestScore:
+				bestScore, best = score, []point{candidate}
+			case score == bestScore:
+				best = append(best, candidate)
+			}
+		}
+	}
+	// 17 個取樣點至少吻合 16 個
+	if bestScore < 16 {
+		return point{}, false
+	}
+
+	// 取最接近預估位置的最佳點，與其鄰近的最佳點平均以求次像素精度
+	sort.Slice(best, func(i, j int) bool {
+		return distance(best[i], estimate) < distance(best[j], estimate)
+	})
+	var sum point
+	n := 0
+	for _, p := range best {
+		if distance(p, best[0]) <= moduleSize {
+			sum.x += p.x
+			sum.y += p.y
+			n++
+		}
+	}
+	return point{x: sum.x / float64(n), y: sum.y / float64(n)}, true
+}
+
+// alignmentScore 計算候選中心與校正圖形的吻合取樣點數
+func alignmentScore(image *bitMatrix, center, ux, uy point) int {
+	score := 0
+	for j := -2; j <= 2; j++ {
+		for i := -2; i <= 2; i++ {
+			ring := max(abs(i), abs(j))
+			// 每環取 8 個方向（中心 1 點）
+			if ring == 2 && abs(i) == 1 || ring == 2 && abs(j) == 1 {
+				continue
+			}
+			x := int(center.x + float64(i)*ux.x + float64(j)*uy.x)
+			y := int(center.y + float64(i)*ux.y + float64(j)*uy.y)
+			if !image.inBounds(x, y) {
+				continue
+			}
+			if image.get(x, y) == (ring != 1) {
+				score++
+			}
+		}
+	}
+	return score
+}
@@ -0,0 +1,194 @@
+package qrcode
+
+import "errors"
+
+// ===========================
+// GF(256) 運算
+// ===========================
+
+// QR Code 使用 GF(2^8)，本原多項式 x^8 + x^4 + x^3 + x^2 + 1（0x11D），生成多項式根從 α^0 開始
+const gfPrimitive = 0x11D
+
+var (
+	gfExp [512]byte
+	gfLog [256]int
+)
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		gfExp[i] = byte(x)
+		gfLog[x] = i
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= gfPrimitive
+		}
+	}
+	for i := 255; i < len(gfExp); i++ {
+		gfExp[i] = gfExp[i-255]
+	}
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[gfLog[a]+gfLog[b]]
+}
+
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gfExp[gfLog[a]-gfLog[b]+255]
+}
+
+// gfPow 返回 α^e（e 可為負數）
+func gfPow(e int) byte {
+	e %= 255
+	if e < 0 {
+		e += 255
+	}
+	return gfExp[e]
+}
+
+// evalPoly 計算多項式在 x 的值（係數由低次到高次）
+func evalPoly(poly []byte, x byte) byte {
+	var result byte
+	for i := len(poly) - 1; i >= 0; i-- {
+		result = gfMul(result, x) ^ poly[i]
+	}
+	return result
+}
+
+// ===========================
+// Reed-Solomon 解碼
+// ===========================
+
+// errTooManyErrors 錯誤數超過糾錯能力
+var errTooManyErrors = errors.New("qrcode: too many errors to correct")
+
+// rsCorrect 就地修正單一區塊（資料碼字 + 糾錯碼字）
+//
+// 演算法：
+// 1. 計算校正子 S_j = R(α^j)（block[0] 為最高次項係數）
+// 2. Berlekamp-Massey 求錯誤位置多項式 Λ(x)
+// 3. Chien 搜尋找出錯誤位置
+// 4. Forney 公式計算錯誤值
+// 5. 修正後重新驗證校正子（避免誤修正）
+//
+// 錯誤：錯誤數超過 ecCount/2 時返回 errTooManyErrors
+func rsCorrect(block []byte, ecCount int) error {
+	n := len(block)
+	syndromes, hasError := computeSyndromes(block, ecCount)
+	if !hasError {
+		return nil
+	}
+
+	locator := berlekampMassey(syndromes)
+	errorCount := len(locator) - 1
+	if errorCount == 0 || errorCount > ecCount/2 {
+		return errTooManyErrors
+	}
+
+	// Chien 搜尋：Λ(α^-i) = 0 表示 x^i 項（block[n-1-i]）有錯
+	var positions []int
+	for i := 0; i < n; i++ {
+		if evalPoly(locator, gfPow(-i)) == 0 {
+			positions = append(positions, i)
+		}
+	}
+	if len(positions) != errorCount {
+		return errTooManyErrors
+	}
+
+	// Ω(x) = S(x)Λ(x) mod x^ecCount
+	omega := make([]byte, ecCount)
+	for i := 0; i < ecCount; i++ {
+		for j := 0; j <= i && j < len(locator); j++ {
+			omega[i] ^= gfMul(syndromes[i-j], locator[j])
+		}
+	}
+
+	// Λ'(x)：GF(2^m) 下只保留奇次項
+	derivative := make([]byte, len(locator)-1)
+	for i := 1; i < len(locator); i += 2 {
+		derivative[i-1] = locator[i]
+	}
+
+	for _, i := range positions {
+		xInverse := gfPow(-i)
+		denominator := evalPoly(derivative, xInverse)
+		if denominator == 0 {
+			return errTooManyErrors
+		}
+		magnitude := gfMul(gfPow(i), gfDiv(evalPoly(omega, xInverse), denominator))
+		block[n-1-i] ^= magnitude
+	}
+
+	if _, hasError := computeSyndromes(block, ecCount); hasError {
+		return errTooManyErrors
+	}
+	return nil
+}
+
+// computeSyndromes 計算校正子，第二個返回值表示是否有錯誤
+func computeSyndromes(block []byte, ecCount int) ([]byte, bool) {
+	syndromes := make([]byte, ecCount)
+	hasError := false
+	for j := 0; j < ecCount; j++ {
+		var s byte
+		alpha := gfPow(j)
+		for _, c := range block {
+			s = gfMul(s, alpha) ^ c
+		}
+		syndromes[j] = s
+		if s != 0 {
+			hasError = true
+		}
+	}
+	return syndromes, hasError
+}
+
+// berlekampMassey 由校正子求錯誤位置多項式 Λ(x)（係數由低次到高次，Λ(0) = 1）
+func berlekampMassey(syndromes []byte) []byte {
+	current := []byte{1}
+	previous := []byte{1}
+	length := 0
+	shift := 1
+	previousDiscrepancy := byte(1)
+
+	for i := range syndromes {
+		discrepancy := syndromes[i]
+		for k := 1; k <= length && k < len(current); k++ {
+			discrepancy ^= gfMul(current[k], syndromes[i-k])
+		}
+		if discrepancy == 0 {
+			shift++
+			continue
+		}
+
+		coefficient := gfDiv(discrepancy, previousDiscrepancy)
+		next := make([]byte, max(len(current), len(previous)+shift))
+		copy(next, current)
+		for k, c := range previous {
+			next[k+shift] ^= gfMul(coefficient, c)
+		}
+
+		if 2*length <= i {
+			previous = current
+			length = i + 1 - length
+			previousDiscrepancy = discrepancy
+			shift = 1
+		} else {
+			shift++
+		}
+		current = next
+	}
+
+	// 截去高次零係數；若超出 length 仍有非零係數代表無法修正，由呼叫端的 Chien 搜尋檢出
+	for len(current) > length+1 && current[len(current)-1] == 0 {
+		current = current[:len(current)-1]
+	}
+	return current
+}
@@ -0,0 +1,81 @@
+package qrcode
+
+import "math"
+
+// ===========================
+// 透視變換
+// ===========================
+
+// perspectiveTransform 將模組座標映射到影像座標
+//
+//	u = (a·x + b·y + c) / (g·x + h·y + 1)
+//	v = (d·x + e·y + f) / (g·x + h·y + 1)
+type perspectiveTransform [8]float64
+
+// newPerspectiveTransform 由 4 組對應點求解變換係數（高斯消去法）
+func newPerspectiveTransform(src, dst [4]point) (perspectiveTransform, bool) {
+	var system [8][9]float64
+	for i := 0; i < 4; i++ {
+		x, y, u, v := src[i].x, src[i].y, dst[i].x, dst[i].y
+		system[2*i] = [9]float64{x, y, 1, 0, 0, 0, -x * u, -y * u, u}
+		system[2*i+1] = [9]float64{0, 0, 0, x, y, 1, -x * v, -y * v, v}
+	}
+
+	for col := 0; col < 8; col++ {
+		pivot := col
+		for row := col + 1; row < 8; row++ {
+			if math.Abs(system[row][col]) > math.Abs(system[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(system[pivot][col]) < 1e-12 {
+			return perspectiveTransform{}, false
+		}
+		system[col], system[pivot] = system[pivot], system[col]
+
+		for row := 0; row < 8; row++ {
+			if row == col {
+				continue
+			}
+			factor := system[row][col] / system[col][col]
+			for k := col; k < 9; k++ {
+				system[row][k] -= factor * system[col][k]
+			}
+		}
+	}
+
+	var t perspectiveTransform
+	for i := 0; i < 8; i++ {
+		t[i] = system[i][8] / system[i][i]
+	}
+	return t, true
+}
+
+// apply 映射單一點
+func (t perspectiveTransform) apply(x, y float64) point {
+	denominator := t[6]*x + t[7]*y + 1
+	return point{
+		x: (t[0]*x + t[1]*y + t[2]) / denominator,
+		y: (t[3]*x + t[4]*y + t[5]) / denominator,
+	}
+}
+
+// sampleGrid 以模組中心取樣，產生 dimension × dimension 的模組矩陣
+//
+// 錯誤：取樣點超出影像範圍（超過 1 模組）時返回 false
+func sampleGrid(image *bitMatrix, t perspectiveTransform, dimension int, moduleSize float64) (*bitMatrix, bool) {
+	grid := newBitMatrix(dimension, dimension)
+	for y := 0; y < dimension; y++ {
+		for x := 0; x < dimension; x++ {
+			p := t.apply(float64(x)+0.5, float64(y)+0.5)
+			if p.x < -moduleSize || p.y < -moduleSize ||
+				p.x >= float64(image.width)+moduleSize || p.y >= float64(image.height)+moduleSize {
+				return nil, false
+			}
+			px := min(max(int(p.x), 0), image.width-1)
+			py := min(max(int(p.y), 0), image.height-1)
+			grid.set(x, y, image.get(px, py))
+		}
+	}
+	return grid, true
+}
@@ -0,0 +1,294 @@
+package qrcode
+
+import "math/bits"
+
+// ===========================
+// 版本與糾錯等級參數（ISO/IEC 18004 Table 9）
+// ===========================
+
+// 版本範圍
+const (
+	minVersion = 1
+	maxVersion = 40
+)
+
+// ecLevel 糾錯等級（L / M / Q / H，對應表格索引）
+type ecLevel int
+
+const (
+	ecLevelL ecLevel = iota
+	ecLevelM
+	ecLevelQ
+	ecLevelH
+)
+
+// ecCodewordsPerBlock 每個區塊的糾錯碼字數 [糾錯等級][版本]
+var ecCodewordsPerBlock = [4][41]int{
+	{0, 7, 10, 15, 20, 26, 18, 20, 24, 30, 18, 20, 24, 26, 30, 22, 24, 28, 30, 28, 28, 28, 28, 30, 30, 26, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{0, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26, 26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+	{0, 13, 22, 18, 26, 18, 24, 18, 22, 20, 24, 28, 26, 24, 20, 30, 24, 28, 28, 26, 30, 28, 30, 30, 30, 30, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	{0, 17, 28, 22, 16, 22, 28, 26, 26, 24, 28, 24, 28, 22, 24, 24, 30, 28, 28, 26, 28, 30, 24, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+}
+
+// ecBlockCount 糾錯區塊數 [糾錯等級][版本]
+var ecBlockCount = [4][41]int{
+	{0, 1, 1, 1, 1, 1, 2, 2, 2, 2, 4, 4, 4, 4, 4, 6, 6, 6, 6, 7, 8, 8, 9, 9, 10, 12, 12, 12, 13, 14, 15, 16, 17, 18, 19, 19, 20, 21, 22, 24, 25},
+	{0, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16, 17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49},
+	{0, 1, 1, 2, 2, 4, 4, 6, 6, 8, 8, 8, 10, 12, 16, 12, 17, 16, 18, 21, 20, 23, 23, 25, 27, 29, 34, 34, 35, 38, 40, 43, 45, 48, 51, 53, 56, 59, 62, 65, 68},
+	{0, 1, 1, 2, 4, 4, 4, 5, 6, 8, 8, 11, 11, 16, 16, 18, 16, 19, 21, 25, 25, 25, 34, 30, 32, 35, 37, 40, 42, 45, 48, 51, 54, 57, 60, 63, 66, 70, 74, 77, 81},
+}
+
+// dimensionForVersion 返回版本對應的模組邊長
+func dimensionForVersion(version int) int {
+	return version*4 + 17
+}
+
+// rawCodewordCount 返回版本可容納的碼字總數（資料 + 糾錯，扣除功能圖形）
+func rawCodewordCount(version int) int {
+	modules := (16*version+128)*version + 64
+	if version >= 2 {
+		alignments := version/7 + 2
+		modules -= (25*alignments-10)*alignments - 55
+		if version >= 7 {
+			modules -= 36
+		}
+	}
+	return modules / 8
+}
+
+// alignmentPositions 返回校正圖形中心座標（行列共用）
+func alignmentPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+	count := version/7 + 2
+	step := 26
+	if version != 32 {
+		step = (version*4 + count*2 + 1) / (count*2 - 2) * 2
+	}
+	positions := make([]int, count)
+	positions[0] = 6
+	for i, pos := count-1, dimensionForVersion(version)-7; i >= 1; i, pos = i-1, pos-step {
+		positions[i] = pos
+	}
+	return positions
+}
+
+// ===========================
+// 格式資訊與版本資訊
+// ===========================
+
+// formatInfo 格式資訊（糾錯等級 + 遮罩編號）
+type formatInfo struct {
+	level ecLevel
+	mask  int
+}
+
+// formatLevelBits 格式資訊中的糾錯等級編碼（L=01, M=00, Q=11, H=10）
+var formatLevelBits = [4]int{1, 0, 3, 2}
+
+// encodeFormatBits 產生 15 位元格式資訊（BCH(15,5) + 固定遮罩 0x5412）
+func encodeFormatBits(level ecLevel, mask int) int {
+	data := formatLevelBits[level]<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	return (data<<10 | rem) ^ 0x5412
+}
+
+// encodeVersionBits 產生 18 位元版本資訊（BCH(18,6)）
+func encodeVersionBits(version int) int {
+	rem := version
+	for i := 0; i < 12; i++ {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+	}
+	return version<<12 | rem
+}
+
+// maxInfoBitErrors 格式與版本資訊可容忍的錯誤位元數
+const maxInfoBitErrors = 3
+
+// readFormatInfo 讀取格式資訊（兩份副本取漢明距離最小者）
+func readFormatInfo(grid *bitMatrix) (formatInfo, bool) {
+	size := grid.width
+	var first, second int
+	for i := 0; i <= 5; i++ {
+		first |= grid.bit(8, i) << i
+	}
+	first |= grid.bit(8, 7) << 6
+	first |= grid.bit(8, 8) << 7
+	first |= grid.bit(7, 8) << 8
+	for i := 9; i < 15; i++ {
+		first |= grid.bit(14-i, 8) << i
+	}
+	for i := 0; i < 8; i++ {
+		second |= grid.bit(size-1-i, 8) << i
+	}
+	for i := 8; i < 15; i++ {
+		second |= grid.bit(8, size-15+i) << i
+	}
+
+	best, bestDistance := formatInfo{}, maxInfoBitErrors+1
+	for level := ecLevelL; level <= ecLevelH; level++ {
+		for mask := 0; mask < 8; mask++ {
+			code := encodeFormatBits(level, mask)
+			distance := min(bits.OnesCount(uint(code^first)), bits.OnesCount(uint(code^second)))
+			if distance < bestDistance {
+				best, bestDistance = formatInfo{level: level, mask: mask}, distance
+			}
+		}
+	}
+	return best, bestDistance <= maxInfoBitErrors
+}
+
+// readVersionInfo 讀取版本資訊（版本 7 以上才有，兩份副本取漢明距離最小者）
+func readVersionInfo(grid *bitMatrix) (int, bool) {
+	size := grid.width
+	var topRight, bottomLeft int
+	for i := 0; i < 18; i++ {
+		a, b := size-11+i%3, i/3
+		topRight |= grid.bit(a, b) << i
+		bottomLeft |= grid.bit(b, a) << i
+	}
+
+	best, bestDistance := 0, maxInfoBitErrors+1
+	for version := 7; version <= maxVersion; version++ {
+		code := encodeVersionBits(version)
+		distance := min(bits.OnesCount(uint(code^topRight)), bits.OnesCount(uint(code^bottomLeft)))
+		if distance < bestDistance {
+			best, bestDistance = version, distance
+		}
+	}
+	return best, bestDistance <= maxInfoBitErrors
+}
+
+// ===========================
+// 資料碼字讀取
+// ===========================
+
+// functionPatternMask 標記功能圖形模組（定位、時序、校正、格式與版本資訊），資料讀取時略過
+func functionPatternMask(version int) *bitMatrix {
+	size := dimensionForVersion(version)
+	mask := newBitMatrix(size, size)
+	mask.setRegion(0, 6, size, 1)
+	mask.setRegion(6, 0, 1, size)
+	mask.setRegion(0, 0, 9, 9)
+	mask.setRegion(size-8, 0, 8, 9)
+	mask.setRegion(0, size-8, 9, 8)
+
+	positions := alignmentPositions(version)
+	last := len(positions) - 1
+	for i, y := range positions {
+		for j, x := range positions {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			mask.setRegion(x-2, y-2, 5, 5)
+		}
+	}
+
+	if version >= 7 {
+		mask.setRegion(size-11, 0, 3, 6)
+		mask.setRegion(0, size-11, 6, 3)
+	}
+	return mask
+}
+
+// isMasked 判斷模組是否被資料遮罩翻轉（x 為欄、y 為列）
+func isMasked(mask, x, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+// readCodewords 依 Z 字形順序讀取碼字並解除遮罩
+func readCodewords(grid *bitMatrix, version, mask int) []byte {
+	size := grid.width
+	function := functionPatternMask(version)
+	codewords := make([]byte, rawCodewordCount(version))
+
+	bitIndex := 0
+	for right := size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		upward := (right+1)&2 == 0
+		for vert := 0; vert < size; vert++ {
+			y := vert
+			if upward {
+				y = size - 1 - vert
+			}
+			for j := 0; j < 2; j++ {
+				x := right - j
+				if function.get(x, y) || bitIndex >= len(codewords)*8 {
+					continue
+				}
+				if grid.get(x, y) != isMasked(mask, x, y) {
+					codewords[bitIndex>>3] |= 1 << (7 - bitIndex&7)
+				}
+				bitIndex++
+			}
+		}
+	}
+	return codewords
+}
+
+// correctCodewords 解交錯各區塊並以 Reed-Solomon 修正，返回資料碼字
+func correctCodewords(codewords []byte, version int, level ecLevel) ([]byte, error) {
+	blockCount := ecBlockCount[level][version]
+	ecCount := ecCodewordsPerBlock[level][version]
+	shortBlocks := blockCount - len(codewords)%blockCount
+	shortBlockLength := len(codewords) / blockCount
+
+	blocks := make([][]byte, blockCount)
+	dataLengths := make([]int, blockCount)
+	for i := range blocks {
+		length := shortBlockLength
+		if i >= shortBlocks {
+			length++
+		}
+		blocks[i] = make([]byte, length)
+		dataLengths[i] = length - ecCount
+	}
+
+	index := 0
+	for i := 0; i <= shortBlockLength-ecCount; i++ {
+		for b := range blocks {
+			if i < dataLengths[b] {
+				blocks[b][i] = codewords[index]
+				index++
+			}
+		}
+	}
+	for i := 0; i < ecCount; i++ {
+		for b := range blocks {
+			blocks[b][dataLengths[b]+i] = codewords[index]
+			index++
+		}
+	}
+
+	var data []byte
+	for b, block := range blocks {
+		if err := rsCorrect(block, ecCount); err != nil {
+			return nil, err
+		}
+		data = append(data, block[:dataLengths[b]]...)
+	}
+	return data, nil
+}
@@ -0,0 +1,49 @@
+package security
+
+import (
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// DefaultBcryptCost bcrypt 預設成本（約 250ms / 次，兼顧安全與登入延遲）
+const DefaultBcryptCost = 12
+
+// ===========================
+// BcryptPasswordHasher
+// ===========================
+
+// BcryptPasswordHasher 以 bcrypt 實作密碼雜湊
+//
+// 設計原則：
+// - 雜湊值自帶 salt 與成本（調高成本後舊雜湊仍可驗證）
+// - 比對為固定時間（由 bcrypt 套件保證）
+type BcryptPasswordHasher struct {
+	cost int
+}
+
+// NewBcryptPasswordHasher 創建 bcrypt 雜湊器
+//
+// 參數：
+//   cost - bcrypt 成本（<= 0 時使用 DefaultBcryptCost；測試可用 bcrypt.MinCost 加速）
+func NewBcryptPasswordHasher(cost int) admin.PasswordHasher {
+	if cost <= 0 {
+		cost = DefaultBcryptCost
+	}
+	return &BcryptPasswordHasher{cost: cost}
+}
+
+// Hash 計算密碼雜湊
+func (h *BcryptPasswordHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hash), nil
+}
+
+// Verify 比對密碼與雜湊值
+func (h *BcryptPasswordHasher) Verify(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
@@ -0,0 +1,30 @@
+package security
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Test 1: bcrypt 雜湊（每次 salt 不同、可驗證、錯誤密碼或損毀雜湊驗證失敗）
+func TestBcryptPasswordHasher_HashAndVerify(t *testing.T) {
+	// Arrange
+	hasher := NewBcryptPasswordHasher(bcrypt.MinCost)
+
+	// Act
+	first, err := hasher.Hash("correct horse battery")
+	require.NoError(t, err)
+	second, err := hasher.Hash("correct horse battery")
+	require.NoError(t, err)
+
+	// Assert
+	assert.True(t, strings.HasPrefix(first, "$2a$04$"))
+	assert.NotEqual(t, first, second)
+	assert.True(t, hasher.Verify(first, "correct horse battery"))
+	assert.True(t, hasher.Verify(second, "correct horse battery"))
+	assert.False(t, hasher.Verify(first, "correct horse batterY"))
+	assert.False(t, hasher.Verify("not-a-hash", "correct horse battery"))
+}
@@ -0,0 +1,303 @@
+package adminapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	appadmin "github.com/jackyeh168/bar_crm/src/internal/application/admin"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+)
+
+// ===========================
+// 認證 / 授權
+// ===========================
+
+// principalKey request context 中已驗證管理員身分的鍵
+type principalKey struct{}
+
+// LoginRequest 登入請求
+//
+// 欄位：
+// - OTPCode: TOTP 驗證碼或復原碼（已啟用兩步驟驗證時必填）
+type LoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	OTPCode  string `json:"otp_code"`
+}
+
+// LoginResponse 登入結果（後續請求以 "Authorization: Bearer <token>" 帶入）
+type LoginResponse struct {
+	Token     string            `json:"token"`
+	TokenType string            `json:"token_type"`
+	ExpiresAt time.Time         `json:"expires_at"`
+	Admin     AdminUserResponse `json:"admin"`
+}
+
+// AdminUserResponse 管理員帳號
+type AdminUserResponse struct {
+	AdminID     string     `json:"admin_id"`
+	Username    string     `json:"username"`
+	DisplayName string     `json:"display_name"`
+	Role        string     `json:"role"`
+	Permissions []string   `json:"permissions"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+	Disabled    bool       `json:"disabled"`
+
+	TwoFactorEnabled       bool `json:"two_factor_enabled"`
+	RemainingRecoveryCodes int  `json:"remaining_recovery_codes"`
+}
+
+// PrincipalResponse 目前登入的管理員
+type PrincipalResponse struct {
+	AdminID     string    `json:"admin_id"`
+	Username    string    `json:"username"`
+	DisplayName string    `json:"display_name"`
+	Role        string    `json:"role"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// TOTPEnrollmentResponse 兩步驟驗證設定資訊
+//
+// 欄位：
+// - Secret: Base32 金鑰（無法掃描 QR Code 時手動輸入）
+// - ProvisioningURI: otpauth:// URI（轉成 QR Code 供 Authenticator App 掃描）
+type TOTPEnrollmentResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+// OTPCodeRequest 帶入 TOTP 驗證碼的請求
+type OTPCodeRequest struct {
+	Code string `json:"code"`
+}
+
+// RecoveryCodesResponse 一次性復原碼（明文僅回傳這一次）
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// CreateAdminUserRequest 建立管理員帳號請求
+//
+// 欄位：
+// - Role: owner / manager / staff / auditor
+type CreateAdminUserRequest struct {
+	Username    string `json:"username"`
+	DisplayName string `json:"display_name"`
+	Role        string `json:"role"`
+	Password    string `json:"password"`
+}
+
+// authorize 驗證 Bearer Token 並檢查權限後才執行 handler
+//
+// 參數：
+//   permission - 所需權限（空字串表示只需登入）
+//
+// 驗證通過時，管理員身分存入 request context（見 principalFrom）
+func (r *Router) authorize(permission admin.Permission, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		principal, err := r.useCases.Authorize.Execute(appadmin.AuthorizeQuery{
+			Token:      bearerToken(req),
+			Permission: permission,
+			Now:        r.now(),
+		})
+		if err != nil {
+			if body, ok := domainErrorBody(err); ok && statusForCode(body.Code) == http.StatusUnauthorized {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="admin"`)
+			}
+			writeError(w, req, err)
+			return
+		}
+		next(w, req.WithContext(context.WithValue(req.Context(), principalKey{}, principal)))
+	}
+}
+
+// bearerToken 取出 "Authorization: Bearer <token>" 的 Token（未提供時返回空字串）
+func bearerToken(req *http.Request) string {
+	scheme, token, ok := strings.Cut(req.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// principalFrom 取出已驗證的管理員身分（未經 authorize 的路由返回 nil）
+func principalFrom(req *http.Request) *appadmin.PrincipalResult {
+	principal, _ := req.Context().Value(principalKey{}).(*appadmin.PrincipalResult)
+	return principal
+}
+
+// operatorID 目前登入管理員的帳號（寫入操作紀錄的操作者）
+func operatorID(req *http.Request) string {
+	if principal := principalFrom(req); principal != nil {
+		return principal.Username
+	}
+	return ""
+}
+
+// principalCan 目前登入管理員的角色是否具備權限（路由權限之外的細部判斷）
+func principalCan(req *http.Request, permission admin.Permission) bool {
+	principal := principalFrom(req)
+	return principal != nil && admin.Role(principal.Role).Can(permission)
+}
+
+// login POST /auth/login
+func (r *Router) login(w http.ResponseWriter, req *http.Request) {
+	var body LoginRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.Login.Execute(appadmin.LoginCommand{
+		Username: body.Username,
+		Password: body.Password,
+		OTPCode:  body.OTPCode,
+		Now:      r.now(),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, LoginResponse{
+		Token:     result.Token,
+		TokenType: "Bearer",
+		ExpiresAt: result.ExpiresAt,
+		Admin:     toAdminUserResponse(result.Admin),
+	})
+}
+
+// logout POST /auth/logout
+func (r *Router) logout(w http.ResponseWriter, req *http.Request) {
+	err := r.useCases.Logout.Execute(appadmin.LogoutCommand{Token: bearerToken(req), Now: r.now()})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getPrincipal GET /auth/me
+func (r *Router) getPrincipal(w http.ResponseWriter, req *http.Request) {
+	principal := principalFrom(req)
+	writeJSON(w, http.StatusOK, PrincipalResponse{
+		AdminID:     principal.AdminID,
+		Username:    principal.Username,
+		DisplayName: principal.DisplayName,
+		Role:        principal.Role,
+		ExpiresAt:   principal.ExpiresAt,
+	})
+}
+
+// beginTOTPEnrollment POST /auth/totp/enroll
+func (r *Router) beginTOTPEnrollment(w http.ResponseWriter, req *http.Request) {
+	result, err := r.useCases.BeginTOTP.Execute(appadmin.BeginTOTPEnrollmentCommand{
+		AdminID: principalFrom(req).AdminID,
+		Now:     r.now(),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, TOTPEnrollmentResponse{
+		Secret:          result.Secret,
+		ProvisioningURI: result.ProvisioningURI,
+	})
+}
+
+// confirmTOTPEnrollment POST /auth/totp/confirm
+func (r *Router) confirmTOTPEnrollment(w http.ResponseWriter, req *http.Request) {
+	var body OTPCodeRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.ConfirmTOTP.Execute(appadmin.ConfirmTOTPEnrollmentCommand{
+		AdminID: principalFrom(req).AdminID,
+		Token:   bearerToken(req),
+		Code:    body.Code,
+		Now:     r.now(),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, RecoveryCodesResponse{RecoveryCodes: result.RecoveryCodes})
+}
+
+// regenerateRecoveryCodes POST /auth/recovery-codes
+func (r *Router) regenerateRecoveryCodes(w http.ResponseWriter, req *http.Request) {
+	var body OTPCodeRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.RegenerateRecovery.Execute(appadmin.RegenerateRecoveryCodesCommand{
+		AdminID: principalFrom(req).AdminID,
+		Code:    body.Code,
+		Now:     r.now(),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, RecoveryCodesResponse{RecoveryCodes: result.RecoveryCodes})
+}
+
+// createAdminUser POST /admin-users
+func (r *Router) createAdminUser(w http.ResponseWriter, req *http.Request) {
+	var body CreateAdminUserRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.CreateAdminUser.Execute(appadmin.CreateAdminUserCommand{
+		Username:    body.Username,
+		DisplayName: body.DisplayName,
+		Role:        body.Role,
+		Password:    body.Password,
+		Now:         r.now(),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toAdminUserResponse(result))
+}
+
+// disableAdminUser POST /admin-users/{adminID}/disable
+func (r *Router) disableAdminUser(w http.ResponseWriter, req *http.Request) {
+	result, err := r.useCases.DisableAdminUser.Execute(appadmin.DisableAdminUserCommand{
+		AdminID:    req.PathValue("adminID"),
+		OperatorID: principalFrom(req).AdminID,
+		Now:        r.now(),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toAdminUserResponse(result))
+}
+
+func toAdminUserResponse(result *appadmin.AdminUserResult) AdminUserResponse {
+	return AdminUserResponse{
+		AdminID:     result.AdminID,
+		Username:    result.Username,
+		DisplayName: result.DisplayName,
+		Role:        result.Role,
+		Permissions: result.Permissions,
+		LastLoginAt: result.LastLoginAt,
+		Disabled:    result.Disabled,
+
+		TwoFactorEnabled:       result.TwoFactorEnabled,
+		RemainingRecoveryCodes: result.RemainingRecoveryCodes,
+	}
+}
@@ -0,0 +1,157 @@
+package adminapi
+
+import (
+	"net/http"
+	"time"
+
+	appbroadcast "github.com/jackyeh168/bar_crm/src/internal/application/broadcast"
+)
+
+// ===========================
+// 群發活動 / 積分轉換規則
+// ===========================
+
+// CreateCampaignRequest 建立群發活動請求（建立者為目前登入的管理員）
+//
+// 欄位：
+// - ScheduledAt: 預定發送時間（未提供時立即發送）
+// - InactiveDays: 未消費天數篩選（0 表示不篩選）
+// - VerifiedAdultsOnly: 僅發送給已驗證成年的會員（酒類促銷必須設定）
+type CreateCampaignRequest struct {
+	Name               string     `json:"name"`
+	MinAvailablePoints int        `json:"min_available_points"`
+	InactiveDays       int        `json:"inactive_days"`
+	VerifiedAdultsOnly bool       `json:"verified_adults_only"`
+	Message            string     `json:"message"`
+	ScheduledAt        *time.Time `json:"scheduled_at"`
+}
+
+// CampaignResponse 群發活動
+type CampaignResponse struct {
+	CampaignID         string     `json:"campaign_id"`
+	Name               string     `json:"name"`
+	MinAvailablePoints int        `json:"min_available_points"`
+	InactiveDays       int        `json:"inactive_days"`
+	VerifiedAdultsOnly bool       `json:"verified_adults_only"`
+	Message            string     `json:"message"`
+	Status             string     `json:"status"`
+	ScheduledAt        time.Time  `json:"scheduled_at"`
+	CreatedBy          string     `json:"created_by"`
+	StartedAt          *time.Time `json:"started_at"`
+	CompletedAt        *time.Time `json:"completed_at"`
+}
+
+// CampaignReportResponse 投遞報告
+type CampaignReportResponse struct {
+	Campaign CampaignResponse           `json:"campaign"`
+	Total    int                        `json:"total"`
+	Pending  int                        `json:"pending"`
+	Sent     int                        `json:"sent"`
+	Failed   int                        `json:"failed"`
+	Excluded int                        `json:"excluded"`
+	Failures []RecipientFailureResponse `json:"failures"`
+}
+
+// RecipientFailureResponse 發送失敗的收件者
+type RecipientFailureResponse struct {
+	MemberID  string    `json:"member_id"`
+	Attempts  int       `json:"attempts"`
+	LastError string    `json:"last_error"`
+	FailedAt  time.Time `json:"failed_at"`
+}
+
+// ConversionRuleResponse 積分轉換規則（amount_per_point：每 1 點所需消費金額）
+type ConversionRuleResponse struct {
+	AmountPerPoint int `json:"amount_per_point"`
+}
+
+// createCampaign POST /broadcasts
+func (r *Router) createCampaign(w http.ResponseWriter, req *http.Request) {
+	var body CreateCampaignRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	cmd := appbroadcast.CreateCampaignCommand{
+		Name:               body.Name,
+		MinAvailablePoints: body.MinAvailablePoints,
+		InactiveDays:       body.InactiveDays,
+		VerifiedAdultsOnly: body.VerifiedAdultsOnly,
+		Message:            body.Message,
+		CreatedBy:          operatorID(req),
+		Now:                r.now(),
+	}
+	if body.ScheduledAt != nil {
+		cmd.ScheduledAt = *body.ScheduledAt
+	}
+
+	result, err := r.useCases.CreateCampaign.Execute(cmd)
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toCampaignResponse(result))
+}
+
+// cancelCampaign POST /broadcasts/{campaignID}/cancel
+func (r *Router) cancelCampaign(w http.ResponseWriter, req *http.Request) {
+	result, err := r.useCases.CancelCampaign.Execute(appbroadcast.CancelCampaignCommand{
+		CampaignID: req.PathValue("campaignID"),
+		Now:        r.now(),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toCampaignResponse(result))
+}
+
+// getCampaignReport GET /broadcasts/{campaignID}/report
+func (r *Router) getCampaignReport(w http.ResponseWriter, req *http.Request) {
+	report, err := r.useCases.CampaignReport.Execute(req.PathValue("campaignID"))
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	failures := make([]RecipientFailureResponse, 0, len(report.Failures))
+	for _, f := range report.Failures {
+		failures = append(failures, RecipientFailureResponse{
+			MemberID:  f.MemberID,
+			Attempts:  f.Attempts,
+			LastError: f.LastError,
+			FailedAt:  f.FailedAt,
+		})
+	}
+	writeJSON(w, http.StatusOK, CampaignReportResponse{
+		Campaign: toCampaignResponse(report.Campaign),
+		Total:    report.Total,
+		Pending:  report.Pending,
+		Sent:     report.Sent,
+		Failed:   report.Failed,
+		Excluded: report.Excluded,
+		Failures: failures,
+	})
+}
+
+// getConversionRule GET /conversion-rule
+func (r *Router) getConversionRule(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, ConversionRuleResponse{AmountPerPoint: r.useCases.ConversionRate.Value()})
+}
+
+func toCampaignResponse(c *appbroadcast.CampaignResult) CampaignResponse {
+	return CampaignResponse{
+		CampaignID:         c.CampaignID,
+		Name:               c.Name,
+		MinAvailablePoints: c.MinAvailablePoints,
+		InactiveDays:       c.InactiveDays,
+		VerifiedAdultsOnly: c.VerifiedAdultsOnly,
+		Message:            c.Message,
+		Status:             c.Status,
+		ScheduledAt:        c.ScheduledAt,
+		CreatedBy:          c.CreatedBy,
+		StartedAt:          c.StartedAt,
+		CompletedAt:        c.CompletedAt,
+	}
+}
@@ -0,0 +1,199 @@
+package adminapi
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/broadcast"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/conversation"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/external"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/fraud"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/notification"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/tier"
+)
+
+// ===========================
+// 錯誤回應
+// ===========================
+
+// 非領域錯誤的錯誤代碼
+const (
+	codeInvalidRequest = "INVALID_REQUEST"
+	codeNotFound       = "NOT_FOUND"
+	codeInternalError  = "INTERNAL_ERROR"
+)
+
+// ErrorResponse 錯誤回應本體
+//
+// 範例：
+//
+//	{"error": {"code": "MEMBER_NOT_FOUND", "message": "會員不存在", "context": {"line_user_id": "U..."}}}
+type ErrorResponse struct {
+	Error ErrorBody `json:"error"`
+}
+
+// ErrorBody 錯誤內容
+//
+// 欄位：
+// - Code: 領域錯誤代碼（DomainError.Code）或 INVALID_REQUEST / NOT_FOUND / INTERNAL_ERROR
+// - Context: 領域錯誤的上下文（僅領域錯誤）
+type ErrorBody struct {
+	Code    string                 `json:"code"`
+	Message string                 `json:"message"`
+	Context map[string]interface{} `json:"context,omitempty"`
+}
+
+// conflictCodes 對應 409 Conflict 的錯誤代碼（狀態衝突 / 重複資料）
+var conflictCodes = map[string]bool{
+	string(member.ErrCodeMemberAlreadyExists):            true,
+	string(member.ErrCodePhoneNumberAlreadyBound):        true,
+	string(member.ErrCodePhoneAlreadyBound):              true,
+	string(member.ErrCodePhoneNotBound):                  true,
+	string(member.ErrCodeMemberVersionConflict):          true,
+	string(member.ErrCodeMemberMerged):                   true,
+	string(points.ErrCodeAccountFrozen):                  true,
+	string(points.ErrCodeAccountNotFrozen):               true,
+	string(points.ErrCodeAccountVersionConflict):         true,
+	string(points.ErrCodeAdjustmentNotPending):           true,
+	string(invoice.ErrCodeDuplicateInvoice):              true,
+	string(invoice.ErrCodeInvalidStatusTransition):       true,
+	string(survey.ErrCodeSurveyAlreadyActive):            true,
+	string(survey.ErrCodeResponseAlreadySubmitted):       true,
+	string(survey.ErrCodeBonusAlreadyAwarded):            true,
+	string(survey.ErrCodeSurveyTokenUsed):                true,
+	string(fraud.ErrCodeCaseAlreadyClosed):               true,
+	string(fraud.ErrCodeNotSuspicious):                   true,
+	string(external.ErrCodeReviewAlreadyClosed):          true,
+	string(external.ErrCodeReviewAlreadyExists):          true,
+	string(broadcast.ErrCodeInvalidCampaignState):        true,
+	string(conversation.ErrCodeInvalidSessionState):      true,
+	string(notification.ErrCodeInvalidNotificationState): true,
+	string(admin.ErrCodeUsernameTaken):                   true,
+	string(admin.ErrCodeAdminVersionConflict):            true,
+	string(admin.ErrCodeTwoFactorAlreadyEnabled):         true,
+	string(admin.ErrCodeTwoFactorNotEnabled):             true,
+	string(admin.ErrCodeTwoFactorNotPending):             true,
+}
+
+// unauthorizedCodes 對應 401 Unauthorized 的錯誤代碼（未登入 / 登入失效）
+var unauthorizedCodes = map[string]bool{
+	string(admin.ErrCodeUnauthenticated):    true,
+	string(admin.ErrCodeSessionExpired):     true,
+	string(admin.ErrCodeSessionRevoked):     true,
+	string(admin.ErrCodeInvalidCredentials): true,
+	string(admin.ErrCodeTwoFactorRequired):  true,
+}
+
+// statusForCode 將領域錯誤代碼映射為 HTTP 狀態碼
+//
+// 映射規則：
+// - *_NOT_FOUND / NO_ACTIVE_SURVEY → 404
+// - 狀態衝突、重複資料（conflictCodes）→ 409
+// - 未登入 / 登入失效（unauthorizedCodes）→ 401
+// - 權限不足、帳號停用、尚未設定必要的兩步驟驗證、核准自己的積分調整、撤回他人的積分調整 → 403
+// - 登入失敗次數過多（帳號鎖定）→ 429
+// - INVARIANT_VIOLATION → 500（資料損壞，非請求錯誤）
+// - 其他領域錯誤（格式、業務規則）→ 400
+func statusForCode(code string) int {
+	switch {
+	case strings.HasSuffix(code, "_NOT_FOUND"), code == string(survey.ErrCodeNoActiveSurvey):
+		return http.StatusNotFound
+	case conflictCodes[code]:
+		return http.StatusConflict
+	case unauthorizedCodes[code]:
+		return http.StatusUnauthorized
+	case code == string(admin.ErrCodePermissionDenied),
+		code == string(admin.ErrCodeAdminDisabled),
+		code == string(admin.ErrCodeTwoFactorEnrollmentRequired),
+		code == string(points.ErrCodeAdjustmentSelfApproval),
+		code == string(points.ErrCodeAdjustmentNotRequester):
+		return http.StatusForbidden
+	case code == string(admin.ErrCodeAccountLocked):
+		return http.StatusTooManyRequests
+	case code == string(points.ErrCodeInvariantViolation):
+		return http.StatusInternalServerError
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// domainErrorBody 從錯誤鏈取出領域錯誤（各 Bounded Context 各自定義 DomainError）
+func domainErrorBody(err error) (ErrorBody, bool) {
+	var (
+		memberErr       *member.DomainError
+		pointsErr       *points.DomainError
+		invoiceErr      *invoice.DomainError
+		surveyErr       *survey.DomainError
+		fraudErr        *fraud.DomainError
+		externalErr     *external.DomainError
+		broadcastErr    *broadcast.DomainError
+		conversationErr *conversation.DomainError
+		notificationErr *notification.DomainError
+		adminErr        *admin.DomainError
+		tierErr         *tier.DomainError
+	)
+	switch {
+	case errors.As(err, &memberErr):
+		return ErrorBody{string(memberErr.Code), memberErr.Message, memberErr.Context}, true
+	case errors.As(err, &pointsErr):
+		return ErrorBody{string(pointsErr.Code), pointsErr.Message, pointsErr.Context}, true
+	case errors.As(err, &invoiceErr):
+		return ErrorBody{string(invoiceErr.Code), invoiceErr.Message, invoiceErr.Context}, true
+	case errors.As(err, &surveyErr):
+		return ErrorBody{string(surveyErr.Code), surveyErr.Message, surveyErr.Context}, true
+	case errors.As(err, &fraudErr):
+		return ErrorBody{string(fraudErr.Code), fraudErr.Message, fraudErr.Context}, true
+	case errors.As(err, &externalErr):
+		return ErrorBody{string(externalErr.Code), externalErr.Message, externalErr.Context}, true
+	case errors.As(err, &broadcastErr):
+		return ErrorBody{string(broadcastErr.Code), broadcastErr.Message, broadcastErr.Context}, true
+	case errors.As(err, &conversationErr):
+		return ErrorBody{string(conversationErr.Code), conversationErr.Message, conversationErr.Context}, true
+	case errors.As(err, &notificationErr):
+		return ErrorBody{string(notificationErr.Code), notificationErr.Message, notificationErr.Context}, true
+	case errors.As(err, &adminErr):
+		return ErrorBody{string(adminErr.Code), adminErr.Message, adminErr.Context}, true
+	case errors.As(err, &tierErr):
+		return ErrorBody{string(tierErr.Code), tierErr.Message, tierErr.Context}, true
+	default:
+		return ErrorBody{}, false
+	}
+}
+
+// writeError 依錯誤類型回應
+//
+// 錯誤處理：
+// - 領域錯誤 → 依錯誤代碼映射狀態碼，回傳代碼、訊息與上下文
+// - 其他錯誤 → 500，不回傳內部訊息（記錄於日誌）
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	body, ok := domainErrorBody(err)
+	if !ok {
+		log.Printf("[ERROR] %s %s: %v", r.Method, r.URL.Path, err)
+		writeJSON(w, http.StatusInternalServerError, ErrorResponse{Error: ErrorBody{
+			Code:    codeInternalError,
+			Message: "internal server error",
+		}})
+		return
+	}
+
+	status := statusForCode(body.Code)
+	if status == http.StatusInternalServerError {
+		log.Printf("[ERROR] %s %s: %v", r.Method, r.URL.Path, err)
+	}
+	writeJSON(w, status, ErrorResponse{Error: body})
+}
+
+// writeBadRequest 請求格式錯誤（JSON / 路徑 / 查詢參數）
+func writeBadRequest(w http.ResponseWriter, message string) {
+	writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: ErrorBody{
+		Code:    codeInvalidRequest,
+		Message: message,
+	}})
+}
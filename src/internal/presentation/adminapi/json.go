@@ -0,0 +1,125 @@
+package adminapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ===========================
+// JSON 請求 / 回應
+// ===========================
+
+// maxRequestBodyBytes 請求本體上限（1 MB）
+const maxRequestBodyBytes = 1 << 20
+
+// writeJSON 回應 JSON
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("[ERROR] Failed to encode response: %v", err)
+	}
+}
+
+// decodeJSON 解析請求本體
+//
+// 規則：
+// - 未知欄位視為錯誤（避免欄位名稱打錯時被靜默忽略）
+// - 本體只能包含單一 JSON 物件
+func decodeJSON(w http.ResponseWriter, r *http.Request, dst any) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(dst); err != nil {
+		if errors.Is(err, io.EOF) {
+			return errors.New("request body is required")
+		}
+		return fmt.Errorf("invalid JSON body: %w", err)
+	}
+	if decoder.More() {
+		return errors.New("request body must contain a single JSON object")
+	}
+	return nil
+}
+
+// ===========================
+// 查詢參數
+// ===========================
+
+// queryTime 解析 RFC 3339 時間參數（未提供時返回零值）
+func queryTime(r *http.Request, name string) (time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("query parameter %q must be an RFC 3339 time", name)
+	}
+	return t, nil
+}
+
+// queryInt 解析整數參數（未提供時返回零值）
+func queryInt(r *http.Request, name string) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("query parameter %q must be an integer", name)
+	}
+	return n, nil
+}
+
+// queryDuration 解析時間長度參數（例如 168h；未提供時返回零值）
+func queryDuration(r *http.Request, name string) (time.Duration, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("query parameter %q must be a duration such as 168h", name)
+	}
+	return d, nil
+}
+
+// queryOptionalInt 解析選填整數參數（未提供時返回 nil）
+func queryOptionalInt(r *http.Request, name string) (*int, error) {
+	if r.URL.Query().Get(name) == "" {
+		return nil, nil
+	}
+	n, err := queryInt(r, name)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// queryOptionalTime 解析選填 RFC 3339 時間參數（未提供時返回 nil）
+func queryOptionalTime(r *http.Request, name string) (*time.Time, error) {
+	t, err := queryTime(r, name)
+	if err != nil || t.IsZero() {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// queryOptionalBool 解析選填布林參數（true / false；未提供時返回 nil）
+func queryOptionalBool(r *http.Request, name string) (*bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("query parameter %q must be true or false", name)
+	}
+	return &b, nil
+}
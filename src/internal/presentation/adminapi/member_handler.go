@@ -0,0 +1,315 @@
+package adminapi
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	appmember "github.com/jackyeh168/bar_crm/src/internal/application/member"
+	apppoints "github.com/jackyeh168/bar_crm/src/internal/application/points"
+)
+
+// ===========================
+// 會員 / 積分帳戶
+// ===========================
+
+// MemberResponse 會員資料（version 於修改會員資料時帶回；merged_into 僅已被合併的會員；
+// age_verified_by / age_verification_method / age_verified_at 僅已驗證成年的會員）
+type MemberResponse struct {
+	MemberID     string `json:"member_id"`
+	LineUserID   string `json:"line_user_id"`
+	DisplayName  string `json:"display_name"`
+	PhoneNumber  string `json:"phone_number"`
+	IsRegistered bool   `json:"is_registered"`
+	IsReachable  bool   `json:"is_reachable"`
+	Email        string `json:"email"`
+	Note         string `json:"note"`
+	Birthday     string `json:"birthday"`
+	Version      int    `json:"version"`
+	MergedInto   string `json:"merged_into,omitempty"`
+
+	IsVerifiedAdult       bool       `json:"is_verified_adult"`
+	AgeVerifiedBy         string     `json:"age_verified_by,omitempty"`
+	AgeVerificationMethod string     `json:"age_verification_method,omitempty"`
+	AgeVerifiedAt         *time.Time `json:"age_verified_at,omitempty"`
+}
+
+// UpdateMemberProfileRequest 修改會員資料請求
+//
+// 欄位：
+// - 未提供的欄位不修改；email / note / birthday 傳空字串表示清除
+// - birthday: YYYY-MM-DD
+// - version: 讀取會員資料時的版本號（必填）
+type UpdateMemberProfileRequest struct {
+	DisplayName *string `json:"display_name"`
+	Email       *string `json:"email"`
+	Note        *string `json:"note"`
+	Birthday    *string `json:"birthday"`
+	Version     int     `json:"version"`
+}
+
+// VerifyMemberAgeRequest 成年驗證請求（查驗人員為目前登入的帳號）
+//
+// 欄位：
+// - method: 驗證方式（未提供時為 staff_id_check：店員查驗身分證件）
+// - version: 讀取會員資料時的版本號（必填）
+type VerifyMemberAgeRequest struct {
+	Method  string `json:"method"`
+	Version int    `json:"version"`
+}
+
+// BalanceResponse 積分餘額
+type BalanceResponse struct {
+	AccountID       string `json:"account_id"`
+	MemberID        string `json:"member_id"`
+	EarnedPoints    int    `json:"earned_points"`
+	UsedPoints      int    `json:"used_points"`
+	AvailablePoints int    `json:"available_points"`
+}
+
+// MemberSummaryResponse 會員搜尋結果（含積分餘額）
+type MemberSummaryResponse struct {
+	MemberID         string    `json:"member_id"`
+	LineUserID       string    `json:"line_user_id"`
+	DisplayName      string    `json:"display_name"`
+	PhoneNumber      string    `json:"phone_number"`
+	IsRegistered     bool      `json:"is_registered"`
+	IsReachable      bool      `json:"is_reachable"`
+	RegisteredAt     time.Time `json:"registered_at"`
+	HasPointsAccount bool      `json:"has_points_account"`
+	EarnedPoints     int       `json:"earned_points"`
+	UsedPoints       int       `json:"used_points"`
+	AvailablePoints  int       `json:"available_points"`
+}
+
+// MemberSearchResponse 會員搜尋結果（next_cursor 為空字串表示已無下一頁）
+type MemberSearchResponse struct {
+	Items      []MemberSummaryResponse `json:"items"`
+	NextCursor string                  `json:"next_cursor"`
+}
+
+// FreezeAccountRequest 凍結積分帳戶請求（操作者為目前登入的管理員）
+type FreezeAccountRequest struct {
+	Reason string `json:"reason"`
+}
+
+// FreezeAccountResponse 凍結狀態
+type FreezeAccountResponse struct {
+	AccountID string `json:"account_id"`
+	MemberID  string `json:"member_id"`
+	Frozen    bool   `json:"frozen"`
+}
+
+// getMember GET /members?line_user_id=
+func (r *Router) getMember(w http.ResponseWriter, req *http.Request) {
+	lineUserID := req.URL.Query().Get("line_user_id")
+	if lineUserID == "" {
+		writeBadRequest(w, `query parameter "line_user_id" is required`)
+		return
+	}
+
+	m, err := r.useCases.MemberQuery.Execute(appmember.GetMemberByLineUserIDQuery{LineUserID: lineUserID})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toMemberResponse(m))
+}
+
+// updateMemberProfile PATCH /members/{memberID}
+func (r *Router) updateMemberProfile(w http.ResponseWriter, req *http.Request) {
+	var body UpdateMemberProfileRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	m, err := r.useCases.UpdateProfile.Execute(appmember.UpdateMemberProfileCommand{
+		MemberID:    req.PathValue("memberID"),
+		DisplayName: body.DisplayName,
+		Email:       body.Email,
+		Note:        body.Note,
+		Birthday:    body.Birthday,
+		Version:     body.Version,
+		Now:         r.now(),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toMemberResponse(m))
+}
+
+// verifyMemberAge POST /members/{memberID}/age-verification
+func (r *Router) verifyMemberAge(w http.ResponseWriter, req *http.Request) {
+	var body VerifyMemberAgeRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	m, err := r.useCases.VerifyAge.Execute(appmember.VerifyMemberAgeCommand{
+		MemberID:   req.PathValue("memberID"),
+		Method:     body.Method,
+		OperatorID: operatorID(req),
+		Version:    body.Version,
+		Now:        r.now(),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toMemberResponse(m))
+}
+
+func toMemberResponse(m *appmember.MemberResult) MemberResponse {
+	return MemberResponse{
+		MemberID:     m.MemberID,
+		LineUserID:   m.LineUserID,
+		DisplayName:  m.DisplayName,
+		PhoneNumber:  m.PhoneNumber,
+		IsRegistered: m.IsRegistered,
+		IsReachable:  m.IsReachable,
+		Email:        m.Email,
+		Note:         m.Note,
+		Birthday:     m.Birthday,
+		Version:      m.Version,
+		MergedInto:   m.MergedInto,
+
+		IsVerifiedAdult:       m.IsVerifiedAdult,
+		AgeVerifiedBy:         m.AgeVerifiedBy,
+		AgeVerificationMethod: m.AgeVerificationMethod,
+		AgeVerifiedAt:         m.AgeVerifiedAt,
+	}
+}
+
+// searchMembers GET /members/search?phone_suffix=&name=&registered_from=&registered_to=&reachable=
+// &min_points=&max_points=&sort=&order=&limit=&cursor=
+func (r *Router) searchMembers(w http.ResponseWriter, req *http.Request) {
+	query, err := parseSearchMembersQuery(req)
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.SearchMembers.Execute(query)
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	items := make([]MemberSummaryResponse, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, MemberSummaryResponse{
+			MemberID:         item.MemberID,
+			LineUserID:       item.LineUserID,
+			DisplayName:      item.DisplayName,
+			PhoneNumber:      item.PhoneNumber,
+			IsRegistered:     item.IsRegistered,
+			IsReachable:      item.IsReachable,
+			RegisteredAt:     item.RegisteredAt,
+			HasPointsAccount: item.HasPointsAccount,
+			EarnedPoints:     item.EarnedPoints,
+			UsedPoints:       item.UsedPoints,
+			AvailablePoints:  item.AvailablePoints,
+		})
+	}
+	writeJSON(w, http.StatusOK, MemberSearchResponse{Items: items, NextCursor: result.NextCursor})
+}
+
+// parseSearchMembersQuery 解析會員搜尋查詢參數（order: asc / desc）
+func parseSearchMembersQuery(req *http.Request) (appmember.SearchMembersQuery, error) {
+	values := req.URL.Query()
+	query := appmember.SearchMembersQuery{
+		PhoneSuffix: values.Get("phone_suffix"),
+		Name:        values.Get("name"),
+		Sort:        values.Get("sort"),
+		Cursor:      values.Get("cursor"),
+	}
+
+	switch values.Get("order") {
+	case "", "asc":
+	case "desc":
+		query.Descending = true
+	default:
+		return query, errors.New(`query parameter "order" must be asc or desc`)
+	}
+
+	var err error
+	if query.RegisteredFrom, err = queryOptionalTime(req, "registered_from"); err != nil {
+		return query, err
+	}
+	if query.RegisteredTo, err = queryOptionalTime(req, "registered_to"); err != nil {
+		return query, err
+	}
+	if query.Reachable, err = queryOptionalBool(req, "reachable"); err != nil {
+		return query, err
+	}
+	if query.MinPoints, err = queryOptionalInt(req, "min_points"); err != nil {
+		return query, err
+	}
+	if query.MaxPoints, err = queryOptionalInt(req, "max_points"); err != nil {
+		return query, err
+	}
+	if query.Limit, err = queryInt(req, "limit"); err != nil {
+		return query, err
+	}
+	return query, nil
+}
+
+// getBalance GET /members/{memberID}/points
+func (r *Router) getBalance(w http.ResponseWriter, req *http.Request) {
+	balance, err := r.useCases.BalanceQuery.Execute(apppoints.GetPointsBalanceQuery{MemberID: req.PathValue("memberID")})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, BalanceResponse{
+		AccountID:       balance.AccountID,
+		MemberID:        balance.MemberID,
+		EarnedPoints:    balance.EarnedPoints,
+		UsedPoints:      balance.UsedPoints,
+		AvailablePoints: balance.AvailablePoints,
+	})
+}
+
+// freezeAccount POST /members/{memberID}/points/freeze
+func (r *Router) freezeAccount(w http.ResponseWriter, req *http.Request) {
+	var body FreezeAccountRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.FreezeAccount.Execute(apppoints.FreezePointsAccountCommand{
+		MemberID:   req.PathValue("memberID"),
+		Reason:     body.Reason,
+		OperatorID: operatorID(req),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toFreezeAccountResponse(result))
+}
+
+// unfreezeAccount POST /members/{memberID}/points/unfreeze
+func (r *Router) unfreezeAccount(w http.ResponseWriter, req *http.Request) {
+	result, err := r.useCases.UnfreezeAccount.Execute(apppoints.UnfreezePointsAccountCommand{
+		MemberID:   req.PathValue("memberID"),
+		OperatorID: operatorID(req),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toFreezeAccountResponse(result))
+}
+
+func toFreezeAccountResponse(result *apppoints.FreezePointsAccountResult) FreezeAccountResponse {
+	return FreezeAccountResponse{
+		AccountID: result.AccountID,
+		MemberID:  result.MemberID,
+		Frozen:    result.Frozen,
+	}
+}
@@ -0,0 +1,90 @@
+package adminapi
+
+import (
+	"net/http"
+	"time"
+
+	appmember "github.com/jackyeh168/bar_crm/src/internal/application/member"
+)
+
+// ===========================
+// 重複會員合併
+// ===========================
+
+// MergeMembersRequest 合併會員請求（路徑中的會員為保留的一方，操作者為目前登入的管理員）
+type MergeMembersRequest struct {
+	SecondaryMemberID string `json:"secondary_member_id"`
+	Reason            string `json:"reason"`
+}
+
+// MemberMergeResponse 會員合併紀錄
+type MemberMergeResponse struct {
+	MergeID             string    `json:"merge_id"`
+	PrimaryMemberID     string    `json:"primary_member_id"`
+	SecondaryMemberID   string    `json:"secondary_member_id"`
+	Reason              string    `json:"reason"`
+	OperatorID          string    `json:"operator_id"`
+	ReleasedPhoneNumber string    `json:"released_phone_number"`
+	EarnedPoints        int       `json:"earned_points"`
+	UsedPoints          int       `json:"used_points"`
+	Transactions        int       `json:"transactions"`
+	SurveyResponses     int       `json:"survey_responses"`
+	Adjustments         int       `json:"adjustments"`
+	MergedAt            time.Time `json:"merged_at"`
+}
+
+// mergeMembers POST /members/{memberID}/merge
+func (r *Router) mergeMembers(w http.ResponseWriter, req *http.Request) {
+	var body MergeMembersRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.MergeMembers.Execute(appmember.MergeMembersCommand{
+		PrimaryMemberID:   req.PathValue("memberID"),
+		SecondaryMemberID: body.SecondaryMemberID,
+		Reason:            body.Reason,
+		OperatorID:        operatorID(req),
+		Now:               r.now(),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toMemberMergeResponse(*result))
+}
+
+// listMemberMerges GET /members/{memberID}/merges
+func (r *Router) listMemberMerges(w http.ResponseWriter, req *http.Request) {
+	results, err := r.useCases.MemberMerges.Execute(appmember.ListMemberMergesQuery{
+		MemberID: req.PathValue("memberID"),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	items := make([]MemberMergeResponse, 0, len(results))
+	for _, result := range results {
+		items = append(items, toMemberMergeResponse(result))
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func toMemberMergeResponse(result appmember.MemberMergeResult) MemberMergeResponse {
+	return MemberMergeResponse{
+		MergeID:             result.MergeID,
+		PrimaryMemberID:     result.PrimaryMemberID,
+		SecondaryMemberID:   result.SecondaryMemberID,
+		Reason:              result.Reason,
+		OperatorID:          result.OperatorID,
+		ReleasedPhoneNumber: result.ReleasedPhoneNumber,
+		EarnedPoints:        result.EarnedPoints,
+		UsedPoints:          result.UsedPoints,
+		Transactions:        result.Transactions,
+		SurveyResponses:     result.SurveyResponses,
+		Adjustments:         result.Adjustments,
+		MergedAt:            result.MergedAt,
+	}
+}
@@ -0,0 +1,52 @@
+package adminapi
+
+import (
+	"net/http"
+	"time"
+
+	apptier "github.com/jackyeh168/bar_crm/src/internal/application/tier"
+)
+
+// ===========================
+// 會員等級
+// ===========================
+
+// MemberTierResponse 會員等級與權益
+type MemberTierResponse struct {
+	MemberID         string     `json:"member_id"`
+	Level            string     `json:"level"`
+	Multiplier       string     `json:"multiplier"`
+	Perks            []string   `json:"perks"`
+	RollingSpend     int        `json:"rolling_spend"`
+	DemotionDueAt    *time.Time `json:"demotion_due_at,omitempty"`
+	NextLevel        string     `json:"next_level,omitempty"`
+	SpendToNextLevel int        `json:"spend_to_next_level"`
+	EvaluatedAt      *time.Time `json:"evaluated_at,omitempty"`
+}
+
+// getMemberTier GET /members/{memberID}/tier
+func (r *Router) getMemberTier(w http.ResponseWriter, req *http.Request) {
+	result, err := r.useCases.MemberTier.Execute(apptier.GetMemberTierQuery{
+		MemberID: req.PathValue("memberID"),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	perks := result.Perks
+	if perks == nil {
+		perks = []string{}
+	}
+	writeJSON(w, http.StatusOK, MemberTierResponse{
+		MemberID:         result.MemberID,
+		Level:            result.Level,
+		Multiplier:       result.Multiplier,
+		Perks:            perks,
+		RollingSpend:     result.RollingSpend,
+		DemotionDueAt:    result.DemotionDueAt,
+		NextLevel:        result.NextLevel,
+		SpendToNextLevel: result.SpendToNextLevel,
+		EvaluatedAt:      result.EvaluatedAt,
+	})
+}
@@ -0,0 +1,61 @@
+package adminapi
+
+import (
+	"net/http"
+
+	appnotification "github.com/jackyeh168/bar_crm/src/internal/application/notification"
+)
+
+// ===========================
+// 會員通知偏好
+// ===========================
+
+// NotificationPreferenceRequest 更新通知偏好請求
+//
+// 欄位：
+// - PointsActivityEnabled: false 表示會員不接收積分異動通知
+// - QuietHoursStart / QuietHoursEnd: "HH:MM"（台北時間），皆省略表示不啟用勿擾時段
+// - Locale: zh-TW / en（省略使用預設語系）
+type NotificationPreferenceRequest struct {
+	PointsActivityEnabled bool   `json:"points_activity_enabled"`
+	QuietHoursStart       string `json:"quiet_hours_start"`
+	QuietHoursEnd         string `json:"quiet_hours_end"`
+	Locale                string `json:"locale"`
+}
+
+// NotificationPreferenceResponse 通知偏好（quiet_hours：HH:MM-HH:MM 或 off）
+type NotificationPreferenceResponse struct {
+	MemberID              string `json:"member_id"`
+	PointsActivityEnabled bool   `json:"points_activity_enabled"`
+	QuietHours            string `json:"quiet_hours"`
+	Locale                string `json:"locale"`
+}
+
+// updateNotificationPreference PUT /members/{memberID}/notification-preference
+//
+// 使用場景：會員要求關閉積分通知或設定勿擾時段
+func (r *Router) updateNotificationPreference(w http.ResponseWriter, req *http.Request) {
+	var body NotificationPreferenceRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.NotificationPrefs.Execute(appnotification.UpdateNotificationPreferenceCommand{
+		MemberID:              req.PathValue("memberID"),
+		PointsActivityEnabled: body.PointsActivityEnabled,
+		QuietHoursStart:       body.QuietHoursStart,
+		QuietHoursEnd:         body.QuietHoursEnd,
+		Locale:                body.Locale,
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, NotificationPreferenceResponse{
+		MemberID:              result.MemberID,
+		PointsActivityEnabled: result.PointsActivityEnabled,
+		QuietHours:            result.QuietHours,
+		Locale:                result.Locale,
+	})
+}
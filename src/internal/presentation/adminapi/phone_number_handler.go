@@ -0,0 +1,108 @@
+package adminapi
+
+import (
+	"net/http"
+	"time"
+
+	appmember "github.com/jackyeh168/bar_crm/src/internal/application/member"
+)
+
+// ===========================
+// 會員手機號碼（管理員更換 / 解除綁定）
+// ===========================
+
+// RebindPhoneNumberRequest 更換手機號碼請求（操作者為目前登入的管理員）
+type RebindPhoneNumberRequest struct {
+	PhoneNumber string `json:"phone_number"`
+	Reason      string `json:"reason"`
+}
+
+// UnbindPhoneNumberRequest 解除綁定請求
+type UnbindPhoneNumberRequest struct {
+	Reason string `json:"reason"`
+}
+
+// PhoneNumberChangeResponse 手機號碼異動紀錄
+type PhoneNumberChangeResponse struct {
+	ChangeID            string    `json:"change_id"`
+	MemberID            string    `json:"member_id"`
+	Action              string    `json:"action"`
+	PreviousPhoneNumber string    `json:"previous_phone_number"`
+	PhoneNumber         string    `json:"phone_number"`
+	Reason              string    `json:"reason"`
+	OperatorID          string    `json:"operator_id"`
+	ChangedAt           time.Time `json:"changed_at"`
+}
+
+// rebindPhoneNumber POST /members/{memberID}/phone/rebind
+func (r *Router) rebindPhoneNumber(w http.ResponseWriter, req *http.Request) {
+	var body RebindPhoneNumberRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.RebindPhone.Execute(appmember.RebindPhoneNumberCommand{
+		MemberID:    req.PathValue("memberID"),
+		PhoneNumber: body.PhoneNumber,
+		Reason:      body.Reason,
+		OperatorID:  operatorID(req),
+		Now:         r.now(),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toPhoneNumberChangeResponse(*result))
+}
+
+// unbindPhoneNumber POST /members/{memberID}/phone/unbind
+func (r *Router) unbindPhoneNumber(w http.ResponseWriter, req *http.Request) {
+	var body UnbindPhoneNumberRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.UnbindPhone.Execute(appmember.UnbindPhoneNumberCommand{
+		MemberID:   req.PathValue("memberID"),
+		Reason:     body.Reason,
+		OperatorID: operatorID(req),
+		Now:        r.now(),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toPhoneNumberChangeResponse(*result))
+}
+
+// listPhoneNumberChanges GET /members/{memberID}/phone/changes
+func (r *Router) listPhoneNumberChanges(w http.ResponseWriter, req *http.Request) {
+	results, err := r.useCases.PhoneChanges.Execute(appmember.ListPhoneNumberChangesQuery{
+		MemberID: req.PathValue("memberID"),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	items := make([]PhoneNumberChangeResponse, 0, len(results))
+	for _, result := range results {
+		items = append(items, toPhoneNumberChangeResponse(result))
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func toPhoneNumberChangeResponse(result appmember.PhoneNumberChangeResult) PhoneNumberChangeResponse {
+	return PhoneNumberChangeResponse{
+		ChangeID:            result.ChangeID,
+		MemberID:            result.MemberID,
+		Action:              result.Action,
+		PreviousPhoneNumber: result.PreviousPhoneNumber,
+		PhoneNumber:         result.PhoneNumber,
+		Reason:              result.Reason,
+		OperatorID:          result.OperatorID,
+		ChangedAt:           result.ChangedAt,
+	}
+}
@@ -0,0 +1,167 @@
+package adminapi
+
+import (
+	"net/http"
+	"time"
+
+	apppoints "github.com/jackyeh168/bar_crm/src/internal/application/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+)
+
+// ===========================
+// 積分人工調整（maker-checker）
+// ===========================
+
+// AdjustPointsRequest 人工調整積分請求（申請人為目前登入的管理員）
+//
+// 欄位：
+// - Delta: 正數加點、負數扣點
+// - Reason: goodwill / service_recovery / missed_invoice / correction / fraud_reversal
+type AdjustPointsRequest struct {
+	Delta  int    `json:"delta"`
+	Reason string `json:"reason"`
+	Note   string `json:"note"`
+}
+
+// ReviewPointsAdjustmentRequest 核准 / 駁回 / 撤回請求（審核人為目前登入的管理員）
+type ReviewPointsAdjustmentRequest struct {
+	Note string `json:"note"`
+}
+
+// PointsAdjustmentResponse 積分調整紀錄
+//
+// 欄位：
+// - AccountEventID: 入帳時的 points.adjusted 事件 ID（未入帳為空字串）
+// - AvailablePoints: 處理後的帳戶可用積分（清單不提供）
+type PointsAdjustmentResponse struct {
+	AdjustmentID     string     `json:"adjustment_id"`
+	MemberID         string     `json:"member_id"`
+	Delta            int        `json:"delta"`
+	Reason           string     `json:"reason"`
+	Note             string     `json:"note"`
+	RequiresApproval bool       `json:"requires_approval"`
+	Status           string     `json:"status"`
+	RequestedBy      string     `json:"requested_by"`
+	RequestedAt      time.Time  `json:"requested_at"`
+	ReviewedBy       string     `json:"reviewed_by,omitempty"`
+	ReviewNote       string     `json:"review_note,omitempty"`
+	ReviewedAt       *time.Time `json:"reviewed_at"`
+	AccountEventID   string     `json:"account_event_id,omitempty"`
+	AppliedAt        *time.Time `json:"applied_at"`
+	AvailablePoints  *int       `json:"available_points,omitempty"`
+}
+
+// PointsAdjustmentListResponse 積分調整清單
+type PointsAdjustmentListResponse struct {
+	Items []PointsAdjustmentResponse `json:"items"`
+}
+
+// adjustPoints POST /members/{memberID}/points/adjustments
+//
+// 回應：立即入帳 → 201；超過門檻或申請人不具核准權限（非 owner）待核准 → 202
+func (r *Router) adjustPoints(w http.ResponseWriter, req *http.Request) {
+	var body AdjustPointsRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.AdjustPoints.Execute(apppoints.AdjustPointsCommand{
+		MemberID:            req.PathValue("memberID"),
+		Delta:               body.Delta,
+		Reason:              body.Reason,
+		Note:                body.Note,
+		RequestedBy:         operatorID(req),
+		RequesterCanApprove: principalCan(req, admin.PermissionAdjustPoints),
+		Now:                 r.now(),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	status := http.StatusCreated
+	if result.Status == "pending_approval" {
+		status = http.StatusAccepted
+	}
+	writeJSON(w, status, toPointsAdjustmentResponse(result, true))
+}
+
+// listPointsAdjustments GET /points-adjustments?status=&member_id=&limit=
+//
+// 不具核准權限（非 owner）時只列出自己申請的調整
+func (r *Router) listPointsAdjustments(w http.ResponseWriter, req *http.Request) {
+	limit, err := queryInt(req, "limit")
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	query := apppoints.ListPointsAdjustmentsQuery{
+		Status:   req.URL.Query().Get("status"),
+		MemberID: req.URL.Query().Get("member_id"),
+		Limit:    limit,
+	}
+	if !principalCan(req, admin.PermissionAdjustPoints) {
+		query.RequestedBy = operatorID(req)
+	}
+	results, err := r.useCases.ListAdjustments.Execute(query)
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	items := make([]PointsAdjustmentResponse, 0, len(results))
+	for _, result := range results {
+		items = append(items, toPointsAdjustmentResponse(result, false))
+	}
+	writeJSON(w, http.StatusOK, PointsAdjustmentListResponse{Items: items})
+}
+
+// reviewPointsAdjustment POST /points-adjustments/{adjustmentID}/approve|reject|withdraw
+func (r *Router) reviewPointsAdjustment(useCase ReviewPointsAdjustmentUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var body ReviewPointsAdjustmentRequest
+		if err := decodeJSON(w, req, &body); err != nil {
+			writeBadRequest(w, err.Error())
+			return
+		}
+
+		result, err := useCase.Execute(apppoints.ReviewPointsAdjustmentCommand{
+			AdjustmentID: req.PathValue("adjustmentID"),
+			Reviewer:     operatorID(req),
+			Note:         body.Note,
+			Now:          r.now(),
+		})
+		if err != nil {
+			writeError(w, req, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, toPointsAdjustmentResponse(result, true))
+	}
+}
+
+// toPointsAdjustmentResponse 轉換回應 DTO（withBalance 為 false 時不輸出可用積分）
+func toPointsAdjustmentResponse(result *apppoints.PointsAdjustmentResult, withBalance bool) PointsAdjustmentResponse {
+	response := PointsAdjustmentResponse{
+		AdjustmentID:     result.AdjustmentID,
+		MemberID:         result.MemberID,
+		Delta:            result.Delta,
+		Reason:           result.Reason,
+		Note:             result.Note,
+		RequiresApproval: result.RequiresApproval,
+		Status:           result.Status,
+		RequestedBy:      result.RequestedBy,
+		RequestedAt:      result.RequestedAt,
+		ReviewedBy:       result.ReviewedBy,
+		ReviewNote:       result.ReviewNote,
+		ReviewedAt:       result.ReviewedAt,
+		AccountEventID:   result.AccountEventID,
+		AppliedAt:        result.AppliedAt,
+	}
+	if withBalance {
+		available := result.AvailablePoints
+		response.AvailablePoints = &available
+	}
+	return response
+}
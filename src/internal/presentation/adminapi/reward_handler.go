@@ -0,0 +1,112 @@
+package adminapi
+
+import (
+	"net/http"
+	"time"
+
+	apppoints "github.com/jackyeh168/bar_crm/src/internal/application/points"
+)
+
+// ===========================
+// 兌換目錄
+// ===========================
+
+// CreateRewardRequest 新增獎勵請求
+//
+// 欄位：
+// - Alcoholic: 酒類獎勵（僅限已驗證成年的會員兌換）
+type CreateRewardRequest struct {
+	Name       string `json:"name"`
+	PointsCost int    `json:"points_cost"`
+	Alcoholic  bool   `json:"alcoholic"`
+}
+
+// RewardResponse 兌換目錄中的獎勵
+type RewardResponse struct {
+	RewardID   string    `json:"reward_id"`
+	Name       string    `json:"name"`
+	PointsCost int       `json:"points_cost"`
+	Alcoholic  bool      `json:"alcoholic"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// RewardListResponse 兌換目錄
+type RewardListResponse struct {
+	Items []RewardResponse `json:"items"`
+}
+
+// RedeemRewardResponse 兌換結果
+type RedeemRewardResponse struct {
+	MemberID        string `json:"member_id"`
+	RewardID        string `json:"reward_id"`
+	RewardName      string `json:"reward_name"`
+	PointsCost      int    `json:"points_cost"`
+	AvailablePoints int    `json:"available_points"`
+}
+
+// listRewards GET /rewards
+func (r *Router) listRewards(w http.ResponseWriter, req *http.Request) {
+	results, err := r.useCases.ListRewards.Execute()
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	items := make([]RewardResponse, 0, len(results))
+	for _, result := range results {
+		items = append(items, toRewardResponse(result))
+	}
+	writeJSON(w, http.StatusOK, RewardListResponse{Items: items})
+}
+
+// createReward POST /rewards
+func (r *Router) createReward(w http.ResponseWriter, req *http.Request) {
+	var body CreateRewardRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.CreateReward.Execute(apppoints.CreateRewardCommand{
+		Name:       body.Name,
+		PointsCost: body.PointsCost,
+		Alcoholic:  body.Alcoholic,
+		Now:        r.now(),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toRewardResponse(result))
+}
+
+// redeemReward POST /members/{memberID}/rewards/{rewardID}/redeem
+//
+// 錯誤：酒類獎勵且會員尚未驗證成年 → 400 AGE_VERIFICATION_REQUIRED
+func (r *Router) redeemReward(w http.ResponseWriter, req *http.Request) {
+	result, err := r.useCases.RedeemReward.Execute(apppoints.RedeemRewardCommand{
+		MemberID: req.PathValue("memberID"),
+		RewardID: req.PathValue("rewardID"),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, RedeemRewardResponse{
+		MemberID:        result.MemberID,
+		RewardID:        result.RewardID,
+		RewardName:      result.RewardName,
+		PointsCost:      result.PointsCost,
+		AvailablePoints: result.AvailablePoints,
+	})
+}
+
+func toRewardResponse(r *apppoints.RewardResult) RewardResponse {
+	return RewardResponse{
+		RewardID:   r.RewardID,
+		Name:       r.Name,
+		PointsCost: r.PointsCost,
+		Alcoholic:  r.Alcoholic,
+		CreatedAt:  r.CreatedAt,
+	}
+}
@@ -0,0 +1,472 @@
+package adminapi
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	appadmin "github.com/jackyeh168/bar_crm/src/internal/application/admin"
+	appbroadcast "github.com/jackyeh168/bar_crm/src/internal/application/broadcast"
+	appexternal "github.com/jackyeh168/bar_crm/src/internal/application/external"
+	appfraud "github.com/jackyeh168/bar_crm/src/internal/application/fraud"
+	appmember "github.com/jackyeh168/bar_crm/src/internal/application/member"
+	appnotification "github.com/jackyeh168/bar_crm/src/internal/application/notification"
+	apppoints "github.com/jackyeh168/bar_crm/src/internal/application/points"
+	appsurvey "github.com/jackyeh168/bar_crm/src/internal/application/survey"
+	apptier "github.com/jackyeh168/bar_crm/src/internal/application/tier"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+)
+
+// ===========================
+// Use Case 依賴
+// ===========================
+
+// 設計原則：以介面宣告依賴的 Use Case，方便測試替換
+// 實作：application 層各 Bounded Context 的 Use Case
+
+// LoginUseCase 管理員登入
+type LoginUseCase interface {
+	Execute(cmd appadmin.LoginCommand) (*appadmin.LoginResult, error)
+}
+
+// LogoutUseCase 管理員登出
+type LogoutUseCase interface {
+	Execute(cmd appadmin.LogoutCommand) error
+}
+
+// AuthorizeUseCase 驗證 Token 並檢查權限
+type AuthorizeUseCase interface {
+	Execute(query appadmin.AuthorizeQuery) (*appadmin.PrincipalResult, error)
+}
+
+// CreateAdminUserUseCase 建立管理員帳號
+type CreateAdminUserUseCase interface {
+	Execute(cmd appadmin.CreateAdminUserCommand) (*appadmin.AdminUserResult, error)
+}
+
+// DisableAdminUserUseCase 停用管理員帳號
+type DisableAdminUserUseCase interface {
+	Execute(cmd appadmin.DisableAdminUserCommand) (*appadmin.AdminUserResult, error)
+}
+
+// BeginTOTPEnrollmentUseCase 開始設定兩步驟驗證
+type BeginTOTPEnrollmentUseCase interface {
+	Execute(cmd appadmin.BeginTOTPEnrollmentCommand) (*appadmin.BeginTOTPEnrollmentResult, error)
+}
+
+// ConfirmTOTPEnrollmentUseCase 確認並啟用兩步驟驗證
+type ConfirmTOTPEnrollmentUseCase interface {
+	Execute(cmd appadmin.ConfirmTOTPEnrollmentCommand) (*appadmin.RecoveryCodesResult, error)
+}
+
+// RegenerateRecoveryCodesUseCase 重新產生復原碼
+type RegenerateRecoveryCodesUseCase interface {
+	Execute(cmd appadmin.RegenerateRecoveryCodesCommand) (*appadmin.RecoveryCodesResult, error)
+}
+
+// MemberQueryUseCase 以 LINE UserID 查詢會員
+type MemberQueryUseCase interface {
+	Execute(query appmember.GetMemberByLineUserIDQuery) (*appmember.MemberResult, error)
+}
+
+// SearchMembersUseCase 會員搜尋（條件篩選、排序、keyset 分頁）
+type SearchMembersUseCase interface {
+	Execute(query appmember.SearchMembersQuery) (*appmember.SearchMembersResult, error)
+}
+
+// UpdateMemberProfileUseCase 管理員修改會員資料（樂觀鎖）
+type UpdateMemberProfileUseCase interface {
+	Execute(cmd appmember.UpdateMemberProfileCommand) (*appmember.MemberResult, error)
+}
+
+// VerifyMemberAgeUseCase 店員查驗證件後標記會員已成年（樂觀鎖）
+type VerifyMemberAgeUseCase interface {
+	Execute(cmd appmember.VerifyMemberAgeCommand) (*appmember.MemberResult, error)
+}
+
+// RebindPhoneNumberUseCase 管理員更換會員手機號碼
+type RebindPhoneNumberUseCase interface {
+	Execute(cmd appmember.RebindPhoneNumberCommand) (*appmember.PhoneNumberChangeResult, error)
+}
+
+// UnbindPhoneNumberUseCase 管理員解除會員手機號碼綁定
+type UnbindPhoneNumberUseCase interface {
+	Execute(cmd appmember.UnbindPhoneNumberCommand) (*appmember.PhoneNumberChangeResult, error)
+}
+
+// ListPhoneNumberChangesUseCase 查詢會員手機號碼異動紀錄
+type ListPhoneNumberChangesUseCase interface {
+	Execute(query appmember.ListPhoneNumberChangesQuery) ([]appmember.PhoneNumberChangeResult, error)
+}
+
+// MergeMembersUseCase 合併重複會員
+type MergeMembersUseCase interface {
+	Execute(cmd appmember.MergeMembersCommand) (*appmember.MemberMergeResult, error)
+}
+
+// ListMemberMergesUseCase 查詢會員合併紀錄
+type ListMemberMergesUseCase interface {
+	Execute(query appmember.ListMemberMergesQuery) ([]appmember.MemberMergeResult, error)
+}
+
+// MemberTierQueryUseCase 查詢會員等級
+type MemberTierQueryUseCase interface {
+	Execute(query apptier.GetMemberTierQuery) (*apptier.MemberTierResult, error)
+}
+
+// UpdateNotificationPreferenceUseCase 更新會員通知偏好
+type UpdateNotificationPreferenceUseCase interface {
+	Execute(cmd appnotification.UpdateNotificationPreferenceCommand) (*appnotification.NotificationPreferenceResult, error)
+}
+
+// BalanceQueryUseCase 查詢積分餘額
+type BalanceQueryUseCase interface {
+	Execute(query apppoints.GetPointsBalanceQuery) (*apppoints.GetPointsBalanceResult, error)
+}
+
+// FreezeAccountUseCase 凍結積分帳戶
+type FreezeAccountUseCase interface {
+	Execute(cmd apppoints.FreezePointsAccountCommand) (*apppoints.FreezePointsAccountResult, error)
+}
+
+// UnfreezeAccountUseCase 解除凍結積分帳戶
+type UnfreezeAccountUseCase interface {
+	Execute(cmd apppoints.UnfreezePointsAccountCommand) (*apppoints.FreezePointsAccountResult, error)
+}
+
+// AdjustPointsUseCase 人工調整積分（超過門檻需另一位管理員核准）
+type AdjustPointsUseCase interface {
+	Execute(cmd apppoints.AdjustPointsCommand) (*apppoints.PointsAdjustmentResult, error)
+}
+
+// ReviewPointsAdjustmentUseCase 核准 / 駁回 / 撤回積分調整
+type ReviewPointsAdjustmentUseCase interface {
+	Execute(cmd apppoints.ReviewPointsAdjustmentCommand) (*apppoints.PointsAdjustmentResult, error)
+}
+
+// ListPointsAdjustmentsUseCase 查詢積分調整清單
+type ListPointsAdjustmentsUseCase interface {
+	Execute(query apppoints.ListPointsAdjustmentsQuery) ([]*apppoints.PointsAdjustmentResult, error)
+}
+
+// CreateRewardUseCase 新增兌換目錄中的獎勵
+type CreateRewardUseCase interface {
+	Execute(cmd apppoints.CreateRewardCommand) (*apppoints.RewardResult, error)
+}
+
+// ListRewardsUseCase 查詢兌換目錄
+type ListRewardsUseCase interface {
+	Execute() ([]*apppoints.RewardResult, error)
+}
+
+// RedeemRewardUseCase 以積分兌換獎勵（酒類獎勵需已驗證成年）
+type RedeemRewardUseCase interface {
+	Execute(cmd apppoints.RedeemRewardCommand) (*apppoints.RedeemRewardResult, error)
+}
+
+// ResolveFraudCaseUseCase 處理詐騙調查案件（排除 / 確認）
+type ResolveFraudCaseUseCase interface {
+	Execute(cmd appfraud.ResolveFraudCaseCommand) (*appfraud.ResolveFraudCaseResult, error)
+}
+
+// MatchIChefRecordUseCase 以 iChef 記錄比對會員掃描的發票
+type MatchIChefRecordUseCase interface {
+	Execute(record appexternal.IChefRecord) (*appexternal.MatchIChefRecordResult, error)
+}
+
+// ListDiscrepanciesUseCase 查詢待審核的 iChef 差異案件
+type ListDiscrepanciesUseCase interface {
+	Execute(query appexternal.ListPendingDiscrepanciesQuery) ([]appexternal.DiscrepancyReviewDTO, error)
+}
+
+// ResolveDiscrepancyUseCase 處理差異審核案件（核准 / 駁回）
+type ResolveDiscrepancyUseCase interface {
+	Execute(cmd appexternal.ResolveDiscrepancyCommand) (*appexternal.ResolveDiscrepancyResult, error)
+}
+
+// CreateSurveyUseCase 建立問卷
+type CreateSurveyUseCase interface {
+	Execute(cmd appsurvey.CreateSurveyCommand) (*appsurvey.SurveyResult, error)
+}
+
+// ReviseSurveyUseCase 修訂問卷
+type ReviseSurveyUseCase interface {
+	Execute(cmd appsurvey.ReviseSurveyCommand) (*appsurvey.SurveyResult, error)
+}
+
+// ActivateSurveyUseCase 啟用問卷
+type ActivateSurveyUseCase interface {
+	Execute(cmd appsurvey.ActivateSurveyCommand) (*appsurvey.ActivateSurveyResult, error)
+}
+
+// DeactivateSurveyUseCase 停用問卷
+type DeactivateSurveyUseCase interface {
+	Execute(cmd appsurvey.ActivateSurveyCommand) error
+}
+
+// ActiveSurveyQueryUseCase 查詢啟用中的問卷
+type ActiveSurveyQueryUseCase interface {
+	Execute() (*appsurvey.SurveyResult, error)
+}
+
+// IssueSurveyTokenUseCase 簽發問卷 Token
+type IssueSurveyTokenUseCase interface {
+	Execute(cmd appsurvey.IssueSurveyTokenCommand) (*appsurvey.IssueSurveyTokenResult, error)
+}
+
+// OpenSurveyUseCase 以 Token 開啟問卷
+type OpenSurveyUseCase interface {
+	Execute(query appsurvey.OpenSurveyQuery) (*appsurvey.OpenSurveyResult, error)
+}
+
+// SubmitSurveyResponseUseCase 以 Token 提交問卷回覆
+type SubmitSurveyResponseUseCase interface {
+	Execute(cmd appsurvey.SubmitSurveyResponseCommand) (*appsurvey.SubmitSurveyResponseResult, error)
+}
+
+// SurveyStatisticsUseCase 問卷統計
+type SurveyStatisticsUseCase interface {
+	Execute(query appsurvey.SurveyStatisticsQuery) (*appsurvey.SurveyStatisticsResult, error)
+}
+
+// SurveyNPSUseCase 問卷 NPS 分析
+type SurveyNPSUseCase interface {
+	Execute(query appsurvey.SurveyNPSQuery) (*appsurvey.SurveyNPSResult, error)
+}
+
+// TextAnswersUseCase 文字題答案列表
+type TextAnswersUseCase interface {
+	Execute(query appsurvey.ListTextAnswersQuery) (*appsurvey.ListTextAnswersResult, error)
+}
+
+// ExportSurveyResponsesUseCase 匯出問卷回覆（CSV）
+type ExportSurveyResponsesUseCase interface {
+	Execute(query appsurvey.ExportSurveyResponsesQuery, w io.Writer) error
+}
+
+// CreateCampaignUseCase 建立群發活動
+type CreateCampaignUseCase interface {
+	Execute(cmd appbroadcast.CreateCampaignCommand) (*appbroadcast.CampaignResult, error)
+}
+
+// CancelCampaignUseCase 取消群發活動
+type CancelCampaignUseCase interface {
+	Execute(cmd appbroadcast.CancelCampaignCommand) (*appbroadcast.CampaignResult, error)
+}
+
+// CampaignReportUseCase 群發活動投遞報告
+type CampaignReportUseCase interface {
+	Execute(campaignID string) (*appbroadcast.CampaignReportResult, error)
+}
+
+// UseCases 管理後台 API 依賴的 Use Case
+//
+// 欄位：
+// - ConversionRate: 目前設定的積分轉換率（唯讀顯示）
+type UseCases struct {
+	Login              LoginUseCase
+	Logout             LogoutUseCase
+	Authorize          AuthorizeUseCase
+	CreateAdminUser    CreateAdminUserUseCase
+	DisableAdminUser   DisableAdminUserUseCase
+	BeginTOTP          BeginTOTPEnrollmentUseCase
+	ConfirmTOTP        ConfirmTOTPEnrollmentUseCase
+	RegenerateRecovery RegenerateRecoveryCodesUseCase
+	MemberQuery        MemberQueryUseCase
+	SearchMembers      SearchMembersUseCase
+	UpdateProfile      UpdateMemberProfileUseCase
+	VerifyAge          VerifyMemberAgeUseCase
+	RebindPhone        RebindPhoneNumberUseCase
+	UnbindPhone        UnbindPhoneNumberUseCase
+	PhoneChanges       ListPhoneNumberChangesUseCase
+	MergeMembers       MergeMembersUseCase
+	MemberMerges       ListMemberMergesUseCase
+	MemberTier         MemberTierQueryUseCase
+	NotificationPrefs  UpdateNotificationPreferenceUseCase
+	BalanceQuery       BalanceQueryUseCase
+	FreezeAccount      FreezeAccountUseCase
+	UnfreezeAccount    UnfreezeAccountUseCase
+	AdjustPoints       AdjustPointsUseCase
+	ApproveAdjustment  ReviewPointsAdjustmentUseCase
+	RejectAdjustment   ReviewPointsAdjustmentUseCase
+	WithdrawAdjustment ReviewPointsAdjustmentUseCase
+	ListAdjustments    ListPointsAdjustmentsUseCase
+	CreateReward       CreateRewardUseCase
+	ListRewards        ListRewardsUseCase
+	RedeemReward       RedeemRewardUseCase
+	ClearFraudCase     ResolveFraudCaseUseCase
+	ConfirmFraudCase   ResolveFraudCaseUseCase
+	MatchIChefRecord   MatchIChefRecordUseCase
+	ListDiscrepancies  ListDiscrepanciesUseCase
+	ApproveDiscrepancy ResolveDiscrepancyUseCase
+	RejectDiscrepancy  ResolveDiscrepancyUseCase
+	CreateSurvey       CreateSurveyUseCase
+	ReviseSurvey       ReviseSurveyUseCase
+	ActivateSurvey     ActivateSurveyUseCase
+	DeactivateSurvey   DeactivateSurveyUseCase
+	ActiveSurvey       ActiveSurveyQueryUseCase
+	IssueSurveyToken   IssueSurveyTokenUseCase
+	OpenSurvey         OpenSurveyUseCase
+	SubmitSurvey       SubmitSurveyResponseUseCase
+	SurveyStatistics   SurveyStatisticsUseCase
+	SurveyNPS          SurveyNPSUseCase
+	TextAnswers        TextAnswersUseCase
+	ExportResponses    ExportSurveyResponsesUseCase
+	CreateCampaign     CreateCampaignUseCase
+	CancelCampaign     CancelCampaignUseCase
+	CampaignReport     CampaignReportUseCase
+	ConversionRate     points.ConversionRate
+}
+
+// ===========================
+// Router
+// ===========================
+
+// BasePath 管理後台 API 路徑前綴
+const BasePath = "/api/admin"
+
+// Router 管理後台 REST API（實作 http.Handler）
+//
+// 職責：
+// 1. 解析路徑 / 查詢參數 / JSON 本體並轉為 Use Case 的 Command / Query
+// 2. 將 Use Case 結果轉為 JSON 回應 DTO
+// 3. 將錯誤依 DomainError.Code 轉為一致的錯誤回應（見 errors.go）
+// 4. 除登入外，每個路由執行前先驗證 Bearer Token 並檢查角色權限（見 auth_handler.go）
+//
+// 路由：
+// - 認證：POST /auth/login、POST /auth/logout、GET /auth/me
+// - 兩步驟驗證：POST /auth/totp/enroll、POST /auth/totp/confirm、POST /auth/recovery-codes
+// - 後台帳號：POST /admin-users、POST /admin-users/{adminID}/disable
+// - 會員：GET /members?line_user_id=、GET /members/search、PATCH /members/{memberID}、GET /members/{memberID}/points、
+//   POST /members/{memberID}/points/freeze、POST /members/{memberID}/points/unfreeze、
+//   POST /members/{memberID}/phone/rebind|unbind、GET /members/{memberID}/phone/changes、
+//   POST /members/{memberID}/merge、GET /members/{memberID}/merges、GET /members/{memberID}/tier、
+//   POST /members/{memberID}/age-verification、PUT /members/{memberID}/notification-preference
+// - 積分調整：POST /members/{memberID}/points/adjustments（staff 以上可申請）、
+//   GET /points-adjustments、POST /points-adjustments/{adjustmentID}/withdraw（非 owner 僅限自己的申請）、
+//   POST /points-adjustments/{adjustmentID}/approve|reject（僅 owner）
+// - 兌換目錄：GET /rewards、POST /rewards、POST /members/{memberID}/rewards/{rewardID}/redeem（酒類獎勵需已驗證成年）
+// - 交易：POST /fraud-cases/{caseID}/clear|confirm、POST /ichef-records、GET /discrepancies、
+//   POST /discrepancies/{reviewID}/approve|reject
+// - 問卷：POST /surveys、PUT /surveys/{surveyID}、POST /surveys/{surveyID}/activate|deactivate、GET /surveys/active、
+//   GET /surveys/{surveyID}/statistics|nps|responses.csv、GET /surveys/{surveyID}/questions/{questionID}/text-answers、
+//   POST /transactions/{transactionID}/survey-token
+// - 會員填寫問卷（不需登入，以問卷 Token 驗證）：GET /survey-tokens/{token}、POST /survey-tokens/{token}/responses
+// - 積分轉換規則：GET /conversion-rule
+// - 群發：POST /broadcasts、POST /broadcasts/{campaignID}/cancel、GET /broadcasts/{campaignID}/report
+type Router struct {
+	useCases UseCases
+	mux      *http.ServeMux
+	now      func() time.Time
+}
+
+// NewRouter 創建管理後台 Router
+func NewRouter(useCases UseCases) *Router {
+	r := &Router{
+		useCases: useCases,
+		mux:      http.NewServeMux(),
+		now:      time.Now,
+	}
+	r.routes()
+	return r
+}
+
+// routes 註冊路由
+func (r *Router) routes() {
+	r.handlePublic("POST /auth/login", r.login)
+	r.handle("POST /auth/logout", "", r.logout)
+	r.handle("GET /auth/me", "", r.getPrincipal)
+	r.handle("POST /auth/totp/enroll", "", r.beginTOTPEnrollment)
+	r.handle("POST /auth/totp/confirm", "", r.confirmTOTPEnrollment)
+	r.handle("POST /auth/recovery-codes", "", r.regenerateRecoveryCodes)
+	r.handle("POST /admin-users", admin.PermissionManageAdmins, r.createAdminUser)
+	r.handle("POST /admin-users/{adminID}/disable", admin.PermissionManageAdmins, r.disableAdminUser)
+
+	r.handle("GET /members", admin.PermissionViewMembers, r.getMember)
+	r.handle("GET /members/search", admin.PermissionViewMembers, r.searchMembers)
+	r.handle("PATCH /members/{memberID}", admin.PermissionManageMembers, r.updateMemberProfile)
+	r.handle("GET /members/{memberID}/points", admin.PermissionViewMembers, r.getBalance)
+	r.handle("POST /members/{memberID}/points/freeze", admin.PermissionManageMembers, r.freezeAccount)
+	r.handle("POST /members/{memberID}/points/unfreeze", admin.PermissionManageMembers, r.unfreezeAccount)
+	r.handle("POST /members/{memberID}/phone/rebind", admin.PermissionManageMembers, r.rebindPhoneNumber)
+	r.handle("POST /members/{memberID}/phone/unbind", admin.PermissionManageMembers, r.unbindPhoneNumber)
+	r.handle("GET /members/{memberID}/phone/changes", admin.PermissionViewMembers, r.listPhoneNumberChanges)
+	r.handle("POST /members/{memberID}/merge", admin.PermissionAdjustPoints, r.mergeMembers)
+	r.handle("GET /members/{memberID}/merges", admin.PermissionViewMembers, r.listMemberMerges)
+	r.handle("GET /members/{memberID}/tier", admin.PermissionViewMembers, r.getMemberTier)
+	r.handle("POST /members/{memberID}/age-verification", admin.PermissionVerifyAge, r.verifyMemberAge)
+	r.handle("PUT /members/{memberID}/notification-preference", admin.PermissionManageMembers,
+		r.updateNotificationPreference)
+
+	r.handle("POST /members/{memberID}/points/adjustments", admin.PermissionRequestPointsAdjustment, r.adjustPoints)
+	r.handle("GET /points-adjustments", admin.PermissionRequestPointsAdjustment, r.listPointsAdjustments)
+	r.handle("POST /points-adjustments/{adjustmentID}/approve", admin.PermissionAdjustPoints,
+		r.reviewPointsAdjustment(r.useCases.ApproveAdjustment))
+	r.handle("POST /points-adjustments/{adjustmentID}/reject", admin.PermissionAdjustPoints,
+		r.reviewPointsAdjustment(r.useCases.RejectAdjustment))
+	r.handle("POST /points-adjustments/{adjustmentID}/withdraw", admin.PermissionRequestPointsAdjustment,
+		r.reviewPointsAdjustment(r.useCases.WithdrawAdjustment))
+
+	r.handle("GET /rewards", admin.PermissionViewRewards, r.listRewards)
+	r.handle("POST /rewards", admin.PermissionManageRewards, r.createReward)
+	r.handle("POST /members/{memberID}/rewards/{rewardID}/redeem", admin.PermissionRedeemRewards, r.redeemReward)
+
+	r.handle("POST /fraud-cases/{caseID}/clear", admin.PermissionReviewTransactions,
+		r.resolveFraudCase(r.useCases.ClearFraudCase))
+	r.handle("POST /fraud-cases/{caseID}/confirm", admin.PermissionReviewTransactions,
+		r.resolveFraudCase(r.useCases.ConfirmFraudCase))
+	r.handle("POST /ichef-records", admin.PermissionReviewTransactions, r.importIChefRecords)
+	r.handle("GET /discrepancies", admin.PermissionViewTransactions, r.listDiscrepancies)
+	r.handle("POST /discrepancies/{reviewID}/approve", admin.PermissionReviewTransactions,
+		r.resolveDiscrepancy(r.useCases.ApproveDiscrepancy))
+	r.handle("POST /discrepancies/{reviewID}/reject", admin.PermissionReviewTransactions,
+		r.resolveDiscrepancy(r.useCases.RejectDiscrepancy))
+
+	r.handle("POST /surveys", admin.PermissionManageSurveys, r.createSurvey)
+	r.handle("GET /surveys/active", admin.PermissionViewSurveys, r.getActiveSurvey)
+	r.handle("PUT /surveys/{surveyID}", admin.PermissionManageSurveys, r.reviseSurvey)
+	r.handle("POST /surveys/{surveyID}/activate", admin.PermissionManageSurveys, r.activateSurvey)
+	r.handle("POST /surveys/{surveyID}/deactivate", admin.PermissionManageSurveys, r.deactivateSurvey)
+	r.handle("GET /surveys/{surveyID}/statistics", admin.PermissionViewSurveys, r.getSurveyStatistics)
+	r.handle("GET /surveys/{surveyID}/nps", admin.PermissionViewSurveys, r.getSurveyNPS)
+	r.handle("GET /surveys/{surveyID}/responses.csv", admin.PermissionExportData, r.exportSurveyResponses)
+	r.handle("GET /surveys/{surveyID}/questions/{questionID}/text-answers", admin.PermissionViewSurveys,
+		r.listTextAnswers)
+	r.handle("POST /transactions/{transactionID}/survey-token", admin.PermissionManageSurveys, r.issueSurveyToken)
+	r.handlePublic("GET /survey-tokens/{token}", r.openSurvey)
+	r.handlePublic("POST /survey-tokens/{token}/responses", r.submitSurveyResponse)
+
+	r.handle("GET /conversion-rule", admin.PermissionViewConversionRules, r.getConversionRule)
+
+	r.handle("POST /broadcasts", admin.PermissionManageBroadcasts, r.createCampaign)
+	r.handle("POST /broadcasts/{campaignID}/cancel", admin.PermissionManageBroadcasts, r.cancelCampaign)
+	r.handle("GET /broadcasts/{campaignID}/report", admin.PermissionViewBroadcasts, r.getCampaignReport)
+
+	r.mux.HandleFunc(BasePath+"/", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusNotFound, ErrorResponse{Error: ErrorBody{
+			Code:    codeNotFound,
+			Message: "route not found",
+		}})
+	})
+}
+
+// handle 註冊 BasePath 下需登入的路由（pattern 格式："METHOD /path"）
+//
+// 參數：
+//   permission - 所需權限（空字串表示只需登入）
+func (r *Router) handle(pattern string, permission admin.Permission, handler http.HandlerFunc) {
+	r.handlePublic(pattern, r.authorize(permission, handler))
+}
+
+// handlePublic 註冊 BasePath 下不需登入的路由（登入、以問卷 Token 驗證的會員問卷）
+func (r *Router) handlePublic(pattern string, handler http.HandlerFunc) {
+	method, path, _ := strings.Cut(pattern, " ")
+	r.mux.HandleFunc(method+" "+BasePath+path, handler)
+}
+
+// ServeHTTP 分派請求
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mux.ServeHTTP(w, req)
+}
@@ -0,0 +1,1213 @@
+package adminapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	appadmin "github.com/jackyeh168/bar_crm/src/internal/application/admin"
+	appbroadcast "github.com/jackyeh168/bar_crm/src/internal/application/broadcast"
+	appexternal "github.com/jackyeh168/bar_crm/src/internal/application/external"
+	appmember "github.com/jackyeh168/bar_crm/src/internal/application/member"
+	appnotification "github.com/jackyeh168/bar_crm/src/internal/application/notification"
+	apppoints "github.com/jackyeh168/bar_crm/src/internal/application/points"
+	appsurvey "github.com/jackyeh168/bar_crm/src/internal/application/survey"
+	apptier "github.com/jackyeh168/bar_crm/src/internal/application/tier"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/admin"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/notification"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/survey"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/tier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// Admin API Router 測試
+// ===========================
+
+const (
+	testLineUserID = "U4af4980629a1b2c3d4e5f6a7b8c9d0e1"
+	testMemberID   = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+
+	// 各角色的測試 Token（見 StubAuthorize）
+	ownerToken   = "owner-token"
+	managerToken = "manager-token"
+	staffToken   = "staff-token"
+)
+
+// testSurveyTokenExpiry 測試問卷 Token 的到期時間（見 StubIssueSurveyToken）
+var testSurveyTokenExpiry = time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)
+
+// routerFixture 測試用 Router 環境
+type routerFixture struct {
+	members   *StubMemberQuery
+	freeze    *StubFreezeAccount
+	campaigns *StubCreateCampaign
+	export    *StubExportResponses
+	auth      *StubAuthorize
+	router    *Router
+	now       time.Time
+}
+
+func newRouterFixture(t *testing.T) *routerFixture {
+	rate, err := points.NewConversionRate(100)
+	require.NoError(t, err)
+
+	f := &routerFixture{
+		members:   &StubMemberQuery{members: make(map[string]*appmember.MemberResult)},
+		freeze:    &StubFreezeAccount{},
+		campaigns: &StubCreateCampaign{},
+		export:    &StubExportResponses{},
+		auth: &StubAuthorize{roles: map[string]admin.Role{
+			ownerToken:   admin.RoleOwner,
+			managerToken: admin.RoleManager,
+			staffToken:   admin.RoleStaff,
+		}},
+		now: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+	f.router = NewRouter(UseCases{
+		Authorize:       f.auth,
+		MemberQuery:     f.members,
+		FreezeAccount:   f.freeze,
+		CreateCampaign:  f.campaigns,
+		ExportResponses: f.export,
+		ConversionRate:  rate,
+	})
+	f.router.now = func() time.Time { return f.now }
+	return f
+}
+
+// do 以 owner 身分送出請求
+func (f *routerFixture) do(method, path string, body string) *httptest.ResponseRecorder {
+	return f.doAs(ownerToken, method, path, body)
+}
+
+// doAs 以指定 Token 送出請求（空字串表示不帶 Authorization）
+func (f *routerFixture) doAs(token, method, path string, body string) *httptest.ResponseRecorder {
+	var reader io.Reader
+	if body != "" {
+		reader = bytes.NewBufferString(body)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	f.router.ServeHTTP(rec, req)
+	return rec
+}
+
+// decodeError 解析錯誤回應
+func decodeError(t *testing.T, rec *httptest.ResponseRecorder) ErrorBody {
+	t.Helper()
+	var resp ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp.Error
+}
+
+// Test 1: 查詢會員（成功回傳 snake_case JSON；領域錯誤依代碼轉為 404 並附上下文）
+func TestRouter_GetMember(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+	f.members.members[testLineUserID] = &appmember.MemberResult{
+		MemberID:     testMemberID,
+		LineUserID:   testLineUserID,
+		DisplayName:  "王小明",
+		PhoneNumber:  "0912345678",
+		IsRegistered: true,
+		IsReachable:  true,
+		Email:        "wang@example.com",
+		Birthday:     "1990-05-20",
+		Version:      3,
+	}
+
+	// Act
+	found := f.do(http.MethodGet, "/api/admin/members?line_user_id="+testLineUserID, "")
+	missing := f.do(http.MethodGet, "/api/admin/members?line_user_id=U00000000000000000000000000000000", "")
+	noQuery := f.do(http.MethodGet, "/api/admin/members", "")
+
+	// Assert
+	require.Equal(t, http.StatusOK, found.Code)
+	assert.Equal(t, "application/json; charset=utf-8", found.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"member_id": "`+testMemberID+`",
+		"line_user_id": "`+testLineUserID+`",
+		"display_name": "王小明",
+		"phone_number": "0912345678",
+		"is_registered": true,
+		"is_reachable": true,
+		"email": "wang@example.com",
+		"note": "",
+		"birthday": "1990-05-20",
+		"is_verified_adult": false,
+		"version": 3
+	}`, found.Body.String())
+
+	require.Equal(t, http.StatusNotFound, missing.Code)
+	notFound := decodeError(t, missing)
+	assert.Equal(t, "MEMBER_NOT_FOUND", notFound.Code)
+	assert.Equal(t, "U00000000000000000000000000000000", notFound.Context["line_user_id"])
+
+	assert.Equal(t, http.StatusBadRequest, noQuery.Code)
+	assert.Equal(t, codeInvalidRequest, decodeError(t, noQuery).Code)
+}
+
+// Test 2: 凍結帳戶（路徑 + 本體轉為 Command；狀態衝突 409；未知欄位 400）
+func TestRouter_FreezeAccount(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+
+	// Act
+	ok := f.do(http.MethodPost, "/api/admin/members/"+testMemberID+"/points/freeze",
+		`{"reason":"疑似盜刷"}`)
+	f.freeze.err = points.ErrAccountFrozen
+	conflict := f.do(http.MethodPost, "/api/admin/members/"+testMemberID+"/points/freeze",
+		`{"reason":"疑似盜刷"}`)
+	unknownField := f.do(http.MethodPost, "/api/admin/members/"+testMemberID+"/points/freeze",
+		`{"reason":"疑似盜刷","operator":"owner@bar"}`)
+
+	// Assert
+	require.Equal(t, http.StatusOK, ok.Code)
+	require.Len(t, f.freeze.commands, 2)
+	assert.Equal(t, apppoints.FreezePointsAccountCommand{
+		MemberID:   testMemberID,
+		Reason:     "疑似盜刷",
+		OperatorID: "owner",
+	}, f.freeze.commands[0])
+	assert.JSONEq(t, `{"account_id":"acc-1","member_id":"`+testMemberID+`","frozen":true}`, ok.Body.String())
+
+	assert.Equal(t, http.StatusConflict, conflict.Code)
+	assert.Equal(t, "ACCOUNT_FROZEN", decodeError(t, conflict).Code)
+
+	assert.Equal(t, http.StatusBadRequest, unknownField.Code)
+	assert.Equal(t, codeInvalidRequest, decodeError(t, unknownField).Code)
+}
+
+// Test 3: 建立群發活動（未提供排程時間則立即發送；非預期錯誤回 500 且不洩漏內部訊息）
+func TestRouter_CreateCampaign(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+
+	// Act
+	created := f.do(http.MethodPost, "/api/admin/broadcasts",
+		`{"name":"春季回娘家","min_available_points":100,"inactive_days":30,"verified_adults_only":true,"message":"好久不見"}`)
+	f.campaigns.err = errors.New("database is locked")
+	failed := f.do(http.MethodPost, "/api/admin/broadcasts", `{"name":"x","message":"y"}`)
+
+	// Assert
+	require.Equal(t, http.StatusCreated, created.Code)
+	require.Len(t, f.campaigns.commands, 2)
+	cmd := f.campaigns.commands[0]
+	assert.True(t, cmd.ScheduledAt.IsZero())
+	assert.Equal(t, f.now, cmd.Now)
+	assert.Equal(t, 30, cmd.InactiveDays)
+	assert.True(t, cmd.VerifiedAdultsOnly)
+	assert.Equal(t, "owner", cmd.CreatedBy)
+	var campaign CampaignResponse
+	require.NoError(t, json.Unmarshal(created.Body.Bytes(), &campaign))
+	assert.Equal(t, "scheduled", campaign.Status)
+	assert.Equal(t, "春季回娘家", campaign.Name)
+	assert.True(t, campaign.VerifiedAdultsOnly)
+
+	require.Equal(t, http.StatusInternalServerError, failed.Code)
+	internal := decodeError(t, failed)
+	assert.Equal(t, codeInternalError, internal.Code)
+	assert.NotContains(t, internal.Message, "database")
+}
+
+// Test 4: 匯出 CSV 與查詢參數驗證；未知路由回 JSON 404
+func TestRouter_ExportAndRouting(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+
+	// Act
+	csv := f.do(http.MethodGet, "/api/admin/surveys/s-1/responses.csv?from=2025-01-01T00:00:00Z&to=2025-02-01T00:00:00Z", "")
+	badTime := f.do(http.MethodGet, "/api/admin/surveys/s-1/responses.csv?from=2025-01-01", "")
+	f.export.err = survey.ErrSurveyNotFound
+	missing := f.do(http.MethodGet, "/api/admin/surveys/s-2/responses.csv", "")
+	rule := f.do(http.MethodGet, "/api/admin/conversion-rule", "")
+	unknown := f.do(http.MethodGet, "/api/admin/unknown", "")
+
+	// Assert
+	require.Equal(t, http.StatusOK, csv.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", csv.Header().Get("Content-Type"))
+	assert.Equal(t, "回覆ID\n", csv.Body.String())
+	require.Len(t, f.export.queries, 2)
+	assert.Equal(t, "s-1", f.export.queries[0].SurveyID)
+	assert.Equal(t, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), f.export.queries[0].To)
+
+	assert.Equal(t, http.StatusBadRequest, badTime.Code)
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+	assert.Equal(t, "SURVEY_NOT_FOUND", decodeError(t, missing).Code)
+	assert.JSONEq(t, `{"amount_per_point":100}`, rule.Body.String())
+	assert.Equal(t, http.StatusNotFound, unknown.Code)
+	assert.Equal(t, codeNotFound, decodeError(t, unknown).Code)
+}
+
+// Test 5: 錯誤代碼映射
+func TestStatusForCode(t *testing.T) {
+	cases := map[string]int{
+		string(member.ErrCodeMemberNotFound):           http.StatusNotFound,
+		string(survey.ErrCodeNoActiveSurvey):           http.StatusNotFound,
+		string(member.ErrCodePhoneAlreadyBound):        http.StatusConflict,
+		string(survey.ErrCodeSurveyAlreadyActive):      http.StatusConflict,
+		string(points.ErrCodeInvariantViolation):       http.StatusInternalServerError,
+		string(points.ErrCodeInsufficientPoints):       http.StatusBadRequest,
+		string(member.ErrCodeInvalidPhoneNumberFormat): http.StatusBadRequest,
+		string(admin.ErrCodeSessionExpired):            http.StatusUnauthorized,
+		string(admin.ErrCodePermissionDenied):          http.StatusForbidden,
+		string(admin.ErrCodeAccountLocked):             http.StatusTooManyRequests,
+		string(admin.ErrCodeUsernameTaken):             http.StatusConflict,
+		string(points.ErrCodeAdjustmentNotPending):     http.StatusConflict,
+		string(points.ErrCodeAdjustmentSelfApproval):   http.StatusForbidden,
+	}
+	for code, status := range cases {
+		assert.Equal(t, status, statusForCode(code), code)
+	}
+}
+
+// Test 7: 授權（未帶 Token 401；角色不具權限 403 且不執行 Use Case；登入不需 Token）
+func TestRouter_Authorization(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+	f.members.members[testLineUserID] = &appmember.MemberResult{MemberID: testMemberID, LineUserID: testLineUserID}
+	login := &StubLogin{}
+	f.router = NewRouter(UseCases{
+		Login:         login,
+		Authorize:     f.auth,
+		MemberQuery:   f.members,
+		FreezeAccount: f.freeze,
+	})
+	f.router.now = func() time.Time { return f.now }
+	memberPath := "/api/admin/members?line_user_id=" + testLineUserID
+	freezePath := "/api/admin/members/" + testMemberID + "/points/freeze"
+
+	// Act
+	anonymous := f.doAs("", http.MethodGet, memberPath, "")
+	forged := f.doAs("forged", http.MethodGet, memberPath, "")
+	staffRead := f.doAs(staffToken, http.MethodGet, memberPath, "")
+	staffFreeze := f.doAs(staffToken, http.MethodPost, freezePath, `{"reason":"疑似盜刷"}`)
+	managerFreeze := f.doAs(managerToken, http.MethodPost, freezePath, `{"reason":"疑似盜刷"}`)
+	loggedIn := f.doAs("", http.MethodPost, "/api/admin/auth/login", `{"username":"owner","password":"correct horse battery"}`)
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, anonymous.Code)
+	assert.Equal(t, `Bearer realm="admin"`, anonymous.Header().Get("WWW-Authenticate"))
+	assert.Equal(t, "ADMIN_UNAUTHENTICATED", decodeError(t, anonymous).Code)
+	assert.Equal(t, http.StatusUnauthorized, forged.Code)
+
+	assert.Equal(t, http.StatusOK, staffRead.Code)
+	require.Equal(t, http.StatusForbidden, staffFreeze.Code)
+	denied := decodeError(t, staffFreeze)
+	assert.Equal(t, "ADMIN_PERMISSION_DENIED", denied.Code)
+	assert.Equal(t, "members:write", denied.Context["permission"])
+	assert.Equal(t, http.StatusOK, managerFreeze.Code)
+	require.Len(t, f.freeze.commands, 1)
+	assert.Equal(t, "manager", f.freeze.commands[0].OperatorID)
+
+	require.Equal(t, http.StatusOK, loggedIn.Code)
+	assert.Equal(t, "no-store", loggedIn.Header().Get("Cache-Control"))
+	assert.JSONEq(t, `{
+		"token": "owner-token",
+		"token_type": "Bearer",
+		"expires_at": "2025-03-02T00:00:00Z",
+		"admin": {"admin_id": "a-1", "username": "owner", "display_name": "王姐", "role": "owner",
+			"permissions": ["points:adjust"], "last_login_at": null, "disabled": false,
+			"two_factor_enabled": false, "remaining_recovery_codes": 0}
+	}`, loggedIn.Body.String())
+	assert.Equal(t, "owner", login.commands[0].Username)
+}
+
+// Test 8: 人工調整積分（立即入帳 201、待核准 202；staff 以上可申請但一律待核准、僅 owner 可核准；核准自己的申請 403）
+func TestRouter_AdjustPoints(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+	adjust := &StubAdjustPoints{}
+	approve := &StubReviewAdjustment{err: points.ErrAdjustmentSelfApproval}
+	f.router = NewRouter(UseCases{
+		Authorize:         f.auth,
+		AdjustPoints:      adjust,
+		ApproveAdjustment: approve,
+	})
+	f.router.now = func() time.Time { return f.now }
+	adjustPath := "/api/admin/members/" + testMemberID + "/points/adjustments"
+
+	// Act
+	applied := f.do(http.MethodPost, adjustPath, `{"delta":50,"reason":"goodwill","note":"生日招待"}`)
+	pending := f.do(http.MethodPost, adjustPath, `{"delta":-800,"reason":"fraud_reversal","note":"盜刷追回"}`)
+	managerAdjust := f.doAs(managerToken, http.MethodPost, adjustPath, `{"delta":50,"reason":"goodwill","note":"招待"}`)
+	staffAdjust := f.doAs(staffToken, http.MethodPost, adjustPath, `{"delta":30,"reason":"service_recovery","note":"上錯酒"}`)
+	managerApprove := f.doAs(managerToken, http.MethodPost, "/api/admin/points-adjustments/adj-2/approve", `{"note":"OK"}`)
+	selfApproval := f.do(http.MethodPost, "/api/admin/points-adjustments/adj-2/approve", `{"note":"OK"}`)
+
+	// Assert
+	require.Equal(t, http.StatusCreated, applied.Code)
+	assert.JSONEq(t, `{
+		"adjustment_id": "adj-1", "member_id": "`+testMemberID+`", "delta": 50, "reason": "goodwill",
+		"note": "生日招待", "requires_approval": false, "status": "applied", "requested_by": "owner",
+		"requested_at": "2025-03-01T12:00:00Z", "reviewed_at": null, "account_event_id": "evt-1",
+		"applied_at": "2025-03-01T12:00:00Z", "available_points": 150
+	}`, applied.Body.String())
+	assert.Equal(t, http.StatusAccepted, pending.Code)
+	require.Len(t, adjust.commands, 4)
+	assert.Equal(t, apppoints.AdjustPointsCommand{
+		MemberID:            testMemberID,
+		Delta:               -800,
+		Reason:              "fraud_reversal",
+		Note:                "盜刷追回",
+		RequestedBy:         "owner",
+		RequesterCanApprove: true,
+		Now:                 f.now,
+	}, adjust.commands[1])
+
+	assert.Equal(t, http.StatusAccepted, managerAdjust.Code)
+	assert.False(t, adjust.commands[2].RequesterCanApprove)
+	assert.Equal(t, http.StatusAccepted, staffAdjust.Code)
+	assert.Equal(t, "staff", adjust.commands[3].RequestedBy)
+	assert.False(t, adjust.commands[3].RequesterCanApprove)
+
+	require.Equal(t, http.StatusForbidden, managerApprove.Code)
+	assert.Equal(t, "points:adjust", decodeError(t, managerApprove).Context["permission"])
+	require.Len(t, approve.commands, 1, "manager approval should be rejected before reaching the use case")
+
+	require.Equal(t, http.StatusForbidden, selfApproval.Code)
+	assert.Equal(t, "POINTS_ADJUSTMENT_SELF_APPROVAL", decodeError(t, selfApproval).Code)
+	assert.Equal(t, "adj-2", approve.commands[0].AdjustmentID)
+	assert.Equal(t, "owner", approve.commands[0].Reviewer)
+}
+
+// Test 9: 會員搜尋（查詢參數轉為 Query；參數格式錯誤 400；游標錯誤依領域代碼 400）
+func TestRouter_SearchMembers(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+	search := &StubSearchMembers{}
+	f.router = NewRouter(UseCases{Authorize: f.auth, SearchMembers: search})
+	registeredAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	search.result = &appmember.SearchMembersResult{
+		Items: []appmember.MemberSummaryResult{{
+			MemberID: testMemberID, LineUserID: testLineUserID, DisplayName: "王小明", PhoneNumber: "0912345678",
+			IsRegistered: true, IsReachable: true, RegisteredAt: registeredAt, HasPointsAccount: true,
+			EarnedPoints: 130, UsedPoints: 10, AvailablePoints: 120,
+		}},
+		NextCursor: "next",
+	}
+
+	// Act
+	ok := f.doAs(staffToken, http.MethodGet, "/api/admin/members/search?phone_suffix=5678&name=%E5%B0%8F%E6%98%8E"+
+		"&registered_from=2025-01-01T00:00:00Z&reachable=true&min_points=100&sort=available_points&order=desc&limit=10&cursor=abc", "")
+	badBool := f.do(http.MethodGet, "/api/admin/members/search?reachable=maybe", "")
+	badOrder := f.do(http.MethodGet, "/api/admin/members/search?order=up", "")
+	search.err = member.ErrInvalidSearchCursor
+	badCursor := f.do(http.MethodGet, "/api/admin/members/search?cursor=zzz", "")
+
+	// Assert
+	require.Equal(t, http.StatusOK, ok.Code)
+	assert.JSONEq(t, `{
+		"items": [{
+			"member_id": "`+testMemberID+`", "line_user_id": "`+testLineUserID+`", "display_name": "王小明",
+			"phone_number": "0912345678", "is_registered": true, "is_reachable": true,
+			"registered_at": "2025-01-02T03:04:05Z", "has_points_account": true,
+			"earned_points": 130, "used_points": 10, "available_points": 120
+		}],
+		"next_cursor": "next"
+	}`, ok.Body.String())
+	require.Len(t, search.queries, 2)
+	query := search.queries[0]
+	assert.Equal(t, "5678", query.PhoneSuffix)
+	assert.Equal(t, "小明", query.Name)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), *query.RegisteredFrom)
+	assert.Nil(t, query.RegisteredTo)
+	assert.True(t, *query.Reachable)
+	assert.Equal(t, 100, *query.MinPoints)
+	assert.Nil(t, query.MaxPoints)
+	assert.Equal(t, "available_points", query.Sort)
+	assert.True(t, query.Descending)
+	assert.Equal(t, 10, query.Limit)
+	assert.Equal(t, "abc", query.Cursor)
+
+	assert.Equal(t, http.StatusBadRequest, badBool.Code)
+	assert.Equal(t, codeInvalidRequest, decodeError(t, badBool).Code)
+	assert.Equal(t, http.StatusBadRequest, badOrder.Code)
+	assert.Equal(t, http.StatusBadRequest, badCursor.Code)
+	assert.Equal(t, "INVALID_SEARCH_CURSOR", decodeError(t, badCursor).Code)
+}
+
+// Test 10: 更換 / 解除手機綁定（操作者為登入管理員；staff 403；版本衝突與重複號碼 409）
+func TestRouter_ChangePhoneNumber(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+	phone, unbind := &StubRebindPhoneNumber{}, &StubUnbindPhoneNumber{}
+	f.router = NewRouter(UseCases{Authorize: f.auth, RebindPhone: phone, UnbindPhone: unbind})
+	f.router.now = func() time.Time { return f.now }
+	path := "/api/admin/members/" + testMemberID + "/phone/"
+
+	// Act
+	rebound := f.doAs(managerToken, http.MethodPost, path+"rebind", `{"phone_number":"0987654321","reason":"會員換號"}`)
+	unbound := f.doAs(managerToken, http.MethodPost, path+"unbind", `{"reason":"門號停用"}`)
+	forbidden := f.doAs(staffToken, http.MethodPost, path+"unbind", `{"reason":"門號停用"}`)
+	phone.err = member.ErrMemberVersionConflict
+	conflict := f.do(http.MethodPost, path+"rebind", `{"phone_number":"0987654321","reason":"會員換號"}`)
+	phone.err = member.ErrPhoneNumberAlreadyBound
+	taken := f.do(http.MethodPost, path+"rebind", `{"phone_number":"0911111111","reason":"會員換號"}`)
+
+	// Assert
+	require.Equal(t, http.StatusOK, rebound.Code)
+	assert.JSONEq(t, `{
+		"change_id": "chg-1", "member_id": "`+testMemberID+`", "action": "rebind",
+		"previous_phone_number": "0912345678", "phone_number": "0987654321",
+		"reason": "會員換號", "operator_id": "manager", "changed_at": "2025-03-01T12:00:00Z"
+	}`, rebound.Body.String())
+	require.Len(t, phone.rebinds, 3)
+	assert.Equal(t, appmember.RebindPhoneNumberCommand{
+		MemberID:    testMemberID,
+		PhoneNumber: "0987654321",
+		Reason:      "會員換號",
+		OperatorID:  "manager",
+		Now:         f.now,
+	}, phone.rebinds[0])
+
+	require.Equal(t, http.StatusOK, unbound.Code)
+	require.Len(t, unbind.commands, 1)
+	assert.Equal(t, "門號停用", unbind.commands[0].Reason)
+	assert.Contains(t, unbound.Body.String(), `"action":"unbind"`)
+
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusConflict, conflict.Code)
+	assert.Equal(t, "MEMBER_VERSION_CONFLICT", decodeError(t, conflict).Code)
+	assert.Equal(t, http.StatusConflict, taken.Code)
+}
+
+// Test 11: 修改會員資料（未提供的欄位為 nil；版本衝突 409；staff 403）
+func TestRouter_UpdateMemberProfile(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+	update := &StubUpdateMemberProfile{}
+	f.router = NewRouter(UseCases{Authorize: f.auth, UpdateProfile: update})
+	f.router.now = func() time.Time { return f.now }
+	path := "/api/admin/members/" + testMemberID
+
+	// Act
+	ok := f.doAs(managerToken, http.MethodPatch, path, `{"note":"常客","email":"","birthday":"1990-05-20","version":3}`)
+	forbidden := f.doAs(staffToken, http.MethodPatch, path, `{"note":"常客","version":3}`)
+	update.err = member.ErrMemberVersionConflict
+	conflict := f.do(http.MethodPatch, path, `{"display_name":"小明","version":2}`)
+
+	// Assert
+	require.Equal(t, http.StatusOK, ok.Code)
+	require.Len(t, update.commands, 2)
+	cmd := update.commands[0]
+	assert.Equal(t, testMemberID, cmd.MemberID)
+	assert.Nil(t, cmd.DisplayName)
+	require.NotNil(t, cmd.Email)
+	assert.Empty(t, *cmd.Email)
+	assert.Equal(t, "常客", *cmd.Note)
+	assert.Equal(t, "1990-05-20", *cmd.Birthday)
+	assert.Equal(t, 3, cmd.Version)
+	assert.Equal(t, f.now, cmd.Now)
+	assert.Contains(t, ok.Body.String(), `"version":4`)
+
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusConflict, conflict.Code)
+	assert.Equal(t, "MEMBER_VERSION_CONFLICT", decodeError(t, conflict).Code)
+}
+
+// Test 12: 合併重複會員（路徑為保留會員；僅 owner；已被合併 409）
+func TestRouter_MergeMembers(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+	merge := &StubMergeMembers{}
+	f.router = NewRouter(UseCases{Authorize: f.auth, MergeMembers: merge})
+	f.router.now = func() time.Time { return f.now }
+	path := "/api/admin/members/" + testMemberID + "/merge"
+	body := `{"secondary_member_id":"member-2","reason":"重複註冊"}`
+
+	// Act
+	merged := f.do(http.MethodPost, path, body)
+	forbidden := f.doAs(managerToken, http.MethodPost, path, body)
+	merge.err = member.ErrMemberMerged
+	conflict := f.do(http.MethodPost, path, body)
+
+	// Assert
+	require.Equal(t, http.StatusOK, merged.Code)
+	assert.JSONEq(t, `{
+		"merge_id": "merge-1", "primary_member_id": "`+testMemberID+`", "secondary_member_id": "member-2",
+		"reason": "重複註冊", "operator_id": "owner", "released_phone_number": "0987654321",
+		"earned_points": 80, "used_points": 30, "transactions": 5, "survey_responses": 2, "adjustments": 0,
+		"merged_at": "2025-03-01T12:00:00Z"
+	}`, merged.Body.String())
+	require.Len(t, merge.commands, 2)
+	assert.Equal(t, appmember.MergeMembersCommand{
+		PrimaryMemberID:   testMemberID,
+		SecondaryMemberID: "member-2",
+		Reason:            "重複註冊",
+		OperatorID:        "owner",
+		Now:               f.now,
+	}, merge.commands[0])
+
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	assert.Equal(t, http.StatusConflict, conflict.Code)
+	assert.Equal(t, "MEMBER_MERGED", decodeError(t, conflict).Code)
+}
+
+// Test 13: 會員等級（會員等級上下文的領域錯誤同樣轉為錯誤回應）
+func TestRouter_GetMemberTier(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+	stub := &StubMemberTier{}
+	f.router = NewRouter(UseCases{Authorize: f.auth, MemberTier: stub})
+	path := "/api/admin/members/" + testMemberID + "/tier"
+
+	// Act
+	found := f.do(http.MethodGet, path, "")
+	stub.err = tier.ErrInvalidMemberID
+	invalid := f.do(http.MethodGet, path, "")
+
+	// Assert
+	require.Equal(t, http.StatusOK, found.Code)
+	assert.JSONEq(t, `{
+		"member_id": "`+testMemberID+`", "level": "gold", "multiplier": "1.5",
+		"perks": ["週末優先訂位"], "rolling_spend": 42000,
+		"next_level": "black", "spend_to_next_level": 38000
+	}`, found.Body.String())
+	assert.Equal(t, apptier.GetMemberTierQuery{MemberID: testMemberID}, stub.queries[0])
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	assert.Equal(t, "MEMBER_ID_INVALID", decodeError(t, invalid).Code)
+}
+
+// Test 14: 成年驗證（店員可操作，查驗人員為登入帳號；未滿 18 歲 400）
+func TestRouter_VerifyMemberAge(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+	verify := &StubVerifyMemberAge{}
+	f.router = NewRouter(UseCases{Authorize: f.auth, VerifyAge: verify})
+	f.router.now = func() time.Time { return f.now }
+	path := "/api/admin/members/" + testMemberID + "/age-verification"
+
+	// Act
+	ok := f.doAs(staffToken, http.MethodPost, path, `{"version":2}`)
+	verify.err = member.ErrMemberUnderage
+	underage := f.doAs(staffToken, http.MethodPost, path, `{"version":2}`)
+
+	// Assert
+	require.Equal(t, http.StatusOK, ok.Code)
+	require.Len(t, verify.commands, 2)
+	assert.Equal(t, appmember.VerifyMemberAgeCommand{
+		MemberID: testMemberID, OperatorID: "staff", Version: 2, Now: f.now,
+	}, verify.commands[0])
+	assert.Contains(t, ok.Body.String(), `"is_verified_adult":true`)
+	assert.Contains(t, ok.Body.String(), `"age_verified_by":"staff"`)
+	assert.Equal(t, http.StatusBadRequest, underage.Code)
+	assert.Equal(t, "MEMBER_UNDERAGE", decodeError(t, underage).Code)
+}
+
+// Test 15: iChef 匯入（逐筆匹配；領域錯誤記錄於該筆結果；店員不可匯入）
+func TestRouter_ImportIChefRecords(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+	match := &StubMatchIChefRecord{}
+	f.router = NewRouter(UseCases{Authorize: f.auth, MatchIChefRecord: match})
+	body := `{"records":[
+		{"invoice_number":"AB12345678","invoice_date":"2025-01-15T20:00:00+08:00","amount":1000},
+		{"invoice_number":"ZZ00000000","invoice_date":"2025-01-15T20:00:00+08:00","amount":500}
+	]}`
+
+	// Act
+	imported := f.doAs(managerToken, http.MethodPost, "/api/admin/ichef-records", body)
+	staff := f.doAs(staffToken, http.MethodPost, "/api/admin/ichef-records", body)
+	empty := f.doAs(managerToken, http.MethodPost, "/api/admin/ichef-records", `{"records":[]}`)
+	match.err = errors.New("database is locked")
+	failed := f.doAs(managerToken, http.MethodPost, "/api/admin/ichef-records", body)
+
+	// Assert
+	require.Equal(t, http.StatusOK, imported.Code)
+	assert.JSONEq(t, `{"items":[
+		{"invoice_number":"AB12345678","transaction_id":"tx-1","outcome":"matched",
+			"amount_delta":0,"date_offset_days":0,"points_earned":10},
+		{"invoice_number":"ZZ00000000","amount_delta":0,"date_offset_days":0,"points_earned":0,
+			"error":{"code":"TRANSACTION_NOT_FOUND","message":"交易不存在"}}
+	]}`, imported.Body.String())
+	require.Len(t, match.records, 3)
+	assert.Equal(t, 1000, match.records[0].Amount)
+	assert.True(t, match.records[0].InvoiceDate.Equal(time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)))
+
+	assert.Equal(t, http.StatusForbidden, staff.Code)
+	assert.Equal(t, http.StatusBadRequest, empty.Code)
+	assert.Equal(t, http.StatusInternalServerError, failed.Code)
+}
+
+// Test 16: 會員問卷（開啟與提交不需登入，以 Token 驗證；Token 已使用 409；店員不可簽發）
+func TestRouter_SurveyTokens(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+	submit := &StubSubmitSurvey{}
+	f.router = NewRouter(UseCases{
+		Authorize:        f.auth,
+		IssueSurveyToken: StubIssueSurveyToken{},
+		OpenSurvey:       StubOpenSurvey{},
+		SubmitSurvey:     submit,
+	})
+
+	// Act
+	issued := f.doAs(managerToken, http.MethodPost, "/api/admin/transactions/tx-1/survey-token", "")
+	staff := f.doAs(staffToken, http.MethodPost, "/api/admin/transactions/tx-1/survey-token", "")
+	opened := f.doAs("", http.MethodGet, "/api/admin/survey-tokens/tok-1", "")
+	submitted := f.doAs("", http.MethodPost, "/api/admin/survey-tokens/tok-1/responses",
+		`{"answers":[{"question_id":"q-1","rating":5},{"question_id":"q-2","text":"會"}]}`)
+	submit.err = survey.ErrSurveyTokenUsed
+	used := f.doAs("", http.MethodPost, "/api/admin/survey-tokens/tok-1/responses", `{"answers":[]}`)
+
+	// Assert
+	require.Equal(t, http.StatusCreated, issued.Code)
+	assert.JSONEq(t, `{"token":"tok-1","expires_at":"2025-03-31T12:00:00Z"}`, issued.Body.String())
+	assert.Equal(t, http.StatusForbidden, staff.Code)
+
+	require.Equal(t, http.StatusOK, opened.Code)
+	assert.JSONEq(t, `{"transaction_id":"tx-1","expires_at":"2025-03-31T12:00:00Z","survey":{
+		"survey_id":"s-1","title":"滿意度問卷","description":"","is_active":false,"questions":[]}}`, opened.Body.String())
+
+	require.Equal(t, http.StatusCreated, submitted.Code)
+	assert.JSONEq(t, `{"response_id":"r-1","transaction_id":"tx-1","bonus_status":"pending","points_awarded":0}`,
+		submitted.Body.String())
+	require.Len(t, submit.commands, 2)
+	assert.Equal(t, "tok-1", submit.commands[0].Token)
+	assert.Equal(t, []appsurvey.AnswerInput{{QuestionID: "q-1", Rating: 5}, {QuestionID: "q-2", Text: "會"}},
+		submit.commands[0].Answers)
+	assert.Equal(t, http.StatusConflict, used.Code)
+}
+
+// Test 17: 會員通知偏好（關閉積分通知；勿擾時段格式錯誤 400；店員不可修改）
+func TestRouter_UpdateNotificationPreference(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+	prefs := &StubNotificationPreference{}
+	f.router = NewRouter(UseCases{Authorize: f.auth, NotificationPrefs: prefs})
+	path := "/api/admin/members/" + testMemberID + "/notification-preference"
+
+	// Act
+	updated := f.doAs(managerToken, http.MethodPut, path, `{"points_activity_enabled":false}`)
+	staff := f.doAs(staffToken, http.MethodPut, path, `{"points_activity_enabled":false}`)
+	prefs.err = notification.ErrInvalidQuietHours
+	invalid := f.doAs(managerToken, http.MethodPut, path, `{"points_activity_enabled":true,"quiet_hours_start":"25:00"}`)
+
+	// Assert
+	require.Equal(t, http.StatusOK, updated.Code)
+	assert.JSONEq(t, `{"member_id":"`+testMemberID+`","points_activity_enabled":false,"quiet_hours":"off","locale":"zh-TW"}`,
+		updated.Body.String())
+	require.Len(t, prefs.commands, 2)
+	assert.Equal(t, testMemberID, prefs.commands[0].MemberID)
+	assert.False(t, prefs.commands[0].PointsActivityEnabled)
+	assert.Equal(t, http.StatusForbidden, staff.Code)
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+}
+
+// Test 18: 兌換目錄（店員可查詢與兌換、不可新增；酒類獎勵未驗證成年 400）
+func TestRouter_Rewards(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+	rewards := &StubRewards{}
+	f.router = NewRouter(UseCases{
+		Authorize: f.auth, CreateReward: rewards, ListRewards: StubListRewards{rewards}, RedeemReward: &StubRedeemReward{rewards: rewards},
+	})
+	f.router.now = func() time.Time { return f.now }
+	redeemPath := func(rewardID string) string {
+		return "/api/admin/members/" + testMemberID + "/rewards/" + rewardID + "/redeem"
+	}
+
+	// Act
+	created := f.doAs(managerToken, http.MethodPost, "/api/admin/rewards", `{"name":"生啤酒一杯","points_cost":120,"alcoholic":true}`)
+	staffCreate := f.doAs(staffToken, http.MethodPost, "/api/admin/rewards", `{"name":"薯條","points_cost":80}`)
+	list := f.doAs(staffToken, http.MethodGet, "/api/admin/rewards", "")
+	unverified := f.doAs(staffToken, http.MethodPost, redeemPath("reward-1"), "")
+	rewards.verifiedAdult = true
+	redeemed := f.doAs(staffToken, http.MethodPost, redeemPath("reward-1"), "")
+
+	// Assert
+	require.Equal(t, http.StatusCreated, created.Code)
+	assert.Contains(t, created.Body.String(), `"alcoholic":true`)
+	require.Len(t, rewards.commands, 1)
+	assert.Equal(t, apppoints.CreateRewardCommand{Name: "生啤酒一杯", PointsCost: 120, Alcoholic: true, Now: f.now},
+		rewards.commands[0])
+	assert.Equal(t, http.StatusForbidden, staffCreate.Code)
+	require.Equal(t, http.StatusOK, list.Code)
+	assert.Contains(t, list.Body.String(), `"reward_id":"reward-1"`)
+	assert.Equal(t, http.StatusBadRequest, unverified.Code)
+	assert.Equal(t, "AGE_VERIFICATION_REQUIRED", decodeError(t, unverified).Code)
+	require.Equal(t, http.StatusOK, redeemed.Code)
+	assert.JSONEq(t, `{"member_id":"`+testMemberID+`","reward_id":"reward-1","reward_name":"生啤酒一杯","points_cost":120,"available_points":80}`,
+		redeemed.Body.String())
+}
+
+// Test 19: 非 owner 只能查詢與撤回自己的積分調整申請；駁回仍僅限 owner
+func TestRouter_WithdrawAndListOwnAdjustments(t *testing.T) {
+	// Arrange
+	f := newRouterFixture(t)
+	list := &StubListAdjustments{}
+	withdraw := &StubReviewAdjustment{}
+	reject := &StubReviewAdjustment{}
+	f.router = NewRouter(UseCases{
+		Authorize:          f.auth,
+		ListAdjustments:    list,
+		RejectAdjustment:   reject,
+		WithdrawAdjustment: withdraw,
+	})
+	f.router.now = func() time.Time { return f.now }
+
+	// Act
+	ownerList := f.do(http.MethodGet, "/api/admin/points-adjustments?status=pending_approval", "")
+	staffList := f.doAs(staffToken, http.MethodGet, "/api/admin/points-adjustments?member_id="+testMemberID, "")
+	staffWithdraw := f.doAs(staffToken, http.MethodPost, "/api/admin/points-adjustments/adj-1/withdraw", `{"note":"輸入錯誤"}`)
+	staffReject := f.doAs(staffToken, http.MethodPost, "/api/admin/points-adjustments/adj-1/reject", `{}`)
+	withdraw.err = points.ErrAdjustmentNotRequester
+	othersWithdraw := f.doAs(managerToken, http.MethodPost, "/api/admin/points-adjustments/adj-1/withdraw", `{}`)
+
+	// Assert
+	require.Equal(t, http.StatusOK, ownerList.Code)
+	require.Equal(t, http.StatusOK, staffList.Code)
+	require.Len(t, list.queries, 2)
+	assert.Equal(t, apppoints.ListPointsAdjustmentsQuery{Status: "pending_approval"}, list.queries[0])
+	assert.Equal(t, apppoints.ListPointsAdjustmentsQuery{MemberID: testMemberID, RequestedBy: "staff"}, list.queries[1])
+
+	require.Equal(t, http.StatusOK, staffWithdraw.Code)
+	assert.Equal(t, apppoints.ReviewPointsAdjustmentCommand{
+		AdjustmentID: "adj-1", Reviewer: "staff", Note: "輸入錯誤", Now: f.now,
+	}, withdraw.commands[0])
+	assert.Equal(t, http.StatusForbidden, staffReject.Code)
+	assert.Empty(t, reject.commands)
+	require.Equal(t, http.StatusForbidden, othersWithdraw.Code)
+	assert.Equal(t, "POINTS_ADJUSTMENT_NOT_REQUESTER", decodeError(t, othersWithdraw).Code)
+}
+
+// ===========================
+// Stubs
+// ===========================
+
+// StubAuthorize 以 Token 對應角色（帳號即角色名稱），權限檢查使用領域角色表
+type StubAuthorize struct {
+	roles map[string]admin.Role
+}
+
+func (s *StubAuthorize) Execute(query appadmin.AuthorizeQuery) (*appadmin.PrincipalResult, error) {
+	role, ok := s.roles[query.Token]
+	if !ok {
+		return nil, admin.ErrUnauthenticated
+	}
+	if query.Permission != "" && !role.Can(query.Permission) {
+		return nil, admin.ErrPermissionDenied.WithContext("role", role.String(), "permission", query.Permission.String())
+	}
+	return &appadmin.PrincipalResult{AdminID: "a-" + role.String(), Username: role.String(), Role: role.String()}, nil
+}
+
+// StubLogin 記錄登入指令並發放固定 Token
+type StubLogin struct {
+	commands []appadmin.LoginCommand
+}
+
+func (s *StubLogin) Execute(cmd appadmin.LoginCommand) (*appadmin.LoginResult, error) {
+	s.commands = append(s.commands, cmd)
+	return &appadmin.LoginResult{
+		Token:     ownerToken,
+		ExpiresAt: cmd.Now.Add(12 * time.Hour),
+		Admin: &appadmin.AdminUserResult{
+			AdminID:     "a-1",
+			Username:    cmd.Username,
+			DisplayName: "王姐",
+			Role:        "owner",
+			Permissions: []string{"points:adjust"},
+		},
+	}, nil
+}
+
+// StubMemberQuery 以 LINE UserID 查詢會員
+type StubMemberQuery struct {
+	members map[string]*appmember.MemberResult
+}
+
+func (s *StubMemberQuery) Execute(query appmember.GetMemberByLineUserIDQuery) (*appmember.MemberResult, error) {
+	m, ok := s.members[query.LineUserID]
+	if !ok {
+		return nil, member.ErrMemberNotFound.WithContext("line_user_id", query.LineUserID)
+	}
+	return m, nil
+}
+
+// StubSearchMembers 記錄搜尋查詢
+type StubSearchMembers struct {
+	queries []appmember.SearchMembersQuery
+	result  *appmember.SearchMembersResult
+	err     error
+}
+
+func (s *StubSearchMembers) Execute(query appmember.SearchMembersQuery) (*appmember.SearchMembersResult, error) {
+	s.queries = append(s.queries, query)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.result, nil
+}
+
+// StubRebindPhoneNumber 記錄更換手機號碼指令
+type StubRebindPhoneNumber struct {
+	rebinds []appmember.RebindPhoneNumberCommand
+	err     error
+}
+
+func (s *StubRebindPhoneNumber) Execute(cmd appmember.RebindPhoneNumberCommand) (*appmember.PhoneNumberChangeResult, error) {
+	s.rebinds = append(s.rebinds, cmd)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &appmember.PhoneNumberChangeResult{
+		ChangeID:            "chg-1",
+		MemberID:            cmd.MemberID,
+		Action:              "rebind",
+		PreviousPhoneNumber: "0912345678",
+		PhoneNumber:         cmd.PhoneNumber,
+		Reason:              cmd.Reason,
+		OperatorID:          cmd.OperatorID,
+		ChangedAt:           cmd.Now,
+	}, nil
+}
+
+// StubUnbindPhoneNumber 記錄解除手機綁定指令
+type StubUnbindPhoneNumber struct {
+	commands []appmember.UnbindPhoneNumberCommand
+}
+
+func (s *StubUnbindPhoneNumber) Execute(cmd appmember.UnbindPhoneNumberCommand) (*appmember.PhoneNumberChangeResult, error) {
+	s.commands = append(s.commands, cmd)
+	return &appmember.PhoneNumberChangeResult{
+		ChangeID:            "chg-2",
+		MemberID:            cmd.MemberID,
+		Action:              "unbind",
+		PreviousPhoneNumber: "0912345678",
+		Reason:              cmd.Reason,
+		OperatorID:          cmd.OperatorID,
+		ChangedAt:           cmd.Now,
+	}, nil
+}
+
+// StubUpdateMemberProfile 記錄修改會員資料指令
+type StubUpdateMemberProfile struct {
+	commands []appmember.UpdateMemberProfileCommand
+	err      error
+}
+
+func (s *StubUpdateMemberProfile) Execute(cmd appmember.UpdateMemberProfileCommand) (*appmember.MemberResult, error) {
+	s.commands = append(s.commands, cmd)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &appmember.MemberResult{MemberID: cmd.MemberID, DisplayName: "王小明", Note: *cmd.Note, Version: cmd.Version + 1}, nil
+}
+
+// StubVerifyMemberAge 記錄成年驗證指令
+type StubVerifyMemberAge struct {
+	commands []appmember.VerifyMemberAgeCommand
+	err      error
+}
+
+func (s *StubVerifyMemberAge) Execute(cmd appmember.VerifyMemberAgeCommand) (*appmember.MemberResult, error) {
+	s.commands = append(s.commands, cmd)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &appmember.MemberResult{
+		MemberID:              cmd.MemberID,
+		DisplayName:           "王小明",
+		Version:               cmd.Version + 1,
+		IsVerifiedAdult:       true,
+		AgeVerifiedBy:         cmd.OperatorID,
+		AgeVerificationMethod: "staff_id_check",
+		AgeVerifiedAt:         &cmd.Now,
+	}, nil
+}
+
+// StubMergeMembers 記錄合併會員指令
+type StubMergeMembers struct {
+	commands []appmember.MergeMembersCommand
+	err      error
+}
+
+func (s *StubMergeMembers) Execute(cmd appmember.MergeMembersCommand) (*appmember.MemberMergeResult, error) {
+	s.commands = append(s.commands, cmd)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &appmember.MemberMergeResult{
+		MergeID:             "merge-1",
+		PrimaryMemberID:     cmd.PrimaryMemberID,
+		SecondaryMemberID:   cmd.SecondaryMemberID,
+		Reason:              cmd.Reason,
+		OperatorID:          cmd.OperatorID,
+		ReleasedPhoneNumber: "0987654321",
+		EarnedPoints:        80,
+		UsedPoints:          30,
+		Transactions:        5,
+		SurveyResponses:     2,
+		MergedAt:            cmd.Now,
+	}, nil
+}
+
+// StubMemberTier 記錄查詢並返回金卡會員
+type StubMemberTier struct {
+	queries []apptier.GetMemberTierQuery
+	err     error
+}
+
+func (s *StubMemberTier) Execute(query apptier.GetMemberTierQuery) (*apptier.MemberTierResult, error) {
+	s.queries = append(s.queries, query)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &apptier.MemberTierResult{
+		MemberID:         query.MemberID,
+		Level:            "gold",
+		Multiplier:       "1.5",
+		Perks:            []string{"週末優先訂位"},
+		RollingSpend:     42000,
+		NextLevel:        "black",
+		SpendToNextLevel: 38000,
+	}, nil
+}
+
+// StubFreezeAccount 記錄凍結指令
+type StubFreezeAccount struct {
+	commands []apppoints.FreezePointsAccountCommand
+	err      error
+}
+
+func (s *StubFreezeAccount) Execute(cmd apppoints.FreezePointsAccountCommand) (*apppoints.FreezePointsAccountResult, error) {
+	s.commands = append(s.commands, cmd)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &apppoints.FreezePointsAccountResult{AccountID: "acc-1", MemberID: cmd.MemberID, Frozen: true}, nil
+}
+
+// StubAdjustPoints 記錄調整指令（超過 500 點視為待核准）
+type StubAdjustPoints struct {
+	commands []apppoints.AdjustPointsCommand
+}
+
+func (s *StubAdjustPoints) Execute(cmd apppoints.AdjustPointsCommand) (*apppoints.PointsAdjustmentResult, error) {
+	s.commands = append(s.commands, cmd)
+	result := &apppoints.PointsAdjustmentResult{
+		AdjustmentID:    fmt.Sprintf("adj-%d", len(s.commands)),
+		MemberID:        cmd.MemberID,
+		Delta:           cmd.Delta,
+		Reason:          cmd.Reason,
+		Note:            cmd.Note,
+		Status:          "applied",
+		RequestedBy:     cmd.RequestedBy,
+		RequestedAt:     cmd.Now,
+		AvailablePoints: 100 + cmd.Delta,
+	}
+	if cmd.Delta > 500 || cmd.Delta < -500 || !cmd.RequesterCanApprove {
+		result.RequiresApproval = true
+		result.Status = "pending_approval"
+		result.AvailablePoints = 100
+		return result, nil
+	}
+	result.AccountEventID = fmt.Sprintf("evt-%d", len(s.commands))
+	result.AppliedAt = &cmd.Now
+	return result, nil
+}
+
+// StubReviewAdjustment 記錄核准 / 駁回指令
+type StubReviewAdjustment struct {
+	commands []apppoints.ReviewPointsAdjustmentCommand
+	err      error
+}
+
+func (s *StubReviewAdjustment) Execute(cmd apppoints.ReviewPointsAdjustmentCommand) (*apppoints.PointsAdjustmentResult, error) {
+	s.commands = append(s.commands, cmd)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &apppoints.PointsAdjustmentResult{AdjustmentID: cmd.AdjustmentID, Status: "applied", ReviewedBy: cmd.Reviewer}, nil
+}
+
+// StubListAdjustments 記錄查詢條件，返回空清單
+type StubListAdjustments struct {
+	queries []apppoints.ListPointsAdjustmentsQuery
+}
+
+func (s *StubListAdjustments) Execute(query apppoints.ListPointsAdjustmentsQuery) ([]*apppoints.PointsAdjustmentResult, error) {
+	s.queries = append(s.queries, query)
+	return nil, nil
+}
+
+// StubCreateCampaign 記錄建立指令
+type StubCreateCampaign struct {
+	commands []appbroadcast.CreateCampaignCommand
+	err      error
+}
+
+func (s *StubCreateCampaign) Execute(cmd appbroadcast.CreateCampaignCommand) (*appbroadcast.CampaignResult, error) {
+	s.commands = append(s.commands, cmd)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &appbroadcast.CampaignResult{
+		CampaignID:         "c-1",
+		Name:               cmd.Name,
+		MinAvailablePoints: cmd.MinAvailablePoints,
+		InactiveDays:       cmd.InactiveDays,
+		VerifiedAdultsOnly: cmd.VerifiedAdultsOnly,
+		Message:            cmd.Message,
+		Status:             "scheduled",
+		ScheduledAt:        cmd.Now,
+		CreatedBy:          cmd.CreatedBy,
+	}, nil
+}
+
+// StubExportResponses 寫入固定 CSV 標題
+type StubExportResponses struct {
+	queries []appsurvey.ExportSurveyResponsesQuery
+	err     error
+}
+
+func (s *StubExportResponses) Execute(query appsurvey.ExportSurveyResponsesQuery, w io.Writer) error {
+	s.queries = append(s.queries, query)
+	if _, err := io.WriteString(w, "回覆ID\n"); err != nil {
+		return err
+	}
+	return s.err
+}
+
+// StubMatchIChefRecord AB12345678 匹配成功，其他發票號碼找不到交易
+type StubMatchIChefRecord struct {
+	records []appexternal.IChefRecord
+	err     error
+}
+
+func (s *StubMatchIChefRecord) Execute(record appexternal.IChefRecord) (*appexternal.MatchIChefRecordResult, error) {
+	s.records = append(s.records, record)
+	if s.err != nil {
+		return nil, s.err
+	}
+	if record.InvoiceNumber != "AB12345678" {
+		return nil, invoice.ErrTransactionNotFound
+	}
+	return &appexternal.MatchIChefRecordResult{TransactionID: "tx-1", Outcome: "matched", PointsEarned: 10}, nil
+}
+
+// StubIssueSurveyToken 固定簽發 tok-1
+type StubIssueSurveyToken struct{}
+
+func (StubIssueSurveyToken) Execute(cmd appsurvey.IssueSurveyTokenCommand) (*appsurvey.IssueSurveyTokenResult, error) {
+	return &appsurvey.IssueSurveyTokenResult{Token: "tok-1", ExpiresAt: testSurveyTokenExpiry}, nil
+}
+
+// StubOpenSurvey 固定開啟交易 tx-1 的問卷
+type StubOpenSurvey struct{}
+
+func (StubOpenSurvey) Execute(query appsurvey.OpenSurveyQuery) (*appsurvey.OpenSurveyResult, error) {
+	return &appsurvey.OpenSurveyResult{
+		Survey:        &appsurvey.SurveyResult{SurveyID: "s-1", Title: "滿意度問卷"},
+		TransactionID: "tx-1",
+		ExpiresAt:     testSurveyTokenExpiry,
+	}, nil
+}
+
+// StubSubmitSurvey 記錄提交指令，獎勵待交易驗證後發放
+type StubSubmitSurvey struct {
+	commands []appsurvey.SubmitSurveyResponseCommand
+	err      error
+}
+
+func (s *StubSubmitSurvey) Execute(cmd appsurvey.SubmitSurveyResponseCommand) (*appsurvey.SubmitSurveyResponseResult, error) {
+	s.commands = append(s.commands, cmd)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &appsurvey.SubmitSurveyResponseResult{ResponseID: "r-1", TransactionID: "tx-1", BonusStatus: "pending"}, nil
+}
+
+// StubNotificationPreference 記錄更新指令，固定回傳關閉積分通知
+type StubNotificationPreference struct {
+	commands []appnotification.UpdateNotificationPreferenceCommand
+	err      error
+}
+
+func (s *StubNotificationPreference) Execute(cmd appnotification.UpdateNotificationPreferenceCommand) (*appnotification.NotificationPreferenceResult, error) {
+	s.commands = append(s.commands, cmd)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &appnotification.NotificationPreferenceResult{
+		MemberID:              cmd.MemberID,
+		PointsActivityEnabled: cmd.PointsActivityEnabled,
+		QuietHours:            "off",
+		Locale:                "zh-TW",
+	}, nil
+}
+
+// StubRewards 記錄新增獎勵指令，目錄以 reward-N 編號
+type StubRewards struct {
+	commands      []apppoints.CreateRewardCommand
+	verifiedAdult bool
+}
+
+func (s *StubRewards) Execute(cmd apppoints.CreateRewardCommand) (*apppoints.RewardResult, error) {
+	s.commands = append(s.commands, cmd)
+	return s.reward(len(s.commands) - 1), nil
+}
+
+func (s *StubRewards) reward(i int) *apppoints.RewardResult {
+	cmd := s.commands[i]
+	return &apppoints.RewardResult{
+		RewardID:   fmt.Sprintf("reward-%d", i+1),
+		Name:       cmd.Name,
+		PointsCost: cmd.PointsCost,
+		Alcoholic:  cmd.Alcoholic,
+		CreatedAt:  cmd.Now,
+	}
+}
+
+// StubListRewards 以 StubRewards 的新增紀錄作為目錄
+type StubListRewards struct {
+	rewards *StubRewards
+}
+
+func (s StubListRewards) Execute() ([]*apppoints.RewardResult, error) {
+	results := make([]*apppoints.RewardResult, 0, len(s.rewards.commands))
+	for i := range s.rewards.commands {
+		results = append(results, s.rewards.reward(i))
+	}
+	return results, nil
+}
+
+// StubRedeemReward 兌換 StubRewards 中的獎勵（可用積分固定 200）；酒類獎勵需 verifiedAdult
+type StubRedeemReward struct {
+	rewards *StubRewards
+}
+
+func (s *StubRedeemReward) Execute(cmd apppoints.RedeemRewardCommand) (*apppoints.RedeemRewardResult, error) {
+	for i := range s.rewards.commands {
+		reward := s.rewards.reward(i)
+		if reward.RewardID != cmd.RewardID {
+			continue
+		}
+		if reward.Alcoholic && !s.rewards.verifiedAdult {
+			return nil, member.ErrAgeVerificationRequired
+		}
+		return &apppoints.RedeemRewardResult{
+			MemberID:        cmd.MemberID,
+			RewardID:        reward.RewardID,
+			RewardName:      reward.Name,
+			PointsCost:      reward.PointsCost,
+			AvailablePoints: 200 - reward.PointsCost,
+		}, nil
+	}
+	return nil, points.ErrRewardNotFound
+}
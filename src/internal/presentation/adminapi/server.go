@@ -0,0 +1,103 @@
+package adminapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+)
+
+// ===========================
+// HTTP Server
+// ===========================
+
+// ServerConfig HTTP 伺服器設定
+//
+// 欄位：
+// - Addr: 監聽位址（例如 :8080）
+// - ShutdownTimeout: 收到停止訊號後等待進行中請求完成的時間上限
+type ServerConfig struct {
+	Addr              string
+	ReadHeaderTimeout time.Duration
+	ReadTimeout       time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	ShutdownTimeout   time.Duration
+}
+
+// DefaultServerConfig 預設設定
+func DefaultServerConfig(addr string) ServerConfig {
+	return ServerConfig{
+		Addr:              addr,
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       15 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       60 * time.Second,
+		ShutdownTimeout:   15 * time.Second,
+	}
+}
+
+// Server HTTP 伺服器（優雅關閉）
+//
+// 關閉流程：
+// 1. ctx 取消（例如收到 SIGTERM）→ 停止接受新連線
+// 2. 等待進行中的請求完成（最多 ShutdownTimeout）
+// 3. 逾時則強制關閉剩餘連線
+type Server struct {
+	httpServer      *http.Server
+	shutdownTimeout time.Duration
+}
+
+// NewServer 創建 HTTP 伺服器
+func NewServer(config ServerConfig, handler http.Handler) *Server {
+	return &Server{
+		httpServer: &http.Server{
+			Addr:              config.Addr,
+			Handler:           handler,
+			ReadHeaderTimeout: config.ReadHeaderTimeout,
+			ReadTimeout:       config.ReadTimeout,
+			WriteTimeout:      config.WriteTimeout,
+			IdleTimeout:       config.IdleTimeout,
+		},
+		shutdownTimeout: config.ShutdownTimeout,
+	}
+}
+
+// Run 監聽並服務，直到 ctx 取消後優雅關閉
+func (s *Server) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.httpServer.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.httpServer.Addr, err)
+	}
+	return s.Serve(ctx, listener)
+}
+
+// Serve 以指定 listener 服務（測試可使用隨機埠）
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Printf("[INFO] HTTP server listening on %s", listener.Addr())
+		serveErr <- s.httpServer.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("http server stopped: %w", err)
+	case <-ctx.Done():
+	}
+
+	log.Printf("[INFO] Shutting down HTTP server (timeout %s)", s.shutdownTimeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+	if err := s.httpServer.Shutdown(shutdownCtx); err != nil {
+		_ = s.httpServer.Close()
+		return fmt.Errorf("failed to shut down http server: %w", err)
+	}
+	if err := <-serveErr; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("http server stopped: %w", err)
+	}
+	return nil
+}
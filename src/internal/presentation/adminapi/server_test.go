@@ -0,0 +1,70 @@
+package adminapi
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// Server 測試
+// ===========================
+
+// Test 6: 停止訊號後等待進行中的請求完成，並拒絕新連線
+func TestServer_GracefulShutdown(t *testing.T) {
+	// Arrange
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		<-release
+		_, _ = io.WriteString(w, "done")
+	})
+	config := DefaultServerConfig("127.0.0.1:0")
+	config.ShutdownTimeout = 5 * time.Second
+	server := NewServer(config, handler)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	url := "http://" + listener.Addr().String()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- server.Serve(ctx, listener) }()
+
+	type result struct {
+		body string
+		err  error
+	}
+	inFlight := make(chan result, 1)
+	go func() {
+		resp, err := http.Get(url)
+		if err != nil {
+			inFlight <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		inFlight <- result{body: string(body), err: err}
+	}()
+	<-started
+
+	// Act
+	cancel()
+	time.Sleep(50 * time.Millisecond) // 等待伺服器關閉 listener
+	_, newErr := http.Get(url)
+	close(release)
+
+	// Assert
+	r := <-inFlight
+	require.NoError(t, r.err)
+	assert.Equal(t, "done", r.body)
+	assert.Error(t, newErr)
+	assert.NoError(t, <-serveErr)
+}
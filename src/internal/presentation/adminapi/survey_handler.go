@@ -0,0 +1,463 @@
+package adminapi
+
+import (
+	"bytes"
+	"net/http"
+	"time"
+
+	appsurvey "github.com/jackyeh168/bar_crm/src/internal/application/survey"
+)
+
+// ===========================
+// 問卷
+// ===========================
+
+// SurveyRequest 建立 / 修訂問卷請求
+type SurveyRequest struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Questions   []QuestionRequest `json:"questions"`
+}
+
+// QuestionRequest 題目（修訂時填入既有 question_id 以保留答案對應）
+type QuestionRequest struct {
+	QuestionID string             `json:"question_id"`
+	Text       string             `json:"text"`
+	Type       string             `json:"type"`
+	Options    []string           `json:"options"`
+	Required   bool               `json:"required"`
+	Conditions []ConditionRequest `json:"conditions"`
+}
+
+// ConditionRequest 顯示條件（引用同批新增的題目時使用 source_position，從 1 開始）
+type ConditionRequest struct {
+	SourceQuestionID string `json:"source_question_id"`
+	SourcePosition   int    `json:"source_position"`
+	Operator         string `json:"operator"`
+	Value            string `json:"value"`
+}
+
+// SurveyResponse 問卷
+type SurveyResponse struct {
+	SurveyID    string             `json:"survey_id"`
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	IsActive    bool               `json:"is_active"`
+	Questions   []QuestionResponse `json:"questions"`
+}
+
+// QuestionResponse 題目
+type QuestionResponse struct {
+	QuestionID string              `json:"question_id"`
+	Text       string              `json:"text"`
+	Type       string              `json:"type"`
+	Options    []string            `json:"options"`
+	Required   bool                `json:"required"`
+	Conditions []ConditionResponse `json:"conditions"`
+}
+
+// ConditionResponse 顯示條件
+type ConditionResponse struct {
+	SourceQuestionID string `json:"source_question_id"`
+	Operator         string `json:"operator"`
+	Value            string `json:"value"`
+}
+
+// ActivateSurveyResponse 啟用結果（deactivated_survey_id：被自動停用的問卷）
+type ActivateSurveyResponse struct {
+	SurveyID            string `json:"survey_id"`
+	DeactivatedSurveyID string `json:"deactivated_survey_id,omitempty"`
+}
+
+// SurveyStatisticsResponse 問卷統計
+type SurveyStatisticsResponse struct {
+	SurveyID      string                       `json:"survey_id"`
+	Title         string                       `json:"title"`
+	From          time.Time                    `json:"from"`
+	To            time.Time                    `json:"to"`
+	ResponseCount int                          `json:"response_count"`
+	Questions     []QuestionStatisticsResponse `json:"questions"`
+}
+
+// QuestionStatisticsResponse 單題統計（rating_distribution 鍵為評分 1-5）
+type QuestionStatisticsResponse struct {
+	QuestionID         string                `json:"question_id"`
+	Text               string                `json:"text"`
+	Type               string                `json:"type"`
+	AnswerCount        int                   `json:"answer_count"`
+	RatingDistribution map[int]int           `json:"rating_distribution,omitempty"`
+	RatingAverage      float64               `json:"rating_average"`
+	ChoiceCounts       []ChoiceCountResponse `json:"choice_counts,omitempty"`
+	LatestTextAnswers  []TextAnswerResponse  `json:"latest_text_answers,omitempty"`
+}
+
+// ChoiceCountResponse 選項計數
+type ChoiceCountResponse struct {
+	Option string `json:"option"`
+	Count  int    `json:"count"`
+}
+
+// TextAnswerResponse 文字答案
+type TextAnswerResponse struct {
+	ResponseID  string    `json:"response_id"`
+	Text        string    `json:"text"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+// TextAnswerListResponse 文字答案列表
+type TextAnswerListResponse struct {
+	Total   int                  `json:"total"`
+	Answers []TextAnswerResponse `json:"answers"`
+}
+
+// SurveyNPSResponse NPS 分析
+type SurveyNPSResponse struct {
+	Overall    NPSResponse         `json:"overall"`
+	Trend      []NPSWindowResponse `json:"trend"`
+	ByWeekday  []BreakdownResponse `json:"by_weekday"`
+	ByTimeSlot []BreakdownResponse `json:"by_time_slot"`
+}
+
+// NPSResponse NPS 分數與分布
+type NPSResponse struct {
+	Score      float64 `json:"score"`
+	Promoters  int     `json:"promoters"`
+	Passives   int     `json:"passives"`
+	Detractors int     `json:"detractors"`
+	Total      int     `json:"total"`
+}
+
+// NPSWindowResponse 時間窗 NPS
+type NPSWindowResponse struct {
+	Start time.Time   `json:"start"`
+	End   time.Time   `json:"end"`
+	NPS   NPSResponse `json:"nps"`
+}
+
+// BreakdownResponse 分群統計（星期 / 時段）
+type BreakdownResponse struct {
+	Key           string      `json:"key"`
+	Count         int         `json:"count"`
+	AverageRating float64     `json:"average_rating"`
+	NPS           NPSResponse `json:"nps"`
+}
+
+// createSurvey POST /surveys
+func (r *Router) createSurvey(w http.ResponseWriter, req *http.Request) {
+	var body SurveyRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.CreateSurvey.Execute(appsurvey.CreateSurveyCommand{
+		Title:       body.Title,
+		Description: body.Description,
+		Questions:   toQuestionInputs(body.Questions),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toSurveyResponse(result))
+}
+
+// reviseSurvey PUT /surveys/{surveyID}
+func (r *Router) reviseSurvey(w http.ResponseWriter, req *http.Request) {
+	var body SurveyRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.ReviseSurvey.Execute(appsurvey.ReviseSurveyCommand{
+		SurveyID:    req.PathValue("surveyID"),
+		Title:       body.Title,
+		Description: body.Description,
+		Questions:   toQuestionInputs(body.Questions),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toSurveyResponse(result))
+}
+
+// activateSurvey POST /surveys/{surveyID}/activate
+func (r *Router) activateSurvey(w http.ResponseWriter, req *http.Request) {
+	result, err := r.useCases.ActivateSurvey.Execute(appsurvey.ActivateSurveyCommand{SurveyID: req.PathValue("surveyID")})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, ActivateSurveyResponse{
+		SurveyID:            result.SurveyID,
+		DeactivatedSurveyID: result.DeactivatedSurveyID,
+	})
+}
+
+// deactivateSurvey POST /surveys/{surveyID}/deactivate
+func (r *Router) deactivateSurvey(w http.ResponseWriter, req *http.Request) {
+	if err := r.useCases.DeactivateSurvey.Execute(appsurvey.ActivateSurveyCommand{SurveyID: req.PathValue("surveyID")}); err != nil {
+		writeError(w, req, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getActiveSurvey GET /surveys/active
+func (r *Router) getActiveSurvey(w http.ResponseWriter, req *http.Request) {
+	result, err := r.useCases.ActiveSurvey.Execute()
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toSurveyResponse(result))
+}
+
+// getSurveyStatistics GET /surveys/{surveyID}/statistics?from=&to=
+func (r *Router) getSurveyStatistics(w http.ResponseWriter, req *http.Request) {
+	from, to, err := queryPeriod(req)
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.SurveyStatistics.Execute(appsurvey.SurveyStatisticsQuery{
+		SurveyID: req.PathValue("surveyID"),
+		From:     from,
+		To:       to,
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	questions := make([]QuestionStatisticsResponse, 0, len(result.Questions))
+	for _, q := range result.Questions {
+		choices := make([]ChoiceCountResponse, 0, len(q.ChoiceCounts))
+		for _, c := range q.ChoiceCounts {
+			choices = append(choices, ChoiceCountResponse{Option: c.Option, Count: c.Count})
+		}
+		questions = append(questions, QuestionStatisticsResponse{
+			QuestionID:         q.QuestionID,
+			Text:               q.Text,
+			Type:               q.Type,
+			AnswerCount:        q.AnswerCount,
+			RatingDistribution: q.RatingDistribution,
+			RatingAverage:      q.RatingAverage,
+			ChoiceCounts:       choices,
+			LatestTextAnswers:  toTextAnswerResponses(q.LatestTextAnswers),
+		})
+	}
+	writeJSON(w, http.StatusOK, SurveyStatisticsResponse{
+		SurveyID:      result.SurveyID,
+		Title:         result.Title,
+		From:          result.From,
+		To:            result.To,
+		ResponseCount: result.ResponseCount,
+		Questions:     questions,
+	})
+}
+
+// getSurveyNPS GET /surveys/{surveyID}/nps?question_id=&from=&to=&window=
+func (r *Router) getSurveyNPS(w http.ResponseWriter, req *http.Request) {
+	from, to, err := queryPeriod(req)
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	window, err := queryDuration(req, "window")
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.SurveyNPS.Execute(appsurvey.SurveyNPSQuery{
+		SurveyID:   req.PathValue("surveyID"),
+		QuestionID: req.URL.Query().Get("question_id"),
+		From:       from,
+		To:         to,
+		Window:     window,
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	trend := make([]NPSWindowResponse, 0, len(result.Trend))
+	for _, t := range result.Trend {
+		trend = append(trend, NPSWindowResponse{Start: t.Start, End: t.End, NPS: toNPSResponse(t.NPS)})
+	}
+	writeJSON(w, http.StatusOK, SurveyNPSResponse{
+		Overall:    toNPSResponse(result.Overall),
+		Trend:      trend,
+		ByWeekday:  toBreakdownResponses(result.ByWeekday),
+		ByTimeSlot: toBreakdownResponses(result.ByTimeSlot),
+	})
+}
+
+// listTextAnswers GET /surveys/{surveyID}/questions/{questionID}/text-answers?from=&to=&limit=&offset=
+func (r *Router) listTextAnswers(w http.ResponseWriter, req *http.Request) {
+	from, to, err := queryPeriod(req)
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	limit, err := queryInt(req, "limit")
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	offset, err := queryInt(req, "offset")
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	result, err := r.useCases.TextAnswers.Execute(appsurvey.ListTextAnswersQuery{
+		SurveyID:   req.PathValue("surveyID"),
+		QuestionID: req.PathValue("questionID"),
+		From:       from,
+		To:         to,
+		Limit:      limit,
+		Offset:     offset,
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, TextAnswerListResponse{
+		Total:   result.Total,
+		Answers: toTextAnswerResponses(result.Answers),
+	})
+}
+
+// exportSurveyResponses GET /surveys/{surveyID}/responses.csv?from=&to=
+//
+// 設計考量：先寫入緩衝區，匯出失敗時仍可回應錯誤（避免回傳不完整的 CSV）
+func (r *Router) exportSurveyResponses(w http.ResponseWriter, req *http.Request) {
+	from, to, err := queryPeriod(req)
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	var buf bytes.Buffer
+	surveyID := req.PathValue("surveyID")
+	err = r.useCases.ExportResponses.Execute(appsurvey.ExportSurveyResponsesQuery{
+		SurveyID: surveyID,
+		From:     from,
+		To:       to,
+	}, &buf)
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="survey-`+surveyID+`.csv"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = buf.WriteTo(w)
+}
+
+// queryPeriod 解析統計區間（from / to，RFC 3339）
+func queryPeriod(req *http.Request) (time.Time, time.Time, error) {
+	from, err := queryTime(req, "from")
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	to, err := queryTime(req, "to")
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return from, to, nil
+}
+
+func toQuestionInputs(questions []QuestionRequest) []appsurvey.QuestionInput {
+	inputs := make([]appsurvey.QuestionInput, 0, len(questions))
+	for _, q := range questions {
+		conditions := make([]appsurvey.ConditionInput, 0, len(q.Conditions))
+		for _, c := range q.Conditions {
+			conditions = append(conditions, appsurvey.ConditionInput{
+				SourceQuestionID: c.SourceQuestionID,
+				SourcePosition:   c.SourcePosition,
+				Operator:         c.Operator,
+				Value:            c.Value,
+			})
+		}
+		inputs = append(inputs, appsurvey.QuestionInput{
+			QuestionID: q.QuestionID,
+			Text:       q.Text,
+			Type:       q.Type,
+			Options:    q.Options,
+			Required:   q.Required,
+			Conditions: conditions,
+		})
+	}
+	return inputs
+}
+
+func toSurveyResponse(s *appsurvey.SurveyResult) SurveyResponse {
+	questions := make([]QuestionResponse, 0, len(s.Questions))
+	for _, q := range s.Questions {
+		conditions := make([]ConditionResponse, 0, len(q.Conditions))
+		for _, c := range q.Conditions {
+			conditions = append(conditions, ConditionResponse{
+				SourceQuestionID: c.SourceQuestionID,
+				Operator:         c.Operator,
+				Value:            c.Value,
+			})
+		}
+		questions = append(questions, QuestionResponse{
+			QuestionID: q.QuestionID,
+			Text:       q.Text,
+			Type:       q.Type,
+			Options:    q.Options,
+			Required:   q.Required,
+			Conditions: conditions,
+		})
+	}
+	return SurveyResponse{
+		SurveyID:    s.SurveyID,
+		Title:       s.Title,
+		Description: s.Description,
+		IsActive:    s.IsActive,
+		Questions:   questions,
+	}
+}
+
+func toTextAnswerResponses(answers []appsurvey.TextAnswerResult) []TextAnswerResponse {
+	responses := make([]TextAnswerResponse, 0, len(answers))
+	for _, a := range answers {
+		responses = append(responses, TextAnswerResponse{
+			ResponseID:  a.ResponseID,
+			Text:        a.Text,
+			SubmittedAt: a.SubmittedAt,
+		})
+	}
+	return responses
+}
+
+func toNPSResponse(nps appsurvey.NPSResult) NPSResponse {
+	return NPSResponse{
+		Score:      nps.Score,
+		Promoters:  nps.Promoters,
+		Passives:   nps.Passives,
+		Detractors: nps.Detractors,
+		Total:      nps.Total,
+	}
+}
+
+func toBreakdownResponses(breakdowns []appsurvey.BreakdownResult) []BreakdownResponse {
+	responses := make([]BreakdownResponse, 0, len(breakdowns))
+	for _, b := range breakdowns {
+		responses = append(responses, BreakdownResponse{
+			Key:           b.Key,
+			Count:         b.Count,
+			AverageRating: b.AverageRating,
+			NPS:           toNPSResponse(b.NPS),
+		})
+	}
+	return responses
+}
@@ -0,0 +1,102 @@
+package adminapi
+
+import (
+	"net/http"
+	"time"
+
+	appsurvey "github.com/jackyeh168/bar_crm/src/internal/application/survey"
+)
+
+// ===========================
+// 問卷 Token（會員填寫問卷）
+// ===========================
+
+// SurveyTokenResponse 簽發的問卷 Token
+type SurveyTokenResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// SurveyFormResponse 以 Token 開啟的問卷（Token 綁定簽發當下啟用中的問卷）
+type SurveyFormResponse struct {
+	TransactionID string         `json:"transaction_id"`
+	ExpiresAt     time.Time      `json:"expires_at"`
+	Survey        SurveyResponse `json:"survey"`
+}
+
+// SubmitSurveyResponseRequest 提交問卷回覆請求
+type SubmitSurveyResponseRequest struct {
+	Answers []AnswerRequest `json:"answers"`
+}
+
+// AnswerRequest 單題答案（text：文字題答案或選擇題選項；rating：評分題 1-5）
+type AnswerRequest struct {
+	QuestionID string `json:"question_id"`
+	Text       string `json:"text"`
+	Rating     int    `json:"rating"`
+}
+
+// SubmitSurveyResponseResponse 提交結果（bonus_status：awarded / pending）
+type SubmitSurveyResponseResponse struct {
+	ResponseID    string `json:"response_id"`
+	TransactionID string `json:"transaction_id"`
+	BonusStatus   string `json:"bonus_status"`
+	PointsAwarded int    `json:"points_awarded"`
+}
+
+// issueSurveyToken POST /transactions/{transactionID}/survey-token
+//
+// 使用場景：會員遺失問卷連結時，由管理員重新簽發
+func (r *Router) issueSurveyToken(w http.ResponseWriter, req *http.Request) {
+	result, err := r.useCases.IssueSurveyToken.Execute(appsurvey.IssueSurveyTokenCommand{
+		TransactionID: req.PathValue("transactionID"),
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, SurveyTokenResponse{Token: result.Token, ExpiresAt: result.ExpiresAt})
+}
+
+// openSurvey GET /survey-tokens/{token}（不需登入，以 Token 驗證）
+func (r *Router) openSurvey(w http.ResponseWriter, req *http.Request) {
+	result, err := r.useCases.OpenSurvey.Execute(appsurvey.OpenSurveyQuery{Token: req.PathValue("token")})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, SurveyFormResponse{
+		TransactionID: result.TransactionID,
+		ExpiresAt:     result.ExpiresAt,
+		Survey:        toSurveyResponse(result.Survey),
+	})
+}
+
+// submitSurveyResponse POST /survey-tokens/{token}/responses（不需登入，以 Token 驗證）
+func (r *Router) submitSurveyResponse(w http.ResponseWriter, req *http.Request) {
+	var body SubmitSurveyResponseRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	answers := make([]appsurvey.AnswerInput, 0, len(body.Answers))
+	for _, a := range body.Answers {
+		answers = append(answers, appsurvey.AnswerInput{QuestionID: a.QuestionID, Text: a.Text, Rating: a.Rating})
+	}
+
+	result, err := r.useCases.SubmitSurvey.Execute(appsurvey.SubmitSurveyResponseCommand{
+		Token:   req.PathValue("token"),
+		Answers: answers,
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, SubmitSurveyResponseResponse{
+		ResponseID:    result.ResponseID,
+		TransactionID: result.TransactionID,
+		BonusStatus:   result.BonusStatus,
+		PointsAwarded: result.PointsAwarded,
+	})
+}
@@ -0,0 +1,250 @@
+package adminapi
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	appexternal "github.com/jackyeh168/bar_crm/src/internal/application/external"
+	appfraud "github.com/jackyeh168/bar_crm/src/internal/application/fraud"
+)
+
+// ===========================
+// 交易（詐騙調查 / iChef 差異審核）
+// ===========================
+
+// ResolveFraudCaseRequest 處理調查案件請求（處理者為目前登入的管理員）
+//
+// 欄位：
+// - FreezeAccount: 確認詐騙時是否凍結積分帳戶（僅 confirm 使用）
+type ResolveFraudCaseRequest struct {
+	Note          string `json:"note"`
+	FreezeAccount bool   `json:"freeze_account"`
+}
+
+// ResolveFraudCaseResponse 調查案件處理結果
+type ResolveFraudCaseResponse struct {
+	CaseID            string `json:"case_id"`
+	Status            string `json:"status"`
+	TransactionStatus string `json:"transaction_status"`
+	AccountFrozen     bool   `json:"account_frozen"`
+}
+
+// maxIChefRecordsPerImport 單次匯入的 iChef 記錄上限
+const maxIChefRecordsPerImport = 1000
+
+// IChefRecordRequest iChef 匯出的單筆發票
+type IChefRecordRequest struct {
+	InvoiceNumber string    `json:"invoice_number"`
+	InvoiceDate   time.Time `json:"invoice_date"`
+	Amount        int       `json:"amount"`
+}
+
+// ImportIChefRecordsRequest 匯入 iChef 記錄請求
+type ImportIChefRecordsRequest struct {
+	Records []IChefRecordRequest `json:"records"`
+}
+
+// IChefRecordResultResponse 單筆 iChef 記錄的匹配結果
+//
+// 欄位：
+// - Outcome: matched / near_miss / mismatch；無法匹配（例如沒有會員掃描過此發票）時為空並附 Error
+// - ReviewID: near_miss 時開立（或已存在）的審核案件 ID
+type IChefRecordResultResponse struct {
+	InvoiceNumber  string     `json:"invoice_number"`
+	TransactionID  string     `json:"transaction_id,omitempty"`
+	Outcome        string     `json:"outcome,omitempty"`
+	AmountDelta    int        `json:"amount_delta"`
+	DateOffsetDays int        `json:"date_offset_days"`
+	ReviewID       string     `json:"review_id,omitempty"`
+	PointsEarned   int        `json:"points_earned"`
+	Error          *ErrorBody `json:"error,omitempty"`
+}
+
+// ImportIChefRecordsResponse 匯入結果（順序與請求相同）
+type ImportIChefRecordsResponse struct {
+	Items []IChefRecordResultResponse `json:"items"`
+}
+
+// DiscrepancyResponse 差異審核案件
+type DiscrepancyResponse struct {
+	ReviewID       string    `json:"review_id"`
+	TransactionID  string    `json:"transaction_id"`
+	InvoiceNumber  string    `json:"invoice_number"`
+	PosAmount      int       `json:"pos_amount"`
+	PosInvoiceDate time.Time `json:"pos_invoice_date"`
+	ScannedAmount  int       `json:"scanned_amount"`
+	ScannedDate    time.Time `json:"scanned_date"`
+	AmountDelta    int       `json:"amount_delta"`
+	DateOffsetDays int       `json:"date_offset_days"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// DiscrepancyListResponse 待審核案件列表
+type DiscrepancyListResponse struct {
+	Items []DiscrepancyResponse `json:"items"`
+}
+
+// ResolveDiscrepancyRequest 處理差異審核案件請求（處理者為目前登入的管理員）
+type ResolveDiscrepancyRequest struct {
+	Note string `json:"note"`
+}
+
+// ResolveDiscrepancyResponse 差異審核處理結果
+type ResolveDiscrepancyResponse struct {
+	ReviewID          string    `json:"review_id"`
+	TransactionID     string    `json:"transaction_id"`
+	Status            string    `json:"status"`
+	TransactionStatus string    `json:"transaction_status"`
+	ResolvedBy        string    `json:"resolved_by"`
+	ResolvedAt        time.Time `json:"resolved_at"`
+	PointsEarned      int       `json:"points_earned"`
+}
+
+// resolveFraudCase POST /fraud-cases/{caseID}/clear|confirm
+func (r *Router) resolveFraudCase(useCase ResolveFraudCaseUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var body ResolveFraudCaseRequest
+		if err := decodeJSON(w, req, &body); err != nil {
+			writeBadRequest(w, err.Error())
+			return
+		}
+
+		result, err := useCase.Execute(appfraud.ResolveFraudCaseCommand{
+			CaseID:        req.PathValue("caseID"),
+			ResolvedBy:    operatorID(req),
+			Note:          body.Note,
+			FreezeAccount: body.FreezeAccount,
+		})
+		if err != nil {
+			writeError(w, req, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, ResolveFraudCaseResponse{
+			CaseID:            result.CaseID,
+			Status:            result.Status,
+			TransactionStatus: result.TransactionStatus,
+			AccountFrozen:     result.AccountFrozen,
+		})
+	}
+}
+
+// importIChefRecords POST /ichef-records
+//
+// 逐筆匹配（每筆各自一個事務）：
+// - 領域錯誤（發票號碼無效、沒有會員掃描過、交易已驗證）記錄於該筆結果，不影響其他記錄
+// - 非預期錯誤中止匯入並回應 500（已處理的記錄維持已提交的結果，重新匯入不重複入帳）
+func (r *Router) importIChefRecords(w http.ResponseWriter, req *http.Request) {
+	var body ImportIChefRecordsRequest
+	if err := decodeJSON(w, req, &body); err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	if len(body.Records) == 0 {
+		writeBadRequest(w, "records is required")
+		return
+	}
+	if len(body.Records) > maxIChefRecordsPerImport {
+		writeBadRequest(w, fmt.Sprintf("at most %d records per import", maxIChefRecordsPerImport))
+		return
+	}
+
+	items := make([]IChefRecordResultResponse, 0, len(body.Records))
+	for _, record := range body.Records {
+		item := IChefRecordResultResponse{InvoiceNumber: record.InvoiceNumber}
+		result, err := r.useCases.MatchIChefRecord.Execute(appexternal.IChefRecord{
+			InvoiceNumber: record.InvoiceNumber,
+			InvoiceDate:   record.InvoiceDate,
+			Amount:        record.Amount,
+		})
+		if err != nil {
+			errBody, ok := domainErrorBody(err)
+			if !ok {
+				writeError(w, req, err)
+				return
+			}
+			item.Error = &errBody
+			items = append(items, item)
+			continue
+		}
+
+		item.TransactionID = result.TransactionID
+		item.Outcome = result.Outcome
+		item.AmountDelta = result.AmountDelta
+		item.DateOffsetDays = result.DateOffsetDays
+		item.ReviewID = result.ReviewID
+		item.PointsEarned = result.PointsEarned
+		items = append(items, item)
+	}
+	writeJSON(w, http.StatusOK, ImportIChefRecordsResponse{Items: items})
+}
+
+// listDiscrepancies GET /discrepancies?limit=&offset=
+func (r *Router) listDiscrepancies(w http.ResponseWriter, req *http.Request) {
+	limit, err := queryInt(req, "limit")
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	offset, err := queryInt(req, "offset")
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	reviews, err := r.useCases.ListDiscrepancies.Execute(appexternal.ListPendingDiscrepanciesQuery{
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		writeError(w, req, err)
+		return
+	}
+
+	items := make([]DiscrepancyResponse, 0, len(reviews))
+	for _, review := range reviews {
+		items = append(items, DiscrepancyResponse{
+			ReviewID:       review.ReviewID,
+			TransactionID:  review.TransactionID,
+			InvoiceNumber:  review.InvoiceNumber,
+			PosAmount:      review.PosAmount,
+			PosInvoiceDate: review.PosInvoiceDate,
+			ScannedAmount:  review.ScannedAmount,
+			ScannedDate:    review.ScannedDate,
+			AmountDelta:    review.AmountDelta,
+			DateOffsetDays: review.DateOffsetDays,
+			CreatedAt:      review.CreatedAt,
+		})
+	}
+	writeJSON(w, http.StatusOK, DiscrepancyListResponse{Items: items})
+}
+
+// resolveDiscrepancy POST /discrepancies/{reviewID}/approve|reject
+func (r *Router) resolveDiscrepancy(useCase ResolveDiscrepancyUseCase) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var body ResolveDiscrepancyRequest
+		if err := decodeJSON(w, req, &body); err != nil {
+			writeBadRequest(w, err.Error())
+			return
+		}
+
+		result, err := useCase.Execute(appexternal.ResolveDiscrepancyCommand{
+			ReviewID:   req.PathValue("reviewID"),
+			ResolvedBy: operatorID(req),
+			Note:       body.Note,
+		})
+		if err != nil {
+			writeError(w, req, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, ResolveDiscrepancyResponse{
+			ReviewID:          result.ReviewID,
+			TransactionID:     result.TransactionID,
+			Status:            result.Status,
+			TransactionStatus: result.TransactionStatus,
+			ResolvedBy:        result.ResolvedBy,
+			ResolvedAt:        result.ResolvedAt,
+			PointsEarned:      result.PointsEarned,
+		})
+	}
+}
@@ -0,0 +1,427 @@
+package linebot
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ===========================
+// LINE Messaging API 客戶端
+// ===========================
+
+// API 限制（https://developers.line.biz/en/reference/messaging-api/）
+const (
+	// MaxMessagesPerRequest 單次 reply / push / multicast 最多 5 則訊息
+	MaxMessagesPerRequest = 5
+
+	// MaxMulticastRecipients 單次 multicast 最多 500 位收件者
+	MaxMulticastRecipients = 500
+
+	// ReplyTokenTTL replyToken 有效時間（收到 Webhook 後需在 1 分鐘內回覆）
+	ReplyTokenTTL = time.Minute
+)
+
+// 預設值
+const (
+	DefaultBaseURL        = "https://api.line.me"
+	DefaultDataBaseURL    = "https://api-data.line.me"
+	defaultMaxRetries     = 3
+	defaultInitialBackoff = 500 * time.Millisecond
+	defaultMaxBackoff     = 10 * time.Second
+	defaultRequestTimeout = 10 * time.Second
+)
+
+// 錯誤
+var (
+	// ErrReplyTokenExpired replyToken 已逾時（不送出請求）
+	ErrReplyTokenExpired = errors.New("reply token expired")
+
+	// ErrInvalidMessageCount 訊息數量須為 1 ~ 5 則
+	ErrInvalidMessageCount = errors.New("message count must be between 1 and 5")
+)
+
+// APIError LINE API 返回的錯誤
+//
+// 欄位：
+// - StatusCode: HTTP 狀態碼
+// - Message / Details: LINE 錯誤回應內容
+type APIError struct {
+	StatusCode int
+	Message    string
+	Details    []string
+}
+
+// Error 實現 error 介面
+func (e *APIError) Error() string {
+	if len(e.Details) == 0 {
+		return fmt.Sprintf("LINE API error (status %d): %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("LINE API error (status %d): %s %v", e.StatusCode, e.Message, e.Details)
+}
+
+// IsRetryable 是否可重試（429 / 5xx）
+func (e *APIError) IsRetryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// ReplyToken Webhook 事件的 replyToken
+//
+// 欄位：
+// - IssuedAt: Webhook 事件時間（用於判斷是否逾時；零值視為未知，不檢查）
+type ReplyToken struct {
+	Value    string
+	IssuedAt time.Time
+}
+
+// IsExpiredAt 檢查 replyToken 在指定時間是否已逾時
+func (t ReplyToken) IsExpiredAt(now time.Time) bool {
+	if t.IssuedAt.IsZero() {
+		return false
+	}
+	return now.Sub(t.IssuedAt) >= ReplyTokenTTL
+}
+
+// ClientConfig 客戶端設定
+//
+// 欄位：
+// - ChannelAccessToken: Channel access token（必填）
+// - BaseURL: API 網址（預設 https://api.line.me；測試時指向本機 fake server）
+// - DataBaseURL: 內容下載網址（預設 https://api-data.line.me）
+// - HTTPClient: 預設 10 秒逾時
+// - MaxRetries: 429 / 5xx / 網路錯誤的最大重試次數（預設 3；負數表示不重試）
+// - InitialBackoff / MaxBackoff: 指數退避的初始與上限等待時間（Retry-After 亦受上限限制）
+type ClientConfig struct {
+	ChannelAccessToken string
+	BaseURL            string
+	DataBaseURL        string
+	HTTPClient         *http.Client
+	MaxRetries         int
+	InitialBackoff     time.Duration
+	MaxBackoff         time.Duration
+}
+
+// Client LINE Messaging API 客戶端
+//
+// 設計原則：
+// - 實作 Replier 與 ProfileProvider，供 EventRouter 使用
+// - 實作 MessageContentFetcher，供發票照片處理下載圖片
+// - 實作 notification.MessagePusher，供積分通知推播使用
+// - push / multicast 帶 X-Line-Retry-Key，重試不會重複發送（409 視為已送達）
+// - reply 無法帶 Retry-Key：replyToken 逾時即停止重試
+type Client struct {
+	accessToken    string
+	baseURL        string
+	dataBaseURL    string
+	httpClient     *http.Client
+	maxRetries     int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	now            func() time.Time
+	sleep          func(time.Duration)
+}
+
+// NewClient 創建 LINE Messaging API 客戶端
+func NewClient(config ClientConfig) *Client {
+	c := &Client{
+		accessToken:    config.ChannelAccessToken,
+		baseURL:        config.BaseURL,
+		dataBaseURL:    config.DataBaseURL,
+		httpClient:     config.HTTPClient,
+		maxRetries:     config.MaxRetries,
+		initialBackoff: config.InitialBackoff,
+		maxBackoff:     config.MaxBackoff,
+		now:            time.Now,
+		sleep:          time.Sleep,
+	}
+	if c.baseURL == "" {
+		c.baseURL = DefaultBaseURL
+	}
+	if c.dataBaseURL == "" {
+		c.dataBaseURL = DefaultDataBaseURL
+	}
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{Timeout: defaultRequestTimeout}
+	}
+	if c.maxRetries == 0 {
+		c.maxRetries = defaultMaxRetries
+	}
+	if c.maxRetries < 0 {
+		c.maxRetries = 0
+	}
+	if c.initialBackoff <= 0 {
+		c.initialBackoff = defaultInitialBackoff
+	}
+	if c.maxBackoff <= 0 {
+		c.maxBackoff = defaultMaxBackoff
+	}
+	return c
+}
+
+// Reply 以 replyToken 回覆訊息（POST /v2/bot/message/reply）
+//
+// 錯誤：
+// - ErrReplyTokenExpired: replyToken 已逾時（送出前或重試前檢查）
+// - ErrInvalidMessageCount: 訊息數量不是 1 ~ 5 則
+func (c *Client) Reply(token ReplyToken, messages ...Message) error {
+	if err := validateMessageCount(messages); err != nil {
+		return err
+	}
+
+	body := struct {
+		ReplyToken string    `json:"replyToken"`
+		Messages   []Message `json:"messages"`
+	}{
+		ReplyToken: token.Value,
+		Messages:   messages,
+	}
+
+	return c.send(http.MethodPost, c.baseURL+"/v2/bot/message/reply", body, "", func() error {
+		if token.IsExpiredAt(c.now()) {
+			return ErrReplyTokenExpired
+		}
+		return nil
+	}, nil)
+}
+
+// Push 主動推播訊息給單一用戶（POST /v2/bot/message/push）
+func (c *Client) Push(to string, messages ...Message) error {
+	if err := validateMessageCount(messages); err != nil {
+		return err
+	}
+
+	body := struct {
+		To       string    `json:"to"`
+		Messages []Message `json:"messages"`
+	}{
+		To:       to,
+		Messages: messages,
+	}
+
+	return c.send(http.MethodPost, c.baseURL+"/v2/bot/message/push", body, uuid.NewString(), nil, nil)
+}
+
+// PushText 推播純文字訊息（實作 application/notification.MessagePusher）
+func (c *Client) PushText(to, text string) error {
+	return c.Push(to, NewTextMessage(text))
+}
+
+// Multicast 推播訊息給多位用戶（POST /v2/bot/message/multicast）
+//
+// 超過 500 位收件者時自動分批；任一批失敗即停止並返回錯誤
+func (c *Client) Multicast(to []string, messages ...Message) error {
+	if err := validateMessageCount(messages); err != nil {
+		return err
+	}
+
+	for start := 0; start < len(to); start += MaxMulticastRecipients {
+		end := min(start+MaxMulticastRecipients, len(to))
+		body := struct {
+			To       []string  `json:"to"`
+			Messages []Message `json:"messages"`
+		}{
+			To:       to[start:end],
+			Messages: messages,
+		}
+		if err := c.send(http.MethodPost, c.baseURL+"/v2/bot/message/multicast", body, uuid.NewString(), nil, nil); err != nil {
+			return fmt.Errorf("failed to multicast to recipients %d-%d: %w", start, end-1, err)
+		}
+	}
+	return nil
+}
+
+// MulticastText 以指定 Retry-Key 推播純文字訊息給一批用戶（實作 application/broadcast.MulticastSender）
+//
+// 與 Multicast 不同：不自動分批，retryKey 由呼叫端保存，
+// 行程重啟後以相同 retryKey 重送時 LINE 不會重複發送
+//
+// 錯誤：收件者為空或超過 500 位
+func (c *Client) MulticastText(retryKey string, to []string, text string) error {
+	if len(to) == 0 || len(to) > MaxMulticastRecipients {
+		return fmt.Errorf("multicast recipients must be between 1 and %d, got %d", MaxMulticastRecipients, len(to))
+	}
+
+	body := struct {
+		To       []string  `json:"to"`
+		Messages []Message `json:"messages"`
+	}{
+		To:       to,
+		Messages: []Message{NewTextMessage(text)},
+	}
+	return c.send(http.MethodPost, c.baseURL+"/v2/bot/message/multicast", body, retryKey, nil, nil)
+}
+
+// DisplayName 查詢用戶的 LINE 顯示名稱（GET /v2/bot/profile/{userId}）
+func (c *Client) DisplayName(lineUserID string) (string, error) {
+	var profile struct {
+		DisplayName string `json:"displayName"`
+	}
+	endpoint := c.baseURL + "/v2/bot/profile/" + url.PathEscape(lineUserID)
+	if err := c.send(http.MethodGet, endpoint, nil, "", nil, &profile); err != nil {
+		return "", err
+	}
+	return profile.DisplayName, nil
+}
+
+// MessageContent 下載使用者傳送的圖片等內容（GET https://api-data.line.me/v2/bot/message/{messageId}/content）
+//
+// 注意：內容僅在使用者傳送後的一段時間內可下載
+func (c *Client) MessageContent(messageID string) ([]byte, error) {
+	var content []byte
+	endpoint := c.dataBaseURL + "/v2/bot/message/" + url.PathEscape(messageID) + "/content"
+	if err := c.send(http.MethodGet, endpoint, nil, "", nil, &content); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// send 送出請求並在 429 / 5xx / 網路錯誤時重試
+//
+// 參數：
+//   retryKey - X-Line-Retry-Key（空字串表示不帶；同一請求的重試沿用相同值）
+//   precheck - 每次送出前的檢查（例如 replyToken 逾時），返回錯誤即停止
+//   out - 成功時解析回應內容（可為 nil；*[]byte 時保留原始內容）
+func (c *Client) send(method, endpoint string, body any, retryKey string, precheck func() error, out any) error {
+	var payload []byte
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		payload = encoded
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if precheck != nil {
+			if err := precheck(); err != nil {
+				return err
+			}
+		}
+
+		retryAfter, err := c.do(method, endpoint, payload, retryKey, out)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && !apiErr.IsRetryable() {
+			return err
+		}
+		if attempt < c.maxRetries {
+			c.sleep(c.backoff(attempt, retryAfter))
+		}
+	}
+	return fmt.Errorf("LINE API request failed after %d attempts: %w", c.maxRetries+1, lastErr)
+}
+
+// do 送出單次請求
+//
+// 返回：Retry-After 標頭指定的等待時間（未指定時為 0）
+func (c *Client) do(method, endpoint string, payload []byte, retryKey string, out any) (time.Duration, error) {
+	var reader io.Reader
+	if payload != nil {
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequest(method, endpoint, reader)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if retryKey != "" {
+		req.Header.Set("X-Line-Retry-Key", retryKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	// 409：相同 Retry-Key 的請求已被接受（先前的嘗試其實已送達）
+	if resp.StatusCode == http.StatusConflict && retryKey != "" {
+		return 0, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return parseRetryAfter(resp.Header.Get("Retry-After")), newAPIError(resp.StatusCode, respBody)
+	}
+
+	if raw, ok := out.(*[]byte); ok {
+		*raw = respBody
+		return 0, nil
+	}
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return 0, fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+	return 0, nil
+}
+
+// backoff 計算第 attempt 次失敗後的等待時間（指數退避，Retry-After 優先，皆不超過上限）
+func (c *Client) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	wait := retryAfter
+	if wait <= 0 {
+		wait = c.initialBackoff << attempt
+	}
+	if wait <= 0 || wait > c.maxBackoff {
+		return c.maxBackoff
+	}
+	return wait
+}
+
+// newAPIError 解析 LINE 錯誤回應
+func newAPIError(statusCode int, body []byte) *APIError {
+	var resp struct {
+		Message string `json:"message"`
+		Details []struct {
+			Message  string `json:"message"`
+			Property string `json:"property"`
+		} `json:"details"`
+	}
+	apiErr := &APIError{StatusCode: statusCode, Message: http.StatusText(statusCode)}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return apiErr
+	}
+	if resp.Message != "" {
+		apiErr.Message = resp.Message
+	}
+	for _, d := range resp.Details {
+		apiErr.Details = append(apiErr.Details, d.Property+": "+d.Message)
+	}
+	return apiErr
+}
+
+// parseRetryAfter 解析 Retry-After 標頭（秒數）
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// validateMessageCount 檢查訊息數量
+func validateMessageCount(messages []Message) error {
+	if len(messages) == 0 || len(messages) > MaxMessagesPerRequest {
+		return ErrInvalidMessageCount
+	}
+	return nil
+}
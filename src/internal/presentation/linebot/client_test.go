@@ -0,0 +1,356 @@
+package linebot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// Fake LINE API Server
+// ===========================
+
+const testAccessToken = "test-channel-access-token"
+
+// recordedRequest fake server 收到的請求
+type recordedRequest struct {
+	method   string
+	path     string
+	auth     string
+	retryKey string
+	body     map[string]any
+}
+
+// fakeLineServer 本機 LINE Messaging API（依序返回預先設定的回應）
+type fakeLineServer struct {
+	t         *testing.T
+	server    *httptest.Server
+	mu        sync.Mutex
+	requests  []recordedRequest
+	responses []fakeResponse
+}
+
+type fakeResponse struct {
+	status     int
+	body       string
+	retryAfter string
+}
+
+func newFakeLineServer(t *testing.T, responses ...fakeResponse) *fakeLineServer {
+	f := &fakeLineServer{t: t, responses: responses}
+	f.server = httptest.NewServer(http.HandlerFunc(f.handle))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *fakeLineServer) handle(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	req := recordedRequest{
+		method:   r.Method,
+		path:     r.URL.Path,
+		auth:     r.Header.Get("Authorization"),
+		retryKey: r.Header.Get("X-Line-Retry-Key"),
+	}
+	if raw, _ := io.ReadAll(r.Body); len(raw) > 0 {
+		require.NoError(f.t, json.Unmarshal(raw, &req.body))
+	}
+	f.requests = append(f.requests, req)
+
+	resp := fakeResponse{status: http.StatusOK, body: "{}"}
+	if len(f.responses) > 0 {
+		resp = f.responses[0]
+		f.responses = f.responses[1:]
+	}
+	if resp.retryAfter != "" {
+		w.Header().Set("Retry-After", resp.retryAfter)
+	}
+	w.WriteHeader(resp.status)
+	_, _ = w.Write([]byte(resp.body))
+}
+
+// newTestClient 指向 fake server 的客戶端（記錄等待時間，不實際 sleep）
+func newTestClient(f *fakeLineServer, sleeps *[]time.Duration) *Client {
+	c := NewClient(ClientConfig{
+		ChannelAccessToken: testAccessToken,
+		BaseURL:            f.server.URL,
+		DataBaseURL:        f.server.URL,
+		InitialBackoff:     100 * time.Millisecond,
+		MaxBackoff:         2 * time.Second,
+	})
+	c.sleep = func(d time.Duration) { *sleeps = append(*sleeps, d) }
+	return c
+}
+
+// ===========================
+// 測試
+// ===========================
+
+// Test 1: Reply 送出 replyToken 與訊息（含授權標頭）
+func TestClient_Reply_SendsMessages(t *testing.T) {
+	// Arrange
+	f := newFakeLineServer(t)
+	var sleeps []time.Duration
+	c := newTestClient(f, &sleeps)
+	token := ReplyToken{Value: "reply-token", IssuedAt: time.Now()}
+
+	// Act
+	err := c.Reply(token, NewTextMessage("你好"))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, f.requests, 1)
+	req := f.requests[0]
+	assert.Equal(t, http.MethodPost, req.method)
+	assert.Equal(t, "/v2/bot/message/reply", req.path)
+	assert.Equal(t, "Bearer "+testAccessToken, req.auth)
+	assert.Empty(t, req.retryKey)
+	assert.Equal(t, "reply-token", req.body["replyToken"])
+	assert.Equal(t, []any{map[string]any{"type": "text", "text": "你好"}}, req.body["messages"])
+}
+
+// Test 2: replyToken 已逾時不送出請求
+func TestClient_Reply_ExpiredToken(t *testing.T) {
+	// Arrange
+	f := newFakeLineServer(t)
+	var sleeps []time.Duration
+	c := newTestClient(f, &sleeps)
+	token := ReplyToken{Value: "reply-token", IssuedAt: time.Now().Add(-ReplyTokenTTL - time.Second)}
+
+	// Act
+	err := c.Reply(token, NewTextMessage("你好"))
+
+	// Assert
+	assert.ErrorIs(t, err, ErrReplyTokenExpired)
+	assert.Empty(t, f.requests)
+}
+
+// Test 3: 重試期間 replyToken 逾時即停止
+func TestClient_Reply_StopsRetryingAfterTokenExpiry(t *testing.T) {
+	// Arrange
+	f := newFakeLineServer(t,
+		fakeResponse{status: http.StatusInternalServerError, body: `{"message":"internal error"}`},
+		fakeResponse{status: http.StatusOK, body: "{}"},
+	)
+	issuedAt := time.Date(2025, 1, 8, 20, 0, 0, 0, time.UTC)
+	now := issuedAt.Add(50 * time.Second)
+	c := NewClient(ClientConfig{ChannelAccessToken: testAccessToken, BaseURL: f.server.URL})
+	c.now = func() time.Time { return now }
+	c.sleep = func(d time.Duration) { now = now.Add(15 * time.Second) }
+
+	// Act
+	err := c.Reply(ReplyToken{Value: "reply-token", IssuedAt: issuedAt}, NewTextMessage("你好"))
+
+	// Assert
+	assert.ErrorIs(t, err, ErrReplyTokenExpired)
+	assert.Len(t, f.requests, 1)
+}
+
+// Test 4: 429 / 5xx 以指數退避重試（Retry-After 優先且不超過上限）
+func TestClient_Push_RetriesWithBackoff(t *testing.T) {
+	// Arrange
+	f := newFakeLineServer(t,
+		fakeResponse{status: http.StatusInternalServerError},
+		fakeResponse{status: http.StatusBadGateway},
+		fakeResponse{status: http.StatusTooManyRequests, body: `{"message":"Too Many Requests"}`, retryAfter: "60"},
+		fakeResponse{status: http.StatusOK, body: "{}"},
+	)
+	var sleeps []time.Duration
+	c := newTestClient(f, &sleeps)
+
+	// Act
+	err := c.Push("U4af4980629a1b2c3d4e5f6a7b8c9d0e1", NewTextMessage("🎉 您的發票已驗證！"))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, f.requests, 4)
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 2 * time.Second}, sleeps)
+
+	// 同一請求的重試沿用相同 Retry-Key
+	assert.NotEmpty(t, f.requests[0].retryKey)
+	for _, req := range f.requests {
+		assert.Equal(t, f.requests[0].retryKey, req.retryKey)
+		assert.Equal(t, "/v2/bot/message/push", req.path)
+		assert.Equal(t, "U4af4980629a1b2c3d4e5f6a7b8c9d0e1", req.body["to"])
+	}
+}
+
+// Test 5: 409（相同 Retry-Key 已被接受）視為成功
+func TestClient_Push_ConflictTreatedAsDelivered(t *testing.T) {
+	// Arrange
+	f := newFakeLineServer(t,
+		fakeResponse{status: http.StatusServiceUnavailable},
+		fakeResponse{status: http.StatusConflict, body: `{"message":"The retry key is already accepted"}`},
+	)
+	var sleeps []time.Duration
+	c := newTestClient(f, &sleeps)
+
+	// Act
+	err := c.Push("U4af4980629a1b2c3d4e5f6a7b8c9d0e1", NewTextMessage("hi"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, f.requests, 2)
+}
+
+// Test 6: 4xx 不重試，返回 APIError
+func TestClient_ClientError_NotRetried(t *testing.T) {
+	// Arrange
+	f := newFakeLineServer(t, fakeResponse{
+		status: http.StatusBadRequest,
+		body:   `{"message":"The request body has 1 error(s)","details":[{"message":"must be specified","property":"messages[0].text"}]}`,
+	})
+	var sleeps []time.Duration
+	c := newTestClient(f, &sleeps)
+
+	// Act
+	err := c.Push("U4af4980629a1b2c3d4e5f6a7b8c9d0e1", NewTextMessage(""))
+
+	// Assert
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Equal(t, "The request body has 1 error(s)", apiErr.Message)
+	assert.Equal(t, []string{"messages[0].text: must be specified"}, apiErr.Details)
+	assert.Len(t, f.requests, 1)
+	assert.Empty(t, sleeps)
+}
+
+// Test 7: 重試次數用盡返回最後一次錯誤
+func TestClient_RetriesExhausted(t *testing.T) {
+	// Arrange
+	f := newFakeLineServer(t,
+		fakeResponse{status: http.StatusInternalServerError},
+		fakeResponse{status: http.StatusInternalServerError},
+		fakeResponse{status: http.StatusInternalServerError},
+		fakeResponse{status: http.StatusInternalServerError},
+	)
+	var sleeps []time.Duration
+	c := newTestClient(f, &sleeps)
+
+	// Act
+	err := c.Push("U4af4980629a1b2c3d4e5f6a7b8c9d0e1", NewTextMessage("hi"))
+
+	// Assert
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusInternalServerError, apiErr.StatusCode)
+	assert.Len(t, f.requests, defaultMaxRetries+1)
+	assert.Len(t, sleeps, defaultMaxRetries)
+}
+
+// Test 8: Multicast 超過 500 位收件者自動分批（每批獨立 Retry-Key）
+func TestClient_Multicast_ChunksRecipients(t *testing.T) {
+	// Arrange
+	f := newFakeLineServer(t)
+	var sleeps []time.Duration
+	c := newTestClient(f, &sleeps)
+	recipients := make([]string, 1001)
+	for i := range recipients {
+		recipients[i] = fmt.Sprintf("U%032d", i)
+	}
+
+	// Act
+	err := c.Multicast(recipients, NewTextMessage("本週特惠"))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, f.requests, 3)
+	assert.Len(t, f.requests[0].body["to"], 500)
+	assert.Len(t, f.requests[1].body["to"], 500)
+	assert.Equal(t, []any{recipients[1000]}, f.requests[2].body["to"])
+	assert.NotEqual(t, f.requests[0].retryKey, f.requests[1].retryKey)
+}
+
+// Test 9: 訊息數量檢查（1 ~ 5 則）
+func TestClient_InvalidMessageCount(t *testing.T) {
+	// Arrange
+	f := newFakeLineServer(t)
+	var sleeps []time.Duration
+	c := newTestClient(f, &sleeps)
+	six := make([]Message, 6)
+	for i := range six {
+		six[i] = NewTextMessage("hi")
+	}
+
+	// Act & Assert
+	assert.ErrorIs(t, c.Push("U1"), ErrInvalidMessageCount)
+	assert.ErrorIs(t, c.Multicast([]string{"U1"}, six...), ErrInvalidMessageCount)
+	assert.Empty(t, f.requests)
+}
+
+// Test 10: 查詢 LINE 顯示名稱
+func TestClient_DisplayName(t *testing.T) {
+	// Arrange
+	f := newFakeLineServer(t, fakeResponse{
+		status: http.StatusOK,
+		body:   `{"userId":"U4af4980629a1b2c3d4e5f6a7b8c9d0e1","displayName":"小明","pictureUrl":"https://example.com/p.png"}`,
+	})
+	var sleeps []time.Duration
+	c := newTestClient(f, &sleeps)
+
+	// Act
+	name, err := c.DisplayName("U4af4980629a1b2c3d4e5f6a7b8c9d0e1")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "小明", name)
+	require.Len(t, f.requests, 1)
+	assert.Equal(t, http.MethodGet, f.requests[0].method)
+	assert.Equal(t, "/v2/bot/profile/U4af4980629a1b2c3d4e5f6a7b8c9d0e1", f.requests[0].path)
+}
+
+// Test 11: 下載圖片內容（5xx 重試，返回原始位元組）
+func TestClient_MessageContent(t *testing.T) {
+	// Arrange
+	f := newFakeLineServer(t,
+		fakeResponse{status: http.StatusServiceUnavailable, body: `{"message":"unavailable"}`},
+		fakeResponse{status: http.StatusOK, body: "\x89PNG\r\n\x1a\n"},
+	)
+	var sleeps []time.Duration
+	c := newTestClient(f, &sleeps)
+
+	// Act
+	content, err := c.MessageContent("325708")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []byte("\x89PNG\r\n\x1a\n"), content)
+	require.Len(t, f.requests, 2)
+	assert.Equal(t, http.MethodGet, f.requests[1].method)
+	assert.Equal(t, "/v2/bot/message/325708/content", f.requests[1].path)
+	assert.Len(t, sleeps, 1)
+}
+
+// Test 12: MulticastText 使用呼叫端的 Retry-Key（續傳重送時 409 視為已送達）
+func TestClient_MulticastText_UsesCallerRetryKey(t *testing.T) {
+	// Arrange
+	f := newFakeLineServer(t,
+		fakeResponse{status: http.StatusConflict, body: `{"message":"The retry key is already accepted"}`},
+	)
+	var sleeps []time.Duration
+	c := newTestClient(f, &sleeps)
+	retryKey := "123e4567-e89b-42d3-a456-426614174000"
+
+	// Act
+	err := c.MulticastText(retryKey, []string{"U1", "U2"}, "本週特惠")
+	tooMany := c.MulticastText(retryKey, make([]string, MaxMulticastRecipients+1), "本週特惠")
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, f.requests, 1)
+	assert.Equal(t, retryKey, f.requests[0].retryKey)
+	assert.Equal(t, "/v2/bot/message/multicast", f.requests[0].path)
+	assert.Equal(t, []any{"U1", "U2"}, f.requests[0].body["to"])
+	assert.Error(t, tooMany)
+}
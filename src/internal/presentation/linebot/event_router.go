@@ -0,0 +1,277 @@
+package linebot
+
+import (
+	"errors"
+	"fmt"
+
+	appconversation "github.com/jackyeh168/bar_crm/src/internal/application/conversation"
+	appmember "github.com/jackyeh168/bar_crm/src/internal/application/member"
+	apppoints "github.com/jackyeh168/bar_crm/src/internal/application/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+)
+
+// ===========================
+// Use Case 依賴
+// ===========================
+
+// 設計原則：以介面宣告依賴的 Use Case，方便測試替換
+// 實作：application/member、application/points、application/conversation 的 Use Case
+
+// MemberQueryUseCase 以 LINE UserID 查詢會員
+type MemberQueryUseCase interface {
+	Execute(query appmember.GetMemberByLineUserIDQuery) (*appmember.MemberResult, error)
+}
+
+// BalanceQueryUseCase 查詢積分餘額
+type BalanceQueryUseCase interface {
+	Execute(query apppoints.GetPointsBalanceQuery) (*apppoints.GetPointsBalanceResult, error)
+}
+
+// RegistrationStartUseCase 開始註冊對話
+type RegistrationStartUseCase interface {
+	Execute(cmd appconversation.StartRegistrationCommand) (*appconversation.RegistrationStepResult, error)
+}
+
+// RegistrationInputUseCase 處理註冊對話中的輸入
+type RegistrationInputUseCase interface {
+	Execute(cmd appconversation.RegistrationInputCommand) (*appconversation.RegistrationStepResult, error)
+}
+
+// ReachabilityUseCase 更新會員可觸及狀態（封鎖 / 解除封鎖）
+type ReachabilityUseCase interface {
+	Execute(cmd appmember.UpdateReachabilityCommand) (*appmember.ReachabilityResult, error)
+}
+
+// ProfileSyncUseCase 以 LINE Profile 同步會員顯示名稱
+type ProfileSyncUseCase interface {
+	Execute(cmd appmember.SyncLineProfileCommand) (*appmember.SyncLineProfileResult, error)
+}
+
+// BirthdayUseCase 會員填寫生日
+type BirthdayUseCase interface {
+	Execute(cmd appmember.RecordBirthdayCommand) (*appmember.RecordBirthdayResult, error)
+}
+
+// InvoiceImageProcessor 發票照片處理（QR Code 解析 + 發票登錄）
+//
+// 返回：回覆給會員的訊息
+type InvoiceImageProcessor interface {
+	ProcessInvoiceImage(memberID, messageID string) ([]Message, error)
+}
+
+// ===========================
+// EventRouter
+// ===========================
+
+// postback action
+const (
+	postbackActionBalance  = "balance"
+	postbackActionHelp     = "help"
+	postbackActionRegister = "register"
+)
+
+// EventRouter 依事件類型分派至對應的 Use Case
+//
+// 事件處理：
+// - follow: 恢復可觸及狀態；未註冊時開始註冊對話並顯示歡迎訊息（已註冊會員顯示歡迎回來並同步顯示名稱）
+// - unfollow: 標記會員不可觸及（停止推播與群發），無 replyToken，不回覆
+// - message（text）: 積分查詢關鍵字 / 說明 / 填寫生日 / 未註冊時交由註冊對話處理
+// - message（image）: 發票照片登錄
+// - postback: Rich Menu 動作（action=balance / help / register）
+// - 其他事件: 忽略
+type EventRouter struct {
+	memberQuery       MemberQueryUseCase
+	startRegistration RegistrationStartUseCase
+	registrationInput RegistrationInputUseCase
+	balanceQuery      BalanceQueryUseCase
+	reachability      ReachabilityUseCase
+	profileSync       ProfileSyncUseCase
+	birthday          BirthdayUseCase
+	images            InvoiceImageProcessor
+	profiles          ProfileProvider
+	replier           Replier
+}
+
+// NewEventRouter 創建 EventRouter
+//
+// 參數：
+//   images - 發票照片處理器（可為 nil，未提供時回覆暫不支援）
+func NewEventRouter(
+	memberQuery MemberQueryUseCase,
+	startRegistration RegistrationStartUseCase,
+	registrationInput RegistrationInputUseCase,
+	balanceQuery BalanceQueryUseCase,
+	reachability ReachabilityUseCase,
+	profileSync ProfileSyncUseCase,
+	birthday BirthdayUseCase,
+	images InvoiceImageProcessor,
+	profiles ProfileProvider,
+	replier Replier,
+) *EventRouter {
+	return &EventRouter{
+		memberQuery:       memberQuery,
+		startRegistration: startRegistration,
+		registrationInput: registrationInput,
+		balanceQuery:      balanceQuery,
+		reachability:      reachability,
+		profileSync:       profileSync,
+		birthday:          birthday,
+		images:            images,
+		profiles:          profiles,
+		replier:           replier,
+	}
+}
+
+// Dispatch 分派單一事件
+//
+// 錯誤處理：
+// - 業務錯誤（格式錯誤、未註冊等）轉為回覆訊息，不返回錯誤
+// - 非預期錯誤先回覆系統錯誤訊息，再返回錯誤供記錄
+func (r *EventRouter) Dispatch(event Event) error {
+	switch event.Type {
+	case EventTypeFollow:
+		return r.handleFollow(event)
+	case EventTypeUnfollow:
+		return r.updateReachability(event, false)
+	case EventTypeMessage:
+		return r.handleMessage(event)
+	case EventTypePostback:
+		return r.handlePostback(event)
+	default:
+		return nil
+	}
+}
+
+// handleFollow 加入好友
+func (r *EventRouter) handleFollow(event Event) error {
+	if err := r.updateReachability(event, true); err != nil {
+		return r.replySystemError(event, err)
+	}
+
+	m, err := r.findMember(event.UserID)
+	if err != nil {
+		return r.replySystemError(event, err)
+	}
+
+	if m != nil && m.IsRegistered {
+		if err := r.reply(event, textWelcomeBack); err != nil {
+			return err
+		}
+		// 先回覆（replyToken 有時效）再同步顯示名稱
+		return r.syncDisplayName(event)
+	}
+	return r.startRegistrationWith(event, textWelcome)
+}
+
+// syncDisplayName 以 LINE Profile 同步已註冊會員的顯示名稱
+func (r *EventRouter) syncDisplayName(event Event) error {
+	displayName, err := r.profiles.DisplayName(event.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get LINE profile: %w", err)
+	}
+
+	_, err = r.profileSync.Execute(appmember.SyncLineProfileCommand{
+		LineUserID:  event.UserID,
+		DisplayName: displayName,
+		Now:         event.Timestamp,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to sync display name: %w", err)
+	}
+	return nil
+}
+
+// updateReachability 更新會員可觸及狀態（未註冊的 LINE 使用者忽略）
+func (r *EventRouter) updateReachability(event Event, reachable bool) error {
+	_, err := r.reachability.Execute(appmember.UpdateReachabilityCommand{
+		LineUserID: event.UserID,
+		Reachable:  reachable,
+		OccurredAt: event.Timestamp,
+	})
+	if errors.Is(err, member.ErrMemberNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update reachability: %w", err)
+	}
+	return nil
+}
+
+// handleMessage 訊息事件
+func (r *EventRouter) handleMessage(event Event) error {
+	if event.Message == nil {
+		return nil
+	}
+
+	switch event.Message.Type {
+	case MessageTypeText:
+		return r.handleText(event)
+	case MessageTypeImage:
+		return r.handleImage(event)
+	default:
+		return r.reply(event, textHelp)
+	}
+}
+
+// handlePostback Rich Menu / 按鈕回傳
+func (r *EventRouter) handlePostback(event Event) error {
+	if event.Postback == nil {
+		return nil
+	}
+
+	switch event.Postback.Action() {
+	case postbackActionBalance:
+		return r.handleBalance(event)
+	case postbackActionHelp:
+		return r.reply(event, textHelp)
+	case postbackActionRegister:
+		return r.handleRegisterRequest(event)
+	default:
+		return nil
+	}
+}
+
+// ===========================
+// 共用
+// ===========================
+
+// findMember 查詢會員（不存在時返回 nil, nil）
+func (r *EventRouter) findMember(lineUserID string) (*appmember.MemberResult, error) {
+	m, err := r.memberQuery.Execute(appmember.GetMemberByLineUserIDQuery{LineUserID: lineUserID})
+	if errors.Is(err, member.ErrMemberNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// reply 回覆文字訊息
+func (r *EventRouter) reply(event Event, texts ...string) error {
+	messages := make([]Message, 0, len(texts))
+	for _, text := range texts {
+		messages = append(messages, NewTextMessage(text))
+	}
+	return r.replyMessages(event, messages...)
+}
+
+// replyMessages 回覆訊息（文字 / Flex）
+func (r *EventRouter) replyMessages(event Event, messages ...Message) error {
+	if event.ReplyToken == "" || len(messages) == 0 {
+		return nil
+	}
+
+	if err := r.replier.Reply(event.replyToken(), messages...); err != nil {
+		return fmt.Errorf("failed to reply: %w", err)
+	}
+	return nil
+}
+
+// replySystemError 回覆系統錯誤訊息並返回原始錯誤
+func (r *EventRouter) replySystemError(event Event, cause error) error {
+	if err := r.reply(event, textSystemError); err != nil {
+		return errors.Join(cause, err)
+	}
+	return cause
+}
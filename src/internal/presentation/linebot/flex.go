@@ -0,0 +1,377 @@
+package linebot
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	apppoints "github.com/jackyeh168/bar_crm/src/internal/application/points"
+	appsurvey "github.com/jackyeh168/bar_crm/src/internal/application/survey"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+)
+
+// ===========================
+// Flex Message 元件
+// ===========================
+
+// 設計原則：
+// - 只實作本系統用到的 Flex 元件子集（bubble / box / text / button / separator）
+// - 各元件自行序列化 type 欄位，builder 只組合結構
+
+// FlexComponent Flex 元件（box / text / button / separator）
+type FlexComponent interface {
+	flexComponent()
+}
+
+// FlexMessage Flex 訊息
+//
+// 欄位：
+// - AltText: 通知列與不支援 Flex 的裝置顯示的文字（必填，最多 400 字）
+type FlexMessage struct {
+	AltText  string
+	Contents FlexBubble
+}
+
+// MessageType 返回訊息類型
+func (m FlexMessage) MessageType() string {
+	return "flex"
+}
+
+// MarshalJSON 序列化為 LINE API 格式
+func (m FlexMessage) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type     string     `json:"type"`
+		AltText  string     `json:"altText"`
+		Contents FlexBubble `json:"contents"`
+	}{
+		Type:     m.MessageType(),
+		AltText:  m.AltText,
+		Contents: m.Contents,
+	})
+}
+
+// FlexBubble Bubble 容器
+type FlexBubble struct {
+	Header *FlexBox
+	Body   *FlexBox
+	Footer *FlexBox
+}
+
+// MarshalJSON 序列化為 LINE API 格式
+func (b FlexBubble) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type   string   `json:"type"`
+		Header *FlexBox `json:"header,omitempty"`
+		Body   *FlexBox `json:"body,omitempty"`
+		Footer *FlexBox `json:"footer,omitempty"`
+	}{
+		Type:   "bubble",
+		Header: b.Header,
+		Body:   b.Body,
+		Footer: b.Footer,
+	})
+}
+
+// FlexBox Box 元件
+//
+// 欄位：
+// - Layout: vertical / horizontal / baseline
+type FlexBox struct {
+	Layout          string
+	Contents        []FlexComponent
+	Spacing         string
+	Margin          string
+	BackgroundColor string
+}
+
+func (FlexBox) flexComponent() {}
+
+// MarshalJSON 序列化為 LINE API 格式
+func (b FlexBox) MarshalJSON() ([]byte, error) {
+	contents := b.Contents
+	if contents == nil {
+		contents = []FlexComponent{}
+	}
+	return json.Marshal(struct {
+		Type            string          `json:"type"`
+		Layout          string          `json:"layout"`
+		Contents        []FlexComponent `json:"contents"`
+		Spacing         string          `json:"spacing,omitempty"`
+		Margin          string          `json:"margin,omitempty"`
+		BackgroundColor string          `json:"backgroundColor,omitempty"`
+	}{
+		Type:            "box",
+		Layout:          b.Layout,
+		Contents:        contents,
+		Spacing:         b.Spacing,
+		Margin:          b.Margin,
+		BackgroundColor: b.BackgroundColor,
+	})
+}
+
+// FlexText Text 元件
+type FlexText struct {
+	Text   string
+	Size   string
+	Weight string
+	Color  string
+	Align  string
+	Flex   int
+	Wrap   bool
+}
+
+func (FlexText) flexComponent() {}
+
+// MarshalJSON 序列化為 LINE API 格式
+func (t FlexText) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type   string `json:"type"`
+		Text   string `json:"text"`
+		Size   string `json:"size,omitempty"`
+		Weight string `json:"weight,omitempty"`
+		Color  string `json:"color,omitempty"`
+		Align  string `json:"align,omitempty"`
+		Flex   *int   `json:"flex,omitempty"`
+		Wrap   bool   `json:"wrap,omitempty"`
+	}{
+		Type:   "text",
+		Text:   t.Text,
+		Size:   t.Size,
+		Weight: t.Weight,
+		Color:  t.Color,
+		Align:  t.Align,
+		Flex:   optionalFlex(t.Flex),
+		Wrap:   t.Wrap,
+	})
+}
+
+// FlexButton Button 元件（URI 動作）
+//
+// 欄位：
+// - Style: primary / secondary / link
+type FlexButton struct {
+	Label string
+	URI   string
+	Style string
+	Color string
+}
+
+func (FlexButton) flexComponent() {}
+
+// MarshalJSON 序列化為 LINE API 格式
+func (b FlexButton) MarshalJSON() ([]byte, error) {
+	type uriAction struct {
+		Type  string `json:"type"`
+		Label string `json:"label"`
+		URI   string `json:"uri"`
+	}
+	return json.Marshal(struct {
+		Type   string    `json:"type"`
+		Action uriAction `json:"action"`
+		Style  string    `json:"style,omitempty"`
+		Color  string    `json:"color,omitempty"`
+	}{
+		Type:   "button",
+		Action: uriAction{Type: "uri", Label: b.Label, URI: b.URI},
+		Style:  b.Style,
+		Color:  b.Color,
+	})
+}
+
+// FlexSeparator Separator 元件
+type FlexSeparator struct {
+	Margin string
+}
+
+func (FlexSeparator) flexComponent() {}
+
+// MarshalJSON 序列化為 LINE API 格式
+func (s FlexSeparator) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type   string `json:"type"`
+		Margin string `json:"margin,omitempty"`
+	}{
+		Type:   "separator",
+		Margin: s.Margin,
+	})
+}
+
+// optionalFlex flex 為 0 時省略（LINE 預設值依 layout 而定）
+func optionalFlex(flex int) *int {
+	if flex == 0 {
+		return nil
+	}
+	return &flex
+}
+
+// ===========================
+// Flex Message Builders
+// ===========================
+
+// 品牌色
+const (
+	flexColorPrimary = "#1DB446"
+	flexColorMuted   = "#888888"
+	flexColorHeader  = "#F5F5F5"
+)
+
+// NewBalanceCard 積分餘額卡片（US-003）
+//
+// 參數：
+//   balance - GetPointsBalanceUseCase 查詢結果
+//   queriedAt - 查詢時間（以台北時間顯示）
+func NewBalanceCard(balance *apppoints.GetPointsBalanceResult, queriedAt time.Time) FlexMessage {
+	return FlexMessage{
+		AltText: fmt.Sprintf("💰 您的積分資訊：可用餘額 %d 點", balance.AvailablePoints),
+		Contents: FlexBubble{
+			Header: flexHeader("💰 您的積分資訊"),
+			Body: &FlexBox{
+				Layout:  "vertical",
+				Spacing: "md",
+				Contents: []FlexComponent{
+					FlexText{Text: "可用餘額", Size: "sm", Color: flexColorMuted},
+					FlexText{Text: formatPoints(balance.AvailablePoints), Size: "3xl", Weight: "bold", Color: flexColorPrimary},
+					FlexSeparator{Margin: "lg"},
+					flexRow("累積賺取", formatPoints(balance.EarnedPoints)),
+					flexRow("已使用", formatPoints(balance.UsedPoints)),
+				},
+			},
+			Footer: flexFooterNote("查詢時間：" + queriedAt.In(shared.BusinessLocation).Format("2006/01/02 15:04")),
+		},
+	}
+}
+
+// PointsReceipt 積分入帳收據內容
+//
+// 欄位：
+// - InvoiceDate: 發票開立日期
+// - Amount: 消費金額（元）
+// - PointsEarned: 本次獲得積分
+// - AvailablePoints: 入帳後可用餘額
+type PointsReceipt struct {
+	InvoiceNumber   string
+	InvoiceDate     time.Time
+	Amount          int
+	PointsEarned    int
+	AvailablePoints int
+}
+
+// NewPointsReceipt 積分入帳收據（發票驗證完成通知）
+func NewPointsReceipt(receipt PointsReceipt) FlexMessage {
+	return FlexMessage{
+		AltText: fmt.Sprintf("🎉 您的發票已驗證！獲得積分：%d 點", receipt.PointsEarned),
+		Contents: FlexBubble{
+			Header: flexHeader("🎉 您的發票已驗證！"),
+			Body: &FlexBox{
+				Layout:  "vertical",
+				Spacing: "md",
+				Contents: []FlexComponent{
+					flexRow("發票號碼", formatInvoiceNumber(receipt.InvoiceNumber)),
+					flexRow("消費日期", receipt.InvoiceDate.In(shared.BusinessLocation).Format("2006/01/02")),
+					flexRow("消費金額", fmt.Sprintf("NT$ %d", receipt.Amount)),
+					FlexSeparator{Margin: "lg"},
+					FlexBox{
+						Layout: "horizontal",
+						Margin: "lg",
+						Contents: []FlexComponent{
+							FlexText{Text: "獲得積分", Weight: "bold", Flex: 1},
+							FlexText{Text: "+" + formatPoints(receipt.PointsEarned), Weight: "bold", Color: flexColorPrimary, Align: "end", Flex: 1},
+						},
+					},
+					flexRow("最新積分餘額", formatPoints(receipt.AvailablePoints)),
+				},
+			},
+			Footer: flexFooterNote("感謝您的支持！"),
+		},
+	}
+}
+
+// NewSurveyLinkBubble 問卷連結卡片（US-004）
+//
+// 參數：
+//   surveyBaseURL - 問卷頁面網址（Token 以 token 參數附加）
+//   token - IssueSurveyTokenUseCase 簽發結果
+func NewSurveyLinkBubble(surveyBaseURL string, token *appsurvey.IssueSurveyTokenResult) (FlexMessage, error) {
+	link, err := url.Parse(surveyBaseURL)
+	if err != nil {
+		return FlexMessage{}, fmt.Errorf("invalid survey base URL: %w", err)
+	}
+	query := link.Query()
+	query.Set("token", token.Token)
+	link.RawQuery = query.Encode()
+
+	return FlexMessage{
+		AltText: "📋 填寫問卷可再得 1 點！",
+		Contents: FlexBubble{
+			Header: flexHeader("📋 填寫問卷可再得 1 點！"),
+			Body: &FlexBox{
+				Layout:  "vertical",
+				Spacing: "md",
+				Contents: []FlexComponent{
+					FlexText{Text: "問卷約 2 分鐘，完成後積分自動增加。", Wrap: true},
+					FlexText{
+						Text:  "連結有效至 " + token.ExpiresAt.In(shared.BusinessLocation).Format("2006/01/02"),
+						Size:  "xs",
+						Color: flexColorMuted,
+					},
+				},
+			},
+			Footer: &FlexBox{
+				Layout: "vertical",
+				Contents: []FlexComponent{
+					FlexButton{Label: "填寫問卷", URI: link.String(), Style: "primary", Color: flexColorPrimary},
+				},
+			},
+		},
+	}, nil
+}
+
+// ===========================
+// Builder Helpers
+// ===========================
+
+// flexHeader 卡片標題
+func flexHeader(title string) *FlexBox {
+	return &FlexBox{
+		Layout:          "vertical",
+		BackgroundColor: flexColorHeader,
+		Contents: []FlexComponent{
+			FlexText{Text: title, Weight: "bold", Size: "lg"},
+		},
+	}
+}
+
+// flexRow 左標籤右數值的列
+func flexRow(label, value string) FlexBox {
+	return FlexBox{
+		Layout: "horizontal",
+		Contents: []FlexComponent{
+			FlexText{Text: label, Size: "sm", Color: flexColorMuted, Flex: 1},
+			FlexText{Text: value, Size: "sm", Align: "end", Flex: 1},
+		},
+	}
+}
+
+// flexFooterNote 卡片底部註記
+func flexFooterNote(note string) *FlexBox {
+	return &FlexBox{
+		Layout: "vertical",
+		Contents: []FlexComponent{
+			FlexText{Text: note, Size: "xs", Color: flexColorMuted, Align: "center"},
+		},
+	}
+}
+
+// formatPoints 積分顯示格式（125 點）
+func formatPoints(points int) string {
+	return fmt.Sprintf("%d 點", points)
+}
+
+// formatInvoiceNumber 發票號碼顯示格式（AB12345678 → AB-12345678）
+func formatInvoiceNumber(number string) string {
+	if len(number) != 10 {
+		return number
+	}
+	return number[:2] + "-" + number[2:]
+}
@@ -0,0 +1,108 @@
+package linebot
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	apppoints "github.com/jackyeh168/bar_crm/src/internal/application/points"
+	appsurvey "github.com/jackyeh168/bar_crm/src/internal/application/survey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// collectTexts 取出 Flex JSON 中所有 text 元件的文字
+func collectTexts(node any) []string {
+	var texts []string
+	switch v := node.(type) {
+	case map[string]any:
+		if v["type"] == "text" {
+			texts = append(texts, v["text"].(string))
+		}
+		for _, child := range v {
+			texts = append(texts, collectTexts(child)...)
+		}
+	case []any:
+		for _, child := range v {
+			texts = append(texts, collectTexts(child)...)
+		}
+	}
+	return texts
+}
+
+func marshalFlex(t *testing.T, m FlexMessage) map[string]any {
+	t.Helper()
+	raw, err := json.Marshal(m)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	return decoded
+}
+
+// Test 1: 積分餘額卡片（查詢時間以台北時間顯示）
+func TestNewBalanceCard(t *testing.T) {
+	// Arrange
+	balance := &apppoints.GetPointsBalanceResult{EarnedPoints: 125, UsedPoints: 5, AvailablePoints: 120}
+	queriedAt := time.Date(2025, 1, 8, 12, 30, 0, 0, time.UTC)
+
+	// Act
+	decoded := marshalFlex(t, NewBalanceCard(balance, queriedAt))
+
+	// Assert
+	assert.Equal(t, "flex", decoded["type"])
+	assert.Equal(t, "💰 您的積分資訊：可用餘額 120 點", decoded["altText"])
+	contents := decoded["contents"].(map[string]any)
+	assert.Equal(t, "bubble", contents["type"])
+	texts := collectTexts(contents)
+	assert.Contains(t, texts, "120 點")
+	assert.Contains(t, texts, "125 點")
+	assert.Contains(t, texts, "5 點")
+	assert.Contains(t, texts, "查詢時間：2025/01/08 20:30")
+}
+
+// Test 2: 積分入帳收據
+func TestNewPointsReceipt(t *testing.T) {
+	// Arrange
+	receipt := PointsReceipt{
+		InvoiceNumber:   "AB12345678",
+		InvoiceDate:     time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC),
+		Amount:          350,
+		PointsEarned:    3,
+		AvailablePoints: 128,
+	}
+
+	// Act
+	decoded := marshalFlex(t, NewPointsReceipt(receipt))
+
+	// Assert
+	assert.Equal(t, "🎉 您的發票已驗證！獲得積分：3 點", decoded["altText"])
+	texts := collectTexts(decoded["contents"])
+	assert.Contains(t, texts, "AB-12345678")
+	assert.Contains(t, texts, "NT$ 350")
+	assert.Contains(t, texts, "+3 點")
+	assert.Contains(t, texts, "128 點")
+}
+
+// Test 3: 問卷連結卡片（Token 附加於網址）
+func TestNewSurveyLinkBubble(t *testing.T) {
+	// Arrange
+	token := &appsurvey.IssueSurveyTokenResult{
+		Token:     "abc.def",
+		ExpiresAt: time.Date(2025, 1, 15, 16, 0, 0, 0, time.UTC),
+	}
+
+	// Act
+	message, err := NewSurveyLinkBubble("https://crm.example.com/survey?src=line", token)
+
+	// Assert
+	require.NoError(t, err)
+	decoded := marshalFlex(t, message)
+	assert.Equal(t, "📋 填寫問卷可再得 1 點！", decoded["altText"])
+	footer := decoded["contents"].(map[string]any)["footer"].(map[string]any)
+	button := footer["contents"].([]any)[0].(map[string]any)
+	assert.Equal(t, "button", button["type"])
+	action := button["action"].(map[string]any)
+	assert.Equal(t, "uri", action["type"])
+	assert.Equal(t, "https://crm.example.com/survey?src=line&token=abc.def", action["uri"])
+	assert.Contains(t, collectTexts(decoded["contents"]), "連結有效至 2025/01/16")
+}
@@ -0,0 +1,107 @@
+package linebot
+
+import (
+	"errors"
+	"fmt"
+
+	appinvoice "github.com/jackyeh168/bar_crm/src/internal/application/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+)
+
+// ===========================
+// 發票照片處理
+// ===========================
+
+// MessageContentFetcher 下載使用者傳送的圖片內容
+//
+// 實作：Client.MessageContent（GET https://api-data.line.me/v2/bot/message/{messageId}/content）
+type MessageContentFetcher interface {
+	MessageContent(messageID string) ([]byte, error)
+}
+
+// QRCodeDecoder 從 JPEG / PNG 圖片解出所有 QR Code 內容
+//
+// 實作：infrastructure/qrcode.Decoder
+// 錯誤：找不到 QR Code 時返回 invoice.ErrQRCodeNotFound
+type QRCodeDecoder interface {
+	Decode(image []byte) ([]string, error)
+}
+
+// ScannedInvoiceUseCase 登錄會員掃描的發票（含掃描風險評估）
+type ScannedInvoiceUseCase interface {
+	Execute(cmd appinvoice.RecordScannedInvoiceCommand) (*appinvoice.RecordScannedInvoiceResult, error)
+}
+
+// InvoiceQRProcessor 發票照片處理器（實作 InvoiceImageProcessor）
+//
+// 處理流程：
+// 1. 下載圖片內容
+// 2. 解出照片中的 QR Code（電子發票證明聯並排的左右兩個）
+// 3. 交由 invoice.ParseInvoiceQRCode 解析發票資訊
+// 4. 登錄發票交易（同一事務中進行風險評估）並回覆確認訊息
+//
+// 錯誤處理：
+// - 找不到 QR Code / 非電子發票 QR Code / 發票已被登錄 → 回覆提示訊息，不返回錯誤
+// - 下載失敗等非預期錯誤 → 返回錯誤（由 EventRouter 回覆系統錯誤）
+//
+// 設計決策：風險評估暫停或封鎖的交易同樣回覆待驗證，不向會員透露偵測規則
+type InvoiceQRProcessor struct {
+	contents MessageContentFetcher
+	decoder  QRCodeDecoder
+	invoices ScannedInvoiceUseCase
+}
+
+// NewInvoiceQRProcessor 創建發票照片處理器
+func NewInvoiceQRProcessor(
+	contents MessageContentFetcher,
+	decoder QRCodeDecoder,
+	invoices ScannedInvoiceUseCase,
+) *InvoiceQRProcessor {
+	return &InvoiceQRProcessor{
+		contents: contents,
+		decoder:  decoder,
+		invoices: invoices,
+	}
+}
+
+// ProcessInvoiceImage 處理會員上傳的發票照片
+func (p *InvoiceQRProcessor) ProcessInvoiceImage(memberID, messageID string) ([]Message, error) {
+	content, err := p.contents.MessageContent(messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download image %s: %w", messageID, err)
+	}
+
+	payloads, err := p.decoder.Decode(content)
+	if err != nil {
+		if errors.Is(err, invoice.ErrQRCodeNotFound) {
+			return []Message{NewTextMessage(textQRCodeNotFound)}, nil
+		}
+		return nil, fmt.Errorf("failed to decode QR code: %w", err)
+	}
+
+	qr, err := invoice.ParseInvoiceQRCode(payloads)
+	if err != nil {
+		switch {
+		case errors.Is(err, invoice.ErrQRCodeNotFound):
+			return []Message{NewTextMessage(textQRCodeNotFound)}, nil
+		case errors.Is(err, invoice.ErrInvalidQRCodeFormat):
+			return []Message{NewTextMessage(textInvalidInvoiceQRCode)}, nil
+		default:
+			return nil, fmt.Errorf("failed to parse invoice QR code for member %s: %w", memberID, err)
+		}
+	}
+
+	_, err = p.invoices.Execute(appinvoice.RecordScannedInvoiceCommand{
+		MemberID:      memberID,
+		InvoiceNumber: qr.InvoiceNumber().String(),
+		InvoiceDate:   qr.InvoiceDate(),
+		Amount:        qr.TotalAmount().Amount(),
+	})
+	if err != nil {
+		if errors.Is(err, invoice.ErrDuplicateInvoice) {
+			return []Message{NewTextMessage(duplicateInvoiceText(qr))}, nil
+		}
+		return nil, fmt.Errorf("failed to record invoice for member %s: %w", memberID, err)
+	}
+	return []Message{NewTextMessage(invoiceRecordedText(qr))}, nil
+}
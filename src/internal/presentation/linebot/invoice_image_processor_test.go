@@ -0,0 +1,144 @@
+package linebot
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	appinvoice "github.com/jackyeh168/bar_crm/src/internal/application/invoice"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// Test Doubles
+// ===========================
+
+// StubContentFetcher 固定返回圖片內容
+type StubContentFetcher struct {
+	content []byte
+	err     error
+	calls   []string
+}
+
+func (s *StubContentFetcher) MessageContent(messageID string) ([]byte, error) {
+	s.calls = append(s.calls, messageID)
+	return s.content, s.err
+}
+
+// StubQRCodeDecoder 固定返回解碼結果
+type StubQRCodeDecoder struct {
+	payloads []string
+	err      error
+}
+
+func (s *StubQRCodeDecoder) Decode(image []byte) ([]string, error) {
+	return s.payloads, s.err
+}
+
+// StubScannedInvoices 記錄登錄指令
+type StubScannedInvoices struct {
+	commands []appinvoice.RecordScannedInvoiceCommand
+	err      error
+}
+
+func (s *StubScannedInvoices) Execute(cmd appinvoice.RecordScannedInvoiceCommand) (*appinvoice.RecordScannedInvoiceResult, error) {
+	s.commands = append(s.commands, cmd)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &appinvoice.RecordScannedInvoiceResult{TransactionID: "tx-1", Status: "imported"}, nil
+}
+
+const testInvoiceQRLeft = "AB11223344" + "1140105" + "5678" + "000000ee" + "000000fa" +
+	"00000000" + "12345678" + "kH2eA0f1sXyZp3Qw9LmRtA==" + ":**********:1:1:1:莫西多:1:180"
+
+// processImage 以指定的解碼結果處理照片，返回回覆文字
+func processImage(t *testing.T, decoder *StubQRCodeDecoder, invoices *StubScannedInvoices) (string, error) {
+	t.Helper()
+	fetcher := &StubContentFetcher{content: []byte("jpeg")}
+	messages, err := NewInvoiceQRProcessor(fetcher, decoder, invoices).ProcessInvoiceImage("member-1", "325708")
+	if err != nil {
+		return "", err
+	}
+	assert.Equal(t, []string{"325708"}, fetcher.calls)
+	require.Len(t, messages, 1)
+	return messages[0].(TextMessage).Text, nil
+}
+
+// ===========================
+// 測試
+// ===========================
+
+// Test 1: 解析左右 QR Code 後登錄發票並回覆發票資訊
+func TestInvoiceQRProcessor_RecordsInvoice(t *testing.T) {
+	// Arrange
+	invoices := &StubScannedInvoices{}
+
+	// Act
+	text, err := processImage(t, &StubQRCodeDecoder{payloads: []string{testInvoiceQRLeft, "**:招待小菜:1:0"}}, invoices)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "✅ 發票資訊確認\n\n發票號碼：AB-11223344\n消費日期：2025/01/05\n消費金額：NT$ 250\n\n"+
+		textInvoicePendingVerification, text)
+	require.Len(t, invoices.commands, 1)
+	assert.Equal(t, "member-1", invoices.commands[0].MemberID)
+	assert.Equal(t, "AB11223344", invoices.commands[0].InvoiceNumber)
+	assert.Equal(t, 250, invoices.commands[0].Amount)
+	assert.Equal(t, "2025-01-05", invoices.commands[0].InvoiceDate.Format(time.DateOnly))
+}
+
+// Test 2: 發票已被登錄回覆提示訊息；其他登錄錯誤返回錯誤
+func TestInvoiceQRProcessor_RecordFailures(t *testing.T) {
+	// Arrange
+	decoder := &StubQRCodeDecoder{payloads: []string{testInvoiceQRLeft}}
+
+	// Act
+	duplicate, dupErr := processImage(t, decoder, &StubScannedInvoices{err: invoice.ErrDuplicateInvoice})
+	_, failErr := processImage(t, decoder, &StubScannedInvoices{err: errors.New("database is locked")})
+
+	// Assert
+	require.NoError(t, dupErr)
+	assert.Contains(t, duplicate, "⚠️ 此發票已被登錄")
+	assert.Contains(t, duplicate, "AB-11223344")
+	assert.Error(t, failErr)
+}
+
+// Test 3: 找不到 QR Code / 非電子發票 QR Code 回覆提示訊息
+func TestInvoiceQRProcessor_UnreadableImages(t *testing.T) {
+	tests := []struct {
+		name     string
+		decoder  *StubQRCodeDecoder
+		expected string
+	}{
+		{"找不到 QR Code", &StubQRCodeDecoder{err: invoice.ErrQRCodeNotFound}, textQRCodeNotFound},
+		{"非電子發票", &StubQRCodeDecoder{payloads: []string{"https://example.com/menu"}}, textInvalidInvoiceQRCode},
+		{"只拍到右側", &StubQRCodeDecoder{payloads: []string{"**:招待小菜:1:0"}}, textInvalidInvoiceQRCode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invoices := &StubScannedInvoices{}
+			text, err := processImage(t, tt.decoder, invoices)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, text)
+			assert.Empty(t, invoices.commands)
+		})
+	}
+}
+
+// Test 4: 下載圖片失敗返回錯誤
+func TestInvoiceQRProcessor_DownloadFailure(t *testing.T) {
+	// Arrange
+	fetcher := &StubContentFetcher{err: errors.New("LINE API error (status 404)")}
+	processor := NewInvoiceQRProcessor(fetcher, &StubQRCodeDecoder{}, &StubScannedInvoices{})
+
+	// Act
+	messages, err := processor.ProcessInvoiceImage("member-1", "325708")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, messages)
+}
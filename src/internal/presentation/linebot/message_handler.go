@@ -0,0 +1,153 @@
+package linebot
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	appmember "github.com/jackyeh168/bar_crm/src/internal/application/member"
+	apppoints "github.com/jackyeh168/bar_crm/src/internal/application/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+)
+
+// ===========================
+// 訊息處理
+// ===========================
+
+// balanceKeywords 積分查詢關鍵字（US-003）
+var balanceKeywords = map[string]bool{
+	"積分":   true,
+	"點數":   true,
+	"查詢積分": true,
+	"我的積分": true,
+	"餘額":   true,
+}
+
+// helpKeywords 功能說明關鍵字
+var helpKeywords = map[string]bool{
+	"幫助":   true,
+	"說明":   true,
+	"help": true,
+}
+
+// registerKeywords 開始註冊關鍵字
+var registerKeywords = map[string]bool{
+	"註冊": true,
+	"綁定": true,
+}
+
+// birthdayKeyword 填寫生日關鍵字（範例：生日 1990-05-20）
+const birthdayKeyword = "生日"
+
+// handleText 文字訊息
+//
+// 處理順序：
+// 1. 積分查詢關鍵字
+// 2. 功能說明關鍵字
+// 3. 註冊關鍵字
+// 4. 填寫生日（生日 YYYY-MM-DD）
+// 5. 未註冊：交由註冊對話處理（手機號碼 / 確認 / 取消）
+// 6. 已註冊：回覆功能說明
+func (r *EventRouter) handleText(event Event) error {
+	text := strings.TrimSpace(event.Message.Text)
+
+	if balanceKeywords[text] {
+		return r.handleBalance(event)
+	}
+	if helpKeywords[strings.ToLower(text)] {
+		return r.reply(event, textHelp)
+	}
+	if registerKeywords[text] {
+		return r.handleRegisterRequest(event)
+	}
+	if birthday, ok := strings.CutPrefix(text, birthdayKeyword); ok {
+		return r.handleBirthday(event, strings.TrimSpace(birthday))
+	}
+
+	m, err := r.findMember(event.UserID)
+	if err != nil {
+		return r.replySystemError(event, err)
+	}
+	if m != nil && m.IsRegistered {
+		return r.reply(event, textHelp)
+	}
+
+	return r.handleRegistrationInput(event, text)
+}
+
+// handleBalance 積分查詢（UC-003）
+//
+// 注意：尚未建立積分帳戶的會員視為 0 點
+func (r *EventRouter) handleBalance(event Event) error {
+	m, err := r.findMember(event.UserID)
+	if err != nil {
+		return r.replySystemError(event, err)
+	}
+	if m == nil || !m.IsRegistered {
+		return r.startRegistrationWith(event, textRegistrationRequired)
+	}
+
+	balance, err := r.balanceQuery.Execute(apppoints.GetPointsBalanceQuery{MemberID: m.MemberID})
+	if errors.Is(err, points.ErrAccountNotFound) {
+		balance = &apppoints.GetPointsBalanceResult{MemberID: m.MemberID}
+		err = nil
+	}
+	if err != nil {
+		return r.replySystemError(event, fmt.Errorf("failed to get points balance: %w", err))
+	}
+
+	return r.replyMessages(event, NewBalanceCard(balance, event.Timestamp))
+}
+
+// handleBirthday 填寫生日（用於生日禮）
+//
+// 注意：只輸入「生日」時回覆填寫方式；已填寫不同的生日時請會員洽店家修改
+func (r *EventRouter) handleBirthday(event Event, birthday string) error {
+	m, err := r.findMember(event.UserID)
+	if err != nil {
+		return r.replySystemError(event, err)
+	}
+	if m == nil || !m.IsRegistered {
+		return r.startRegistrationWith(event, textRegistrationRequired)
+	}
+	if birthday == "" {
+		return r.reply(event, textAskBirthday)
+	}
+
+	result, err := r.birthday.Execute(appmember.RecordBirthdayCommand{
+		LineUserID: event.UserID,
+		Birthday:   birthday,
+		Now:        event.Timestamp,
+	})
+	switch {
+	case errors.Is(err, member.ErrInvalidBirthday):
+		return r.reply(event, textInvalidBirthday)
+	case errors.Is(err, member.ErrBirthdayAlreadySet):
+		return r.reply(event, textBirthdayAlreadySet)
+	case err != nil:
+		return r.replySystemError(event, fmt.Errorf("failed to record birthday: %w", err))
+	}
+	return r.reply(event, birthdayRecordedText(result.Birthday))
+}
+
+// handleImage 圖片訊息（發票照片）
+func (r *EventRouter) handleImage(event Event) error {
+	m, err := r.findMember(event.UserID)
+	if err != nil {
+		return r.replySystemError(event, err)
+	}
+	if m == nil || !m.IsRegistered {
+		return r.startRegistrationWith(event, textRegistrationRequired)
+	}
+
+	if r.images == nil {
+		return r.reply(event, textImageNotSupported)
+	}
+
+	messages, err := r.images.ProcessInvoiceImage(m.MemberID, event.Message.ID)
+	if err != nil {
+		return r.replySystemError(event, fmt.Errorf("failed to process invoice image: %w", err))
+	}
+	return r.replyMessages(event, messages...)
+}
@@ -0,0 +1,241 @@
+package linebot
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackyeh168/bar_crm/src/internal/domain/invoice"
+)
+
+// ===========================
+// 回覆訊息
+// ===========================
+
+// Message LINE Messaging API 訊息物件
+//
+// 設計原則：
+// - 每種訊息自行序列化為 LINE API 格式（MarshalJSON）
+// - Replier 實作只負責傳送，不關心訊息內容
+type Message interface {
+	// MessageType 返回 LINE 訊息類型（text / flex ...）
+	MessageType() string
+}
+
+// TextMessage 純文字訊息
+type TextMessage struct {
+	Text string
+}
+
+// NewTextMessage 創建純文字訊息
+func NewTextMessage(text string) TextMessage {
+	return TextMessage{Text: text}
+}
+
+// MessageType 返回訊息類型
+func (m TextMessage) MessageType() string {
+	return "text"
+}
+
+// MarshalJSON 序列化為 LINE API 格式
+func (m TextMessage) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}{
+		Type: m.MessageType(),
+		Text: m.Text,
+	})
+}
+
+// Replier 回覆訊息介面
+//
+// 設計原則：
+// - 以 Webhook 事件的 replyToken 回覆（每個 token 只能使用一次，且有效時間有限）
+// - 實作由 LINE Messaging API 客戶端（Client）提供；測試時以 fake 替換
+type Replier interface {
+	Reply(token ReplyToken, messages ...Message) error
+}
+
+// ProfileProvider LINE 用戶資料查詢介面
+//
+// 使用場景：註冊時取得 LINE 顯示名稱、已註冊會員重新加入好友時同步顯示名稱
+// （Webhook 事件本身不含顯示名稱）
+type ProfileProvider interface {
+	DisplayName(lineUserID string) (string, error)
+}
+
+// ===========================
+// 訊息文案（docs/product/ui-ux/linebot-conversation-flows.md）
+// ===========================
+
+const (
+	// textWelcome 加入好友歡迎訊息
+	textWelcome = "🎉 歡迎加入會員系統！\n\n" +
+		"透過本系統，您可以：\n" +
+		"📸 掃描發票 QR Code 賺取積分\n" +
+		"💰 隨時查詢您的積分餘額\n" +
+		"📋 參與問卷調查獲得額外獎勵\n\n" +
+		"請輸入您的手機號碼完成註冊：\n" +
+		"（格式：0912345678，10 位數字）"
+
+	// textWelcomeBack 已註冊會員重新加入好友
+	textWelcomeBack = "🎉 歡迎回來！\n\n" +
+		"📸 直接傳送發票照片即可賺取積分\n" +
+		"💰 輸入「積分」查詢您的積分餘額"
+
+	// textRegistrationRequired 未註冊用戶嘗試操作
+	textRegistrationRequired = "⚠️ 請先完成註冊\n\n" +
+		"您尚未綁定手機號碼，無法使用此功能。\n\n" +
+		"請輸入您的手機號碼完成註冊：\n" +
+		"（格式：0912345678）"
+
+	// textInvalidPhoneNumber 手機號碼格式錯誤（PRD US-001 失敗場景 1，需逐字一致）
+	textInvalidPhoneNumber = "手機號碼格式錯誤，請輸入 10 位數字，以 09 開頭"
+
+	// textPhoneNumberAlreadyBound 手機號碼已被使用（PRD US-001 失敗場景 2，需逐字一致）
+	textPhoneNumberAlreadyBound = "此手機號碼已被註冊"
+
+	// textPhoneNumberAlreadyBoundHint 手機號碼已被使用的補充說明
+	textPhoneNumberAlreadyBoundHint = "每個手機號碼只能綁定一個 LINE 帳號。\n" +
+		"如有問題，請聯繫餐廳人員，或輸入其他手機號碼："
+
+	// textRetryLimitExceeded 手機號碼輸入錯誤次數過多
+	textRetryLimitExceeded = "❌ 輸入錯誤次數過多，註冊流程已結束。\n\n" +
+		"如需繼續，請輸入「註冊」重新開始。"
+
+	// textRegistrationCancelled 用戶取消註冊
+	textRegistrationCancelled = "已取消註冊。\n\n" +
+		"如需繼續，請輸入「註冊」重新開始。"
+
+	// textSessionExpired 會話逾時
+	textSessionExpired = "⏰ 操作已逾時\n\n" +
+		"您的操作已超過時間限制。\n" +
+		"如需繼續，請重新開始。\n\n" +
+		"需要協助嗎？輸入「幫助」查看功能說明"
+
+	// textAskPhoneNumber 請輸入手機號碼
+	textAskPhoneNumber = "請輸入您的手機號碼完成註冊：\n" +
+		"（格式：0912345678，10 位數字）"
+
+	// textAlreadyRegistered 已完成註冊
+	textAlreadyRegistered = "✅ 您已完成註冊\n\n" +
+		"📸 直接傳送發票照片即可賺取積分\n" +
+		"💰 輸入「積分」查詢您的積分餘額"
+
+	// textHelp 功能說明
+	textHelp = "💡 功能說明\n\n" +
+		"📸 上傳發票 QR Code\n" +
+		"   → 直接傳送發票照片即可\n\n" +
+		"💰 查詢積分\n" +
+		"   → 輸入「積分」或「點數」\n\n" +
+		"🎂 填寫生日領取生日禮\n" +
+		"   → 輸入「生日 1990-05-20」"
+
+	// textAskBirthday 只輸入「生日」時說明填寫方式
+	textAskBirthday = "🎂 填寫生日，生日當天即可獲得生日禮積分！\n\n" +
+		"請輸入「生日」加上您的生日：\n" +
+		"（範例：生日 1990-05-20）"
+
+	// textInvalidBirthday 生日格式錯誤
+	textInvalidBirthday = "❌ 生日格式錯誤\n\n" +
+		"請依照格式輸入：生日 YYYY-MM-DD\n" +
+		"（範例：生日 1990-05-20）"
+
+	// textBirthdayAlreadySet 已填寫不同的生日
+	textBirthdayAlreadySet = "您已填寫過生日。\n" +
+		"如需修改，請聯繫餐廳人員。"
+
+	// textImageNotSupported 尚未提供發票照片處理
+	textImageNotSupported = "📸 目前暫時無法處理發票照片，請稍後再試。"
+
+	// textQRCodeNotFound 照片中找不到 QR Code
+	textQRCodeNotFound = "❌ 未找到 QR Code\n\n" +
+		"請確認照片清晰可見，並包含完整的發票 QR Code。\n\n" +
+		"💡 拍照小技巧：\n" +
+		"• 確保光線充足\n" +
+		"• 對準 QR Code\n" +
+		"• 避免反光或陰影\n" +
+		"• QR Code 完整出現在畫面中\n\n" +
+		"請重新上傳照片"
+
+	// textInvalidInvoiceQRCode QR Code 不是電子發票（或只拍到右側 QR Code）
+	textInvalidInvoiceQRCode = "❌ 無效的 QR Code 格式\n\n" +
+		"請上傳電子發票證明聯，並確認左側 QR Code 完整出現在畫面中。"
+
+	// textInvoicePendingVerification 發票登錄成功，等待 iChef 驗證
+	textInvoicePendingVerification = "📌 狀態：待驗證\n" +
+		"此發票將在店家匯入 POS 系統資料後自動驗證，積分將於驗證後入帳。\n\n" +
+		"您可以隨時輸入「積分」查詢最新狀態"
+
+	// textSystemError 系統錯誤
+	textSystemError = "❌ 系統處理中發生錯誤\n\n" +
+		"很抱歉，系統暫時無法處理您的請求。\n" +
+		"請稍後再試，或聯繫客服人員。"
+)
+
+// registrationSucceededText 註冊成功訊息（手機號碼遮罩）
+func registrationSucceededText(displayName, phoneNumber string) string {
+	return fmt.Sprintf("✅ 註冊成功！\n\n"+
+		"會員資訊：\n"+
+		"• 暱稱：%s\n"+
+		"• 手機：%s\n\n"+
+		"現在您可以：\n"+
+		"📸 上傳發票 QR Code\n"+
+		"   → 直接傳送發票照片即可\n\n"+
+		"💰 查詢積分\n"+
+		"   → 輸入「積分」或「點數」",
+		displayName, maskPhoneNumber(phoneNumber))
+}
+
+// birthdayRecordedText 生日填寫成功訊息
+func birthdayRecordedText(birthday string) string {
+	return fmt.Sprintf("✅ 已記錄您的生日：%s\n\n"+
+		"生日當天將自動贈送生日禮積分 🎁",
+		birthday)
+}
+
+// remainingAttemptsText 格式錯誤時的重試提示
+func remainingAttemptsText(remaining int) string {
+	return fmt.Sprintf("範例：0912345678\n請重新輸入（還可嘗試 %d 次）：", remaining)
+}
+
+// confirmPhoneNumberText 請用戶確認手機號碼
+func confirmPhoneNumberText(phoneNumber string) string {
+	return fmt.Sprintf("請確認您的手機號碼：%s\n\n"+
+		"正確請回覆「是」，重新輸入請回覆「否」\n"+
+		"輸入「取消」可結束註冊",
+		phoneNumber)
+}
+
+// invoiceConfirmationText 發票資訊確認訊息（AB12345678 → AB-12345678）
+func invoiceConfirmationText(qr invoice.InvoiceQRCode) string {
+	number := qr.InvoiceNumber().String()
+	return fmt.Sprintf("✅ 發票資訊確認\n\n"+
+		"發票號碼：%s-%s\n"+
+		"消費日期：%s\n"+
+		"消費金額：NT$ %d",
+		number[:2], number[2:], qr.InvoiceDate().Format("2006/01/02"), qr.TotalAmount().Amount())
+}
+
+// invoiceRecordedText 發票登錄成功訊息（發票資訊 + 待驗證說明）
+func invoiceRecordedText(qr invoice.InvoiceQRCode) string {
+	return invoiceConfirmationText(qr) + "\n\n" + textInvoicePendingVerification
+}
+
+// duplicateInvoiceText 發票已被登錄訊息
+func duplicateInvoiceText(qr invoice.InvoiceQRCode) string {
+	number := qr.InvoiceNumber().String()
+	return fmt.Sprintf("⚠️ 此發票已被登錄\n\n"+
+		"發票號碼：%s-%s\n\n"+
+		"每張發票只能獲得一次積分。\n\n"+
+		"輸入「積分」查看您的積分記錄",
+		number[:2], number[2:])
+}
+
+// maskPhoneNumber 遮罩手機號碼（0912345678 → 0912***678）
+func maskPhoneNumber(phoneNumber string) string {
+	if len(phoneNumber) != 10 {
+		return phoneNumber
+	}
+	return phoneNumber[:4] + "***" + phoneNumber[7:]
+}
@@ -0,0 +1,92 @@
+package linebot
+
+import (
+	"errors"
+	"fmt"
+
+	appconversation "github.com/jackyeh168/bar_crm/src/internal/application/conversation"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/conversation"
+)
+
+// ===========================
+// 註冊對話處理
+// ===========================
+
+// handleRegisterRequest 用戶要求註冊（「註冊」關鍵字 / Rich Menu）
+func (r *EventRouter) handleRegisterRequest(event Event) error {
+	m, err := r.findMember(event.UserID)
+	if err != nil {
+		return r.replySystemError(event, err)
+	}
+	if m != nil && m.IsRegistered {
+		return r.reply(event, textAlreadyRegistered)
+	}
+	return r.startRegistrationWith(event, textAskPhoneNumber)
+}
+
+// startRegistrationWith 開始註冊對話並回覆指定訊息
+func (r *EventRouter) startRegistrationWith(event Event, text string) error {
+	_, err := r.startRegistration.Execute(appconversation.StartRegistrationCommand{LineUserID: event.UserID})
+	if err != nil {
+		return r.replySystemError(event, fmt.Errorf("failed to start registration: %w", err))
+	}
+	return r.reply(event, text)
+}
+
+// handleRegistrationInput 未註冊用戶的文字輸入
+//
+// 沒有進行中的會話時（例如未經加入好友直接輸入）自動開始會話再處理本次輸入
+func (r *EventRouter) handleRegistrationInput(event Event, text string) error {
+	cmd := appconversation.RegistrationInputCommand{
+		LineUserID: event.UserID,
+		Text:       text,
+	}
+
+	// 僅在確認註冊時查詢 LINE 顯示名稱（避免每則訊息都呼叫 Profile API）
+	if conversation.ParseConfirmation(text) == conversation.ConfirmationYes {
+		displayName, err := r.profiles.DisplayName(event.UserID)
+		if err != nil {
+			return r.replySystemError(event, fmt.Errorf("failed to get LINE profile: %w", err))
+		}
+		cmd.DisplayName = displayName
+	}
+
+	result, err := r.registrationInput.Execute(cmd)
+	if errors.Is(err, conversation.ErrSessionNotFound) {
+		if _, err := r.startRegistration.Execute(appconversation.StartRegistrationCommand{LineUserID: event.UserID}); err != nil {
+			return r.replySystemError(event, fmt.Errorf("failed to start registration: %w", err))
+		}
+		result, err = r.registrationInput.Execute(cmd)
+	}
+	if err != nil {
+		return r.replySystemError(event, fmt.Errorf("failed to handle registration input: %w", err))
+	}
+
+	return r.reply(event, registrationReply(result, cmd.DisplayName)...)
+}
+
+// registrationReply 將註冊對話步驟轉為回覆訊息
+//
+// 注意：格式錯誤與手機號碼已被註冊的第一則訊息需與 PRD（US-001）文案逐字一致
+func registrationReply(result *appconversation.RegistrationStepResult, displayName string) []string {
+	switch result.Step {
+	case appconversation.StepInvalidPhoneNumber:
+		return []string{textInvalidPhoneNumber, remainingAttemptsText(result.RemainingAttempts)}
+	case appconversation.StepConfirmPhoneNumber:
+		return []string{confirmPhoneNumberText(result.PhoneNumber)}
+	case appconversation.StepPhoneNumberBound:
+		return []string{textPhoneNumberAlreadyBound, textPhoneNumberAlreadyBoundHint}
+	case appconversation.StepRetryLimitExceeded:
+		return []string{textRetryLimitExceeded}
+	case appconversation.StepCancelled:
+		return []string{textRegistrationCancelled}
+	case appconversation.StepExpired:
+		return []string{textSessionExpired}
+	case appconversation.StepRegistered:
+		return []string{registrationSucceededText(displayName, result.PhoneNumber)}
+	case appconversation.StepAlreadyRegistered:
+		return []string{textAlreadyRegistered}
+	default:
+		return []string{textAskPhoneNumber}
+	}
+}
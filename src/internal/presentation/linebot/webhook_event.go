@@ -0,0 +1,167 @@
+package linebot
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ===========================
+// Webhook 事件
+// ===========================
+
+// EventType Webhook 事件類型
+type EventType string
+
+const (
+	EventTypeFollow   EventType = "follow"   // 加入好友（含解除封鎖）
+	EventTypeUnfollow EventType = "unfollow" // 封鎖
+	EventTypeMessage  EventType = "message"  // 訊息
+	EventTypePostback EventType = "postback" // Rich Menu / 按鈕回傳
+)
+
+// MessageType 訊息事件的訊息類型
+type MessageType string
+
+const (
+	MessageTypeText  MessageType = "text"
+	MessageTypeImage MessageType = "image"
+)
+
+// Event 解析後的 Webhook 事件
+//
+// 設計原則：
+// - 只保留業務需要的欄位（Anti-Corruption：LINE 原始格式不外洩）
+// - 未支援的事件類型仍會解析，由 EventRouter 忽略
+//
+// 欄位：
+// - ReplyToken: unfollow 事件沒有 replyToken
+// - UserID: 只處理一對一聊天（source.type = user）
+// - Message: 僅 message 事件
+// - Postback: 僅 postback 事件
+type Event struct {
+	Type           EventType
+	WebhookEventID string
+	ReplyToken     string
+	UserID         string
+	Timestamp      time.Time
+	IsRedelivery   bool
+	Message        *EventMessage
+	Postback       *EventPostback
+}
+
+// EventMessage 訊息內容
+//
+// 欄位：
+// - ID: 訊息 ID（圖片訊息以此下載內容）
+// - Text: 僅文字訊息
+type EventMessage struct {
+	ID   string
+	Type MessageType
+	Text string
+}
+
+// EventPostback Postback 內容
+//
+// 欄位：
+// - Data: 原始 data 字串（query string 格式，例如 action=balance）
+// - Params: datetime picker 等附帶參數
+type EventPostback struct {
+	Data   string
+	Params map[string]string
+}
+
+// Action 返回 data 中的 action 參數
+func (p *EventPostback) Action() string {
+	values, err := url.ParseQuery(p.Data)
+	if err != nil {
+		return ""
+	}
+	return values.Get("action")
+}
+
+// replyToken 返回附帶事件時間的 replyToken（用於判斷是否逾時）
+func (e Event) replyToken() ReplyToken {
+	return ReplyToken{Value: e.ReplyToken, IssuedAt: e.Timestamp}
+}
+
+// ===========================
+// LINE 原始格式
+// ===========================
+
+// webhookRequestJSON Webhook 請求本體
+type webhookRequestJSON struct {
+	Destination string      `json:"destination"`
+	Events      []eventJSON `json:"events"`
+}
+
+type eventJSON struct {
+	Type            string `json:"type"`
+	WebhookEventID  string `json:"webhookEventId"`
+	ReplyToken      string `json:"replyToken"`
+	Timestamp       int64  `json:"timestamp"`
+	DeliveryContext struct {
+		IsRedelivery bool `json:"isRedelivery"`
+	} `json:"deliveryContext"`
+	Source struct {
+		Type   string `json:"type"`
+		UserID string `json:"userId"`
+	} `json:"source"`
+	Message *struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"message"`
+	Postback *struct {
+		Data   string            `json:"data"`
+		Params map[string]string `json:"params"`
+	} `json:"postback"`
+}
+
+// ParseWebhookEvents 解析 Webhook 請求本體
+//
+// 返回：
+//   []Event - 解析後的事件（保持原順序）
+//   error - JSON 格式錯誤
+//
+// 注意：
+// - 群組 / 聊天室事件（source.type 非 user）會被略過
+// - LINE 驗證 Webhook URL 時會送出空的 events 陣列
+func ParseWebhookEvents(body []byte) ([]Event, error) {
+	var req webhookRequestJSON
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook body: %w", err)
+	}
+
+	events := make([]Event, 0, len(req.Events))
+	for _, raw := range req.Events {
+		if raw.Source.Type != "user" {
+			continue
+		}
+
+		event := Event{
+			Type:           EventType(raw.Type),
+			WebhookEventID: raw.WebhookEventID,
+			ReplyToken:     raw.ReplyToken,
+			UserID:         raw.Source.UserID,
+			Timestamp:      time.UnixMilli(raw.Timestamp),
+			IsRedelivery:   raw.DeliveryContext.IsRedelivery,
+		}
+		if raw.Message != nil {
+			event.Message = &EventMessage{
+				ID:   raw.Message.ID,
+				Type: MessageType(raw.Message.Type),
+				Text: raw.Message.Text,
+			}
+		}
+		if raw.Postback != nil {
+			event.Postback = &EventPostback{
+				Data:   raw.Postback.Data,
+				Params: raw.Postback.Params,
+			}
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -0,0 +1,158 @@
+package linebot
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+)
+
+// ===========================
+// Webhook Handler
+// ===========================
+
+// SignatureHeader LINE 簽章標頭
+const SignatureHeader = "X-Line-Signature"
+
+// maxWebhookBodyBytes Webhook 請求本體上限（1 MB）
+const maxWebhookBodyBytes = 1 << 20
+
+// recentEventCapacity 記錄最近處理過的 webhookEventId 數量（LINE 逾時重送的事件 ID 不變）
+const recentEventCapacity = 10000
+
+// WebhookHandler LINE Webhook 入口（實作 http.Handler）
+//
+// 職責：
+// 1. 驗證 X-Line-Signature（HMAC-SHA256，以 Channel Secret 為金鑰）
+// 2. 解析事件
+// 3. 略過已處理過的 webhookEventId（回應逾時時 LINE 會以相同 ID 重送，避免重複登錄發票或重複回覆）
+// 4. 交由 EventRouter 分派
+//
+// 回應碼：
+// - 200: 簽章正確（個別事件處理失敗仍回 200，避免 LINE 重送造成重複處理）
+// - 400: 簽章錯誤或格式錯誤
+// - 405: 非 POST 請求
+// - 413: 請求本體過大
+type WebhookHandler struct {
+	channelSecret []byte
+	router        *EventRouter
+	seen          *recentEventIDs
+}
+
+// NewWebhookHandler 創建 Webhook Handler
+func NewWebhookHandler(channelSecret string, router *EventRouter) *WebhookHandler {
+	return &WebhookHandler{
+		channelSecret: []byte(channelSecret),
+		router:        router,
+		seen:          newRecentEventIDs(recentEventCapacity),
+	}
+}
+
+// ServeHTTP 處理 Webhook 請求
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodyBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !VerifySignature(h.channelSecret, body, r.Header.Get(SignatureHeader)) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	events, err := ParseWebhookEvents(body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	for _, event := range events {
+		if !h.seen.markSeen(event.WebhookEventID) {
+			log.Printf("[INFO] Skipping duplicate LINE webhook event %s (redelivery: %t)", event.WebhookEventID, event.IsRedelivery)
+			continue
+		}
+		if err := h.router.Dispatch(event); err != nil {
+			log.Printf("[ERROR] Failed to handle LINE webhook event %s (%s): %v", event.WebhookEventID, event.Type, err)
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// recentEventIDs 最近處理過的 webhookEventId（固定容量，超過時淘汰最舊的）
+//
+// 並行安全：同一事件的重送可能與原請求同時到達
+type recentEventIDs struct {
+	mu    sync.Mutex
+	ids   map[string]struct{}
+	order []string
+	next  int
+}
+
+func newRecentEventIDs(capacity int) *recentEventIDs {
+	return &recentEventIDs{
+		ids:   make(map[string]struct{}, capacity),
+		order: make([]string, capacity),
+	}
+}
+
+// markSeen 記錄事件 ID，已記錄過時返回 false（空 ID 一律視為新事件）
+func (r *recentEventIDs) markSeen(id string) bool {
+	if id == "" {
+		return true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.ids[id]; exists {
+		return false
+	}
+	if evicted := r.order[r.next]; evicted != "" {
+		delete(r.ids, evicted)
+	}
+	r.order[r.next] = id
+	r.next = (r.next + 1) % len(r.order)
+	r.ids[id] = struct{}{}
+	return true
+}
+
+// VerifySignature 驗證 X-Line-Signature
+//
+// 演算法：Base64(HMAC-SHA256(channelSecret, body))
+// 使用常數時間比較，避免時序攻擊
+func VerifySignature(channelSecret, body []byte, signature string) bool {
+	if signature == "" {
+		return false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(decoded, computeSignature(channelSecret, body))
+}
+
+// computeSignature 計算 HMAC-SHA256 簽章（原始位元組）
+func computeSignature(channelSecret, body []byte) []byte {
+	mac := hmac.New(sha256.New, channelSecret)
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,615 @@
+package linebot
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	appconversation "github.com/jackyeh168/bar_crm/src/internal/application/conversation"
+	appmember "github.com/jackyeh168/bar_crm/src/internal/application/member"
+	apppoints "github.com/jackyeh168/bar_crm/src/internal/application/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/conversation"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/member"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/points"
+	"github.com/jackyeh168/bar_crm/src/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// Webhook Handler 測試
+// ===========================
+
+const (
+	testChannelSecret = "test-channel-secret"
+	testLineUserID    = "U4af4980629a1b2c3d4e5f6a7b8c9d0e1"
+	testMemberID      = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+)
+
+// webhookFixture 測試用 Webhook 環境
+type webhookFixture struct {
+	members  *StubMemberQuery
+	register *StubRegisterMember
+	balances *StubBalanceQuery
+	reach    *StubReachability
+	sync     *StubProfileSync
+	birthday *StubBirthday
+	images   *StubInvoiceImageProcessor
+	replier  *FakeReplier
+	handler  *WebhookHandler
+}
+
+func newWebhookFixture() *webhookFixture {
+	f := &webhookFixture{
+		members:  &StubMemberQuery{members: make(map[string]*appmember.MemberResult)},
+		register: &StubRegisterMember{},
+		balances: &StubBalanceQuery{balances: make(map[string]*apppoints.GetPointsBalanceResult)},
+		sync:     &StubProfileSync{},
+		birthday: &StubBirthday{},
+		images:   &StubInvoiceImageProcessor{},
+		replier:  &FakeReplier{},
+	}
+	f.reach = &StubReachability{members: f.members}
+	sessions := &InMemorySessionRepository{sessions: make(map[string]*conversation.RegistrationSession)}
+	router := NewEventRouter(
+		f.members,
+		appconversation.NewStartRegistrationUseCase(sessions, PassthroughTransactionManager{}),
+		appconversation.NewHandleRegistrationInputUseCase(sessions, f.register, StubBindPhoneNumber{}, PassthroughTransactionManager{}),
+		f.balances,
+		f.reach,
+		f.sync,
+		f.birthday,
+		f.images,
+		StubProfileProvider{name: "王小明"},
+		f.replier,
+	)
+	f.handler = NewWebhookHandler(testChannelSecret, router)
+	return f
+}
+
+// givenRegisteredMember 建立已綁定手機的會員
+func (f *webhookFixture) givenRegisteredMember() {
+	f.members.members[testLineUserID] = &appmember.MemberResult{
+		MemberID:     testMemberID,
+		LineUserID:   testLineUserID,
+		DisplayName:  "王小明",
+		PhoneNumber:  "0912345678",
+		IsRegistered: true,
+	}
+}
+
+// post 以正確簽章送出 Webhook 請求
+func (f *webhookFixture) post(t *testing.T, body []byte) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	req.Header.Set(SignatureHeader, sign(body))
+	rec := httptest.NewRecorder()
+	f.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// loadPayload 讀取錄製的 Webhook 請求
+func loadPayload(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return body
+}
+
+// textPayload 以錄製的文字訊息替換內容（每次產生新的 webhookEventId，與實際送達的訊息相同）
+func textPayload(t *testing.T, text string) []byte {
+	textPayloadCount++
+	body := bytes.Replace(loadPayload(t, "text_message.json"), []byte(`"text": "積分"`), []byte(`"text": "`+text+`"`), 1)
+	eventID := fmt.Sprintf("01HGZ7Q3F0W8TEXT%012d", textPayloadCount)
+	return bytes.Replace(body, []byte("01HGZ7Q3F0W8TEXT000000000001"), []byte(eventID), 1)
+}
+
+// textPayloadCount 已產生的文字訊息數（用於產生不重複的 webhookEventId）
+var textPayloadCount int
+
+func sign(body []byte) string {
+	return base64.StdEncoding.EncodeToString(computeSignature([]byte(testChannelSecret), body))
+}
+
+// Test 1: 簽章錯誤回傳 400 且不處理事件
+func TestWebhookHandler_InvalidSignature_Returns400(t *testing.T) {
+	// Arrange
+	f := newWebhookFixture()
+	body := loadPayload(t, "follow.json")
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	req.Header.Set(SignatureHeader, base64.StdEncoding.EncodeToString([]byte("forged")))
+	rec := httptest.NewRecorder()
+
+	// Act
+	f.handler.ServeHTTP(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Empty(t, f.replier.replies)
+}
+
+// Test 2: 缺少簽章與非 POST 請求
+func TestWebhookHandler_MissingSignatureOrWrongMethod(t *testing.T) {
+	// Arrange
+	f := newWebhookFixture()
+	body := loadPayload(t, "follow.json")
+
+	// Act
+	unsigned := httptest.NewRecorder()
+	f.handler.ServeHTTP(unsigned, httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body)))
+	get := httptest.NewRecorder()
+	f.handler.ServeHTTP(get, httptest.NewRequest(http.MethodGet, "/webhook", nil))
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, unsigned.Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, get.Code)
+	assert.Empty(t, f.replier.replies)
+}
+
+// Test 3: LINE 驗證 Webhook URL（空事件）回傳 200
+func TestWebhookHandler_VerificationRequest_Returns200(t *testing.T) {
+	// Arrange
+	f := newWebhookFixture()
+
+	// Act
+	rec := f.post(t, []byte(`{"destination":"U0123456789abcdef0123456789abcdef","events":[]}`))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, f.replier.replies)
+}
+
+// Test 4: 解析錄製的事件
+func TestParseWebhookEvents_RecordedPayloads(t *testing.T) {
+	// Act
+	follow, err := ParseWebhookEvents(loadPayload(t, "follow.json"))
+	require.NoError(t, err)
+	image, err := ParseWebhookEvents(loadPayload(t, "image_message.json"))
+	require.NoError(t, err)
+	postback, err := ParseWebhookEvents(loadPayload(t, "postback.json"))
+	require.NoError(t, err)
+	unfollow, err := ParseWebhookEvents(loadPayload(t, "unfollow.json"))
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, follow, 1)
+	assert.Equal(t, EventTypeFollow, follow[0].Type)
+	assert.Equal(t, testLineUserID, follow[0].UserID)
+	assert.Equal(t, "nHuyWiB7yP5Zw52FIkcQobQuGDXCTA", follow[0].ReplyToken)
+	assert.Equal(t, int64(1736321400000), follow[0].Timestamp.UnixMilli())
+
+	require.Len(t, image, 1)
+	assert.Equal(t, MessageTypeImage, image[0].Message.Type)
+	assert.Equal(t, "354718705033693861", image[0].Message.ID)
+
+	require.Len(t, postback, 1)
+	assert.Equal(t, "balance", postback[0].Postback.Action())
+
+	require.Len(t, unfollow, 1)
+	assert.Empty(t, unfollow[0].ReplyToken)
+}
+
+// Test 5: 加入好友回覆歡迎訊息
+func TestWebhookHandler_Follow_RepliesWelcome(t *testing.T) {
+	// Arrange
+	f := newWebhookFixture()
+
+	// Act
+	rec := f.post(t, loadPayload(t, "follow.json"))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, f.replier.replies, 1)
+	assert.Equal(t, "nHuyWiB7yP5Zw52FIkcQobQuGDXCTA", f.replier.replies[0].token.Value)
+	assert.False(t, f.replier.replies[0].token.IssuedAt.IsZero())
+	assert.Equal(t, textWelcome, f.replier.replies[0].texts[0])
+}
+
+// Test 6: 未註冊用戶輸入手機號碼並確認後完成註冊
+func TestWebhookHandler_PhoneNumberConfirmed_RegistersMember(t *testing.T) {
+	// Arrange
+	f := newWebhookFixture()
+	f.post(t, loadPayload(t, "follow.json"))
+
+	// Act
+	phone := f.post(t, textPayload(t, "0912345678"))
+	confirm := f.post(t, textPayload(t, "是"))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, phone.Code)
+	assert.Equal(t, http.StatusOK, confirm.Code)
+	require.Len(t, f.replier.replies, 3)
+	assert.Equal(t, confirmPhoneNumberText("0912345678"), f.replier.replies[1].texts[0])
+	require.Len(t, f.register.commands, 1)
+	assert.Equal(t, appmember.RegisterMemberCommand{
+		LineUserID:  testLineUserID,
+		DisplayName: "王小明",
+		PhoneNumber: "0912345678",
+	}, f.register.commands[0])
+	assert.Contains(t, f.replier.replies[2].texts[0], "✅ 註冊成功！")
+	assert.Contains(t, f.replier.replies[2].texts[0], "0912***678")
+}
+
+// Test 7: 格式錯誤與手機號碼已被註冊回覆 PRD 文案
+func TestWebhookHandler_RegistrationErrors_RepliesPRDText(t *testing.T) {
+	t.Run("格式錯誤", func(t *testing.T) {
+		// Arrange
+		f := newWebhookFixture()
+
+		// Act
+		rec := f.post(t, textPayload(t, "0812345678"))
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rec.Code)
+		require.Len(t, f.replier.replies, 1)
+		assert.Equal(t, []string{
+			"手機號碼格式錯誤，請輸入 10 位數字，以 09 開頭",
+			remainingAttemptsText(conversation.MaxPhoneAttempts - 1),
+		}, f.replier.replies[0].texts)
+		assert.Empty(t, f.register.commands)
+	})
+
+	t.Run("手機已綁定", func(t *testing.T) {
+		// Arrange
+		f := newWebhookFixture()
+		f.register.err = member.ErrPhoneNumberAlreadyBound
+		f.post(t, textPayload(t, "0912345678"))
+
+		// Act
+		rec := f.post(t, textPayload(t, "是"))
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rec.Code)
+		require.Len(t, f.replier.replies, 2)
+		assert.Equal(t, "此手機號碼已被註冊", f.replier.replies[1].texts[0])
+	})
+
+	t.Run("系統錯誤", func(t *testing.T) {
+		// Arrange
+		f := newWebhookFixture()
+		f.register.err = assert.AnError
+		f.post(t, textPayload(t, "0912345678"))
+
+		// Act
+		rec := f.post(t, textPayload(t, "是"))
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rec.Code)
+		require.Len(t, f.replier.replies, 2)
+		assert.Equal(t, textSystemError, f.replier.replies[1].texts[0])
+	})
+}
+
+// Test 8: 已註冊會員查詢積分
+func TestWebhookHandler_BalanceKeyword_RepliesBalance(t *testing.T) {
+	// Arrange
+	f := newWebhookFixture()
+	f.givenRegisteredMember()
+	f.balances.balances[testMemberID] = &apppoints.GetPointsBalanceResult{
+		MemberID:        testMemberID,
+		EarnedPoints:    125,
+		UsedPoints:      5,
+		AvailablePoints: 120,
+	}
+
+	// Act
+	rec := f.post(t, loadPayload(t, "text_message.json"))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, f.replier.replies, 1)
+	require.Len(t, f.replier.replies[0].messages, 1)
+	card, ok := f.replier.replies[0].messages[0].(FlexMessage)
+	require.True(t, ok)
+	assert.Equal(t, "💰 您的積分資訊：可用餘額 120 點", card.AltText)
+	assert.Empty(t, f.register.commands)
+}
+
+// Test 9: 未註冊用戶查詢積分 / 上傳發票需先註冊
+func TestWebhookHandler_Unregistered_RepliesRegistrationRequired(t *testing.T) {
+	for _, payload := range []string{"text_message.json", "image_message.json", "postback.json"} {
+		t.Run(payload, func(t *testing.T) {
+			// Arrange
+			f := newWebhookFixture()
+
+			// Act
+			rec := f.post(t, loadPayload(t, payload))
+
+			// Assert
+			assert.Equal(t, http.StatusOK, rec.Code)
+			require.Len(t, f.replier.replies, 1)
+			assert.Equal(t, textRegistrationRequired, f.replier.replies[0].texts[0])
+			assert.Empty(t, f.images.calls)
+		})
+	}
+}
+
+// Test 10: 尚未建立積分帳戶視為 0 點（postback）
+func TestWebhookHandler_PostbackBalance_NoAccount_RepliesZero(t *testing.T) {
+	// Arrange
+	f := newWebhookFixture()
+	f.givenRegisteredMember()
+
+	// Act
+	rec := f.post(t, loadPayload(t, "postback.json"))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, f.replier.replies, 1)
+	card, ok := f.replier.replies[0].messages[0].(FlexMessage)
+	require.True(t, ok)
+	assert.Equal(t, "💰 您的積分資訊：可用餘額 0 點", card.AltText)
+}
+
+// Test 11: 發票照片交由處理器
+func TestWebhookHandler_Image_DispatchesToProcessor(t *testing.T) {
+	// Arrange
+	f := newWebhookFixture()
+	f.givenRegisteredMember()
+	f.images.reply = []Message{NewTextMessage("✅ 發票資訊確認")}
+
+	// Act
+	rec := f.post(t, loadPayload(t, "image_message.json"))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []string{testMemberID + "/354718705033693861"}, f.images.calls)
+	require.Len(t, f.replier.replies, 1)
+	assert.Equal(t, "✅ 發票資訊確認", f.replier.replies[0].texts[0])
+}
+
+// Test 12: 封鎖事件標記會員不可觸及且不回覆；重新加入好友恢復、歡迎回來並同步顯示名稱
+func TestWebhookHandler_UnfollowAndRefollow(t *testing.T) {
+	// Arrange
+	f := newWebhookFixture()
+	f.givenRegisteredMember()
+
+	// Act
+	unfollow := f.post(t, loadPayload(t, "unfollow.json"))
+	follow := f.post(t, loadPayload(t, "follow.json"))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, unfollow.Code)
+	assert.Equal(t, http.StatusOK, follow.Code)
+	require.Len(t, f.reach.commands, 2)
+	assert.Equal(t, testLineUserID, f.reach.commands[0].LineUserID)
+	assert.False(t, f.reach.commands[0].Reachable)
+	assert.False(t, f.reach.commands[0].OccurredAt.IsZero())
+	assert.True(t, f.reach.commands[1].Reachable)
+	require.Len(t, f.replier.replies, 1)
+	assert.Equal(t, textWelcomeBack, f.replier.replies[0].texts[0])
+	require.Len(t, f.sync.commands, 1)
+	assert.Equal(t, testLineUserID, f.sync.commands[0].LineUserID)
+	assert.Equal(t, "王小明", f.sync.commands[0].DisplayName)
+}
+
+// Test 13: 註冊對話中輸入取消關鍵字
+func TestWebhookHandler_CancelRegistration(t *testing.T) {
+	// Arrange
+	f := newWebhookFixture()
+	f.post(t, loadPayload(t, "follow.json"))
+
+	// Act
+	rec := f.post(t, textPayload(t, "取消"))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, f.replier.replies, 2)
+	assert.Equal(t, textRegistrationCancelled, f.replier.replies[1].texts[0])
+	assert.Empty(t, f.register.commands)
+}
+
+// Test 14: 已註冊會員以「生日 YYYY-MM-DD」填寫生日；格式錯誤與已填寫時回覆提示
+func TestWebhookHandler_RecordBirthday(t *testing.T) {
+	// Arrange
+	f := newWebhookFixture()
+	f.givenRegisteredMember()
+
+	// Act
+	f.post(t, textPayload(t, "生日"))
+	f.post(t, textPayload(t, "生日 1990-05-20"))
+	f.birthday.err = member.ErrInvalidBirthday
+	f.post(t, textPayload(t, "生日 5/20"))
+	f.birthday.err = member.ErrBirthdayAlreadySet
+	rec := f.post(t, textPayload(t, "生日 1991-05-20"))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, f.birthday.commands, 3)
+	assert.Equal(t, testLineUserID, f.birthday.commands[0].LineUserID)
+	assert.Equal(t, "1990-05-20", f.birthday.commands[0].Birthday)
+	require.Len(t, f.replier.replies, 4)
+	assert.Equal(t, textAskBirthday, f.replier.replies[0].texts[0])
+	assert.Equal(t, birthdayRecordedText("1990-05-20"), f.replier.replies[1].texts[0])
+	assert.Equal(t, textInvalidBirthday, f.replier.replies[2].texts[0])
+	assert.Equal(t, textBirthdayAlreadySet, f.replier.replies[3].texts[0])
+}
+
+// Test 15: LINE 重送相同 webhookEventId 時不重複處理
+func TestWebhookHandler_DuplicateEventID_ProcessedOnce(t *testing.T) {
+	// Arrange
+	f := newWebhookFixture()
+	f.givenRegisteredMember()
+	f.images.reply = []Message{NewTextMessage("✅ 發票資訊確認")}
+	body := loadPayload(t, "image_message.json")
+	redelivered := bytes.Replace(body, []byte(`"isRedelivery": false`), []byte(`"isRedelivery": true`), 1)
+
+	// Act
+	first := f.post(t, body)
+	second := f.post(t, redelivered)
+	third := f.post(t, body)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, http.StatusOK, second.Code)
+	assert.Equal(t, http.StatusOK, third.Code)
+	assert.Len(t, f.images.calls, 1)
+	assert.Len(t, f.replier.replies, 1)
+}
+
+// ===========================
+// Stubs / Fakes
+// ===========================
+
+// StubMemberQuery 以 LINE UserID 查詢會員
+type StubMemberQuery struct {
+	members map[string]*appmember.MemberResult
+}
+
+func (s *StubMemberQuery) Execute(query appmember.GetMemberByLineUserIDQuery) (*appmember.MemberResult, error) {
+	m, ok := s.members[query.LineUserID]
+	if !ok {
+		return nil, member.ErrMemberNotFound
+	}
+	return m, nil
+}
+
+// StubRegisterMember 記錄註冊指令
+type StubRegisterMember struct {
+	commands []appmember.RegisterMemberCommand
+	err      error
+}
+
+func (s *StubRegisterMember) Execute(cmd appmember.RegisterMemberCommand) (*appmember.RegisterMemberResult, error) {
+	s.commands = append(s.commands, cmd)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &appmember.RegisterMemberResult{MemberID: testMemberID, LineUserID: cmd.LineUserID}, nil
+}
+
+// StubBindPhoneNumber 已存在的會員皆視為已綁定手機號碼
+type StubBindPhoneNumber struct{}
+
+func (StubBindPhoneNumber) Execute(cmd appmember.BindPhoneNumberCommand) (*appmember.RegisterMemberResult, error) {
+	return nil, member.ErrPhoneAlreadyBound
+}
+
+// StubBalanceQuery 查詢積分餘額
+type StubBalanceQuery struct {
+	balances map[string]*apppoints.GetPointsBalanceResult
+}
+
+func (s *StubBalanceQuery) Execute(query apppoints.GetPointsBalanceQuery) (*apppoints.GetPointsBalanceResult, error) {
+	b, ok := s.balances[query.MemberID]
+	if !ok {
+		return nil, points.ErrAccountNotFound
+	}
+	return b, nil
+}
+
+// StubReachability 記錄可觸及狀態指令（會員不存在時返回 ErrMemberNotFound）
+type StubReachability struct {
+	members  *StubMemberQuery
+	commands []appmember.UpdateReachabilityCommand
+}
+
+func (s *StubReachability) Execute(cmd appmember.UpdateReachabilityCommand) (*appmember.ReachabilityResult, error) {
+	m, ok := s.members.members[cmd.LineUserID]
+	if !ok {
+		return nil, member.ErrMemberNotFound
+	}
+	s.commands = append(s.commands, cmd)
+	return &appmember.ReachabilityResult{MemberID: m.MemberID, Reachable: cmd.Reachable, Changed: true}, nil
+}
+
+// StubProfileSync 記錄顯示名稱同步指令
+type StubProfileSync struct {
+	commands []appmember.SyncLineProfileCommand
+}
+
+func (s *StubProfileSync) Execute(cmd appmember.SyncLineProfileCommand) (*appmember.SyncLineProfileResult, error) {
+	s.commands = append(s.commands, cmd)
+	return &appmember.SyncLineProfileResult{MemberID: testMemberID, DisplayName: cmd.DisplayName}, nil
+}
+
+// StubBirthday 記錄填寫生日指令（設定 err 時返回錯誤）
+type StubBirthday struct {
+	commands []appmember.RecordBirthdayCommand
+	err      error
+}
+
+func (s *StubBirthday) Execute(cmd appmember.RecordBirthdayCommand) (*appmember.RecordBirthdayResult, error) {
+	s.commands = append(s.commands, cmd)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &appmember.RecordBirthdayResult{MemberID: testMemberID, Birthday: cmd.Birthday, Changed: true}, nil
+}
+
+// StubInvoiceImageProcessor 記錄處理的照片
+type StubInvoiceImageProcessor struct {
+	calls []string
+	reply []Message
+}
+
+func (s *StubInvoiceImageProcessor) ProcessInvoiceImage(memberID, messageID string) ([]Message, error) {
+	s.calls = append(s.calls, memberID+"/"+messageID)
+	return s.reply, nil
+}
+
+// StubProfileProvider 固定顯示名稱
+type StubProfileProvider struct {
+	name string
+}
+
+func (s StubProfileProvider) DisplayName(lineUserID string) (string, error) {
+	return s.name, nil
+}
+
+// InMemorySessionRepository 以 map 保存註冊會話
+type InMemorySessionRepository struct {
+	sessions map[string]*conversation.RegistrationSession
+}
+
+func (r *InMemorySessionRepository) Save(ctx shared.TransactionContext, s *conversation.RegistrationSession) error {
+	r.sessions[s.LineUserID()] = s
+	return nil
+}
+
+func (r *InMemorySessionRepository) FindByLineUserID(ctx shared.TransactionContext, lineUserID string) (*conversation.RegistrationSession, error) {
+	s, ok := r.sessions[lineUserID]
+	if !ok {
+		return nil, conversation.ErrSessionNotFound
+	}
+	return s, nil
+}
+
+func (r *InMemorySessionRepository) Delete(ctx shared.TransactionContext, lineUserID string) error {
+	delete(r.sessions, lineUserID)
+	return nil
+}
+
+// PassthroughTransactionManager 直接執行函數（ctx 為 nil）
+type PassthroughTransactionManager struct{}
+
+func (PassthroughTransactionManager) InTransaction(fn func(ctx shared.TransactionContext) error) error {
+	return fn(nil)
+}
+
+// FakeReplier 記錄回覆內容
+type FakeReplier struct {
+	replies []recordedReply
+}
+
+type recordedReply struct {
+	token    ReplyToken
+	texts    []string
+	messages []Message
+}
+
+func (f *FakeReplier) Reply(token ReplyToken, messages ...Message) error {
+	reply := recordedReply{token: token, messages: messages}
+	for _, m := range messages {
+		if text, ok := m.(TextMessage); ok {
+			reply.texts = append(reply.texts, text.Text)
+		}
+	}
+	f.replies = append(f.replies, reply)
+	return nil
+}